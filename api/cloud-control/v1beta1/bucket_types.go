@@ -0,0 +1,227 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BucketSpec defines the desired state of Bucket
+type BucketSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RemoteRef is immutable."
+	RemoteRef RemoteRef `json:"remoteRef"`
+
+	// IpRange is required only on Azure, where the storage account is reached through
+	// a private endpoint created in the IpRange subnet.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="IpRange is immutable."
+	IpRange IpRangeRef `json:"ipRange,omitempty"`
+
+	// +kubebuilder:validation:Required
+	Scope ScopeRef `json:"scope"`
+
+	// +kubebuilder:validation:Required
+	Bucket BucketInfo `json:"bucket"`
+}
+
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type BucketInfo struct {
+	// +optional
+	Aws *BucketAws `json:"aws,omitempty"`
+
+	// +optional
+	Gcp *BucketGcp `json:"gcp,omitempty"`
+
+	// +optional
+	Azure *BucketAzure `json:"azure,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(has(self.expirationDays) || has(self.noncurrentVersionExpirationDays)), message="At least one of expirationDays or noncurrentVersionExpirationDays must be set."
+type BucketLifecycleRule struct {
+	// Only objects with keys starting with the prefix are affected by the rule. Empty prefix matches all objects.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Current objects are deleted this many days after they were created.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ExpirationDays int32 `json:"expirationDays,omitempty"`
+
+	// Noncurrent object versions are deleted this many days after they became noncurrent.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	NoncurrentVersionExpirationDays int32 `json:"noncurrentVersionExpirationDays,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.retentionDays) || self.retentionDays == 0 || self.versioning), message="retentionDays requires versioning on AWS."
+// +kubebuilder:validation:XValidation:rule="self.versioning || !has(self.lifecycle) || self.lifecycle.all(r, !has(r.noncurrentVersionExpirationDays))", message="noncurrentVersionExpirationDays requires versioning."
+type BucketAws struct {
+	// +optional
+	// +kubebuilder:default=false
+	Versioning bool `json:"versioning"`
+
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Lifecycle []BucketLifecycleRule `json:"lifecycle,omitempty"`
+
+	// Default retention period in days for new objects, enforced with S3 Object Lock in governance mode.
+	// Object Lock can not be disabled once enabled, so versioning can not be suspended afterwards.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.retentionDays) || self.retentionDays == 0 || !self.versioning), message="versioning and retentionDays can not be used together on GCP."
+// +kubebuilder:validation:XValidation:rule="self.versioning || !has(self.lifecycle) || self.lifecycle.all(r, !has(r.noncurrentVersionExpirationDays))", message="noncurrentVersionExpirationDays requires versioning."
+type BucketGcp struct {
+	// +optional
+	// +kubebuilder:default=false
+	Versioning bool `json:"versioning"`
+
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Lifecycle []BucketLifecycleRule `json:"lifecycle,omitempty"`
+
+	// Retention period in days during which objects can not be deleted or replaced.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.versioning || !has(self.lifecycle) || self.lifecycle.all(r, !has(r.noncurrentVersionExpirationDays))", message="noncurrentVersionExpirationDays requires versioning."
+type BucketAzure struct {
+	// +optional
+	// +kubebuilder:default=false
+	Versioning bool `json:"versioning"`
+
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Lifecycle []BucketLifecycleRule `json:"lifecycle,omitempty"`
+
+	// Retention period in days, enforced with an unlocked time-based immutability policy on the container.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// BucketStatus defines the observed state of Bucket
+type BucketStatus struct {
+	// +optional
+	State StatusState `json:"state,omitempty"`
+
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The name of the cloud provider bucket.
+	// AWS: S3 bucket name
+	// GCP: Cloud Storage bucket name
+	// Azure: blob container name
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// The region or location of the bucket.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// The identity of the generated credentials.
+	// AWS: IAM access key id
+	// GCP: HMAC access id
+	// Azure: storage account name
+	// +optional
+	AccessKeyId string `json:"accessKeyId,omitempty"`
+
+	// +optional
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+
+	// List of status conditions to indicate the status of a Bucket.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope.name"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// Bucket is the Schema for the buckets API
+type Bucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketSpec   `json:"spec,omitempty"`
+	Status BucketStatus `json:"status,omitempty"`
+}
+
+func (in *Bucket) ScopeRef() ScopeRef {
+	return in.Spec.Scope
+}
+
+func (in *Bucket) SetScopeRef(scopeRef ScopeRef) {
+	in.Spec.Scope = scopeRef
+}
+
+func (in *Bucket) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *Bucket) ObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *Bucket) SetObservedGeneration(v int64) {
+	in.Status.ObservedGeneration = v
+}
+
+func (in *Bucket) GetStatus() any {
+	return &in.Status
+}
+
+func (in *Bucket) State() string {
+	return string(in.Status.State)
+}
+
+func (in *Bucket) SetState(v string) {
+	in.Status.State = StatusState(v)
+}
+
+func (in *Bucket) SetStatusStateToReady() {
+	in.Status.State = StateReady
+}
+
+func (in *Bucket) SetStatusStateToError() {
+	in.Status.State = StateError
+}
+
+//+kubebuilder:object:root=true
+
+// BucketList contains a list of Bucket
+type BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Bucket `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Bucket{}, &BucketList{})
+}
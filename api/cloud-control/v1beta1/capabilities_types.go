@@ -0,0 +1,35 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	CapabilityServiceNfs          = "nfs"
+	CapabilityServiceRedis        = "redis"
+	CapabilityServiceRedisCluster = "redisCluster"
+)
+
+// RegionCapabilities describes which services, tiers and versions are available in one provider region.
+// Empty lists mean the values are not restricted.
+type RegionCapabilities struct {
+	// Availability of the services by name, e.g. nfs, redis, redisCluster. Services not listed are available.
+	// +optional
+	Services map[string]bool `json:"services,omitempty"`
+
+	// Redis tiers as used in the SKR Redis instance spec, e.g. S1, P2
+	// +optional
+	RedisTiers []string `json:"redisTiers,omitempty"`
+
+	// Redis versions as used in the SKR Redis instance spec, e.g. REDIS_7_2 on GCP and 7.1 on AWS
+	// +optional
+	RedisVersions []string `json:"redisVersions,omitempty"`
+
+	// NFS tiers as used in the SKR NFS volume spec, e.g. BASIC_HDD on GCP
+	// +optional
+	NfsTiers []string `json:"nfsTiers,omitempty"`
+
+	// Time the capabilities were discovered from the provider API
+	// +optional
+	ReadTime *metav1.Time `json:"readTime,omitempty"`
+}
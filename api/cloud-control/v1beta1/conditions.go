@@ -7,6 +7,8 @@ const (
 	ConditionTypeUpdating          = "Updating"
 	ConditionTypeDeleteWhileUsed   = "DeleteWhileUsed"
 	ConditionTypeInvalidDependency = "InvalidDependency"
+	ConditionTypeDrifted           = "Drifted"
+	ConditionTypeRestoring         = "Restoring"
 
 	ReasonScopeNotFound      = "ScopeNoFound"
 	ReasonProcessing         = "Processing"
@@ -27,4 +29,12 @@ const (
 	ReasonInvalidBinding     = "InvalidBinding"
 	ReasonProviderError      = "ProviderError"
 	ReasonDeleting           = "Deleting"
+	ReasonAwaitingApproval   = "AwaitingApproval"
+	ReasonInSync             = "InSync"
+	ReasonDriftDetected      = "DriftDetected"
+	ReasonDriftCorrecting    = "DriftCorrecting"
+	ReasonRestoreFailed      = "RestoreFailed"
+
+	ReasonUnsupportedTierChange = "UnsupportedTierChange"
+	ReasonTierChangeDeferred    = "TierChangeDeferred"
 )
@@ -0,0 +1,26 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CostEstimate is the estimated monthly cost of the cloud resources backing an object,
+// computed from the configured price catalog. It is a list price estimate and not a bill.
+type CostEstimate struct {
+	// Monthly amount as a decimal number with two fraction digits, e.g. "123.45"
+	Amount string `json:"amount"`
+
+	// Currency of the amount, as defined by the price catalog
+	Currency string `json:"currency"`
+
+	// Time the estimate was last changed
+	// +optional
+	CalculatedAt *metav1.Time `json:"calculatedAt,omitempty"`
+}
+
+// ObjWithCostEstimate is implemented by KCP objects that carry an estimated monthly cost in their status
+// +kubebuilder:object:generate=false
+type ObjWithCostEstimate interface {
+	GetEstimatedMonthlyCost() *CostEstimate
+	SetEstimatedMonthlyCost(*CostEstimate)
+}
@@ -97,6 +97,17 @@ type NfsInstanceAws struct {
 
 	// +kubebuilder:default=bursting
 	Throughput AwsThroughputMode `json:"throughput,omitempty"`
+
+	// SourceRecoveryPointId specifies the AWS Backup recovery point the new file system is restored from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceRecoveryPointId is immutable."
+	SourceRecoveryPointId string `json:"sourceRecoveryPointId,omitempty"`
+
+	// SourceBackupVaultName specifies the AWS Backup vault holding the SourceRecoveryPointId.
+	// Defaults to the scope backup vault.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackupVaultName is immutable."
+	SourceBackupVaultName string `json:"sourceBackupVaultName,omitempty"`
 }
 
 // NfsInstanceStatus defines the observed state of NfsInstance
@@ -142,6 +153,10 @@ type NfsInstanceStatus struct {
 
 	// +optional
 	StateData map[string]string `json:"stateData,omitempty"`
+
+	// Estimated monthly cost of the cloud resources, set when the price catalog has prices for them
+	// +optional
+	EstimatedMonthlyCost *CostEstimate `json:"estimatedMonthlyCost,omitempty"`
 }
 
 var _ client.Object = &NfsInstance{}
@@ -173,6 +188,14 @@ func (in *NfsInstance) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
 
+func (in *NfsInstance) GetEstimatedMonthlyCost() *CostEstimate {
+	return in.Status.EstimatedMonthlyCost
+}
+
+func (in *NfsInstance) SetEstimatedMonthlyCost(estimate *CostEstimate) {
+	in.Status.EstimatedMonthlyCost = estimate
+}
+
 func (in *NfsInstance) State() string {
 	return string(in.Status.State)
 }
@@ -39,6 +39,15 @@ const (
 // NukeSpec defines the desired state of Nuke
 type NukeSpec struct {
 	Scope ScopeRef `json:"scope"`
+
+	// DryRun when true makes Nuke only discover the KCP and provider resources of the Scope and
+	// list them in the status. Nothing is deleted until the Approved field is set.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Approved allows the deletion of the resources discovered in the dry-run mode.
+	// +optional
+	Approved bool `json:"approved,omitempty"`
 }
 
 // NukeStatus defines the observed state of Nuke
@@ -99,6 +108,8 @@ type NukeStatusKind struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="DryRun",type="boolean",JSONPath=".spec.dryRun"
+// +kubebuilder:printcolumn:name="Approved",type="boolean",JSONPath=".spec.approved"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
 
 // Nuke is the Schema for the nukes API
@@ -118,6 +129,11 @@ func (in *Nuke) SetScopeRef(scopeRef ScopeRef) {
 	in.Spec.Scope = scopeRef
 }
 
+// IsApprovalPending returns true if Nuke is in the dry-run mode and the deletion is not approved yet
+func (in *Nuke) IsApprovalPending() bool {
+	return in.Spec.DryRun && !in.Spec.Approved
+}
+
 func (in *Nuke) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
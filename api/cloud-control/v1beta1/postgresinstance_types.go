@@ -0,0 +1,273 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PostgresInstanceSpec defines the desired state of PostgresInstance
+type PostgresInstanceSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RemoteRef is immutable."
+	RemoteRef RemoteRef `json:"remoteRef"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(size(self.name) > 0), message="IpRange name must not be empty."
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// +kubebuilder:validation:Required
+	Scope ScopeRef `json:"scope"`
+
+	// +kubebuilder:validation:Required
+	Instance PostgresInstanceInfo `json:"instance"`
+}
+
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type PostgresInstanceInfo struct {
+	// +optional
+	Aws *PostgresInstanceAws `json:"aws,omitempty"`
+
+	// +optional
+	Gcp *PostgresInstanceGcp `json:"gcp,omitempty"`
+
+	// +optional
+	Azure *PostgresInstanceAzure `json:"azure,omitempty"`
+}
+
+type PostgresInstanceAws struct {
+	// The compute and memory capacity of the DB instance, for example db.m7g.large.
+	// +kubebuilder:validation:Required
+	InstanceClass string `json:"instanceClass"`
+
+	// +optional
+	// +kubebuilder:default="16"
+	// +kubebuilder:validation:Enum="17";"16";"15"
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="engineVersion cannot be downgraded."
+	EngineVersion string `json:"engineVersion"`
+
+	// Allocated storage in GiB.
+	// +optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=20
+	// +kubebuilder:validation:Maximum=65536
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="storageGb cannot be decreased."
+	StorageGb int32 `json:"storageGb"`
+
+	// +optional
+	// +kubebuilder:default=false
+	MultiAz bool `json:"multiAz"`
+
+	// Name of the database created together with the instance.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="DatabaseName is immutable."
+	DatabaseName string `json:"databaseName"`
+
+	// Name of the master user.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="MasterUsername is immutable."
+	MasterUsername string `json:"masterUsername"`
+
+	// Specifies the weekly time range during which maintenance on the instance is
+	// performed. It is specified as a range in the format ddd:hh24:mi-ddd:hh24:mi (24H
+	// Clock UTC). The minimum maintenance window is a 30 minute period.
+	//
+	// Example: sun:23:00-mon:01:30
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+}
+
+type PostgresInstanceGcp struct {
+	// The Cloud SQL machine tier, for example db-custom-2-8192.
+	// +kubebuilder:validation:Required
+	Tier string `json:"tier"`
+
+	// +optional
+	// +kubebuilder:default="POSTGRES_16"
+	// +kubebuilder:validation:Enum=POSTGRES_17;POSTGRES_16;POSTGRES_15
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="databaseVersion cannot be downgraded."
+	DatabaseVersion string `json:"databaseVersion"`
+
+	// Data disk size in GiB.
+	// +optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=65536
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="dataDiskSizeGb cannot be decreased."
+	DataDiskSizeGb int32 `json:"dataDiskSizeGb"`
+
+	// ZONAL instances run in a single zone, REGIONAL instances have a standby in another zone of the region.
+	// +optional
+	// +kubebuilder:default=ZONAL
+	// +kubebuilder:validation:Enum=ZONAL;REGIONAL
+	AvailabilityType string `json:"availabilityType"`
+
+	// Name of the database created together with the instance.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="DatabaseName is immutable."
+	DatabaseName string `json:"databaseName"`
+
+	// Cloud SQL maintenance is scheduled by the hour, minutes of the start time are ignored.
+	// +optional
+	MaintenancePolicy *MaintenancePolicyGcp `json:"maintenancePolicy,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!self.highAvailability || self.skuTier != 'Burstable'), message="highAvailability is not supported by the Burstable skuTier."
+type PostgresInstanceAzure struct {
+	// The flexible server compute SKU name, for example Standard_D2ds_v5.
+	// +kubebuilder:validation:Required
+	SkuName string `json:"skuName"`
+
+	// +optional
+	// +kubebuilder:default=GeneralPurpose
+	// +kubebuilder:validation:Enum=Burstable;GeneralPurpose;MemoryOptimized
+	SkuTier string `json:"skuTier"`
+
+	// +optional
+	// +kubebuilder:default="16"
+	// +kubebuilder:validation:Enum="17";"16";"15"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="version is immutable."
+	Version string `json:"version"`
+
+	// Storage size in GiB.
+	// +optional
+	// +kubebuilder:default=32
+	// +kubebuilder:validation:Minimum=32
+	// +kubebuilder:validation:Maximum=32767
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="storageGb cannot be decreased."
+	StorageGb int32 `json:"storageGb"`
+
+	// When enabled, a zone redundant standby server is provisioned. Not supported by the Burstable SKU tier.
+	// +optional
+	// +kubebuilder:default=false
+	HighAvailability bool `json:"highAvailability"`
+
+	// Name of the database created together with the instance.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="DatabaseName is immutable."
+	DatabaseName string `json:"databaseName"`
+}
+
+// PostgresInstanceStatus defines the observed state of PostgresInstance
+type PostgresInstanceStatus struct {
+	// +optional
+	State StatusState `json:"state,omitempty"`
+
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// +optional
+	PrimaryEndpoint string `json:"primaryEndpoint,omitempty"`
+
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// +optional
+	Password string `json:"password,omitempty"`
+
+	// The reconciled instance class of the Postgres instance.
+	// AWS: DB instance class (e.g., "db.m7g.large")
+	// GCP: Cloud SQL machine tier (e.g., "db-custom-2-8192")
+	// Azure: flexible server SKU name (e.g., "Standard_D2ds_v5")
+	// +optional
+	InstanceClass string `json:"instanceClass,omitempty"`
+
+	// The reconciled allocated storage in GiB.
+	// +optional
+	StorageGb int32 `json:"storageGb,omitempty"`
+
+	// List of status conditions to indicate the status of a PostgresInstance.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope.name"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// PostgresInstance is the Schema for the postgresinstances API
+type PostgresInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PostgresInstanceSpec   `json:"spec,omitempty"`
+	Status PostgresInstanceStatus `json:"status,omitempty"`
+}
+
+func (in *PostgresInstance) ScopeRef() ScopeRef {
+	return in.Spec.Scope
+}
+
+func (in *PostgresInstance) SetScopeRef(scopeRef ScopeRef) {
+	in.Spec.Scope = scopeRef
+}
+
+func (in *PostgresInstance) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *PostgresInstance) ObservedGeneration() int64 {
+	return in.Status.ObservedGeneration
+}
+
+func (in *PostgresInstance) SetObservedGeneration(v int64) {
+	in.Status.ObservedGeneration = v
+}
+
+func (in *PostgresInstance) GetStatus() any {
+	return &in.Status
+}
+
+func (in *PostgresInstance) State() string {
+	return string(in.Status.State)
+}
+
+func (in *PostgresInstance) SetState(v string) {
+	in.Status.State = StatusState(v)
+}
+
+func (in *PostgresInstance) SetStatusStateToReady() {
+	in.Status.State = StateReady
+}
+
+func (in *PostgresInstance) SetStatusStateToError() {
+	in.Status.State = StateError
+}
+
+//+kubebuilder:object:root=true
+
+// PostgresInstanceList contains a list of PostgresInstance
+type PostgresInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PostgresInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PostgresInstance{}, &PostgresInstanceList{})
+}
@@ -53,6 +53,9 @@ type RedisInstanceInfo struct {
 
 	// +optional
 	Aws *RedisInstanceAws `json:"aws,omitempty"`
+
+	// +optional
+	OpenStack *RedisInstanceOpenStack `json:"openStack,omitempty"`
 }
 
 type RedisInstanceAzureConfigs struct {
@@ -185,6 +188,17 @@ type RedisInstanceGcp struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=5
 	ReplicaCount int32 `json:"replicaCount"`
+
+	// DeferTierChange specifies if a change of MemorySizeGb is applied in the next MaintenancePolicy window
+	// instead of immediately. It has no effect if MaintenancePolicy is not set.
+	// +optional
+	DeferTierChange bool `json:"deferTierChange,omitempty"`
+
+	// ImportUri specifies the Cloud Storage URI of the RDB file the instance is seeded from,
+	// in the format gs://bucket/path/to/file.rdb.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="ImportUri is immutable."
+	ImportUri string `json:"importUri,omitempty"`
 }
 
 type RedisInstanceAzure struct {
@@ -199,6 +213,11 @@ type RedisInstanceAzure struct {
 
 	// +optional
 	ShardCount int `json:"shardCount,omitempty"`
+
+	// ImportBlobUrl specifies the URL of the blob holding the RDB file the instance is seeded from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="ImportBlobUrl is immutable."
+	ImportBlobUrl string `json:"importBlobUrl,omitempty"`
 }
 
 type RedisInstanceAws struct {
@@ -240,6 +259,43 @@ type RedisInstanceAws struct {
 	// +kubebuilder:validation:Maximum=1
 	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="ReadReplicas is immutable."
 	ReadReplicas int32 `json:"readReplicas"`
+
+	// DeferTierChange specifies if a change of CacheNodeType is applied in the next PreferredMaintenanceWindow
+	// instead of immediately.
+	// +optional
+	DeferTierChange bool `json:"deferTierChange,omitempty"`
+
+	// SnapshotName specifies the ElastiCache snapshot the replication group is seeded from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SnapshotName is immutable."
+	SnapshotName string `json:"snapshotName,omitempty"`
+}
+
+type RedisInstanceOpenStack struct {
+	// Name of the database service flavor the instance is provisioned with.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="FlavorName is immutable."
+	FlavorName string `json:"flavorName"`
+
+	// Size of the instance volume in GiB.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="VolumeSizeGb is immutable."
+	VolumeSizeGb int `json:"volumeSizeGb"`
+
+	// Datastore type of the database service, valkey or redis.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=valkey;redis
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Engine is immutable."
+	Engine string `json:"engine"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="EngineVersion is immutable."
+	EngineVersion string `json:"engineVersion"`
+
+	// +optional
+	// +kubebuilder:default=false
+	AuthEnabled bool `json:"authEnabled"`
 }
 
 // RedisInstanceStatus defines the observed state of RedisInstance
@@ -268,6 +324,7 @@ type RedisInstanceStatus struct {
 	// The reconciled node/machine type of the Redis instance.
 	// AWS: cache node type (e.g., "cache.t3.micro")
 	// Azure: SKU family + capacity (e.g., "P3")
+	// OpenStack: database service flavor name (e.g., "redis_c2_m4")
 	// +optional
 	NodeType string `json:"nodeType,omitempty"`
 
@@ -279,11 +336,31 @@ type RedisInstanceStatus struct {
 	// +optional
 	ReplicaCount int32 `json:"replicaCount,omitempty"`
 
+	// The node/machine type the instance is being scaled to, set while the change is in progress or deferred.
+	// +optional
+	PendingNodeType string `json:"pendingNodeType,omitempty"`
+
+	// The memory size in GiB the instance is being scaled to, set while the change is in progress or deferred.
+	// +optional
+	PendingMemorySizeGb int32 `json:"pendingMemorySizeGb,omitempty"`
+
+	// The snapshot, RDB file or blob the instance was seeded from, set once the restore completed.
+	// +optional
+	RestoredFrom string `json:"restoredFrom,omitempty"`
+
+	// Identifier of the in progress restore operation.
+	// +optional
+	RestoreOpIdentifier string `json:"restoreOpIdentifier,omitempty"`
+
 	// List of status conditions to indicate the status of a RedisInstance.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions"`
+
+	// Estimated monthly cost of the cloud resources, set when the price catalog has prices for them
+	// +optional
+	EstimatedMonthlyCost *CostEstimate `json:"estimatedMonthlyCost,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -312,6 +389,14 @@ func (in *RedisInstance) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
 
+func (in *RedisInstance) GetEstimatedMonthlyCost() *CostEstimate {
+	return in.Status.EstimatedMonthlyCost
+}
+
+func (in *RedisInstance) SetEstimatedMonthlyCost(estimate *CostEstimate) {
+	in.Status.EstimatedMonthlyCost = estimate
+}
+
 func (in *RedisInstance) ObservedGeneration() int64 {
 	return in.Status.ObservedGeneration
 }
@@ -213,6 +213,14 @@ type ScopeStatus struct {
 
 	// +optional
 	ExposedData ExposedData `json:"exposedData"`
+
+	// Estimated monthly cost of the cloud resources, set when the price catalog has prices for them
+	// +optional
+	EstimatedMonthlyCost *CostEstimate `json:"estimatedMonthlyCost,omitempty"`
+
+	// Capabilities of the Scope region discovered from the provider API, they override the static catalog
+	// +optional
+	Capabilities *RegionCapabilities `json:"capabilities,omitempty"`
 }
 
 type ExposedData struct {
@@ -221,6 +229,42 @@ type ExposedData struct {
 
 	// +optional
 	NatGatewayIps []string `json:"natGatewayIps"`
+
+	// NatGatewayIpsHistory are the last observed distinct sets of the NAT gateway IPs, the oldest first
+	// +optional
+	NatGatewayIpsHistory []NatGatewayIpsRecord `json:"natGatewayIpsHistory,omitempty"`
+
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// NetworkId is the provider identifier of the shoot network: the VPC ID in AWS, the VNet resource ID
+	// in Azure, the network resource name in GCP and the network ID in OpenStack
+	// +optional
+	NetworkId string `json:"networkId,omitempty"`
+
+	// +optional
+	NodesCidr string `json:"nodesCidr,omitempty"`
+
+	// +optional
+	PodsCidr string `json:"podsCidr,omitempty"`
+
+	// +optional
+	ServicesCidr string `json:"servicesCidr,omitempty"`
+
+	// IpRangeCidrs are the CIDRs of the Cloud Manager IpRanges allocated in the shoot network
+	// +optional
+	IpRangeCidrs []string `json:"ipRangeCidrs,omitempty"`
+}
+
+// NatGatewayIpsRecord is a set of the NAT gateway IPs and the time it was first observed
+type NatGatewayIpsRecord struct {
+	Time metav1.Time `json:"time"`
+
+	// +optional
+	NatGatewayIps []string `json:"natGatewayIps,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -242,6 +286,14 @@ func (in *Scope) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
 
+func (in *Scope) GetEstimatedMonthlyCost() *CostEstimate {
+	return in.Status.EstimatedMonthlyCost
+}
+
+func (in *Scope) SetEstimatedMonthlyCost(estimate *CostEstimate) {
+	in.Status.EstimatedMonthlyCost = estimate
+}
+
 func (in *Scope) GetObjectMeta() *metav1.ObjectMeta {
 	return &in.ObjectMeta
 }
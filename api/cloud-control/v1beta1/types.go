@@ -8,4 +8,5 @@ const (
 	StateProcessing StatusState = "Processing"
 	StateWarning    StatusState = "Warning"
 	StateDeleting   StatusState = "Deleting"
+	StateUpdating   StatusState = "Updating"
 )
@@ -121,6 +121,11 @@ func (b *VpcPeeringBuilder) WithRemoteRouteTableUpdateStrategy(strategy AwsRoute
 	return b
 }
 
+func (b *VpcPeeringBuilder) WithOpenStackPeeringMode(mode OpenStackVpcPeeringMode) *VpcPeeringBuilder {
+	b.Obj.Spec.Details.OpenStackPeeringMode = mode
+	return b
+}
+
 func (b *VpcPeeringBuilder) WithUseRemoteGateway(useRemoteGateway bool) *VpcPeeringBuilder {
 	b.Obj.Spec.Details.UseRemoteGateway = useRemoteGateway
 	return b
@@ -28,6 +28,7 @@ const (
 	ReasonFailedLoadingRemoteVpcNetwork           = "FailedLoadingRemoteVpcNetwork"
 	ReasonFailedLoadingRemoteVpcPeeringConnection = "FailedLoadingRemoteVpcPeeringConnection"
 	ReasonFailedCreatingRoutes                    = "FailedCreatingRoutes"
+	ReasonFailedDeletingRoutes                    = "FailedDeletingRoutes"
 	ReasonUnauthorized                            = "Unauthorized"
 	ReasonUnauthenticated                         = "Unauthenticated"
 	ReasonConflict                                = "Conflict"
@@ -54,6 +55,13 @@ const (
 	AwsRouteTableUpdateStrategyUnmatched AwsRouteTableUpdateStrategy = "UNMATCHED"
 )
 
+type OpenStackVpcPeeringMode string
+
+const (
+	OpenStackVpcPeeringModeRouterInterface OpenStackVpcPeeringMode = "RouterInterface"
+	OpenStackVpcPeeringModeNetworkRbac     OpenStackVpcPeeringMode = "NetworkRbac"
+)
+
 // VpcPeeringSpec defines the desired state of VpcPeering
 // +kubebuilder:validation:XValidation:rule=(has(self.vpcPeering) && !has(self.details) || !has(self.vpcPeering) && has(self.details)), message="Only one of details or vpcPeering can be specified."
 type VpcPeeringSpec struct {
@@ -93,6 +101,10 @@ type VpcPeeringDetails struct {
 	// +kubebuilder:default:=AUTO
 	// +kubebuilder:validation:Enum=AUTO;NONE;MATCHED;UNMATCHED
 	RemoteRouteTableUpdateStrategy AwsRouteTableUpdateStrategy `json:"remoteRouteTableUpdateStrategy,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=RouterInterface;NetworkRbac
+	OpenStackPeeringMode OpenStackVpcPeeringMode `json:"openStackPeeringMode,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties=1
@@ -155,6 +167,10 @@ type VpcPeeringStatus struct {
 	LocalPeeringOperation string `json:"localPeeringOperation,omitempty"`
 	// +optional
 	RemotePeeringOperation string `json:"remotePeeringOperation,omitempty"`
+
+	// Estimated monthly cost of the cloud resources, set when the price catalog has prices for them
+	// +optional
+	EstimatedMonthlyCost *CostEstimate `json:"estimatedMonthlyCost,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -183,6 +199,14 @@ func (in *VpcPeering) Conditions() *[]metav1.Condition {
 	return &in.Status.Conditions
 }
 
+func (in *VpcPeering) GetEstimatedMonthlyCost() *CostEstimate {
+	return in.Status.EstimatedMonthlyCost
+}
+
+func (in *VpcPeering) SetEstimatedMonthlyCost(estimate *CostEstimate) {
+	in.Status.EstimatedMonthlyCost = estimate
+}
+
 func (in *VpcPeering) GetObjectMeta() *metav1.ObjectMeta {
 	return &in.ObjectMeta
 }
@@ -331,6 +331,230 @@ func (in *AzureVpcPeering) DeepCopy() *AzureVpcPeering {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bucket) DeepCopyInto(out *Bucket) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bucket.
+func (in *Bucket) DeepCopy() *Bucket {
+	if in == nil {
+		return nil
+	}
+	out := new(Bucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Bucket) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketAws) DeepCopyInto(out *BucketAws) {
+	*out = *in
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = make([]BucketLifecycleRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketAws.
+func (in *BucketAws) DeepCopy() *BucketAws {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketAws)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketAzure) DeepCopyInto(out *BucketAzure) {
+	*out = *in
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = make([]BucketLifecycleRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketAzure.
+func (in *BucketAzure) DeepCopy() *BucketAzure {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketAzure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketGcp) DeepCopyInto(out *BucketGcp) {
+	*out = *in
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = make([]BucketLifecycleRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketGcp.
+func (in *BucketGcp) DeepCopy() *BucketGcp {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketGcp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketInfo) DeepCopyInto(out *BucketInfo) {
+	*out = *in
+	if in.Aws != nil {
+		in, out := &in.Aws, &out.Aws
+		*out = new(BucketAws)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gcp != nil {
+		in, out := &in.Gcp, &out.Gcp
+		*out = new(BucketGcp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(BucketAzure)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketInfo.
+func (in *BucketInfo) DeepCopy() *BucketInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketLifecycleRule) DeepCopyInto(out *BucketLifecycleRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketLifecycleRule.
+func (in *BucketLifecycleRule) DeepCopy() *BucketLifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketLifecycleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketList) DeepCopyInto(out *BucketList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Bucket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketList.
+func (in *BucketList) DeepCopy() *BucketList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
+	*out = *in
+	out.RemoteRef = in.RemoteRef
+	out.IpRange = in.IpRange
+	out.Scope = in.Scope
+	in.Bucket.DeepCopyInto(&out.Bucket)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpec.
+func (in *BucketSpec) DeepCopy() *BucketSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
+func (in *BucketStatus) DeepCopy() *BucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostEstimate) DeepCopyInto(out *CostEstimate) {
+	*out = *in
+	if in.CalculatedAt != nil {
+		in, out := &in.CalculatedAt, &out.CalculatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostEstimate.
+func (in *CostEstimate) DeepCopy() *CostEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(CostEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DayOfWeekPolicyGcp) DeepCopyInto(out *DayOfWeekPolicyGcp) {
 	*out = *in
@@ -359,6 +583,23 @@ func (in *ExposedData) DeepCopyInto(out *ExposedData) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NatGatewayIpsHistory != nil {
+		in, out := &in.NatGatewayIpsHistory, &out.NatGatewayIpsHistory
+		*out = make([]NatGatewayIpsRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IpRangeCidrs != nil {
+		in, out := &in.IpRangeCidrs, &out.IpRangeCidrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposedData.
@@ -978,6 +1219,27 @@ func (in *ManagedNetwork) DeepCopy() *ManagedNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayIpsRecord) DeepCopyInto(out *NatGatewayIpsRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.NatGatewayIps != nil {
+		in, out := &in.NatGatewayIps, &out.NatGatewayIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayIpsRecord.
+func (in *NatGatewayIpsRecord) DeepCopy() *NatGatewayIpsRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayIpsRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -1337,6 +1599,11 @@ func (in *NfsInstanceStatus) DeepCopyInto(out *NfsInstanceStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.EstimatedMonthlyCost != nil {
+		in, out := &in.EstimatedMonthlyCost, &out.EstimatedMonthlyCost
+		*out = new(CostEstimate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NfsInstanceStatus.
@@ -1549,6 +1816,191 @@ func (in *OpenStackScope) DeepCopy() *OpenStackScope {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstance) DeepCopyInto(out *PostgresInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstance.
+func (in *PostgresInstance) DeepCopy() *PostgresInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceAws) DeepCopyInto(out *PostgresInstanceAws) {
+	*out = *in
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceAws.
+func (in *PostgresInstanceAws) DeepCopy() *PostgresInstanceAws {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceAws)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceAzure) DeepCopyInto(out *PostgresInstanceAzure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceAzure.
+func (in *PostgresInstanceAzure) DeepCopy() *PostgresInstanceAzure {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceAzure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceGcp) DeepCopyInto(out *PostgresInstanceGcp) {
+	*out = *in
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicyGcp)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceGcp.
+func (in *PostgresInstanceGcp) DeepCopy() *PostgresInstanceGcp {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceGcp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceInfo) DeepCopyInto(out *PostgresInstanceInfo) {
+	*out = *in
+	if in.Aws != nil {
+		in, out := &in.Aws, &out.Aws
+		*out = new(PostgresInstanceAws)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gcp != nil {
+		in, out := &in.Gcp, &out.Gcp
+		*out = new(PostgresInstanceGcp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(PostgresInstanceAzure)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceInfo.
+func (in *PostgresInstanceInfo) DeepCopy() *PostgresInstanceInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceList) DeepCopyInto(out *PostgresInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceList.
+func (in *PostgresInstanceList) DeepCopy() *PostgresInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceSpec) DeepCopyInto(out *PostgresInstanceSpec) {
+	*out = *in
+	out.RemoteRef = in.RemoteRef
+	out.IpRange = in.IpRange
+	out.Scope = in.Scope
+	in.Instance.DeepCopyInto(&out.Instance)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceSpec.
+func (in *PostgresInstanceSpec) DeepCopy() *PostgresInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresInstanceStatus) DeepCopyInto(out *PostgresInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresInstanceStatus.
+func (in *PostgresInstanceStatus) DeepCopy() *PostgresInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisCluster) DeepCopyInto(out *RedisCluster) {
 	*out = *in
@@ -1849,6 +2301,11 @@ func (in *RedisInstanceInfo) DeepCopyInto(out *RedisInstanceInfo) {
 		*out = new(RedisInstanceAws)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(RedisInstanceOpenStack)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceInfo.
@@ -1893,6 +2350,21 @@ func (in *RedisInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceOpenStack) DeepCopyInto(out *RedisInstanceOpenStack) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceOpenStack.
+func (in *RedisInstanceOpenStack) DeepCopy() *RedisInstanceOpenStack {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceOpenStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisInstanceSpec) DeepCopyInto(out *RedisInstanceSpec) {
 	*out = *in
@@ -1922,6 +2394,11 @@ func (in *RedisInstanceStatus) DeepCopyInto(out *RedisInstanceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EstimatedMonthlyCost != nil {
+		in, out := &in.EstimatedMonthlyCost, &out.EstimatedMonthlyCost
+		*out = new(CostEstimate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceStatus.
@@ -1934,6 +2411,47 @@ func (in *RedisInstanceStatus) DeepCopy() *RedisInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionCapabilities) DeepCopyInto(out *RegionCapabilities) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RedisTiers != nil {
+		in, out := &in.RedisTiers, &out.RedisTiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedisVersions != nil {
+		in, out := &in.RedisVersions, &out.RedisVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NfsTiers != nil {
+		in, out := &in.NfsTiers, &out.NfsTiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadTime != nil {
+		in, out := &in.ReadTime, &out.ReadTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionCapabilities.
+func (in *RegionCapabilities) DeepCopy() *RegionCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteRef) DeepCopyInto(out *RemoteRef) {
 	*out = *in
@@ -2090,6 +2608,16 @@ func (in *ScopeStatus) DeepCopyInto(out *ScopeStatus) {
 		copy(*out, *in)
 	}
 	in.ExposedData.DeepCopyInto(&out.ExposedData)
+	if in.EstimatedMonthlyCost != nil {
+		in, out := &in.EstimatedMonthlyCost, &out.EstimatedMonthlyCost
+		*out = new(CostEstimate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(RegionCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScopeStatus.
@@ -2762,6 +3290,11 @@ func (in *VpcPeeringStatus) DeepCopyInto(out *VpcPeeringStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EstimatedMonthlyCost != nil {
+		in, out := &in.EstimatedMonthlyCost, &out.EstimatedMonthlyCost
+		*out = new(CostEstimate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VpcPeeringStatus.
@@ -41,6 +41,7 @@ const (
 )
 
 // AwsNfsVolumeSpec defines the desired state of AwsNfsVolume
+// +kubebuilder:validation:XValidation:rule=(!has(self.sourceBackup) || !has(self.sourceBackupUrl)), message="Only one of sourceBackup or sourceBackupUrl can be specified."
 type AwsNfsVolumeSpec struct {
 
 	// +optional
@@ -58,6 +59,19 @@ type AwsNfsVolumeSpec struct {
 	PersistentVolume *AwsNfsVolumePvSpec `json:"volume,omitempty"`
 
 	PersistentVolumeClaim *AwsNfsVolumePvcSpec `json:"volumeClaim,omitempty"`
+
+	// SourceBackup specifies the AwsNfsVolumeBackup the new volume is restored from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackup is immutable."
+	SourceBackup *BackupRef `json:"sourceBackup,omitempty"`
+
+	// SourceBackupUrl specifies the recovery point the new volume is restored from in the
+	// {backupVaultName}/{recoveryPointId} format, as listed by AwsNfsVolumeBackupDiscovery.
+	// The recovery point can belong to the backup vault of another runtime.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackupUrl is immutable."
+	// +kubebuilder:validation:Pattern=`^(.+)/(.+)$`
+	SourceBackupUrl string `json:"sourceBackupUrl,omitempty"`
 }
 
 type AwsNfsVolumePvSpec struct {
@@ -37,6 +37,15 @@ type AwsNfsVolumeBackupSpec struct {
 	// AWS Region Code (as specified in https://docs.aws.amazon.com/global-infrastructure/latest/regions/aws-regions.html#available-regions) where this backup should be created.
 	// If not specified, region of the AwsNfsVolume is used for the backup.
 	Location string `json:"location"`
+
+	// AccessibleFrom is an array of shootNames or subaccountIds that would have access to the backup for restore.
+	// "all" is also accepted as a value to allow access from all shoots in the same global account and AWS account. "all" cannot be used in combination with other values.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:XValidation:rule="(self.all(x, x == 'all') || self.all(x, x != 'all'))", message="The value 'all' cannot be combined with other values."
+	AccessibleFrom []string `json:"accessibleFrom,omitempty"`
 }
 
 type AwsNfsVolumeBackupSource struct {
@@ -125,6 +134,10 @@ type AwsNfsVolumeBackupStatus struct {
 	// AWS locations where the backups are created.
 	// +optional
 	Locations []string `json:"locations"`
+
+	// Comma separated list that reflects the AccessibleFrom field in spec upon the last successful reconciliation
+	// +optional
+	AccessibleFrom string `json:"accessibleFrom,omitempty"`
 }
 
 // +kubebuilder:object:root=true
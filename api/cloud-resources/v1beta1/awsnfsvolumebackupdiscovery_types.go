@@ -0,0 +1,121 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AwsNfsVolumeBackupDiscoverySpec defines the desired state of AwsNfsVolumeBackupDiscovery
+type AwsNfsVolumeBackupDiscoverySpec struct {
+}
+
+// AwsNfsVolumeBackupDiscoveryStatus defines the observed state of AwsNfsVolumeBackupDiscovery
+type AwsNfsVolumeBackupDiscoveryStatus struct {
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	DiscoverySnapshotTime *metav1.Time `json:"discoverySnapshotTime,omitempty"`
+
+	// +optional
+	AvailableBackupsCount *int `json:"availableBackupsCount,omitempty"`
+
+	// {backupVaultName}/{recoveryPointId} references of the discovered AWS Recovery Points
+	// that can be used as AwsNfsVolume.Spec.SourceBackupUrl
+	// +optional
+	AvailableBackupUris []string `json:"availableBackupUris,omitempty"`
+
+	// +optional
+	// Detailed information about available backups
+	AvailableBackups []AvailableBackup `json:"availableBackups,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="AvailableBackupsCount",type="integer",JSONPath=".status.availableBackupsCount"
+// +kubebuilder:printcolumn:name="DiscoverySnapshotTime",type="date",JSONPath=".status.discoverySnapshotTime"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AwsNfsVolumeBackupDiscovery is the Schema for the awsnfsvolumebackupdiscoveries API
+type AwsNfsVolumeBackupDiscovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AwsNfsVolumeBackupDiscoverySpec   `json:"spec,omitempty"`
+	Status AwsNfsVolumeBackupDiscoveryStatus `json:"status,omitempty"`
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) State() string {
+	return in.Status.State
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureNfsBackup
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) SpecificToProviders() []string {
+	return []string{"aws"}
+}
+
+//+kubebuilder:object:root=true
+
+// AwsNfsVolumeBackupDiscoveryList contains a list of AwsNfsVolumeBackupDiscovery
+type AwsNfsVolumeBackupDiscoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AwsNfsVolumeBackupDiscovery `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AwsNfsVolumeBackupDiscovery{}, &AwsNfsVolumeBackupDiscoveryList{})
+}
+
+func (in *AwsNfsVolumeBackupDiscovery) CloneForPatchStatus() client.Object {
+	return &AwsNfsVolumeBackupDiscovery{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AwsNfsVolumeBackupDiscovery",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:validation:Enum=S1;S2;S3;S4;P1;P2;P3;P4
+type AwsPostgresTier string
+
+const (
+	AwsPostgresTierS1 AwsPostgresTier = "S1"
+	AwsPostgresTierS2 AwsPostgresTier = "S2"
+	AwsPostgresTierS3 AwsPostgresTier = "S3"
+	AwsPostgresTierS4 AwsPostgresTier = "S4"
+
+	AwsPostgresTierP1 AwsPostgresTier = "P1"
+	AwsPostgresTierP2 AwsPostgresTier = "P2"
+	AwsPostgresTierP3 AwsPostgresTier = "P3"
+	AwsPostgresTierP4 AwsPostgresTier = "P4"
+)
+
+// AwsPostgresInstanceSpec defines the desired state of AwsPostgresInstance
+type AwsPostgresInstanceSpec struct {
+	// +optional
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// +optional
+	AuthSecret *PostgresAuthSecretSpec `json:"authSecret,omitempty"`
+
+	// Defines Service Tier and Capacity Tier. PostgresTiers starting with 'S' are Standard service tier running in a single availability zone. PostgresTiers starting with 'P' are premium service tier running in multiple availability zones. Number next to service tier represents capacity tier.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self.startsWith('S') && oldSelf.startsWith('S') || self.startsWith('P') && oldSelf.startsWith('P')), message="Service tier cannot be changed within postgresTier. Only capacity tier can be changed."
+	PostgresTier AwsPostgresTier `json:"postgresTier"`
+
+	// +optional
+	// +kubebuilder:default="16"
+	// +kubebuilder:validation:Enum="17";"16";"15"
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="engineVersion cannot be downgraded."
+	EngineVersion string `json:"engineVersion"`
+
+	// Allocated storage in GiB.
+	// +optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=20
+	// +kubebuilder:validation:Maximum=65536
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="storageGb cannot be decreased."
+	StorageGb int32 `json:"storageGb"`
+
+	// Name of the database created together with the instance.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="DatabaseName is immutable."
+	DatabaseName string `json:"databaseName"`
+
+	// Specifies the weekly time range during which maintenance on the instance is
+	// performed. It is specified as a range in the format ddd:hh24:mi-ddd:hh24:mi (24H
+	// Clock UTC). The minimum maintenance window is a 30 minute period.
+	//
+	// Valid values for ddd are: sun mon tue wed thu fri sat
+	//
+	// Example: sun:23:00-mon:01:30
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+}
+
+// AwsPostgresInstanceStatus defines the observed state of AwsPostgresInstance
+type AwsPostgresInstanceStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AwsPostgresInstance is the Schema for the awspostgresinstances API
+type AwsPostgresInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AwsPostgresInstanceSpec   `json:"spec,omitempty"`
+	Status AwsPostgresInstanceStatus `json:"status,omitempty"`
+}
+
+func (in *AwsPostgresInstance) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AwsPostgresInstance) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AwsPostgresInstance) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeaturePostgres
+}
+
+func (in *AwsPostgresInstance) SpecificToProviders() []string {
+	return []string{"aws"}
+}
+
+func (in *AwsPostgresInstance) GetIpRangeRef() IpRangeRef {
+	return in.Spec.IpRange
+}
+
+func (in *AwsPostgresInstance) State() string {
+	return in.Status.State
+}
+
+func (in *AwsPostgresInstance) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AwsPostgresInstance) CloneForPatchStatus() client.Object {
+	return &AwsPostgresInstance{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AwsPostgresInstance",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// AwsPostgresInstanceList contains a list of AwsPostgresInstance
+type AwsPostgresInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AwsPostgresInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AwsPostgresInstance{}, &AwsPostgresInstanceList{})
+}
@@ -44,6 +44,7 @@ const (
 )
 
 // AwsRedisInstanceSpec defines the desired state of AwsRedisInstance
+// +kubebuilder:validation:XValidation:rule=(!has(self.engineVersion) || self.redisTier in ['S1', 'S2'] || !(self.engineVersion in ['4.0.10', '5.0.6', '6.0'])), message="redisTier other than S1 and S2 requires engineVersion 6.2 or newer."
 type AwsRedisInstanceSpec struct {
 	// +optional
 	IpRange IpRangeRef `json:"ipRange"`
@@ -84,6 +85,16 @@ type AwsRedisInstanceSpec struct {
 
 	// +optional
 	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// DeferTierChange specifies if a change of redisTier is applied in the next preferredMaintenanceWindow
+	// instead of immediately.
+	// +optional
+	DeferTierChange bool `json:"deferTierChange,omitempty"`
+
+	// SourceBackup specifies the redis instance backup the new instance is seeded from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackup is immutable."
+	SourceBackup *RedisInstanceBackupRef `json:"sourceBackup,omitempty"`
 }
 
 // AwsRedisInstanceStatus defines the observed state of AwsRedisInstance
@@ -99,6 +110,10 @@ type AwsRedisInstanceStatus struct {
 
 	// +optional
 	State string `json:"state,omitempty"`
+
+	// The redisTier the instance is being scaled to, set while the change is in progress or deferred.
+	// +optional
+	PendingRedisTier AwsRedisTier `json:"pendingRedisTier,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,114 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AwsRedisInstanceBackupSpec defines the one time backup of the AwsRedisInstance data.
+type AwsRedisInstanceBackupSpec struct {
+	// Source specifies the AwsRedisInstance that a backup has to be made of.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="source is immutable."
+	Source RedisInstanceBackupSource `json:"source"`
+}
+
+// AwsRedisInstanceBackupStatus defines the observed state of AwsRedisInstanceBackup
+type AwsRedisInstanceBackupStatus struct {
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Name of the ElastiCache snapshot
+	// +optional
+	Id string `json:"id,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="Redis Instance",type="string",JSONPath=".spec.source.redisInstance.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AwsRedisInstanceBackup is the Schema for the awsredisinstancebackups API
+type AwsRedisInstanceBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AwsRedisInstanceBackupSpec   `json:"spec,omitempty"`
+	Status AwsRedisInstanceBackupStatus `json:"status,omitempty"`
+}
+
+func (in *AwsRedisInstanceBackup) State() string {
+	return in.Status.State
+}
+
+func (in *AwsRedisInstanceBackup) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AwsRedisInstanceBackup) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AwsRedisInstanceBackup) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AwsRedisInstanceBackup) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureRedis
+}
+
+func (in *AwsRedisInstanceBackup) SpecificToProviders() []string {
+	return []string{"aws"}
+}
+
+//+kubebuilder:object:root=true
+
+// AwsRedisInstanceBackupList contains a list of AwsRedisInstanceBackup
+type AwsRedisInstanceBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AwsRedisInstanceBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AwsRedisInstanceBackup{}, &AwsRedisInstanceBackupList{})
+}
+
+func (in *AwsRedisInstanceBackup) CloneForPatchStatus() client.Object {
+	return &AwsRedisInstanceBackup{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AwsRedisInstanceBackup",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
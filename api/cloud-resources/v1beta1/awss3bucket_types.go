@@ -0,0 +1,128 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AwsS3BucketSpec defines the desired state of AwsS3Bucket
+// +kubebuilder:validation:XValidation:rule=(!has(self.retentionDays) || self.retentionDays == 0 || self.versioning), message="retentionDays requires versioning."
+// +kubebuilder:validation:XValidation:rule="self.versioning || !has(self.lifecycle) || self.lifecycle.all(r, !has(r.noncurrentVersionExpirationDays))", message="noncurrentVersionExpirationDays requires versioning."
+type AwsS3BucketSpec struct {
+	// +optional
+	AuthSecret *BucketAuthSecretSpec `json:"authSecret,omitempty"`
+
+	// When enabled, previous versions of overwritten and deleted objects are kept.
+	// +optional
+	// +kubebuilder:default=false
+	Versioning bool `json:"versioning"`
+
+	// Rules deleting objects after the given number of days.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Lifecycle []BucketLifecycleRule `json:"lifecycle,omitempty"`
+
+	// Default retention period in days for new objects. Objects can not be deleted or overwritten by workloads
+	// during the retention period. Once retention is set, versioning can not be disabled any more.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=36500
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// AwsS3BucketStatus defines the observed state of AwsS3Bucket
+type AwsS3BucketStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AwsS3Bucket is the Schema for the awss3buckets API
+type AwsS3Bucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AwsS3BucketSpec   `json:"spec,omitempty"`
+	Status AwsS3BucketStatus `json:"status,omitempty"`
+}
+
+func (in *AwsS3Bucket) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AwsS3Bucket) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AwsS3Bucket) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureBucket
+}
+
+func (in *AwsS3Bucket) SpecificToProviders() []string {
+	return []string{"aws"}
+}
+
+func (in *AwsS3Bucket) State() string {
+	return in.Status.State
+}
+
+func (in *AwsS3Bucket) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AwsS3Bucket) CloneForPatchStatus() client.Object {
+	return &AwsS3Bucket{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AwsS3Bucket",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// AwsS3BucketList contains a list of AwsS3Bucket
+type AwsS3BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AwsS3Bucket `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AwsS3Bucket{}, &AwsS3BucketList{})
+}
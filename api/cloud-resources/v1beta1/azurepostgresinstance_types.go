@@ -0,0 +1,155 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:validation:Enum=S1;S2;S3;S4;P1;P2;P3;P4
+type AzurePostgresTier string
+
+const (
+	AzurePostgresTierS1 AzurePostgresTier = "S1"
+	AzurePostgresTierS2 AzurePostgresTier = "S2"
+	AzurePostgresTierS3 AzurePostgresTier = "S3"
+	AzurePostgresTierS4 AzurePostgresTier = "S4"
+
+	AzurePostgresTierP1 AzurePostgresTier = "P1"
+	AzurePostgresTierP2 AzurePostgresTier = "P2"
+	AzurePostgresTierP3 AzurePostgresTier = "P3"
+	AzurePostgresTierP4 AzurePostgresTier = "P4"
+)
+
+// AzurePostgresInstanceSpec defines the desired state of AzurePostgresInstance
+type AzurePostgresInstanceSpec struct {
+	// +optional
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// +optional
+	AuthSecret *PostgresAuthSecretSpec `json:"authSecret,omitempty"`
+
+	// Defines Service Tier and Capacity Tier. PostgresTiers starting with 'S' are Standard service tier running in a single zone. PostgresTiers starting with 'P' are premium service tier with a zone redundant standby server. Number next to service tier represents capacity tier.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self.startsWith('S') && oldSelf.startsWith('S') || self.startsWith('P') && oldSelf.startsWith('P')), message="Service tier cannot be changed within postgresTier. Only capacity tier can be changed."
+	PostgresTier AzurePostgresTier `json:"postgresTier"`
+
+	// +optional
+	// +kubebuilder:default="16"
+	// +kubebuilder:validation:Enum="17";"16";"15"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="postgresVersion is immutable."
+	PostgresVersion string `json:"postgresVersion"`
+
+	// Storage size in GiB. Azure flexible server supports only the listed storage sizes.
+	// +optional
+	// +kubebuilder:default=32
+	// +kubebuilder:validation:Enum=32;64;128;256;512;1024;2048;4096;8192;16384;32767
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="storageGb cannot be decreased."
+	StorageGb int32 `json:"storageGb"`
+
+	// Name of the database created together with the instance.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="DatabaseName is immutable."
+	DatabaseName string `json:"databaseName"`
+}
+
+// AzurePostgresInstanceStatus defines the observed state of AzurePostgresInstance
+type AzurePostgresInstanceStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AzurePostgresInstance is the Schema for the azurepostgresinstances API
+type AzurePostgresInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzurePostgresInstanceSpec   `json:"spec,omitempty"`
+	Status AzurePostgresInstanceStatus `json:"status,omitempty"`
+}
+
+func (in *AzurePostgresInstance) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AzurePostgresInstance) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AzurePostgresInstance) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeaturePostgres
+}
+
+func (in *AzurePostgresInstance) SpecificToProviders() []string {
+	return []string{"azure"}
+}
+
+func (in *AzurePostgresInstance) GetIpRangeRef() IpRangeRef {
+	return in.Spec.IpRange
+}
+
+func (in *AzurePostgresInstance) State() string {
+	return in.Status.State
+}
+
+func (in *AzurePostgresInstance) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AzurePostgresInstance) CloneForPatchStatus() client.Object {
+	return &AzurePostgresInstance{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AzurePostgresInstance",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// AzurePostgresInstanceList contains a list of AzurePostgresInstance
+type AzurePostgresInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzurePostgresInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzurePostgresInstance{}, &AzurePostgresInstanceList{})
+}
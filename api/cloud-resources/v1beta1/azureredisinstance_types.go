@@ -53,6 +53,7 @@ type RedisInstanceAzureConfigs struct {
 // AzureRedisInstanceSpec defines the desired state of AzureRedisInstance
 type AzureRedisInstanceSpec struct {
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self.startsWith('S') && oldSelf.startsWith('S') || self.startsWith('P') && oldSelf.startsWith('P')), message="Service tier cannot be changed within redisTier. Only capacity tier can be changed."
 	RedisTier AzureRedisTier `json:"redisTier"`
 
 	// +optional
@@ -69,6 +70,11 @@ type AzureRedisInstanceSpec struct {
 
 	// +optional
 	IpRange IpRangeRef `json:"ipRange"`
+
+	// SourceBackup specifies the redis instance backup the new instance is seeded from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackup is immutable."
+	SourceBackup *RedisInstanceBackupRef `json:"sourceBackup,omitempty"`
 }
 
 // AzureRedisInstanceStatus defines the observed state of AzureRedisInstance
@@ -85,6 +91,10 @@ type AzureRedisInstanceStatus struct {
 
 	// +optional
 	State string `json:"state,omitempty"`
+
+	// The redisTier the instance is being scaled to, set while the change is in progress or deferred.
+	// +optional
+	PendingRedisTier AzureRedisTier `json:"pendingRedisTier,omitempty"`
 }
 
 // +kubebuilder:object:root=true
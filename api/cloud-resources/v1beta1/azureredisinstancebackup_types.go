@@ -0,0 +1,118 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AzureRedisInstanceBackupSpec defines the one time backup of the AzureRedisInstance data.
+type AzureRedisInstanceBackupSpec struct {
+	// Source specifies the AzureRedisInstance that a backup has to be made of.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="source is immutable."
+	Source RedisInstanceBackupSource `json:"source"`
+}
+
+// AzureRedisInstanceBackupStatus defines the observed state of AzureRedisInstanceBackup
+type AzureRedisInstanceBackupStatus struct {
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// URL of the blob container the RDB snapshot is exported to
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// Resume token of the Azure Cache export operation
+	// +optional
+	OpIdentifier string `json:"opIdentifier,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="Redis Instance",type="string",JSONPath=".spec.source.redisInstance.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// AzureRedisInstanceBackup is the Schema for the azureredisinstancebackups API
+type AzureRedisInstanceBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureRedisInstanceBackupSpec   `json:"spec,omitempty"`
+	Status AzureRedisInstanceBackupStatus `json:"status,omitempty"`
+}
+
+func (in *AzureRedisInstanceBackup) State() string {
+	return in.Status.State
+}
+
+func (in *AzureRedisInstanceBackup) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *AzureRedisInstanceBackup) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *AzureRedisInstanceBackup) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *AzureRedisInstanceBackup) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureRedis
+}
+
+func (in *AzureRedisInstanceBackup) SpecificToProviders() []string {
+	return []string{"azure"}
+}
+
+//+kubebuilder:object:root=true
+
+// AzureRedisInstanceBackupList contains a list of AzureRedisInstanceBackup
+type AzureRedisInstanceBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureRedisInstanceBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AzureRedisInstanceBackup{}, &AzureRedisInstanceBackupList{})
+}
+
+func (in *AzureRedisInstanceBackup) CloneForPatchStatus() client.Object {
+	return &AzureRedisInstanceBackup{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "AzureRedisInstanceBackup",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
@@ -37,6 +37,13 @@ type AzureRwxBackupScheduleSpec struct {
 	// +optional
 	Location string `json:"location"`
 
+	// CopyTo is a list of Azure regions where copies of each created backup are kept.
+	// The only supported region is the Azure paired region of Location.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=1
+	CopyTo []string `json:"copyTo,omitempty"`
+
 	// Cron expression of the schedule, e.g. "0 0 * * *" for daily at midnight
 	// If not provided, backup will be taken once on the specified start time.
 	// +optional
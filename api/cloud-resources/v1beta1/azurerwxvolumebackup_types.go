@@ -74,6 +74,15 @@ type AzureRwxVolumeBackupSpec struct {
 	Source PvcSource `json:"source"`
 
 	Location string `json:"location"`
+
+	// CopyTo is a list of Azure regions where copies of the backup are kept, e.g. for disaster recovery in another region.
+	// Copies are made by the geo-redundant Recovery Services vault, so the only supported region is
+	// the Azure paired region of Location.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="CopyTo is immutable."
+	CopyTo []string `json:"copyTo,omitempty"`
 }
 
 // AzureRwxVolumeBackupStatus defines the observed state of AzureRwxVolumeBackup
@@ -100,6 +109,16 @@ type AzureRwxVolumeBackupStatus struct {
 	// StorageAccountPath specifies the Azure Storage Account path
 	// +optional
 	StorageAccountPath string `json:"storageAccountPath,omitempty"`
+
+	// ProtectedItemPath specifies the Azure protected file share the backup was taken from
+	// +optional
+	ProtectedItemPath string `json:"protectedItemPath,omitempty"`
+
+	// Copies tracks the copies of the backup in the regions listed in CopyTo.
+	// +optional
+	// +listType=map
+	// +listMapKey=location
+	Copies []BackupCopyStatus `json:"copies,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +50,34 @@ type AzureRwxVolumeRestoreSource struct {
 	Backup AzureRwxVolumeBackupRef `json:"backup"`
 }
 
+// AzureRwxVolumeRestoreDestination specifies where the backup is restored to.
+// Exactly one of Pvc or NewVolume must be set.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type AzureRwxVolumeRestoreDestination struct {
+	// Pvc references an existing PVC. The backup is restored into the RestoredDir directory of its volume.
+	// +optional
+	Pvc *PvcRef `json:"pvc,omitempty"`
+
+	// NewVolume restores the backup into a new Azure file share and creates a matching PV and PVC for it.
+	// +optional
+	NewVolume *AzureRwxVolumeRestoreNewVolume `json:"newVolume,omitempty"`
+}
+
+type AzureRwxVolumeRestoreNewVolume struct {
+	// Pvc specifies the PersistentVolumeClaim created for the restored file share
+	// in the namespace of the AzureRwxVolumeRestore.
+	// +kubebuilder:validation:Required
+	Pvc AzureRwxVolumeRestorePvcSpec `json:"pvc"`
+}
+
+type AzureRwxVolumeRestorePvcSpec struct {
+	// +kubebuilder:validation:Required
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 // AzureRwxVolumeRestoreSpec defines the desired state of AzureRwxVolumeRestore
 type AzureRwxVolumeRestoreSpec struct {
 	// +kubebuilder:validation:Required
@@ -57,7 +86,7 @@ type AzureRwxVolumeRestoreSpec struct {
 
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Destination is immutable."
-	Destination PvcSource `json:"destination"`
+	Destination AzureRwxVolumeRestoreDestination `json:"destination"`
 }
 
 // AzureRwxVolumeRestoreStatus defines the observed state of AzureRwxVolumeRestore
@@ -83,14 +112,35 @@ type AzureRwxVolumeRestoreStatus struct {
 	// Operation Identifier to track the Hyperscaler Restore Operation
 	// +optional
 	OpIdentifier string `json:"opIdentifier,omitempty"`
+
+	// Progress of the restore operation.
+	// +kubebuilder:validation:Enum=Restoring;CreatingVolume;Completed
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
+	// The Azure file share the backup is restored into (new volume restore only).
+	// +optional
+	FileShareName string `json:"fileShareName,omitempty"`
+
+	// CreatedPvc references the PersistentVolumeClaim created for the restored file share (new volume restore only).
+	// +optional
+	CreatedPvc *corev1.ObjectReference `json:"createdPvc,omitempty"`
 }
 
+const (
+	AzureRwxVolumeRestoreProgressRestoring      = "Restoring"
+	AzureRwxVolumeRestoreProgressCreatingVolume = "CreatingVolume"
+	AzureRwxVolumeRestoreProgressCompleted      = "Completed"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:categories={kyma-cloud-manager}
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.source.backup.name"
 // +kubebuilder:printcolumn:name="Destination",type="string",JSONPath=".spec.destination.pvc.name"
+// +kubebuilder:printcolumn:name="Created PVC",type="string",JSONPath=".status.createdPvc.name"
+// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=".status.progress"
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
 
 // AzureRwxVolumeRestore is the Schema for the azurerwxvolumerestores API
@@ -170,4 +220,5 @@ const (
 	ConditionReasonRestoreJobInvalidStatus         = "RestoreJobInvalidStatus"
 	ConditionReasonRestoreJobCompletedWithWarnings = "RestoreJobCompletedWithWarnings"
 	ConditionReasonErrorStartingRestore            = "ErrorStartingRestore"
+	ConditionReasonPvcAlreadyExists                = "PvcAlreadyExists"
 )
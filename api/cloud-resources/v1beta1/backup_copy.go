@@ -0,0 +1,62 @@
+package v1beta1
+
+// BackupCopyStatus tracks a copy of a backup kept in another region.
+type BackupCopyStatus struct {
+	// Location is the region where the copy is stored.
+	// +kubebuilder:validation:Required
+	Location string `json:"location"`
+
+	// State of the copy, one of Creating, Ready, Error or Deleting.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Id of the copy on the cloud provider.
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// Message describes why the copy is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FindBackupCopy returns the copy status of the given location, or nil if there is none.
+func FindBackupCopy(copies []BackupCopyStatus, location string) *BackupCopyStatus {
+	for i := range copies {
+		if copies[i].Location == location {
+			return &copies[i]
+		}
+	}
+	return nil
+}
+
+// SetBackupCopy adds or replaces the copy status of its location and returns the resulting slice.
+func SetBackupCopy(copies []BackupCopyStatus, c BackupCopyStatus) []BackupCopyStatus {
+	if existing := FindBackupCopy(copies, c.Location); existing != nil {
+		*existing = c
+		return copies
+	}
+	return append(copies, c)
+}
+
+// AllBackupCopiesReady returns true if every location in copyTo has a copy in Ready state.
+func AllBackupCopiesReady(copyTo []string, copies []BackupCopyStatus) bool {
+	for _, location := range copyTo {
+		c := FindBackupCopy(copies, location)
+		if c == nil || c.State != StateReady {
+			return false
+		}
+	}
+	return true
+}
+
+// AllBackupCopiesDone returns true if every location in copyTo has a copy that is either Ready
+// or has finally failed in Error state.
+func AllBackupCopiesDone(copyTo []string, copies []BackupCopyStatus) bool {
+	for _, location := range copyTo {
+		c := FindBackupCopy(copies, location)
+		if c == nil || (c.State != StateReady && c.State != StateError) {
+			return false
+		}
+	}
+	return true
+}
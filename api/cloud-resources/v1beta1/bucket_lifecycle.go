@@ -0,0 +1,18 @@
+package v1beta1
+
+// +kubebuilder:validation:XValidation:rule=(has(self.expirationDays) || has(self.noncurrentVersionExpirationDays)), message="At least one of expirationDays or noncurrentVersionExpirationDays must be set."
+type BucketLifecycleRule struct {
+	// Only objects with keys starting with the prefix are affected by the rule. Empty prefix matches all objects.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Current objects are deleted this many days after they were created.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ExpirationDays int32 `json:"expirationDays,omitempty"`
+
+	// Noncurrent object versions are deleted this many days after they became noncurrent. Requires versioning.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	NoncurrentVersionExpirationDays int32 `json:"noncurrentVersionExpirationDays,omitempty"`
+}
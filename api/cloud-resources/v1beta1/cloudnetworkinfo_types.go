@@ -0,0 +1,101 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudNetworkInfoName is the name of the single CloudNetworkInfo in the SKR
+const CloudNetworkInfoName = "default"
+
+// CloudNetworkInfoStatus defines the network facts of the cloud provider account of the Kyma runtime
+type CloudNetworkInfoStatus struct {
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+
+	// NetworkId is the provider identifier of the Kyma network: the VPC ID in AWS, the VNet resource ID
+	// in Azure, the network resource name in GCP and the network ID in OpenStack
+	// +optional
+	NetworkId string `json:"networkId,omitempty"`
+
+	// +optional
+	NodesCidr string `json:"nodesCidr,omitempty"`
+
+	// +optional
+	PodsCidr string `json:"podsCidr,omitempty"`
+
+	// +optional
+	ServicesCidr string `json:"servicesCidr,omitempty"`
+
+	// NatGatewayIps are the public IP addresses of the Kyma egress traffic
+	// +optional
+	NatGatewayIps []string `json:"natGatewayIps,omitempty"`
+
+	// IpRangeCidrs are the CIDRs of the IpRanges used by the Cloud Manager resources
+	// +optional
+	IpRangeCidrs []string `json:"ipRangeCidrs,omitempty"`
+
+	// +optional
+	ReadTime *metav1.Time `json:"readTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=cloudnetworkinfos,scope=Cluster,categories={kyma-cloud-manager}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".status.provider"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".status.region"
+// +kubebuilder:printcolumn:name="Network",type="string",JSONPath=".status.networkId"
+// +kubebuilder:printcolumn:name="Nodes",type="string",JSONPath=".status.nodesCidr"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// CloudNetworkInfo is the read-only Schema for the cloudnetworkinfos API. It is maintained by
+// the Cloud Manager and any change to it is overwritten on the next exposed data read.
+type CloudNetworkInfo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status CloudNetworkInfoStatus `json:"status,omitempty"`
+}
+
+func (in *CloudNetworkInfo) SpecificToFeature() featuretypes.FeatureName {
+	return ""
+}
+
+func (in *CloudNetworkInfo) SpecificToProviders() []string {
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// CloudNetworkInfoList contains a list of CloudNetworkInfo
+type CloudNetworkInfoList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudNetworkInfo `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudNetworkInfo{}, &CloudNetworkInfoList{})
+}
@@ -68,6 +68,31 @@ type CloudResourcesStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Quotas lists the usage of each quota defined for this SKR. It is also used as
+	// the admission policy parameter to reject objects that would exceed a quota.
+	// +optional
+	// +listType=atomic
+	Quotas []QuotaUsage `json:"quotas,omitempty"`
+}
+
+// QuotaUsage is the current usage of one quota in the SKR
+type QuotaUsage struct {
+	// Resource the quota applies to in the form `[lower(Kind)].[Group]`
+	Resource string `json:"resource"`
+
+	// Quota name, one of totalCount, totalCapacityGb, maxTier or backupsPerVolume
+	Quota string `json:"quota"`
+
+	// Subject the usage is counted for, the namespace/name of the source volume for the backupsPerVolume quota
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// Limit of the quota
+	Limit int64 `json:"limit"`
+
+	// Used is the current usage of the quota
+	Used int64 `json:"used"`
 }
 
 // +kubebuilder:object:root=true
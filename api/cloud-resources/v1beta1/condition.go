@@ -25,13 +25,28 @@ const (
 	ConditionReasonNfsVolumeBackupNotReady = "NfsVolumeBackupNotReady"
 	ConditionReasonNfsRestoreInProgress    = "NfsRestoreInProgress"
 	ConditionReasonNfsRestoreFailed        = "NfsRestoreFailed"
+	ConditionReasonMissingRedisInstance    = "MissingRedisInstance"
+	ConditionReasonRedisInstanceNotReady   = "RedisInstanceNotReady"
 	ConditionReasonError                   = "Error"
+
+	ConditionReasonMissingRedisInstanceBackup  = "MissingRedisInstanceBackup"
+	ConditionReasonRedisInstanceBackupNotReady = "RedisInstanceBackupNotReady"
+	ConditionReasonRedisRestoreFailed          = "RedisRestoreFailed"
+	ConditionReasonUnsupportedTierChange       = "UnsupportedTierChange"
+	ConditionReasonInvalidCopyLocation         = "InvalidCopyLocation"
+	ConditionReasonBackupCopyFailed            = "BackupCopyFailed"
+	ConditionReasonUnavailableInRegion         = "UnavailableInRegion"
+	ConditionReasonUnsupportedCapacityChange   = "UnsupportedCapacityChange"
 )
 
 const (
 	ConditionTypeUpdating = "Updating"
 )
 
+const (
+	ConditionTypeRestoring = "Restoring"
+)
+
 const (
 	ConditionTypeDeleting = "Deleting"
 
@@ -27,6 +27,7 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // GcpNfsBackupScheduleSpec defines the desired state of GcpNfsBackupSchedule
+// +kubebuilder:validation:XValidation:rule="!has(self.copyTo) || !has(self.location) || !(self.location in self.copyTo)", message="CopyTo must not contain Location."
 type GcpNfsBackupScheduleSpec struct {
 
 	// NfsVolumeRef specifies the SourceRef resource that a backup has to be made of.
@@ -38,6 +39,14 @@ type GcpNfsBackupScheduleSpec struct {
 	// +optional
 	Location string `json:"location"`
 
+	// CopyTo is a list of GCP regions where regional replica backups of each created backup are kept.
+	// Must not contain the location of the backups.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:items:Pattern=`^(africa-south1|asia-east1|asia-east2|asia-northeast1|asia-northeast2|asia-northeast3|asia-south1|asia-south2|asia-southeast1|asia-southeast2|asia-southeast3|australia-southeast1|australia-southeast2|europe-central2|europe-north1|europe-southwest1|europe-west1|europe-west10|europe-west12|europe-west2|europe-west3|europe-west4|europe-west6|europe-west8|europe-west9|me-central1|me-central2|me-west1|northamerica-northeast1|northamerica-northeast2|southamerica-east1|southamerica-west1|us-central1|us-east1|us-east4|us-east5|us-east7|us-south1|us-west1|us-west2|us-west3|us-west4|us-west8)$`
+	CopyTo []string `json:"copyTo,omitempty"`
+
 	// Cron expression of the schedule, e.g. "0 0 * * *" for daily at midnight
 	// If not provided, backup will be taken once on the specified start time.
 	// +optional
@@ -76,6 +76,7 @@ func (v *GcpNfsVolumeRef) ToNamespacedName(fallbackNamespace string) types.Names
 }
 
 // GcpNfsVolumeBackupSpec defines the desired state of GcpNfsVolumeBackup
+// +kubebuilder:validation:XValidation:rule="!has(self.copyTo) || !has(self.location) || !(self.location in self.copyTo)", message="CopyTo must not contain Location."
 type GcpNfsVolumeBackupSpec struct {
 
 	// +kubebuilder:validation:Required
@@ -97,6 +98,17 @@ type GcpNfsVolumeBackupSpec struct {
 	// +kubebuilder:validation:MaxItems=10
 	// +kubebuilder:validation:XValidation:rule="(self.all(x, x == 'all') || self.all(x, x != 'all'))", message="The value 'all' cannot be combined with other values."
 	AccessibleFrom []string `json:"accessibleFrom,omitempty"`
+
+	// CopyTo is a list of GCP regions where regional replica backups of the source GcpNfsVolume are kept, e.g. for disaster recovery.
+	// Filestore can not copy a backup to another region, so each replica is a new Filestore backup of the GcpNfsVolume taken
+	// in that region once this backup is ready. A replica is not created if the GcpNfsVolume no longer exists by then, and failed replicas are not retried.
+	// Replicas are deleted together with the backup. Must not contain the location of the backup.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="CopyTo is immutable."
+	// +kubebuilder:validation:items:Pattern=`^(africa-south1|asia-east1|asia-east2|asia-northeast1|asia-northeast2|asia-northeast3|asia-south1|asia-south2|asia-southeast1|asia-southeast2|asia-southeast3|australia-southeast1|australia-southeast2|europe-central2|europe-north1|europe-southwest1|europe-west1|europe-west10|europe-west12|europe-west2|europe-west3|europe-west4|europe-west6|europe-west8|europe-west9|me-central1|me-central2|me-west1|northamerica-northeast1|northamerica-northeast2|southamerica-east1|southamerica-west1|us-central1|us-east1|us-east4|us-east5|us-east7|us-south1|us-west1|us-west2|us-west3|us-west4|us-west8)$`
+	CopyTo []string `json:"copyTo,omitempty"`
 }
 
 // GcpNfsVolumeBackupStatus defines the observed state of GcpNfsVolumeBackup
@@ -132,6 +144,12 @@ type GcpNfsVolumeBackupStatus struct {
 
 	// +optional
 	FileStoreBackupLabels map[string]string `json:"fileStoreBackupLabels,omitempty"`
+
+	// Copies tracks the copies of the backup in the regions listed in CopyTo.
+	// +optional
+	// +listType=map
+	// +listMapKey=location
+	Copies []BackupCopyStatus `json:"copies,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,160 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:validation:Enum=S1;S2;S3;S4;P1;P2;P3;P4
+type GcpPostgresTier string
+
+const (
+	GcpPostgresTierS1 GcpPostgresTier = "S1"
+	GcpPostgresTierS2 GcpPostgresTier = "S2"
+	GcpPostgresTierS3 GcpPostgresTier = "S3"
+	GcpPostgresTierS4 GcpPostgresTier = "S4"
+
+	GcpPostgresTierP1 GcpPostgresTier = "P1"
+	GcpPostgresTierP2 GcpPostgresTier = "P2"
+	GcpPostgresTierP3 GcpPostgresTier = "P3"
+	GcpPostgresTierP4 GcpPostgresTier = "P4"
+)
+
+// GcpPostgresInstanceSpec defines the desired state of GcpPostgresInstance
+type GcpPostgresInstanceSpec struct {
+	// +optional
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// +optional
+	AuthSecret *PostgresAuthSecretSpec `json:"authSecret,omitempty"`
+
+	// Defines Service Tier and Capacity Tier. PostgresTiers starting with 'S' are Standard service tier running in a single zone. PostgresTiers starting with 'P' are premium service tier with a standby instance in another zone of the region. Number next to service tier represents capacity tier.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self.startsWith('S') && oldSelf.startsWith('S') || self.startsWith('P') && oldSelf.startsWith('P')), message="Service tier cannot be changed within postgresTier. Only capacity tier can be changed."
+	PostgresTier GcpPostgresTier `json:"postgresTier"`
+
+	// +optional
+	// +kubebuilder:default="16"
+	// +kubebuilder:validation:Enum="17";"16";"15"
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="postgresVersion cannot be downgraded."
+	PostgresVersion string `json:"postgresVersion"`
+
+	// Data disk size in GiB.
+	// +optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=65536
+	// +kubebuilder:validation:XValidation:rule=(self >= oldSelf), message="storageGb cannot be decreased."
+	StorageGb int32 `json:"storageGb"`
+
+	// Name of the database created together with the instance.
+	// +optional
+	// +kubebuilder:default="postgres"
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="DatabaseName is immutable."
+	DatabaseName string `json:"databaseName"`
+
+	// Defines a weekly maintenance window. Cloud SQL schedules maintenance by the hour, minutes of the start time are ignored.
+	// +optional
+	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+}
+
+// GcpPostgresInstanceStatus defines the observed state of GcpPostgresInstance
+type GcpPostgresInstanceStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// GcpPostgresInstance is the Schema for the gcppostgresinstances API
+type GcpPostgresInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GcpPostgresInstanceSpec   `json:"spec,omitempty"`
+	Status GcpPostgresInstanceStatus `json:"status,omitempty"`
+}
+
+func (in *GcpPostgresInstance) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *GcpPostgresInstance) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *GcpPostgresInstance) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeaturePostgres
+}
+
+func (in *GcpPostgresInstance) SpecificToProviders() []string {
+	return []string{"gcp"}
+}
+
+func (in *GcpPostgresInstance) GetIpRangeRef() IpRangeRef {
+	return in.Spec.IpRange
+}
+
+func (in *GcpPostgresInstance) State() string {
+	return in.Status.State
+}
+
+func (in *GcpPostgresInstance) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *GcpPostgresInstance) CloneForPatchStatus() client.Object {
+	return &GcpPostgresInstance{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "GcpPostgresInstance",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// GcpPostgresInstanceList contains a list of GcpPostgresInstance
+type GcpPostgresInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GcpPostgresInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GcpPostgresInstance{}, &GcpPostgresInstanceList{})
+}
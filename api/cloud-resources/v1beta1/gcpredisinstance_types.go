@@ -74,6 +74,7 @@ type MaintenancePolicy struct {
 }
 
 // GcpRedisInstanceSpec defines the desired state of GcpRedisInstance
+// +kubebuilder:validation:XValidation:rule=(!has(self.redisVersion) || self.redisTier.startsWith('S') || !(self.redisVersion in ['REDIS_3_2', 'REDIS_4_0'])), message="Premium redisTier requires redisVersion REDIS_5_0 or newer."
 type GcpRedisInstanceSpec struct {
 
 	// +optional
@@ -110,6 +111,23 @@ type GcpRedisInstanceSpec struct {
 	// If not provided, maintenance events can be performed at any time.
 	// +optional
 	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+
+	// DeferTierChange specifies if a change of redisTier is applied in the next maintenancePolicy window
+	// instead of immediately. It has no effect if maintenancePolicy is not set.
+	// +optional
+	DeferTierChange bool `json:"deferTierChange,omitempty"`
+
+	// SourceBackup specifies the redis instance backup the new instance is seeded from.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackup is immutable."
+	SourceBackup *RedisInstanceBackupRef `json:"sourceBackup,omitempty"`
+
+	// SourceBackupUrl specifies the RDB file in a Cloud Storage bucket the new instance is seeded from,
+	// in the format gs://bucket/path/to/file.rdb. It is ignored if SourceBackup is specified.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="SourceBackupUrl is immutable."
+	// +kubebuilder:validation:Pattern=`^gs://(.+)/(.+)$`
+	SourceBackupUrl string `json:"sourceBackupUrl,omitempty"`
 }
 
 // GcpRedisInstanceStatus defines the observed state of GcpRedisInstance
@@ -126,6 +144,10 @@ type GcpRedisInstanceStatus struct {
 
 	// +optional
 	State string `json:"state,omitempty"`
+
+	// The redisTier the instance is being scaled to, set while the change is in progress or deferred.
+	// +optional
+	PendingRedisTier GcpRedisTier `json:"pendingRedisTier,omitempty"`
 }
 
 // +kubebuilder:object:root=true
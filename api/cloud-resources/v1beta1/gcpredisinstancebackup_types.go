@@ -0,0 +1,125 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GcpRedisInstanceBackupSpec defines the one time backup of the GcpRedisInstance data.
+type GcpRedisInstanceBackupSpec struct {
+	// Source specifies the GcpRedisInstance that a backup has to be made of.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="source is immutable."
+	Source RedisInstanceBackupSource `json:"source"`
+
+	// Bucket specifies the name of the GCS bucket the RDB snapshot is exported to.
+	// The Memorystore service agent of the project must be allowed to create objects in the bucket.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="bucket is immutable."
+	Bucket string `json:"bucket"`
+}
+
+// GcpRedisInstanceBackupStatus defines the observed state of GcpRedisInstanceBackup
+type GcpRedisInstanceBackupStatus struct {
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// URI of the exported RDB snapshot object
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// Operation Identifier to track the Memorystore export operation
+	// +optional
+	OpIdentifier string `json:"opIdentifier,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="Redis Instance",type="string",JSONPath=".spec.source.redisInstance.name"
+// +kubebuilder:printcolumn:name="Bucket",type="string",JSONPath=".spec.bucket"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// GcpRedisInstanceBackup is the Schema for the gcpredisinstancebackups API
+type GcpRedisInstanceBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GcpRedisInstanceBackupSpec   `json:"spec,omitempty"`
+	Status GcpRedisInstanceBackupStatus `json:"status,omitempty"`
+}
+
+func (in *GcpRedisInstanceBackup) State() string {
+	return in.Status.State
+}
+
+func (in *GcpRedisInstanceBackup) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *GcpRedisInstanceBackup) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *GcpRedisInstanceBackup) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *GcpRedisInstanceBackup) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureRedis
+}
+
+func (in *GcpRedisInstanceBackup) SpecificToProviders() []string {
+	return []string{"gcp"}
+}
+
+//+kubebuilder:object:root=true
+
+// GcpRedisInstanceBackupList contains a list of GcpRedisInstanceBackup
+type GcpRedisInstanceBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GcpRedisInstanceBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GcpRedisInstanceBackup{}, &GcpRedisInstanceBackupList{})
+}
+
+func (in *GcpRedisInstanceBackup) CloneForPatchStatus() client.Object {
+	return &GcpRedisInstanceBackup{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "GcpRedisInstanceBackup",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
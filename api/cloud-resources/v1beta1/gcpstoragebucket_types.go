@@ -0,0 +1,127 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GcpStorageBucketSpec defines the desired state of GcpStorageBucket
+// +kubebuilder:validation:XValidation:rule=(!has(self.retentionDays) || self.retentionDays == 0 || !self.versioning), message="versioning and retentionDays can not be used together."
+// +kubebuilder:validation:XValidation:rule="self.versioning || !has(self.lifecycle) || self.lifecycle.all(r, !has(r.noncurrentVersionExpirationDays))", message="noncurrentVersionExpirationDays requires versioning."
+type GcpStorageBucketSpec struct {
+	// +optional
+	AuthSecret *BucketAuthSecretSpec `json:"authSecret,omitempty"`
+
+	// When enabled, previous versions of overwritten and deleted objects are kept.
+	// +optional
+	// +kubebuilder:default=false
+	Versioning bool `json:"versioning"`
+
+	// Rules deleting objects after the given number of days.
+	// +optional
+	// +kubebuilder:validation:MaxItems=50
+	Lifecycle []BucketLifecycleRule `json:"lifecycle,omitempty"`
+
+	// Retention period in days during which objects can not be deleted or replaced by workloads.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=36500
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// GcpStorageBucketStatus defines the observed state of GcpStorageBucket
+type GcpStorageBucketStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// GcpStorageBucket is the Schema for the gcpstoragebuckets API
+type GcpStorageBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GcpStorageBucketSpec   `json:"spec,omitempty"`
+	Status GcpStorageBucketStatus `json:"status,omitempty"`
+}
+
+func (in *GcpStorageBucket) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *GcpStorageBucket) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *GcpStorageBucket) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureBucket
+}
+
+func (in *GcpStorageBucket) SpecificToProviders() []string {
+	return []string{"gcp"}
+}
+
+func (in *GcpStorageBucket) State() string {
+	return in.Status.State
+}
+
+func (in *GcpStorageBucket) SetState(v string) {
+	in.Status.State = v
+}
+
+func (in *GcpStorageBucket) CloneForPatchStatus() client.Object {
+	return &GcpStorageBucket{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "GcpStorageBucket",
+			APIVersion: GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: in.Namespace,
+			Name:      in.Name,
+		},
+		Status: in.Status,
+	}
+}
+
+//+kubebuilder:object:root=true
+
+// GcpStorageBucketList contains a list of GcpStorageBucket
+type GcpStorageBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GcpStorageBucket `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GcpStorageBucket{}, &GcpStorageBucketList{})
+}
@@ -13,6 +13,12 @@ const (
 	LabelRedisClusterStatusId  = "cloud-resources.kyma-project.io/redisClusterStatusId"
 	LabelRedisClusterNamespace = "cloud-resources.kyma-project.io/redisClusterNamespace"
 
+	LabelPostgresInstanceStatusId  = "cloud-resources.kyma-project.io/postgresInstanceStatusId"
+	LabelPostgresInstanceNamespace = "cloud-resources.kyma-project.io/postgresInstanceNamespace"
+
+	LabelBucketStatusId  = "cloud-resources.kyma-project.io/bucketStatusId"
+	LabelBucketNamespace = "cloud-resources.kyma-project.io/bucketNamespace"
+
 	LabelScheduleName      = "cloud-resources.kyma-project.io/scheduleName"
 	LabelScheduleNamespace = "cloud-resources.kyma-project.io/scheduleNamespace"
 )
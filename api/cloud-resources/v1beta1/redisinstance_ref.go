@@ -0,0 +1,55 @@
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type RedisInstanceBackupSource struct {
+	// RedisInstance specifies the redis instance resource that a backup has to be made of.
+	// +kubebuilder:validation:Required
+	RedisInstance RedisInstanceRef `json:"redisInstance"`
+}
+
+type RedisInstanceRef struct {
+	// Name specifies the name of the redis instance resource.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace specified the namespace of the redis instance resource.
+	// If not specified then namespace of the referencing resource is used.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+func (v RedisInstanceRef) ToNamespacedName(fallbackNamespace string) types.NamespacedName {
+	ns := v.Namespace
+	if len(ns) == 0 {
+		ns = fallbackNamespace
+	}
+	return types.NamespacedName{
+		Namespace: ns,
+		Name:      v.Name,
+	}
+}
+
+type RedisInstanceBackupRef struct {
+	// Name specifies the name of the redis instance backup resource.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace specified the namespace of the redis instance backup resource.
+	// If not specified then namespace of the referencing resource is used.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+func (v RedisInstanceBackupRef) ToNamespacedName(fallbackNamespace string) types.NamespacedName {
+	ns := v.Namespace
+	if len(ns) == 0 {
+		ns = fallbackNamespace
+	}
+	return types.NamespacedName{
+		Namespace: ns,
+		Name:      v.Name,
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=S1;S2;S3;S4
+type SapRedisTier string
+
+const (
+	SapRedisTierS1 SapRedisTier = "S1"
+	SapRedisTierS2 SapRedisTier = "S2"
+	SapRedisTierS3 SapRedisTier = "S3"
+	SapRedisTierS4 SapRedisTier = "S4"
+)
+
+// +kubebuilder:validation:Enum=valkey;redis
+type SapRedisEngine string
+
+const (
+	SapRedisEngineValkey SapRedisEngine = "valkey"
+	SapRedisEngineRedis  SapRedisEngine = "redis"
+)
+
+// SapRedisInstanceSpec defines the desired state of SapRedisInstance
+type SapRedisInstanceSpec struct {
+	// +optional
+	IpRange IpRangeRef `json:"ipRange"`
+
+	// +optional
+	AuthSecret *RedisAuthSecretSpec `json:"authSecret,omitempty"`
+
+	// Defines the capacity tier of the instance. Higher number represents higher memory and storage capacity.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RedisTier is immutable."
+	RedisTier SapRedisTier `json:"redisTier"`
+
+	// +optional
+	// +kubebuilder:default=valkey
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Engine is immutable."
+	Engine SapRedisEngine `json:"engine,omitempty"`
+
+	// EngineVersion specifies the version of the engine. If not set, the default version
+	// of the chosen engine is used.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="EngineVersion is immutable."
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="AuthEnabled is immutable."
+	AuthEnabled bool `json:"authEnabled"`
+}
+
+// SapRedisInstanceStatus defines the observed state of SapRedisInstance
+type SapRedisInstanceStatus struct {
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kyma-cloud-manager}
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// SapRedisInstance is the Schema for the sapredisinstances API
+type SapRedisInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SapRedisInstanceSpec   `json:"spec,omitempty"`
+	Status SapRedisInstanceStatus `json:"status,omitempty"`
+}
+
+func (in *SapRedisInstance) GetIpRangeRef() IpRangeRef {
+	return in.Spec.IpRange
+}
+
+func (in *SapRedisInstance) Conditions() *[]metav1.Condition {
+	return &in.Status.Conditions
+}
+
+func (in *SapRedisInstance) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+func (in *SapRedisInstance) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeatureRedis
+}
+
+func (in *SapRedisInstance) SpecificToProviders() []string {
+	return []string{"openstack"}
+}
+
+func (in *SapRedisInstance) State() string {
+	return in.Status.State
+}
+
+func (in *SapRedisInstance) SetState(v string) {
+	in.Status.State = v
+}
+
+//+kubebuilder:object:root=true
+
+// SapRedisInstanceList contains a list of SapRedisInstance
+type SapRedisInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SapRedisInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SapRedisInstance{}, &SapRedisInstanceList{})
+}
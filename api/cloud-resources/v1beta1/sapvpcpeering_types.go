@@ -0,0 +1,114 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type SapVpcPeeringMode string
+
+const (
+	// SapVpcPeeringModeRouterInterface attaches the subnets of the remote network to the Kyma router.
+	// The remote network must be shared with the Kyma project.
+	SapVpcPeeringModeRouterInterface SapVpcPeeringMode = "RouterInterface"
+
+	// SapVpcPeeringModeNetworkRbac shares the Kyma network with the remote project with an RBAC policy.
+	SapVpcPeeringModeNetworkRbac SapVpcPeeringMode = "NetworkRbac"
+)
+
+// SapVpcPeeringSpec defines the desired state of SapVpcPeering
+type SapVpcPeeringSpec struct {
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RemoteProject is immutable."
+	// +kubebuilder:validation:XValidation:rule=(size(self) > 0), message="RemoteProject is required."
+	RemoteProject string `json:"remoteProject"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="RemoteNetworkId is immutable."
+	// +kubebuilder:validation:XValidation:rule=(size(self) > 0), message="RemoteNetworkId is required."
+	RemoteNetworkId string `json:"remoteNetworkId"`
+
+	// +kubebuilder:default:=RouterInterface
+	// +kubebuilder:validation:Enum=RouterInterface;NetworkRbac
+	// +kubebuilder:validation:XValidation:rule=(self == oldSelf), message="Mode is immutable."
+	Mode SapVpcPeeringMode `json:"mode,omitempty"`
+}
+
+// SapVpcPeeringStatus defines the observed state of SapVpcPeering
+type SapVpcPeeringStatus struct {
+
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// List of status conditions to indicate the status of a Peering.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={kyma-cloud-manager}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Remote Network",type="string",JSONPath=".spec.remoteNetworkId"
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+
+// SapVpcPeering is the Schema for the sapvpcpeerings API
+type SapVpcPeering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SapVpcPeeringSpec   `json:"spec,omitempty"`
+	Status SapVpcPeeringStatus `json:"status,omitempty"`
+}
+
+func (in *SapVpcPeering) Conditions() *[]metav1.Condition { return &in.Status.Conditions }
+
+func (in *SapVpcPeering) GetObjectMeta() *metav1.ObjectMeta { return &in.ObjectMeta }
+
+func (in *SapVpcPeering) SpecificToFeature() featuretypes.FeatureName {
+	return featuretypes.FeaturePeering
+}
+
+func (in *SapVpcPeering) SpecificToProviders() []string { return []string{"openstack"} }
+
+func (in *SapVpcPeering) State() string {
+	return in.Status.State
+}
+func (in *SapVpcPeering) SetState(v string) {
+	in.Status.State = v
+}
+
+//+kubebuilder:object:root=true
+
+// SapVpcPeeringList contains a list of SapVpcPeering
+type SapVpcPeeringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SapVpcPeering `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SapVpcPeering{}, &SapVpcPeeringList{})
+}
@@ -9,6 +9,7 @@ const (
 	StateCreating          = "Creating"
 	StateDeleting          = "Deleting"
 	StateUpdating          = "Updating"
+	StateRestoring         = "Restoring"
 	StateDeleted           = "Deleted"
 	StateFailed            = "Failed"
 	StateCreatingRemote    = "CreatingRemote"
@@ -230,6 +230,123 @@ func (in *AwsNfsVolumeBackup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsNfsVolumeBackupDiscovery) DeepCopyInto(out *AwsNfsVolumeBackupDiscovery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupDiscovery.
+func (in *AwsNfsVolumeBackupDiscovery) DeepCopy() *AwsNfsVolumeBackupDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsNfsVolumeBackupDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AwsNfsVolumeBackupDiscovery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsNfsVolumeBackupDiscoveryList) DeepCopyInto(out *AwsNfsVolumeBackupDiscoveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AwsNfsVolumeBackupDiscovery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupDiscoveryList.
+func (in *AwsNfsVolumeBackupDiscoveryList) DeepCopy() *AwsNfsVolumeBackupDiscoveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsNfsVolumeBackupDiscoveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AwsNfsVolumeBackupDiscoveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsNfsVolumeBackupDiscoverySpec) DeepCopyInto(out *AwsNfsVolumeBackupDiscoverySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupDiscoverySpec.
+func (in *AwsNfsVolumeBackupDiscoverySpec) DeepCopy() *AwsNfsVolumeBackupDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsNfsVolumeBackupDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsNfsVolumeBackupDiscoveryStatus) DeepCopyInto(out *AwsNfsVolumeBackupDiscoveryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DiscoverySnapshotTime != nil {
+		in, out := &in.DiscoverySnapshotTime, &out.DiscoverySnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AvailableBackupsCount != nil {
+		in, out := &in.AvailableBackupsCount, &out.AvailableBackupsCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.AvailableBackupUris != nil {
+		in, out := &in.AvailableBackupUris, &out.AvailableBackupUris
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailableBackups != nil {
+		in, out := &in.AvailableBackups, &out.AvailableBackups
+		*out = make([]AvailableBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupDiscoveryStatus.
+func (in *AwsNfsVolumeBackupDiscoveryStatus) DeepCopy() *AwsNfsVolumeBackupDiscoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsNfsVolumeBackupDiscoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsNfsVolumeBackupLifecycle) DeepCopyInto(out *AwsNfsVolumeBackupLifecycle) {
 	*out = *in
@@ -308,6 +425,11 @@ func (in *AwsNfsVolumeBackupSpec) DeepCopyInto(out *AwsNfsVolumeBackupSpec) {
 	*out = *in
 	out.Source = in.Source
 	in.Lifecycle.DeepCopyInto(&out.Lifecycle)
+	if in.AccessibleFrom != nil {
+		in, out := &in.AccessibleFrom, &out.AccessibleFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeBackupSpec.
@@ -570,6 +692,11 @@ func (in *AwsNfsVolumeSpec) DeepCopyInto(out *AwsNfsVolumeSpec) {
 		*out = new(AwsNfsVolumePvcSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SourceBackup != nil {
+		in, out := &in.SourceBackup, &out.SourceBackup
+		*out = new(BackupRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsNfsVolumeSpec.
@@ -606,7 +733,7 @@ func (in *AwsNfsVolumeStatus) DeepCopy() *AwsNfsVolumeStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisCluster) DeepCopyInto(out *AwsRedisCluster) {
+func (in *AwsPostgresInstance) DeepCopyInto(out *AwsPostgresInstance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -614,18 +741,18 @@ func (in *AwsRedisCluster) DeepCopyInto(out *AwsRedisCluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisCluster.
-func (in *AwsRedisCluster) DeepCopy() *AwsRedisCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsPostgresInstance.
+func (in *AwsPostgresInstance) DeepCopy() *AwsPostgresInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisCluster)
+	out := new(AwsPostgresInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AwsRedisCluster) DeepCopyObject() runtime.Object {
+func (in *AwsPostgresInstance) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -633,31 +760,31 @@ func (in *AwsRedisCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisClusterList) DeepCopyInto(out *AwsRedisClusterList) {
+func (in *AwsPostgresInstanceList) DeepCopyInto(out *AwsPostgresInstanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AwsRedisCluster, len(*in))
+		*out = make([]AwsPostgresInstance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisClusterList.
-func (in *AwsRedisClusterList) DeepCopy() *AwsRedisClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsPostgresInstanceList.
+func (in *AwsPostgresInstanceList) DeepCopy() *AwsPostgresInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisClusterList)
+	out := new(AwsPostgresInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AwsRedisClusterList) DeepCopyObject() runtime.Object {
+func (in *AwsPostgresInstanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -665,12 +792,12 @@ func (in *AwsRedisClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisClusterSpec) DeepCopyInto(out *AwsRedisClusterSpec) {
+func (in *AwsPostgresInstanceSpec) DeepCopyInto(out *AwsPostgresInstanceSpec) {
 	*out = *in
 	out.IpRange = in.IpRange
 	if in.AuthSecret != nil {
 		in, out := &in.AuthSecret, &out.AuthSecret
-		*out = new(RedisAuthSecretSpec)
+		*out = new(PostgresAuthSecretSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredMaintenanceWindow != nil {
@@ -678,27 +805,20 @@ func (in *AwsRedisClusterSpec) DeepCopyInto(out *AwsRedisClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisClusterSpec.
-func (in *AwsRedisClusterSpec) DeepCopy() *AwsRedisClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsPostgresInstanceSpec.
+func (in *AwsPostgresInstanceSpec) DeepCopy() *AwsPostgresInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisClusterSpec)
+	out := new(AwsPostgresInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisClusterStatus) DeepCopyInto(out *AwsRedisClusterStatus) {
+func (in *AwsPostgresInstanceStatus) DeepCopyInto(out *AwsPostgresInstanceStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -709,18 +829,18 @@ func (in *AwsRedisClusterStatus) DeepCopyInto(out *AwsRedisClusterStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisClusterStatus.
-func (in *AwsRedisClusterStatus) DeepCopy() *AwsRedisClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsPostgresInstanceStatus.
+func (in *AwsPostgresInstanceStatus) DeepCopy() *AwsPostgresInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisClusterStatus)
+	out := new(AwsPostgresInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisInstance) DeepCopyInto(out *AwsRedisInstance) {
+func (in *AwsRedisBackupSchedule) DeepCopyInto(out *AwsRedisBackupSchedule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -728,18 +848,18 @@ func (in *AwsRedisInstance) DeepCopyInto(out *AwsRedisInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstance.
-func (in *AwsRedisInstance) DeepCopy() *AwsRedisInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisBackupSchedule.
+func (in *AwsRedisBackupSchedule) DeepCopy() *AwsRedisBackupSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisInstance)
+	out := new(AwsRedisBackupSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AwsRedisInstance) DeepCopyObject() runtime.Object {
+func (in *AwsRedisBackupSchedule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -747,31 +867,31 @@ func (in *AwsRedisInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisInstanceList) DeepCopyInto(out *AwsRedisInstanceList) {
+func (in *AwsRedisBackupScheduleList) DeepCopyInto(out *AwsRedisBackupScheduleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AwsRedisInstance, len(*in))
+		*out = make([]AwsRedisBackupSchedule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceList.
-func (in *AwsRedisInstanceList) DeepCopy() *AwsRedisInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisBackupScheduleList.
+func (in *AwsRedisBackupScheduleList) DeepCopy() *AwsRedisBackupScheduleList {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisInstanceList)
+	out := new(AwsRedisBackupScheduleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AwsRedisInstanceList) DeepCopyObject() runtime.Object {
+func (in *AwsRedisBackupScheduleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -779,40 +899,31 @@ func (in *AwsRedisInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisInstanceSpec) DeepCopyInto(out *AwsRedisInstanceSpec) {
+func (in *AwsRedisBackupScheduleSpec) DeepCopyInto(out *AwsRedisBackupScheduleSpec) {
 	*out = *in
-	out.IpRange = in.IpRange
-	if in.AuthSecret != nil {
-		in, out := &in.AuthSecret, &out.AuthSecret
-		*out = new(RedisAuthSecretSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PreferredMaintenanceWindow != nil {
-		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
-		*out = new(string)
-		**out = **in
+	out.RedisInstanceRef = in.RedisInstanceRef
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceSpec.
-func (in *AwsRedisInstanceSpec) DeepCopy() *AwsRedisInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisBackupScheduleSpec.
+func (in *AwsRedisBackupScheduleSpec) DeepCopy() *AwsRedisBackupScheduleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisInstanceSpec)
+	out := new(AwsRedisBackupScheduleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsRedisInstanceStatus) DeepCopyInto(out *AwsRedisInstanceStatus) {
+func (in *AwsRedisBackupScheduleStatus) DeepCopyInto(out *AwsRedisBackupScheduleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -821,39 +932,65 @@ func (in *AwsRedisInstanceStatus) DeepCopyInto(out *AwsRedisInstanceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextRunTimes != nil {
+		in, out := &in.NextRunTimes, &out.NextRunTimes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextDeleteTimes != nil {
+		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastCreateRun != nil {
+		in, out := &in.LastCreateRun, &out.LastCreateRun
+		*out = (*in).DeepCopy()
+	}
+	out.LastCreatedBackup = in.LastCreatedBackup
+	if in.LastDeleteRun != nil {
+		in, out := &in.LastDeleteRun, &out.LastDeleteRun
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeletedBackups != nil {
+		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceStatus.
-func (in *AwsRedisInstanceStatus) DeepCopy() *AwsRedisInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisBackupScheduleStatus.
+func (in *AwsRedisBackupScheduleStatus) DeepCopy() *AwsRedisBackupScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsRedisInstanceStatus)
+	out := new(AwsRedisBackupScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsVpcPeering) DeepCopyInto(out *AwsVpcPeering) {
+func (in *AwsRedisCluster) DeepCopyInto(out *AwsRedisCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeering.
-func (in *AwsVpcPeering) DeepCopy() *AwsVpcPeering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisCluster.
+func (in *AwsRedisCluster) DeepCopy() *AwsRedisCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsVpcPeering)
+	out := new(AwsRedisCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AwsVpcPeering) DeepCopyObject() runtime.Object {
+func (in *AwsRedisCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -861,31 +998,31 @@ func (in *AwsVpcPeering) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsVpcPeeringList) DeepCopyInto(out *AwsVpcPeeringList) {
+func (in *AwsRedisClusterList) DeepCopyInto(out *AwsRedisClusterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AwsVpcPeering, len(*in))
+		*out = make([]AwsRedisCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeeringList.
-func (in *AwsVpcPeeringList) DeepCopy() *AwsVpcPeeringList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisClusterList.
+func (in *AwsRedisClusterList) DeepCopy() *AwsRedisClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsVpcPeeringList)
+	out := new(AwsRedisClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AwsVpcPeeringList) DeepCopyObject() runtime.Object {
+func (in *AwsRedisClusterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -893,22 +1030,40 @@ func (in *AwsVpcPeeringList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsVpcPeeringSpec) DeepCopyInto(out *AwsVpcPeeringSpec) {
+func (in *AwsRedisClusterSpec) DeepCopyInto(out *AwsRedisClusterSpec) {
 	*out = *in
+	out.IpRange = in.IpRange
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(RedisAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeeringSpec.
-func (in *AwsVpcPeeringSpec) DeepCopy() *AwsVpcPeeringSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisClusterSpec.
+func (in *AwsRedisClusterSpec) DeepCopy() *AwsRedisClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsVpcPeeringSpec)
+	out := new(AwsRedisClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AwsVpcPeeringStatus) DeepCopyInto(out *AwsVpcPeeringStatus) {
+func (in *AwsRedisClusterStatus) DeepCopyInto(out *AwsRedisClusterStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -919,18 +1074,18 @@ func (in *AwsVpcPeeringStatus) DeepCopyInto(out *AwsVpcPeeringStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeeringStatus.
-func (in *AwsVpcPeeringStatus) DeepCopy() *AwsVpcPeeringStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisClusterStatus.
+func (in *AwsRedisClusterStatus) DeepCopy() *AwsRedisClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AwsVpcPeeringStatus)
+	out := new(AwsRedisClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisCluster) DeepCopyInto(out *AzureRedisCluster) {
+func (in *AwsRedisInstance) DeepCopyInto(out *AwsRedisInstance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -938,18 +1093,18 @@ func (in *AzureRedisCluster) DeepCopyInto(out *AzureRedisCluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisCluster.
-func (in *AzureRedisCluster) DeepCopy() *AzureRedisCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstance.
+func (in *AwsRedisInstance) DeepCopy() *AwsRedisInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisCluster)
+	out := new(AwsRedisInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRedisCluster) DeepCopyObject() runtime.Object {
+func (in *AwsRedisInstance) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -957,31 +1112,58 @@ func (in *AzureRedisCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisClusterList) DeepCopyInto(out *AzureRedisClusterList) {
+func (in *AwsRedisInstanceBackup) DeepCopyInto(out *AwsRedisInstanceBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceBackup.
+func (in *AwsRedisInstanceBackup) DeepCopy() *AwsRedisInstanceBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(AwsRedisInstanceBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AwsRedisInstanceBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwsRedisInstanceBackupList) DeepCopyInto(out *AwsRedisInstanceBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureRedisCluster, len(*in))
+		*out = make([]AwsRedisInstanceBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisClusterList.
-func (in *AzureRedisClusterList) DeepCopy() *AzureRedisClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceBackupList.
+func (in *AwsRedisInstanceBackupList) DeepCopy() *AwsRedisInstanceBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisClusterList)
+	out := new(AwsRedisInstanceBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRedisClusterList) DeepCopyObject() runtime.Object {
+func (in *AwsRedisInstanceBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -989,29 +1171,23 @@ func (in *AzureRedisClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisClusterSpec) DeepCopyInto(out *AzureRedisClusterSpec) {
+func (in *AwsRedisInstanceBackupSpec) DeepCopyInto(out *AwsRedisInstanceBackupSpec) {
 	*out = *in
-	out.RedisConfiguration = in.RedisConfiguration
-	if in.AuthSecret != nil {
-		in, out := &in.AuthSecret, &out.AuthSecret
-		*out = new(RedisAuthSecretSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	out.IpRange = in.IpRange
+	out.Source = in.Source
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisClusterSpec.
-func (in *AzureRedisClusterSpec) DeepCopy() *AzureRedisClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceBackupSpec.
+func (in *AwsRedisInstanceBackupSpec) DeepCopy() *AwsRedisInstanceBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisClusterSpec)
+	out := new(AwsRedisInstanceBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisClusterStatus) DeepCopyInto(out *AzureRedisClusterStatus) {
+func (in *AwsRedisInstanceBackupStatus) DeepCopyInto(out *AwsRedisInstanceBackupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1022,69 +1198,42 @@ func (in *AzureRedisClusterStatus) DeepCopyInto(out *AzureRedisClusterStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisClusterStatus.
-func (in *AzureRedisClusterStatus) DeepCopy() *AzureRedisClusterStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(AzureRedisClusterStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisInstance) DeepCopyInto(out *AzureRedisInstance) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstance.
-func (in *AzureRedisInstance) DeepCopy() *AzureRedisInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceBackupStatus.
+func (in *AwsRedisInstanceBackupStatus) DeepCopy() *AwsRedisInstanceBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisInstance)
+	out := new(AwsRedisInstanceBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRedisInstance) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisInstanceList) DeepCopyInto(out *AzureRedisInstanceList) {
+func (in *AwsRedisInstanceList) DeepCopyInto(out *AwsRedisInstanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureRedisInstance, len(*in))
+		*out = make([]AwsRedisInstance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceList.
-func (in *AzureRedisInstanceList) DeepCopy() *AzureRedisInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceList.
+func (in *AwsRedisInstanceList) DeepCopy() *AwsRedisInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisInstanceList)
+	out := new(AwsRedisInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRedisInstanceList) DeepCopyObject() runtime.Object {
+func (in *AwsRedisInstanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1092,29 +1241,45 @@ func (in *AzureRedisInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisInstanceSpec) DeepCopyInto(out *AzureRedisInstanceSpec) {
+func (in *AwsRedisInstanceSpec) DeepCopyInto(out *AwsRedisInstanceSpec) {
 	*out = *in
-	out.RedisConfiguration = in.RedisConfiguration
+	out.IpRange = in.IpRange
 	if in.AuthSecret != nil {
 		in, out := &in.AuthSecret, &out.AuthSecret
 		*out = new(RedisAuthSecretSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.IpRange = in.IpRange
+	if in.PreferredMaintenanceWindow != nil {
+		in, out := &in.PreferredMaintenanceWindow, &out.PreferredMaintenanceWindow
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SourceBackup != nil {
+		in, out := &in.SourceBackup, &out.SourceBackup
+		*out = new(RedisInstanceBackupRef)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceSpec.
-func (in *AzureRedisInstanceSpec) DeepCopy() *AzureRedisInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceSpec.
+func (in *AwsRedisInstanceSpec) DeepCopy() *AwsRedisInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisInstanceSpec)
+	out := new(AwsRedisInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRedisInstanceStatus) DeepCopyInto(out *AzureRedisInstanceStatus) {
+func (in *AwsRedisInstanceStatus) DeepCopyInto(out *AwsRedisInstanceStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1125,18 +1290,18 @@ func (in *AzureRedisInstanceStatus) DeepCopyInto(out *AzureRedisInstanceStatus)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceStatus.
-func (in *AzureRedisInstanceStatus) DeepCopy() *AzureRedisInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsRedisInstanceStatus.
+func (in *AwsRedisInstanceStatus) DeepCopy() *AwsRedisInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRedisInstanceStatus)
+	out := new(AwsRedisInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxBackupSchedule) DeepCopyInto(out *AzureRwxBackupSchedule) {
+func (in *AwsS3Bucket) DeepCopyInto(out *AwsS3Bucket) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1144,18 +1309,18 @@ func (in *AzureRwxBackupSchedule) DeepCopyInto(out *AzureRwxBackupSchedule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupSchedule.
-func (in *AzureRwxBackupSchedule) DeepCopy() *AzureRwxBackupSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsS3Bucket.
+func (in *AwsS3Bucket) DeepCopy() *AwsS3Bucket {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxBackupSchedule)
+	out := new(AwsS3Bucket)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRwxBackupSchedule) DeepCopyObject() runtime.Object {
+func (in *AwsS3Bucket) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1163,31 +1328,31 @@ func (in *AzureRwxBackupSchedule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxBackupScheduleList) DeepCopyInto(out *AzureRwxBackupScheduleList) {
+func (in *AwsS3BucketList) DeepCopyInto(out *AwsS3BucketList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureRwxBackupSchedule, len(*in))
+		*out = make([]AwsS3Bucket, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleList.
-func (in *AzureRwxBackupScheduleList) DeepCopy() *AzureRwxBackupScheduleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsS3BucketList.
+func (in *AwsS3BucketList) DeepCopy() *AwsS3BucketList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxBackupScheduleList)
+	out := new(AwsS3BucketList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRwxBackupScheduleList) DeepCopyObject() runtime.Object {
+func (in *AwsS3BucketList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1195,31 +1360,32 @@ func (in *AzureRwxBackupScheduleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxBackupScheduleSpec) DeepCopyInto(out *AzureRwxBackupScheduleSpec) {
+func (in *AwsS3BucketSpec) DeepCopyInto(out *AwsS3BucketSpec) {
 	*out = *in
-	out.PvcRef = in.PvcRef
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(BucketAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.EndTime != nil {
-		in, out := &in.EndTime, &out.EndTime
-		*out = (*in).DeepCopy()
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = make([]BucketLifecycleRule, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleSpec.
-func (in *AzureRwxBackupScheduleSpec) DeepCopy() *AzureRwxBackupScheduleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsS3BucketSpec.
+func (in *AwsS3BucketSpec) DeepCopy() *AwsS3BucketSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxBackupScheduleSpec)
+	out := new(AwsS3BucketSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxBackupScheduleStatus) DeepCopyInto(out *AzureRwxBackupScheduleStatus) {
+func (in *AwsS3BucketStatus) DeepCopyInto(out *AwsS3BucketStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1228,46 +1394,20 @@ func (in *AzureRwxBackupScheduleStatus) DeepCopyInto(out *AzureRwxBackupSchedule
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.NextRunTimes != nil {
-		in, out := &in.NextRunTimes, &out.NextRunTimes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.NextDeleteTimes != nil {
-		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.LastCreateRun != nil {
-		in, out := &in.LastCreateRun, &out.LastCreateRun
-		*out = (*in).DeepCopy()
-	}
-	out.LastCreatedBackup = in.LastCreatedBackup
-	if in.LastDeleteRun != nil {
-		in, out := &in.LastDeleteRun, &out.LastDeleteRun
-		*out = (*in).DeepCopy()
-	}
-	if in.LastDeletedBackups != nil {
-		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
-		*out = make([]corev1.ObjectReference, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleStatus.
-func (in *AzureRwxBackupScheduleStatus) DeepCopy() *AzureRwxBackupScheduleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsS3BucketStatus.
+func (in *AwsS3BucketStatus) DeepCopy() *AwsS3BucketStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxBackupScheduleStatus)
+	out := new(AwsS3BucketStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeBackup) DeepCopyInto(out *AzureRwxVolumeBackup) {
+func (in *AwsVpcPeering) DeepCopyInto(out *AwsVpcPeering) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1275,18 +1415,18 @@ func (in *AzureRwxVolumeBackup) DeepCopyInto(out *AzureRwxVolumeBackup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackup.
-func (in *AzureRwxVolumeBackup) DeepCopy() *AzureRwxVolumeBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeering.
+func (in *AwsVpcPeering) DeepCopy() *AwsVpcPeering {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeBackup)
+	out := new(AwsVpcPeering)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRwxVolumeBackup) DeepCopyObject() runtime.Object {
+func (in *AwsVpcPeering) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1294,31 +1434,31 @@ func (in *AzureRwxVolumeBackup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeBackupList) DeepCopyInto(out *AzureRwxVolumeBackupList) {
+func (in *AwsVpcPeeringList) DeepCopyInto(out *AwsVpcPeeringList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureRwxVolumeBackup, len(*in))
+		*out = make([]AwsVpcPeering, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupList.
-func (in *AzureRwxVolumeBackupList) DeepCopy() *AzureRwxVolumeBackupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeeringList.
+func (in *AwsVpcPeeringList) DeepCopy() *AwsVpcPeeringList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeBackupList)
+	out := new(AwsVpcPeeringList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRwxVolumeBackupList) DeepCopyObject() runtime.Object {
+func (in *AwsVpcPeeringList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1326,38 +1466,22 @@ func (in *AzureRwxVolumeBackupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeBackupRef) DeepCopyInto(out *AzureRwxVolumeBackupRef) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupRef.
-func (in *AzureRwxVolumeBackupRef) DeepCopy() *AzureRwxVolumeBackupRef {
-	if in == nil {
-		return nil
-	}
-	out := new(AzureRwxVolumeBackupRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeBackupSpec) DeepCopyInto(out *AzureRwxVolumeBackupSpec) {
+func (in *AwsVpcPeeringSpec) DeepCopyInto(out *AwsVpcPeeringSpec) {
 	*out = *in
-	out.Source = in.Source
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupSpec.
-func (in *AzureRwxVolumeBackupSpec) DeepCopy() *AzureRwxVolumeBackupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeeringSpec.
+func (in *AwsVpcPeeringSpec) DeepCopy() *AwsVpcPeeringSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeBackupSpec)
+	out := new(AwsVpcPeeringSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeBackupStatus) DeepCopyInto(out *AzureRwxVolumeBackupStatus) {
+func (in *AwsVpcPeeringStatus) DeepCopyInto(out *AwsVpcPeeringStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1368,37 +1492,37 @@ func (in *AzureRwxVolumeBackupStatus) DeepCopyInto(out *AzureRwxVolumeBackupStat
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupStatus.
-func (in *AzureRwxVolumeBackupStatus) DeepCopy() *AzureRwxVolumeBackupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsVpcPeeringStatus.
+func (in *AwsVpcPeeringStatus) DeepCopy() *AwsVpcPeeringStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeBackupStatus)
+	out := new(AwsVpcPeeringStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeRestore) DeepCopyInto(out *AzureRwxVolumeRestore) {
+func (in *AzureBlobContainer) DeepCopyInto(out *AzureBlobContainer) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestore.
-func (in *AzureRwxVolumeRestore) DeepCopy() *AzureRwxVolumeRestore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainer.
+func (in *AzureBlobContainer) DeepCopy() *AzureBlobContainer {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeRestore)
+	out := new(AzureBlobContainer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRwxVolumeRestore) DeepCopyObject() runtime.Object {
+func (in *AzureBlobContainer) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1406,31 +1530,31 @@ func (in *AzureRwxVolumeRestore) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeRestoreList) DeepCopyInto(out *AzureRwxVolumeRestoreList) {
+func (in *AzureBlobContainerList) DeepCopyInto(out *AzureBlobContainerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureRwxVolumeRestore, len(*in))
+		*out = make([]AzureBlobContainer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreList.
-func (in *AzureRwxVolumeRestoreList) DeepCopy() *AzureRwxVolumeRestoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainerList.
+func (in *AzureBlobContainerList) DeepCopy() *AzureBlobContainerList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeRestoreList)
+	out := new(AzureBlobContainerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureRwxVolumeRestoreList) DeepCopyObject() runtime.Object {
+func (in *AzureBlobContainerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1438,40 +1562,33 @@ func (in *AzureRwxVolumeRestoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeRestoreSource) DeepCopyInto(out *AzureRwxVolumeRestoreSource) {
+func (in *AzureBlobContainerSpec) DeepCopyInto(out *AzureBlobContainerSpec) {
 	*out = *in
-	out.Backup = in.Backup
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreSource.
-func (in *AzureRwxVolumeRestoreSource) DeepCopy() *AzureRwxVolumeRestoreSource {
-	if in == nil {
-		return nil
+	out.IpRange = in.IpRange
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(BucketAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = make([]BucketLifecycleRule, len(*in))
+		copy(*out, *in)
 	}
-	out := new(AzureRwxVolumeRestoreSource)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeRestoreSpec) DeepCopyInto(out *AzureRwxVolumeRestoreSpec) {
-	*out = *in
-	out.Source = in.Source
-	out.Destination = in.Destination
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreSpec.
-func (in *AzureRwxVolumeRestoreSpec) DeepCopy() *AzureRwxVolumeRestoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainerSpec.
+func (in *AzureBlobContainerSpec) DeepCopy() *AzureBlobContainerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeRestoreSpec)
+	out := new(AzureBlobContainerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureRwxVolumeRestoreStatus) DeepCopyInto(out *AzureRwxVolumeRestoreStatus) {
+func (in *AzureBlobContainerStatus) DeepCopyInto(out *AzureBlobContainerStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1480,43 +1597,39 @@ func (in *AzureRwxVolumeRestoreStatus) DeepCopyInto(out *AzureRwxVolumeRestoreSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreStatus.
-func (in *AzureRwxVolumeRestoreStatus) DeepCopy() *AzureRwxVolumeRestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainerStatus.
+func (in *AzureBlobContainerStatus) DeepCopy() *AzureBlobContainerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureRwxVolumeRestoreStatus)
+	out := new(AzureBlobContainerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcDnsLink) DeepCopyInto(out *AzureVpcDnsLink) {
+func (in *AzurePostgresInstance) DeepCopyInto(out *AzurePostgresInstance) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLink.
-func (in *AzureVpcDnsLink) DeepCopy() *AzureVpcDnsLink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePostgresInstance.
+func (in *AzurePostgresInstance) DeepCopy() *AzurePostgresInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcDnsLink)
+	out := new(AzurePostgresInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureVpcDnsLink) DeepCopyObject() runtime.Object {
+func (in *AzurePostgresInstance) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1524,31 +1637,31 @@ func (in *AzureVpcDnsLink) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcDnsLinkList) DeepCopyInto(out *AzureVpcDnsLinkList) {
+func (in *AzurePostgresInstanceList) DeepCopyInto(out *AzurePostgresInstanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureVpcDnsLink, len(*in))
+		*out = make([]AzurePostgresInstance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLinkList.
-func (in *AzureVpcDnsLinkList) DeepCopy() *AzureVpcDnsLinkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePostgresInstanceList.
+func (in *AzurePostgresInstanceList) DeepCopy() *AzurePostgresInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcDnsLinkList)
+	out := new(AzurePostgresInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureVpcDnsLinkList) DeepCopyObject() runtime.Object {
+func (in *AzurePostgresInstanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1556,22 +1669,28 @@ func (in *AzureVpcDnsLinkList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcDnsLinkSpec) DeepCopyInto(out *AzureVpcDnsLinkSpec) {
+func (in *AzurePostgresInstanceSpec) DeepCopyInto(out *AzurePostgresInstanceSpec) {
 	*out = *in
+	out.IpRange = in.IpRange
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(PostgresAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLinkSpec.
-func (in *AzureVpcDnsLinkSpec) DeepCopy() *AzureVpcDnsLinkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePostgresInstanceSpec.
+func (in *AzurePostgresInstanceSpec) DeepCopy() *AzurePostgresInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcDnsLinkSpec)
+	out := new(AzurePostgresInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcDnsLinkStatus) DeepCopyInto(out *AzureVpcDnsLinkStatus) {
+func (in *AzurePostgresInstanceStatus) DeepCopyInto(out *AzurePostgresInstanceStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1582,37 +1701,37 @@ func (in *AzureVpcDnsLinkStatus) DeepCopyInto(out *AzureVpcDnsLinkStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLinkStatus.
-func (in *AzureVpcDnsLinkStatus) DeepCopy() *AzureVpcDnsLinkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePostgresInstanceStatus.
+func (in *AzurePostgresInstanceStatus) DeepCopy() *AzurePostgresInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcDnsLinkStatus)
+	out := new(AzurePostgresInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeering) DeepCopyInto(out *AzureVpcPeering) {
+func (in *AzureRedisBackupSchedule) DeepCopyInto(out *AzureRedisBackupSchedule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeering.
-func (in *AzureVpcPeering) DeepCopy() *AzureVpcPeering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisBackupSchedule.
+func (in *AzureRedisBackupSchedule) DeepCopy() *AzureRedisBackupSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeering)
+	out := new(AzureRedisBackupSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureVpcPeering) DeepCopyObject() runtime.Object {
+func (in *AzureRedisBackupSchedule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1620,31 +1739,31 @@ func (in *AzureVpcPeering) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeeringList) DeepCopyInto(out *AzureVpcPeeringList) {
+func (in *AzureRedisBackupScheduleList) DeepCopyInto(out *AzureRedisBackupScheduleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AzureVpcPeering, len(*in))
+		*out = make([]AzureRedisBackupSchedule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringList.
-func (in *AzureVpcPeeringList) DeepCopy() *AzureVpcPeeringList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisBackupScheduleList.
+func (in *AzureRedisBackupScheduleList) DeepCopy() *AzureRedisBackupScheduleList {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeeringList)
+	out := new(AzureRedisBackupScheduleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AzureVpcPeeringList) DeepCopyObject() runtime.Object {
+func (in *AzureRedisBackupScheduleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1652,22 +1771,31 @@ func (in *AzureVpcPeeringList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeeringSpec) DeepCopyInto(out *AzureVpcPeeringSpec) {
+func (in *AzureRedisBackupScheduleSpec) DeepCopyInto(out *AzureRedisBackupScheduleSpec) {
 	*out = *in
+	out.RedisInstanceRef = in.RedisInstanceRef
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringSpec.
-func (in *AzureVpcPeeringSpec) DeepCopy() *AzureVpcPeeringSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisBackupScheduleSpec.
+func (in *AzureRedisBackupScheduleSpec) DeepCopy() *AzureRedisBackupScheduleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AzureVpcPeeringSpec)
+	out := new(AzureRedisBackupScheduleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AzureVpcPeeringStatus) DeepCopyInto(out *AzureVpcPeeringStatus) {
+func (in *AzureRedisBackupScheduleStatus) DeepCopyInto(out *AzureRedisBackupScheduleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1676,54 +1804,65 @@ func (in *AzureVpcPeeringStatus) DeepCopyInto(out *AzureVpcPeeringStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringStatus.
-func (in *AzureVpcPeeringStatus) DeepCopy() *AzureVpcPeeringStatus {
-	if in == nil {
-		return nil
+	if in.NextRunTimes != nil {
+		in, out := &in.NextRunTimes, &out.NextRunTimes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextDeleteTimes != nil {
+		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastCreateRun != nil {
+		in, out := &in.LastCreateRun, &out.LastCreateRun
+		*out = (*in).DeepCopy()
+	}
+	out.LastCreatedBackup = in.LastCreatedBackup
+	if in.LastDeleteRun != nil {
+		in, out := &in.LastDeleteRun, &out.LastDeleteRun
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeletedBackups != nil {
+		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
 	}
-	out := new(AzureVpcPeeringStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BackupRef) DeepCopyInto(out *BackupRef) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRef.
-func (in *BackupRef) DeepCopy() *BackupRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisBackupScheduleStatus.
+func (in *AzureRedisBackupScheduleStatus) DeepCopy() *AzureRedisBackupScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BackupRef)
+	out := new(AzureRedisBackupScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudResources) DeepCopyInto(out *CloudResources) {
+func (in *AzureRedisCluster) DeepCopyInto(out *AzureRedisCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResources.
-func (in *CloudResources) DeepCopy() *CloudResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisCluster.
+func (in *AzureRedisCluster) DeepCopy() *AzureRedisCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudResources)
+	out := new(AzureRedisCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CloudResources) DeepCopyObject() runtime.Object {
+func (in *AzureRedisCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1731,31 +1870,31 @@ func (in *CloudResources) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudResourcesList) DeepCopyInto(out *CloudResourcesList) {
+func (in *AzureRedisClusterList) DeepCopyInto(out *AzureRedisClusterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CloudResources, len(*in))
+		*out = make([]AzureRedisCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesList.
-func (in *CloudResourcesList) DeepCopy() *CloudResourcesList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisClusterList.
+func (in *AzureRedisClusterList) DeepCopy() *AzureRedisClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudResourcesList)
+	out := new(AzureRedisClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CloudResourcesList) DeepCopyObject() runtime.Object {
+func (in *AzureRedisClusterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1763,22 +1902,29 @@ func (in *CloudResourcesList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudResourcesSpec) DeepCopyInto(out *CloudResourcesSpec) {
+func (in *AzureRedisClusterSpec) DeepCopyInto(out *AzureRedisClusterSpec) {
 	*out = *in
+	out.RedisConfiguration = in.RedisConfiguration
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(RedisAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.IpRange = in.IpRange
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesSpec.
-func (in *CloudResourcesSpec) DeepCopy() *CloudResourcesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisClusterSpec.
+func (in *AzureRedisClusterSpec) DeepCopy() *AzureRedisClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudResourcesSpec)
+	out := new(AzureRedisClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudResourcesStatus) DeepCopyInto(out *CloudResourcesStatus) {
+func (in *AzureRedisClusterStatus) DeepCopyInto(out *AzureRedisClusterStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1789,53 +1935,64 @@ func (in *CloudResourcesStatus) DeepCopyInto(out *CloudResourcesStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesStatus.
-func (in *CloudResourcesStatus) DeepCopy() *CloudResourcesStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisClusterStatus.
+func (in *AzureRedisClusterStatus) DeepCopy() *AzureRedisClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudResourcesStatus)
+	out := new(AzureRedisClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DayOfWeekPolicy) DeepCopyInto(out *DayOfWeekPolicy) {
+func (in *AzureRedisInstance) DeepCopyInto(out *AzureRedisInstance) {
 	*out = *in
-	out.StartTime = in.StartTime
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DayOfWeekPolicy.
-func (in *DayOfWeekPolicy) DeepCopy() *DayOfWeekPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstance.
+func (in *AzureRedisInstance) DeepCopy() *AzureRedisInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(DayOfWeekPolicy)
+	out := new(AzureRedisInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureRedisInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsBackupSchedule) DeepCopyInto(out *GcpNfsBackupSchedule) {
+func (in *AzureRedisInstanceBackup) DeepCopyInto(out *AzureRedisInstanceBackup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupSchedule.
-func (in *GcpNfsBackupSchedule) DeepCopy() *GcpNfsBackupSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceBackup.
+func (in *AzureRedisInstanceBackup) DeepCopy() *AzureRedisInstanceBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsBackupSchedule)
+	out := new(AzureRedisInstanceBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsBackupSchedule) DeepCopyObject() runtime.Object {
+func (in *AzureRedisInstanceBackup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1843,31 +2000,31 @@ func (in *GcpNfsBackupSchedule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsBackupScheduleList) DeepCopyInto(out *GcpNfsBackupScheduleList) {
+func (in *AzureRedisInstanceBackupList) DeepCopyInto(out *AzureRedisInstanceBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GcpNfsBackupSchedule, len(*in))
+		*out = make([]AzureRedisInstanceBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleList.
-func (in *GcpNfsBackupScheduleList) DeepCopy() *GcpNfsBackupScheduleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceBackupList.
+func (in *AzureRedisInstanceBackupList) DeepCopy() *AzureRedisInstanceBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsBackupScheduleList)
+	out := new(AzureRedisInstanceBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsBackupScheduleList) DeepCopyObject() runtime.Object {
+func (in *AzureRedisInstanceBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1875,36 +2032,23 @@ func (in *GcpNfsBackupScheduleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsBackupScheduleSpec) DeepCopyInto(out *GcpNfsBackupScheduleSpec) {
+func (in *AzureRedisInstanceBackupSpec) DeepCopyInto(out *AzureRedisInstanceBackupSpec) {
 	*out = *in
-	out.NfsVolumeRef = in.NfsVolumeRef
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.EndTime != nil {
-		in, out := &in.EndTime, &out.EndTime
-		*out = (*in).DeepCopy()
-	}
-	if in.AccessibleFrom != nil {
-		in, out := &in.AccessibleFrom, &out.AccessibleFrom
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.Source = in.Source
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleSpec.
-func (in *GcpNfsBackupScheduleSpec) DeepCopy() *GcpNfsBackupScheduleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceBackupSpec.
+func (in *AzureRedisInstanceBackupSpec) DeepCopy() *AzureRedisInstanceBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsBackupScheduleSpec)
+	out := new(AzureRedisInstanceBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsBackupScheduleStatus) DeepCopyInto(out *GcpNfsBackupScheduleStatus) {
+func (in *AzureRedisInstanceBackupStatus) DeepCopyInto(out *AzureRedisInstanceBackupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1913,65 +2057,44 @@ func (in *GcpNfsBackupScheduleStatus) DeepCopyInto(out *GcpNfsBackupScheduleStat
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.NextRunTimes != nil {
-		in, out := &in.NextRunTimes, &out.NextRunTimes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.NextDeleteTimes != nil {
-		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.LastCreateRun != nil {
-		in, out := &in.LastCreateRun, &out.LastCreateRun
-		*out = (*in).DeepCopy()
-	}
-	out.LastCreatedBackup = in.LastCreatedBackup
-	if in.LastDeleteRun != nil {
-		in, out := &in.LastDeleteRun, &out.LastDeleteRun
-		*out = (*in).DeepCopy()
-	}
-	if in.LastDeletedBackups != nil {
-		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
-		*out = make([]corev1.ObjectReference, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleStatus.
-func (in *GcpNfsBackupScheduleStatus) DeepCopy() *GcpNfsBackupScheduleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceBackupStatus.
+func (in *AzureRedisInstanceBackupStatus) DeepCopy() *AzureRedisInstanceBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsBackupScheduleStatus)
+	out := new(AzureRedisInstanceBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolume) DeepCopyInto(out *GcpNfsVolume) {
+func (in *AzureRedisInstanceList) DeepCopyInto(out *AzureRedisInstanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureRedisInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolume.
-func (in *GcpNfsVolume) DeepCopy() *GcpNfsVolume {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceList.
+func (in *AzureRedisInstanceList) DeepCopy() *AzureRedisInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolume)
+	out := new(AzureRedisInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolume) DeepCopyObject() runtime.Object {
+func (in *AzureRedisInstanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1979,53 +2102,75 @@ func (in *GcpNfsVolume) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackup) DeepCopyInto(out *GcpNfsVolumeBackup) {
+func (in *AzureRedisInstanceSpec) DeepCopyInto(out *AzureRedisInstanceSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.RedisConfiguration = in.RedisConfiguration
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(RedisAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.IpRange = in.IpRange
+	if in.SourceBackup != nil {
+		in, out := &in.SourceBackup, &out.SourceBackup
+		*out = new(RedisInstanceBackupRef)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackup.
-func (in *GcpNfsVolumeBackup) DeepCopy() *GcpNfsVolumeBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceSpec.
+func (in *AzureRedisInstanceSpec) DeepCopy() *AzureRedisInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackup)
+	out := new(AzureRedisInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeBackup) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRedisInstanceStatus) DeepCopyInto(out *AzureRedisInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRedisInstanceStatus.
+func (in *AzureRedisInstanceStatus) DeepCopy() *AzureRedisInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRedisInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupDiscovery) DeepCopyInto(out *GcpNfsVolumeBackupDiscovery) {
+func (in *AzureRwxBackupSchedule) DeepCopyInto(out *AzureRwxBackupSchedule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscovery.
-func (in *GcpNfsVolumeBackupDiscovery) DeepCopy() *GcpNfsVolumeBackupDiscovery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupSchedule.
+func (in *AzureRwxBackupSchedule) DeepCopy() *AzureRwxBackupSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupDiscovery)
+	out := new(AzureRwxBackupSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeBackupDiscovery) DeepCopyObject() runtime.Object {
+func (in *AzureRwxBackupSchedule) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2033,31 +2178,31 @@ func (in *GcpNfsVolumeBackupDiscovery) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopyInto(out *GcpNfsVolumeBackupDiscoveryList) {
+func (in *AzureRwxBackupScheduleList) DeepCopyInto(out *AzureRwxBackupScheduleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GcpNfsVolumeBackupDiscovery, len(*in))
+		*out = make([]AzureRwxBackupSchedule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscoveryList.
-func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopy() *GcpNfsVolumeBackupDiscoveryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleList.
+func (in *AzureRwxBackupScheduleList) DeepCopy() *AzureRwxBackupScheduleList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupDiscoveryList)
+	out := new(AzureRwxBackupScheduleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopyObject() runtime.Object {
+func (in *AzureRwxBackupScheduleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2065,22 +2210,36 @@ func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupDiscoverySpec) DeepCopyInto(out *GcpNfsVolumeBackupDiscoverySpec) {
+func (in *AzureRwxBackupScheduleSpec) DeepCopyInto(out *AzureRwxBackupScheduleSpec) {
 	*out = *in
+	out.PvcRef = in.PvcRef
+	if in.CopyTo != nil {
+		in, out := &in.CopyTo, &out.CopyTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscoverySpec.
-func (in *GcpNfsVolumeBackupDiscoverySpec) DeepCopy() *GcpNfsVolumeBackupDiscoverySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleSpec.
+func (in *AzureRwxBackupScheduleSpec) DeepCopy() *AzureRwxBackupScheduleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupDiscoverySpec)
+	out := new(AzureRwxBackupScheduleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupDiscoveryStatus) DeepCopyInto(out *GcpNfsVolumeBackupDiscoveryStatus) {
+func (in *AzureRwxBackupScheduleStatus) DeepCopyInto(out *AzureRwxBackupScheduleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2089,65 +2248,65 @@ func (in *GcpNfsVolumeBackupDiscoveryStatus) DeepCopyInto(out *GcpNfsVolumeBacku
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DiscoverySnapshotTime != nil {
-		in, out := &in.DiscoverySnapshotTime, &out.DiscoverySnapshotTime
-		*out = (*in).DeepCopy()
-	}
-	if in.AvailableBackupsCount != nil {
-		in, out := &in.AvailableBackupsCount, &out.AvailableBackupsCount
-		*out = new(int)
-		**out = **in
-	}
-	if in.AvailableBackupUris != nil {
-		in, out := &in.AvailableBackupUris, &out.AvailableBackupUris
+	if in.NextRunTimes != nil {
+		in, out := &in.NextRunTimes, &out.NextRunTimes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.AvailableBackups != nil {
-		in, out := &in.AvailableBackups, &out.AvailableBackups
-		*out = make([]AvailableBackup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.NextDeleteTimes != nil {
+		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.LastCreateRun != nil {
+		in, out := &in.LastCreateRun, &out.LastCreateRun
+		*out = (*in).DeepCopy()
+	}
+	out.LastCreatedBackup = in.LastCreatedBackup
+	if in.LastDeleteRun != nil {
+		in, out := &in.LastDeleteRun, &out.LastDeleteRun
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeletedBackups != nil {
+		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscoveryStatus.
-func (in *GcpNfsVolumeBackupDiscoveryStatus) DeepCopy() *GcpNfsVolumeBackupDiscoveryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxBackupScheduleStatus.
+func (in *AzureRwxBackupScheduleStatus) DeepCopy() *AzureRwxBackupScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupDiscoveryStatus)
+	out := new(AzureRwxBackupScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupList) DeepCopyInto(out *GcpNfsVolumeBackupList) {
+func (in *AzureRwxVolumeBackup) DeepCopyInto(out *AzureRwxVolumeBackup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GcpNfsVolumeBackup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupList.
-func (in *GcpNfsVolumeBackupList) DeepCopy() *GcpNfsVolumeBackupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackup.
+func (in *AzureRwxVolumeBackup) DeepCopy() *AzureRwxVolumeBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupList)
+	out := new(AzureRwxVolumeBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeBackupList) DeepCopyObject() runtime.Object {
+func (in *AzureRwxVolumeBackup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2155,59 +2314,75 @@ func (in *GcpNfsVolumeBackupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupRef) DeepCopyInto(out *GcpNfsVolumeBackupRef) {
+func (in *AzureRwxVolumeBackupList) DeepCopyInto(out *AzureRwxVolumeBackupList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureRwxVolumeBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupRef.
-func (in *GcpNfsVolumeBackupRef) DeepCopy() *GcpNfsVolumeBackupRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupList.
+func (in *AzureRwxVolumeBackupList) DeepCopy() *AzureRwxVolumeBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupRef)
+	out := new(AzureRwxVolumeBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureRwxVolumeBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupSource) DeepCopyInto(out *GcpNfsVolumeBackupSource) {
+func (in *AzureRwxVolumeBackupRef) DeepCopyInto(out *AzureRwxVolumeBackupRef) {
 	*out = *in
-	out.Volume = in.Volume
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupSource.
-func (in *GcpNfsVolumeBackupSource) DeepCopy() *GcpNfsVolumeBackupSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupRef.
+func (in *AzureRwxVolumeBackupRef) DeepCopy() *AzureRwxVolumeBackupRef {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupSource)
+	out := new(AzureRwxVolumeBackupRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupSpec) DeepCopyInto(out *GcpNfsVolumeBackupSpec) {
+func (in *AzureRwxVolumeBackupSpec) DeepCopyInto(out *AzureRwxVolumeBackupSpec) {
 	*out = *in
 	out.Source = in.Source
-	if in.AccessibleFrom != nil {
-		in, out := &in.AccessibleFrom, &out.AccessibleFrom
+	if in.CopyTo != nil {
+		in, out := &in.CopyTo, &out.CopyTo
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupSpec.
-func (in *GcpNfsVolumeBackupSpec) DeepCopy() *GcpNfsVolumeBackupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupSpec.
+func (in *AzureRwxVolumeBackupSpec) DeepCopy() *AzureRwxVolumeBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupSpec)
+	out := new(AzureRwxVolumeBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeBackupStatus) DeepCopyInto(out *GcpNfsVolumeBackupStatus) {
+func (in *AzureRwxVolumeBackupStatus) DeepCopyInto(out *AzureRwxVolumeBackupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2216,56 +2391,44 @@ func (in *GcpNfsVolumeBackupStatus) DeepCopyInto(out *GcpNfsVolumeBackupStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Capacity = in.Capacity.DeepCopy()
-	if in.LastCapacityUpdate != nil {
-		in, out := &in.LastCapacityUpdate, &out.LastCapacityUpdate
-		*out = (*in).DeepCopy()
-	}
-	if in.FileStoreBackupLabels != nil {
-		in, out := &in.FileStoreBackupLabels, &out.FileStoreBackupLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Copies != nil {
+		in, out := &in.Copies, &out.Copies
+		*out = make([]BackupCopyStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupStatus.
-func (in *GcpNfsVolumeBackupStatus) DeepCopy() *GcpNfsVolumeBackupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeBackupStatus.
+func (in *AzureRwxVolumeBackupStatus) DeepCopy() *AzureRwxVolumeBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeBackupStatus)
+	out := new(AzureRwxVolumeBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeList) DeepCopyInto(out *GcpNfsVolumeList) {
+func (in *AzureRwxVolumeRestore) DeepCopyInto(out *AzureRwxVolumeRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]GcpNfsVolume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeList.
-func (in *GcpNfsVolumeList) DeepCopy() *GcpNfsVolumeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestore.
+func (in *AzureRwxVolumeRestore) DeepCopy() *AzureRwxVolumeRestore {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeList)
+	out := new(AzureRwxVolumeRestore)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeList) DeepCopyObject() runtime.Object {
+func (in *AzureRwxVolumeRestore) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2273,7 +2436,80 @@ func (in *GcpNfsVolumeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumePvSpec) DeepCopyInto(out *GcpNfsVolumePvSpec) {
+func (in *AzureRwxVolumeRestoreDestination) DeepCopyInto(out *AzureRwxVolumeRestoreDestination) {
+	*out = *in
+	if in.Pvc != nil {
+		in, out := &in.Pvc, &out.Pvc
+		*out = new(PvcRef)
+		**out = **in
+	}
+	if in.NewVolume != nil {
+		in, out := &in.NewVolume, &out.NewVolume
+		*out = new(AzureRwxVolumeRestoreNewVolume)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreDestination.
+func (in *AzureRwxVolumeRestoreDestination) DeepCopy() *AzureRwxVolumeRestoreDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRwxVolumeRestoreDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRwxVolumeRestoreList) DeepCopyInto(out *AzureRwxVolumeRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureRwxVolumeRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreList.
+func (in *AzureRwxVolumeRestoreList) DeepCopy() *AzureRwxVolumeRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRwxVolumeRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureRwxVolumeRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRwxVolumeRestoreNewVolume) DeepCopyInto(out *AzureRwxVolumeRestoreNewVolume) {
+	*out = *in
+	in.Pvc.DeepCopyInto(&out.Pvc)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreNewVolume.
+func (in *AzureRwxVolumeRestoreNewVolume) DeepCopy() *AzureRwxVolumeRestoreNewVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRwxVolumeRestoreNewVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRwxVolumeRestorePvcSpec) DeepCopyInto(out *AzureRwxVolumeRestorePvcSpec) {
 	*out = *in
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
@@ -2291,81 +2527,1551 @@ func (in *GcpNfsVolumePvSpec) DeepCopyInto(out *GcpNfsVolumePvSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumePvSpec.
-func (in *GcpNfsVolumePvSpec) DeepCopy() *GcpNfsVolumePvSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestorePvcSpec.
+func (in *AzureRwxVolumeRestorePvcSpec) DeepCopy() *AzureRwxVolumeRestorePvcSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumePvSpec)
+	out := new(AzureRwxVolumeRestorePvcSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumePvcSpec) DeepCopyInto(out *GcpNfsVolumePvcSpec) {
+func (in *AzureRwxVolumeRestoreSource) DeepCopyInto(out *AzureRwxVolumeRestoreSource) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.Backup = in.Backup
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreSource.
+func (in *AzureRwxVolumeRestoreSource) DeepCopy() *AzureRwxVolumeRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRwxVolumeRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRwxVolumeRestoreSpec) DeepCopyInto(out *AzureRwxVolumeRestoreSpec) {
+	*out = *in
+	out.Source = in.Source
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreSpec.
+func (in *AzureRwxVolumeRestoreSpec) DeepCopy() *AzureRwxVolumeRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRwxVolumeRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureRwxVolumeRestoreStatus) DeepCopyInto(out *AzureRwxVolumeRestoreStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedPvc != nil {
+		in, out := &in.CreatedPvc, &out.CreatedPvc
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureRwxVolumeRestoreStatus.
+func (in *AzureRwxVolumeRestoreStatus) DeepCopy() *AzureRwxVolumeRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureRwxVolumeRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcDnsLink) DeepCopyInto(out *AzureVpcDnsLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLink.
+func (in *AzureVpcDnsLink) DeepCopy() *AzureVpcDnsLink {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcDnsLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureVpcDnsLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcDnsLinkList) DeepCopyInto(out *AzureVpcDnsLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureVpcDnsLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumePvcSpec.
-func (in *GcpNfsVolumePvcSpec) DeepCopy() *GcpNfsVolumePvcSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLinkList.
+func (in *AzureVpcDnsLinkList) DeepCopy() *AzureVpcDnsLinkList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumePvcSpec)
+	out := new(AzureVpcDnsLinkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureVpcDnsLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRef) DeepCopyInto(out *GcpNfsVolumeRef) {
+func (in *AzureVpcDnsLinkSpec) DeepCopyInto(out *AzureVpcDnsLinkSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLinkSpec.
+func (in *AzureVpcDnsLinkSpec) DeepCopy() *AzureVpcDnsLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcDnsLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcDnsLinkStatus) DeepCopyInto(out *AzureVpcDnsLinkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcDnsLinkStatus.
+func (in *AzureVpcDnsLinkStatus) DeepCopy() *AzureVpcDnsLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcDnsLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeering) DeepCopyInto(out *AzureVpcPeering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeering.
+func (in *AzureVpcPeering) DeepCopy() *AzureVpcPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureVpcPeering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeeringList) DeepCopyInto(out *AzureVpcPeeringList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureVpcPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringList.
+func (in *AzureVpcPeeringList) DeepCopy() *AzureVpcPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureVpcPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeeringSpec) DeepCopyInto(out *AzureVpcPeeringSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringSpec.
+func (in *AzureVpcPeeringSpec) DeepCopy() *AzureVpcPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVpcPeeringStatus) DeepCopyInto(out *AzureVpcPeeringStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVpcPeeringStatus.
+func (in *AzureVpcPeeringStatus) DeepCopy() *AzureVpcPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVpcPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCopyStatus) DeepCopyInto(out *BackupCopyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCopyStatus.
+func (in *BackupCopyStatus) DeepCopy() *BackupCopyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCopyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRef) DeepCopyInto(out *BackupRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRef.
+func (in *BackupRef) DeepCopy() *BackupRef {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketAuthSecretSpec) DeepCopyInto(out *BucketAuthSecretSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraData != nil {
+		in, out := &in.ExtraData, &out.ExtraData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketAuthSecretSpec.
+func (in *BucketAuthSecretSpec) DeepCopy() *BucketAuthSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketAuthSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketLifecycleRule) DeepCopyInto(out *BucketLifecycleRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketLifecycleRule.
+func (in *BucketLifecycleRule) DeepCopy() *BucketLifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketLifecycleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkInfo) DeepCopyInto(out *CloudNetworkInfo) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkInfo.
+func (in *CloudNetworkInfo) DeepCopy() *CloudNetworkInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudNetworkInfo) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkInfoList) DeepCopyInto(out *CloudNetworkInfoList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudNetworkInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkInfoList.
+func (in *CloudNetworkInfoList) DeepCopy() *CloudNetworkInfoList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkInfoList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudNetworkInfoList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkInfoStatus) DeepCopyInto(out *CloudNetworkInfoStatus) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NatGatewayIps != nil {
+		in, out := &in.NatGatewayIps, &out.NatGatewayIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IpRangeCidrs != nil {
+		in, out := &in.IpRangeCidrs, &out.IpRangeCidrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadTime != nil {
+		in, out := &in.ReadTime, &out.ReadTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkInfoStatus.
+func (in *CloudNetworkInfoStatus) DeepCopy() *CloudNetworkInfoStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkInfoStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudResources) DeepCopyInto(out *CloudResources) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResources.
+func (in *CloudResources) DeepCopy() *CloudResources {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudResources) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudResourcesList) DeepCopyInto(out *CloudResourcesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesList.
+func (in *CloudResourcesList) DeepCopy() *CloudResourcesList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudResourcesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudResourcesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudResourcesSpec) DeepCopyInto(out *CloudResourcesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesSpec.
+func (in *CloudResourcesSpec) DeepCopy() *CloudResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudResourcesStatus) DeepCopyInto(out *CloudResourcesStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]QuotaUsage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudResourcesStatus.
+func (in *CloudResourcesStatus) DeepCopy() *CloudResourcesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudResourcesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DayOfWeekPolicy) DeepCopyInto(out *DayOfWeekPolicy) {
+	*out = *in
+	out.StartTime = in.StartTime
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DayOfWeekPolicy.
+func (in *DayOfWeekPolicy) DeepCopy() *DayOfWeekPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DayOfWeekPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsBackupSchedule) DeepCopyInto(out *GcpNfsBackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupSchedule.
+func (in *GcpNfsBackupSchedule) DeepCopy() *GcpNfsBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsBackupScheduleList) DeepCopyInto(out *GcpNfsBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpNfsBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleList.
+func (in *GcpNfsBackupScheduleList) DeepCopy() *GcpNfsBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsBackupScheduleSpec) DeepCopyInto(out *GcpNfsBackupScheduleSpec) {
+	*out = *in
+	out.NfsVolumeRef = in.NfsVolumeRef
+	if in.CopyTo != nil {
+		in, out := &in.CopyTo, &out.CopyTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AccessibleFrom != nil {
+		in, out := &in.AccessibleFrom, &out.AccessibleFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleSpec.
+func (in *GcpNfsBackupScheduleSpec) DeepCopy() *GcpNfsBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsBackupScheduleStatus) DeepCopyInto(out *GcpNfsBackupScheduleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextRunTimes != nil {
+		in, out := &in.NextRunTimes, &out.NextRunTimes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextDeleteTimes != nil {
+		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastCreateRun != nil {
+		in, out := &in.LastCreateRun, &out.LastCreateRun
+		*out = (*in).DeepCopy()
+	}
+	out.LastCreatedBackup = in.LastCreatedBackup
+	if in.LastDeleteRun != nil {
+		in, out := &in.LastDeleteRun, &out.LastDeleteRun
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeletedBackups != nil {
+		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsBackupScheduleStatus.
+func (in *GcpNfsBackupScheduleStatus) DeepCopy() *GcpNfsBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolume) DeepCopyInto(out *GcpNfsVolume) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolume.
+func (in *GcpNfsVolume) DeepCopy() *GcpNfsVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolume) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackup) DeepCopyInto(out *GcpNfsVolumeBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackup.
+func (in *GcpNfsVolumeBackup) DeepCopy() *GcpNfsVolumeBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupDiscovery) DeepCopyInto(out *GcpNfsVolumeBackupDiscovery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscovery.
+func (in *GcpNfsVolumeBackupDiscovery) DeepCopy() *GcpNfsVolumeBackupDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeBackupDiscovery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopyInto(out *GcpNfsVolumeBackupDiscoveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpNfsVolumeBackupDiscovery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscoveryList.
+func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopy() *GcpNfsVolumeBackupDiscoveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupDiscoveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeBackupDiscoveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupDiscoverySpec) DeepCopyInto(out *GcpNfsVolumeBackupDiscoverySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscoverySpec.
+func (in *GcpNfsVolumeBackupDiscoverySpec) DeepCopy() *GcpNfsVolumeBackupDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupDiscoveryStatus) DeepCopyInto(out *GcpNfsVolumeBackupDiscoveryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DiscoverySnapshotTime != nil {
+		in, out := &in.DiscoverySnapshotTime, &out.DiscoverySnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AvailableBackupsCount != nil {
+		in, out := &in.AvailableBackupsCount, &out.AvailableBackupsCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.AvailableBackupUris != nil {
+		in, out := &in.AvailableBackupUris, &out.AvailableBackupUris
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailableBackups != nil {
+		in, out := &in.AvailableBackups, &out.AvailableBackups
+		*out = make([]AvailableBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupDiscoveryStatus.
+func (in *GcpNfsVolumeBackupDiscoveryStatus) DeepCopy() *GcpNfsVolumeBackupDiscoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupDiscoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupList) DeepCopyInto(out *GcpNfsVolumeBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpNfsVolumeBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupList.
+func (in *GcpNfsVolumeBackupList) DeepCopy() *GcpNfsVolumeBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupRef) DeepCopyInto(out *GcpNfsVolumeBackupRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupRef.
+func (in *GcpNfsVolumeBackupRef) DeepCopy() *GcpNfsVolumeBackupRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupSource) DeepCopyInto(out *GcpNfsVolumeBackupSource) {
+	*out = *in
+	out.Volume = in.Volume
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupSource.
+func (in *GcpNfsVolumeBackupSource) DeepCopy() *GcpNfsVolumeBackupSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupSpec) DeepCopyInto(out *GcpNfsVolumeBackupSpec) {
+	*out = *in
+	out.Source = in.Source
+	if in.AccessibleFrom != nil {
+		in, out := &in.AccessibleFrom, &out.AccessibleFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CopyTo != nil {
+		in, out := &in.CopyTo, &out.CopyTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupSpec.
+func (in *GcpNfsVolumeBackupSpec) DeepCopy() *GcpNfsVolumeBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeBackupStatus) DeepCopyInto(out *GcpNfsVolumeBackupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.LastCapacityUpdate != nil {
+		in, out := &in.LastCapacityUpdate, &out.LastCapacityUpdate
+		*out = (*in).DeepCopy()
+	}
+	if in.FileStoreBackupLabels != nil {
+		in, out := &in.FileStoreBackupLabels, &out.FileStoreBackupLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Copies != nil {
+		in, out := &in.Copies, &out.Copies
+		*out = make([]BackupCopyStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeBackupStatus.
+func (in *GcpNfsVolumeBackupStatus) DeepCopy() *GcpNfsVolumeBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeList) DeepCopyInto(out *GcpNfsVolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpNfsVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeList.
+func (in *GcpNfsVolumeList) DeepCopy() *GcpNfsVolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumePvSpec) DeepCopyInto(out *GcpNfsVolumePvSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumePvSpec.
+func (in *GcpNfsVolumePvSpec) DeepCopy() *GcpNfsVolumePvSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumePvSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumePvcSpec) DeepCopyInto(out *GcpNfsVolumePvcSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumePvcSpec.
+func (in *GcpNfsVolumePvcSpec) DeepCopy() *GcpNfsVolumePvcSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumePvcSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRef) DeepCopyInto(out *GcpNfsVolumeRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRef.
+func (in *GcpNfsVolumeRef) DeepCopy() *GcpNfsVolumeRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRestore) DeepCopyInto(out *GcpNfsVolumeRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestore.
+func (in *GcpNfsVolumeRestore) DeepCopy() *GcpNfsVolumeRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRestoreDestination) DeepCopyInto(out *GcpNfsVolumeRestoreDestination) {
+	*out = *in
+	out.Volume = in.Volume
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreDestination.
+func (in *GcpNfsVolumeRestoreDestination) DeepCopy() *GcpNfsVolumeRestoreDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRestoreDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRestoreList) DeepCopyInto(out *GcpNfsVolumeRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpNfsVolumeRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreList.
+func (in *GcpNfsVolumeRestoreList) DeepCopy() *GcpNfsVolumeRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpNfsVolumeRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRestoreSource) DeepCopyInto(out *GcpNfsVolumeRestoreSource) {
+	*out = *in
+	out.Backup = in.Backup
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreSource.
+func (in *GcpNfsVolumeRestoreSource) DeepCopy() *GcpNfsVolumeRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRestoreSpec) DeepCopyInto(out *GcpNfsVolumeRestoreSpec) {
+	*out = *in
+	out.Source = in.Source
+	out.Destination = in.Destination
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreSpec.
+func (in *GcpNfsVolumeRestoreSpec) DeepCopy() *GcpNfsVolumeRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeRestoreStatus) DeepCopyInto(out *GcpNfsVolumeRestoreStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreStatus.
+func (in *GcpNfsVolumeRestoreStatus) DeepCopy() *GcpNfsVolumeRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeSpec) DeepCopyInto(out *GcpNfsVolumeSpec) {
+	*out = *in
+	out.IpRange = in.IpRange
+	out.SourceBackup = in.SourceBackup
+	if in.PersistentVolume != nil {
+		in, out := &in.PersistentVolume, &out.PersistentVolume
+		*out = new(GcpNfsVolumePvSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(GcpNfsVolumePvcSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeSpec.
+func (in *GcpNfsVolumeSpec) DeepCopy() *GcpNfsVolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpNfsVolumeStatus) DeepCopyInto(out *GcpNfsVolumeStatus) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeStatus.
+func (in *GcpNfsVolumeStatus) DeepCopy() *GcpNfsVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpNfsVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpPostgresInstance) DeepCopyInto(out *GcpPostgresInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpPostgresInstance.
+func (in *GcpPostgresInstance) DeepCopy() *GcpPostgresInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpPostgresInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpPostgresInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpPostgresInstanceList) DeepCopyInto(out *GcpPostgresInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpPostgresInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpPostgresInstanceList.
+func (in *GcpPostgresInstanceList) DeepCopy() *GcpPostgresInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpPostgresInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpPostgresInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpPostgresInstanceSpec) DeepCopyInto(out *GcpPostgresInstanceSpec) {
+	*out = *in
+	out.IpRange = in.IpRange
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(PostgresAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpPostgresInstanceSpec.
+func (in *GcpPostgresInstanceSpec) DeepCopy() *GcpPostgresInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpPostgresInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpPostgresInstanceStatus) DeepCopyInto(out *GcpPostgresInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpPostgresInstanceStatus.
+func (in *GcpPostgresInstanceStatus) DeepCopy() *GcpPostgresInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpPostgresInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpRedisBackupSchedule) DeepCopyInto(out *GcpRedisBackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisBackupSchedule.
+func (in *GcpRedisBackupSchedule) DeepCopy() *GcpRedisBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpRedisBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpRedisBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpRedisBackupScheduleList) DeepCopyInto(out *GcpRedisBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpRedisBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisBackupScheduleList.
+func (in *GcpRedisBackupScheduleList) DeepCopy() *GcpRedisBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpRedisBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpRedisBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpRedisBackupScheduleSpec) DeepCopyInto(out *GcpRedisBackupScheduleSpec) {
+	*out = *in
+	out.RedisInstanceRef = in.RedisInstanceRef
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisBackupScheduleSpec.
+func (in *GcpRedisBackupScheduleSpec) DeepCopy() *GcpRedisBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpRedisBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpRedisBackupScheduleStatus) DeepCopyInto(out *GcpRedisBackupScheduleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextRunTimes != nil {
+		in, out := &in.NextRunTimes, &out.NextRunTimes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextDeleteTimes != nil {
+		in, out := &in.NextDeleteTimes, &out.NextDeleteTimes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastCreateRun != nil {
+		in, out := &in.LastCreateRun, &out.LastCreateRun
+		*out = (*in).DeepCopy()
+	}
+	out.LastCreatedBackup = in.LastCreatedBackup
+	if in.LastDeleteRun != nil {
+		in, out := &in.LastDeleteRun, &out.LastDeleteRun
+		*out = (*in).DeepCopy()
+	}
+	if in.LastDeletedBackups != nil {
+		in, out := &in.LastDeletedBackups, &out.LastDeletedBackups
+		*out = make([]corev1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRef.
-func (in *GcpNfsVolumeRef) DeepCopy() *GcpNfsVolumeRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisBackupScheduleStatus.
+func (in *GcpRedisBackupScheduleStatus) DeepCopy() *GcpRedisBackupScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeRef)
+	out := new(GcpRedisBackupScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRestore) DeepCopyInto(out *GcpNfsVolumeRestore) {
+func (in *GcpRedisCluster) DeepCopyInto(out *GcpRedisCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestore.
-func (in *GcpNfsVolumeRestore) DeepCopy() *GcpNfsVolumeRestore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisCluster.
+func (in *GcpRedisCluster) DeepCopy() *GcpRedisCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeRestore)
+	out := new(GcpRedisCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeRestore) DeepCopyObject() runtime.Object {
+func (in *GcpRedisCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2373,47 +4079,31 @@ func (in *GcpNfsVolumeRestore) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRestoreDestination) DeepCopyInto(out *GcpNfsVolumeRestoreDestination) {
-	*out = *in
-	out.Volume = in.Volume
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreDestination.
-func (in *GcpNfsVolumeRestoreDestination) DeepCopy() *GcpNfsVolumeRestoreDestination {
-	if in == nil {
-		return nil
-	}
-	out := new(GcpNfsVolumeRestoreDestination)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRestoreList) DeepCopyInto(out *GcpNfsVolumeRestoreList) {
+func (in *GcpRedisClusterList) DeepCopyInto(out *GcpRedisClusterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GcpNfsVolumeRestore, len(*in))
+		*out = make([]GcpRedisCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreList.
-func (in *GcpNfsVolumeRestoreList) DeepCopy() *GcpNfsVolumeRestoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisClusterList.
+func (in *GcpRedisClusterList) DeepCopy() *GcpRedisClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeRestoreList)
+	out := new(GcpRedisClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpNfsVolumeRestoreList) DeepCopyObject() runtime.Object {
+func (in *GcpRedisClusterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2421,168 +4111,205 @@ func (in *GcpNfsVolumeRestoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRestoreSource) DeepCopyInto(out *GcpNfsVolumeRestoreSource) {
+func (in *GcpRedisClusterSpec) DeepCopyInto(out *GcpRedisClusterSpec) {
 	*out = *in
-	out.Backup = in.Backup
+	out.Subnet = in.Subnet
+	if in.RedisConfigs != nil {
+		in, out := &in.RedisConfigs, &out.RedisConfigs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(RedisAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreSource.
-func (in *GcpNfsVolumeRestoreSource) DeepCopy() *GcpNfsVolumeRestoreSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisClusterSpec.
+func (in *GcpRedisClusterSpec) DeepCopy() *GcpRedisClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeRestoreSource)
+	out := new(GcpRedisClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRestoreSpec) DeepCopyInto(out *GcpNfsVolumeRestoreSpec) {
+func (in *GcpRedisClusterStatus) DeepCopyInto(out *GcpRedisClusterStatus) {
 	*out = *in
-	out.Source = in.Source
-	out.Destination = in.Destination
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreSpec.
-func (in *GcpNfsVolumeRestoreSpec) DeepCopy() *GcpNfsVolumeRestoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisClusterStatus.
+func (in *GcpRedisClusterStatus) DeepCopy() *GcpRedisClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeRestoreSpec)
+	out := new(GcpRedisClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeRestoreStatus) DeepCopyInto(out *GcpNfsVolumeRestoreStatus) {
+func (in *GcpRedisInstance) DeepCopyInto(out *GcpRedisInstance) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeRestoreStatus.
-func (in *GcpNfsVolumeRestoreStatus) DeepCopy() *GcpNfsVolumeRestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstance.
+func (in *GcpRedisInstance) DeepCopy() *GcpRedisInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeRestoreStatus)
+	out := new(GcpRedisInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpRedisInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeSpec) DeepCopyInto(out *GcpNfsVolumeSpec) {
+func (in *GcpRedisInstanceBackup) DeepCopyInto(out *GcpRedisInstanceBackup) {
 	*out = *in
-	out.IpRange = in.IpRange
-	out.SourceBackup = in.SourceBackup
-	if in.PersistentVolume != nil {
-		in, out := &in.PersistentVolume, &out.PersistentVolume
-		*out = new(GcpNfsVolumePvSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PersistentVolumeClaim != nil {
-		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
-		*out = new(GcpNfsVolumePvcSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeSpec.
-func (in *GcpNfsVolumeSpec) DeepCopy() *GcpNfsVolumeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceBackup.
+func (in *GcpRedisInstanceBackup) DeepCopy() *GcpRedisInstanceBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeSpec)
+	out := new(GcpRedisInstanceBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpRedisInstanceBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpNfsVolumeStatus) DeepCopyInto(out *GcpNfsVolumeStatus) {
+func (in *GcpRedisInstanceBackupList) DeepCopyInto(out *GcpRedisInstanceBackupList) {
 	*out = *in
-	if in.Hosts != nil {
-		in, out := &in.Hosts, &out.Hosts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	out.Capacity = in.Capacity.DeepCopy()
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GcpRedisInstanceBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpNfsVolumeStatus.
-func (in *GcpNfsVolumeStatus) DeepCopy() *GcpNfsVolumeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceBackupList.
+func (in *GcpRedisInstanceBackupList) DeepCopy() *GcpRedisInstanceBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpNfsVolumeStatus)
+	out := new(GcpRedisInstanceBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GcpRedisInstanceBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisCluster) DeepCopyInto(out *GcpRedisCluster) {
+func (in *GcpRedisInstanceBackupSpec) DeepCopyInto(out *GcpRedisInstanceBackupSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Source = in.Source
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisCluster.
-func (in *GcpRedisCluster) DeepCopy() *GcpRedisCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceBackupSpec.
+func (in *GcpRedisInstanceBackupSpec) DeepCopy() *GcpRedisInstanceBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisCluster)
+	out := new(GcpRedisInstanceBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpRedisCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpRedisInstanceBackupStatus) DeepCopyInto(out *GcpRedisInstanceBackupStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceBackupStatus.
+func (in *GcpRedisInstanceBackupStatus) DeepCopy() *GcpRedisInstanceBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GcpRedisInstanceBackupStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisClusterList) DeepCopyInto(out *GcpRedisClusterList) {
+func (in *GcpRedisInstanceList) DeepCopyInto(out *GcpRedisInstanceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GcpRedisCluster, len(*in))
+		*out = make([]GcpRedisInstance, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisClusterList.
-func (in *GcpRedisClusterList) DeepCopy() *GcpRedisClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceList.
+func (in *GcpRedisInstanceList) DeepCopy() *GcpRedisInstanceList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisClusterList)
+	out := new(GcpRedisInstanceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpRedisClusterList) DeepCopyObject() runtime.Object {
+func (in *GcpRedisInstanceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2590,9 +4317,9 @@ func (in *GcpRedisClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisClusterSpec) DeepCopyInto(out *GcpRedisClusterSpec) {
+func (in *GcpRedisInstanceSpec) DeepCopyInto(out *GcpRedisInstanceSpec) {
 	*out = *in
-	out.Subnet = in.Subnet
+	out.IpRange = in.IpRange
 	if in.RedisConfigs != nil {
 		in, out := &in.RedisConfigs, &out.RedisConfigs
 		*out = make(map[string]string, len(*in))
@@ -2605,20 +4332,30 @@ func (in *GcpRedisClusterSpec) DeepCopyInto(out *GcpRedisClusterSpec) {
 		*out = new(RedisAuthSecretSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceBackup != nil {
+		in, out := &in.SourceBackup, &out.SourceBackup
+		*out = new(RedisInstanceBackupRef)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisClusterSpec.
-func (in *GcpRedisClusterSpec) DeepCopy() *GcpRedisClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceSpec.
+func (in *GcpRedisInstanceSpec) DeepCopy() *GcpRedisInstanceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisClusterSpec)
+	out := new(GcpRedisInstanceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisClusterStatus) DeepCopyInto(out *GcpRedisClusterStatus) {
+func (in *GcpRedisInstanceStatus) DeepCopyInto(out *GcpRedisInstanceStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2629,18 +4366,18 @@ func (in *GcpRedisClusterStatus) DeepCopyInto(out *GcpRedisClusterStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisClusterStatus.
-func (in *GcpRedisClusterStatus) DeepCopy() *GcpRedisClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceStatus.
+func (in *GcpRedisInstanceStatus) DeepCopy() *GcpRedisInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisClusterStatus)
+	out := new(GcpRedisInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisInstance) DeepCopyInto(out *GcpRedisInstance) {
+func (in *GcpStorageBucket) DeepCopyInto(out *GcpStorageBucket) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2648,18 +4385,18 @@ func (in *GcpRedisInstance) DeepCopyInto(out *GcpRedisInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstance.
-func (in *GcpRedisInstance) DeepCopy() *GcpRedisInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpStorageBucket.
+func (in *GcpStorageBucket) DeepCopy() *GcpStorageBucket {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisInstance)
+	out := new(GcpStorageBucket)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpRedisInstance) DeepCopyObject() runtime.Object {
+func (in *GcpStorageBucket) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2667,72 +4404,64 @@ func (in *GcpRedisInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisInstanceList) DeepCopyInto(out *GcpRedisInstanceList) {
+func (in *GcpStorageBucketList) DeepCopyInto(out *GcpStorageBucketList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GcpRedisInstance, len(*in))
+		*out = make([]GcpStorageBucket, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceList.
-func (in *GcpRedisInstanceList) DeepCopy() *GcpRedisInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpStorageBucketList.
+func (in *GcpStorageBucketList) DeepCopy() *GcpStorageBucketList {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisInstanceList)
+	out := new(GcpStorageBucketList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GcpRedisInstanceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisInstanceSpec) DeepCopyInto(out *GcpRedisInstanceSpec) {
-	*out = *in
-	out.IpRange = in.IpRange
-	if in.RedisConfigs != nil {
-		in, out := &in.RedisConfigs, &out.RedisConfigs
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+func (in *GcpStorageBucketList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcpStorageBucketSpec) DeepCopyInto(out *GcpStorageBucketSpec) {
+	*out = *in
 	if in.AuthSecret != nil {
 		in, out := &in.AuthSecret, &out.AuthSecret
-		*out = new(RedisAuthSecretSpec)
+		*out = new(BucketAuthSecretSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MaintenancePolicy != nil {
-		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
-		*out = new(MaintenancePolicy)
-		(*in).DeepCopyInto(*out)
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = make([]BucketLifecycleRule, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceSpec.
-func (in *GcpRedisInstanceSpec) DeepCopy() *GcpRedisInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpStorageBucketSpec.
+func (in *GcpStorageBucketSpec) DeepCopy() *GcpStorageBucketSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisInstanceSpec)
+	out := new(GcpStorageBucketSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GcpRedisInstanceStatus) DeepCopyInto(out *GcpRedisInstanceStatus) {
+func (in *GcpStorageBucketStatus) DeepCopyInto(out *GcpStorageBucketStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2743,12 +4472,12 @@ func (in *GcpRedisInstanceStatus) DeepCopyInto(out *GcpRedisInstanceStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpRedisInstanceStatus.
-func (in *GcpRedisInstanceStatus) DeepCopy() *GcpRedisInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcpStorageBucketStatus.
+func (in *GcpStorageBucketStatus) DeepCopy() *GcpStorageBucketStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GcpRedisInstanceStatus)
+	out := new(GcpStorageBucketStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3120,6 +4849,42 @@ func (in *NameLabelsAnnotationsSpec) DeepCopy() *NameLabelsAnnotationsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresAuthSecretSpec) DeepCopyInto(out *PostgresAuthSecretSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraData != nil {
+		in, out := &in.ExtraData, &out.ExtraData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresAuthSecretSpec.
+func (in *PostgresAuthSecretSpec) DeepCopy() *PostgresAuthSecretSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresAuthSecretSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PvcRef) DeepCopyInto(out *PvcRef) {
 	*out = *in
@@ -3151,6 +4916,21 @@ func (in *PvcSource) DeepCopy() *PvcSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsage) DeepCopyInto(out *QuotaUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsage.
+func (in *QuotaUsage) DeepCopy() *QuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisAuthSecretSpec) DeepCopyInto(out *RedisAuthSecretSpec) {
 	*out = *in
@@ -3217,6 +4997,52 @@ func (in *RedisInstanceAzureConfigs) DeepCopy() *RedisInstanceAzureConfigs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceBackupRef) DeepCopyInto(out *RedisInstanceBackupRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceBackupRef.
+func (in *RedisInstanceBackupRef) DeepCopy() *RedisInstanceBackupRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceBackupRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceBackupSource) DeepCopyInto(out *RedisInstanceBackupSource) {
+	*out = *in
+	out.RedisInstance = in.RedisInstance
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceBackupSource.
+func (in *RedisInstanceBackupSource) DeepCopy() *RedisInstanceBackupSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceBackupSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisInstanceRef) DeepCopyInto(out *RedisInstanceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisInstanceRef.
+func (in *RedisInstanceRef) DeepCopy() *RedisInstanceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisInstanceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SapNfsVolume) DeepCopyInto(out *SapNfsVolume) {
 	*out = *in
@@ -3782,6 +5608,204 @@ func (in *SapNfsVolumeStatus) DeepCopy() *SapNfsVolumeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapRedisInstance) DeepCopyInto(out *SapRedisInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapRedisInstance.
+func (in *SapRedisInstance) DeepCopy() *SapRedisInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(SapRedisInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SapRedisInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapRedisInstanceList) DeepCopyInto(out *SapRedisInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SapRedisInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapRedisInstanceList.
+func (in *SapRedisInstanceList) DeepCopy() *SapRedisInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SapRedisInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SapRedisInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapRedisInstanceSpec) DeepCopyInto(out *SapRedisInstanceSpec) {
+	*out = *in
+	out.IpRange = in.IpRange
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(RedisAuthSecretSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapRedisInstanceSpec.
+func (in *SapRedisInstanceSpec) DeepCopy() *SapRedisInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SapRedisInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapRedisInstanceStatus) DeepCopyInto(out *SapRedisInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapRedisInstanceStatus.
+func (in *SapRedisInstanceStatus) DeepCopy() *SapRedisInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SapRedisInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapVpcPeering) DeepCopyInto(out *SapVpcPeering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapVpcPeering.
+func (in *SapVpcPeering) DeepCopy() *SapVpcPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(SapVpcPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SapVpcPeering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapVpcPeeringList) DeepCopyInto(out *SapVpcPeeringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SapVpcPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapVpcPeeringList.
+func (in *SapVpcPeeringList) DeepCopy() *SapVpcPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(SapVpcPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SapVpcPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapVpcPeeringSpec) DeepCopyInto(out *SapVpcPeeringSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapVpcPeeringSpec.
+func (in *SapVpcPeeringSpec) DeepCopy() *SapVpcPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SapVpcPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SapVpcPeeringStatus) DeepCopyInto(out *SapVpcPeeringStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SapVpcPeeringStatus.
+func (in *SapVpcPeeringStatus) DeepCopy() *SapVpcPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SapVpcPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeOfDay) DeepCopyInto(out *TimeOfDay) {
 	*out = *in
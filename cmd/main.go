@@ -22,11 +22,17 @@ import (
 	"fmt"
 	"os"
 
+	awsruntime "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/runtime"
+	awsruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/runtime/client"
 	awsvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork"
 	awsvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork/client"
+	azureruntime "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/runtime"
+	azureruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/runtime/client"
 	azurevpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcnetwork"
 	azurevpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcnetwork/client"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	gcpruntime "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/runtime"
+	gcpruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/runtime/client"
 	gcpvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcnetwork"
 	gcpvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcnetwork/client"
 	sapvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcnetwork"
@@ -55,21 +61,26 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	featuretypes "github.com/kyma-project/cloud-manager/pkg/feature/types"
+	awsbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket/client"
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/exposedData/client"
 	awsiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/iprange/client"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
 	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
 	awsvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering/client"
+	azurebucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket/client"
 	azureexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/exposedData/client"
 	azureiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/iprange/client"
 	azurenetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/network/client"
 	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	azurepostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance/client"
+	azureredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisbackup/client"
 	azureredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/rediscluster/client"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
 	azurevnetlinkdnsresolverclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vnetlink/dnsresolver/client"
 	azurevnetlinkdnszoneclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vnetlink/dnszone/client"
 	azurevpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering/client"
+	gcpbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket/client"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/exposedData/client"
 	gcpiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/iprange/client"
@@ -79,11 +90,16 @@ import (
 	gcpnfsinstancev2client "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsinstance/v2/client"
 	gcpnfsrestoreclientv1 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsrestore/client/v1"
 	gcpnfsrestoreclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsrestore/client/v2"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcppostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance/client"
+	gcpredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisbackup/client"
 	gcpredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/rediscluster/client"
 	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
 	gcpsubnetclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/subnet/client"
 	gcpvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcpeering/client"
 	sapnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nfsinstance/client"
+	sapredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance/client"
+	sapvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering/client"
 	scopeclient "github.com/kyma-project/cloud-manager/pkg/kcp/scope/client"
 	subscriptionclient "github.com/kyma-project/cloud-manager/pkg/kcp/subscription/client"
 	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
@@ -91,8 +107,10 @@ import (
 	azurerwxpvclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxpv/client"
 	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	skrruntimeconfig "github.com/kyma-project/cloud-manager/pkg/skr/runtime/config"
 	"github.com/kyma-project/cloud-manager/pkg/skr/sapnfsvolumesnapshot"
 	"github.com/kyma-project/cloud-manager/pkg/skr/sapnfsvolumesnapshotrestore"
+	skrwebhook "github.com/kyma-project/cloud-manager/pkg/skr/webhook"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
@@ -297,6 +315,41 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = cloudresourcescontroller.SetupSapRedisInstanceReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SapRedisInstance")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAwsPostgresInstanceReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AwsPostgresInstance")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupGcpPostgresInstanceReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GcpPostgresInstance")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAzurePostgresInstanceReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzurePostgresInstance")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAwsS3BucketReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AwsS3Bucket")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupGcpStorageBucketReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GcpStorageBucket")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAzureBlobContainerReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzureBlobContainer")
+		os.Exit(1)
+	}
+
 	if err = cloudresourcescontroller.SetupAwsRedisClusterReconciler(skrRegistry); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AwsRedisCluster")
 		os.Exit(1)
@@ -342,16 +395,55 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = cloudresourcescontroller.SetupSapVpcPeeringReconciler(skrRegistry); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SapVpcPeering")
+		os.Exit(1)
+	}
+
 	if err = cloudresourcescontroller.SetupAwsNfsVolumeBackupReconciler(skrRegistry, awsnfsvolumebackupclient.NewClientProvider(), env); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AwsNfsVolumeBackup")
 		os.Exit(1)
 	}
+	if err = cloudresourcescontroller.SetupAwsNfsVolumeBackupDiscoveryReconciler(skrRegistry, awsnfsvolumebackupclient.NewClientProvider()); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AwsNfsVolumeBackupDiscovery")
+		os.Exit(1)
+	}
 
 	if err = cloudresourcescontroller.SetupAwsNfsBackupScheduleReconciler(skrRegistry, env); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AwsNfsBackupSchedule")
 		os.Exit(1)
 	}
 
+	if err = cloudresourcescontroller.SetupAwsRedisInstanceBackupReconciler(skrRegistry, awsclient.NewElastiCacheClientProvider(), env); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AwsRedisInstanceBackup")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAwsRedisBackupScheduleReconciler(skrRegistry, env); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AwsRedisBackupSchedule")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupGcpRedisInstanceBackupReconciler(skrRegistry, gcpredisbackupclient.NewClientProvider(gcpClients), env); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GcpRedisInstanceBackup")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupGcpRedisBackupScheduleReconciler(skrRegistry, env); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GcpRedisBackupSchedule")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAzureRedisInstanceBackupReconciler(skrRegistry, azureredisbackupclient.NewClientProvider(), env); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzureRedisInstanceBackup")
+		os.Exit(1)
+	}
+
+	if err = cloudresourcescontroller.SetupAzureRedisBackupScheduleReconciler(skrRegistry, env); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzureRedisBackupSchedule")
+		os.Exit(1)
+	}
+
 	if err = cloudresourcescontroller.SetupAwsNfsVolumeRestoreReconciler(skrRegistry, awsnfsvolumerestoreclient.NewClientProvider(), env); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AwsNfsVolumeRestore")
 		os.Exit(1)
@@ -422,6 +514,7 @@ func main() {
 		awsvpcpeeringclient.NewClientProvider(),
 		azurevpcpeeringclient.NewClientProvider(),
 		gcpvpcpeeringclient.NewClientProvider(gcpClients),
+		sapvpcpeeringclient.NewClientProvider(),
 		env,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VpcPeering")
@@ -447,11 +540,30 @@ func main() {
 		gcpredisinstanceclient.NewMemorystoreClientProvider(gcpClients),
 		azureredisinstanceclient.NewClientProvider(),
 		awsclient.NewElastiCacheClientProvider(),
+		sapredisinstanceclient.NewClientProvider(),
 		env,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RedisInstance")
 		os.Exit(1)
 	}
+	if err = cloudcontrolcontroller.SetupPostgresInstanceReconciler(
+		mgr,
+		awsclient.NewRdsClientProvider(),
+		gcppostgresinstanceclient.NewClientProvider(gcpClients),
+		azurepostgresinstanceclient.NewClientProvider(),
+	); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PostgresInstance")
+		os.Exit(1)
+	}
+	if err = cloudcontrolcontroller.SetupBucketReconciler(
+		mgr,
+		awsbucketclient.NewClientProvider(),
+		gcpbucketclient.NewClientProvider(gcpClients),
+		azurebucketclient.NewClientProvider(),
+	); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Bucket")
+		os.Exit(1)
+	}
 	if err = cloudcontrolcontroller.SetupNetworkReconciler(
 		ctx,
 		mgr,
@@ -466,6 +578,9 @@ func main() {
 		gcpnfsbackupclientv1.NewFileBackupClientProvider(),
 		awsnukeclient.NewClientProvider(),
 		azurenukeclient.NewClientProvider(),
+		gcpnukeclient.NewOrphanClientProvider(gcpClients),
+		awsnukeclient.NewOrphanClientProvider(),
+		azurenukeclient.NewOrphanClientProvider(),
 		env,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Nuke")
@@ -528,12 +643,22 @@ func main() {
 	if err = cloudcontrolcontroller.SetupRuntimeReconciler(
 		ctx,
 		mgr,
+		awsruntime.NewStateFactory(awsruntimeclient.NewClientProvider()),
+		azureruntime.NewStateFactory(azureruntimeclient.NewClientProvider()),
+		gcpruntime.NewStateFactory(gcpruntimeclient.NewClientProvider(gcpClients)),
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Runtime")
 		os.Exit(1)
 	}
 	//+kubebuilder:scaffold:builder
 
+	if skrruntimeconfig.SkrRuntimeConfig.WebhooksEnabled() {
+		if err := skrwebhook.SetupWithServer(mgr.GetWebhookServer(), skrScheme, []byte(skrruntimeconfig.SkrRuntimeConfig.WebhookSecret)); err != nil {
+			setupLog.Error(err, "unable to set up SKR webhooks")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
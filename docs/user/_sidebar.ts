@@ -7,10 +7,12 @@ export default [
   { text: 'Redis', link: './00-40-redis' },
   { text: 'Resources', link: './resources/README', collapsed: true, items: [
     { text: 'IpRange Custom Resource', link: './resources/04-10-iprange' },
+    { text: 'CloudNetworkInfo Custom Resource', link: './resources/04-11-cloud-network-info' },
     { text: 'AwsNfsVolume Custom Resource', link: './resources/04-20-10-aws-nfs-volume' },
     { text: 'AwsNfsVolumeBackup Custom Resource', link: './resources/04-20-11-aws-nfs-volume-backup' },
     { text: 'AwsNfsBackupSchedule Custom Resource', link: './resources/04-20-12-aws-nfs-backup-schedule' },
     { text: 'AwsNfsVolumeRestore Custom Resource', link: './resources/04-20-13-aws-nfs-volume-restore' },
+    { text: 'AwsNfsVolumeBackupDiscovery Custom Resource', link: './resources/04-20-14-aws-nfs-volume-backup-discovery' },
     { text: 'GcpNfsVolume Custom Resource', link: './resources/04-20-20-gcp-nfs-volume' },
     { text: 'GcpNfsVolumeBackup Custom Resource', link: './resources/04-20-21-gcp-nfs-volume-backup' },
     { text: 'GcpNfsBackupSchedule Custom Resource', link: './resources/04-20-22-gcp-nfs-backup-schedule' },
@@ -19,13 +21,27 @@ export default [
     { text: 'AwsVpcPeering Custom Resource', link: './resources/04-30-10-aws-vpc-peering' },
     { text: 'GcpVpcPeering Custom Resource', link: './resources/04-30-20-gcp-vpc-peering' },
     { text: 'AzureVpcPeering Custom Resource', link: './resources/04-30-30-azure-vpc-peering' },
+    { text: 'SapVpcPeering Custom Resource', link: './resources/04-30-40-sap-vpc-peering' },
     { text: 'AwsRedisInstance Custom Resource', link: './resources/04-40-10-aws-redis-instance' },   
+    { text: 'AwsRedisInstanceBackup Custom Resource', link: './resources/04-40-11-aws-redis-instance-backup' },
+    { text: 'AwsRedisBackupSchedule Custom Resource', link: './resources/04-40-12-aws-redis-backup-schedule' },
     { text: 'GcpRedisInstance Custom Resource', link: './resources/04-40-20-gcp-redis-instance' },
+    { text: 'GcpRedisInstanceBackup Custom Resource', link: './resources/04-40-21-gcp-redis-instance-backup' },
+    { text: 'GcpRedisBackupSchedule Custom Resource', link: './resources/04-40-22-gcp-redis-backup-schedule' },
     { text: 'AzureRedisInstance Custom Resource', link: './resources/04-40-30-azure-redis-instance' },
+    { text: 'AzureRedisInstanceBackup Custom Resource', link: './resources/04-40-31-azure-redis-instance-backup' },
+    { text: 'AzureRedisBackupSchedule Custom Resource', link: './resources/04-40-32-azure-redis-backup-schedule' },
+    { text: 'SapRedisInstance Custom Resource', link: './resources/04-40-50-sap-redis-instance' },
     { text: 'AwsRedisCluster Custom Resource', link: './resources/04-50-10-aws-redis-cluster' },   
     { text: 'GcpRedisCluster Custom Resource', link: './resources/04-50-20-gcp-redis-cluster' },
     { text: 'GcpSubnet Custom Resource', link: './resources/04-50-21-gcp-subnet' },
     { text: 'AzureRedisCluster Custom Resource', link: './resources/04-50-30-azure-redis-cluster' },
+    { text: 'AwsPostgresInstance Custom Resource', link: './resources/04-60-10-aws-postgres-instance' },
+    { text: 'GcpPostgresInstance Custom Resource', link: './resources/04-60-20-gcp-postgres-instance' },
+    { text: 'AzurePostgresInstance Custom Resource', link: './resources/04-60-30-azure-postgres-instance' },
+    { text: 'AwsS3Bucket Custom Resource', link: './resources/04-70-10-aws-s3-bucket' },
+    { text: 'GcpStorageBucket Custom Resource', link: './resources/04-70-20-gcp-storage-bucket' },
+    { text: 'AzureBlobContainer Custom Resource', link: './resources/04-70-30-azure-blob-container' },
     { text: 'SapNfsVolume Custom Resource', link: './resources/04-20-50-sap-nfs-volume' },
     { text: 'AzureVpcDnsLink Custom Resource', link: './resources/04-40-40-azure-vpc-dns-link' }
     ] },
@@ -0,0 +1,70 @@
+package api_tests
+
+import (
+	"github.com/google/uuid"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+type redisInstanceBuilderAzure struct {
+	instance *cloudcontrolv1beta1.RedisInstance
+}
+
+func newRedisInstanceBuilderAzure(family string, capacity int) *redisInstanceBuilderAzure {
+	return &redisInstanceBuilderAzure{
+		instance: &cloudcontrolv1beta1.RedisInstance{
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				RemoteRef: cloudcontrolv1beta1.RemoteRef{
+					Name:      uuid.NewString(),
+					Namespace: "default",
+				},
+				IpRange: cloudcontrolv1beta1.IpRangeRef{
+					Name: uuid.NewString(),
+				},
+				Scope: cloudcontrolv1beta1.ScopeRef{
+					Name: uuid.NewString(),
+				},
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					Azure: &cloudcontrolv1beta1.RedisInstanceAzure{
+						SKU: cloudcontrolv1beta1.AzureRedisSKU{
+							Family:   family,
+							Capacity: capacity,
+						},
+						RedisVersion: "6.0",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *redisInstanceBuilderAzure) Build() *cloudcontrolv1beta1.RedisInstance {
+	return b.instance
+}
+
+var _ = Describe("Feature: KCP RedisInstance Azure", Ordered, func() {
+
+	canNotChangeKcp(
+		"RedisInstance Azure SKU family can not be changed from S to P",
+		newRedisInstanceBuilderAzure("S", 1),
+		func(b Builder[*cloudcontrolv1beta1.RedisInstance]) {
+			b.(*redisInstanceBuilderAzure).instance.Spec.Instance.Azure.SKU.Family = "P"
+		},
+		"Family is immutable",
+	)
+	canNotChangeKcp(
+		"RedisInstance Azure SKU family can not be changed from P to S",
+		newRedisInstanceBuilderAzure("P", 1),
+		func(b Builder[*cloudcontrolv1beta1.RedisInstance]) {
+			b.(*redisInstanceBuilderAzure).instance.Spec.Instance.Azure.SKU.Family = "S"
+		},
+		"Family is immutable",
+	)
+	canChangeKcp(
+		"RedisInstance Azure SKU capacity can be changed",
+		newRedisInstanceBuilderAzure("P", 1),
+		func(b Builder[*cloudcontrolv1beta1.RedisInstance]) {
+			b.(*redisInstanceBuilderAzure).instance.Spec.Instance.Azure.SKU.Capacity = 3
+		},
+	)
+})
@@ -48,3 +48,49 @@ var _ = Describe("Feature: KCP RedisInstance GCP", Ordered, func() {
 	canNotCreateKcp("RedisInstance GCP can not be created with zero replicas if tier is STANDARD_HA", &redisInstanceBuilderGcp{tier: "STANDARD_HA", replicaCount: 0, memorySizeGb: 16}, "")
 	canNotCreateKcp("RedisInstance GCP can not be created with memory size under 5GiB if tier is STANDARD_HA", &redisInstanceBuilderGcp{tier: "STANDARD_HA", replicaCount: 0, memorySizeGb: 1}, "")
 })
+
+// redisInstanceChangeBuilderGcp keeps the built RedisInstance, so it can be changed after it is created
+type redisInstanceChangeBuilderGcp struct {
+	instance *cloudcontrolv1beta1.RedisInstance
+}
+
+func newRedisInstanceChangeBuilderGcp(tier string, replicaCount int32, memorySizeGb int32) *redisInstanceChangeBuilderGcp {
+	return &redisInstanceChangeBuilderGcp{
+		instance: (&redisInstanceBuilderGcp{tier: tier, replicaCount: replicaCount, memorySizeGb: memorySizeGb}).Build(),
+	}
+}
+
+func (b *redisInstanceChangeBuilderGcp) Build() *cloudcontrolv1beta1.RedisInstance {
+	return b.instance
+}
+
+var _ = Describe("Feature: KCP RedisInstance GCP tier change", Ordered, func() {
+
+	canNotChangeKcp(
+		"RedisInstance GCP tier can not be changed from BASIC to STANDARD_HA",
+		newRedisInstanceChangeBuilderGcp("BASIC", 0, 16),
+		func(b Builder[*cloudcontrolv1beta1.RedisInstance]) {
+			gcp := b.(*redisInstanceChangeBuilderGcp).instance.Spec.Instance.Gcp
+			gcp.Tier = "STANDARD_HA"
+			gcp.ReplicaCount = 1
+		},
+		"Tier is immutable",
+	)
+	canNotChangeKcp(
+		"RedisInstance GCP tier can not be changed from STANDARD_HA to BASIC",
+		newRedisInstanceChangeBuilderGcp("STANDARD_HA", 1, 16),
+		func(b Builder[*cloudcontrolv1beta1.RedisInstance]) {
+			gcp := b.(*redisInstanceChangeBuilderGcp).instance.Spec.Instance.Gcp
+			gcp.Tier = "BASIC"
+			gcp.ReplicaCount = 0
+		},
+		"Tier is immutable",
+	)
+	canChangeKcp(
+		"RedisInstance GCP memorySizeGb can be changed",
+		newRedisInstanceChangeBuilderGcp("BASIC", 0, 16),
+		func(b Builder[*cloudcontrolv1beta1.RedisInstance]) {
+			b.(*redisInstanceChangeBuilderGcp).instance.Spec.Instance.Gcp.MemorySizeGb = 32
+		},
+	)
+})
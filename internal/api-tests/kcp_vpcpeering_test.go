@@ -22,6 +22,21 @@ var _ = Describe("Feature: KCP VpcPeering", func() {
 			WithGcpPeering("peering", "project", "vpc", true),
 	)
 
+	canCreateKcp(
+		"VpcPeering with network details and OpenStack peering mode can be created",
+		nb().WithScope("s").WithRemoteRef("ns", "n").
+			WithDetails("loc", "loc-ns", "rem", "rem-ns", "name", false, false).
+			WithOpenStackPeeringMode(cloudcontrolv1beta1.OpenStackVpcPeeringModeNetworkRbac),
+	)
+
+	canNotCreateKcp(
+		"VpcPeering with unknown OpenStack peering mode can not be created",
+		nb().WithScope("s").WithRemoteRef("ns", "n").
+			WithDetails("loc", "loc-ns", "rem", "rem-ns", "name", false, false).
+			WithOpenStackPeeringMode("unknown"),
+		"openStackPeeringMode",
+	)
+
 	canCreateKcp(
 		"VpcPeering with network details",
 		nb().WithScope("s").WithRemoteRef("ns", "n").
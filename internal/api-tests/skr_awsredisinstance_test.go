@@ -95,6 +95,19 @@ var _ = Describe("Feature: SKR AwsRedisInstance", Ordered, func() {
 		"",
 	)
 
+	for _, engineVersion := range []string{"6.x", "7.0", "7.1"} {
+		canCreateSkr(
+			fmt.Sprintf("AwsRedisInstance can be created with premium tier and engineVersion %s", engineVersion),
+			newTestAwsRedisInstanceBuilder().WithRedisTier(cloudresourcesv1beta1.AwsRedisTierP1).WithEngineVersion(engineVersion),
+		)
+	}
+
+	canNotCreateSkr(
+		"AwsRedisInstance cannot be created with premium tier and engineVersion older than 6.2",
+		newTestAwsRedisInstanceBuilder().WithRedisTier(cloudresourcesv1beta1.AwsRedisTierP1).WithEngineVersion("6.0"),
+		"redisTier other than S1 and S2 requires engineVersion 6.2 or newer.",
+	)
+
 	allowedVersionUpgrades := [][]string{
 		{"6.x", "7.0"},
 		{"6.x", "7.1"},
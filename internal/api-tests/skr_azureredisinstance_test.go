@@ -156,4 +156,24 @@ var _ = Describe("Feature: SKR AzureRedisInstance", Ordered, func() {
 			},
 		)
 	})
+
+	Context("Scenario: redisTier mutability", func() {
+
+		canChangeSkr(
+			"AzureRedisInstance redisTier can be changed within the service tier",
+			newTestAzureRedisInstanceBuilder(),
+			func(b Builder[*cloudresourcesv1beta1.AzureRedisInstance]) {
+				b.(*testAzureRedisInstanceBuilder).instance.Spec.RedisTier = cloudresourcesv1beta1.AzureRedisTierP3
+			},
+		)
+
+		canNotChangeSkr(
+			"AzureRedisInstance redisTier can not be changed from Premium to Standard",
+			newTestAzureRedisInstanceBuilder(),
+			func(b Builder[*cloudresourcesv1beta1.AzureRedisInstance]) {
+				b.(*testAzureRedisInstanceBuilder).instance.Spec.RedisTier = cloudresourcesv1beta1.AzureRedisTierS1
+			},
+			"Service tier cannot be changed",
+		)
+	})
 })
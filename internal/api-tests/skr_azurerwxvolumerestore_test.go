@@ -0,0 +1,86 @@
+package api_tests
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+type testAzureRwxVolumeRestoreBuilder struct {
+	instance cloudresourcesv1beta1.AzureRwxVolumeRestore
+}
+
+func newTestAzureRwxVolumeRestoreBuilder(destination cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination) *testAzureRwxVolumeRestoreBuilder {
+	return &testAzureRwxVolumeRestoreBuilder{
+		instance: cloudresourcesv1beta1.AzureRwxVolumeRestore{
+			Spec: cloudresourcesv1beta1.AzureRwxVolumeRestoreSpec{
+				Source: cloudresourcesv1beta1.AzureRwxVolumeRestoreSource{
+					Backup: cloudresourcesv1beta1.AzureRwxVolumeBackupRef{
+						Name: "test-backup",
+					},
+				},
+				Destination: destination,
+			},
+		},
+	}
+}
+
+func (b *testAzureRwxVolumeRestoreBuilder) Build() *cloudresourcesv1beta1.AzureRwxVolumeRestore {
+	return &b.instance
+}
+
+func (b *testAzureRwxVolumeRestoreBuilder) WithNewVolumePvcName(name string) *testAzureRwxVolumeRestoreBuilder {
+	b.instance.Spec.Destination.NewVolume.Pvc.Name = name
+	return b
+}
+
+var _ = Describe("Feature: SKR AzureRwxVolumeRestore", Ordered, func() {
+
+	existingPvc := cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+		Pvc: &cloudresourcesv1beta1.PvcRef{Name: "test-pvc"},
+	}
+	newVolume := cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+		NewVolume: &cloudresourcesv1beta1.AzureRwxVolumeRestoreNewVolume{
+			Pvc: cloudresourcesv1beta1.AzureRwxVolumeRestorePvcSpec{Name: "test-restored-pvc"},
+		},
+	}
+
+	Context("Scenario: Destination validation", func() {
+
+		canCreateSkr(
+			"AzureRwxVolumeRestore with existing pvc",
+			newTestAzureRwxVolumeRestoreBuilder(existingPvc),
+		)
+
+		canCreateSkr(
+			"AzureRwxVolumeRestore with newVolume",
+			newTestAzureRwxVolumeRestoreBuilder(newVolume),
+		)
+
+		canNotCreateSkr(
+			"AzureRwxVolumeRestore with both pvc and newVolume",
+			newTestAzureRwxVolumeRestoreBuilder(cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+				Pvc:       existingPvc.Pvc,
+				NewVolume: newVolume.NewVolume,
+			}),
+			"must have at most 1 item",
+		)
+
+		canNotCreateSkr(
+			"AzureRwxVolumeRestore with neither pvc nor newVolume",
+			newTestAzureRwxVolumeRestoreBuilder(cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{}),
+			"should have at least 1 properties",
+		)
+	})
+
+	Context("Scenario: Immutability", func() {
+
+		canNotChangeSkr(
+			"AzureRwxVolumeRestore Destination is immutable",
+			newTestAzureRwxVolumeRestoreBuilder(newVolume),
+			func(b Builder[*cloudresourcesv1beta1.AzureRwxVolumeRestore]) {
+				b.(*testAzureRwxVolumeRestoreBuilder).WithNewVolumePvcName("other-pvc")
+			},
+			"Destination is immutable",
+		)
+	})
+})
@@ -0,0 +1,66 @@
+package api_tests
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type testGcpNfsBackupScheduleBuilder struct {
+	instance cloudresourcesv1beta1.GcpNfsBackupSchedule
+}
+
+func newTestGcpNfsBackupScheduleBuilder() *testGcpNfsBackupScheduleBuilder {
+	return &testGcpNfsBackupScheduleBuilder{
+		instance: cloudresourcesv1beta1.GcpNfsBackupSchedule{
+			Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{
+				NfsVolumeRef: corev1.ObjectReference{
+					Name: "test-volume",
+				},
+				Schedule: "0 0 * * *",
+			},
+		},
+	}
+}
+
+func (b *testGcpNfsBackupScheduleBuilder) Build() *cloudresourcesv1beta1.GcpNfsBackupSchedule {
+	return &b.instance
+}
+
+func (b *testGcpNfsBackupScheduleBuilder) WithLocation(location string) *testGcpNfsBackupScheduleBuilder {
+	b.instance.Spec.Location = location
+	return b
+}
+
+func (b *testGcpNfsBackupScheduleBuilder) WithCopyTo(copyTo ...string) *testGcpNfsBackupScheduleBuilder {
+	b.instance.Spec.CopyTo = copyTo
+	return b
+}
+
+var _ = Describe("Feature: SKR GcpNfsBackupSchedule", Ordered, func() {
+
+	Context("Scenario: CopyTo validation", func() {
+
+		canCreateSkr(
+			"GcpNfsBackupSchedule with copyTo",
+			newTestGcpNfsBackupScheduleBuilder().WithLocation("us-central1").WithCopyTo("us-east1", "europe-west1"),
+		)
+
+		canCreateSkr(
+			"GcpNfsBackupSchedule with copyTo and empty location",
+			newTestGcpNfsBackupScheduleBuilder().WithLocation("").WithCopyTo("us-east1"),
+		)
+
+		canNotCreateSkr(
+			"GcpNfsBackupSchedule with invalid copyTo region",
+			newTestGcpNfsBackupScheduleBuilder().WithLocation("us-central1").WithCopyTo("us-west1-a"),
+			"should match",
+		)
+
+		canNotCreateSkr(
+			"GcpNfsBackupSchedule with copyTo containing location",
+			newTestGcpNfsBackupScheduleBuilder().WithLocation("us-central1").WithCopyTo("us-central1"),
+			"CopyTo must not contain Location",
+		)
+	})
+})
@@ -33,6 +33,11 @@ func (b *testGcpNfsVolumeBackupBuilder) WithLocation(location string) *testGcpNf
 	return b
 }
 
+func (b *testGcpNfsVolumeBackupBuilder) WithCopyTo(copyTo ...string) *testGcpNfsVolumeBackupBuilder {
+	b.instance.Spec.CopyTo = copyTo
+	return b
+}
+
 var _ = Describe("Feature: SKR GcpNfsVolumeBackup", Ordered, func() {
 
 	Context("Scenario: Location validation", func() {
@@ -160,4 +165,44 @@ var _ = Describe("Feature: SKR GcpNfsVolumeBackup", Ordered, func() {
 			newTestGcpNfsVolumeBackupBuilder().WithLocation(""),
 		)
 	})
+
+	Context("Scenario: CopyTo validation", func() {
+
+		canCreateSkr(
+			"GcpNfsVolumeBackup with copyTo",
+			newTestGcpNfsVolumeBackupBuilder().WithLocation("us-central1").WithCopyTo("us-east1", "europe-west1"),
+		)
+
+		canCreateSkr(
+			"GcpNfsVolumeBackup with copyTo and empty location",
+			newTestGcpNfsVolumeBackupBuilder().WithLocation("").WithCopyTo("us-east1"),
+		)
+
+		canNotCreateSkr(
+			"GcpNfsVolumeBackup with invalid copyTo region",
+			newTestGcpNfsVolumeBackupBuilder().WithLocation("us-central1").WithCopyTo("us-west1-a"),
+			"should match",
+		)
+
+		canNotCreateSkr(
+			"GcpNfsVolumeBackup with copyTo containing location",
+			newTestGcpNfsVolumeBackupBuilder().WithLocation("us-central1").WithCopyTo("us-central1"),
+			"CopyTo must not contain Location",
+		)
+
+		canNotCreateSkr(
+			"GcpNfsVolumeBackup with too many copyTo regions",
+			newTestGcpNfsVolumeBackupBuilder().WithLocation("us-central1").WithCopyTo("us-east1", "us-east4", "us-west1", "us-west2"),
+			"must have at most 3 items",
+		)
+
+		canNotChangeSkr(
+			"GcpNfsVolumeBackup CopyTo cannot be changed",
+			newTestGcpNfsVolumeBackupBuilder().WithLocation("us-central1").WithCopyTo("us-east1"),
+			func(b Builder[*cloudresourcesv1beta1.GcpNfsVolumeBackup]) {
+				b.(*testGcpNfsVolumeBackupBuilder).WithCopyTo("us-west1")
+			},
+			"CopyTo is immutable",
+		)
+	})
 })
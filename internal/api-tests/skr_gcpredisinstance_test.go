@@ -119,6 +119,19 @@ var _ = Describe("Feature: SKR GcpRedisInstance", Ordered, func() {
 		"",
 	)
 
+	for _, redisVersion := range []string{"REDIS_6_X", "REDIS_7_0", "REDIS_7_2"} {
+		canCreateSkr(
+			fmt.Sprintf("GcpRedisInstance can be created with premium tier and redisVersion %s", redisVersion),
+			newTestGcpRedisInstanceBuilder().WithRedisTier(cloudresourcesv1beta1.GcpRedisTierP1).WithRedisVersion(redisVersion),
+		)
+	}
+
+	canNotCreateSkr(
+		"GcpRedisInstance cannot be created with premium tier and redisVersion without read replicas",
+		newTestGcpRedisInstanceBuilder().WithRedisTier(cloudresourcesv1beta1.GcpRedisTierP1).WithRedisVersion("REDIS_4_0"),
+		"Premium redisTier requires redisVersion REDIS_5_0 or newer.",
+	)
+
 	allowedVersionUpgrades := [][]string{
 		{"REDIS_6_X", "REDIS_7_0"},
 		{"REDIS_6_X", "REDIS_7_2"},
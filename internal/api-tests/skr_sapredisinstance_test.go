@@ -0,0 +1,147 @@
+package api_tests
+
+import (
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+type testSapRedisInstanceBuilder struct {
+	instance cloudresourcesv1beta1.SapRedisInstance
+}
+
+func newTestSapRedisInstanceBuilder() *testSapRedisInstanceBuilder {
+	return &testSapRedisInstanceBuilder{
+		instance: cloudresourcesv1beta1.SapRedisInstance{
+			Spec: cloudresourcesv1beta1.SapRedisInstanceSpec{
+				RedisTier: cloudresourcesv1beta1.SapRedisTierS1,
+			},
+		},
+	}
+}
+
+func (b *testSapRedisInstanceBuilder) Build() *cloudresourcesv1beta1.SapRedisInstance {
+	return &b.instance
+}
+
+func (b *testSapRedisInstanceBuilder) WithRedisTier(redisTier cloudresourcesv1beta1.SapRedisTier) *testSapRedisInstanceBuilder {
+	b.instance.Spec.RedisTier = redisTier
+	return b
+}
+
+func (b *testSapRedisInstanceBuilder) WithEngine(engine cloudresourcesv1beta1.SapRedisEngine) *testSapRedisInstanceBuilder {
+	b.instance.Spec.Engine = engine
+	return b
+}
+
+func (b *testSapRedisInstanceBuilder) WithEngineVersion(engineVersion string) *testSapRedisInstanceBuilder {
+	b.instance.Spec.EngineVersion = engineVersion
+	return b
+}
+
+func (b *testSapRedisInstanceBuilder) WithAuthEnabled(authEnabled bool) *testSapRedisInstanceBuilder {
+	b.instance.Spec.AuthEnabled = authEnabled
+	return b
+}
+
+func (b *testSapRedisInstanceBuilder) WithAuthSecretName(name string) *testSapRedisInstanceBuilder {
+	if b.instance.Spec.AuthSecret == nil {
+		b.instance.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+	}
+	b.instance.Spec.AuthSecret.Name = name
+	return b
+}
+
+func (b *testSapRedisInstanceBuilder) WithAuthSecretLabels(labels map[string]string) *testSapRedisInstanceBuilder {
+	if b.instance.Spec.AuthSecret == nil {
+		b.instance.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+	}
+	b.instance.Spec.AuthSecret.Labels = labels
+	return b
+}
+
+var _ = Describe("Feature: SKR SapRedisInstance", Ordered, func() {
+
+	Context("Scenario: spec validation", func() {
+
+		canCreateSkr(
+			"SapRedisInstance with default engine",
+			newTestSapRedisInstanceBuilder(),
+		)
+
+		canCreateSkr(
+			"SapRedisInstance with redis engine and version",
+			newTestSapRedisInstanceBuilder().WithEngine(cloudresourcesv1beta1.SapRedisEngineRedis).WithEngineVersion("7.2"),
+		)
+
+		canNotCreateSkr(
+			"SapRedisInstance with unknown engine",
+			newTestSapRedisInstanceBuilder().WithEngine("memcached"),
+			"spec.engine",
+		)
+
+		canNotCreateSkr(
+			"SapRedisInstance with unknown redisTier",
+			newTestSapRedisInstanceBuilder().WithRedisTier("P1"),
+			"spec.redisTier",
+		)
+	})
+
+	Context("Scenario: spec mutability", func() {
+
+		canNotChangeSkr(
+			"SapRedisInstance redisTier cannot be changed",
+			newTestSapRedisInstanceBuilder(),
+			func(b Builder[*cloudresourcesv1beta1.SapRedisInstance]) {
+				b.(*testSapRedisInstanceBuilder).WithRedisTier(cloudresourcesv1beta1.SapRedisTierS2)
+			},
+			"RedisTier is immutable.",
+		)
+
+		canNotChangeSkr(
+			"SapRedisInstance engine cannot be changed",
+			newTestSapRedisInstanceBuilder().WithEngine(cloudresourcesv1beta1.SapRedisEngineValkey),
+			func(b Builder[*cloudresourcesv1beta1.SapRedisInstance]) {
+				b.(*testSapRedisInstanceBuilder).WithEngine(cloudresourcesv1beta1.SapRedisEngineRedis)
+			},
+			"Engine is immutable.",
+		)
+
+		canNotChangeSkr(
+			"SapRedisInstance engineVersion cannot be changed",
+			newTestSapRedisInstanceBuilder().WithEngineVersion("8.0"),
+			func(b Builder[*cloudresourcesv1beta1.SapRedisInstance]) {
+				b.(*testSapRedisInstanceBuilder).WithEngineVersion("8.1")
+			},
+			"EngineVersion is immutable.",
+		)
+
+		canNotChangeSkr(
+			"SapRedisInstance authEnabled cannot be changed",
+			newTestSapRedisInstanceBuilder().WithAuthEnabled(false),
+			func(b Builder[*cloudresourcesv1beta1.SapRedisInstance]) {
+				b.(*testSapRedisInstanceBuilder).WithAuthEnabled(true)
+			},
+			"AuthEnabled is immutable.",
+		)
+	})
+
+	Context("Scenario: authSecret mutability", func() {
+
+		canNotChangeSkr(
+			"SapRedisInstance authSecret.name cannot be changed",
+			newTestSapRedisInstanceBuilder().WithAuthSecretName("original-name"),
+			func(b Builder[*cloudresourcesv1beta1.SapRedisInstance]) {
+				b.(*testSapRedisInstanceBuilder).WithAuthSecretName("new-name")
+			},
+			"name is immutable",
+		)
+
+		canChangeSkr(
+			"SapRedisInstance authSecret.labels can be changed",
+			newTestSapRedisInstanceBuilder().WithAuthSecretLabels(map[string]string{"env": "dev"}),
+			func(b Builder[*cloudresourcesv1beta1.SapRedisInstance]) {
+				b.(*testSapRedisInstanceBuilder).WithAuthSecretLabels(map[string]string{"env": "prod", "team": "platform"})
+			},
+		)
+	})
+})
@@ -0,0 +1,147 @@
+package cloudcontrol
+
+import (
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	awsbucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket"
+	awsmock "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/mock"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Feature: KCP Bucket", func() {
+
+	It("Scenario: KCP AWS Bucket is created and deleted", func() {
+
+		const (
+			name  = "8e2b6d0f-4a17-4c93-b5e8-1d9f3a7c2e60"
+			vpcId = "vpc-3c7a1e95"
+		)
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+
+		By("And Given AWS VPC exists", func() {
+			awsMock.AddVpc(
+				vpcId,
+				"10.250.0.0/22",
+				awsutil.Ec2Tags("Name", scope.Spec.Scope.Aws.VpcNetwork),
+				awsmock.VpcSubnetsFromScope(scope),
+			)
+		})
+
+		bucket := &cloudcontrolv1beta1.Bucket{}
+
+		By("When KCP Bucket is created", func() {
+			Eventually(CreateBucket).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket,
+					WithName(name),
+					WithRemoteRef("skr-bucket-example-aws"),
+					WithScope(name),
+					WithBucketAws(),
+					WithKcpBucketVersioning(true),
+					WithKcpBucketRetentionDays(7),
+					WithKcpBucketLifecycle(cloudcontrolv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 30}),
+				).
+				Should(Succeed(), "failed creating Bucket")
+		})
+
+		By("Then KCP Bucket has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected Bucket to has Ready state, but it didn't")
+		})
+
+		By("And Then KCP Bucket has status.id set to S3 bucket name", func() {
+			Expect(bucket.Status.Id).To(Equal(awsbucket.GetAwsS3BucketName(name)))
+		})
+
+		By("And Then KCP Bucket has .status.endpoint and .status.location set", func() {
+			Expect(bucket.Status.Endpoint).To(Equal(awsbucket.GetAwsS3Endpoint(scope.Spec.Region)))
+			Expect(bucket.Status.Location).To(Equal(scope.Spec.Region))
+		})
+
+		By("And Then KCP Bucket has .status.accessKeyId and .status.secretAccessKey set", func() {
+			Expect(bucket.Status.AccessKeyId).NotTo(BeEmpty())
+			Expect(bucket.Status.SecretAccessKey).NotTo(BeEmpty())
+		})
+
+		By("And Then S3 bucket has public access blocked, versioning, retention and lifecycle as specified in KCP Bucket", func() {
+			s3Bucket := awsMock.GetAwsS3Bucket(bucket.Status.Id)
+			Expect(s3Bucket).NotTo(BeNil())
+			Expect(s3Bucket.PublicAccessBlocked).To(BeTrue())
+			Expect(s3Bucket.Versioning).To(Equal(s3types.BucketVersioningStatusEnabled))
+			Expect(s3Bucket.ObjectLockEnabled).To(BeTrue())
+			Expect(s3Bucket.ObjectLockRetentionDays).To(Equal(int32(7)))
+			Expect(s3Bucket.LifecycleRules).To(HaveLen(1))
+			Expect(ptr.Deref(s3Bucket.LifecycleRules[0].Filter.Prefix, "")).To(Equal("logs/"))
+			Expect(s3Bucket.Policy).To(ContainSubstring("aws:SourceVpce"))
+		})
+
+		By("And Then S3 gateway VPC endpoint is created", func() {
+			Expect(awsMock.GetAwsVpcEndpoints(vpcId)).To(HaveLen(1))
+		})
+
+		iamUserName := awsbucket.GetAwsIamUserName(name)
+
+		By("And Then IAM user with access key is created", func() {
+			Expect(awsMock.GetAwsIamUser(iamUserName)).NotTo(BeNil())
+			Expect(awsMock.GetAwsIamUserPolicy(iamUserName, "cm-bucket-access")).To(ContainSubstring(bucket.Status.Id))
+			accessKeys := awsMock.GetAwsIamAccessKeys(iamUserName)
+			Expect(accessKeys).To(HaveLen(1))
+			Expect(ptr.Deref(accessKeys[0].AccessKeyId, "")).To(Equal(bucket.Status.AccessKeyId))
+		})
+
+		By("When workloads write objects into S3 bucket", func() {
+			awsMock.AddAwsS3BucketObjects(bucket.Status.Id, 3)
+		})
+
+		// DELETE
+
+		By("When KCP Bucket is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket).
+				Should(Succeed(), "failed deleting Bucket")
+		})
+
+		By("Then KCP Bucket does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket).
+				Should(Succeed(), "expected Bucket not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then S3 bucket is deleted", func() {
+			Expect(awsMock.GetAwsS3Bucket(awsbucket.GetAwsS3BucketName(name))).To(BeNil())
+		})
+
+		By("And Then IAM user is deleted", func() {
+			Expect(awsMock.GetAwsIamUser(iamUserName)).To(BeNil())
+		})
+
+		By("And Then S3 gateway VPC endpoint is deleted", func() {
+			Expect(awsMock.GetAwsVpcEndpoints(vpcId)).To(BeEmpty())
+		})
+	})
+
+})
@@ -0,0 +1,190 @@
+package cloudcontrol
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	azurebucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Feature: KCP Bucket", func() {
+
+	It("Scenario: KCP Azure Bucket is created and deleted", func() {
+
+		name := "6a2f9d14-8c3e-4b07-a5d1-0e7b3c9f2a68"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "d3b8e1f7-2a4c-4e96-b0d5-9f6a1c7e3b42"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithKcpIpRangeRemoteRef("some-remote-ref"),
+					WithKcpIpRangeNetwork("kcpNetworkCm.Name"),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		bucket := &cloudcontrolv1beta1.Bucket{}
+
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+		storageAccountName := azurebucket.GetAzureStorageAccountName(name)
+		containerName := azurebucket.GetAzureBlobContainerName(name)
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+
+		By("When KCP Bucket is created", func() {
+			Eventually(CreateBucket).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket,
+					WithName(name),
+					WithRemoteRef("skr-bucket-example-azure"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithBucketAzure(),
+					WithKcpBucketVersioning(true),
+					WithKcpBucketRetentionDays(7),
+					WithKcpBucketLifecycle(cloudcontrolv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 30}),
+				).
+				Should(Succeed(), "failed creating Bucket")
+		})
+
+		By("Then KCP Bucket has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected Bucket to has Ready state, but it didn't")
+		})
+
+		By("And Then KCP Bucket has status.id set to blob container name", func() {
+			Expect(bucket.Status.Id).To(Equal(containerName))
+		})
+
+		By("And Then Azure storage account is created with public network access disabled", func() {
+			account, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ptr.Deref(account.Properties.PublicNetworkAccess, "")).To(Equal(armstorage.PublicNetworkAccessDisabled))
+			Expect(ptr.Deref(account.Properties.AllowBlobPublicAccess, true)).To(BeFalse())
+		})
+
+		By("And Then KCP Bucket has .status.endpoint, .status.location and credentials set", func() {
+			Expect(bucket.Status.Endpoint).NotTo(BeEmpty())
+			Expect(bucket.Status.Location).To(Equal(scope.Spec.Region))
+			Expect(bucket.Status.AccessKeyId).To(Equal(storageAccountName))
+			key, err := azureMock.GetStorageAccountKey(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bucket.Status.SecretAccessKey).To(Equal(key))
+		})
+
+		By("And Then Azure blob container is created", func() {
+			container, err := azureMock.GetBlobContainer(infra.Ctx(), resourceGroupName, storageAccountName, containerName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(container).NotTo(BeNil())
+		})
+
+		By("And Then Azure blob container has versioning, retention and lifecycle as specified in KCP Bucket", func() {
+			versioning, err := azureMock.GetBlobVersioning(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versioning).To(BeTrue())
+
+			policy, err := azureMock.GetBlobImmutabilityPolicy(infra.Ctx(), resourceGroupName, storageAccountName, containerName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ptr.Deref(policy.Properties.ImmutabilityPeriodSinceCreationInDays, 0)).To(Equal(int32(7)))
+
+			rules, err := azureMock.GetBlobManagementPolicyRules(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(HaveLen(1))
+		})
+
+		By("And Then Blob Private Dns Zone is created and linked to the kyma network", func() {
+			zoneName := azureutil.NewBlobPrivateDnsZoneName()
+			zone, err := azureMock.GetPrivateDnsZone(infra.Ctx(), resourceGroupName, zoneName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zone).NotTo(BeNil())
+
+			link, err := azureMock.GetVirtualNetworkLink(infra.Ctx(), resourceGroupName, zoneName, scope.Spec.Scope.Azure.VpcNetwork)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(link).NotTo(BeNil())
+		})
+
+		By("And Then Private End Point is created", func() {
+			pep, err := azureMock.GetPrivateEndPoint(infra.Ctx(), resourceGroupName, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pep).NotTo(BeNil())
+		})
+
+		By("And Then Private Dns Zone Group is created", func() {
+			group, err := azureMock.GetPrivateDnsZoneGroup(infra.Ctx(), resourceGroupName, name, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).ToNot(BeNil())
+		})
+
+		// DELETE
+
+		By("When KCP Bucket is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket).
+				Should(Succeed(), "failed deleting Bucket")
+		})
+
+		By("Then KCP Bucket does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket).
+				Should(Succeed(), "expected Bucket not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then Azure storage account is deleted", func() {
+			_, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).To(HaveOccurred())
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then Private Dns Zone Group is deleted", func() {
+			group, err := azureMock.GetPrivateDnsZoneGroup(infra.Ctx(), resourceGroupName, name, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(BeNil())
+		})
+
+		By("And Then Private End Point is deleted", func() {
+			pep, err := azureMock.GetPrivateEndPoint(infra.Ctx(), resourceGroupName, name)
+			Expect(err).To(HaveOccurred())
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+			Expect(pep).To(BeNil())
+		})
+	})
+
+})
@@ -0,0 +1,85 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudcontrol
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/bucket"
+	awsbucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket"
+	awsbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket/client"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	azurebucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket"
+	azurebucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket/client"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	gcpbucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket"
+	gcpbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket/client"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+func SetupBucketReconciler(
+	kcpManager manager.Manager,
+	awsBucketClientProvider awsclient.SkrClientProvider[awsbucketclient.Client],
+	gcpBucketClientProvider gcpclient.GcpClientProvider[gcpbucketclient.Client],
+	azureBucketClientProvider azureclient.ClientProvider[azurebucketclient.Client],
+) error {
+	return NewBucketReconciler(
+		bucket.NewBucketReconciler(
+			composed.NewStateFactory(composed.NewStateClusterFromCluster(kcpManager)),
+			focal.NewStateFactory(),
+			awsbucket.NewStateFactory(awsBucketClientProvider),
+			gcpbucket.NewStateFactory(gcpBucketClientProvider),
+			azurebucket.NewStateFactory(azureBucketClientProvider),
+		),
+	).SetupWithManager(kcpManager)
+}
+
+func NewBucketReconciler(
+	reconciler bucket.BucketReconciler,
+) *BucketReconciler {
+	return &BucketReconciler{
+		Reconciler: reconciler,
+	}
+}
+
+type BucketReconciler struct {
+	Reconciler bucket.BucketReconciler
+}
+
+//+kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=buckets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=buckets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=buckets/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.Reconciler.Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BucketReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cloudcontrolv1beta1.Bucket{}, builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,116 @@
+package cloudcontrol
+
+import (
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	gcpbucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP Bucket", func() {
+
+	It("Scenario: KCP GCP Bucket is created and deleted", func() {
+
+		name := "b4e0a8c2-6d39-4f51-9a7e-3c1f5b9d0e82"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		gcpMock := infra.GcpMock2().NewSubscription("bucket")
+		defer gcpMock.Delete()
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeGcp2).
+				WithArguments(infra.Ctx(), infra, scope, gcpMock.ProjectId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		bucket := &cloudcontrolv1beta1.Bucket{}
+
+		By("When KCP Bucket is created", func() {
+			Eventually(CreateBucket).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket,
+					WithName(name),
+					WithRemoteRef("skr-bucket-example-gcp"),
+					WithScope(name),
+					WithBucketGcp(),
+					WithKcpBucketRetentionDays(7),
+					WithKcpBucketLifecycle(cloudcontrolv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 30}),
+				).
+				Should(Succeed(), "failed creating Bucket")
+		})
+
+		By("Then KCP Bucket has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected Bucket to has Ready state, but it didn't")
+		})
+
+		By("And Then KCP Bucket has status.id set to GCP bucket name", func() {
+			Expect(bucket.Status.Id).To(Equal(gcpbucket.GetGcpBucketName(name)))
+		})
+
+		By("And Then KCP Bucket has .status.endpoint and .status.location set", func() {
+			Expect(bucket.Status.Endpoint).To(Equal("https://storage.googleapis.com"))
+			Expect(bucket.Status.Location).To(Equal(scope.Spec.Region))
+		})
+
+		By("And Then KCP Bucket has .status.accessKeyId and .status.secretAccessKey set", func() {
+			Expect(bucket.Status.AccessKeyId).NotTo(BeEmpty())
+			Expect(bucket.Status.SecretAccessKey).NotTo(BeEmpty())
+		})
+
+		By("And Then GCP bucket has retention, lifecycle and VPC ip filter as specified in KCP Bucket", func() {
+			gcpBucket, err := gcpMock.GetStorageBucket(infra.Ctx(), bucket.Status.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gcpBucket.RetentionPolicy.RetentionPeriod).To(Equal(int64(7 * 24 * 60 * 60)))
+			Expect(gcpBucket.Lifecycle.Rule).To(HaveLen(1))
+			Expect(gcpBucket.Lifecycle.Rule[0].Condition.MatchesPrefix).To(ConsistOf("logs/"))
+			Expect(gcpBucket.IamConfiguration.PublicAccessPrevention).To(Equal("enforced"))
+			Expect(gcpBucket.IpFilter.VpcNetworkSources).To(HaveLen(1))
+		})
+
+		By("And Then GCP service account is created", func() {
+			sa, err := gcpMock.GetServiceAccount(infra.Ctx(), gcpMock.ProjectId(), gcpbucket.GetGcpServiceAccountId(name))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sa).NotTo(BeNil())
+		})
+
+		By("When workloads write objects into GCP bucket", func() {
+			Expect(gcpMock.AddStorageBucketObjects(infra.Ctx(), bucket.Status.Id, 3)).To(Succeed())
+		})
+
+		// DELETE
+
+		By("When KCP Bucket is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket).
+				Should(Succeed(), "failed deleting Bucket")
+		})
+
+		By("Then KCP Bucket does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), bucket).
+				Should(Succeed(), "expected Bucket not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then GCP bucket is deleted", func() {
+			_, err := gcpMock.GetStorageBucket(infra.Ctx(), gcpbucket.GetGcpBucketName(name))
+			Expect(gcpmeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then GCP service account is deleted", func() {
+			_, err := gcpMock.GetServiceAccount(infra.Ctx(), gcpMock.ProjectId(), gcpbucket.GetGcpServiceAccountId(name))
+			Expect(gcpmeta.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 
@@ -170,4 +171,240 @@ var _ = Describe("Feature: KCP NfsInstance AWS", func() {
 		})
 	})
 
+	It("Scenario: KCP AWS NfsInstance is restored from recovery point", func() {
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		name := "e2a6f4c1-3b7d-4c9e-8f1a-5d2b7c9e0a34"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given AWS Scope exists", func() {
+			// Tell Scope reconciler to ignore this Scope
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(name)).
+				Should(Succeed(), "failed creating Scope")
+		})
+
+		vpcId := "0c6b1e2f-8d4a-4f3b-a9e7-6c5d2b1a0f98"
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+
+		By("And Given AWS VPC exists", func() {
+			awsMock.AddVpc(
+				vpcId,
+				"10.180.0.0/16",
+				awsutil.Ec2Tags("Name", scope.Spec.Scope.Aws.VpcNetwork),
+				awsmock.VpcSubnetsFromScope(scope),
+			)
+		})
+
+		iprange := &cloudcontrolv1beta1.IpRange{}
+		iprangeCidr := "10.181.0.0/16"
+
+		By("And Given KCP IpRange exists", func() {
+			// Tell IpRange reconciler to ignore this IpRange
+			kcpiprange.Ignore.AddName(name)
+
+			Eventually(CreateAwsIpRangeWithSubnets).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), awsMock, iprange, vpcId, name, iprangeCidr).
+				Should(Succeed(), "failed creating IpRange")
+		})
+
+		nfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+		recoveryPointId := "a1b2c3d4-0000-4000-8000-recoverypoint"
+
+		By("When NfsInstance with source recovery point is created", func() {
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					WithName(name),
+					WithRemoteRef("foo"),
+					WithScope(name),
+					WithIpRange(name),
+					WithNfsInstanceAws(),
+					WithNfsInstanceAwsSourceRecoveryPointId(recoveryPointId),
+				).
+				Should(Succeed(), "failed creating NfsInstance")
+		})
+
+		By("Then NfsInstance has Restoring condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeRestoring),
+					HavingFieldSet("status", "opIdentifier"),
+				).
+				Should(Succeed(), "expected NfsInstance to have Restoring condition, but it didn't")
+		})
+
+		By("And Then AWS restore job is started for the recovery point", func() {
+			job := awsMock.GetRestoreJobById(nfsInstance.Status.OpIdentifier)
+			Expect(job).NotTo(BeNil())
+			Expect(ptr.Deref(job.RecoveryPointArn, "")).To(Equal(awsutil.BackupRecoveryPointArn(scope.Spec.Region, awsAccount.AccountId(), recoveryPointId)))
+			Expect(awsMock.GetRestoreJobBackupVaultName(nfsInstance.Status.OpIdentifier)).To(Equal("cm-" + scope.Name))
+		})
+
+		By("When AWS restore job completes", func() {
+			awsMock.CompleteRestoreJob(nfsInstance.Status.OpIdentifier)
+		})
+
+		var theEfs *efstypes.FileSystemDescription
+		By("Then NfsInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingFieldSet("status", "id"),
+				).
+				Should(Succeed(), "expected NfsInstance to have Ready state, but it didn't")
+			Expect(meta.FindStatusCondition(nfsInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeRestoring)).To(BeNil())
+		})
+
+		By("And Then NfsInstance uses the restored EFS", func() {
+			job := awsMock.GetRestoreJobById(nfsInstance.Status.OpIdentifier)
+			Expect(nfsInstance.Status.Id).To(Equal(awsutil.ParseArnResourceId(ptr.Deref(job.CreatedResourceArn, ""))))
+			theEfs = awsMock.GetFileSystemById(nfsInstance.Status.Id)
+			Expect(ptr.Deref(theEfs.Name, "")).To(Equal(nfsInstance.Name))
+		})
+
+		By("And Then EFS has mount targets", func() {
+			list, err := awsMock.DescribeMountTargets(infra.Ctx(), ptr.Deref(theEfs.FileSystemId, ""))
+			Expect(err).NotTo(HaveOccurred(), "failed listing EFS mount targets")
+			Expect(list).To(HaveLen(3), "expected 3 EFS mount targets to exist")
+		})
+
+		// DELETE
+
+		By("When NfsInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance).
+				Should(Succeed(), "failed deleting NfsInstance")
+		})
+
+		By("And When AWS EFS state is deleted", func() {
+			awsMock.SetFileSystemLifeCycleState(ptr.Deref(theEfs.FileSystemId, ""), efstypes.LifeCycleStateDeleted)
+		})
+
+		By("Then NfsInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance).
+				Should(Succeed(), "expected NfsInstance not to exist (be deleted), but it still exists")
+		})
+	})
+
+	It("Scenario: KCP AWS NfsInstance is restored from recovery point of another runtime", func() {
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		name := "3f8b1d6e-7a2c-4e9f-b5d1-8c4a2e6f0b73"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given AWS Scope exists", func() {
+			// Tell Scope reconciler to ignore this Scope
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(name)).
+				Should(Succeed(), "failed creating Scope")
+		})
+
+		vpcId := "9e4c2a7b-1f5d-4b8e-a3c6-2d7f9b1e5a40"
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+
+		By("And Given AWS VPC exists", func() {
+			awsMock.AddVpc(
+				vpcId,
+				"10.180.0.0/16",
+				awsutil.Ec2Tags("Name", scope.Spec.Scope.Aws.VpcNetwork),
+				awsmock.VpcSubnetsFromScope(scope),
+			)
+		})
+
+		iprange := &cloudcontrolv1beta1.IpRange{}
+		iprangeCidr := "10.181.0.0/16"
+
+		By("And Given KCP IpRange exists", func() {
+			// Tell IpRange reconciler to ignore this IpRange
+			kcpiprange.Ignore.AddName(name)
+
+			Eventually(CreateAwsIpRangeWithSubnets).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), awsMock, iprange, vpcId, name, iprangeCidr).
+				Should(Succeed(), "failed creating IpRange")
+		})
+
+		nfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+		recoveryPointId := "b7d3f9a1-5c2e-4a8b-9d6f-1e3a5c7b9d20"
+		sourceBackupVaultName := "cm-c5a1e7d3-9b2f-4d6a-8e4c-0f2b6d8a1c37"
+
+		By("When NfsInstance with source recovery point in the backup vault of another runtime is created", func() {
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					WithName(name),
+					WithRemoteRef("foo"),
+					WithScope(name),
+					WithIpRange(name),
+					WithNfsInstanceAws(),
+					WithNfsInstanceAwsSourceRecoveryPointId(recoveryPointId),
+					WithNfsInstanceAwsSourceBackupVaultName(sourceBackupVaultName),
+				).
+				Should(Succeed(), "failed creating NfsInstance")
+		})
+
+		By("Then NfsInstance has Restoring condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeRestoring),
+					HavingFieldSet("status", "opIdentifier"),
+				).
+				Should(Succeed(), "expected NfsInstance to have Restoring condition, but it didn't")
+		})
+
+		By("And Then AWS restore job is started for the recovery point from the source backup vault", func() {
+			job := awsMock.GetRestoreJobById(nfsInstance.Status.OpIdentifier)
+			Expect(job).NotTo(BeNil())
+			Expect(ptr.Deref(job.RecoveryPointArn, "")).To(Equal(awsutil.BackupRecoveryPointArn(scope.Spec.Region, awsAccount.AccountId(), recoveryPointId)))
+			Expect(awsMock.GetRestoreJobBackupVaultName(nfsInstance.Status.OpIdentifier)).To(Equal(sourceBackupVaultName))
+		})
+
+		By("When AWS restore job completes", func() {
+			awsMock.CompleteRestoreJob(nfsInstance.Status.OpIdentifier)
+		})
+
+		var theEfs *efstypes.FileSystemDescription
+		By("Then NfsInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingFieldSet("status", "id"),
+				).
+				Should(Succeed(), "expected NfsInstance to have Ready state, but it didn't")
+			theEfs = awsMock.GetFileSystemById(nfsInstance.Status.Id)
+			Expect(theEfs).NotTo(BeNil())
+		})
+
+		// DELETE
+
+		By("When NfsInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance).
+				Should(Succeed(), "failed deleting NfsInstance")
+		})
+
+		By("And When AWS EFS state is deleted", func() {
+			awsMock.SetFileSystemLifeCycleState(ptr.Deref(theEfs.FileSystemId, ""), efstypes.LifeCycleStateDeleted)
+		})
+
+		By("Then NfsInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nfsInstance).
+				Should(Succeed(), "expected NfsInstance not to exist (be deleted), but it still exists")
+		})
+	})
+
 })
@@ -4,8 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/elliotchance/pie/v2"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
 	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
 	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
@@ -26,15 +31,15 @@ var _ = Describe("Feature: KCP Nuke AwsNfsVolumeBackup", func() {
 
 	recoveryPointArns := []string{"", ""}
 
-	awsAccountId := "37456563728"
-
 	BeforeEach(func() {
+		awsAccount := infra.AwsMock().NewAccount()
+
 		By("Given KCP Scope exists", func() {
 			kcpscope.Ignore.AddName(scopeName)
 			// Given Scope exists
 			Eventually(CreateScopeAws).
 				WithArguments(
-					infra.Ctx(), infra, scope, awsAccountId,
+					infra.Ctx(), infra, scope, awsAccount.AccountId(),
 					WithName(scopeName),
 				).
 				Should(Succeed())
@@ -152,3 +157,144 @@ var _ = Describe("Feature: KCP Nuke AwsNfsVolumeBackup", func() {
 		})
 	})
 })
+
+var _ = Describe("Feature: KCP Nuke orphan AWS provider resources", func() {
+
+	It("Scenario: KCP Nuke deletes AWS provider resources tagged with the Scope that have no KCP object", func() {
+		if !feature.FFNukeBackupsAws.Value(context.Background()) {
+			Skip("Nuke Backups for AWS is disabled")
+		}
+
+		const kymaName = "5b1d7a2e-7c0e-4f0b-9a5d-0f8f8d3c1e42"
+		scope := &cloudcontrolv1beta1.Scope{}
+		awsAccount := infra.AwsMock().NewAccount()
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(kymaName)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(kymaName)).
+				Should(Succeed())
+		})
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+
+		var orphanFsId, otherFsId string
+
+		By("And Given EFS file system tagged with the Scope exists without NfsInstance", func() {
+			out, err := awsMock.CreateFileSystem(infra.Ctx(), efstypes.PerformanceModeGeneralPurpose, efstypes.ThroughputModeBursting, []efstypes.Tag{
+				{Key: new(common.TagScope), Value: new(kymaName)},
+				{Key: new(common.TagCloudManagerName), Value: new("kcp-system/f2a2c7f5-3b55-4a4b-8f9c-2b1e0d7e9a61")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			orphanFsId = ptr.Deref(out.FileSystemId, "")
+		})
+
+		By("And Given EFS file system tagged with another Scope exists", func() {
+			out, err := awsMock.CreateFileSystem(infra.Ctx(), efstypes.PerformanceModeGeneralPurpose, efstypes.ThroughputModeBursting, []efstypes.Tag{
+				{Key: new(common.TagScope), Value: new("another-scope")},
+				{Key: new(common.TagCloudManagerName), Value: new("kcp-system/8c0a5f4d-1e6b-4b8e-9d2a-7a3f6e5c4b21")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			otherFsId = ptr.Deref(out.FileSystemId, "")
+		})
+
+		orphanRedisName := "cm-0d9e3b6a-2f4c-4e8b-a1d7-6c5b4a3e2f10"
+
+		By("And Given ElastiCache replication group tagged with the Scope exists without RedisInstance", func() {
+			_, err := awsMock.CreateElastiCacheReplicationGroup(infra.Ctx(), []elasticachetypes.Tag{
+				{Key: new(common.TagScope), Value: new(kymaName)},
+				{Key: new(common.TagCloudManagerName), Value: new("kcp-system/0d9e3b6a-2f4c-4e8b-a1d7-6c5b4a3e2f10")},
+			}, awsclient.CreateElastiCacheClusterOptions{
+				Name:          orphanRedisName,
+				CacheNodeType: "cache.t3.micro",
+				EngineVersion: "7.0",
+				ShardCount:    1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+		By("When Nuke for the Scope is created", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("a4c1e8d2-9b7f-4d3a-8e6c-1f2b3a4d5e6f"),
+				WithScope(kymaName),
+			)).To(Succeed())
+		})
+
+		By("Then orphan EFS file system does not exist", func() {
+			Eventually(func() error {
+				list, err := awsMock.DescribeFileSystems(infra.Ctx())
+				if err != nil {
+					return err
+				}
+				for _, fs := range list {
+					if ptr.Deref(fs.FileSystemId, "") == orphanFsId {
+						return fmt.Errorf("orphan EFS file system %s still exists", orphanFsId)
+					}
+				}
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("And Then EFS file system of another Scope still exists", func() {
+			list, err := awsMock.DescribeFileSystems(infra.Ctx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pie.Map(list, func(fs efstypes.FileSystemDescription) string {
+				return ptr.Deref(fs.FileSystemId, "")
+			})).To(ContainElement(otherFsId))
+		})
+
+		By("And Then Nuke status resource AwsElastiCacheReplicationGroup has Deleting status", func() {
+			Eventually(func() error {
+				if err := LoadAndCheck(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions()); err != nil {
+					return err
+				}
+				sk := nuke.Status.GetKindNoCreate(awsnukeclient.AwsElastiCacheReplicationGroup)
+				if sk == nil {
+					return fmt.Errorf("kind %s not found in Nuke status", awsnukeclient.AwsElastiCacheReplicationGroup)
+				}
+				actual := sk.Objects[orphanRedisName]
+				if actual == cloudcontrolv1beta1.NukeResourceStatusDeleting {
+					return nil
+				}
+				return fmt.Errorf("expected resource %s to have status Deleting, but found %s", orphanRedisName, actual)
+			}).Should(Succeed())
+		})
+
+		By("When ElastiCache replication group is deleted", func() {
+			awsMock.DeleteAwsElastiCacheByName(orphanRedisName)
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).
+				Should(Succeed())
+		})
+
+		for kind, id := range map[string]*string{
+			awsnukeclient.AwsEfsFileSystem:               &orphanFsId,
+			awsnukeclient.AwsElastiCacheReplicationGroup: &orphanRedisName,
+		} {
+			By(fmt.Sprintf("And Then Nuke status resource %s has state Deleted", kind), func() {
+				sk := nuke.Status.GetKindNoCreate(kind)
+				Expect(sk).NotTo(BeNil())
+				Expect(sk.Objects).To(HaveLen(1))
+				Expect(sk.Objects).To(HaveKeyWithValue(*id, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+			})
+		}
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
+})
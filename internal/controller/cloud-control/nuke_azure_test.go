@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
 	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
 	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
 	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
@@ -174,3 +179,177 @@ var _ = Describe("Feature: KCP Nuke AzureRwxVolumeBackup", func() {
 		})
 	})
 })
+
+var _ = Describe("Feature: KCP Nuke orphan Azure provider resources", func() {
+
+	It("Scenario: KCP Nuke deletes Azure redis instances in the cloud-manager resource group that have no KCP object", func() {
+		if !feature.FFNukeBackupsAzure.Value(context.Background()) {
+			Skip("Nuke Backups for Azure is disabled")
+		}
+
+		const kymaName = "7e3f1c2a-4b5d-4e6f-9a8b-0c1d2e3f4a5b"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(kymaName)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(kymaName)).
+				Should(Succeed())
+		})
+
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+		orphanRedisName := "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+		By("And Given Azure redis instance exists in the cloud-manager resource group without RedisInstance", func() {
+			Expect(azureMock.CreateRedisInstance(infra.Ctx(), resourceGroupName, orphanRedisName, armredis.CreateParameters{
+				Location: new(scope.Spec.Region),
+			})).To(Succeed())
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+		By("When Nuke for the Scope is created", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("9f8e7d6c-5b4a-4c3d-8e2f-1a0b9c8d7e6f"),
+				WithScope(kymaName),
+			)).To(Succeed())
+		})
+
+		id := azureutil.NewRedisInstanceResourceId(scope.Spec.Scope.Azure.SubscriptionId, resourceGroupName, orphanRedisName).String()
+
+		By("Then Nuke status resource AzureRedisInstance has Deleting status", func() {
+			Eventually(func() error {
+				if err := LoadAndCheck(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions()); err != nil {
+					return err
+				}
+				sk := nuke.Status.GetKindNoCreate(azurenukeclient.AzureRedisInstance)
+				if sk == nil {
+					return fmt.Errorf("kind %s not found in Nuke status", azurenukeclient.AzureRedisInstance)
+				}
+				actual := sk.Objects[id]
+				if actual == cloudcontrolv1beta1.NukeResourceStatusDeleting {
+					return nil
+				}
+				return fmt.Errorf("expected resource %s to have status Deleting, but found %s", id, actual)
+			}).Should(Succeed())
+		})
+
+		By("When Azure redis instance is deleted", func() {
+			Expect(azureMock.AzureRemoveRedisInstance(infra.Ctx(), resourceGroupName, orphanRedisName)).
+				To(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then Nuke status resource AzureRedisInstance has state Deleted", func() {
+			sk := nuke.Status.GetKindNoCreate(azurenukeclient.AzureRedisInstance)
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(id, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
+
+	It("Scenario: KCP Nuke deletes only cloud-manager Azure peerings of the kyma network that have no VpcPeering", func() {
+		const kymaName = "3c8a5e1f-6d2b-4f7a-9e0c-b4d1a2f3e5c6"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(kymaName)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(kymaName)).
+				Should(Succeed())
+		})
+
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+		vnetName := scope.Spec.Scope.Azure.VpcNetwork
+		remoteVnetId := azureutil.NewVirtualNetworkResourceId("remote-subscription", "remote-rg", "remote-vnet").String()
+
+		By("And Given kyma Azure VNet exists", func() {
+			_, err := azureclient.PollUntilDone(azureMock.CreateOrUpdateNetwork(
+				infra.Ctx(),
+				vnetName,
+				vnetName,
+				azureclient.NewVirtualNetwork(scope.Spec.Region, "10.250.0.0/22", nil),
+				nil,
+			))(infra.Ctx(), nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		orphanPeeringName := "5f4e3d2c-1b0a-4e9d-8c7b-6a5f4e3d2c1b"
+		otherPeeringName := "customer-peering"
+
+		By("And Given kyma VNet peering named by cloud-manager exists without VpcPeering", func() {
+			Expect(azureMock.CreateOrUpdatePeering(infra.Ctx(), vnetName, vnetName, orphanPeeringName, remoteVnetId, true, false, false)).
+				To(Succeed())
+		})
+
+		By("And Given kyma VNet peering not created by cloud-manager exists", func() {
+			Expect(azureMock.CreateOrUpdatePeering(infra.Ctx(), vnetName, vnetName, otherPeeringName, remoteVnetId, true, false, false)).
+				To(Succeed())
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+		By("When Nuke for the Scope is created", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("d2c1b0a9-8e7f-4d6c-9b5a-4e3f2d1c0b9a"),
+				WithScope(kymaName),
+			)).To(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).
+				Should(Succeed())
+		})
+
+		orphanId := azureutil.NewVirtualNetworkPeeringResourceId(scope.Spec.Scope.Azure.SubscriptionId, vnetName, vnetName, orphanPeeringName).String()
+
+		By("And Then Nuke status resource AzureVirtualNetworkPeering has only the cloud-manager peering Deleted", func() {
+			sk := nuke.Status.GetKindNoCreate(azurenukeclient.AzureVirtualNetworkPeering)
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveLen(1))
+			Expect(sk.Objects).To(HaveKeyWithValue(orphanId, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+		})
+
+		By("And Then cloud-manager peering does not exist", func() {
+			_, err := azureMock.GetPeering(infra.Ctx(), vnetName, vnetName, orphanPeeringName)
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then peering not created by cloud-manager still exists", func() {
+			_, err := azureMock.GetPeering(infra.Ctx(), vnetName, vnetName, otherPeeringName)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpnfsbackupclientv1 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v1"
 	gcpnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -28,6 +29,9 @@ func SetupNukeReconciler(
 	gcpFileBackupClientProvider gcpclient.ClientProvider[gcpnfsbackupclientv1.FileBackupClient],
 	awsNukeNfsClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeNfsBackupClient],
 	azureNukeRwxClientProvider azureclient.ClientProvider[azurenukeclient.NukeRwxBackupClient],
+	gcpNukeOrphanClientProvider gcpclient.GcpClientProvider[gcpnukeclient.NukeOrphanClient],
+	awsNukeOrphanClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeOrphanClient],
+	azureNukeOrphanClientProvider azureclient.ClientProvider[azurenukeclient.NukeOrphanClient],
 	env abstractions.Environment,
 ) error {
 	baseStateFactory := composed.NewStateFactory(composed.NewStateClusterFromCluster(kcpManager))
@@ -35,9 +39,9 @@ func SetupNukeReconciler(
 		kcpnuke.New(
 			baseStateFactory,
 			activeSkrCollection,
-			gcpnuke.NewStateFactory(gcpFileBackupClientProvider, env),
-			awsnuke.NewStateFactory(awsNukeNfsClientProvider, env),
-			azurenuke.NewStateFactory(azureNukeRwxClientProvider, env),
+			gcpnuke.NewStateFactory(gcpFileBackupClientProvider, gcpNukeOrphanClientProvider, env),
+			awsnuke.NewStateFactory(awsNukeNfsClientProvider, awsNukeOrphanClientProvider, env),
+			azurenuke.NewStateFactory(azureNukeRwxClientProvider, azureNukeOrphanClientProvider, env),
 		),
 	).SetupWithManager(kcpManager)
 }
@@ -11,12 +11,14 @@ import (
 	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
 	kcpnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/network"
 	kcpnfsinstance "github.com/kyma-project/cloud-manager/pkg/kcp/nfsinstance"
+	kcppostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance"
 	kcpredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance"
 	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
 	kcpvpcpeering "github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Feature: Cleanup orphan resources", func() {
@@ -117,6 +119,26 @@ var _ = Describe("Feature: Cleanup orphan resources", func() {
 			)).To(Succeed(), "failed creating NfsInstance")
 		})
 
+		postgresInstanceName := "0d6f3a8e-2c41-4b7a-9e5d-71c8b2f4a963"
+		postgresInstance := &cloudcontrolv1beta1.PostgresInstance{}
+
+		By("And Given PostgresInstance exists", func() {
+			kcppostgresinstance.Ignore.AddName(postgresInstanceName)
+
+			Expect(CreatePostgresInstance(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+				WithName(postgresInstanceName),
+				AddFinalizer(api.CommonFinalizerDeletionHook),
+				WithRemoteRef("remote-postgres"),
+				WithIpRange(ipRange.Name),
+				WithScope(kymaName),
+				WithPostgresInstanceAzure(),
+				WithKcpAzurePostgresSku("Standard_D2ds_v5", "GeneralPurpose"),
+				WithKcpAzurePostgresVersion("16"),
+				WithKcpAzurePostgresStorageGb(32),
+				WithKcpAzurePostgresDatabaseName("postgres"),
+			)).To(Succeed(), "failed creating PostgresInstance")
+		})
+
 		nuke := &cloudcontrolv1beta1.Nuke{}
 
 		By("When Nuke for the Scope is created", func() {
@@ -135,11 +157,12 @@ var _ = Describe("Feature: Cleanup orphan resources", func() {
 		})
 
 		resources := map[string]focal.CommonObject{
-			"VpcPeering":    vpcPeering,
-			"RedisInstance": redisInstance,
-			"NfsInstance":   nfsInstance,
-			"IpRange":       ipRange,
-			"Network":       cmNetwork,
+			"VpcPeering":       vpcPeering,
+			"RedisInstance":    redisInstance,
+			"NfsInstance":      nfsInstance,
+			"PostgresInstance": postgresInstance,
+			"IpRange":          ipRange,
+			"Network":          cmNetwork,
 		}
 
 		for kind, obj := range resources {
@@ -208,4 +231,112 @@ var _ = Describe("Feature: Cleanup orphan resources", func() {
 		})
 
 	})
+
+	It("Scenario: KCP Nuke in dry-run mode deletes resources only after approval", func() {
+		const kymaName = "0c9d3f61-7b2e-4a58-9e14-d6a8b5c2f307"
+
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(kymaName)
+
+			Expect(CreateScopeAzure(infra.Ctx(), infra, scope, WithName(kymaName))).
+				To(Succeed(), "failed creating scope")
+		})
+
+		ipRangeName := "e5a1b7c3-92d4-4f6e-8a0b-3c7d9e1f5a28"
+		ipRange := &cloudcontrolv1beta1.IpRange{}
+
+		By("And Given IpRange exists", func() {
+			kcpiprange.Ignore.AddName(ipRangeName)
+
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), ipRange,
+					WithName(ipRangeName),
+					AddFinalizer(api.CommonFinalizerDeletionHook),
+					WithKcpIpRangeNetwork("cm"),
+					WithScope(kymaName),
+					WithRemoteRef("foo"),
+					WithKcpIpRangeSpecCidr(common.DefaultCloudManagerCidr),
+				).
+				Should(Succeed(), "failed creating IpRange")
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+
+		By("When Nuke for the Scope is created in dry-run mode", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("4b8e2d90-6f1a-4c37-b5e9-a2d0c8f6e413"),
+				WithScope(kymaName),
+				WithNukeDryRun(),
+			)).To(Succeed())
+		})
+
+		By("Then Nuke status state is AwaitingApproval", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("AwaitingApproval"),
+					HavingCondition(cloudcontrolv1beta1.ConditionTypeReady, metav1.ConditionFalse, cloudcontrolv1beta1.ReasonAwaitingApproval, ""),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then Nuke status resource IpRange has Discovered status", func() {
+			sk := nuke.Status.GetKindNoCreate("IpRange")
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(ipRange.Name, cloudcontrolv1beta1.NukeResourceStatusDiscovered))
+		})
+
+		By("And Then IpRange is not deleted", func() {
+			Consistently(func(g Gomega) {
+				g.Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), ipRange, NewObjActions())).To(Succeed())
+				g.Expect(ipRange.GetDeletionTimestamp().IsZero()).To(BeTrue())
+			}).Should(Succeed())
+		})
+
+		By("When Nuke is approved", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke,
+					WithNukeApproved(),
+				).
+				Should(Succeed())
+		})
+
+		By("Then IpRange has deletion timestamp", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), ipRange, NewObjActions())).To(Succeed())
+				g.Expect(ipRange.GetDeletionTimestamp().IsZero()).To(BeFalse())
+			}).Should(Succeed())
+		})
+
+		By("When IpRange finalizer is removed", func() {
+			_, err := composed.PatchObjRemoveFinalizer(infra.Ctx(), api.CommonFinalizerDeletionHook, ipRange, infra.KCP().Client())
+			Expect(err).To(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).Should(Succeed())
+		})
+
+		By("And Then Nuke status resource IpRange has Deleted status", func() {
+			sk := nuke.Status.GetKindNoCreate("IpRange")
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(ipRange.Name, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
 })
@@ -3,7 +3,11 @@ package cloudcontrol
 import (
 	"context"
 	"fmt"
+	"path"
 
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"cloud.google.com/go/redis/apiv1/redispb"
 	"github.com/kyma-project/cloud-manager/api"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
@@ -14,8 +18,12 @@ import (
 	kcpnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/network"
 	kcpnfsinstance "github.com/kyma-project/cloud-manager/pkg/kcp/nfsinstance"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
 	kcpgcprediscluster "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/rediscluster"
+	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
 	kcpgcpsubnet "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/subnet"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
 	kcpredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance"
 	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
@@ -33,11 +41,14 @@ var _ = Describe("Feature: Cleanup orphan resources", func() {
 		backupClient, err := infra.GcpMock().FileBackupClientProvider()(infra.Ctx(), "")
 		Expect(err).To(Succeed())
 
+		gcpMock := infra.GcpMock2().NewSubscription("nuke")
+		defer gcpMock.Delete()
+
 		By("Given Scope exists", func() {
 			// Tell Scope reconciler to ignore this kymaName
 			kcpscope.Ignore.AddName(kymaName)
 
-			Expect(CreateScopeGcp(infra.Ctx(), infra, scope, WithName(kymaName))).
+			Expect(CreateScopeGcp2(infra.Ctx(), infra, scope, gcpMock.ProjectId(), WithName(kymaName))).
 				To(Succeed(), "failed creating scope")
 		})
 
@@ -354,3 +365,178 @@ var _ = Describe("Feature: Cleanup orphan resources", func() {
 
 	})
 })
+
+var _ = Describe("Feature: KCP Nuke orphan GCP provider resources", func() {
+
+	It("Scenario: KCP Nuke deletes GCP Memorystore instances created for a RedisInstance that does not exist", func() {
+		const kymaName = "9a6e2c1d-4b3f-4e7a-8d5c-2f1b0a9e8d74"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		gcpMock := infra.GcpMock2().NewSubscription("nuke-orphan")
+		defer gcpMock.Delete()
+
+		By("Given Scope exists", func() {
+			kcpscope.Ignore.AddName(kymaName)
+
+			Expect(CreateScopeGcp2(infra.Ctx(), infra, scope, gcpMock.ProjectId(), WithName(kymaName))).
+				To(Succeed(), "failed creating scope")
+		})
+
+		vpcNetworkName := scope.Spec.Scope.Gcp.VpcNetwork
+
+		By("And Given GCP VPC network exists", func() {
+			op, err := gcpMock.InsertNetwork(infra.Ctx(), &computepb.InsertNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				NetworkResource: &computepb.Network{
+					Name: new(vpcNetworkName),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		net := &computepb.Network{}
+		addressName := "test-psa-address"
+
+		By("And Given GCP PSA address range exists", func() {
+			var err error
+			net, err = gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			op, err := gcpMock.InsertGlobalAddress(infra.Ctx(), &computepb.InsertGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				AddressResource: &computepb.Address{
+					Name:         new(addressName),
+					Address:      new("10.251.0.0"),
+					PrefixLength: new(int32(16)),
+					Network:      new(net.GetSelfLink()),
+					AddressType:  new(computepb.Address_INTERNAL.String()),
+					Purpose:      new(computepb.Address_VPC_PEERING.String()),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		By("And Given GCP PSA connection exists", func() {
+			_, err := gcpMock.CreateServiceConnection(infra.Ctx(), gcpMock.ProjectId(), net.GetName(), []string{addressName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		resolvePendingRedisOperations := func() error {
+			it := gcpMock.ListRedisInstanceOperations(infra.Ctx(), &longrunningpb.ListOperationsRequest{})
+			for op, err := it.Next(); err == nil; op, err = it.Next() {
+				if !op.Done && op.Name != "" {
+					if err := gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), op.Name); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+
+		createMemorystoreInstance := func(instanceId string) {
+			_, err := gcpMock.CreateRedisInstance(infra.Ctx(), &redispb.CreateInstanceRequest{
+				Parent:     gcputil.NewLocationName(gcpMock.ProjectId(), scope.Spec.Region).String(),
+				InstanceId: instanceId,
+				Instance: &redispb.Instance{
+					MemorySizeGb:      1,
+					Tier:              redispb.Instance_BASIC,
+					RedisVersion:      "REDIS_7_0",
+					ConnectMode:       redispb.Instance_PRIVATE_SERVICE_ACCESS,
+					AuthorizedNetwork: net.GetSelfLink(),
+					ReservedIpRange:   addressName,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolvePendingRedisOperations()).To(Succeed())
+		}
+
+		orphanRedisName := gcpredisinstanceclient.GetGcpMemoryStoreRedisName(gcpMock.ProjectId(), scope.Spec.Region, "6c2d8e4f-1a3b-4c5d-9e7f-0a1b2c3d4e5f")
+		otherRedisName := gcputil.NewInstanceName(gcpMock.ProjectId(), scope.Spec.Region, "customer-redis").String()
+
+		By("And Given Memorystore instance exists for a RedisInstance that does not exist", func() {
+			createMemorystoreInstance(path.Base(orphanRedisName))
+		})
+
+		By("And Given Memorystore instance not created by cloud-manager exists", func() {
+			createMemorystoreInstance(path.Base(otherRedisName))
+		})
+
+		nuke := &cloudcontrolv1beta1.Nuke{}
+		By("When Nuke for the Scope is created", func() {
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), nuke,
+				WithName("7e3b9c5a-2d4f-4a6b-8c1e-5f0d9a8b7c63"),
+				WithScope(kymaName),
+			)).To(Succeed())
+		})
+
+		By("Then Nuke status resource GcpRedisInstance has orphan Memorystore instance only", func() {
+			Eventually(func() error {
+				if err := LoadAndCheck(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions()); err != nil {
+					return err
+				}
+				sk := nuke.Status.GetKindNoCreate(gcpnukeclient.GcpRedisInstance)
+				if sk == nil {
+					return fmt.Errorf("kind %s not found in Nuke status", gcpnukeclient.GcpRedisInstance)
+				}
+				if _, ok := sk.Objects[orphanRedisName]; !ok {
+					return fmt.Errorf("expected resource %s in Nuke status", orphanRedisName)
+				}
+				return nil
+			}).Should(Succeed())
+			Expect(nuke.Status.GetKindNoCreate(gcpnukeclient.GcpRedisInstance).Objects).To(HaveLen(1))
+		})
+
+		By("When GCP Redis delete operation is resolved", func() {
+			Eventually(func() error {
+				ri, err := gcpMock.GetRedisInstance(infra.Ctx(), &redispb.GetInstanceRequest{Name: orphanRedisName})
+				if err != nil {
+					return err
+				}
+				if ri.State != redispb.Instance_DELETING {
+					return fmt.Errorf("expected orphan Memorystore instance to be deleting, but it is %s", ri.State)
+				}
+				return resolvePendingRedisOperations()
+			}).Should(Succeed())
+		})
+
+		By("Then Nuke status state is Completed", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), nuke, NewObjActions(),
+					HavingState("Completed"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then Nuke status resource GcpRedisInstance has state Deleted", func() {
+			sk := nuke.Status.GetKindNoCreate(gcpnukeclient.GcpRedisInstance)
+			Expect(sk).NotTo(BeNil())
+			Expect(sk.Objects).To(HaveKeyWithValue(orphanRedisName, cloudcontrolv1beta1.NukeResourceStatusDeleted))
+		})
+
+		By("And Then orphan Memorystore instance does not exist", func() {
+			_, err := gcpMock.GetRedisInstance(infra.Ctx(), &redispb.GetInstanceRequest{Name: orphanRedisName})
+			Expect(gcpmeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then Memorystore instance not created by cloud-manager still exists", func() {
+			ri, err := gcpMock.GetRedisInstance(infra.Ctx(), &redispb.GetInstanceRequest{Name: otherRedisName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ri.State).NotTo(Equal(redispb.Instance_DELETING))
+		})
+
+		By("And Then Scope is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Delete Nuke", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), nuke)).
+				To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,144 @@
+package cloudcontrol
+
+import (
+	"time"
+
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP PostgresInstance", func() {
+
+	It("Scenario: KCP AWS PostgresInstance is created and deleted", func() {
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		name := "dcf0deaf-8016-49df-9d18-ad8e568e25ac"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "7cf790f2-2d57-435f-ad9e-6ad0677c90eb"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		postgresInstance := &cloudcontrolv1beta1.PostgresInstance{}
+		instanceClass := "db.m7g.large"
+		engineVersion := "16"
+		storageGb := int32(50)
+		preferredMaintenanceWindow := new("sun:23:00-mon:01:30")
+
+		By("When PostgresInstance is created", func() {
+			Eventually(CreatePostgresInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					WithName(name),
+					WithRemoteRef("skr-postgres-example-aws"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithPostgresInstanceAws(),
+					WithKcpAwsPostgresInstanceClass(instanceClass),
+					WithKcpAwsPostgresEngineVersion(engineVersion),
+					WithKcpAwsPostgresStorageGb(storageGb),
+					WithKcpAwsPostgresPreferredMaintenanceWindow(preferredMaintenanceWindow),
+				).
+				Should(Succeed(), "failed creating PostgresInstance")
+		})
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+
+		var awsRdsInstance *rdstypes.DBInstance
+		By("Then AWS RDS instance is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id")).
+				Should(Succeed(), "expected PostgresInstance to get status.id")
+			awsRdsInstance = awsMock.GetAwsRdsInstanceByName(postgresInstance.Status.Id)
+			Expect(awsRdsInstance).NotTo(BeNil())
+		})
+
+		By("When AWS RDS instance is Available", func() {
+			awsMock.SetAwsRdsInstanceLifeCycleState(*awsRdsInstance.DBInstanceIdentifier, awsmeta.Rds_AVAILABLE)
+		})
+
+		By("Then PostgresInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected PostgresInstance to has Ready state, but it didn't")
+		})
+
+		By("And Then PostgresInstance has .status.primaryEndpoint set", func() {
+			Expect(len(postgresInstance.Status.PrimaryEndpoint) > 0).To(Equal(true))
+		})
+
+		By("And Then PostgresInstance has .status.username and .status.password set", func() {
+			Expect(postgresInstance.Status.Username).To(Equal("postgres"))
+			Expect(len(postgresInstance.Status.Password) > 0).To(Equal(true))
+		})
+
+		By("And Then PostgresInstance has .status.instanceClass set", func() {
+			Expect(postgresInstance.Status.InstanceClass).To(Equal(instanceClass))
+		})
+
+		By("And Then PostgresInstance has .status.storageGb set", func() {
+			Expect(postgresInstance.Status.StorageGb).To(Equal(storageGb))
+		})
+
+		// DELETE
+
+		By("When PostgresInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance).
+				Should(Succeed(), "failed deleting PostgresInstance")
+		})
+
+		By("And When AWS RDS instance is deleted", func() {
+			awsMock.DeleteAwsRdsInstanceByName(*awsRdsInstance.DBInstanceIdentifier)
+		})
+
+		By("Then PostgresInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance).
+				Should(Succeed(), "expected PostgresInstance not to exist (be deleted), but it still exists")
+		})
+	})
+})
@@ -0,0 +1,210 @@
+package cloudcontrol
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Feature: KCP PostgresInstance", func() {
+
+	It("Scenario: KCP Azure PostgresInstance is created and deleted", func() {
+
+		name := "3f0c6a52-1d8e-4b7a-9c2f-6e5d4b3a2f10"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAzure).
+				WithArguments(infra.Ctx(), infra, scope, WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "a7d1c5e2-4f3b-4c8d-8e6a-2b9f0d1c7e34"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithKcpIpRangeRemoteRef("some-remote-ref"),
+					WithKcpIpRangeNetwork("kcpNetworkCm.Name"),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		postgresInstance := &cloudcontrolv1beta1.PostgresInstance{}
+		skuName := "Standard_D2ds_v5"
+		storageGb := int32(64)
+
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+		var server *armpostgresqlflexibleservers.Server
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+
+		By("When KCP PostgresInstance is created", func() {
+			Eventually(CreatePostgresInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					WithName(name),
+					WithRemoteRef("skr-postgres-example-azure"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithPostgresInstanceAzure(),
+					WithKcpAzurePostgresSku(skuName, "GeneralPurpose"),
+					WithKcpAzurePostgresVersion("16"),
+					WithKcpAzurePostgresStorageGb(storageGb),
+					WithKcpAzurePostgresHighAvailability(true),
+					WithKcpAzurePostgresDatabaseName("app"),
+				).
+				Should(Succeed(), "failed creating PostgresInstance")
+		})
+
+		By("Then Azure Postgres server is created", func() {
+			Eventually(func() error {
+				s, err := azureMock.GetPostgresServer(infra.Ctx(), resourceGroupName, name)
+				if err != nil {
+					return err
+				}
+				server = s
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("And Then Azure Postgres server has SKU, storage and high availability as specified in KCP PostgresInstance", func() {
+			Expect(ptr.Deref(server.SKU.Name, "")).To(Equal(skuName))
+			Expect(ptr.Deref(server.SKU.Tier, "")).To(Equal(armpostgresqlflexibleservers.SKUTierGeneralPurpose))
+			Expect(ptr.Deref(server.Properties.Version, "")).To(Equal(armpostgresqlflexibleservers.ServerVersion("16")))
+			Expect(ptr.Deref(server.Properties.Storage.StorageSizeGB, 0)).To(Equal(storageGb))
+			Expect(ptr.Deref(server.Properties.HighAvailability.Mode, "")).To(Equal(armpostgresqlflexibleservers.HighAvailabilityModeZoneRedundant))
+		})
+
+		By("Then KCP PostgresInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected PostgresInstance to has Ready state, but it didn't")
+		})
+
+		By("And Then KCP PostgresInstance has status.id", func() {
+			Expect(postgresInstance.Status.Id).To(Equal(name))
+		})
+
+		By("And Then KCP PostgresInstance has .status.primaryEndpoint set", func() {
+			expected := fmt.Sprintf("%s:%d", ptr.Deref(server.Properties.FullyQualifiedDomainName, ""), 5432)
+			Expect(postgresInstance.Status.PrimaryEndpoint).To(Equal(expected))
+		})
+
+		By("And Then KCP PostgresInstance has .status.username and .status.password set", func() {
+			Expect(postgresInstance.Status.Username).To(Equal(ptr.Deref(server.Properties.AdministratorLogin, "")))
+			Expect(len(postgresInstance.Status.Password) > 0).To(Equal(true))
+		})
+
+		By("And Then KCP PostgresInstance has .status.instanceClass and .status.storageGb set", func() {
+			Expect(postgresInstance.Status.InstanceClass).To(Equal(skuName))
+			Expect(postgresInstance.Status.StorageGb).To(Equal(storageGb))
+		})
+
+		By("And Then Azure Postgres database is created", func() {
+			database, err := azureMock.GetPostgresDatabase(infra.Ctx(), resourceGroupName, name, "app")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(database).NotTo(BeNil())
+		})
+
+		By("And Then Postgres Private Dns Zone is created and linked to the kyma network", func() {
+			zoneName := azureutil.NewPostgresPrivateDnsZoneName()
+			zone, err := azureMock.GetPrivateDnsZone(infra.Ctx(), resourceGroupName, zoneName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zone).NotTo(BeNil())
+
+			link, err := azureMock.GetVirtualNetworkLink(infra.Ctx(), resourceGroupName, zoneName, scope.Spec.Scope.Azure.VpcNetwork)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(link).NotTo(BeNil())
+		})
+
+		By("And Then Private End Point is created", func() {
+			pep, err := azureMock.GetPrivateEndPoint(infra.Ctx(), resourceGroupName, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pep).NotTo(BeNil())
+		})
+
+		By("And Then Private Dns Zone Group is created", func() {
+			group, err := azureMock.GetPrivateDnsZoneGroup(infra.Ctx(), resourceGroupName, name, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).ToNot(BeNil())
+		})
+
+		// DELETE
+
+		By("When KCP PostgresInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance).
+				Should(Succeed(), "failed deleting PostgresInstance")
+		})
+
+		By("Then Azure Postgres server is in Dropping state", func() {
+			Eventually(func() error {
+				s, err := azureMock.GetPostgresServer(infra.Ctx(), resourceGroupName, name)
+				if err != nil {
+					return err
+				}
+				if ptr.Deref(s.Properties.State, "") != armpostgresqlflexibleservers.ServerStateDropping {
+					return fmt.Errorf("expected Azure Postgres server to be in Dropping state, but it was: %s", ptr.Deref(s.Properties.State, ""))
+				}
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("When Azure Postgres server is deleted", func() {
+			err := azureMock.AzureRemovePostgresServer(infra.Ctx(), resourceGroupName, name)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("Then KCP PostgresInstance does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance).
+				Should(Succeed(), "expected PostgresInstance not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then Private Dns Zone Group is deleted", func() {
+			group, err := azureMock.GetPrivateDnsZoneGroup(infra.Ctx(), resourceGroupName, name, name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group).To(BeNil())
+		})
+
+		By("And Then Private End Point is deleted", func() {
+			pep, err := azureMock.GetPrivateEndPoint(infra.Ctx(), resourceGroupName, name)
+			Expect(err).To(HaveOccurred())
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+			Expect(pep).To(BeNil())
+		})
+	})
+
+})
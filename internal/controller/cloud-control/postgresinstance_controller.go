@@ -0,0 +1,84 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudcontrol
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awspostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/postgresinstance"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azurepostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance"
+	azurepostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance/client"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcppostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance"
+	gcppostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+func SetupPostgresInstanceReconciler(
+	kcpManager manager.Manager,
+	awsRdsClientProvider awsclient.SkrClientProvider[awsclient.RdsClient],
+	gcpCloudSqlClientProvider gcpclient.GcpClientProvider[gcppostgresinstanceclient.Client],
+	azurePostgresClientProvider azureclient.ClientProvider[azurepostgresinstanceclient.Client],
+) error {
+	return NewPostgresInstanceReconciler(
+		postgresinstance.NewPostgresInstanceReconciler(
+			composed.NewStateFactory(composed.NewStateClusterFromCluster(kcpManager)),
+			focal.NewStateFactory(),
+			awspostgresinstance.NewStateFactory(awsRdsClientProvider),
+			gcppostgresinstance.NewStateFactory(gcpCloudSqlClientProvider),
+			azurepostgresinstance.NewStateFactory(azurePostgresClientProvider),
+		),
+	).SetupWithManager(kcpManager)
+}
+
+func NewPostgresInstanceReconciler(
+	reconciler postgresinstance.PostgresInstanceReconciler,
+) *PostgresInstanceReconciler {
+	return &PostgresInstanceReconciler{
+		Reconciler: reconciler,
+	}
+}
+
+type PostgresInstanceReconciler struct {
+	Reconciler postgresinstance.PostgresInstanceReconciler
+}
+
+//+kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=postgresinstances,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=postgresinstances/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=cloud-control.kyma-project.io,resources=postgresinstances/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *PostgresInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.Reconciler.Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PostgresInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cloudcontrolv1beta1.PostgresInstance{}, builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Complete(r)
+}
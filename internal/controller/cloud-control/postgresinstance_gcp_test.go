@@ -0,0 +1,195 @@
+package cloudcontrol
+
+import (
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP PostgresInstance", func() {
+
+	It("Scenario: KCP GCP PostgresInstance is created and deleted", func() {
+
+		name := "5b0f4c33-9d1e-4a57-8f0b-3c2b8e6a7d41"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		gcpMock := infra.GcpMock2().NewSubscription("postgres-instance")
+		defer gcpMock.Delete()
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeGcp2).
+				WithArguments(infra.Ctx(), infra, scope, gcpMock.ProjectId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		vpcNetworkName := scope.Spec.Scope.Gcp.VpcNetwork
+
+		By("And Given GCP VPC network exists", func() {
+			op, err := gcpMock.InsertNetwork(infra.Ctx(), &computepb.InsertNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				NetworkResource: &computepb.Network{
+					Name: new(vpcNetworkName),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		addressName := "test-psa-address"
+		By("And Given GCP PSA address range exists", func() {
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			op, err := gcpMock.InsertGlobalAddress(infra.Ctx(), &computepb.InsertGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				AddressResource: &computepb.Address{
+					Name:         new(addressName),
+					Address:      new("10.251.0.0"),
+					PrefixLength: new(int32(16)),
+					Network:      new(net.GetSelfLink()),
+					AddressType:  new(computepb.Address_INTERNAL.String()),
+					Purpose:      new(computepb.Address_VPC_PEERING.String()),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		By("And Given GCP PSA connection exists", func() {
+			addr, err := gcpMock.GetGlobalAddress(infra.Ctx(), &computepb.GetGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				Address: addressName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = gcpMock.CreateServiceConnection(infra.Ctx(), gcpMock.ProjectId(), net.GetName(), []string{addr.GetName()})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		kcpIpRangeName := "0e6f3a1d-6c8b-4e2a-b5d7-9a41c2f8e013"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition and Status.Id", func() {
+			kcpIpRange.Status.Id = addressName
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		postgresInstance := &cloudcontrolv1beta1.PostgresInstance{}
+		tier := "db-custom-2-8192"
+		dataDiskSizeGb := int32(50)
+
+		By("When PostgresInstance is created", func() {
+			Eventually(CreatePostgresInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					WithName(name),
+					WithRemoteRef("skr-postgres-example-gcp"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithPostgresInstanceGcp(),
+					WithKcpGcpPostgresTier(tier),
+					WithKcpGcpPostgresDatabaseVersion("POSTGRES_16"),
+					WithKcpGcpPostgresDataDiskSizeGb(dataDiskSizeGb),
+					WithKcpGcpPostgresAvailabilityType("REGIONAL"),
+				).
+				Should(Succeed(), "failed creating PostgresInstance")
+		})
+
+		By("Then GCP Cloud SQL instance is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id")).
+				Should(Succeed(), "expected PostgresInstance to get status.id")
+		})
+
+		By("When GCP Cloud SQL instance operations are resolved", func() {
+			Expect(gcpMock.ResolveCloudSqlInstanceOperations(infra.Ctx(), postgresInstance.Status.Id)).To(Succeed())
+		})
+
+		By("Then PostgresInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected PostgresInstance to has Ready state, but it didn't")
+		})
+
+		By("And Then PostgresInstance has .status.primaryEndpoint set", func() {
+			Expect(postgresInstance.Status.PrimaryEndpoint).To(Equal("10.251.0.0:5432"))
+		})
+
+		By("And Then PostgresInstance has .status.username and .status.password set", func() {
+			Expect(postgresInstance.Status.Username).To(Equal("postgres"))
+			Expect(len(postgresInstance.Status.Password) > 0).To(Equal(true))
+		})
+
+		By("And Then PostgresInstance has .status.instanceClass set", func() {
+			Expect(postgresInstance.Status.InstanceClass).To(Equal(tier))
+		})
+
+		By("And Then PostgresInstance has .status.storageGb set", func() {
+			Expect(postgresInstance.Status.StorageGb).To(Equal(dataDiskSizeGb))
+		})
+
+		// DELETE
+
+		By("When PostgresInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance).
+				Should(Succeed(), "failed deleting PostgresInstance")
+		})
+
+		By("And When GCP Cloud SQL delete operation is resolved", func() {
+			Eventually(func() error {
+				_, err := gcpMock.GetSqlInstance(infra.Ctx(), gcpMock.ProjectId(), postgresInstance.Status.Id)
+				if gcpmeta.IsNotFound(err) {
+					return nil
+				}
+				return gcpMock.ResolveCloudSqlInstanceOperations(infra.Ctx(), postgresInstance.Status.Id)
+			}).Should(Succeed(), "expected to resolve delete operation")
+		})
+
+		By("Then PostgresInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), postgresInstance).
+				Should(Succeed(), "expected PostgresInstance not to exist (be deleted), but it still exists")
+		})
+	})
+
+})
@@ -170,6 +170,164 @@ var _ = Describe("Feature: KCP RedisInstance", func() {
 		})
 	})
 
+	It("Scenario: KCP AWS RedisInstance tier change is deferred to the maintenance window", func() {
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		name := "b3f1c2d4-6a8e-4f0b-9c7d-2e5a1b3c4d6f"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeAws).
+				WithArguments(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		kcpIpRangeName := "c4a2d3e5-7b9f-4a1c-8d6e-3f6b2c4d5e7a"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		redisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		cacheNodeType := "cache.t3.micro"
+		targetCacheNodeType := "cache.m5.large"
+
+		By("And Given RedisInstance with deferred tier change exists", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithName(name),
+					WithRemoteRef("skr-redis-example-aws-tier"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisInstanceAws(),
+					WithKcpAwsCacheNodeType(cacheNodeType),
+					WithKcpAwsEngineVersion("7.0"),
+					WithKcpAwsPreferredMaintenanceWindow(new("sun:23:00-mon:01:30")),
+					WithKcpAwsDeferTierChange(true),
+				).
+				Should(Succeed(), "failed creating RedisInstance")
+		})
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+
+		var awsElastiCacheClusterInstance *elasticachetypes.ReplicationGroup
+		By("And Given AWS Redis is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id")).
+				Should(Succeed(), "expected RedisInstance to get status.id")
+			awsElastiCacheClusterInstance = awsMock.GetAwsElastiCacheByName(redisInstance.Status.Id)
+		})
+
+		By("And Given AWS Redis is Available", func() {
+			awsMock.SetAwsElastiCacheLifeCycleState(*awsElastiCacheClusterInstance.ReplicationGroupId, awsmeta.ElastiCache_AVAILABLE)
+			awsMock.SetAwsElastiCacheUserGroupLifeCycleState(*awsElastiCacheClusterInstance.ReplicationGroupId, awsmeta.ElastiCache_UserGroup_ACTIVE)
+		})
+
+		By("And Given RedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected RedisInstance to has Ready state, but it didn't")
+		})
+
+		By("When RedisInstance cache node type is changed", func() {
+			Eventually(UpdateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithKcpAwsCacheNodeType(targetCacheNodeType),
+				).
+				Should(Succeed(), "failed updating RedisInstance manifest")
+		})
+
+		By("Then RedisInstance has Updating condition with TierChangeDeferred reason", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionReasonTrue(cloudcontrolv1beta1.ConditionTypeUpdating, cloudcontrolv1beta1.ReasonTierChangeDeferred),
+					HavingState(string(cloudcontrolv1beta1.StateUpdating)),
+				).
+				Should(Succeed(), "expected RedisInstance to have deferred Updating condition, but it didn't")
+		})
+
+		By("And Then RedisInstance has .status.pendingNodeType set", func() {
+			Expect(redisInstance.Status.PendingNodeType).To(Equal(targetCacheNodeType))
+			Expect(redisInstance.Status.NodeType).To(Equal(cacheNodeType))
+		})
+
+		By("And Then AWS Redis has the cache node type change pending", func() {
+			node := awsMock.GetAWsElastiCacheNodeByName(*awsElastiCacheClusterInstance.ReplicationGroupId)
+			Expect(node.PendingModifiedValues).NotTo(BeNil())
+			Expect(ptr.Deref(node.PendingModifiedValues.CacheNodeType, "")).To(Equal(targetCacheNodeType))
+			Expect(ptr.Deref(node.CacheNodeType, "")).To(Equal(cacheNodeType))
+		})
+
+		By("When AWS Redis maintenance window applies pending modifications", func() {
+			awsMock.ApplyAwsElastiCachePendingModifications(*awsElastiCacheClusterInstance.ReplicationGroupId)
+		})
+
+		By("Then RedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected RedisInstance to has Ready state, but it didn't")
+		})
+
+		By("And Then RedisInstance has new .status.nodeType and no .status.pendingNodeType", func() {
+			Expect(redisInstance.Status.NodeType).To(Equal(targetCacheNodeType))
+			Expect(redisInstance.Status.PendingNodeType).To(BeEmpty())
+		})
+
+		// DELETE
+
+		By("When RedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "failed deleting RedisInstance")
+		})
+
+		By("And When AWS Redis state is deleted", func() {
+			awsMock.DeleteAwsElastiCacheByName(*awsElastiCacheClusterInstance.ReplicationGroupId)
+			awsMock.DeleteAwsElastiCacheUserGroupByName(*awsElastiCacheClusterInstance.ReplicationGroupId)
+		})
+
+		By("Then RedisInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "expected RedisInstance not to exist (be deleted), but it still exists")
+		})
+	})
+
 	It("Scenario: KCP AWS RedisInstance is upgraded (6.x -> 7.0)", func() {
 
 		awsAccount := infra.AwsMock().NewAccount()
@@ -0,0 +1,193 @@
+package cloudcontrol
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP RedisInstance SAP", func() {
+
+	It("Scenario: KCP SAP RedisInstance is created and deleted", func() {
+		name := "4b8e2d1f-6a3c-4f7e-9b05-c2d8e1a7f3b6"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		sapMock := infra.SapMock().NewProject()
+
+		By("Given OpenStack Scope exists", func() {
+			// Tell Scope reconciler to ignore this Scope
+			kcpscope.Ignore.AddName(name)
+
+			Expect(CreateScopeOpenStack(infra.Ctx(), infra, scope, sapMock.ProviderParams(), WithName(name))).
+				To(Succeed(), "failed creating Scope")
+		})
+
+		var kcpNetworkKyma *cloudcontrolv1beta1.Network
+
+		By("And Given KCP Kyma Network exists in Ready state", func() {
+			kcpNetworkKyma = cloudcontrolv1beta1.NewNetworkBuilder().
+				WithScope(name).
+				WithName(common.KcpNetworkKymaCommonName(name)).
+				WithOpenStackRef(scope.Spec.Scope.OpenStack.DomainName, scope.Spec.Scope.OpenStack.TenantName, "", scope.Spec.Scope.OpenStack.VpcNetwork).
+				WithType(cloudcontrolv1beta1.NetworkTypeKyma).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNetworkKyma).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNetworkKyma, NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady)).
+				Should(Succeed())
+		})
+
+		By("And Given SAP infra exists", func() {
+			_, err := CreateSapGardenerResources(infra.Ctx(), sapMock, infra.Garden().Namespace(), scope.Spec.ShootName, "10.250.0.0/16")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		By("And Given KCP IpRange exists in Ready state", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(name),
+					WithKcpIpRangeRemoteRef("some-remote-ref"),
+					WithKcpIpRangeNetwork(kcpNetworkKyma.Name),
+					WithScope(name),
+				).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given SAP database flavor exists", func() {
+			sapMock.AddDatabaseFlavor("redis_c2_m4")
+		})
+
+		redisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("When RedisInstance is created", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithName(name),
+					WithRemoteRef("foo"),
+					WithScope(name),
+					WithIpRange(kcpIpRange.Name),
+					WithRedisInstanceOpenStack(),
+					WithKcpOpenStackFlavor("redis_c2_m4", 8),
+					WithKcpOpenStackEngine("valkey", "8.0"),
+					WithKcpOpenStackAuthEnabled(true),
+				).
+				Should(Succeed(), "failed creating RedisInstance")
+		})
+
+		var theInstance *instances.Instance
+
+		By("Then SAP database instance is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id")).
+				Should(Succeed(), "expected RedisInstance to get status.id")
+
+			x, err := sapMock.GetDatabaseInstance(infra.Ctx(), redisInstance.Status.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(x).NotTo(BeNil())
+			theInstance = x
+		})
+
+		By("And Then SAP database instance has requested flavor, volume and datastore", func() {
+			Expect(theInstance.Volume.Size).To(Equal(8))
+			Expect(theInstance.Datastore.Type).To(Equal("valkey"))
+			Expect(theInstance.Datastore.Version).To(Equal("8.0"))
+		})
+
+		var thePort *ports.Port
+
+		By("And Then SAP port is created in the IpRange subnet", func() {
+			arr, err := sapMock.ListPorts(infra.Ctx(), ports.ListOpts{Name: "cm-" + name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(arr).To(HaveLen(1))
+			thePort = &arr[0]
+			Expect(thePort.FixedIPs).To(HaveLen(1))
+			Expect(thePort.FixedIPs[0].SubnetID).To(Equal(kcpIpRange.Status.Subnets[0].Id))
+			Expect(thePort.DeviceID).To(Equal(theInstance.ID))
+		})
+
+		By("When SAP database instance is active", func() {
+			sapMock.SetDatabaseInstanceStatus(theInstance.ID, "ACTIVE")
+		})
+
+		By("Then RedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed(), "expected RedisInstance to have Ready condition, but it didn't")
+		})
+
+		By("And Then RedisInstance has status.primaryEndpoint set", func() {
+			Expect(redisInstance.Status.PrimaryEndpoint).To(Equal(thePort.FixedIPs[0].IPAddress + ":6379"))
+		})
+
+		By("And Then RedisInstance has status.authString set", func() {
+			Expect(redisInstance.Status.AuthString).NotTo(BeEmpty())
+		})
+
+		// DELETE
+
+		By("When RedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "failed deleting RedisInstance")
+		})
+
+		By("Then RedisInstance does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "expected RedisInstance not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then SAP database instance does not exist", func() {
+			x, err := sapMock.GetDatabaseInstance(infra.Ctx(), theInstance.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(x).To(BeNil())
+		})
+
+		By("And Then SAP port does not exist", func() {
+			x, err := sapMock.GetPort(infra.Ctx(), thePort.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(x).To(BeNil())
+		})
+
+		By("// cleanup: delete KCP IpRange", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), kcpIpRange)).
+				To(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpIpRange).
+				Should(Succeed())
+		})
+
+		By("// cleanup: delete Scope", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), scope)).
+				To(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+	})
+
+})
@@ -31,6 +31,9 @@ import (
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance"
 	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	sapredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance"
+	sapredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance/client"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -43,6 +46,7 @@ func SetupRedisInstanceReconciler(
 	gcpFilestoreClientProvider gcpclient.GcpClientProvider[gcpredisinstanceclient.MemorystoreClient],
 	azureFilestoreClientProvider azureclient.ClientProvider[azureredisinstanceclient.Client],
 	awsFilestoreClientProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient],
+	sapClientProvider sapclient.SapClientProvider[sapredisinstanceclient.Client],
 	env abstractions.Environment,
 ) error {
 	if env == nil {
@@ -55,6 +59,7 @@ func SetupRedisInstanceReconciler(
 			gcpredisinstance.NewStateFactory(gcpFilestoreClientProvider, env),
 			azureredisinstance.NewStateFactory(azureFilestoreClientProvider),
 			awsredisinstance.NewStateFactory(awsFilestoreClientProvider),
+			sapredisinstance.NewStateFactory(sapClientProvider),
 		),
 	).SetupWithManager(kcpManager)
 }
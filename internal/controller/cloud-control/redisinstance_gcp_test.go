@@ -6,12 +6,18 @@ import (
 
 	"cloud.google.com/go/compute/apiv1/computepb"
 	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"cloud.google.com/go/redis/apiv1/redispb"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	kcpiprange "github.com/kyma-project/cloud-manager/pkg/kcp/iprange"
+	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
 	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/api/storage/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Feature: KCP RedisInstance", func() {
@@ -223,4 +229,439 @@ var _ = Describe("Feature: KCP RedisInstance", func() {
 		})
 	})
 
+	It("Scenario: KCP GCP RedisInstance is restored from RDB file", func() {
+
+		name := "3e7d9c1a-58b2-4f6e-a0d4-c2b19e8f7a65"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		gcpMock := infra.GcpMock2().NewSubscription("redis-instance-restore")
+		defer gcpMock.Delete()
+
+		By("Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeGcp2).
+				WithArguments(infra.Ctx(), infra, scope, gcpMock.ProjectId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		vpcNetworkName := scope.Spec.Scope.Gcp.VpcNetwork
+
+		By("And Given GCP VPC network exists", func() {
+			op, err := gcpMock.InsertNetwork(infra.Ctx(), &computepb.InsertNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				NetworkResource: &computepb.Network{
+					Name: new(vpcNetworkName),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		addressName := "test-psa-address-restore"
+		By("And Given GCP PSA address range exists", func() {
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			op, err := gcpMock.InsertGlobalAddress(infra.Ctx(), &computepb.InsertGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				AddressResource: &computepb.Address{
+					Name:         new(addressName),
+					Address:      new("10.253.0.0"),
+					PrefixLength: new(int32(16)),
+					Network:      new(net.GetSelfLink()),
+					AddressType:  new(computepb.Address_INTERNAL.String()),
+					Purpose:      new(computepb.Address_VPC_PEERING.String()),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		By("And Given GCP PSA connection exists", func() {
+			addr, err := gcpMock.GetGlobalAddress(infra.Ctx(), &computepb.GetGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				Address: addressName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = gcpMock.CreateServiceConnection(infra.Ctx(), gcpMock.ProjectId(), net.GetName(), []string{addr.GetName()})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		kcpIpRangeName := "9a2c4e6f-1b3d-4a5c-8e7f-0d2b4c6e8a1f"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition and Status.Id", func() {
+			addr, err := gcpMock.GetGlobalAddress(infra.Ctx(), &computepb.GetGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				Address: addressName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			kcpIpRange.Status.Id = addr.GetSelfLink()
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		bucketName := "redis-restore-bucket"
+		objectName := "backups/redis.rdb"
+		importUri := fmt.Sprintf("gs://%s/%s", bucketName, objectName)
+
+		By("And Given RDB file exists in GCS bucket", func() {
+			_, err := gcpMock.InsertStorageBucket(infra.Ctx(), gcpMock.ProjectId(), &storage.Bucket{Name: bucketName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gcpMock.InsertStorageObject(infra.Ctx(), bucketName, objectName)).To(Succeed())
+		})
+
+		redisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("When RedisInstance is created with import uri", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithName(name),
+					WithRemoteRef("skr-redis-restore-example"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisInstanceGcp(),
+					WithKcpGcpRedisInstanceTier("BASIC"),
+					WithKcpGcpRedisInstanceMemorySizeGb(5),
+					WithKcpGcpRedisInstanceRedisVersion("REDIS_7_0"),
+					WithKcpGcpRedisInstanceImportUri(importUri),
+				).
+				Should(Succeed(), "failed creating RedisInstance")
+		})
+
+		var createOpName string
+		By("And When GCP Redis create operation is resolved", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id")).
+				Should(Succeed(), "expected RedisInstance to get status.id")
+
+			it := gcpMock.ListRedisInstanceOperations(infra.Ctx(), &longrunningpb.ListOperationsRequest{})
+			for op, err := it.Next(); err == nil; op, err = it.Next() {
+				if !op.Done && op.Name != "" {
+					createOpName = op.Name
+					break
+				}
+			}
+			Expect(createOpName).ToNot(BeEmpty(), "expected to find a pending create operation")
+			Expect(gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), createOpName)).To(Succeed())
+		})
+
+		By("Then RedisInstance has Restoring condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeRestoring),
+					HavingFieldSet("status", "restoreOpIdentifier"),
+				).
+				Should(Succeed(), "expected RedisInstance to have Restoring condition")
+		})
+
+		By("When GCP Redis import operation is resolved", func() {
+			Expect(gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), redisInstance.Status.RestoreOpIdentifier)).To(Succeed())
+		})
+
+		By("Then RedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState("Ready"),
+				).
+				Should(Succeed(), "expected RedisInstance to has Ready state, but it didn't")
+		})
+
+		By("And Then RedisInstance has .status.restoredFrom set to import uri", func() {
+			Expect(redisInstance.Status.RestoredFrom).To(Equal(importUri))
+			Expect(redisInstance.Status.RestoreOpIdentifier).To(BeEmpty())
+		})
+
+		// DELETE
+
+		By("When RedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "failed deleting RedisInstance")
+		})
+
+		By("And When GCP Redis delete operation is resolved", func() {
+			Eventually(func() error {
+				it := gcpMock.ListRedisInstanceOperations(infra.Ctx(), &longrunningpb.ListOperationsRequest{})
+				for op, err := it.Next(); err == nil; op, err = it.Next() {
+					if !op.Done && op.Name != "" {
+						return gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), op.Name)
+					}
+				}
+				return fmt.Errorf("no pending delete operation found yet")
+			}).Should(Succeed(), "expected to find and resolve delete operation")
+		})
+
+		By("Then RedisInstance does not exist", func() {
+			Eventually(IsDeleted, 5*time.Second).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "expected RedisInstance not to exist (be deleted), but it still exists")
+		})
+	})
+
+	It("Scenario: KCP GCP RedisInstance drift is detected and corrected", func() {
+
+		name := "0d4f3c0e-6a3b-4b8e-9a51-7d1b3e2f6c85"
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		gcpMock := infra.GcpMock2().NewSubscription("redis-instance-drift")
+		defer gcpMock.Delete()
+
+		resolvePendingOperations := func() error {
+			it := gcpMock.ListRedisInstanceOperations(infra.Ctx(), &longrunningpb.ListOperationsRequest{})
+			for op, err := it.Next(); err == nil; op, err = it.Next() {
+				if !op.Done && op.Name != "" {
+					if err := gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), op.Name); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+
+		By("Given drift detection is enabled for RedisInstance with Report policy", func() {
+			drift.Config.RedisInstance = drift.PolicyReport
+			drift.Config.Interval = time.Second
+			DeferCleanup(func() {
+				drift.Config.RedisInstance = drift.PolicyIgnore
+				drift.Config.Interval = time.Hour
+			})
+		})
+
+		By("And Given Scope exists", func() {
+			// Tell Scope reconciler to ignore this kymaName
+			kcpscope.Ignore.AddName(name)
+
+			Eventually(CreateScopeGcp2).
+				WithArguments(infra.Ctx(), infra, scope, gcpMock.ProjectId(), WithName(name)).
+				Should(Succeed())
+		})
+
+		vpcNetworkName := scope.Spec.Scope.Gcp.VpcNetwork
+
+		By("And Given GCP VPC network exists", func() {
+			op, err := gcpMock.InsertNetwork(infra.Ctx(), &computepb.InsertNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				NetworkResource: &computepb.Network{
+					Name: new(vpcNetworkName),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		addressName := "test-psa-address-drift"
+		By("And Given GCP PSA address range exists", func() {
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			op, err := gcpMock.InsertGlobalAddress(infra.Ctx(), &computepb.InsertGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				AddressResource: &computepb.Address{
+					Name:         new(addressName),
+					Address:      new("10.252.0.0"),
+					PrefixLength: new(int32(16)),
+					Network:      new(net.GetSelfLink()),
+					AddressType:  new(computepb.Address_INTERNAL.String()),
+					Purpose:      new(computepb.Address_VPC_PEERING.String()),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+		})
+
+		By("And Given GCP PSA connection exists", func() {
+			addr, err := gcpMock.GetGlobalAddress(infra.Ctx(), &computepb.GetGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				Address: addressName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = gcpMock.CreateServiceConnection(infra.Ctx(), gcpMock.ProjectId(), net.GetName(), []string{addr.GetName()})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		kcpIpRangeName := "5b1e8f2a-2c7d-4e4b-8f0d-6a9c3d2e1b47"
+		kcpIpRange := &cloudcontrolv1beta1.IpRange{}
+
+		// Tell IpRange reconciler to ignore this kymaName
+		kcpiprange.Ignore.AddName(kcpIpRangeName)
+		By("And Given KCP IPRange exists", func() {
+			Eventually(CreateKcpIpRange).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithName(kcpIpRangeName),
+					WithScope(scope.Name),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given KCP IpRange has Ready condition and Status.Id", func() {
+			addr, err := gcpMock.GetGlobalAddress(infra.Ctx(), &computepb.GetGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				Address: addressName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			kcpIpRange.Status.Id = addr.GetSelfLink()
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpIpRange,
+					WithKcpIpRangeStatusCidr(kcpIpRange.Spec.Cidr),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "Expected KCP IpRange to become ready")
+		})
+
+		redisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		memorySizeGb := int32(5)
+
+		By("And Given RedisInstance is created", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					WithName(name),
+					WithRemoteRef("skr-redis-drift-example"),
+					WithIpRange(kcpIpRangeName),
+					WithScope(name),
+					WithRedisInstanceGcp(),
+					WithKcpGcpRedisInstanceTier("BASIC"),
+					WithKcpGcpRedisInstanceMemorySizeGb(memorySizeGb),
+					WithKcpGcpRedisInstanceRedisVersion("REDIS_7_0"),
+				).
+				Should(Succeed(), "failed creating RedisInstance")
+		})
+
+		By("And Given GCP Redis is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id")).
+				Should(Succeed(), "expected RedisInstance to get status.id")
+			Expect(resolvePendingOperations()).To(Succeed())
+		})
+
+		By("And Given RedisInstance is in sync with GCP Redis", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingCondition(cloudcontrolv1beta1.ConditionTypeDrifted, metav1.ConditionFalse, cloudcontrolv1beta1.ReasonInSync, ""),
+				).
+				Should(Succeed(), "expected RedisInstance to be Ready and in sync")
+		})
+
+		gcpRedisName := gcpredisinstanceclient.GetGcpMemoryStoreRedisName(gcpMock.ProjectId(), scope.Spec.Region, redisInstance.Name)
+
+		By("When GCP Redis memory size is changed out of band", func() {
+			_, err := gcpMock.UpdateRedisInstance(infra.Ctx(), &redispb.UpdateInstanceRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"memory_size_gb"}},
+				Instance: &redispb.Instance{
+					Name:         gcpRedisName,
+					MemorySizeGb: memorySizeGb + 1,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolvePendingOperations()).To(Succeed())
+		})
+
+		By("Then RedisInstance has Drifted condition listing memorySizeGb", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingCondition(cloudcontrolv1beta1.ConditionTypeDrifted, metav1.ConditionTrue, cloudcontrolv1beta1.ReasonDriftDetected, "memorySizeGb"),
+				).
+				Should(Succeed(), "expected RedisInstance to have Drifted condition")
+		})
+
+		By("And Then GCP Redis is not changed back", func() {
+			ri, err := gcpMock.GetRedisInstance(infra.Ctx(), &redispb.GetInstanceRequest{Name: gcpRedisName})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ri.MemorySizeGb).To(Equal(memorySizeGb + 1))
+		})
+
+		By("When drift policy for RedisInstance is changed to Correct", func() {
+			drift.Config.RedisInstance = drift.PolicyCorrect
+		})
+
+		By("Then GCP Redis memory size is changed back to the spec", func() {
+			Eventually(func() error {
+				if err := resolvePendingOperations(); err != nil {
+					return err
+				}
+				ri, err := gcpMock.GetRedisInstance(infra.Ctx(), &redispb.GetInstanceRequest{Name: gcpRedisName})
+				if err != nil {
+					return err
+				}
+				if ri.MemorySizeGb != memorySizeGb {
+					return fmt.Errorf("expected GCP Redis memory size %d, but got %d", memorySizeGb, ri.MemorySizeGb)
+				}
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("And Then RedisInstance is Ready and in sync", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingCondition(cloudcontrolv1beta1.ConditionTypeDrifted, metav1.ConditionFalse, cloudcontrolv1beta1.ReasonInSync, ""),
+				).
+				Should(Succeed(), "expected RedisInstance to be Ready and in sync")
+		})
+
+		// DELETE
+
+		By("When RedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), redisInstance).
+				Should(Succeed(), "failed deleting RedisInstance")
+		})
+
+		By("Then RedisInstance does not exist", func() {
+			Eventually(func() error {
+				if err := resolvePendingOperations(); err != nil {
+					return err
+				}
+				return IsDeleted(infra.Ctx(), infra.KCP().Client(), redisInstance)
+			}).Should(Succeed(), "expected RedisInstance not to exist (be deleted), but it still exists")
+		})
+	})
+
 })
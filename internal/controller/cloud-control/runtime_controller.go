@@ -15,13 +15,16 @@ import (
 func SetupRuntimeReconciler(
 	ctx context.Context,
 	mgr ctrl.Manager,
+	awsStateFactory awsruntime.StateFactory,
+	azureStateFactory azureruntime.StateFactory,
+	gcpStateFactory gcpruntime.StateFactory,
 ) error {
 	return NewRuntimeController(
 		kcpruntime.NewRuntimeReconciler(
 			composed.NewStateFactory(composed.NewStateClusterFromCluster(mgr)),
-			awsruntime.NewStateFactory(),
-			azureruntime.NewStateFactory(),
-			gcpruntime.NewStateFactory(),
+			awsStateFactory,
+			azureStateFactory,
+			gcpStateFactory,
 		),
 	).SetupWithManager(ctx, mgr)
 }
@@ -37,7 +40,7 @@ type RuntimeReconciler struct {
 }
 
 // +kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes,verbs=get;list;watch;update;patch
-// +kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes/status,verbs=get
+// +kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes/status,verbs=get;update;patch
 
 func (r *RuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return r.reconciler.Reconcile(ctx, req)
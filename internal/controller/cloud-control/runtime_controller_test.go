@@ -1,18 +1,41 @@
 package cloudcontrol
 
 import (
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	gardenertypes "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/cloud-manager/api"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/external/infrastructuremanagerv1"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	scopeconfig "github.com/kyma-project/cloud-manager/pkg/kcp/scope/config"
 	kcpsubscription "github.com/kyma-project/cloud-manager/pkg/kcp/subscription"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+func havingRuntimeConditionTrue(conditionType string) ObjAssertion {
+	return func(obj client.Object) error {
+		rt, ok := obj.(*infrastructuremanagerv1.Runtime)
+		if !ok {
+			return fmt.Errorf("expected *infrastructuremanagerv1.Runtime, but got %T", obj)
+		}
+		if !meta.IsStatusConditionTrue(rt.Status.Conditions, conditionType) {
+			return fmt.Errorf("expected Runtime %s to have status condition %s true, but it has %v", rt.Name, conditionType, rt.Status.Conditions)
+		}
+		return nil
+	}
+}
+
 var _ = Describe("Feature: Runtime", func() {
 
 	It("Scenario: Runtime is created and deleted", func() {
@@ -95,17 +118,192 @@ var _ = Describe("Feature: Runtime", func() {
 			Expect(Delete(infra.Ctx(), infra.KCP().Client(), runtime)).To(Succeed())
 		})
 
+		By("Then Runtime does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), runtime).
+				Should(Succeed())
+		})
+
 		By("// cleanup: delete Subscription", func() {
 			Expect(Delete(infra.Ctx(), infra.KCP().Client(), subscription)).
 				To(Succeed())
 			Expect(IsDeleted(infra.Ctx(), infra.KCP().Client(), subscription)).
 				To(Succeed())
 		})
+	})
+
+	It("Scenario: Runtime with security hardening provisions KMS key, flow logs and default deny on AWS", func() {
+
+		name := "4c6bd86e-1b1d-4bc8-9a4e-2e5fd3a6b1c7"
+		shootName := "some-shoot-b71d3"
+		secretBindingName := "my-hardened-secret-binding"
+		region := "eu-west-1"
+
+		var runtime *infrastructuremanagerv1.Runtime
+		subscription := &cloudcontrolv1beta1.Subscription{}
+
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+		awsMock := awsAccount.Region(region)
+
+		kcpsubscription.Ignore.AddName(secretBindingName)
 
-		By("// cleanup: delete Runtime", func() {
-			_, err := composed.PatchObjRemoveFinalizer(infra.Ctx(), api.CommonFinalizerDeletionHook, runtime, infra.KCP().Client())
+		var awsInfra *AwsGardenerInfra
+		var defaultSgId string
+
+		By("Given AWS shoot infra exists", func() {
+			createdInfra, err := CreateAwsGardenerResources(infra.Ctx(), awsMock, scopeconfig.ScopeConfig.GardenerNamespace, shootName, "10.250.0.0/16", "10.250.0.0/16")
+			Expect(err).NotTo(HaveOccurred())
+			awsInfra = createdInfra
+		})
+
+		By("And Given shoot VPC default security group allows ingress", func() {
+			sgId, err := awsMock.CreateSecurityGroup(infra.Ctx(), ptr.Deref(awsInfra.VPC.VpcId, ""), "default", nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(IsDeleted(infra.Ctx(), infra.KCP().Client(), runtime)).
+			defaultSgId = sgId
+			Expect(awsMock.AuthorizeSecurityGroupIngress(infra.Ctx(), defaultSgId, []ec2types.IpPermission{
+				{
+					IpProtocol: new("tcp"),
+					FromPort:   new(int32(22)),
+					ToPort:     new(int32(22)),
+					IpRanges:   []ec2types.IpRange{{CidrIp: new("0.0.0.0/0")}},
+				},
+			})).To(Succeed())
+		})
+
+		By("When Runtime with security hardening is created", func() {
+			runtime = &infrastructuremanagerv1.Runtime{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: infra.KCP().Namespace(),
+					Labels: map[string]string{
+						cloudcontrolv1beta1.LabelRuntimeId:            name,
+						cloudcontrolv1beta1.LabelScopeGlobalAccountId: "4b1b6b7e-0a6f-4c02-9d3a-6b7e1f1b7f8a",
+						cloudcontrolv1beta1.LabelScopeSubaccountId:    "a3b0d6c4-7e0c-4d6b-bb5e-21c7c0a5e1d9",
+						cloudcontrolv1beta1.LabelScopeShootName:       shootName,
+						cloudcontrolv1beta1.LabelKymaName:             name,
+						cloudcontrolv1beta1.LabelScopeBrokerPlanName:  "aws",
+						cloudcontrolv1beta1.LabelScopeRegion:          region,
+					},
+				},
+				Spec: infrastructuremanagerv1.RuntimeSpec{
+					Security: infrastructuremanagerv1.Security{
+						Administrators: []string{"someone@sap.com"},
+						Hardening: &infrastructuremanagerv1.SecurityHardening{
+							Enabled: true,
+						},
+					},
+					Shoot: infrastructuremanagerv1.RuntimeShoot{
+						Name:   shootName,
+						Region: region,
+						Provider: infrastructuremanagerv1.Provider{
+							Type: "aws", // required!!!
+							Workers: []gardenertypes.Worker{
+								{
+									Name: "worker1",
+									Machine: gardenertypes.Machine{
+										Image: &gardenertypes.ShootMachineImage{
+											Name: "gardenlinux",
+										},
+										Type: "m5.large",
+									},
+								},
+							},
+						},
+						SecretBindingName: secretBindingName,
+					},
+				},
+			}
+
+			Expect(CreateObj(infra.Ctx(), infra.KCP().Client(), runtime)).
+				To(Succeed())
+		})
+
+		By("Then Subscription is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), subscription, NewObjActions(WithName(secretBindingName))).
+				Should(Succeed())
+		})
+
+		By("And Then Runtime has finalizer", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions(), HavingFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed())
+		})
+
+		By("When Subscription is Ready", func() {
+			Expect(SubscriptionPatchStatusReadyAws(infra.Ctx(), infra, subscription, awsAccount.AccountId())).
+				To(Succeed())
+		})
+
+		By("Then Runtime has all security hardening conditions true", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), runtime, NewObjActions(),
+					havingRuntimeConditionTrue(runtimetypes.ConditionTypeKmsKeyReady),
+					havingRuntimeConditionTrue(runtimetypes.ConditionTypeFlowLogsReady),
+					havingRuntimeConditionTrue(runtimetypes.ConditionTypeDefaultDenyReady),
+				).
+				Should(Succeed())
+		})
+
+		var kmsKeyId string
+
+		By("And Then AWS KMS key is created with rotation enabled", func() {
+			key := awsMock.GetAwsKmsKeyByAlias(fmt.Sprintf("alias/cloud-manager/%s", name))
+			Expect(key).NotTo(BeNil())
+			kmsKeyId = ptr.Deref(key.KeyId, "")
+			Expect(awsMock.IsAwsKmsKeyRotationEnabled(kmsKeyId)).To(BeTrue())
+		})
+
+		By("And Then AWS VPC flow logs are delivered to S3 bucket", func() {
+			flowLogs := awsMock.GetAwsFlowLogs(ptr.Deref(awsInfra.VPC.VpcId, ""))
+			Expect(flowLogs).To(HaveLen(1))
+			Expect(ptr.Deref(flowLogs[0].LogDestination, "")).
+				To(Equal(awsutil.S3BucketArn(fmt.Sprintf("cloud-manager-flow-logs-%s", name))))
+		})
+
+		By("And Then AWS default security group has no rules", func() {
+			sgList, err := awsMock.DescribeSecurityGroups(infra.Ctx(), nil, []string{defaultSgId})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sgList).To(HaveLen(1))
+			Expect(sgList[0].IpPermissions).To(BeEmpty())
+			Expect(sgList[0].IpPermissionsEgress).To(BeEmpty())
+		})
+
+		// DELETE ===============================================================
+
+		By("When Runtime is deleted", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), runtime)).To(Succeed())
+		})
+
+		By("Then Runtime does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), runtime).
+				Should(Succeed())
+		})
+
+		By("And Then AWS KMS key is scheduled for deletion and its alias is deleted", func() {
+			Expect(awsMock.GetAwsKmsKeyByAlias(fmt.Sprintf("alias/cloud-manager/%s", name))).To(BeNil())
+			key := awsMock.GetAwsKmsKey(kmsKeyId)
+			Expect(key).NotTo(BeNil())
+			Expect(key.KeyState).To(Equal(kmstypes.KeyStatePendingDeletion))
+		})
+
+		By("And Then AWS VPC flow logs do not exist", func() {
+			Expect(awsMock.GetAwsFlowLogs(ptr.Deref(awsInfra.VPC.VpcId, ""))).To(BeEmpty())
+		})
+
+		By("And Then AWS flow logs S3 bucket does not exist", func() {
+			exists, err := awsMock.S3BucketExists(infra.Ctx(), fmt.Sprintf("cloud-manager-flow-logs-%s", name))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		By("// cleanup: delete Subscription", func() {
+			Expect(Delete(infra.Ctx(), infra.KCP().Client(), subscription)).
+				To(Succeed())
+			Expect(IsDeleted(infra.Ctx(), infra.KCP().Client(), subscription)).
 				To(Succeed())
 		})
 	})
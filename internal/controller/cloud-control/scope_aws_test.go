@@ -10,11 +10,14 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -132,6 +135,26 @@ var _ = Describe("Feature: KCP Scope", func() {
 			Expect(scope.Status.ExposedData.NatGatewayIps).To(ConsistOf(expected))
 		})
 
+		By("And Then Scope has status.exposedData.natGatewayIpsHistory with the read NAT gateway IPs", func() {
+			Expect(scope.Status.ExposedData.NatGatewayIpsHistory).To(HaveLen(1))
+			Expect(scope.Status.ExposedData.NatGatewayIpsHistory[0].NatGatewayIps).To(Equal(pie.Sort(pie.Unique(scope.Status.ExposedData.NatGatewayIps))))
+		})
+
+		By("And Then Scope has status.exposedData network facts", func() {
+			Expect(scope.Status.ExposedData.NetworkId).To(Equal(ptr.Deref(awsCreatedInfra.VPC.VpcId, "")))
+			Expect(scope.Status.ExposedData.Region).To(Equal(shoot.Spec.Region))
+			Expect(scope.Status.ExposedData.Zones).To(Equal([]string{"eu-west-1a", "eu-west-1b", "eu-west-1c"}))
+			Expect(scope.Status.ExposedData.NodesCidr).To(Equal(scope.Spec.Scope.Aws.Network.Nodes))
+			Expect(scope.Status.ExposedData.PodsCidr).To(Equal(scope.Spec.Scope.Aws.Network.Pods))
+			Expect(scope.Status.ExposedData.ServicesCidr).To(Equal(scope.Spec.Scope.Aws.Network.Services))
+		})
+
+		By("And Then Scope has status.capabilities.redisVersions", func() {
+			Expect(scope.Status.Capabilities).NotTo(BeNil())
+			Expect(scope.Status.Capabilities.RedisVersions).To(Equal([]string{"7.1", "7.0", "6.x"}))
+			Expect(scope.Status.Capabilities.Services).To(HaveKeyWithValue(cloudcontrolv1beta1.CapabilityServiceNfs, true))
+		})
+
 		infoConfigMap := &corev1.ConfigMap{}
 
 		By("And Then SKR kyma-info configmap exists", func() {
@@ -145,6 +168,141 @@ var _ = Describe("Feature: KCP Scope", func() {
 			Expect(infoConfigMap.Data["cloud.natGatewayIps"]).To(Equal(pie.Join(scope.Status.ExposedData.NatGatewayIps, ", ")))
 		})
 
+		By("And Then SKR kyma-info configmap contains region capabilities", func() {
+			Expect(infoConfigMap.Data["cloud.capabilities.redisVersions"]).To(Equal("7.1, 7.0, 6.x"))
+			Expect("cloud.capabilities.redisTiers").To(BeKeyOf(infoConfigMap.Data))
+		})
+
+		By("And Then SKR kyma-info configmap contains network facts", func() {
+			Expect(infoConfigMap.Data["cloud.networkId"]).To(Equal(scope.Status.ExposedData.NetworkId))
+			Expect(infoConfigMap.Data["cloud.region"]).To(Equal(scope.Status.ExposedData.Region))
+			Expect(infoConfigMap.Data["cloud.zones"]).To(Equal("eu-west-1a, eu-west-1b, eu-west-1c"))
+			Expect(infoConfigMap.Data["cloud.nodesCidr"]).To(Equal(scope.Status.ExposedData.NodesCidr))
+			Expect(infoConfigMap.Data["cloud.podsCidr"]).To(Equal(scope.Status.ExposedData.PodsCidr))
+			Expect(infoConfigMap.Data["cloud.servicesCidr"]).To(Equal(scope.Status.ExposedData.ServicesCidr))
+			Expect("cloud.ipRangeCidrs").To(BeKeyOf(infoConfigMap.Data))
+		})
+
+		cloudNetworkInfo := &cloudresourcesv1beta1.CloudNetworkInfo{}
+
+		By("And Then SKR CloudNetworkInfo has network facts", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), cloudNetworkInfo,
+					NewObjActions(WithName(cloudresourcesv1beta1.CloudNetworkInfoName)),
+					func(obj client.Object) error {
+						info := obj.(*cloudresourcesv1beta1.CloudNetworkInfo)
+						if info.Status.NetworkId == "" {
+							return fmt.Errorf("expected CloudNetworkInfo to have status.networkId")
+						}
+						return nil
+					},
+				).
+				Should(Succeed())
+			Expect(cloudNetworkInfo.Status.Provider).To(Equal(string(cloudcontrolv1beta1.ProviderAws)))
+			Expect(cloudNetworkInfo.Status.NetworkId).To(Equal(scope.Status.ExposedData.NetworkId))
+			Expect(cloudNetworkInfo.Status.NatGatewayIps).To(Equal(scope.Status.ExposedData.NatGatewayIps))
+			Expect(cloudNetworkInfo.Status.Zones).To(Equal(scope.Status.ExposedData.Zones))
+		})
+
+		// NAT IPs CHANGE =======================================================
+
+		natGatewayIpsChangeCountBefore := testutil.ToFloat64(metrics.KcpScopeNatGatewayIpsChangeTotal.WithLabelValues(string(cloudcontrolv1beta1.ProviderAws)))
+		previousNatGatewayIps := scope.Status.ExposedData.NatGatewayIps
+		var changedNatGatewayIps []string
+
+		By("When AWS NAT gateway is added", func() {
+			gw, err := awsMock.AddNatGateway(ptr.Deref(awsCreatedInfra.VPC.VpcId, ""), ptr.Deref(awsCreatedInfra.Subnets[0].SubnetId, ""))
+			Expect(err).NotTo(HaveOccurred())
+			changedNatGatewayIps = pie.Sort(pie.Unique(append(
+				append([]string{}, previousNatGatewayIps...),
+				ptr.Deref(gw.NatGatewayAddresses[0].PublicIp, ""),
+			)))
+		})
+
+		By("And When Scope exposed data read time is reset", func() {
+			Eventually(func() error {
+				if err := LoadAndCheck(infra.Ctx(), infra.KCP().Client(), scope, NewObjActions()); err != nil {
+					return err
+				}
+				scope.Status.ExposedData.ReadTime = nil
+				return UpdateStatus(infra.Ctx(), infra.KCP().Client(), scope)
+			}).Should(Succeed(), "failed resetting Scope exposed data read time")
+		})
+
+		By("Then Scope has status.exposedData.natGatewayIps with the changed NAT gateway IPs", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), scope, NewObjActions(),
+					func(obj client.Object) error {
+						x := obj.(*cloudcontrolv1beta1.Scope)
+						if len(x.Status.ExposedData.NatGatewayIpsHistory) != 2 {
+							return fmt.Errorf("expected Scope to have 2 natGatewayIpsHistory records, but it has %d", len(x.Status.ExposedData.NatGatewayIpsHistory))
+						}
+						return nil
+					},
+				).
+				Should(Succeed())
+			Expect(pie.Sort(scope.Status.ExposedData.NatGatewayIps)).To(Equal(changedNatGatewayIps))
+		})
+
+		By("And Then Scope has status.exposedData.natGatewayIpsHistory with the appended changed NAT gateway IPs", func() {
+			Expect(scope.Status.ExposedData.NatGatewayIpsHistory[0].NatGatewayIps).To(Equal(pie.Sort(pie.Unique(previousNatGatewayIps))))
+			Expect(scope.Status.ExposedData.NatGatewayIpsHistory[1].NatGatewayIps).To(Equal(changedNatGatewayIps))
+		})
+
+		By("And Then Scope has NatGatewayIpsChanged event", func() {
+			Eventually(func() error {
+				list := &eventsv1.EventList{}
+				if err := infra.KCP().Client().List(infra.Ctx(), list, client.InNamespace(scope.Namespace)); err != nil {
+					return err
+				}
+				for _, evt := range list.Items {
+					if evt.Regarding.Name == scope.Name && evt.Reason == "NatGatewayIpsChanged" {
+						return nil
+					}
+				}
+				return fmt.Errorf("expected Scope to have NatGatewayIpsChanged event")
+			}).Should(Succeed())
+		})
+
+		By("And Then NAT gateway IPs change metric is incremented", func() {
+			Expect(testutil.ToFloat64(metrics.KcpScopeNatGatewayIpsChangeTotal.WithLabelValues(string(cloudcontrolv1beta1.ProviderAws)))).
+				To(BeNumerically(">", natGatewayIpsChangeCountBefore))
+		})
+
+		By("And Then SKR kyma-info configmap contains changed natGatewayIps", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), infoConfigMap,
+					NewObjActions(),
+					func(obj client.Object) error {
+						cm := obj.(*corev1.ConfigMap)
+						if cm.Data["cloud.natGatewayIps"] != pie.Join(scope.Status.ExposedData.NatGatewayIps, ", ") {
+							return fmt.Errorf("expected kyma-info configmap to have changed natGatewayIps, but it has %s", cm.Data["cloud.natGatewayIps"])
+						}
+						return nil
+					},
+				).
+				Should(Succeed())
+		})
+
+		By("And Then SKR CloudNetworkInfo has changed natGatewayIps", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), cloudNetworkInfo, NewObjActions(),
+					func(obj client.Object) error {
+						info := obj.(*cloudresourcesv1beta1.CloudNetworkInfo)
+						if len(info.Status.NatGatewayIps) != len(changedNatGatewayIps) {
+							return fmt.Errorf("expected CloudNetworkInfo to have %d natGatewayIps, but it has %d", len(changedNatGatewayIps), len(info.Status.NatGatewayIps))
+						}
+						return nil
+					},
+				).
+				Should(Succeed())
+			Expect(cloudNetworkInfo.Status.NatGatewayIps).To(Equal(scope.Status.ExposedData.NatGatewayIps))
+		})
+
 		kymaNetwork := &cloudcontrolv1beta1.Network{}
 
 		By("And Then Kyma Network is created", func() {
@@ -24,9 +24,12 @@ import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsruntime "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/runtime"
 	awsvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork"
 	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	azureruntime "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/runtime"
 	azurevpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcnetwork"
+	gcpruntime "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/runtime"
 	gcpvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcnetwork"
 	sapvpcnetwork "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcnetwork"
 	"github.com/kyma-project/cloud-manager/pkg/testinfra"
@@ -126,6 +129,7 @@ var _ = BeforeSuite(func() {
 		infra.AwsMock().VpcPeeringSkrProvider(),
 		infra.AzureMock().VpcPeeringProvider(),
 		infra.GcpMock().VpcPeeringProvider(),
+		infra.SapMock().VpcPeeringProvider(),
 		env,
 	)).NotTo(HaveOccurred())
 	// RedisInstance
@@ -134,8 +138,23 @@ var _ = BeforeSuite(func() {
 		infra.GcpMock2().RedisInstanceProvider(),
 		infra.AzureMock().RedisClientProvider(),
 		infra.AwsMock().ElastiCacheProviderFake(),
+		infra.SapMock().RedisInstanceProvider(),
 		env,
 	)).NotTo(HaveOccurred())
+	// PostgresInstance
+	Expect(SetupPostgresInstanceReconciler(
+		infra.KcpManager(),
+		infra.AwsMock().RdsProviderFake(),
+		infra.GcpMock2().PostgresInstanceProvider(),
+		infra.AzureMock().PostgresClientProvider(),
+	)).NotTo(HaveOccurred())
+	// Bucket
+	Expect(SetupBucketReconciler(
+		infra.KcpManager(),
+		infra.AwsMock().BucketProvider(),
+		infra.GcpMock2().BucketProvider(),
+		infra.AzureMock().BucketClientProvider(),
+	)).NotTo(HaveOccurred())
 	// RedisCluster
 	Expect(SetupRedisClusterReconciler(
 		infra.KcpManager(),
@@ -161,6 +180,9 @@ var _ = BeforeSuite(func() {
 		infra.GcpMock().FileBackupClientProvider(),
 		awsnukeclient.Mock(),
 		azurenukeclient.NukeProvider(infra.AzureMock().StorageProvider()),
+		infra.GcpMock2().NukeOrphanProvider(),
+		infra.AwsMock().NukeOrphanProvider(),
+		infra.AzureMock().NukeOrphanProvider(),
 		env,
 	)).To(Succeed())
 	// GcpSubnet
@@ -195,6 +217,9 @@ var _ = BeforeSuite(func() {
 	Expect(SetupRuntimeReconciler(
 		infra.Ctx(),
 		infra.KcpManager(),
+		awsruntime.NewStateFactory(infra.AwsMock().RuntimeProvider()),
+		azureruntime.NewStateFactory(infra.AzureMock().RuntimeProvider()),
+		gcpruntime.NewStateFactory(infra.GcpMock2().RuntimeProvider()),
 	)).To(Succeed())
 
 	// Start controllers
@@ -0,0 +1,336 @@
+package cloudcontrol
+
+import (
+	"github.com/google/uuid"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/kyma-project/cloud-manager/api"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	kcpscope "github.com/kyma-project/cloud-manager/pkg/kcp/scope"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Feature: KCP VpcPeering SAP", func() {
+
+	It("Scenario: KCP SAP VpcPeering in RouterInterface mode is created and deleted", func() {
+		const (
+			kymaName       = "3f6b2d1e-8c4a-4b7e-9f20-5a1d7c3e8b46"
+			kcpPeeringName = "a7e4c2b9-1d5f-4e83-b6a0-2c9f8d4e7b15"
+			remoteProject  = "remote-project"
+		)
+
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		sapMock := infra.SapMock().NewProject()
+
+		By("Given OpenStack Scope exists", func() {
+			// Tell Scope reconciler to ignore this Scope
+			kcpscope.Ignore.AddName(kymaName)
+
+			Expect(CreateScopeOpenStack(infra.Ctx(), infra, scope, sapMock.ProviderParams(), WithName(kymaName))).
+				To(Succeed(), "failed creating Scope")
+		})
+
+		var sapGardenInfra *SapGardenerInfra
+
+		By("And Given SAP infra exists", func() {
+			sgi, err := CreateSapGardenerResources(infra.Ctx(), sapMock, infra.Garden().Namespace(), scope.Spec.ShootName, "10.250.0.0/16")
+			Expect(err).NotTo(HaveOccurred())
+			sapGardenInfra = sgi
+		})
+
+		var remoteNet *networks.Network
+		var remoteSubnet *subnets.Subnet
+
+		By("And Given remote OpenStack network is shared with the Kyma project", func() {
+			remoteNet = sapMock.AddNetwork(uuid.NewString(), "remote-"+kymaName)
+			subnet, err := sapMock.CreateSubnetOp(infra.Ctx(), remoteNet.ID, "10.100.0.0/24", "remote-"+kymaName)
+			Expect(err).NotTo(HaveOccurred())
+			remoteSubnet = subnet
+		})
+
+		var localKcpNet *cloudcontrolv1beta1.Network
+
+		By("And Given local KCP Network exists in Ready state", func() {
+			localKcpNet = cloudcontrolv1beta1.NewNetworkBuilder().
+				WithScope(kymaName).
+				WithName(common.KcpNetworkKymaCommonName(kymaName)).
+				WithOpenStackRef(scope.Spec.Scope.OpenStack.DomainName, scope.Spec.Scope.OpenStack.TenantName, "", scope.Spec.Scope.OpenStack.VpcNetwork).
+				WithType(cloudcontrolv1beta1.NetworkTypeKyma).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), localKcpNet).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), localKcpNet, NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady)).
+				Should(Succeed())
+		})
+
+		var remoteKcpNet *cloudcontrolv1beta1.Network
+
+		By("And Given remote KCP Network exists in Ready state", func() {
+			remoteKcpNet = cloudcontrolv1beta1.NewNetworkBuilder().
+				WithScope(kymaName).
+				WithName(kymaName+"--remote").
+				WithOpenStackRef("", remoteProject, remoteNet.ID, "").
+				WithType(cloudcontrolv1beta1.NetworkTypeExternal).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteKcpNet).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteKcpNet, NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady)).
+				Should(Succeed())
+		})
+
+		var kcpPeering *cloudcontrolv1beta1.VpcPeering
+
+		By("When KCP VpcPeering is created", func() {
+			kcpPeering = (&cloudcontrolv1beta1.VpcPeeringBuilder{}).
+				WithScope(kymaName).
+				WithRemoteRef("skr-namespace", "skr-sap-vpcpeering").
+				WithDetails(localKcpNet.Name, infra.KCP().Namespace(), remoteKcpNet.Name, infra.KCP().Namespace(), "", false, false).
+				WithOpenStackPeeringMode(cloudcontrolv1beta1.OpenStackVpcPeeringModeRouterInterface).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering,
+					WithName(kcpPeeringName),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP VpcPeering has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering,
+					NewObjActions(),
+					HaveFinalizer(api.CommonFinalizerDeletionHook),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then KCP VpcPeering state is Connected", func() {
+			Expect(kcpPeering.Status.State).To(Equal(cloudcontrolv1beta1.VirtualNetworkPeeringStateConnected))
+		})
+
+		By("And Then KCP VpcPeering Id matches the Kyma router ID", func() {
+			Expect(kcpPeering.Status.Id).To(Equal(sapGardenInfra.Router.ID))
+		})
+
+		By("And Then KCP VpcPeering RemoteId matches the remote network ID", func() {
+			Expect(kcpPeering.Status.RemoteId).To(Equal(remoteNet.ID))
+		})
+
+		By("And Then remote subnet is added to the Kyma router", func() {
+			arr, err := sapMock.ListPorts(infra.Ctx(), ports.ListOpts{
+				DeviceID:  sapGardenInfra.Router.ID,
+				NetworkID: remoteNet.ID,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(arr).To(HaveLen(1))
+			Expect(arr[0].FixedIPs).To(HaveLen(1))
+			Expect(arr[0].FixedIPs[0].SubnetID).To(Equal(remoteSubnet.ID))
+		})
+
+		// DELETE ==========================================================================
+
+		By("When KCP VpcPeering is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering).
+				Should(Succeed(), "failed deleting VpcPeering")
+		})
+
+		By("Then KCP VpcPeering does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering).
+				Should(Succeed(), "expected VpcPeering not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then remote subnet is removed from the Kyma router", func() {
+			arr, err := sapMock.ListPorts(infra.Ctx(), ports.ListOpts{
+				DeviceID:  sapGardenInfra.Router.ID,
+				NetworkID: remoteNet.ID,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(arr).To(BeEmpty())
+		})
+
+		By("// cleanup: Local KCP Network", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), localKcpNet).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Remote KCP Network", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteKcpNet).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Scope", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: KCP SAP VpcPeering in NetworkRbac mode is created and deleted", func() {
+		const (
+			kymaName       = "c4d8e1f7-6a2b-4f95-8e3c-1b7a9d5f2e60"
+			kcpPeeringName = "e9b3a6d2-5c1f-4a78-9d04-7f2e8b6c3a91"
+			remoteProject  = "remote-project"
+			remoteNetId    = "5d7f9b1c-3e2a-4c68-8f14-9a0b2d6e4c73"
+		)
+
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		sapMock := infra.SapMock().NewProject()
+
+		By("Given OpenStack Scope exists", func() {
+			// Tell Scope reconciler to ignore this Scope
+			kcpscope.Ignore.AddName(kymaName)
+
+			Expect(CreateScopeOpenStack(infra.Ctx(), infra, scope, sapMock.ProviderParams(), WithName(kymaName))).
+				To(Succeed(), "failed creating Scope")
+		})
+
+		var sapGardenInfra *SapGardenerInfra
+
+		By("And Given SAP infra exists", func() {
+			sgi, err := CreateSapGardenerResources(infra.Ctx(), sapMock, infra.Garden().Namespace(), scope.Spec.ShootName, "10.250.0.0/16")
+			Expect(err).NotTo(HaveOccurred())
+			sapGardenInfra = sgi
+		})
+
+		var localKcpNet *cloudcontrolv1beta1.Network
+
+		By("And Given local KCP Network exists in Ready state", func() {
+			localKcpNet = cloudcontrolv1beta1.NewNetworkBuilder().
+				WithScope(kymaName).
+				WithName(common.KcpNetworkKymaCommonName(kymaName)).
+				WithOpenStackRef(scope.Spec.Scope.OpenStack.DomainName, scope.Spec.Scope.OpenStack.TenantName, "", scope.Spec.Scope.OpenStack.VpcNetwork).
+				WithType(cloudcontrolv1beta1.NetworkTypeKyma).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), localKcpNet).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), localKcpNet, NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady)).
+				Should(Succeed())
+		})
+
+		var remoteKcpNet *cloudcontrolv1beta1.Network
+
+		By("And Given remote KCP Network exists in Ready state", func() {
+			remoteKcpNet = cloudcontrolv1beta1.NewNetworkBuilder().
+				WithScope(kymaName).
+				WithName(kymaName+"--remote").
+				WithOpenStackRef("", remoteProject, remoteNetId, "").
+				WithType(cloudcontrolv1beta1.NetworkTypeExternal).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteKcpNet).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteKcpNet, NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady)).
+				Should(Succeed())
+		})
+
+		var kcpPeering *cloudcontrolv1beta1.VpcPeering
+
+		By("When KCP VpcPeering is created", func() {
+			kcpPeering = (&cloudcontrolv1beta1.VpcPeeringBuilder{}).
+				WithScope(kymaName).
+				WithRemoteRef("skr-namespace", "skr-sap-vpcpeering").
+				WithDetails(localKcpNet.Name, infra.KCP().Namespace(), remoteKcpNet.Name, infra.KCP().Namespace(), "", false, false).
+				WithOpenStackPeeringMode(cloudcontrolv1beta1.OpenStackVpcPeeringModeNetworkRbac).
+				Build()
+
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering,
+					WithName(kcpPeeringName),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP VpcPeering has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering,
+					NewObjActions(),
+					HaveFinalizer(api.CommonFinalizerDeletionHook),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then KCP VpcPeering state is Connected", func() {
+			Expect(kcpPeering.Status.State).To(Equal(cloudcontrolv1beta1.VirtualNetworkPeeringStateConnected))
+		})
+
+		By("And Then Kyma network is shared with the remote project", func() {
+			policy, err := sapMock.GetNetworkSharedRbacPolicy(infra.Ctx(), sapGardenInfra.VPC.ID, remoteProject)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).NotTo(BeNil())
+			Expect(kcpPeering.Status.Id).To(Equal(policy.ID))
+		})
+
+		By("And Then KCP VpcPeering RemoteId matches the remote network ID", func() {
+			Expect(kcpPeering.Status.RemoteId).To(Equal(remoteNetId))
+		})
+
+		// DELETE ==========================================================================
+
+		By("When KCP VpcPeering is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering).
+				Should(Succeed(), "failed deleting VpcPeering")
+		})
+
+		By("Then KCP VpcPeering does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpPeering).
+				Should(Succeed(), "expected VpcPeering not to exist (be deleted), but it still exists")
+		})
+
+		By("And Then Kyma network is not shared with the remote project", func() {
+			policy, err := sapMock.GetNetworkSharedRbacPolicy(infra.Ctx(), sapGardenInfra.VPC.ID, remoteProject)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy).To(BeNil())
+		})
+
+		By("// cleanup: Local KCP Network", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), localKcpNet).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Remote KCP Network", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteKcpNet).
+				Should(Succeed())
+		})
+
+		By("// cleanup: Scope", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), scope).
+				Should(Succeed())
+		})
+	})
+})
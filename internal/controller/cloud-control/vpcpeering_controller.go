@@ -24,10 +24,12 @@ import (
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
 
 	awsvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering/client"
 	azurevpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering/client"
 	gcpvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcpeering/client"
+	sapvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering/client"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -37,6 +39,7 @@ import (
 	awsvpcpeering "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering"
 	azurevpcpeering "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering"
 	gcpvpcpeering "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcpeering"
+	sapvpcpeering "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering"
 )
 
@@ -45,6 +48,7 @@ func SetupVpcPeeringReconciler(
 	awsSkrProvider awsclient.SkrClientProvider[awsvpcpeeringclient.Client],
 	azureSkrProvider azureclient.ClientProvider[azurevpcpeeringclient.Client],
 	gcpSkrProvider gcpclient.GcpClientProvider[gcpvpcpeeringclient.VpcPeeringClient],
+	sapProvider sapclient.SapClientProvider[sapvpcpeeringclient.Client],
 	env abstractions.Environment,
 ) error {
 	if env == nil {
@@ -57,6 +61,7 @@ func SetupVpcPeeringReconciler(
 			awsvpcpeering.NewStateFactory(awsSkrProvider),
 			azurevpcpeering.NewStateFactory(azureSkrProvider),
 			gcpvpcpeering.NewStateFactory(gcpSkrProvider, env),
+			sapvpcpeering.NewStateFactory(sapProvider),
 		),
 	).SetupWithManager(kcpManager)
 }
@@ -9,6 +9,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skrawsnfsvolumebackup "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup"
 	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	"github.com/kyma-project/cloud-manager/pkg/util"
@@ -16,6 +17,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("Feature: SKR AwsNfsVolume", func() {
@@ -745,4 +747,228 @@ var _ = Describe("Feature: SKR AwsNfsVolume", func() {
 		})
 	})
 
+	It("Scenario: SKR AwsNfsVolume is restored from AwsNfsVolumeBackup", func() {
+
+		skrIpRangeName := "7a1d3e5f-2b4c-4d6e-8f0a-1c3e5a7b9d2f"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "9c2e4a6b-8d0f-4b1a-a3c5-e7f9b1d3a5c7"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		backupName := "aws-nfs-restore-source-backup"
+		backup := &cloudresourcesv1beta1.AwsNfsVolumeBackup{}
+		recoveryPointId := "5e7a9c1b-3d5f-4a7c-9e1b-3d5f7a9c1b3d"
+
+		By("And Given AwsNfsVolumeBackup exists", func() {
+			// tell awsnfsvolumebackup reconciler to ignore this backup
+			skrawsnfsvolumebackup.Ignore.AddName(backupName)
+
+			Eventually(CreateAwsNfsVolumeBackup).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithName(backupName),
+					WithAwsNfsVolume("some-other-aws-nfs-volume"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given AwsNfsVolumeBackup has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithAwsNfsVolumeBackupStatusId(recoveryPointId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		awsNfsVolumeName := "aws-nfs-volume-restored-from-backup"
+		awsNfsVolume := &cloudresourcesv1beta1.AwsNfsVolume{}
+
+		By("When AwsNfsVolume is created with sourceBackup", func() {
+			Eventually(CreateAwsNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolume,
+					WithName(awsNfsVolumeName),
+					WithIpRange(skrIpRange.Name),
+					WithAwsNfsVolumeCapacity("100G"),
+					WithAwsNfsVolumeSourceBackup(backupName),
+				).
+				Should(Succeed())
+		})
+
+		kcpNfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+
+		By("Then KCP NfsInstance is created with source recovery point", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolume,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+				).
+				Should(Succeed(), "expected SKR AwsNfsVolume to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					NewObjActions(
+						WithName(awsNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			Expect(kcpNfsInstance.Spec.Instance.Aws.SourceRecoveryPointId).To(Equal(recoveryPointId))
+		})
+
+		By("When KCP NfsInstance has Restoring condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					WithConditions(metav1.Condition{
+						Type:    cloudcontrolv1beta1.ConditionTypeRestoring,
+						Status:  metav1.ConditionTrue,
+						Reason:  cloudcontrolv1beta1.ConditionTypeRestoring,
+						Message: "EFS is being restored",
+					}),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AwsNfsVolume has Restoring condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolume,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeRestoring),
+					HavingFieldValue(cloudresourcesv1beta1.StateRestoring, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP NfsInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					WithNfsInstanceStatusHost(""),
+					WithConditions(KcpReadyCondition()),
+					WithNfsInstanceStatusCapacity(resource.MustParse("12Ki")),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AwsNfsVolume has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolume,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+			Expect(meta.FindStatusCondition(awsNfsVolume.Status.Conditions, cloudresourcesv1beta1.ConditionTypeRestoring)).To(BeNil())
+		})
+
+		By("And Then SKR PersistentVolume is created", func() {
+			pv := &corev1.PersistentVolume{}
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), pv,
+					NewObjActions(
+						WithName(awsNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: SKR AwsNfsVolume is restored from recovery point shared by another runtime", func() {
+
+		skrIpRangeName := "2e6a8c1f-4b7d-4f9a-b2c5-6e8a1d3f5b79"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "8b1d5f9c-3e7a-4c2b-9f6d-4a8c2e6b1d53"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		sourceBackupVaultName := "cm-6d2f8b4a-1c9e-4a7d-b3f5-9e1c7a5d3b28"
+		recoveryPointId := "4c8e2a6d-9f3b-4d1e-a7c5-3b9f1d7e5a62"
+		awsNfsVolumeName := "aws-nfs-volume-restored-from-backup-url"
+		awsNfsVolume := &cloudresourcesv1beta1.AwsNfsVolume{}
+
+		By("When AwsNfsVolume is created with sourceBackupUrl", func() {
+			Eventually(CreateAwsNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolume,
+					WithName(awsNfsVolumeName),
+					WithIpRange(skrIpRange.Name),
+					WithAwsNfsVolumeCapacity("100G"),
+					WithAwsNfsVolumeSourceBackupUrl(skrawsnfsvolumebackup.BackupUrl(sourceBackupVaultName, recoveryPointId)),
+				).
+				Should(Succeed())
+		})
+
+		kcpNfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+
+		By("Then KCP NfsInstance is created with source recovery point and its backup vault", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolume,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+				).
+				Should(Succeed(), "expected SKR AwsNfsVolume to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpNfsInstance,
+					NewObjActions(
+						WithName(awsNfsVolume.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			Expect(kcpNfsInstance.Spec.Instance.Aws.SourceRecoveryPointId).To(Equal(recoveryPointId))
+			Expect(kcpNfsInstance.Spec.Instance.Aws.SourceBackupVaultName).To(Equal(sourceBackupVaultName))
+		})
+	})
+
 })
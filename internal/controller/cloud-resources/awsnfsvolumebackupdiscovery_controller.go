@@ -0,0 +1,63 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresources
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackupdiscovery"
+	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	reconcile2 "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
+)
+
+// AwsNfsVolumeBackupDiscoveryReconciler reconciles a AwsNfsVolumeBackupDiscovery object
+type AwsNfsVolumeBackupDiscoveryReconciler struct {
+	Reconciler awsnfsvolumebackupdiscovery.Reconciler
+}
+
+// +kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=awsnfsvolumebackupdiscoveries,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=awsnfsvolumebackupdiscoveries/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=awsnfsvolumebackupdiscoveries/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *AwsNfsVolumeBackupDiscoveryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.Reconciler.Run(ctx, req)
+}
+
+type AwsNfsVolumeBackupDiscoveryReconcilerFactory struct {
+	awsClientProvider awsclient.SkrClientProvider[awsnfsvolumebackupclient.Client]
+}
+
+func (f *AwsNfsVolumeBackupDiscoveryReconcilerFactory) New(args reconcile2.ReconcilerArguments) reconcile.Reconciler {
+	return &AwsNfsVolumeBackupDiscoveryReconciler{
+		Reconciler: awsnfsvolumebackupdiscovery.NewReconciler(args.ScopeProvider, args.KcpCluster, args.SkrCluster, f.awsClientProvider),
+	}
+}
+
+func SetupAwsNfsVolumeBackupDiscoveryReconciler(reg skrruntime.SkrRegistry, awsClientProvider awsclient.SkrClientProvider[awsnfsvolumebackupclient.Client]) error {
+	return reg.Register().
+		WithFactory(&AwsNfsVolumeBackupDiscoveryReconcilerFactory{awsClientProvider: awsClientProvider}).
+		For(&cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery{}).
+		Complete()
+}
@@ -0,0 +1,121 @@
+package cloudresources
+
+import (
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	skrawsnfsvolumebackup "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup"
+	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Feature: SKR AwsNfsVolumeBackupDiscovery", func() {
+
+	It("Scenario: SKR AwsNfsVolumeBackupDiscovery is created", func() {
+
+		awsAccountId := "504719362841"
+		kymaName := "5d0f2b7e-3a91-4c6d-b8e4-71f2a9c30d5b"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: kymaName}))
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		By("Given KCP Scope exists", func() {
+			Expect(client.IgnoreAlreadyExists(
+				CreateScopeAws(infra.Ctx(), infra, scope, awsAccountId, WithName(skrKymaRef.Name)))).
+				To(Succeed())
+		})
+
+		shootName := scope.Spec.ShootName
+		otherShootName := "other-shoot"
+		otherVaultName := "cm-" + otherShootName
+
+		var awsMock awsnfsvolumebackupclient.Client
+		var sharedUrl, sharedWithAllUrl, notSharedUrl string
+
+		By("And Given AWS backup vault of another shoot exists", func() {
+			var err error
+			awsMock, err = awsnfsvolumebackupclient.NewMockClient()(infra.Ctx(), awsAccountId, scope.Spec.Region, "", "", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = awsMock.CreateBackupVault(infra.Ctx(), otherVaultName, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		startBackup := func(backupName string, accessibleFrom string) string {
+			tags := map[string]string{
+				"Name":          "default/" + backupName,
+				common.TagShoot: otherShootName,
+			}
+			if accessibleFrom != "" {
+				tags[skrawsnfsvolumebackup.ConvertToAccessibleFromKey(accessibleFrom)] = skrawsnfsvolumebackup.AccessibleFromTagValue
+			}
+			out, err := awsMock.StartBackupJob(infra.Ctx(), &awsnfsvolumebackupclient.StartBackupJobInput{
+				BackupVaultName:   otherVaultName,
+				ResourceArn:       "arn:aws:elasticfilesystem:eu-west-1:" + awsAccountId + ":file-system/fs-1",
+				RecoveryPointTags: tags,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return skrawsnfsvolumebackup.BackupUrl(otherVaultName, awsutil.ParseArnResourceId(ptr.Deref(out.RecoveryPointArn, "")))
+		}
+
+		By("And Given AWS recovery points of another shoot exist", func() {
+			sharedUrl = startBackup("shared-backup", shootName)
+			sharedWithAllUrl = startBackup("shared-with-all-backup", "all")
+			notSharedUrl = startBackup("not-shared-backup", "")
+		})
+
+		awsNfsVolumeBackupDiscovery := &cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery{}
+		discoveryName := "aws-discovery-" + kymaName[:8]
+
+		By("When AwsNfsVolumeBackupDiscovery is created", func() {
+			Expect(CreateObj(
+				infra.Ctx(), infra.SKR().Client(), awsNfsVolumeBackupDiscovery,
+				WithName(discoveryName),
+			)).To(Succeed())
+		})
+
+		By("Then AwsNfsVolumeBackupDiscovery will get Ready condition and Done state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), awsNfsVolumeBackupDiscovery,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingState(cloudresourcesv1beta1.JobStateDone),
+				).
+				Should(Succeed())
+		})
+
+		By("And Then AwsNfsVolumeBackupDiscovery lists only recovery points shared with this shoot", func() {
+			Expect(notSharedUrl).NotTo(BeEmpty())
+			Expect(HavingAwsNfsVolumeBackupDiscoveryAvailableBackupUris(sharedUrl, sharedWithAllUrl)(awsNfsVolumeBackupDiscovery)).
+				To(Succeed())
+		})
+
+		By("And Then AwsNfsVolumeBackupDiscovery available backups have details from recovery point tags", func() {
+			for _, b := range awsNfsVolumeBackupDiscovery.Status.AvailableBackups {
+				Expect(b.ShootName).To(Equal(otherShootName))
+				Expect(b.BackupNamespace).To(Equal("default"))
+				Expect(b.BackupName).NotTo(BeEmpty())
+				Expect(b.Location).To(Equal(scope.Spec.Region))
+				Expect(b.CreationTime).NotTo(BeNil())
+			}
+		})
+
+		By("// cleanup: When AwsNfsVolumeBackupDiscovery is deleted", func() {
+			Expect(Delete(infra.Ctx(), infra.SKR().Client(), awsNfsVolumeBackupDiscovery)).
+				To(Succeed())
+		})
+
+		By("// cleanup: Then AwsNfsVolumeBackupDiscovery does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), awsNfsVolumeBackupDiscovery).
+				Should(Succeed())
+		})
+	})
+
+})
@@ -0,0 +1,64 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresources
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsredisinstancebackup"
+	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	skrreconciler "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type AwsRedisInstanceBackupReconcilerFactory struct {
+	backupProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
+	env            abstractions.Environment
+}
+
+func (f *AwsRedisInstanceBackupReconcilerFactory) New(args skrreconciler.ReconcilerArguments) reconcile.Reconciler {
+	return &AwsRedisInstanceBackupReconciler{
+		reconciler: awsredisinstancebackup.NewReconcilerFactory(f.backupProvider, f.env).New(args),
+	}
+}
+
+// AwsRedisInstanceBackupReconciler reconciles a AwsRedisInstanceBackup object
+type AwsRedisInstanceBackupReconciler struct {
+	reconciler reconcile.Reconciler
+}
+
+//+kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=awsredisinstancebackups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=awsredisinstancebackups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=cloud-resources.kyma-project.io,resources=awsredisinstancebackups/finalizers,verbs=update
+
+func (r *AwsRedisInstanceBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+func SetupAwsRedisInstanceBackupReconciler(reg skrruntime.SkrRegistry, backupProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient], env abstractions.Environment) error {
+	return reg.Register().
+		WithFactory(&AwsRedisInstanceBackupReconcilerFactory{
+			backupProvider: backupProvider,
+			env:            env,
+		}).
+		For(&cloudresourcesv1beta1.AwsRedisInstanceBackup{}).
+		Complete()
+}
@@ -0,0 +1,160 @@
+package cloudresources
+
+import (
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	skrawsredisinstance "github.com/kyma-project/cloud-manager/pkg/skr/awsredisinstance"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Feature: SKR AwsRedisInstanceBackup", func() {
+
+	It("Scenario: SKR AwsRedisInstanceBackup is created and deleted", func() {
+		awsAccount := infra.AwsMock().NewAccount()
+		defer awsAccount.Delete()
+
+		kymaName := "0a6e1f3c-9b24-4d7e-8c15-2f4a6b8d0e13"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: kymaName}))
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		skrRedisInstanceName := "4b7d9e1a-2c3f-4e5a-9b6c-8d0e2f4a6c71"
+		skrRedisInstance := &cloudresourcesv1beta1.AwsRedisInstance{}
+		kcpRedisInstanceName := "9e2a4c6b-8d0f-4a1b-b3c5-7e9f1a3b5d24"
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		backupName := "6c8e0a2b-4d6f-4b8a-a1c3-5e7f9b1d3f58"
+		backup := &cloudresourcesv1beta1.AwsRedisInstanceBackup{}
+
+		By("Given KCP Scope exists", func() {
+			Expect(client.IgnoreAlreadyExists(
+				CreateScopeAws(infra.Ctx(), infra, scope, awsAccount.AccountId(), WithName(skrKymaRef.Name)))).
+				To(Succeed())
+		})
+
+		awsMock := awsAccount.Region(scope.Spec.Region)
+		replicationGroupId := "cm-" + kcpRedisInstanceName
+
+		By("And Given ElastiCache replication group exists", func() {
+			_, err := awsMock.CreateElastiCacheReplicationGroup(infra.Ctx(), nil, awsclient.CreateElastiCacheClusterOptions{
+				Name:          replicationGroupId,
+				CacheNodeType: "cache.t3.micro",
+				EngineVersion: "7.0",
+				ShardCount:    1,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("And Given KCP RedisInstance exists with status.id", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithName(kcpRedisInstanceName),
+					WithRemoteRef(skrRedisInstanceName),
+					WithIpRange("2f4b6d8e-0a1c-4e3f-b5a7-9c1e3f5b7d90"),
+					WithScope(skrKymaRef.Name),
+					WithRedisInstanceAws(),
+					WithKcpAwsCacheNodeType("cache.t3.micro"),
+					WithKcpAwsEngineVersion("7.0"),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithConditions(KcpReadyCondition()),
+					WithRedisInstanceStatusId(replicationGroupId),
+				).Should(Succeed())
+		})
+
+		By("And Given SKR AwsRedisInstance exists in Ready state", func() {
+			skrawsredisinstance.Ignore.AddName(skrRedisInstanceName)
+			Eventually(CreateAwsRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithName(skrRedisInstanceName),
+					WithAwsRedisInstanceDefautSpecs(),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithConditions(SkrReadyCondition()),
+					WithSkrRedisInstanceStatusId(kcpRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		By("When AwsRedisInstanceBackup is created", func() {
+			Eventually(CreateAwsRedisInstanceBackup).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithName(backupName),
+					WithRedisInstanceBackupSource(skrRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		By("Then AwsRedisInstanceBackup has status.id set", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					AssertRedisInstanceBackupHasState(cloudresourcesv1beta1.StateCreating),
+				).Should(Succeed(), "expected AwsRedisInstanceBackup to get status.id")
+		})
+
+		By("And Then ElastiCache snapshot is created from the replication group", func() {
+			snapshot := awsMock.GetAwsElastiCacheSnapshotByName(backup.Status.Id)
+			Expect(snapshot).NotTo(BeNil())
+			Expect(*snapshot.ReplicationGroupId).To(Equal(replicationGroupId))
+		})
+
+		By("When ElastiCache snapshot becomes available", func() {
+			awsMock.SetAwsElastiCacheSnapshotState(backup.Status.Id, awsmeta.ElastiCache_Snapshot_AVAILABLE)
+		})
+
+		By("Then AwsRedisInstanceBackup has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					AssertRedisInstanceBackupHasState(cloudresourcesv1beta1.StateReady),
+				).Should(Succeed())
+		})
+
+		// DELETE
+
+		By("When AwsRedisInstanceBackup is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("Then AwsRedisInstanceBackup does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("And Then ElastiCache snapshot does not exist", func() {
+			Expect(awsMock.GetAwsElastiCacheSnapshotByName(backup.Status.Id)).To(BeNil())
+		})
+
+		By("// cleanup: SKR AwsRedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrRedisInstance).
+				Should(Succeed())
+		})
+
+		By("// cleanup: KCP RedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance).
+				Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,392 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR AzureBlobContainer", func() {
+
+	It("Scenario: SKR AzureBlobContainer is created", func() {
+
+		skrIpRangeName := "f4b8d2e6-0a3c-4e97-b5f1-8c6a2d0e4b93"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "2c6e0a4f-8b1d-4f35-9e7c-b3d5f9a1c068"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		azureBlobContainerName := "c1a9e4f7-5b02-4d86-a3e9-7f4b0d2c6e15"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: azureBlobContainerName}))
+		azureBlobContainer := &cloudresourcesv1beta1.AzureBlobContainer{}
+
+		const (
+			authSecretName = "3e7b0f52-d8a1-4c69-9f3b-a5c2e8d0b147"
+		)
+		authSecretLabels := map[string]string{
+			"foo": "1",
+		}
+
+		extraData := map[string]string{
+			"foo":       "bar",
+			"container": "{{.endpoint}}{{.containerName}}",
+		}
+
+		By("When AzureBlobContainer is created", func() {
+			Eventually(CreateAzureBlobContainer).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), azureBlobContainer,
+					WithName(azureBlobContainerName),
+					WithIpRange(skrIpRange.Name),
+					WithAzureBlobContainerVersioning(true),
+					WithAzureBlobContainerLifecycle(cloudresourcesv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 30}),
+					WithAzureBlobContainerRetentionDays(7),
+					WithAzureBlobContainerAuthSecretName(authSecretName),
+					WithAzureBlobContainerAuthSecretLabels(authSecretLabels),
+					WithAzureBlobContainerAuthSecretExtraData(extraData),
+				).
+				Should(Succeed())
+		})
+
+		kcpBucket := &cloudcontrolv1beta1.Bucket{}
+
+		By("Then KCP Bucket is created", func() {
+			// load SKR AzureBlobContainer to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureBlobContainer,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR AzureBlobContainer to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					NewObjActions(
+						WithName(azureBlobContainer.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And has annotaton cloud-manager.kyma-project.io/kymaName")
+			Expect(kcpBucket.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteName")
+			Expect(kcpBucket.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(azureBlobContainer.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteNamespace")
+			Expect(kcpBucket.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(azureBlobContainer.Namespace))
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpBucket.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.remoteRef matching to SKR AzureBlobContainer")
+			Expect(kcpBucket.Spec.RemoteRef.Namespace).To(Equal(azureBlobContainer.Namespace))
+			Expect(kcpBucket.Spec.RemoteRef.Name).To(Equal(azureBlobContainer.Name))
+
+			By("And has spec.bucket.azure equal to SKR AzureBlobContainer.spec values")
+			Expect(kcpBucket.Spec.Bucket.Azure.Versioning).To(BeTrue())
+			Expect(kcpBucket.Spec.Bucket.Azure.RetentionDays).To(Equal(int32(7)))
+			Expect(kcpBucket.Spec.Bucket.Azure.Lifecycle).To(Equal([]cloudcontrolv1beta1.BucketLifecycleRule{
+				{Prefix: "logs/", ExpirationDays: 30},
+			}))
+
+			By("And has spec.ipRange.name equal to SKR IpRange.status.id")
+			Expect(kcpBucket.Spec.IpRange.Name).To(Equal(skrIpRange.Status.Id))
+		})
+
+		kcpBucketId := "cm-" + azureBlobContainerName
+		kcpBucketEndpoint := "https://cmc1a9e4f75b024d86a3e97f.blob.core.windows.net/"
+		kcpBucketAccessKeyId := "cmc1a9e4f75b024d86a3e97f"
+		kcpBucketSecretAccessKey := "8d4f2a6c-e0b7-4395-b1d8-2c9e5a7f03b6"
+
+		By("When KCP Bucket has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					WithBucketStatusId(kcpBucketId),
+					WithBucketEndpoint(kcpBucketEndpoint, "westeurope"),
+					WithBucketCredentials(kcpBucketAccessKeyId, kcpBucketSecretAccessKey),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR AzureBlobContainer has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureBlobContainer,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Then SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(authSecretName),
+						WithNamespace(azureBlobContainer.Namespace),
+					),
+					HavingLabelKeys(
+						util.WellKnownK8sLabelComponent,
+						util.WellKnownK8sLabelPartOf,
+						util.WellKnownK8sLabelManagedBy,
+					),
+					HavingLabel(cloudresourcesv1beta1.LabelBucketStatusId, azureBlobContainer.Status.Id),
+					HavingLabels(authSecretLabels),
+				).
+				Should(Succeed())
+
+			By("And it has blob container access data")
+			Expect(authSecret.Data).To(HaveKeyWithValue("storageAccountName", []byte(kcpBucketAccessKeyId)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("containerName", []byte(kcpBucketId)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("endpoint", []byte(kcpBucketEndpoint)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("accountKey", []byte(kcpBucketSecretAccessKey)))
+			Expect(authSecret.Data).To(HaveKey("connectionString"))
+
+			By("And it has user defined custom extraData")
+			Expect(authSecret.Data).To(HaveKeyWithValue("foo", []byte("bar")), "expected auth secret data to have foo=bar")
+			Expect(authSecret.Data).To(HaveKeyWithValue("container", []byte(kcpBucketEndpoint+kcpBucketId)))
+
+			By("And it has defined cloud-manager finalizer")
+			Expect(authSecret.Finalizers).To(ContainElement(api.CommonFinalizerDeletionHook))
+		})
+
+		By("When AzureBlobContainer lifecycle is changed", func() {
+			Eventually(Update).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), azureBlobContainer,
+					WithAzureBlobContainerLifecycle(
+						cloudresourcesv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 14},
+						cloudresourcesv1beta1.BucketLifecycleRule{NoncurrentVersionExpirationDays: 3},
+					),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP Bucket lifecycle is updated", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), kcpBucket, NewObjActions())).To(Succeed())
+				g.Expect(kcpBucket.Spec.Bucket.Azure.Lifecycle).To(Equal([]cloudcontrolv1beta1.BucketLifecycleRule{
+					{Prefix: "logs/", ExpirationDays: 14},
+					{NoncurrentVersionExpirationDays: 3},
+				}))
+			}).Should(Succeed())
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), azureBlobContainer).
+			Should(Succeed())
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+			Should(Succeed())
+	})
+
+	It("Scenario: SKR AzureBlobContainer is deleted", func() {
+
+		skrIpRangeName := "b9d3f7a1-4e28-4c60-a8b2-6e0c4f8d2a57"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "7e1c5a9f-3b47-4d02-8f6e-c0a4b8d2f691"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		azureBlobContainerName := "5a0e8c3d-7f19-4b62-8e4a-d3b6f1c9a270"
+		azureBlobContainer := &cloudresourcesv1beta1.AzureBlobContainer{}
+
+		By("And Given AzureBlobContainer is created", func() {
+			Eventually(CreateAzureBlobContainer).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), azureBlobContainer,
+					WithName(azureBlobContainerName),
+					WithIpRange(skrIpRange.Name),
+				).
+				Should(Succeed())
+		})
+
+		kcpBucket := &cloudcontrolv1beta1.Bucket{}
+
+		By("And Given KCP Bucket is created", func() {
+			// load SKR AzureBlobContainer to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureBlobContainer,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR AzureBlobContainer to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					NewObjActions(
+						WithName(azureBlobContainer.Status.Id),
+					),
+				).
+				Should(Succeed(), "expected KCP Bucket to be created, but it was not")
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpBucket, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP Bucket")
+		})
+
+		By("And Given KCP Bucket has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "failed setting KCP Bucket Ready condition")
+		})
+
+		By("And Given SKR AzureBlobContainer has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureBlobContainer,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed(), "expected AzureBlobContainer to exist and have Ready condition")
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Given SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(azureBlobContainer.Name),
+						WithNamespace(azureBlobContainer.Namespace),
+					),
+				).
+				Should(Succeed(), "failed creating auth Secret")
+		})
+
+		// DELETE START HERE
+
+		By("When AzureBlobContainer is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), azureBlobContainer).
+				Should(Succeed(), "failed deleting AzureBlobContainer")
+		})
+
+		By("Then SKR AzureBlobContainer has Deleting state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					azureBlobContainer,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.StateDeleting),
+					HavingFieldValue(cloudresourcesv1beta1.StateDeleting, "status", "state"),
+				).
+				Should(Succeed(), "expected AzureBlobContainer to have Deleting state")
+		})
+
+		By("And Then SKR auth Secret is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), authSecret).
+				Should(Succeed(), "expected authSecret not to exist")
+		})
+
+		By("And Then KCP Bucket is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpBucket, NewObjActions(), HavingDeletionTimestamp()).
+				Should(Succeed(), "expected KCP Bucket to be marked for deletion")
+		})
+
+		By("When KCP Bucket finalizer is removed and it is deleted", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpBucket, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed removing finalizer on KCP Bucket")
+		})
+
+		By("Then SKR AzureBlobContainer is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), azureBlobContainer).
+				Should(Succeed(), "expected AzureBlobContainer not to exist")
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+			Should(Succeed())
+	})
+})
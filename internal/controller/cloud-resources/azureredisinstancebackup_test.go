@@ -0,0 +1,313 @@
+package cloudresources
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	skrazureredisinstance "github.com/kyma-project/cloud-manager/pkg/skr/azureredisinstance"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Feature: SKR AzureRedisInstanceBackup", func() {
+
+	It("Scenario: SKR AzureRedisInstanceBackup is created and deleted", func() {
+		kymaName := "7d1f3b5a-9c2e-4f6a-8b0d-1e3a5c7e9b26"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: kymaName}))
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		skrRedisInstanceName := "a3c5e7f9-1b2d-4e6f-8a0c-2e4b6d8f0a37"
+		skrRedisInstance := &cloudresourcesv1beta1.AzureRedisInstance{}
+		kcpRedisInstanceName := "d5f7b9c1-3e4a-4c6b-9d8e-0f2a4c6e8b49"
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		backupName := "f1b3d5e7-9a0c-4b2d-8e4f-6a8c0e2b4d5a"
+		backup := &cloudresourcesv1beta1.AzureRedisInstanceBackup{}
+
+		By("Given KCP Scope exists", func() {
+			Expect(client.IgnoreAlreadyExists(
+				CreateScopeAzure(infra.Ctx(), infra, scope, WithName(skrKymaRef.Name)))).
+				To(Succeed())
+		})
+
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+		azureRedisName := kcpRedisInstanceName
+
+		By("And Given Azure Redis instance exists in Succeeded state", func() {
+			Expect(azureMock.CreateRedisInstance(infra.Ctx(), resourceGroupName, azureRedisName, armredis.CreateParameters{
+				Location: new(scope.Spec.Region),
+			})).To(Succeed())
+			Expect(azureMock.AzureSetRedisInstanceState(infra.Ctx(), resourceGroupName, azureRedisName, armredis.ProvisioningStateSucceeded)).
+				To(Succeed())
+		})
+
+		By("And Given KCP RedisInstance exists with status.id", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithName(kcpRedisInstanceName),
+					WithRemoteRef(skrRedisInstanceName),
+					WithIpRange("b9d1f3a5-7c8e-4a0b-8c2d-4e6f8a0c2e6b"),
+					WithScope(skrKymaRef.Name),
+					WithRedisInstanceAzure(),
+					WithSKU(1, "P"),
+					WithKcpAzureRedisVersion("6.0"),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithConditions(KcpReadyCondition()),
+					WithRedisInstanceStatusId(azureRedisName),
+				).Should(Succeed())
+		})
+
+		By("And Given SKR AzureRedisInstance exists in Ready state", func() {
+			skrazureredisinstance.Ignore.AddName(skrRedisInstanceName)
+			Eventually(CreateAzureRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithName(skrRedisInstanceName),
+					WithAzureRedisInstanceDefaultSpecs(),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithConditions(SkrReadyCondition()),
+					WithSkrRedisInstanceStatusId(kcpRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		By("When AzureRedisInstanceBackup is created", func() {
+			Eventually(CreateAzureRedisInstanceBackup).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithName(backupName),
+					WithRedisInstanceBackupSource(skrRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		By("Then AzureRedisInstanceBackup has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					AssertRedisInstanceBackupHasState(cloudresourcesv1beta1.StateReady),
+					HavingFieldSet("status", "id"),
+				).Should(Succeed())
+		})
+
+		var storageAccountName string
+		containerName := fmt.Sprintf("cm-%s", backup.UID)
+
+		By("And Then the blob container exists", func() {
+			u, err := url.Parse(backup.Status.Id)
+			Expect(err).ToNot(HaveOccurred())
+			storageAccountName, _, _ = strings.Cut(u.Host, ".")
+			Expect(u.Path).To(Equal("/" + containerName))
+
+			_, err = azureMock.GetBlobContainer(infra.Ctx(), resourceGroupName, storageAccountName, containerName)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("And Then the storage account exists", func() {
+			_, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// DELETE
+
+		By("When AzureRedisInstanceBackup is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("Then AzureRedisInstanceBackup does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("And Then the storage account does not exist", func() {
+			_, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("// cleanup: SKR AzureRedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrRedisInstance).
+				Should(Succeed())
+		})
+
+		By("// cleanup: KCP RedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: SKR AzureRedisInstanceBackup storage account is deleted with the last backup", func() {
+		kymaName := "2c4e6a8b-0d1f-4a3c-9e5b-7d9f1b3c5e60"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: kymaName}))
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		skrRedisInstanceName := "4e6a8c0d-2f3b-4c5e-8a7d-9f1b3d5e7a82"
+		skrRedisInstance := &cloudresourcesv1beta1.AzureRedisInstance{}
+		kcpRedisInstanceName := "6a8c0e2f-4b5d-4e7a-9c9f-1b3d5f7a9c04"
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		firstBackup := &cloudresourcesv1beta1.AzureRedisInstanceBackup{}
+		secondBackup := &cloudresourcesv1beta1.AzureRedisInstanceBackup{}
+
+		By("Given KCP Scope exists", func() {
+			Expect(client.IgnoreAlreadyExists(
+				CreateScopeAzure(infra.Ctx(), infra, scope, WithName(skrKymaRef.Name)))).
+				To(Succeed())
+		})
+
+		azureMock := infra.AzureMock().MockConfigs(scope.Spec.Scope.Azure.SubscriptionId, scope.Spec.Scope.Azure.TenantId)
+		resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(scope.Spec.Scope.Azure.VpcNetwork)
+		azureRedisName := kcpRedisInstanceName
+
+		By("And Given Azure Redis instance exists in Succeeded state", func() {
+			Expect(azureMock.CreateRedisInstance(infra.Ctx(), resourceGroupName, azureRedisName, armredis.CreateParameters{
+				Location: new(scope.Spec.Region),
+			})).To(Succeed())
+			Expect(azureMock.AzureSetRedisInstanceState(infra.Ctx(), resourceGroupName, azureRedisName, armredis.ProvisioningStateSucceeded)).
+				To(Succeed())
+		})
+
+		By("And Given KCP RedisInstance exists with status.id", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithName(kcpRedisInstanceName),
+					WithRemoteRef(skrRedisInstanceName),
+					WithIpRange("8c0e2a4b-6d7f-4a9c-8e1b-3d5f7b9c1e26"),
+					WithScope(skrKymaRef.Name),
+					WithRedisInstanceAzure(),
+					WithSKU(1, "P"),
+					WithKcpAzureRedisVersion("6.0"),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithConditions(KcpReadyCondition()),
+					WithRedisInstanceStatusId(azureRedisName),
+				).Should(Succeed())
+		})
+
+		By("And Given SKR AzureRedisInstance exists in Ready state", func() {
+			skrazureredisinstance.Ignore.AddName(skrRedisInstanceName)
+			Eventually(CreateAzureRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithName(skrRedisInstanceName),
+					WithAzureRedisInstanceDefaultSpecs(),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithConditions(SkrReadyCondition()),
+					WithSkrRedisInstanceStatusId(kcpRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		for _, x := range []struct {
+			name   string
+			backup *cloudresourcesv1beta1.AzureRedisInstanceBackup
+		}{
+			{"0e2a4c6d-8f9b-4c1e-9a3d-5f7b9d1e3a48", firstBackup},
+			{"2a4c6e8f-0b1d-4e3a-8c5f-7b9d1f3a5c6a", secondBackup},
+		} {
+			By(fmt.Sprintf("And Given AzureRedisInstanceBackup %s is Ready", x.name), func() {
+				Eventually(CreateAzureRedisInstanceBackup).
+					WithArguments(
+						infra.Ctx(), infra.SKR().Client(), x.backup,
+						WithName(x.name),
+						WithRedisInstanceBackupSource(skrRedisInstanceName),
+					).Should(Succeed())
+
+				Eventually(LoadAndCheck).
+					WithArguments(
+						infra.Ctx(), infra.SKR().Client(), x.backup,
+						NewObjActions(),
+						HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+						AssertRedisInstanceBackupHasState(cloudresourcesv1beta1.StateReady),
+					).Should(Succeed())
+			})
+		}
+
+		var storageAccountName string
+
+		By("And Given both backups are exported into the same storage account", func() {
+			firstUrl, err := url.Parse(firstBackup.Status.Id)
+			Expect(err).ToNot(HaveOccurred())
+			secondUrl, err := url.Parse(secondBackup.Status.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondUrl.Host).To(Equal(firstUrl.Host))
+			storageAccountName, _, _ = strings.Cut(firstUrl.Host, ".")
+		})
+
+		By("When first AzureRedisInstanceBackup is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), firstBackup).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), firstBackup).
+				Should(Succeed())
+		})
+
+		By("Then the blob container of the first backup does not exist", func() {
+			_, err := azureMock.GetBlobContainer(infra.Ctx(), resourceGroupName, storageAccountName, fmt.Sprintf("cm-%s", firstBackup.UID))
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("And Then the storage account still exists", func() {
+			_, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = azureMock.GetBlobContainer(infra.Ctx(), resourceGroupName, storageAccountName, fmt.Sprintf("cm-%s", secondBackup.UID))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("When second AzureRedisInstanceBackup is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), secondBackup).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), secondBackup).
+				Should(Succeed())
+		})
+
+		By("Then the storage account does not exist", func() {
+			_, err := azureMock.GetStorageAccount(infra.Ctx(), resourceGroupName, storageAccountName)
+			Expect(azuremeta.IsNotFound(err)).To(BeTrue())
+		})
+
+		By("// cleanup: SKR AzureRedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrRedisInstance).
+				Should(Succeed())
+		})
+
+		By("// cleanup: KCP RedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance).
+				Should(Succeed())
+		})
+	})
+})
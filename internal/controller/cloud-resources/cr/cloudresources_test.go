@@ -5,10 +5,12 @@ import (
 
 	"github.com/kyma-project/cloud-manager/api"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
@@ -58,6 +60,20 @@ var _ = Describe("Feature: CloudResources module CR", func() {
 			Expect(cr.Status.Served).To(Equal(cloudresourcesv1beta1.ServedTrue), "expected .status.served to be 'true'")
 		})
 
+		By("And Then CloudResources has quota usage in status", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), cr, NewObjActions(), func(obj client.Object) error {
+					x := obj.(*cloudresourcesv1beta1.CloudResources)
+					for _, q := range x.Status.Quotas {
+						if q.Resource == "iprange.cloud-resources.kyma-project.io" && q.Quota == quota.QuotaTotalCount {
+							return nil
+						}
+					}
+					return fmt.Errorf("expected iprange totalCount quota usage in status, but got: %v", x.Status.Quotas)
+				}).
+				Should(Succeed())
+		})
+
 		// DELETE ========================
 
 		By("When CloudResources is deleted", func() {
@@ -6,6 +6,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skrgcpredisinstancebackup "github.com/kyma-project/cloud-manager/pkg/skr/gcpredisinstancebackup"
 	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
 	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
 	"github.com/kyma-project/cloud-manager/pkg/util"
@@ -13,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -231,6 +233,171 @@ var _ = Describe("Feature: SKR GcpRedisInstance", func() {
 			Should(Succeed())
 	})
 
+	It("Scenario: SKR GcpRedisInstance is restored from GcpRedisInstanceBackup", func() {
+
+		skrIpRangeName := "restore-custom-ip-range"
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "2f6b8d0a-3c5e-4a7b-9d1f-e8c0a2b4d6f8"
+
+		By("Given SKR IpRange exists", func() {
+			// tell skriprange reconciler to ignore this SKR IpRange
+			skriprange.Ignore.AddName(skrIpRangeName)
+
+			Eventually(CreateSkrIpRange).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName(skrIpRangeName),
+				).
+				Should(Succeed())
+		})
+		By("And Given SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusCidr(skrIpRange.Spec.Cidr),
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		backupName := "restore-source-redis-backup"
+		backup := &cloudresourcesv1beta1.GcpRedisInstanceBackup{}
+		backupUri := "gs://restore-bucket/redis-backup.rdb"
+
+		By("And Given GcpRedisInstanceBackup exists", func() {
+			// tell gcpredisinstancebackup reconciler to ignore this backup
+			skrgcpredisinstancebackup.Ignore.AddName(backupName)
+
+			Eventually(CreateGcpRedisInstanceBackup).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithName(backupName),
+					WithRedisInstanceBackupSource("some-other-redis-instance"),
+					WithGcpRedisInstanceBackupBucket("restore-bucket"),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given GcpRedisInstanceBackup has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithRedisInstanceBackupStatusId(backupUri),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		gcpRedisInstanceName := "restore-gcp-redis-instance"
+		gcpRedisInstance := &cloudresourcesv1beta1.GcpRedisInstance{}
+
+		By("When GcpRedisInstance is created with sourceBackup", func() {
+			Eventually(CreateGcpRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), gcpRedisInstance,
+					WithName(gcpRedisInstanceName),
+					WithIpRange(skrIpRange.Name),
+					WithGcpRedisInstanceDefaultSpec(),
+					WithRedisInstanceSourceBackup(backupName),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("Then KCP RedisInstance is created with import uri of the backup", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpRedisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+				).
+				Should(Succeed(), "expected SKR GcpRedisInstance to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					NewObjActions(
+						WithName(gcpRedisInstance.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			Expect(kcpRedisInstance.Spec.Instance.Gcp.ImportUri).To(Equal(backupUri))
+		})
+
+		By("When KCP RedisInstance has Restoring condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					WithConditions(metav1.Condition{
+						Type:    cloudcontrolv1beta1.ConditionTypeRestoring,
+						Status:  metav1.ConditionTrue,
+						Reason:  cloudcontrolv1beta1.ConditionTypeRestoring,
+						Message: "Redis is being restored",
+					}),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR GcpRedisInstance has Restoring condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeRestoring),
+					HavingFieldValue(cloudresourcesv1beta1.StateRestoring, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		By("When KCP RedisInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					WithoutConditions(cloudcontrolv1beta1.ConditionTypeRestoring),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR GcpRedisInstance has Ready condition and no Restoring condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					NotHavingConditionTrue(cloudresourcesv1beta1.ConditionTypeRestoring),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), gcpRedisInstance).
+			Should(Succeed())
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+			Should(Succeed())
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+			Should(Succeed())
+	})
+
 	It("Scenario: SKR GcpRedisInstance is deleted", func() {
 
 		skrIpRangeName := "another-custom-ip-range"
@@ -0,0 +1,226 @@
+package cloudresources
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"cloud.google.com/go/redis/apiv1/redispb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skrgcpredisinstance "github.com/kyma-project/cloud-manager/pkg/skr/gcpredisinstance"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/api/storage/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Feature: SKR GcpRedisInstanceBackup", func() {
+
+	It("Scenario: SKR GcpRedisInstanceBackup is created and deleted", func() {
+		gcpMock := infra.GcpMock2().NewSubscription("redis-backup")
+		defer gcpMock.Delete()
+
+		kymaName := "5c0f4b5d-7a55-4d3e-9a43-2f8f6c0d6a1e"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: kymaName}))
+		scope := &cloudcontrolv1beta1.Scope{}
+
+		skrRedisInstanceName := "8f3c1a8e-5b4d-4f0a-b5b1-3a2a8e3f7c10"
+		skrRedisInstance := &cloudresourcesv1beta1.GcpRedisInstance{}
+		kcpRedisInstanceName := "c1d1e0a2-3b9e-4c3f-8a5e-6f1b7e2d9a44"
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+		bucketName := "redis-backup-bucket"
+		backupName := "e7b0d6a5-1f9c-4e38-9c2d-7a4b5c6d8e90"
+		backup := &cloudresourcesv1beta1.GcpRedisInstanceBackup{}
+
+		By("Given KCP Scope exists", func() {
+			Expect(infra.GivenScopeGcpExistsWithProject(skrKymaRef.Name, gcpMock.ProjectId())).NotTo(HaveOccurred())
+			Eventually(func() (exists bool, err error) {
+				err = infra.KCP().Client().Get(infra.Ctx(), infra.KCP().ObjKey(skrKymaRef.Name), scope)
+				exists = err == nil
+				return exists, client.IgnoreNotFound(err)
+			}).Should(BeTrue(), "expected Scope to get created")
+		})
+
+		vpcNetworkName := scope.Spec.Scope.Gcp.VpcNetwork
+		addressName := "test-psa-address"
+
+		By("And Given GCP VPC network with PSA connection exists", func() {
+			op, err := gcpMock.InsertNetwork(infra.Ctx(), &computepb.InsertNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				NetworkResource: &computepb.Network{
+					Name: new(vpcNetworkName),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+
+			net, err := gcpMock.GetNetwork(infra.Ctx(), &computepb.GetNetworkRequest{
+				Project: gcpMock.ProjectId(),
+				Network: vpcNetworkName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			op, err = gcpMock.InsertGlobalAddress(infra.Ctx(), &computepb.InsertGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				AddressResource: &computepb.Address{
+					Name:         new(addressName),
+					Address:      new("10.251.0.0"),
+					PrefixLength: new(int32(16)),
+					Network:      new(net.GetSelfLink()),
+					AddressType:  new(computepb.Address_INTERNAL.String()),
+					Purpose:      new(computepb.Address_VPC_PEERING.String()),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Wait(infra.Ctx())).To(Succeed())
+
+			_, err = gcpMock.CreateServiceConnection(infra.Ctx(), gcpMock.ProjectId(), net.GetName(), []string{addressName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		gcpRedisInstanceName := fmt.Sprintf("projects/%s/locations/%s/instances/cm-%s", gcpMock.ProjectId(), scope.Spec.Region, kcpRedisInstanceName)
+
+		By("And Given GCP Memorystore instance exists", func() {
+			addr, err := gcpMock.GetGlobalAddress(infra.Ctx(), &computepb.GetGlobalAddressRequest{
+				Project: gcpMock.ProjectId(),
+				Address: addressName,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			op, err := gcpMock.CreateRedisInstance(infra.Ctx(), &redispb.CreateInstanceRequest{
+				Parent:     fmt.Sprintf("projects/%s/locations/%s", gcpMock.ProjectId(), scope.Spec.Region),
+				InstanceId: fmt.Sprintf("cm-%s", kcpRedisInstanceName),
+				Instance: &redispb.Instance{
+					Tier:              redispb.Instance_BASIC,
+					MemorySizeGb:      5,
+					RedisVersion:      "REDIS_7_0",
+					ConnectMode:       redispb.Instance_PRIVATE_SERVICE_ACCESS,
+					AuthorizedNetwork: fmt.Sprintf("projects/%s/global/networks/%s", gcpMock.ProjectId(), vpcNetworkName),
+					ReservedIpRange:   addr.GetSelfLink(),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), op.Name())).To(Succeed())
+		})
+
+		By("And Given GCS bucket exists", func() {
+			_, err := gcpMock.InsertStorageBucket(infra.Ctx(), gcpMock.ProjectId(), &storage.Bucket{Name: bucketName})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		By("And Given KCP RedisInstance exists with status.id", func() {
+			Eventually(CreateRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithName(kcpRedisInstanceName),
+					WithNamespace(skrKymaRef.Namespace),
+					WithRemoteRef(skrRedisInstanceName),
+					WithIpRange("5e1c2a0b-6d9f-4f8e-a3c1-0b7d2e4f6a88"),
+					WithScope(skrKymaRef.Name),
+					WithRedisInstanceGcp(),
+					WithKcpGcpRedisInstanceTier("BASIC"),
+					WithKcpGcpRedisInstanceMemorySizeGb(5),
+					WithKcpGcpRedisInstanceRedisVersion("REDIS_7_0"),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.KCP().Client(), kcpRedisInstance,
+					WithConditions(KcpReadyCondition()),
+					WithRedisInstanceStatusId(gcpRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		By("And Given SKR GcpRedisInstance exists in Ready state", func() {
+			skrgcpredisinstance.Ignore.AddName(skrRedisInstanceName)
+			Eventually(CreateGcpRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithName(skrRedisInstanceName),
+					WithGcpRedisInstanceDefaultSpec(),
+				).Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrRedisInstance,
+					WithConditions(SkrReadyCondition()),
+					WithSkrRedisInstanceStatusId(kcpRedisInstanceName),
+				).Should(Succeed())
+		})
+
+		By("When GcpRedisInstanceBackup is created", func() {
+			Eventually(CreateGcpRedisInstanceBackup).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					WithName(backupName),
+					WithRedisInstanceBackupSource(skrRedisInstanceName),
+					WithGcpRedisInstanceBackupBucket(bucketName),
+				).Should(Succeed())
+		})
+
+		By("Then GcpRedisInstanceBackup has status.id set", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldSet("status", "opIdentifier"),
+				).Should(Succeed(), "expected GcpRedisInstanceBackup to get status.id and status.opIdentifier")
+		})
+
+		By("When GCP export operation is resolved", func() {
+			Expect(gcpMock.ResolveRedisInstanceOperation(infra.Ctx(), backup.Status.OpIdentifier)).To(Succeed())
+		})
+
+		By("Then GcpRedisInstanceBackup has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), backup,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					AssertRedisInstanceBackupHasState(cloudresourcesv1beta1.StateReady),
+				).Should(Succeed())
+		})
+
+		objectName := fmt.Sprintf("cm-%s.rdb", backup.UID)
+
+		By("And Then the RDB object exists in the bucket", func() {
+			Expect(backup.Status.Id).To(Equal(fmt.Sprintf("gs://%s/%s", bucketName, objectName)))
+			_, err := gcpMock.GetStorageObject(infra.Ctx(), bucketName, objectName)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// DELETE
+
+		By("When GcpRedisInstanceBackup is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("Then GcpRedisInstanceBackup does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), backup).
+				Should(Succeed())
+		})
+
+		By("And Then the RDB object does not exist in the bucket", func() {
+			_, err := gcpMock.GetStorageObject(infra.Ctx(), bucketName, objectName)
+			Expect(err).To(HaveOccurred())
+		})
+
+		By("// cleanup: SKR GcpRedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrRedisInstance).
+				Should(Succeed())
+		})
+
+		By("// cleanup: KCP RedisInstance", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance).
+				Should(Succeed())
+		})
+	})
+})
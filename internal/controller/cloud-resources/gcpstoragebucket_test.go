@@ -0,0 +1,324 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR GcpStorageBucket", func() {
+
+	It("Scenario: SKR GcpStorageBucket is created", func() {
+
+		gcpStorageBucketName := "2f8c4a06-b1e9-4d73-a5c2-9e0b6d3f7a18"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: gcpStorageBucketName}))
+		gcpStorageBucket := &cloudresourcesv1beta1.GcpStorageBucket{}
+
+		const (
+			authSecretName = "7b3e1d95-c4a0-4f28-b6e7-0d2a9c5f8e31"
+		)
+		authSecretLabels := map[string]string{
+			"foo": "1",
+		}
+
+		extraData := map[string]string{
+			"foo":    "bar",
+			"bucket": "gs://{{.bucketName}}",
+		}
+
+		By("When GcpStorageBucket is created", func() {
+			Eventually(CreateGcpStorageBucket).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), gcpStorageBucket,
+					WithName(gcpStorageBucketName),
+					WithGcpStorageBucketVersioning(true),
+					WithGcpStorageBucketLifecycle(cloudresourcesv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 30}),
+					WithGcpStorageBucketAuthSecretName(authSecretName),
+					WithGcpStorageBucketAuthSecretLabels(authSecretLabels),
+					WithGcpStorageBucketAuthSecretExtraData(extraData),
+				).
+				Should(Succeed())
+		})
+
+		kcpBucket := &cloudcontrolv1beta1.Bucket{}
+
+		By("Then KCP Bucket is created", func() {
+			// load SKR GcpStorageBucket to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpStorageBucket,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR GcpStorageBucket to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					NewObjActions(
+						WithName(gcpStorageBucket.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And has annotaton cloud-manager.kyma-project.io/kymaName")
+			Expect(kcpBucket.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteName")
+			Expect(kcpBucket.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(gcpStorageBucket.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteNamespace")
+			Expect(kcpBucket.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(gcpStorageBucket.Namespace))
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpBucket.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.remoteRef matching to SKR GcpStorageBucket")
+			Expect(kcpBucket.Spec.RemoteRef.Namespace).To(Equal(gcpStorageBucket.Namespace))
+			Expect(kcpBucket.Spec.RemoteRef.Name).To(Equal(gcpStorageBucket.Name))
+
+			By("And has spec.bucket.gcp equal to SKR GcpStorageBucket.spec values")
+			Expect(kcpBucket.Spec.Bucket.Gcp.Versioning).To(BeTrue())
+			Expect(kcpBucket.Spec.Bucket.Gcp.Lifecycle).To(Equal([]cloudcontrolv1beta1.BucketLifecycleRule{
+				{Prefix: "logs/", ExpirationDays: 30},
+			}))
+		})
+
+		kcpBucketId := "cm-" + gcpStorageBucketName
+		kcpBucketEndpoint := "https://storage.googleapis.com"
+		kcpBucketAccessKeyId := "GOOG1EXAMPLEHMACACCESSID"
+		kcpBucketSecretAccessKey := "e6a0c3f8-2d5b-4197-8c4e-b1f7a9d3e052"
+
+		By("When KCP Bucket has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					WithBucketStatusId(kcpBucketId),
+					WithBucketEndpoint(kcpBucketEndpoint, "europe-west1"),
+					WithBucketCredentials(kcpBucketAccessKeyId, kcpBucketSecretAccessKey),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR GcpStorageBucket has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpStorageBucket,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Then SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(authSecretName),
+						WithNamespace(gcpStorageBucket.Namespace),
+					),
+					HavingLabelKeys(
+						util.WellKnownK8sLabelComponent,
+						util.WellKnownK8sLabelPartOf,
+						util.WellKnownK8sLabelManagedBy,
+					),
+					HavingLabel(cloudresourcesv1beta1.LabelBucketStatusId, gcpStorageBucket.Status.Id),
+					HavingLabels(authSecretLabels),
+				).
+				Should(Succeed())
+
+			By("And it has bucket access data")
+			Expect(authSecret.Data).To(HaveKeyWithValue("bucketName", []byte(kcpBucketId)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("location", []byte("europe-west1")))
+			Expect(authSecret.Data).To(HaveKeyWithValue("endpoint", []byte(kcpBucketEndpoint)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("accessKeyId", []byte(kcpBucketAccessKeyId)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("secretAccessKey", []byte(kcpBucketSecretAccessKey)))
+
+			By("And it has user defined custom extraData")
+			Expect(authSecret.Data).To(HaveKeyWithValue("foo", []byte("bar")), "expected auth secret data to have foo=bar")
+			Expect(authSecret.Data).To(HaveKeyWithValue("bucket", []byte("gs://"+kcpBucketId)))
+
+			By("And it has defined cloud-manager finalizer")
+			Expect(authSecret.Finalizers).To(ContainElement(api.CommonFinalizerDeletionHook))
+		})
+
+		By("When GcpStorageBucket lifecycle is changed", func() {
+			Eventually(Update).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), gcpStorageBucket,
+					WithGcpStorageBucketLifecycle(
+						cloudresourcesv1beta1.BucketLifecycleRule{Prefix: "logs/", ExpirationDays: 14},
+						cloudresourcesv1beta1.BucketLifecycleRule{NoncurrentVersionExpirationDays: 3},
+					),
+				).
+				Should(Succeed())
+		})
+
+		By("Then KCP Bucket lifecycle is updated", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(LoadAndCheck(infra.Ctx(), infra.KCP().Client(), kcpBucket, NewObjActions())).To(Succeed())
+				g.Expect(kcpBucket.Spec.Bucket.Gcp.Lifecycle).To(Equal([]cloudcontrolv1beta1.BucketLifecycleRule{
+					{Prefix: "logs/", ExpirationDays: 14},
+					{NoncurrentVersionExpirationDays: 3},
+				}))
+			}).Should(Succeed())
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), gcpStorageBucket).
+			Should(Succeed())
+	})
+
+	It("Scenario: SKR GcpStorageBucket is deleted", func() {
+
+		gcpStorageBucketName := "94d2f7b0-a3c6-4e81-9b5d-6f0e2c8a1d47"
+		gcpStorageBucket := &cloudresourcesv1beta1.GcpStorageBucket{}
+
+		By("Given GcpStorageBucket is created", func() {
+			Eventually(CreateGcpStorageBucket).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), gcpStorageBucket,
+					WithName(gcpStorageBucketName),
+				).
+				Should(Succeed())
+		})
+
+		kcpBucket := &cloudcontrolv1beta1.Bucket{}
+
+		By("And Given KCP Bucket is created", func() {
+			// load SKR GcpStorageBucket to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpStorageBucket,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR GcpStorageBucket to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					NewObjActions(
+						WithName(gcpStorageBucket.Status.Id),
+					),
+				).
+				Should(Succeed(), "expected KCP Bucket to be created, but it was not")
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpBucket, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP Bucket")
+		})
+
+		By("And Given KCP Bucket has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpBucket,
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "failed setting KCP Bucket Ready condition")
+		})
+
+		By("And Given SKR GcpStorageBucket has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpStorageBucket,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed(), "expected GcpStorageBucket to exist and have Ready condition")
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Given SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(gcpStorageBucket.Name),
+						WithNamespace(gcpStorageBucket.Namespace),
+					),
+				).
+				Should(Succeed(), "failed creating auth Secret")
+		})
+
+		// DELETE START HERE
+
+		By("When GcpStorageBucket is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), gcpStorageBucket).
+				Should(Succeed(), "failed deleting GcpStorageBucket")
+		})
+
+		By("Then SKR GcpStorageBucket has Deleting state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					gcpStorageBucket,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.StateDeleting),
+					HavingFieldValue(cloudresourcesv1beta1.StateDeleting, "status", "state"),
+				).
+				Should(Succeed(), "expected GcpStorageBucket to have Deleting state")
+		})
+
+		By("And Then SKR auth Secret is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), authSecret).
+				Should(Succeed(), "expected authSecret not to exist")
+		})
+
+		By("And Then KCP Bucket is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpBucket, NewObjActions(), HavingDeletionTimestamp()).
+				Should(Succeed(), "expected KCP Bucket to be marked for deletion")
+		})
+
+		By("When KCP Bucket finalizer is removed and it is deleted", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpBucket, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed removing finalizer on KCP Bucket")
+		})
+
+		By("Then SKR GcpStorageBucket is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), gcpStorageBucket).
+				Should(Succeed(), "expected GcpStorageBucket not to exist")
+		})
+	})
+})
@@ -101,6 +101,46 @@ func SetupIpRangeReconciler(reg skrruntime.SkrRegistry) error {
 		}
 		return []string{redisInstance.Spec.IpRange.Name}
 	})
+	reg.IndexField(&cloudresourcesv1beta1.AwsPostgresInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		postgresInstance, ok := object.(*cloudresourcesv1beta1.AwsPostgresInstance)
+		if !ok {
+			return []string{}
+		}
+		if postgresInstance.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{postgresInstance.Spec.IpRange.Name}
+	})
+	reg.IndexField(&cloudresourcesv1beta1.GcpPostgresInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		postgresInstance, ok := object.(*cloudresourcesv1beta1.GcpPostgresInstance)
+		if !ok {
+			return []string{}
+		}
+		if postgresInstance.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{postgresInstance.Spec.IpRange.Name}
+	})
+	reg.IndexField(&cloudresourcesv1beta1.AzurePostgresInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		postgresInstance, ok := object.(*cloudresourcesv1beta1.AzurePostgresInstance)
+		if !ok {
+			return []string{}
+		}
+		if postgresInstance.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{postgresInstance.Spec.IpRange.Name}
+	})
+	reg.IndexField(&cloudresourcesv1beta1.AzureBlobContainer{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		blobContainer, ok := object.(*cloudresourcesv1beta1.AzureBlobContainer)
+		if !ok {
+			return []string{}
+		}
+		if blobContainer.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{blobContainer.Spec.IpRange.Name}
+	})
 	reg.IndexField(&cloudresourcesv1beta1.AzureRedisInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
 		redisInstance, ok := object.(*cloudresourcesv1beta1.AzureRedisInstance)
 		if !ok {
@@ -111,6 +151,16 @@ func SetupIpRangeReconciler(reg skrruntime.SkrRegistry) error {
 		}
 		return []string{redisInstance.Spec.IpRange.Name}
 	})
+	reg.IndexField(&cloudresourcesv1beta1.SapRedisInstance{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
+		redisInstance, ok := object.(*cloudresourcesv1beta1.SapRedisInstance)
+		if !ok {
+			return []string{}
+		}
+		if redisInstance.Spec.IpRange.Name == "" {
+			return []string{"default"}
+		}
+		return []string{redisInstance.Spec.IpRange.Name}
+	})
 	reg.IndexField(&cloudresourcesv1beta1.AwsRedisCluster{}, cloudresourcesv1beta1.IpRangeField, func(object client.Object) []string {
 		redisCluster, ok := object.(*cloudresourcesv1beta1.AwsRedisCluster)
 		if !ok {
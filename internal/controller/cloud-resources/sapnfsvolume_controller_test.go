@@ -432,4 +432,160 @@ var _ = Describe("Feature: SKR SapNfsVolume", func() {
 				Should(Succeed())
 		})
 	})
+
+	It("Scenario: SKR SapNfsVolume is resized", func() {
+		sapNfsVolumeName := "5b0c1a4e-77c2-4a39-9d5e-0f6f3d3b8a21"
+		sapNfsVolume := &cloudresourcesv1beta1.SapNfsVolume{}
+		kcpNfsInstance := &cloudcontrolv1beta1.NfsInstance{}
+		pv := &corev1.PersistentVolume{}
+		pvc := &corev1.PersistentVolumeClaim{}
+
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+		skrIpRangeId := "8e3f0f52-4cbb-4f0e-9a77-34d6b1d5b0c4"
+
+		skriprange.Ignore.AddName("default")
+
+		By("Given default SKR IpRange exists and is Ready", func() {
+			Eventually(CreateObj).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithName("default"), WithNamespace("kyma-system")).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given SapNfsVolume is created with 100GB capacity", func() {
+			Eventually(CreateSapNfsVolume).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), sapNfsVolume,
+					WithName(sapNfsVolumeName),
+					WithSapNfsVolumeCapacity(100),
+				).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapNfsVolume, NewObjActions(), HavingFieldSet("status", "id")).
+				Should(Succeed())
+		})
+
+		By("And Given KCP NfsInstance is Ready", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, NewObjActions(WithName(sapNfsVolume.Status.Id))).
+				Should(Succeed())
+
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					WithNfsInstanceStatusHost(""),
+					WithNfsInstanceStatusPath(""),
+					WithNfsInstanceStatusCapacity(resource.MustParse("100Gi")),
+					WithNfsInstanceStatusCapacityGb(100),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("And Given SKR PersistentVolumeClaim is bound", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pvc, NewObjActions(WithName(sapNfsVolume.Name), WithNamespace(sapNfsVolume.Namespace))).
+				Should(Succeed())
+
+			// envtest has no PV controller, so the binding capacity is set as it would be
+			pvc.Status.Phase = corev1.ClaimBound
+			pvc.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("100G")}
+			Expect(infra.SKR().Client().Status().Update(infra.Ctx(), pvc)).To(Succeed())
+		})
+
+		By("When SapNfsVolume capacity is changed to 200GB", func() {
+			Eventually(func() error {
+				if err := infra.SKR().Client().Get(infra.Ctx(), types.NamespacedName{Namespace: sapNfsVolume.Namespace, Name: sapNfsVolume.Name}, sapNfsVolume); err != nil {
+					return err
+				}
+				sapNfsVolume.Spec.CapacityGb = 200
+				return infra.SKR().Client().Update(infra.Ctx(), sapNfsVolume)
+			}).Should(Succeed())
+		})
+
+		By("Then KCP NfsInstance is resized to 200GB", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpNfsInstance, NewObjActions(),
+					HavingFieldValue(int64(200), "spec", "instance", "openStack", "sizeGb")).
+				Should(Succeed())
+		})
+
+		By("And Then SKR PersistentVolume capacity is not changed until KCP NfsInstance is resized", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), pv, NewObjActions(WithName(sapNfsVolume.Status.Id))).
+				Should(Succeed())
+			Expect(pv.Spec.Capacity[corev1.ResourceStorage]).To(Equal(resource.MustParse("100G")))
+		})
+
+		By("When KCP NfsInstance has 200GB capacity", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpNfsInstance,
+					WithNfsInstanceStatusCapacity(resource.MustParse("200Gi")),
+					WithNfsInstanceStatusCapacityGb(200),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR PersistentVolume has 200GB capacity", func() {
+			Eventually(func() error {
+				if err := infra.SKR().Client().Get(infra.Ctx(), types.NamespacedName{Name: pv.Name}, pv); err != nil {
+					return err
+				}
+				if q := pv.Spec.Capacity[corev1.ResourceStorage]; !q.Equal(resource.MustParse("200G")) {
+					return fmt.Errorf("expected PV capacity 200G, but got %s", q.String())
+				}
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("And Then SKR PersistentVolumeClaim status has 200GB capacity", func() {
+			Eventually(func() error {
+				if err := infra.SKR().Client().Get(infra.Ctx(), types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}, pvc); err != nil {
+					return err
+				}
+				if q := pvc.Status.Capacity[corev1.ResourceStorage]; !q.Equal(resource.MustParse("200G")) {
+					return fmt.Errorf("expected PVC status capacity 200G, but got %s", q.String())
+				}
+				return nil
+			}).Should(Succeed())
+		})
+
+		By("And Then SKR SapNfsVolume has Ready condition and 200GB status capacity", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapNfsVolume, NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady)).
+				Should(Succeed())
+			Expect(sapNfsVolume.Status.Capacity).To(Equal(resource.MustParse("200Gi")))
+		})
+
+		By("// cleanup", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapNfsVolume).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapNfsVolume).
+				Should(Succeed())
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
 })
@@ -0,0 +1,373 @@
+package cloudresources
+
+import (
+	"github.com/kyma-project/cloud-manager/api"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	skriprange "github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR SapRedisInstance", func() {
+
+	It("Scenario: SKR SapRedisInstance is created", func() {
+
+		sapRedisInstanceName := "sap-custom-redis-instance"
+		skrKymaRef := util.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: sapRedisInstanceName}))
+		skrIpRangeId := "7e3a1b2c-5d4f-4a6b-8c9d-0e1f2a3b4c5d"
+		sapRedisInstance := &cloudresourcesv1beta1.SapRedisInstance{}
+		tier := cloudresourcesv1beta1.SapRedisTierS2
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		skriprange.Ignore.AddName("default")
+
+		const (
+			authSecretName = "sap-custom-auth-secretname"
+		)
+		authSecretLabels := map[string]string{
+			"foo": "1",
+		}
+		authSecretAnnotations := map[string]string{
+			"bar": "2",
+		}
+		extraData := map[string]string{
+			"foo":    "bar",
+			"parsed": "{{.host}}:{{.port}}",
+		}
+
+		By("Given default SKR IpRange does not exist", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				ShouldNot(Succeed())
+		})
+
+		By("When SapRedisInstance is created", func() {
+			Eventually(CreateSapRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), sapRedisInstance,
+					WithName(sapRedisInstanceName),
+					WithSapRedisInstanceRedisTier(tier),
+					WithSapRedisInstanceAuthEnabled(true),
+					WithSapRedisInstanceAuthSecretName(authSecretName),
+					WithSapRedisInstanceAuthSecretLabels(authSecretLabels),
+					WithSapRedisInstanceAuthSecretAnnotations(authSecretAnnotations),
+					WithSapRedisInstanceAuthSecretExtraData(extraData),
+				).
+				Should(Succeed())
+		})
+
+		By("Then default SKR IpRange is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				Should(Succeed())
+		})
+
+		By("When default SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("Then KCP RedisInstance is created", func() {
+			// load SKR SapRedisInstance to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapRedisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed())
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					NewObjActions(
+						WithName(sapRedisInstance.Status.Id),
+					),
+				).
+				Should(Succeed())
+
+			By("And has annotaton cloud-manager.kyma-project.io/kymaName")
+			Expect(kcpRedisInstance.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteName")
+			Expect(kcpRedisInstance.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(sapRedisInstance.Name))
+
+			By("And has annotaton cloud-manager.kyma-project.io/remoteNamespace")
+			Expect(kcpRedisInstance.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(sapRedisInstance.Namespace))
+
+			By("And has spec.scope.name equal to SKR Cluster kyma name")
+			Expect(kcpRedisInstance.Spec.Scope.Name).To(Equal(skrKymaRef.Name))
+
+			By("And has spec.ipRange.name equal to SKR IpRange status.id")
+			Expect(kcpRedisInstance.Spec.IpRange.Name).To(Equal(skrIpRangeId))
+
+			By("And has spec.instance.openStack equal to SKR SapRedisInstance.spec values")
+			Expect(kcpRedisInstance.Spec.Instance.OpenStack).NotTo(BeNil())
+			Expect(kcpRedisInstance.Spec.Instance.OpenStack.FlavorName).To(Equal("redis_c2_m4"))
+			Expect(kcpRedisInstance.Spec.Instance.OpenStack.VolumeSizeGb).To(Equal(8))
+			Expect(kcpRedisInstance.Spec.Instance.OpenStack.Engine).To(Equal(string(cloudresourcesv1beta1.SapRedisEngineValkey)))
+			Expect(kcpRedisInstance.Spec.Instance.OpenStack.EngineVersion).To(Equal("8.0"))
+			Expect(kcpRedisInstance.Spec.Instance.OpenStack.AuthEnabled).To(BeTrue())
+		})
+
+		kcpRedisInstancePrimaryEndpoint := "10.250.4.5:6379"
+		kcpRedisInstanceAuthString := "Kq7mXz2vRb9TnW4pLs8dHf3c"
+
+		By("When KCP RedisInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					WithRedisInstancePrimaryEndpoint(kcpRedisInstancePrimaryEndpoint),
+					WithRedisInstanceAuthString(kcpRedisInstanceAuthString),
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		By("Then SKR SapRedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed())
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Then SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(authSecretName),
+						WithNamespace(sapRedisInstance.Namespace),
+					),
+					HavingLabelKeys(
+						util.WellKnownK8sLabelComponent,
+						util.WellKnownK8sLabelPartOf,
+						util.WellKnownK8sLabelManagedBy,
+					),
+					HavingLabel(cloudresourcesv1beta1.LabelRedisInstanceStatusId, sapRedisInstance.Status.Id),
+					HavingLabels(authSecretLabels),
+					HavingAnnotations(authSecretAnnotations),
+				).
+				Should(Succeed())
+			Expect(authSecret.Data).To(HaveKeyWithValue("host", []byte("10.250.4.5")))
+			Expect(authSecret.Data).To(HaveKeyWithValue("port", []byte("6379")))
+			Expect(authSecret.Data).To(HaveKeyWithValue("authString", []byte(kcpRedisInstanceAuthString)))
+			Expect(authSecret.Data).To(HaveKeyWithValue("parsed", []byte(kcpRedisInstancePrimaryEndpoint)), "expected auth secret data to have parsed=host:port")
+
+			By("And it has defined cloud-manager finalizer")
+			Expect(authSecret.Finalizers).To(ContainElement(api.CommonFinalizerDeletionHook))
+		})
+
+		// CleanUp
+		Eventually(Delete).
+			WithArguments(infra.Ctx(), infra.SKR().Client(), sapRedisInstance).
+			Should(Succeed())
+
+		By("// cleanup: delete default SKR IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
+
+	It("Scenario: SKR SapRedisInstance is deleted", func() {
+
+		sapRedisInstanceName := "another-sap-redis-instance"
+		skrIpRangeId := "7e3a1b2c-5d4f-4a6b-8c9d-0e1f2a3b4c6e"
+		sapRedisInstance := &cloudresourcesv1beta1.SapRedisInstance{}
+		tier := cloudresourcesv1beta1.SapRedisTierS1
+		skrIpRange := &cloudresourcesv1beta1.IpRange{}
+
+		skriprange.Ignore.AddName("default")
+
+		By("Given default SKR IpRange does not exist", func() {
+			Consistently(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				ShouldNot(Succeed())
+		})
+
+		By("Given SapRedisInstance is created", func() {
+			Eventually(CreateSapRedisInstance).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), sapRedisInstance,
+					WithName(sapRedisInstanceName),
+					WithSapRedisInstanceRedisTier(tier),
+				).
+				Should(Succeed())
+		})
+
+		By("Then default SKR IpRange is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					NewObjActions(WithName("default"), WithNamespace("kyma-system"))).
+				Should(Succeed())
+		})
+
+		By("When default SKR IpRange has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), skrIpRange,
+					WithSkrIpRangeStatusId(skrIpRangeId),
+					WithConditions(SkrReadyCondition()),
+				).
+				Should(Succeed())
+		})
+
+		kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+
+		By("And Given KCP RedisInstance is created", func() {
+			// load SKR SapRedisInstance to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapRedisInstance,
+					NewObjActions(),
+					HavingFieldSet("status", "id"),
+					HavingFieldValue(cloudresourcesv1beta1.StateCreating, "status", "state"),
+				).
+				Should(Succeed(), "expected SKR SapRedisInstance to get status.id")
+
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					NewObjActions(
+						WithName(sapRedisInstance.Status.Id),
+					),
+				).
+				Should(Succeed(), "expected KCP RedisInstance to be created, but it was not")
+
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance, AddFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed adding finalizer on KCP RedisInstance")
+		})
+
+		By("And Given KCP RedisInstance has Ready condition", func() {
+			Eventually(UpdateStatus).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					kcpRedisInstance,
+					WithConditions(KcpReadyCondition()),
+				).
+				Should(Succeed(), "failed setting KCP RedisInstance Ready condition")
+		})
+
+		By("And Given SKR SapRedisInstance has Ready condition", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.ConditionTypeReady),
+					HavingFieldValue(cloudresourcesv1beta1.StateReady, "status", "state"),
+				).
+				Should(Succeed(), "expected SapRedisInstance to exist and have Ready condition")
+		})
+
+		authSecret := &corev1.Secret{}
+		By("And Given SKR auth Secret is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					authSecret,
+					NewObjActions(
+						WithName(sapRedisInstance.Name),
+						WithNamespace(sapRedisInstance.Namespace),
+					),
+				).
+				Should(Succeed(), "failed creating auth Secret")
+		})
+
+		By("When SapRedisInstance is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapRedisInstance).
+				Should(Succeed(), "failed deleting SapRedisInstance")
+		})
+
+		By("Then SKR SapRedisInstance has Deleting state", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapRedisInstance,
+					NewObjActions(),
+					HavingConditionTrue(cloudresourcesv1beta1.StateDeleting),
+					HavingFieldValue(cloudresourcesv1beta1.StateDeleting, "status", "state"),
+				).
+				Should(Succeed(), "expected SapRedisInstance to have Deleting state")
+		})
+
+		By("And Then SKR auth Secret is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), authSecret).
+				Should(Succeed(), "expected authSecret not to exist")
+		})
+
+		By("And Then KCP RedisInstance is marked for deletion", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance, NewObjActions(), HavingDeletionTimestamp()).
+				Should(Succeed(), "expected KCP RedisInstance to be marked for deletion")
+		})
+
+		By("When KCP RedisInstance finalizer is removed and it is deleted", func() {
+			Eventually(Update).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), kcpRedisInstance, RemoveFinalizer(api.CommonFinalizerDeletionHook)).
+				Should(Succeed(), "failed removing finalizer on KCP RedisInstance")
+		})
+
+		By("Then SKR SapRedisInstance is deleted", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapRedisInstance).
+				Should(Succeed(), "expected SapRedisInstance not to exist")
+		})
+
+		By("// cleanup: delete default SKR IpRange", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), skrIpRange).
+				Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package cloudresources
+
+import (
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	. "github.com/kyma-project/cloud-manager/pkg/testinfra/dsl"
+	cmutil "github.com/kyma-project/cloud-manager/pkg/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Feature: SKR SapVpcPeering", func() {
+
+	It("Scenario: SKR SapVpcPeering is created then deleted", func() {
+		const (
+			remoteProject   = "remote-project"
+			remoteNetworkId = "9e1c5a3b-7f43-4d0e-8a52-6d2f0c8b4e17"
+		)
+		sapVpcPeeringName := "5c2e8f4a-1b7d-4e39-9a06-3f8d2c7b1e54"
+		sapVpcPeering := &cloudresourcesv1beta1.SapVpcPeering{}
+
+		skrKymaRef := cmutil.Must(infra.ScopeProvider().GetScope(infra.Ctx(), types.NamespacedName{Name: sapVpcPeeringName}))
+
+		By("When SapVpcPeering is created", func() {
+			Eventually(CreateSapVpcPeering).
+				WithArguments(
+					infra.Ctx(), infra.SKR().Client(), sapVpcPeering,
+					WithName(sapVpcPeeringName),
+					WithSapVpcPeeringRemote(remoteProject, remoteNetworkId),
+					WithSapVpcPeeringMode(cloudresourcesv1beta1.SapVpcPeeringModeNetworkRbac),
+				).Should(Succeed())
+		})
+
+		By("Then SapVpcPeering has status.ID", func() {
+			// load SKR SapVpcPeering to get ID
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapVpcPeering,
+					NewObjActions(),
+					AssertSapVpcPeeringHasId(),
+				).
+				Should(Succeed(), "expected SapVpcPeering to get status.Id, but it didn't")
+		})
+
+		remoteNetwork := &cloudcontrolv1beta1.Network{}
+
+		By("Then KCP remote Network is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					remoteNetwork,
+					NewObjActions(WithName(sapVpcPeering.Status.Id)),
+				).
+				Should(Succeed(), "failed to load remote Network")
+		})
+
+		By("And Then KCP remote Network is Ready", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(),
+					infra.KCP().Client(),
+					remoteNetwork,
+					WithConditions(KcpReadyCondition())).
+				Should(Succeed(), "failed to update status on KCP remote Network")
+		})
+
+		By("And Then KCP remote Network has OpenStackNetworkReference", func() {
+			Expect(remoteNetwork.Spec.Network.Reference.OpenStack.Project).To(Equal(remoteProject))
+			Expect(remoteNetwork.Spec.Network.Reference.OpenStack.NetworkId).To(Equal(remoteNetworkId))
+		})
+
+		vpcPeering := &cloudcontrolv1beta1.VpcPeering{}
+
+		By("And Then KCP VpcPeering is created", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.KCP().Client(),
+					vpcPeering,
+					NewObjActions(WithName(sapVpcPeering.Status.Id)),
+				).
+				Should(Succeed(), "failed to load KCP VpcPeering")
+		})
+
+		By("And Then KCP VpcPeering has RemoteNetwork object reference", func() {
+			Expect(vpcPeering.Spec.Details.RemoteNetwork.Name).To(Equal(sapVpcPeering.Status.Id))
+			Expect(vpcPeering.Spec.Details.RemoteNetwork.Namespace).To(Equal(DefaultKcpNamespace))
+		})
+
+		By("And Then KCP VpcPeering has LocalNetwork object reference", func() {
+			Expect(vpcPeering.Spec.Details.LocalNetwork.Name).To(Equal(common.KcpNetworkKymaCommonName(vpcPeering.Spec.Scope.Name)))
+			Expect(vpcPeering.Spec.Details.LocalNetwork.Namespace).To(Equal(DefaultKcpNamespace))
+		})
+
+		By("And Then KCP VpcPeering has OpenStack peering mode", func() {
+			Expect(vpcPeering.Spec.Details.OpenStackPeeringMode).To(Equal(cloudcontrolv1beta1.OpenStackVpcPeeringModeNetworkRbac))
+		})
+
+		By("And Then KCP VpcPeering has annotations", func() {
+			Expect(vpcPeering.Annotations[cloudcontrolv1beta1.LabelKymaName]).To(Equal(skrKymaRef.Name))
+			Expect(vpcPeering.Annotations[cloudcontrolv1beta1.LabelRemoteName]).To(Equal(sapVpcPeering.Name))
+			Expect(vpcPeering.Annotations[cloudcontrolv1beta1.LabelRemoteNamespace]).To(Equal(sapVpcPeering.Namespace))
+		})
+
+		By("When KCP VpcPeering is Ready", func() {
+			Eventually(UpdateStatus).
+				WithArguments(infra.Ctx(),
+					infra.KCP().Client(),
+					vpcPeering,
+					WithState(cloudcontrolv1beta1.VirtualNetworkPeeringStateConnected),
+					WithConditions(KcpReadyCondition())).
+				Should(Succeed(), "failed to update status on KCP VpcPeering")
+		})
+
+		By("Then SKR SapVpcPeering is Ready", func() {
+			Eventually(LoadAndCheck).
+				WithArguments(
+					infra.Ctx(),
+					infra.SKR().Client(),
+					sapVpcPeering,
+					NewObjActions(),
+					HavingConditionTrue(cloudcontrolv1beta1.ConditionTypeReady),
+					HavingState(cloudcontrolv1beta1.VirtualNetworkPeeringStateConnected)).
+				Should(Succeed(), "expect SKR SapVpcPeering to be Ready, but it didn't")
+		})
+
+		By("When SKR SapVpcPeering is deleted", func() {
+			Eventually(Delete).
+				WithArguments(infra.Ctx(), infra.SKR().Client(), sapVpcPeering).
+				Should(Succeed(), "failed to delete SKR SapVpcPeering")
+		})
+
+		By("Then KCP VpcPeering does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), vpcPeering, WithName(sapVpcPeering.Status.Id)).
+				Should(Succeed(), "failed to delete KCP VpcPeering")
+		})
+
+		By("And Then KCP remote Network does not exist", func() {
+			Eventually(IsDeleted).
+				WithArguments(infra.Ctx(), infra.KCP().Client(), remoteNetwork, WithName(sapVpcPeering.Status.Id)).
+				Should(Succeed(), "failed to delete KCP remote Network")
+		})
+
+	})
+
+})
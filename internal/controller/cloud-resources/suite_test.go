@@ -32,6 +32,7 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/quota"
+	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
 	"github.com/kyma-project/cloud-manager/pkg/testinfra"
 	clocktesting "k8s.io/utils/clock/testing"
 
@@ -116,6 +117,9 @@ var _ = BeforeSuite(func() {
 	// GcpNfsVolumeBackupDiscovery
 	Expect(SetupGcpNfsVolumeBackupDiscoveryReconciler(infra.Registry(), infra.GcpMock2().NfsBackupV2Provider())).
 		NotTo(HaveOccurred())
+	// AwsNfsVolumeBackupDiscovery
+	Expect(SetupAwsNfsVolumeBackupDiscoveryReconciler(infra.Registry(), awsnfsvolumebackupclient.NewMockClient())).
+		NotTo(HaveOccurred())
 	// GcpRedisInstance
 	Expect(SetupGcpRedisInstanceReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
@@ -125,6 +129,24 @@ var _ = BeforeSuite(func() {
 	// AwsRedisInstance
 	Expect(SetupAwsRedisInstanceReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
+	// AwsPostgresInstance
+	Expect(SetupAwsPostgresInstanceReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// GcpPostgresInstance
+	Expect(SetupGcpPostgresInstanceReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// AzurePostgresInstance
+	Expect(SetupAzurePostgresInstanceReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// AwsS3Bucket
+	Expect(SetupAwsS3BucketReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// GcpStorageBucket
+	Expect(SetupGcpStorageBucketReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// AzureBlobContainer
+	Expect(SetupAzureBlobContainerReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
 	// AwsRedisCluster
 	Expect(SetupAwsRedisClusterReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
@@ -134,6 +156,19 @@ var _ = BeforeSuite(func() {
 	// AzureRedisCluster
 	Expect(SetupAzureRedisClusterReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
+	// AwsRedisInstanceBackup
+	Expect(SetupAwsRedisInstanceBackupReconciler(infra.Registry(), infra.AwsMock().ElastiCacheProviderFake(), env)).
+		NotTo(HaveOccurred())
+	// GcpRedisInstanceBackup
+	Expect(SetupGcpRedisInstanceBackupReconciler(infra.Registry(), infra.GcpMock2().RedisBackupProvider(), env)).
+		NotTo(HaveOccurred())
+	// AzureRedisInstanceBackup
+	Expect(SetupAzureRedisInstanceBackupReconciler(infra.Registry(), infra.AzureMock().RedisBackupClientProvider(), env)).
+		NotTo(HaveOccurred())
+	// RedisBackupSchedule
+	Expect(SetupAwsRedisBackupScheduleReconciler(infra.Registry(), env)).NotTo(HaveOccurred())
+	Expect(SetupGcpRedisBackupScheduleReconciler(infra.Registry(), env)).NotTo(HaveOccurred())
+	Expect(SetupAzureRedisBackupScheduleReconciler(infra.Registry(), env)).NotTo(HaveOccurred())
 	// NfsBackupSchedule
 	Expect(SetupGcpNfsBackupScheduleReconciler(infra.Registry(), env, testFakeClock)).NotTo(HaveOccurred())
 
@@ -166,6 +201,13 @@ var _ = BeforeSuite(func() {
 	Expect(SetupSapNfsVolumeSnapshotScheduleReconciler(infra.Registry(), env, testFakeClock)).
 		NotTo(HaveOccurred())
 
+	// SapRedisInstance
+	Expect(SetupSapRedisInstanceReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+	// SapVpcPeering
+	Expect(SetupSapVpcPeeringReconciler(infra.Registry())).
+		NotTo(HaveOccurred())
+
 	// GcpSubnet
 	Expect(SetupGcpSubnetReconciler(infra.Registry())).
 		NotTo(HaveOccurred())
@@ -0,0 +1,98 @@
+package capability
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"maps"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed static.yaml
+var staticConfig []byte
+
+type ProviderCapabilities struct {
+	Default cloudcontrolv1beta1.RegionCapabilities            `json:"default,omitempty"`
+	Regions map[string]cloudcontrolv1beta1.RegionCapabilities `json:"regions,omitempty"`
+}
+
+// staticCatalog is the embedded fallback catalog, keyed by provider
+var staticCatalog = mustLoadStatic(staticConfig)
+
+func mustLoadStatic(b []byte) map[string]ProviderCapabilities {
+	result := map[string]ProviderCapabilities{}
+	if err := yaml.UnmarshalStrict(b, &result); err != nil {
+		panic(fmt.Errorf("error loading static capability catalog: %w", err))
+	}
+	return result
+}
+
+// Static returns the capabilities of the region from the embedded catalog
+func Static(provider, region string) cloudcontrolv1beta1.RegionCapabilities {
+	p := staticCatalog[provider]
+	result := Merge(p.Default, nil)
+	if regional, ok := p.Regions[region]; ok {
+		result = Merge(result, &regional)
+	}
+	return result
+}
+
+// Merge returns the base capabilities overridden by the non-empty fields of the override.
+// Service availability is merged per service.
+func Merge(base cloudcontrolv1beta1.RegionCapabilities, override *cloudcontrolv1beta1.RegionCapabilities) cloudcontrolv1beta1.RegionCapabilities {
+	result := *base.DeepCopy()
+	if override == nil {
+		return result
+	}
+	if len(override.Services) > 0 {
+		if result.Services == nil {
+			result.Services = map[string]bool{}
+		}
+		maps.Copy(result.Services, override.Services)
+	}
+	if len(override.RedisTiers) > 0 {
+		result.RedisTiers = append([]string(nil), override.RedisTiers...)
+	}
+	if len(override.RedisVersions) > 0 {
+		result.RedisVersions = append([]string(nil), override.RedisVersions...)
+	}
+	if len(override.NfsTiers) > 0 {
+		result.NfsTiers = append([]string(nil), override.NfsTiers...)
+	}
+	if override.ReadTime != nil {
+		result.ReadTime = override.ReadTime.DeepCopy()
+	}
+	return result
+}
+
+// ForScope returns the capabilities of the Scope region, with the capabilities discovered
+// from the provider API merged over the static catalog
+func ForScope(scope *cloudcontrolv1beta1.Scope) *Region {
+	return &Region{
+		Name:               scope.Spec.Region,
+		RegionCapabilities: Merge(Static(string(scope.Spec.Provider), scope.Spec.Region), scope.Status.Capabilities),
+	}
+}
+
+// LoadForKyma loads the Scope of the given Kyma and returns its region capabilities,
+// or nil if the Scope does not exist
+func LoadForKyma(ctx context.Context, kcpClient client.Reader, kymaRef klog.ObjectRef) (*Region, error) {
+	scope := &cloudcontrolv1beta1.Scope{}
+	err := kcpClient.Get(ctx, types.NamespacedName{
+		Namespace: kymaRef.Namespace,
+		Name:      kymaRef.Name,
+	}, scope)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ForScope(scope), nil
+}
@@ -0,0 +1,167 @@
+package capability
+
+import (
+	"context"
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestStatic(t *testing.T) {
+	t.Run("all providers are loaded", func(t *testing.T) {
+		for _, provider := range []cloudcontrolv1beta1.ProviderType{
+			cloudcontrolv1beta1.ProviderAws,
+			cloudcontrolv1beta1.ProviderAzure,
+			cloudcontrolv1beta1.ProviderGCP,
+			cloudcontrolv1beta1.ProviderOpenStack,
+		} {
+			assert.Contains(t, staticCatalog, string(provider))
+		}
+	})
+
+	t.Run("unknown region gets provider default", func(t *testing.T) {
+		c := Static("gcp", "unknown-region")
+		assert.Contains(t, c.RedisTiers, "P6")
+		assert.NotContains(t, c.RedisTiers, "P7")
+		assert.Equal(t, []string{"BASIC_HDD", "BASIC_SSD", "ZONAL", "REGIONAL"}, c.NfsTiers)
+	})
+
+	t.Run("default tiers include all tiers of the SKR api", func(t *testing.T) {
+		assert.Len(t, Static("aws", "any").RedisTiers, 14)
+		assert.Len(t, Static("azure", "any").RedisTiers, 10)
+		assert.Len(t, Static("gcp", "any").RedisTiers, 14)
+		assert.Len(t, Static("openstack", "any").RedisTiers, 4)
+	})
+
+	t.Run("openstack provides redis but not redis cluster", func(t *testing.T) {
+		r := &Region{Name: "eu-de-1", RegionCapabilities: Static("openstack", "eu-de-1")}
+		assert.NoError(t, r.CheckService(cloudcontrolv1beta1.CapabilityServiceRedis))
+		assert.EqualError(t, r.CheckService(cloudcontrolv1beta1.CapabilityServiceRedisCluster), "service redisCluster is not available in region eu-de-1")
+		assert.NoError(t, r.CheckRedisTier("S4"))
+		assert.Error(t, r.CheckRedisTier("P1"))
+	})
+
+	t.Run("unknown provider is not restricted", func(t *testing.T) {
+		c := Static("unknown", "region")
+		assert.Empty(t, c.RedisTiers)
+		assert.Empty(t, c.Services)
+	})
+
+	t.Run("region overrides provider default", func(t *testing.T) {
+		staticCatalog["test"] = ProviderCapabilities{
+			Default: cloudcontrolv1beta1.RegionCapabilities{
+				RedisTiers: []string{"S1", "S2"},
+				NfsTiers:   []string{"BASIC_HDD"},
+			},
+			Regions: map[string]cloudcontrolv1beta1.RegionCapabilities{
+				"small": {RedisTiers: []string{"S1"}},
+			},
+		}
+		defer delete(staticCatalog, "test")
+
+		c := Static("test", "small")
+		assert.Equal(t, []string{"S1"}, c.RedisTiers)
+		assert.Equal(t, []string{"BASIC_HDD"}, c.NfsTiers)
+	})
+}
+
+func TestMerge(t *testing.T) {
+	base := cloudcontrolv1beta1.RegionCapabilities{
+		Services:      map[string]bool{"redis": false},
+		RedisTiers:    []string{"S1", "S2"},
+		RedisVersions: []string{"7.1", "7.0"},
+	}
+
+	t.Run("nil override returns copy of base", func(t *testing.T) {
+		result := Merge(base, nil)
+		assert.Equal(t, base, result)
+		result.Services["nfs"] = false
+		assert.NotContains(t, base.Services, "nfs")
+	})
+
+	t.Run("non-empty fields are overridden", func(t *testing.T) {
+		now := metav1.Now()
+		result := Merge(base, &cloudcontrolv1beta1.RegionCapabilities{
+			Services:      map[string]bool{"redis": true, "nfs": false},
+			RedisVersions: []string{"7.1"},
+			ReadTime:      &now,
+		})
+		assert.Equal(t, map[string]bool{"redis": true, "nfs": false}, result.Services)
+		assert.Equal(t, []string{"S1", "S2"}, result.RedisTiers)
+		assert.Equal(t, []string{"7.1"}, result.RedisVersions)
+		assert.NotNil(t, result.ReadTime)
+	})
+}
+
+func TestRegion(t *testing.T) {
+	r := &Region{
+		Name: "eu-west-1",
+		RegionCapabilities: cloudcontrolv1beta1.RegionCapabilities{
+			Services:      map[string]bool{"redisCluster": false, "nfs": true},
+			RedisTiers:    []string{"S1", "P1"},
+			RedisVersions: []string{"7.1"},
+		},
+	}
+
+	t.Run("services", func(t *testing.T) {
+		assert.NoError(t, r.CheckService("nfs"))
+		assert.NoError(t, r.CheckService("redis"))
+		err := r.CheckService("redisCluster")
+		assert.EqualError(t, err, "service redisCluster is not available in region eu-west-1")
+	})
+
+	t.Run("values", func(t *testing.T) {
+		assert.NoError(t, r.CheckRedisTier("P1"))
+		assert.EqualError(t, r.CheckRedisTier("P2"), "redis tier P2 is not available in region eu-west-1, available: S1, P1")
+		assert.NoError(t, r.CheckRedisVersion(""))
+		assert.Error(t, r.CheckRedisVersion("6.x"))
+		assert.NoError(t, r.CheckNfsTier("anything"), "empty list is not restricted")
+	})
+
+	t.Run("config map data", func(t *testing.T) {
+		assert.Equal(t, map[string]string{
+			"cloud.capabilities.unavailableServices": "redisCluster",
+			"cloud.capabilities.redisTiers":          "S1, P1",
+			"cloud.capabilities.redisVersions":       "7.1",
+			"cloud.capabilities.nfsTiers":            "",
+		}, r.ConfigMapData())
+	})
+}
+
+func TestLoadForKyma(t *testing.T) {
+	ctx := context.Background()
+	scope := &cloudcontrolv1beta1.Scope{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kcp-system", Name: "kyma"},
+		Spec: cloudcontrolv1beta1.ScopeSpec{
+			Provider: cloudcontrolv1beta1.ProviderAws,
+			Region:   "eu-west-1",
+		},
+		Status: cloudcontrolv1beta1.ScopeStatus{
+			Capabilities: &cloudcontrolv1beta1.RegionCapabilities{
+				RedisVersions: []string{"7.1"},
+			},
+		},
+	}
+	clnt := fake.NewClientBuilder().WithScheme(commonscheme.KcpScheme).WithObjects(scope).Build()
+
+	t.Run("discovered capabilities override static", func(t *testing.T) {
+		r, err := LoadForKyma(ctx, clnt, klog.KObj(scope))
+		require.NoError(t, err)
+		require.NotNil(t, r)
+		assert.Equal(t, "eu-west-1", r.Name)
+		assert.Equal(t, []string{"7.1"}, r.RedisVersions)
+		assert.Equal(t, Static("aws", "eu-west-1").RedisTiers, r.RedisTiers)
+	})
+
+	t.Run("missing scope returns nil", func(t *testing.T) {
+		r, err := LoadForKyma(ctx, clnt, klog.ObjectRef{Namespace: "kcp-system", Name: "other"})
+		assert.NoError(t, err)
+		assert.Nil(t, r)
+	})
+}
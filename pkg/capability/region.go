@@ -0,0 +1,83 @@
+package capability
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+)
+
+// UnavailableError is returned when a spec value is not available in the region
+type UnavailableError struct {
+	What      string
+	Value     string
+	Region    string
+	Available []string
+}
+
+func (e *UnavailableError) Error() string {
+	if len(e.Available) == 0 {
+		return fmt.Sprintf("%s %s is not available in region %s", e.What, e.Value, e.Region)
+	}
+	return fmt.Sprintf("%s %s is not available in region %s, available: %s", e.What, e.Value, e.Region, strings.Join(e.Available, ", "))
+}
+
+// Region is the resolved capabilities of one provider region
+type Region struct {
+	Name string
+	cloudcontrolv1beta1.RegionCapabilities
+}
+
+// ServiceAvailable returns false only if the service is explicitly marked as not available
+func (r *Region) ServiceAvailable(service string) bool {
+	available, ok := r.Services[service]
+	return !ok || available
+}
+
+func (r *Region) CheckService(service string) error {
+	if r.ServiceAvailable(service) {
+		return nil
+	}
+	return &UnavailableError{What: "service", Value: service, Region: r.Name}
+}
+
+func (r *Region) CheckRedisTier(tier string) error {
+	return r.checkValue("redis tier", tier, r.RedisTiers)
+}
+
+// CheckRedisVersion checks the redis version, empty version is valid since it is defaulted by the provider
+func (r *Region) CheckRedisVersion(version string) error {
+	return r.checkValue("redis version", version, r.RedisVersions)
+}
+
+func (r *Region) CheckNfsTier(tier string) error {
+	return r.checkValue("nfs tier", tier, r.NfsTiers)
+}
+
+func (r *Region) checkValue(what, value string, allowed []string) error {
+	if len(value) == 0 || len(allowed) == 0 || slices.Contains(allowed, value) {
+		return nil
+	}
+	return &UnavailableError{What: what, Value: value, Region: r.Name, Available: allowed}
+}
+
+// ConfigMapData returns the capabilities in the form published to the SKR kyma-info ConfigMap
+func (r *Region) ConfigMapData() map[string]string {
+	// services not listed are available, so only unavailable ones are published
+	var unavailable []string
+	for service, available := range r.Services {
+		if !available {
+			unavailable = append(unavailable, service)
+		}
+	}
+	sort.Strings(unavailable)
+
+	return map[string]string{
+		"cloud.capabilities.unavailableServices": strings.Join(unavailable, ", "),
+		"cloud.capabilities.redisTiers":          strings.Join(r.RedisTiers, ", "),
+		"cloud.capabilities.redisVersions":       strings.Join(r.RedisVersions, ", "),
+		"cloud.capabilities.nfsTiers":            strings.Join(r.NfsTiers, ", "),
+	}
+}
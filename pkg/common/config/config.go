@@ -2,7 +2,9 @@ package config
 
 import (
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/config"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
 	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
 	gcpconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
@@ -38,6 +40,8 @@ func LoadConfigInstance(cfg config.Config) {
 	gcpconfig.InitConfig(cfg)
 	vpcpeeringconfig.InitConfig(cfg)
 	vpcnetworkconfig.InitConfig(cfg)
+	drift.InitConfig(cfg)
+	pricing.InitConfig(cfg)
 
 	cfg.Read()
 }
@@ -78,6 +78,8 @@ func TestObjectGroupVersionInfo(t *testing.T) {
 		{"typed AwsNfsVolumeBackup", &cloudresourcesv1beta1.AwsNfsVolumeBackup{}, schema.GroupKind{Group: g, Kind: "AwsNfsVolumeBackup"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"typed AwsVpcPeering", &cloudresourcesv1beta1.AwsVpcPeering{}, schema.GroupKind{Group: g, Kind: "AwsVpcPeering"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"typed AzureVpcPeering", &cloudresourcesv1beta1.AzureVpcPeering{}, schema.GroupKind{Group: g, Kind: "AzureVpcPeering"}, schema.GroupKind{}, schema.GroupKind{}},
+		{"typed SapVpcPeering", &cloudresourcesv1beta1.SapVpcPeering{}, schema.GroupKind{Group: g, Kind: "SapVpcPeering"}, schema.GroupKind{}, schema.GroupKind{}},
+		{"typed SapRedisInstance", &cloudresourcesv1beta1.SapRedisInstance{}, schema.GroupKind{Group: g, Kind: "SapRedisInstance"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"typed CloudResources", &cloudresourcesv1beta1.CloudResources{}, schema.GroupKind{Group: g, Kind: "CloudResources"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"typed GcpNfsVolumeBackup", &cloudresourcesv1beta1.GcpNfsVolumeBackup{}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeBackup"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"typed GcpNfsVolumeRestore", &cloudresourcesv1beta1.GcpNfsVolumeRestore{}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeRestore"}, schema.GroupKind{}, schema.GroupKind{}},
@@ -90,6 +92,8 @@ func TestObjectGroupVersionInfo(t *testing.T) {
 		{"unstructured AwsNfsVolumeBackup", NewUnstructuredWithGVK(g, v, "AwsNfsVolumeBackup"), schema.GroupKind{Group: g, Kind: "AwsNfsVolumeBackup"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"unstructured AwsVpcPeering", NewUnstructuredWithGVK(g, v, "AwsVpcPeering"), schema.GroupKind{Group: g, Kind: "AwsVpcPeering"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"unstructured AzureVpcPeering", NewUnstructuredWithGVK(g, v, "AzureVpcPeering"), schema.GroupKind{Group: g, Kind: "AzureVpcPeering"}, schema.GroupKind{}, schema.GroupKind{}},
+		{"unstructured SapVpcPeering", NewUnstructuredWithGVK(g, v, "SapVpcPeering"), schema.GroupKind{Group: g, Kind: "SapVpcPeering"}, schema.GroupKind{}, schema.GroupKind{}},
+		{"unstructured SapRedisInstance", NewUnstructuredWithGVK(g, v, "SapRedisInstance"), schema.GroupKind{Group: g, Kind: "SapRedisInstance"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"unstructured CloudResources", NewUnstructuredWithGVK(g, v, "CloudResources"), schema.GroupKind{Group: g, Kind: "CloudResources"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"unstructured GcpNfsVolumeBackup", NewUnstructuredWithGVK(g, v, "GcpNfsVolumeBackup"), schema.GroupKind{Group: g, Kind: "GcpNfsVolumeBackup"}, schema.GroupKind{}, schema.GroupKind{}},
 		{"unstructured GcpNfsVolumeRestore", NewUnstructuredWithGVK(g, v, "GcpNfsVolumeRestore"), schema.GroupKind{Group: g, Kind: "GcpNfsVolumeRestore"}, schema.GroupKind{}, schema.GroupKind{}},
@@ -102,6 +106,8 @@ func TestObjectGroupVersionInfo(t *testing.T) {
 		{"crdTyped AwsNfsVolumeBackup", NewCrdTypedV1WithKindGroup(t, "AwsNfsVolumeBackup", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "AwsNfsVolumeBackup"}, schema.GroupKind{}},
 		{"crdTyped AwsVpcPeering", NewCrdTypedV1WithKindGroup(t, "AwsVpcPeering", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "AwsVpcPeering"}, schema.GroupKind{}},
 		{"crdTyped AzureVpcPeering", NewCrdTypedV1WithKindGroup(t, "AzureVpcPeering", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "AzureVpcPeering"}, schema.GroupKind{}},
+		{"crdTyped SapVpcPeering", NewCrdTypedV1WithKindGroup(t, "SapVpcPeering", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "SapVpcPeering"}, schema.GroupKind{}},
+		{"crdTyped SapRedisInstance", NewCrdTypedV1WithKindGroup(t, "SapRedisInstance", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "SapRedisInstance"}, schema.GroupKind{}},
 		{"crdTyped CloudResources", NewCrdTypedV1WithKindGroup(t, "CloudResources", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "CloudResources"}, schema.GroupKind{}},
 		{"crdTyped GcpNfsVolumeBackup", NewCrdTypedV1WithKindGroup(t, "GcpNfsVolumeBackup", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeBackup"}, schema.GroupKind{}},
 		{"crdTyped GcpNfsVolumeRestore", NewCrdTypedV1WithKindGroup(t, "GcpNfsVolumeRestore", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeRestore"}, schema.GroupKind{}},
@@ -114,6 +120,8 @@ func TestObjectGroupVersionInfo(t *testing.T) {
 		{"crdUnstructured AwsNfsVolumeBackup", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "AwsNfsVolumeBackup", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "AwsNfsVolumeBackup"}, schema.GroupKind{}},
 		{"crdUnstructured AwsVpcPeering", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "AwsVpcPeering", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "AwsVpcPeering"}, schema.GroupKind{}},
 		{"crdUnstructured AzureVpcPeering", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "AzureVpcPeering", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "AzureVpcPeering"}, schema.GroupKind{}},
+		{"crdUnstructured SapVpcPeering", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "SapVpcPeering", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "SapVpcPeering"}, schema.GroupKind{}},
+		{"crdUnstructured SapRedisInstance", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "SapRedisInstance", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "SapRedisInstance"}, schema.GroupKind{}},
 		{"crdUnstructured CloudResources", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "CloudResources", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "CloudResources"}, schema.GroupKind{}},
 		{"crdUnstructured GcpNfsVolumeBackup", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "GcpNfsVolumeBackup", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeBackup"}, schema.GroupKind{}},
 		{"crdUnstructured GcpNfsVolumeRestore", NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "GcpNfsVolumeRestore", g), schema.GroupKind{Group: gCrd, Kind: kCrd}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeRestore"}, schema.GroupKind{}},
@@ -126,6 +134,8 @@ func TestObjectGroupVersionInfo(t *testing.T) {
 		{"busolaTyped AwsNfsVolumeBackup", NewBusolaCmTypedKindGroup(t, "AwsNfsVolumeBackup"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "AwsNfsVolumeBackup"}},
 		{"busolaTyped AwsVpcPeering", NewBusolaCmTypedKindGroup(t, "AwsVpcPeering"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "AwsVpcPeering"}},
 		{"busolaTyped AzureVpcPeering", NewBusolaCmTypedKindGroup(t, "AzureVpcPeering"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "AzureVpcPeering"}},
+		{"busolaTyped SapVpcPeering", NewBusolaCmTypedKindGroup(t, "SapVpcPeering"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "SapVpcPeering"}},
+		{"busolaTyped SapRedisInstance", NewBusolaCmTypedKindGroup(t, "SapRedisInstance"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "SapRedisInstance"}},
 		{"busolaTyped CloudResources", NewBusolaCmTypedKindGroup(t, "CloudResources"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "CloudResources"}},
 		{"busolaTyped GcpNfsVolumeBackup", NewBusolaCmTypedKindGroup(t, "GcpNfsVolumeBackup"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeBackup"}},
 		{"busolaTyped GcpNfsVolumeRestore", NewBusolaCmTypedKindGroup(t, "GcpNfsVolumeRestore"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeRestore"}},
@@ -138,6 +148,8 @@ func TestObjectGroupVersionInfo(t *testing.T) {
 		{"busolaUnstructured AwsNfsVolumeBackup", NewBusolaCmUnstructuredKindGroup(t, "AwsNfsVolumeBackup"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "AwsNfsVolumeBackup"}},
 		{"busolaUnstructured AwsVpcPeering", NewBusolaCmUnstructuredKindGroup(t, "AwsVpcPeering"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "AwsVpcPeering"}},
 		{"busolaUnstructured AzureVpcPeering", NewBusolaCmUnstructuredKindGroup(t, "AzureVpcPeering"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "AzureVpcPeering"}},
+		{"busolaUnstructured SapVpcPeering", NewBusolaCmUnstructuredKindGroup(t, "SapVpcPeering"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "SapVpcPeering"}},
+		{"busolaUnstructured SapRedisInstance", NewBusolaCmUnstructuredKindGroup(t, "SapRedisInstance"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "SapRedisInstance"}},
 		{"busolaUnstructured CloudResources", NewBusolaCmUnstructuredKindGroup(t, "CloudResources"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "CloudResources"}},
 		{"busolaUnstructured GcpNfsVolumeBackup", NewBusolaCmUnstructuredKindGroup(t, "GcpNfsVolumeBackup"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeBackup"}},
 		{"busolaUnstructured GcpNfsVolumeRestore", NewBusolaCmUnstructuredKindGroup(t, "GcpNfsVolumeRestore"), schema.GroupKind{Group: gCm, Kind: kCm}, schema.GroupKind{}, schema.GroupKind{Group: g, Kind: "GcpNfsVolumeRestore"}},
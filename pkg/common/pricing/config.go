@@ -0,0 +1,126 @@
+package pricing
+
+import (
+	"github.com/kyma-project/cloud-manager/pkg/config"
+)
+
+// Prices are the list prices of the cloud resources in one provider region. Unset prices
+// are taken from the provider default, and resources without a price are not estimated.
+type Prices struct {
+	// RedisHourly is the hourly price of a Redis instance unit per tier. The tier is the
+	// cache node type on AWS, the SKU family and capacity on Azure, e.g. P1, and the
+	// tier on GCP, where the price is per GB of memory.
+	RedisHourly map[string]float64 `mapstructure:"redisHourly,omitempty"`
+
+	// NfsGbMonthly is the monthly price of one GB of NFS capacity per tier. The tier is the
+	// throughput mode on AWS, the Filestore tier on GCP and "default" on other providers.
+	NfsGbMonthly map[string]float64 `mapstructure:"nfsGbMonthly,omitempty"`
+
+	// BackupGbMonthly is the monthly price of one GB of NFS backup storage, reported only in the metric
+	BackupGbMonthly *float64 `mapstructure:"backupGbMonthly,omitempty"`
+
+	// VpcPeeringHourly is the hourly price of one VPC peering connection
+	VpcPeeringHourly *float64 `mapstructure:"vpcPeeringHourly,omitempty"`
+
+	// NatGatewayHourly is the hourly price of one NAT gateway
+	NatGatewayHourly *float64 `mapstructure:"natGatewayHourly,omitempty"`
+}
+
+type ProviderPrices struct {
+	Default Prices            `mapstructure:"default,omitempty"`
+	Regions map[string]Prices `mapstructure:"regions,omitempty"`
+}
+
+// Catalog gives the list prices per provider and region
+type Catalog interface {
+	Currency() string
+	RedisHourly(provider, region, tier string) (float64, bool)
+	NfsGbMonthly(provider, region, tier string) (float64, bool)
+	BackupGbMonthly(provider, region string) (float64, bool)
+	VpcPeeringHourly(provider, region string) (float64, bool)
+	NatGatewayHourly(provider, region string) (float64, bool)
+}
+
+// ConfigStruct is the file based Catalog loaded from pricing.yaml
+type ConfigStruct struct {
+	Enabled      bool                      `mapstructure:"enabled,omitempty"`
+	CurrencyCode string                    `mapstructure:"currency,omitempty"`
+	Providers    map[string]ProviderPrices `mapstructure:"providers,omitempty"`
+}
+
+var Config = &ConfigStruct{}
+
+// DefaultCatalog is the Catalog used by the estimation actions, it can be replaced with
+// another implementation, for example one backed by a provider pricing API
+var DefaultCatalog Catalog = Config
+
+var _ Catalog = &ConfigStruct{}
+
+func (c *ConfigStruct) Currency() string {
+	return c.CurrencyCode
+}
+
+func (c *ConfigStruct) prices(provider, region string) (Prices, Prices) {
+	p := c.Providers[provider]
+	return p.Regions[region], p.Default
+}
+
+func (c *ConfigStruct) RedisHourly(provider, region, tier string) (float64, bool) {
+	regional, def := c.prices(provider, region)
+	return mapPrice(tier, regional, def, func(p Prices) map[string]float64 { return p.RedisHourly })
+}
+
+func (c *ConfigStruct) NfsGbMonthly(provider, region, tier string) (float64, bool) {
+	regional, def := c.prices(provider, region)
+	return mapPrice(tier, regional, def, func(p Prices) map[string]float64 { return p.NfsGbMonthly })
+}
+
+func (c *ConfigStruct) BackupGbMonthly(provider, region string) (float64, bool) {
+	regional, def := c.prices(provider, region)
+	return scalarPrice(regional, def, func(p Prices) *float64 { return p.BackupGbMonthly })
+}
+
+func (c *ConfigStruct) VpcPeeringHourly(provider, region string) (float64, bool) {
+	regional, def := c.prices(provider, region)
+	return scalarPrice(regional, def, func(p Prices) *float64 { return p.VpcPeeringHourly })
+}
+
+func (c *ConfigStruct) NatGatewayHourly(provider, region string) (float64, bool) {
+	regional, def := c.prices(provider, region)
+	return scalarPrice(regional, def, func(p Prices) *float64 { return p.NatGatewayHourly })
+}
+
+func mapPrice(key string, regional, def Prices, field func(Prices) map[string]float64) (float64, bool) {
+	if v, ok := field(regional)[key]; ok {
+		return v, true
+	}
+	v, ok := field(def)[key]
+	return v, ok
+}
+
+func scalarPrice(regional, def Prices, field func(Prices) *float64) (float64, bool) {
+	if v := field(regional); v != nil {
+		return *v, true
+	}
+	if v := field(def); v != nil {
+		return *v, true
+	}
+	return 0, false
+}
+
+func InitConfig(cfg config.Config) {
+	cfg.Path(
+		"pricing",
+		config.Bind(Config),
+		config.SourceFile("pricing.yaml"),
+		config.Path(
+			"enabled",
+			config.DefaultScalar(false),
+			config.SourceEnv("PRICING_ENABLED"),
+		),
+		config.Path(
+			"currency",
+			config.DefaultScalar("USD"),
+		),
+	)
+}
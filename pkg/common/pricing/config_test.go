@@ -0,0 +1,85 @@
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	pkgconfig "github.com/kyma-project/cloud-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigDefaults(t *testing.T) {
+	env := abstractions.NewMockedEnvironment(map[string]string{})
+	cfg := pkgconfig.NewConfig(env)
+	InitConfig(cfg)
+	cfg.Read()
+
+	assert.False(t, Config.Enabled)
+	assert.Equal(t, "USD", Config.Currency())
+
+	_, ok := Config.RedisHourly("gcp", "europe-west1", "BASIC")
+	assert.False(t, ok)
+}
+
+func TestConfigFromFile(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cloud-manager-config")
+	assert.NoError(t, err, "error creating tmp dir")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	err = os.WriteFile(filepath.Join(dir, "pricing.yaml"), []byte(`
+enabled: true
+currency: EUR
+providers:
+  gcp:
+    default:
+      redisHourly:
+        BASIC: 0.05
+        STANDARD_HA: 0.06
+      backupGbMonthly: 0.08
+      natGatewayHourly: 0.04
+    regions:
+      europe-west3:
+        redisHourly:
+          BASIC: 0.07
+        natGatewayHourly: 0
+`), 0644)
+	assert.NoError(t, err, "error creating config file")
+
+	env := abstractions.NewMockedEnvironment(map[string]string{})
+	cfg := pkgconfig.NewConfig(env)
+	cfg.BaseDir(dir)
+	InitConfig(cfg)
+	cfg.Read()
+
+	assert.True(t, Config.Enabled)
+	assert.Equal(t, "EUR", Config.Currency())
+
+	price, ok := Config.RedisHourly("gcp", "europe-west1", "BASIC")
+	assert.True(t, ok)
+	assert.Equal(t, 0.05, price)
+
+	price, ok = Config.RedisHourly("gcp", "europe-west3", "BASIC")
+	assert.True(t, ok)
+	assert.Equal(t, 0.07, price, "regional price overrides the default")
+
+	price, ok = Config.RedisHourly("gcp", "europe-west3", "STANDARD_HA")
+	assert.True(t, ok)
+	assert.Equal(t, 0.06, price, "default is used for tiers without regional price")
+
+	price, ok = Config.NatGatewayHourly("gcp", "europe-west3")
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, price, "zero regional price overrides the default")
+
+	price, ok = Config.BackupGbMonthly("gcp", "europe-west3")
+	assert.True(t, ok)
+	assert.Equal(t, 0.08, price)
+
+	_, ok = Config.VpcPeeringHourly("gcp", "europe-west3")
+	assert.False(t, ok)
+
+	_, ok = Config.RedisHourly("aws", "eu-central-1", "cache.t3.micro")
+	assert.False(t, ok)
+}
@@ -0,0 +1,85 @@
+package pricing
+
+import (
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// HoursPerMonth is the average number of hours in a month used to turn hourly prices into monthly ones
+const HoursPerMonth = 730
+
+const gb = 1 << 30
+
+// EstimateRedisInstance estimates the monthly cost of the RedisInstance from its spec
+func EstimateRedisInstance(c Catalog, scope *cloudcontrolv1beta1.Scope, obj *cloudcontrolv1beta1.RedisInstance) (float64, bool) {
+	provider, region := string(scope.Spec.Provider), scope.Spec.Region
+	switch {
+	case obj.Spec.Instance.Gcp != nil:
+		price, ok := c.RedisHourly(provider, region, obj.Spec.Instance.Gcp.Tier)
+		return price * float64(obj.Spec.Instance.Gcp.MemorySizeGb) * HoursPerMonth, ok
+	case obj.Spec.Instance.Aws != nil:
+		price, ok := c.RedisHourly(provider, region, obj.Spec.Instance.Aws.CacheNodeType)
+		nodes := 1 + obj.Spec.Instance.Aws.ReadReplicas
+		return price * float64(nodes) * HoursPerMonth, ok
+	case obj.Spec.Instance.Azure != nil:
+		sku := obj.Spec.Instance.Azure.SKU
+		family := sku.Family
+		if family == "" {
+			family = "P"
+		}
+		price, ok := c.RedisHourly(provider, region, fmt.Sprintf("%s%d", family, sku.Capacity))
+		shards := max(obj.Spec.Instance.Azure.ShardCount, 1)
+		return price * float64(shards) * HoursPerMonth, ok
+	case obj.Spec.Instance.OpenStack != nil:
+		price, ok := c.RedisHourly(provider, region, obj.Spec.Instance.OpenStack.FlavorName)
+		return price * HoursPerMonth, ok
+	}
+	return 0, false
+}
+
+// EstimateNfsInstance estimates the monthly cost of the NfsInstance from its capacity and tier.
+// Providers with elastic file systems have no capacity in the spec, for them the provisioned
+// capacity from the status is used.
+func EstimateNfsInstance(c Catalog, scope *cloudcontrolv1beta1.Scope, obj *cloudcontrolv1beta1.NfsInstance) (float64, bool) {
+	provider, region := string(scope.Spec.Provider), scope.Spec.Region
+	switch {
+	case obj.Spec.Instance.Gcp != nil:
+		price, ok := c.NfsGbMonthly(provider, region, string(obj.Spec.Instance.Gcp.Tier))
+		return price * float64(obj.Spec.Instance.Gcp.CapacityGb), ok
+	case obj.Spec.Instance.OpenStack != nil:
+		price, ok := c.NfsGbMonthly(provider, region, "default")
+		return price * float64(obj.Spec.Instance.OpenStack.SizeGb), ok
+	case obj.Spec.Instance.Aws != nil:
+		price, ok := c.NfsGbMonthly(provider, region, string(obj.Spec.Instance.Aws.Throughput))
+		return price * gigabytes(obj.Status.Capacity), ok
+	case obj.Spec.Instance.Azure != nil:
+		price, ok := c.NfsGbMonthly(provider, region, "default")
+		return price * gigabytes(obj.Status.Capacity), ok
+	}
+	return 0, false
+}
+
+// EstimateVpcPeering estimates the monthly cost of the VpcPeering connection
+func EstimateVpcPeering(c Catalog, scope *cloudcontrolv1beta1.Scope, _ *cloudcontrolv1beta1.VpcPeering) (float64, bool) {
+	price, ok := c.VpcPeeringHourly(string(scope.Spec.Provider), scope.Spec.Region)
+	return price * HoursPerMonth, ok
+}
+
+// EstimateNatGateways estimates the monthly cost of the NAT gateways of the Scope, one per
+// NAT gateway IP in the exposed data
+func EstimateNatGateways(c Catalog, scope *cloudcontrolv1beta1.Scope) (float64, bool) {
+	price, ok := c.NatGatewayHourly(string(scope.Spec.Provider), scope.Spec.Region)
+	return price * float64(len(scope.Status.ExposedData.NatGatewayIps)) * HoursPerMonth, ok
+}
+
+// EstimateBackup estimates the monthly cost of the NFS backup of the given capacity
+func EstimateBackup(c Catalog, scope *cloudcontrolv1beta1.Scope, capacity resource.Quantity) (float64, bool) {
+	price, ok := c.BackupGbMonthly(string(scope.Spec.Provider), scope.Spec.Region)
+	return price * gigabytes(capacity), ok
+}
+
+func gigabytes(q resource.Quantity) float64 {
+	return q.AsApproximateFloat64() / gb
+}
@@ -0,0 +1,162 @@
+package pricing
+
+import (
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newTestCatalog() *ConfigStruct {
+	return &ConfigStruct{
+		Enabled:      true,
+		CurrencyCode: "USD",
+		Providers: map[string]ProviderPrices{
+			"gcp": {
+				Default: Prices{
+					RedisHourly:      map[string]float64{"BASIC": 0.05},
+					NfsGbMonthly:     map[string]float64{"BASIC_HDD": 0.2},
+					BackupGbMonthly:  new(0.1),
+					VpcPeeringHourly: new(0.01),
+					NatGatewayHourly: new(0.04),
+				},
+			},
+			"aws": {
+				Default: Prices{
+					RedisHourly:  map[string]float64{"cache.t3.micro": 0.02},
+					NfsGbMonthly: map[string]float64{"bursting": 0.3},
+				},
+			},
+			"azure": {
+				Default: Prices{
+					RedisHourly: map[string]float64{"P2": 1.0},
+				},
+			},
+			"openstack": {
+				Default: Prices{
+					RedisHourly: map[string]float64{"redis_c2_m4": 0.1},
+				},
+			},
+		},
+	}
+}
+
+func newTestScope(provider cloudcontrolv1beta1.ProviderType, region string) *cloudcontrolv1beta1.Scope {
+	return &cloudcontrolv1beta1.Scope{
+		Spec: cloudcontrolv1beta1.ScopeSpec{
+			Provider: provider,
+			Region:   region,
+		},
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	c := newTestCatalog()
+
+	t.Run("gcp RedisInstance is priced per GB of memory", func(t *testing.T) {
+		amount, ok := EstimateRedisInstance(c, newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1"), &cloudcontrolv1beta1.RedisInstance{
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					Gcp: &cloudcontrolv1beta1.RedisInstanceGcp{Tier: "BASIC", MemorySizeGb: 5},
+				},
+			},
+		})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.05*5*HoursPerMonth, amount, 0.001)
+	})
+
+	t.Run("aws RedisInstance is priced per node including read replicas", func(t *testing.T) {
+		amount, ok := EstimateRedisInstance(c, newTestScope(cloudcontrolv1beta1.ProviderAws, "eu-central-1"), &cloudcontrolv1beta1.RedisInstance{
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					Aws: &cloudcontrolv1beta1.RedisInstanceAws{CacheNodeType: "cache.t3.micro", ReadReplicas: 1},
+				},
+			},
+		})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.02*2*HoursPerMonth, amount, 0.001)
+	})
+
+	t.Run("azure RedisInstance is priced per SKU with default family", func(t *testing.T) {
+		amount, ok := EstimateRedisInstance(c, newTestScope(cloudcontrolv1beta1.ProviderAzure, "westeurope"), &cloudcontrolv1beta1.RedisInstance{
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					Azure: &cloudcontrolv1beta1.RedisInstanceAzure{SKU: cloudcontrolv1beta1.AzureRedisSKU{Capacity: 2}},
+				},
+			},
+		})
+		assert.True(t, ok)
+		assert.InDelta(t, 1.0*HoursPerMonth, amount, 0.001)
+	})
+
+	t.Run("openstack RedisInstance is priced per flavor", func(t *testing.T) {
+		amount, ok := EstimateRedisInstance(c, newTestScope(cloudcontrolv1beta1.ProviderOpenStack, "eu-de-1"), &cloudcontrolv1beta1.RedisInstance{
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					OpenStack: &cloudcontrolv1beta1.RedisInstanceOpenStack{FlavorName: "redis_c2_m4", VolumeSizeGb: 8},
+				},
+			},
+		})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.1*HoursPerMonth, amount, 0.001)
+	})
+
+	t.Run("RedisInstance without price is not estimated", func(t *testing.T) {
+		_, ok := EstimateRedisInstance(c, newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1"), &cloudcontrolv1beta1.RedisInstance{
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					Gcp: &cloudcontrolv1beta1.RedisInstanceGcp{Tier: "STANDARD_HA", MemorySizeGb: 5},
+				},
+			},
+		})
+		assert.False(t, ok)
+	})
+
+	t.Run("gcp NfsInstance is priced by spec capacity and tier", func(t *testing.T) {
+		amount, ok := EstimateNfsInstance(c, newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1"), &cloudcontrolv1beta1.NfsInstance{
+			Spec: cloudcontrolv1beta1.NfsInstanceSpec{
+				Instance: cloudcontrolv1beta1.NfsInstanceInfo{
+					Gcp: &cloudcontrolv1beta1.NfsInstanceGcp{Tier: cloudcontrolv1beta1.BASIC_HDD, CapacityGb: 1024},
+				},
+			},
+		})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.2*1024, amount, 0.001)
+	})
+
+	t.Run("aws NfsInstance is priced by provisioned capacity", func(t *testing.T) {
+		amount, ok := EstimateNfsInstance(c, newTestScope(cloudcontrolv1beta1.ProviderAws, "eu-central-1"), &cloudcontrolv1beta1.NfsInstance{
+			Spec: cloudcontrolv1beta1.NfsInstanceSpec{
+				Instance: cloudcontrolv1beta1.NfsInstanceInfo{
+					Aws: &cloudcontrolv1beta1.NfsInstanceAws{Throughput: cloudcontrolv1beta1.AwsThroughputModeBursting},
+				},
+			},
+			Status: cloudcontrolv1beta1.NfsInstanceStatus{
+				Capacity: resource.MustParse("10Gi"),
+			},
+		})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.3*10, amount, 0.001)
+	})
+
+	t.Run("VpcPeering", func(t *testing.T) {
+		amount, ok := EstimateVpcPeering(c, newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1"), &cloudcontrolv1beta1.VpcPeering{})
+		assert.True(t, ok)
+		assert.InDelta(t, 0.01*HoursPerMonth, amount, 0.001)
+	})
+
+	t.Run("NAT gateways", func(t *testing.T) {
+		scope := newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1")
+		scope.Status.ExposedData.NatGatewayIps = []string{"10.0.0.1", "10.0.0.2"}
+		amount, ok := EstimateNatGateways(c, scope)
+		assert.True(t, ok)
+		assert.InDelta(t, 0.04*2*HoursPerMonth, amount, 0.001)
+	})
+
+	t.Run("backup", func(t *testing.T) {
+		amount, ok := EstimateBackup(c, newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1"), resource.MustParse("512Gi"))
+		assert.True(t, ok)
+		assert.InDelta(t, 0.1*512, amount, 0.001)
+	})
+}
@@ -0,0 +1,152 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Kind identifies the kind of the object whose cost is estimated
+type Kind string
+
+const (
+	KindRedisInstance      Kind = "RedisInstance"
+	KindNfsInstance        Kind = "NfsInstance"
+	KindVpcPeering         Kind = "VpcPeering"
+	KindScope              Kind = "Scope"
+	KindGcpNfsVolumeBackup Kind = "GcpNfsVolumeBackup"
+	KindAwsNfsVolumeBackup Kind = "AwsNfsVolumeBackup"
+)
+
+type ObjWithCostEstimate interface {
+	composed.ObjWithConditions
+	cloudcontrolv1beta1.ObjWithCostEstimate
+}
+
+// Estimator estimates the monthly cost of the object, returning false if the catalog has no price for it
+type Estimator[T ObjWithCostEstimate] func(c Catalog, scope *cloudcontrolv1beta1.Scope, obj T) (float64, bool)
+
+// New returns an action that keeps the estimated monthly cost of the focal object in its status and
+// in the EstimatedMonthlyCost metric. The status is updated only when the estimate changes, so it
+// can run on every reconciliation. In the delete flow it only removes the metric of the object.
+func New[T ObjWithCostEstimate](kind Kind, estimator Estimator[T]) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		if !Config.Enabled {
+			return nil, ctx
+		}
+
+		obj := st.Obj().(T)
+		if composed.IsMarkedForDeletion(obj) {
+			Forget(kind, obj.GetName())
+			return nil, ctx
+		}
+
+		scope := st.(focal.State).Scope()
+		amount, ok := estimator(DefaultCatalog, scope, obj)
+		if !SetEstimate(kind, obj, scope, amount, ok) {
+			return nil, ctx
+		}
+
+		return composed.UpdateStatus(obj).
+			ErrorLogMessage("Error updating KCP object status with estimated monthly cost").
+			SuccessErrorNil().
+			Run(ctx, st)
+	}
+}
+
+// SetEstimate sets the estimate to the object status and the metric. It returns true if the status
+// was changed and has to be saved. Without a price the estimate is removed.
+func SetEstimate(kind Kind, obj ObjWithCostEstimate, scope *cloudcontrolv1beta1.Scope, amount float64, ok bool) bool {
+	if !ok {
+		Forget(kind, obj.GetName())
+		if obj.GetEstimatedMonthlyCost() == nil {
+			return false
+		}
+		obj.SetEstimatedMonthlyCost(nil)
+		return true
+	}
+
+	observe(kind, obj.GetName(), scope, amount)
+
+	estimate := &cloudcontrolv1beta1.CostEstimate{
+		Amount:   formatAmount(amount),
+		Currency: DefaultCatalog.Currency(),
+	}
+	current := obj.GetEstimatedMonthlyCost()
+	if current != nil && current.Amount == estimate.Amount && current.Currency == estimate.Currency {
+		return false
+	}
+	estimate.CalculatedAt = new(metav1.Now())
+	obj.SetEstimatedMonthlyCost(estimate)
+	return true
+}
+
+// SkrObjectName is the name SKR objects are observed with, unique across all SKRs
+func SkrObjectName(scope *cloudcontrolv1beta1.Scope, obj client.Object) string {
+	return fmt.Sprintf("%s/%s/%s", scope.Name, obj.GetNamespace(), obj.GetName())
+}
+
+// Observe sets the metric for objects that do not carry the estimate in their status,
+// like the SKR backups, which are named with SkrObjectName.
+func Observe(kind Kind, name string, scope *cloudcontrolv1beta1.Scope, amount float64, ok bool) {
+	if !Config.Enabled {
+		return
+	}
+	if !ok {
+		Forget(kind, name)
+		return
+	}
+	observe(kind, name, scope, amount)
+}
+
+func observe(kind Kind, name string, scope *cloudcontrolv1beta1.Scope, amount float64) {
+	metrics.EstimatedMonthlyCost.
+		WithLabelValues(
+			string(kind),
+			name,
+			scope.Labels[cloudcontrolv1beta1.LabelScopeGlobalAccountId],
+			scope.Labels[cloudcontrolv1beta1.LabelScopeSubaccountId],
+			string(scope.Spec.Provider),
+			scope.Spec.Region,
+			DefaultCatalog.Currency(),
+		).
+		Set(amount)
+}
+
+// ObserveBackup sets the metric with the estimated monthly cost of the SKR backup of the given capacity.
+// Backups are metric-only: they have no KCP object with a CostEstimate status, and the estimate is not
+// added to the SKR backup status, so the EstimatedMonthlyCost metric is the only place it is reported.
+func ObserveBackup(kind Kind, scope *cloudcontrolv1beta1.Scope, obj client.Object, capacity resource.Quantity) {
+	if scope == nil {
+		return
+	}
+	amount, ok := EstimateBackup(DefaultCatalog, scope, capacity)
+	Observe(kind, SkrObjectName(scope, obj), scope, amount, ok)
+}
+
+// ForgetBackup removes the metric of the deleted SKR backup
+func ForgetBackup(kind Kind, scope *cloudcontrolv1beta1.Scope, obj client.Object) {
+	if scope == nil {
+		return
+	}
+	Forget(kind, SkrObjectName(scope, obj))
+}
+
+// Forget removes the metric of the object, to be used once the object is deleted
+func Forget(kind Kind, name string) {
+	metrics.EstimatedMonthlyCost.DeletePartialMatch(prometheus.Labels{"kind": string(kind), "name": name})
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(math.Round(amount*100)/100, 'f', 2, 64)
+}
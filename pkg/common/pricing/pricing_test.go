@@ -0,0 +1,141 @@
+package pricing
+
+import (
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPricing(t *testing.T) {
+
+	var k8sClient client.Client
+	var redisInstance *cloudcontrolv1beta1.RedisInstance
+
+	scope := newTestScope(cloudcontrolv1beta1.ProviderGCP, "europe-west1")
+	scope.Name = "test-kyma"
+	scope.Labels = map[string]string{
+		cloudcontrolv1beta1.LabelScopeGlobalAccountId: "test-ga",
+		cloudcontrolv1beta1.LabelScopeSubaccountId:    "test-sa",
+	}
+
+	setupTest := func(t *testing.T, memorySizeGb int32) composed.State {
+		catalog := newTestCatalog()
+		Config.Enabled = true
+		DefaultCatalog = catalog
+		t.Cleanup(func() {
+			Config.Enabled = false
+			DefaultCatalog = Config
+			metrics.EstimatedMonthlyCost.Reset()
+		})
+
+		redisInstance = &cloudcontrolv1beta1.RedisInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "6d8f1f0e-7c1f-4c8e-9f2a-2b3c4d5e6f70",
+				Namespace: "kcp-system",
+			},
+			Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+				Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+					Gcp: &cloudcontrolv1beta1.RedisInstanceGcp{Tier: "BASIC", MemorySizeGb: memorySizeGb},
+				},
+			},
+		}
+		k8sClient = fake.NewClientBuilder().
+			WithScheme(commonscheme.KcpScheme).
+			WithObjects(redisInstance).
+			WithStatusSubresource(redisInstance).
+			Build()
+
+		cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
+		st := focal.NewStateFactory().NewState(composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, redisInstance))
+		st.SetScope(scope)
+		return st
+	}
+
+	loadEstimate := func(t *testing.T) *cloudcontrolv1beta1.CostEstimate {
+		obj := &cloudcontrolv1beta1.RedisInstance{}
+		require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(redisInstance), obj))
+		return obj.Status.EstimatedMonthlyCost
+	}
+
+	action := New(KindRedisInstance, EstimateRedisInstance)
+
+	t.Run("sets estimate in status and metric", func(t *testing.T) {
+		st := setupTest(t, 2)
+
+		err, _ := action(t.Context(), st)
+		assert.NoError(t, err)
+
+		estimate := loadEstimate(t)
+		require.NotNil(t, estimate)
+		assert.Equal(t, "73.00", estimate.Amount)
+		assert.Equal(t, "USD", estimate.Currency)
+		assert.NotNil(t, estimate.CalculatedAt)
+
+		assert.Equal(t, 73.0, testutil.ToFloat64(metrics.EstimatedMonthlyCost.WithLabelValues(
+			string(KindRedisInstance), redisInstance.Name, "test-ga", "test-sa", "gcp", "europe-west1", "USD",
+		)))
+	})
+
+	t.Run("does not update status when estimate is unchanged", func(t *testing.T) {
+		st := setupTest(t, 2)
+
+		err, _ := action(t.Context(), st)
+		assert.NoError(t, err)
+		resourceVersion := st.Obj().GetResourceVersion()
+
+		err, _ = action(t.Context(), st)
+		assert.NoError(t, err)
+		assert.Equal(t, resourceVersion, st.Obj().GetResourceVersion())
+	})
+
+	t.Run("removes estimate when there is no price", func(t *testing.T) {
+		st := setupTest(t, 2)
+
+		err, _ := action(t.Context(), st)
+		assert.NoError(t, err)
+		require.NotNil(t, loadEstimate(t))
+
+		DefaultCatalog = &ConfigStruct{Enabled: true, CurrencyCode: "USD"}
+		err, _ = action(t.Context(), st)
+		assert.NoError(t, err)
+
+		assert.Nil(t, loadEstimate(t))
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.EstimatedMonthlyCost))
+	})
+
+	t.Run("does nothing when disabled", func(t *testing.T) {
+		st := setupTest(t, 2)
+		Config.Enabled = false
+
+		err, _ := action(t.Context(), st)
+		assert.NoError(t, err)
+
+		assert.Nil(t, loadEstimate(t))
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.EstimatedMonthlyCost))
+	})
+
+	t.Run("backup metric is named per SKR and removed on forget", func(t *testing.T) {
+		setupTest(t, 2)
+		backup := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"}}
+
+		ObserveBackup(KindGcpNfsVolumeBackup, scope, backup, resource.MustParse("10Gi"))
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.EstimatedMonthlyCost.WithLabelValues(
+			string(KindGcpNfsVolumeBackup), "test-kyma/default/backup", "test-ga", "test-sa", "gcp", "europe-west1", "USD",
+		)))
+
+		ForgetBackup(KindGcpNfsVolumeBackup, scope, backup)
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.EstimatedMonthlyCost))
+	})
+}
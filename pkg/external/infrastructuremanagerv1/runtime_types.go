@@ -219,6 +219,14 @@ type Networking struct {
 type Security struct {
 	Administrators []string           `json:"administrators"`
 	Networking     NetworkingSecurity `json:"networking"`
+	// Hardening configures the hardened cloud account baseline provisioned by the cloud-manager
+	Hardening *SecurityHardening `json:"hardening,omitempty"`
+}
+
+// SecurityHardening when enabled provisions a customer-managed KMS key, VPC flow logs and
+// default-deny network rules in the cloud account of the runtime
+type SecurityHardening struct {
+	Enabled bool `json:"enabled"`
 }
 
 type NetworkingSecurity struct {
@@ -497,6 +497,11 @@ func (in *Security) DeepCopyInto(out *Security) {
 		copy(*out, *in)
 	}
 	in.Networking.DeepCopyInto(&out.Networking)
+	if in.Hardening != nil {
+		in, out := &in.Hardening, &out.Hardening
+		*out = new(SecurityHardening)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Security.
@@ -509,6 +514,21 @@ func (in *Security) DeepCopy() *Security {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHardening) DeepCopyInto(out *SecurityHardening) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHardening.
+func (in *SecurityHardening) DeepCopy() *SecurityHardening {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHardening)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shoot) DeepCopyInto(out *Shoot) {
 	*out = *in
@@ -30,6 +30,12 @@ func TestEmbeddedConfig(t *testing.T) {
 	assert.True(t, ApiDisabled.Value(ContextBuilderFromCtx(ctx).Feature(types.FeatureVpcDnsLink).Build(ctx)),
 		"apiDisabled targeting feature vpcdnslink should be true")
 
+	assert.True(t, ApiDisabled.Value(ContextBuilderFromCtx(ctx).Feature(types.FeaturePostgres).Build(ctx)),
+		"apiDisabled targeting feature postgres should be true")
+
+	assert.True(t, ApiDisabled.Value(ContextBuilderFromCtx(ctx).Feature(types.FeatureBucket).Build(ctx)),
+		"apiDisabled targeting feature bucket should be true")
+
 	assert.True(t, ApiDisabled.Value(ContextBuilderFromCtx(ctx).BrokerPlan("trial").Build(ctx)),
 		"apiDisabled targeting broker plan trial should be true")
 
@@ -26,6 +26,8 @@ func TestManifestResourceToFeature(t *testing.T) {
 			{"AwsNfsVolumeBackup", &cloudresourcesv1beta1.AwsNfsVolumeBackup{}, types.FeatureNfsBackup},
 			{"AwsVpcPeering", &cloudresourcesv1beta1.AwsVpcPeering{}, types.FeaturePeering},
 			{"AzureVpcPeering", &cloudresourcesv1beta1.AzureVpcPeering{}, types.FeaturePeering},
+			{"SapVpcPeering", &cloudresourcesv1beta1.SapVpcPeering{}, types.FeaturePeering},
+			{"SapRedisInstance", &cloudresourcesv1beta1.SapRedisInstance{}, types.FeatureRedis},
 			{"CloudResources", &cloudresourcesv1beta1.CloudResources{}, ""},
 			{"GcpNfsVolumeBackup", &cloudresourcesv1beta1.GcpNfsVolumeBackup{}, types.FeatureNfsBackup},
 			{"GcpNfsVolumeRestore", &cloudresourcesv1beta1.GcpNfsVolumeRestore{}, types.FeatureNfsBackup},
@@ -57,6 +59,8 @@ func TestManifestResourceToFeature(t *testing.T) {
 			{"AwsNfsVolumeBackup", objkind.NewUnstructuredWithGVK(g, v, "AwsNfsVolumeBackup"), types.FeatureNfsBackup},
 			{"AwsVpcPeering", objkind.NewUnstructuredWithGVK(g, v, "AwsVpcPeering"), types.FeaturePeering},
 			{"AzureVpcPeering", objkind.NewUnstructuredWithGVK(g, v, "AzureVpcPeering"), types.FeaturePeering},
+			{"SapVpcPeering", objkind.NewUnstructuredWithGVK(g, v, "SapVpcPeering"), types.FeaturePeering},
+			{"SapRedisInstance", objkind.NewUnstructuredWithGVK(g, v, "SapRedisInstance"), types.FeatureRedis},
 			{"CloudResources", objkind.NewUnstructuredWithGVK(g, v, "CloudResources"), ""},
 			{"GcpNfsVolumeBackup", objkind.NewUnstructuredWithGVK(g, v, "GcpNfsVolumeBackup"), types.FeatureNfsBackup},
 			{"GcpNfsVolumeRestore", objkind.NewUnstructuredWithGVK(g, v, "GcpNfsVolumeRestore"), types.FeatureNfsBackup},
@@ -91,6 +95,8 @@ func TestManifestResourceToFeature(t *testing.T) {
 			{"AwsNfsVolumeBackup", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "AwsNfsVolumeBackup", g), types.FeatureNfsBackup},
 			{"AwsVpcPeering", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "AwsVpcPeering", g), types.FeaturePeering},
 			{"AzureVpcPeering", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "AzureVpcPeering", g), types.FeaturePeering},
+			{"SapVpcPeering", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "SapVpcPeering", g), types.FeaturePeering},
+			{"SapRedisInstance", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "SapRedisInstance", g), types.FeatureRedis},
 			{"CloudResources", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "CloudResources", g), ""},
 			{"GcpNfsVolumeBackup", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "GcpNfsVolumeBackup", g), types.FeatureNfsBackup},
 			{"GcpNfsVolumeRestore", objkind.NewCrdUnstructuredWithKindGroup(t, baseCrdUnstructured, "GcpNfsVolumeRestore", g), types.FeatureNfsBackup},
@@ -123,6 +129,8 @@ func TestManifestResourceToFeature(t *testing.T) {
 			{"AwsNfsVolumeBackup", objkind.NewBusolaCmUnstructuredKindGroup(t, "AwsNfsVolumeBackup"), types.FeatureNfsBackup},
 			{"AwsVpcPeering", objkind.NewBusolaCmUnstructuredKindGroup(t, "AwsVpcPeering"), types.FeaturePeering},
 			{"AzureVpcPeering", objkind.NewBusolaCmUnstructuredKindGroup(t, "AzureVpcPeering"), types.FeaturePeering},
+			{"SapVpcPeering", objkind.NewBusolaCmUnstructuredKindGroup(t, "SapVpcPeering"), types.FeaturePeering},
+			{"SapRedisInstance", objkind.NewBusolaCmUnstructuredKindGroup(t, "SapRedisInstance"), types.FeatureRedis},
 			{"CloudResources", objkind.NewBusolaCmUnstructuredKindGroup(t, "CloudResources"), ""},
 			{"GcpNfsVolumeBackup", objkind.NewBusolaCmUnstructuredKindGroup(t, "GcpNfsVolumeBackup"), types.FeatureNfsBackup},
 			{"GcpNfsVolumeRestore", objkind.NewBusolaCmUnstructuredKindGroup(t, "GcpNfsVolumeRestore"), types.FeatureNfsBackup},
@@ -28,9 +28,11 @@ type FeatureName = string
 const (
 	FeatureUnknown FeatureName = "unknown"
 
+	FeatureBucket       FeatureName = "bucket"
 	FeatureNfs          FeatureName = "nfs"
 	FeatureNfsBackup    FeatureName = "nfsBackup"
 	FeaturePeering      FeatureName = "peering"
+	FeaturePostgres     FeatureName = "postgres"
 	FeatureRedis        FeatureName = "redis"
 	FeatureRedisCluster FeatureName = "rediscluster"
 	FeatureVpcDnsLink   FeatureName = "vpcdnslink"
@@ -0,0 +1,87 @@
+package bucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awsbucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket"
+	azurebucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket"
+	gcpbucket "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type BucketReconciler interface {
+	reconcile.Reconciler
+}
+
+type bucketReconciler struct {
+	composedStateFactory composed.StateFactory
+	focalStateFactory    focal.StateFactory
+
+	awsStateFactory   awsbucket.StateFactory
+	gcpStateFactory   gcpbucket.StateFactory
+	azureStateFactory azurebucket.StateFactory
+}
+
+func NewBucketReconciler(
+	composedStateFactory composed.StateFactory,
+	focalStateFactory focal.StateFactory,
+	awsStateFactory awsbucket.StateFactory,
+	gcpStateFactory gcpbucket.StateFactory,
+	azureStateFactory azurebucket.StateFactory,
+) BucketReconciler {
+	return &bucketReconciler{
+		composedStateFactory: composedStateFactory,
+		focalStateFactory:    focalStateFactory,
+		awsStateFactory:      awsStateFactory,
+		gcpStateFactory:      gcpStateFactory,
+		azureStateFactory:    azureStateFactory,
+	}
+}
+
+func (r *bucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if Ignore.ShouldIgnoreKey(req) {
+		return ctrl.Result{}, nil
+	}
+
+	state := r.newFocalState(req.NamespacedName)
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("bucket", util.RequestObjToString(req)).
+		Handle(action(ctx, state))
+}
+
+func (r *bucketReconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"main",
+		feature.LoadFeatureContextFromObj(&cloudcontrolv1beta1.Bucket{}),
+		focal.New(),
+		func(ctx context.Context, st composed.State) (error, context.Context) {
+			return composed.ComposeActions(
+				"bucketCommon",
+				loadIpRange,
+				composed.BuildSwitchAction(
+					"providerSwitch",
+					nil,
+					composed.NewCase(statewithscope.AwsProviderPredicate, awsbucket.New(r.awsStateFactory)),
+					composed.NewCase(statewithscope.GcpProviderPredicate, gcpbucket.New(r.gcpStateFactory)),
+					composed.NewCase(statewithscope.AzureProviderPredicate, azurebucket.New(r.azureStateFactory)),
+				),
+			)(ctx, newState(st.(focal.State)))
+		},
+	)
+}
+
+func (r *bucketReconciler) newFocalState(name types.NamespacedName) focal.State {
+	return r.focalStateFactory.NewState(
+		r.composedStateFactory.NewState(name, &cloudcontrolv1beta1.Bucket{}),
+	)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+)
+
+type State interface {
+	focal.State
+	ObjAsBucket() *v1beta1.Bucket
+
+	// IpRange returns the referred IpRange, or nil if the Bucket does not refer to one
+	IpRange() *v1beta1.IpRange
+	SetIpRange(r *v1beta1.IpRange)
+}
@@ -0,0 +1,71 @@
+package drift
+
+import (
+	"time"
+
+	"github.com/kyma-project/cloud-manager/pkg/config"
+)
+
+type ConfigStruct struct {
+	Interval      time.Duration `mapstructure:"interval,omitempty"`
+	RedisInstance Policy        `mapstructure:"redisInstance,omitempty"`
+	NfsInstance   Policy        `mapstructure:"nfsInstance,omitempty"`
+	VpcPeering    Policy        `mapstructure:"vpcPeering,omitempty"`
+	IpRange       Policy        `mapstructure:"ipRange,omitempty"`
+}
+
+var Config = &ConfigStruct{}
+
+// PolicyFor returns the configured policy of the kind, unknown values are treated as PolicyIgnore
+func (c *ConfigStruct) PolicyFor(kind Kind) Policy {
+	var p Policy
+	switch kind {
+	case KindRedisInstance:
+		p = c.RedisInstance
+	case KindNfsInstance:
+		p = c.NfsInstance
+	case KindVpcPeering:
+		p = c.VpcPeering
+	case KindIpRange:
+		p = c.IpRange
+	}
+	switch p {
+	case PolicyReport, PolicyCorrect, PolicyRecreate:
+		return p
+	default:
+		return PolicyIgnore
+	}
+}
+
+func InitConfig(cfg config.Config) {
+	cfg.Path(
+		"drift",
+		config.Bind(Config),
+		config.SourceFile("drift.yaml"),
+		config.Path(
+			"interval",
+			config.DefaultScalar("1h"),
+			config.SourceEnv("DRIFT_CHECK_INTERVAL"),
+		),
+		config.Path(
+			"redisInstance",
+			config.DefaultScalar(string(PolicyIgnore)),
+			config.SourceEnv("DRIFT_POLICY_REDIS_INSTANCE"),
+		),
+		config.Path(
+			"nfsInstance",
+			config.DefaultScalar(string(PolicyIgnore)),
+			config.SourceEnv("DRIFT_POLICY_NFS_INSTANCE"),
+		),
+		config.Path(
+			"vpcPeering",
+			config.DefaultScalar(string(PolicyIgnore)),
+			config.SourceEnv("DRIFT_POLICY_VPC_PEERING"),
+		),
+		config.Path(
+			"ipRange",
+			config.DefaultScalar(string(PolicyIgnore)),
+			config.SourceEnv("DRIFT_POLICY_IP_RANGE"),
+		),
+	)
+}
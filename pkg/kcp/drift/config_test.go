@@ -0,0 +1,69 @@
+package drift
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	pkgconfig "github.com/kyma-project/cloud-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigDefaults(t *testing.T) {
+	env := abstractions.NewMockedEnvironment(map[string]string{})
+	cfg := pkgconfig.NewConfig(env)
+	InitConfig(cfg)
+	cfg.Read()
+
+	assert.Equal(t, time.Hour, Config.Interval)
+	assert.Equal(t, PolicyIgnore, Config.PolicyFor(KindRedisInstance))
+	assert.Equal(t, PolicyIgnore, Config.PolicyFor(KindNfsInstance))
+	assert.Equal(t, PolicyIgnore, Config.PolicyFor(KindVpcPeering))
+	assert.Equal(t, PolicyIgnore, Config.PolicyFor(KindIpRange))
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	env := abstractions.NewMockedEnvironment(map[string]string{
+		"DRIFT_CHECK_INTERVAL":        "30m",
+		"DRIFT_POLICY_REDIS_INSTANCE": "Correct",
+		"DRIFT_POLICY_IP_RANGE":       "Report",
+		"DRIFT_POLICY_VPC_PEERING":    "Unknown",
+		"DRIFT_POLICY_NFS_INSTANCE":   "Recreate",
+	})
+	cfg := pkgconfig.NewConfig(env)
+	InitConfig(cfg)
+	cfg.Read()
+
+	assert.Equal(t, 30*time.Minute, Config.Interval)
+	assert.Equal(t, PolicyCorrect, Config.PolicyFor(KindRedisInstance))
+	assert.Equal(t, PolicyRecreate, Config.PolicyFor(KindNfsInstance))
+	assert.Equal(t, PolicyIgnore, Config.PolicyFor(KindVpcPeering))
+	assert.Equal(t, PolicyReport, Config.PolicyFor(KindIpRange))
+}
+
+func TestConfigFromFile(t *testing.T) {
+	dir, err := os.MkdirTemp("", "cloud-manager-config")
+	assert.NoError(t, err, "error creating tmp dir")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	err = os.WriteFile(filepath.Join(dir, "drift.yaml"), []byte(`
+interval: 2h
+nfsInstance: Report
+vpcPeering: Correct
+`), 0644)
+	assert.NoError(t, err, "error creating config file")
+
+	env := abstractions.NewMockedEnvironment(map[string]string{})
+	cfg := pkgconfig.NewConfig(env)
+	cfg.BaseDir(dir)
+	InitConfig(cfg)
+	cfg.Read()
+
+	assert.Equal(t, 2*time.Hour, Config.Interval)
+	assert.Equal(t, PolicyIgnore, Config.PolicyFor(KindRedisInstance))
+	assert.Equal(t, PolicyReport, Config.PolicyFor(KindNfsInstance))
+	assert.Equal(t, PolicyCorrect, Config.PolicyFor(KindVpcPeering))
+}
@@ -0,0 +1,163 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Kind identifies the KCP kind whose provider state is checked for drift
+type Kind string
+
+const (
+	KindRedisInstance Kind = "RedisInstance"
+	KindNfsInstance   Kind = "NfsInstance"
+	KindVpcPeering    Kind = "VpcPeering"
+	KindIpRange       Kind = "IpRange"
+)
+
+// Policy defines what is done once drift of the provider resource is detected
+type Policy string
+
+const (
+	// PolicyIgnore disables drift detection for the kind
+	PolicyIgnore Policy = "Ignore"
+	// PolicyReport sets the Drifted condition and leaves the provider resource as it is
+	PolicyReport Policy = "Report"
+	// PolicyCorrect sets the Drifted condition and lets the provider flow bring the resource back to the spec.
+	// Provider resources that were removed out of band are only reported, since created again they would not
+	// hold the data of the removed ones.
+	PolicyCorrect Policy = "Correct"
+	// PolicyRecreate is as PolicyCorrect, and also lets the provider flow create again the removed provider resources
+	PolicyRecreate Policy = "Recreate"
+)
+
+// Detector returns names of the spec fields whose actual provider value differs from the desired one.
+// It is called only for Ready objects, after the provider resource is loaded into the state.
+type Detector func(ctx context.Context, st composed.State) []string
+
+// RemovedPredicate returns true if the provider resource of the object was removed out of band.
+// It is called only for Ready objects, after the provider resource is loaded into the state.
+type RemovedPredicate func(ctx context.Context, st composed.State) bool
+
+// New returns an action that compares the provider state with the spec using the given detector.
+//
+// Differences are reported as drift only if the spec did not change since the object was last seen
+// in sync, which is tracked with the observedGeneration of the Drifted condition. Otherwise, they
+// are a pending spec change the provider flow has yet to apply.
+//
+// With PolicyReport the flow is stopped and checked again after the configured interval. With
+// PolicyCorrect the flow continues so the following provider actions can apply the spec again.
+func New(kind Kind, detector Detector) composed.Action {
+	return NewWithRemoved(kind, detector, nil)
+}
+
+// NewWithRemoved is as New, for kinds whose provider flow would create the removed provider resource
+// again. If the given predicate reports the resource as removed, the drift is handled as with PolicyReport,
+// unless the policy is PolicyRecreate.
+func NewWithRemoved(kind Kind, detector Detector, removed RemovedPredicate) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		policy := Config.PolicyFor(kind)
+		if policy == PolicyIgnore {
+			return nil, ctx
+		}
+
+		obj := st.Obj().(composed.ObjWithConditions)
+		if !meta.IsStatusConditionTrue(*obj.Conditions(), cloudcontrolv1beta1.ConditionTypeReady) {
+			return nil, ctx
+		}
+
+		logger := composed.LoggerFromCtx(ctx)
+		fields := detector(ctx, st)
+		metrics.KcpDriftedFields.WithLabelValues(string(kind), obj.GetName()).Set(float64(len(fields)))
+
+		driftedCondition := meta.FindStatusCondition(*obj.Conditions(), cloudcontrolv1beta1.ConditionTypeDrifted)
+
+		if len(fields) == 0 {
+			if driftedCondition != nil &&
+				driftedCondition.Status == metav1.ConditionFalse &&
+				driftedCondition.ObservedGeneration == obj.GetGeneration() {
+				return nil, ctx
+			}
+			return composed.UpdateStatus(obj).
+				SetCondition(metav1.Condition{
+					Type:               cloudcontrolv1beta1.ConditionTypeDrifted,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: obj.GetGeneration(),
+					Reason:             cloudcontrolv1beta1.ReasonInSync,
+					Message:            "Provider state matches the spec",
+				}).
+				ErrorLogMessage("Error updating KCP object status with drift in sync condition").
+				SuccessErrorNil().
+				Run(ctx, st)
+		}
+
+		// a removed provider resource is never a pending spec change
+		isRemoved := removed != nil && removed(ctx, st)
+
+		if !isRemoved && (driftedCondition == nil || driftedCondition.ObservedGeneration != obj.GetGeneration()) {
+			logger.Info("Provider state differs from the changed spec, not treating it as drift", "fields", fields)
+			return nil, ctx
+		}
+
+		message := fmt.Sprintf("Provider state differs from the spec in fields: %s", strings.Join(fields, ", "))
+
+		if policy == PolicyReport || (isRemoved && policy != PolicyRecreate) {
+			logger.Info("Drift detected", "fields", fields)
+			return composed.UpdateStatus(obj).
+				SetCondition(metav1.Condition{
+					Type:               cloudcontrolv1beta1.ConditionTypeDrifted,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: obj.GetGeneration(),
+					Reason:             cloudcontrolv1beta1.ReasonDriftDetected,
+					Message:            message,
+				}).
+				ErrorLogMessage("Error updating KCP object status with drift detected condition").
+				SuccessError(composed.StopWithRequeueDelay(Config.Interval)).
+				Run(ctx, st)
+		}
+
+		logger.Info("Drift detected, correcting", "fields", fields)
+		metrics.KcpDriftCorrectionTotal.WithLabelValues(string(kind)).Inc()
+		return composed.UpdateStatus(obj).
+			SetCondition(metav1.Condition{
+				Type:               cloudcontrolv1beta1.ConditionTypeDrifted,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: obj.GetGeneration(),
+				Reason:             cloudcontrolv1beta1.ReasonDriftCorrecting,
+				Message:            message,
+			}).
+			ErrorLogMessage("Error updating KCP object status with drift correcting condition").
+			SuccessErrorNil().
+			Run(ctx, st)
+	}
+}
+
+// StopAndForget returns the error a provider flow finishes with once the object is in sync. When drift
+// detection is enabled for the kind, the object is requeued after the check interval instead of forgotten.
+func StopAndForget(kind Kind) error {
+	if Config.PolicyFor(kind) == PolicyIgnore {
+		return composed.StopAndForget
+	}
+	return composed.StopWithRequeueDelay(Config.Interval)
+}
+
+func StopAndForgetAction(kind Kind) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		return StopAndForget(kind), nil
+	}
+}
+
+// Forget returns an action that removes the drift metric of the object, to be used in the delete flow
+func Forget(kind Kind) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		metrics.KcpDriftedFields.DeleteLabelValues(string(kind), st.Obj().GetName())
+		return nil, ctx
+	}
+}
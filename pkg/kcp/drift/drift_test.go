@@ -0,0 +1,208 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDrift(t *testing.T) {
+
+	var k8sClient client.Client
+	var redisInstance *cloudcontrolv1beta1.RedisInstance
+	var detectorCalled bool
+
+	setupTest := func(t *testing.T, policy Policy, conditions ...metav1.Condition) composed.State {
+		Config.RedisInstance = policy
+		Config.Interval = 0
+		t.Cleanup(func() {
+			Config.RedisInstance = ""
+		})
+
+		redisInstance = &cloudcontrolv1beta1.RedisInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "ba5e2f2d-5b43-4cb9-9b74-e4b5b4b0c1a7",
+				Namespace:  "kcp-system",
+				Generation: 2,
+			},
+			Status: cloudcontrolv1beta1.RedisInstanceStatus{
+				Conditions: conditions,
+			},
+		}
+		k8sClient = fake.NewClientBuilder().
+			WithScheme(commonscheme.KcpScheme).
+			WithObjects(redisInstance).
+			WithStatusSubresource(redisInstance).
+			Build()
+		detectorCalled = false
+
+		cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
+		return composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, redisInstance)
+	}
+
+	detector := func(fields ...string) Detector {
+		return func(ctx context.Context, st composed.State) []string {
+			detectorCalled = true
+			return fields
+		}
+	}
+
+	loadDriftedCondition := func(t *testing.T) *metav1.Condition {
+		obj := &cloudcontrolv1beta1.RedisInstance{}
+		require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(redisInstance), obj))
+		return meta.FindStatusCondition(obj.Status.Conditions, cloudcontrolv1beta1.ConditionTypeDrifted)
+	}
+
+	readyCondition := metav1.Condition{
+		Type:   cloudcontrolv1beta1.ConditionTypeReady,
+		Status: metav1.ConditionTrue,
+		Reason: cloudcontrolv1beta1.ReasonReady,
+	}
+
+	inSyncCondition := func(generation int64) metav1.Condition {
+		return metav1.Condition{
+			Type:               cloudcontrolv1beta1.ConditionTypeDrifted,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             cloudcontrolv1beta1.ReasonInSync,
+		}
+	}
+
+	t.Run("Should: not call detector when policy is Ignore", func(t *testing.T) {
+		st := setupTest(t, PolicyIgnore, readyCondition)
+
+		err, _ := New(KindRedisInstance, detector("memorySizeGb"))(t.Context(), st)
+
+		assert.NoError(t, err)
+		assert.False(t, detectorCalled)
+		assert.Nil(t, loadDriftedCondition(t))
+	})
+
+	t.Run("Should: not call detector when object is not Ready", func(t *testing.T) {
+		st := setupTest(t, PolicyReport)
+
+		err, _ := New(KindRedisInstance, detector("memorySizeGb"))(t.Context(), st)
+
+		assert.NoError(t, err)
+		assert.False(t, detectorCalled)
+	})
+
+	t.Run("Should: mark object in sync when there are no differences", func(t *testing.T) {
+		st := setupTest(t, PolicyReport, readyCondition)
+
+		err, _ := New(KindRedisInstance, detector())(t.Context(), st)
+
+		assert.NoError(t, err)
+		assert.True(t, detectorCalled)
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, cloudcontrolv1beta1.ReasonInSync, cond.Reason)
+		assert.Equal(t, redisInstance.Generation, cond.ObservedGeneration)
+		assert.Equal(t, float64(0), testutil.ToFloat64(metrics.KcpDriftedFields.WithLabelValues(string(KindRedisInstance), redisInstance.Name)))
+	})
+
+	t.Run("Should: not report drift when spec changed since object was in sync", func(t *testing.T) {
+		st := setupTest(t, PolicyReport, readyCondition, inSyncCondition(1))
+
+		err, _ := New(KindRedisInstance, detector("memorySizeGb"))(t.Context(), st)
+
+		assert.NoError(t, err)
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, int64(1), cond.ObservedGeneration)
+	})
+
+	t.Run("Should: report drift and stop with policy Report", func(t *testing.T) {
+		st := setupTest(t, PolicyReport, readyCondition, inSyncCondition(2))
+
+		err, _ := New(KindRedisInstance, detector("memorySizeGb", "authEnabled"))(t.Context(), st)
+
+		assert.True(t, composed.IsStopWithRequeueDelay(err))
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, cloudcontrolv1beta1.ReasonDriftDetected, cond.Reason)
+		assert.Contains(t, cond.Message, "memorySizeGb, authEnabled")
+		assert.Equal(t, float64(2), testutil.ToFloat64(metrics.KcpDriftedFields.WithLabelValues(string(KindRedisInstance), redisInstance.Name)))
+	})
+
+	t.Run("Should: report drift and continue with policy Correct", func(t *testing.T) {
+		st := setupTest(t, PolicyCorrect, readyCondition, inSyncCondition(2))
+		corrections := testutil.ToFloat64(metrics.KcpDriftCorrectionTotal.WithLabelValues(string(KindRedisInstance)))
+
+		err, _ := New(KindRedisInstance, detector("memorySizeGb"))(t.Context(), st)
+
+		assert.NoError(t, err)
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, cloudcontrolv1beta1.ReasonDriftCorrecting, cond.Reason)
+		assert.Equal(t, corrections+1, testutil.ToFloat64(metrics.KcpDriftCorrectionTotal.WithLabelValues(string(KindRedisInstance))))
+	})
+
+	removed := func(ctx context.Context, st composed.State) bool {
+		return true
+	}
+
+	t.Run("Should: report removed resource and stop with policy Correct", func(t *testing.T) {
+		st := setupTest(t, PolicyCorrect, readyCondition)
+
+		err, _ := NewWithRemoved(KindRedisInstance, detector("replicationGroup"), removed)(t.Context(), st)
+
+		assert.True(t, composed.IsStopWithRequeueDelay(err))
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, cloudcontrolv1beta1.ReasonDriftDetected, cond.Reason)
+		assert.Contains(t, cond.Message, "replicationGroup")
+	})
+
+	t.Run("Should: report removed resource and continue with policy Recreate", func(t *testing.T) {
+		st := setupTest(t, PolicyRecreate, readyCondition)
+
+		err, _ := NewWithRemoved(KindRedisInstance, detector("replicationGroup"), removed)(t.Context(), st)
+
+		assert.NoError(t, err)
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, cloudcontrolv1beta1.ReasonDriftCorrecting, cond.Reason)
+	})
+
+	t.Run("Should: correct drift of existing resource with policy Correct", func(t *testing.T) {
+		st := setupTest(t, PolicyCorrect, readyCondition, inSyncCondition(2))
+
+		err, _ := NewWithRemoved(KindRedisInstance, detector("memorySizeGb"), func(ctx context.Context, st composed.State) bool {
+			return false
+		})(t.Context(), st)
+
+		assert.NoError(t, err)
+		cond := loadDriftedCondition(t)
+		require.NotNil(t, cond)
+		assert.Equal(t, cloudcontrolv1beta1.ReasonDriftCorrecting, cond.Reason)
+	})
+
+	t.Run("Should: requeue in sync object only when drift detection is enabled", func(t *testing.T) {
+		Config.RedisInstance = PolicyIgnore
+		assert.True(t, composed.IsStopAndForget(StopAndForget(KindRedisInstance)))
+
+		Config.RedisInstance = PolicyReport
+		assert.True(t, composed.IsStopWithRequeueDelay(StopAndForget(KindRedisInstance)))
+
+		Config.RedisInstance = ""
+	})
+}
@@ -15,6 +15,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -77,6 +78,7 @@ func (r *nfsInstanceReconciler) newAction() composed.Action {
 			return composed.ComposeActions(
 				"nfsInstanceCommon",
 				// common NfsInstance common actions here
+				pricing.New(pricing.KindNfsInstance, pricing.EstimateNfsInstance),
 				loadIpRange,
 				copyStatusHostsToHost,
 				// and now branch to provider specific flow
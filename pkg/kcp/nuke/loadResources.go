@@ -40,6 +40,14 @@ func loadResources(ctx context.Context, st composed.State) (error, context.Conte
 			Kind: "NfsInstance",
 			List: &cloudcontrolv1beta1.NfsInstanceList{},
 		},
+		{
+			Kind: "Bucket",
+			List: &cloudcontrolv1beta1.BucketList{},
+		},
+		{
+			Kind: "PostgresInstance",
+			List: &cloudcontrolv1beta1.PostgresInstanceList{},
+		},
 		{
 			Kind: "IpRange",
 			List: &cloudcontrolv1beta1.IpRangeList{},
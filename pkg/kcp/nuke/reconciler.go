@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
 	awsnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke"
 	azurenuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke"
 	gcpnuke "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke"
@@ -67,9 +68,13 @@ func (r *nukeReconciler) newAction() composed.Action {
 			shortCircuitCompleted,
 			loadResources,
 			resourceStatusDiscovered,
-			deleteResources,
-			resourceStatusDeleting,
-			resourceStatusDeleted,
+			composed.If(
+				// in dry-run mode nothing is deleted until approved
+				nuketypes.DeletionApprovedPredicate,
+				deleteResources,
+				resourceStatusDeleting,
+				resourceStatusDeleted,
+			),
 			composed.If(
 				composed.All(
 					feature.FFNukeBackupsGcp.Predicate(),
@@ -91,6 +96,7 @@ func (r *nukeReconciler) newAction() composed.Action {
 				),
 				azurenuke.New(r.azureStateFactory),
 			),
+			waitForApproval,
 			checkIfAllDeleted,
 			scopeDelete,
 			statusCompleted,
@@ -69,3 +69,12 @@ func (s *State) ObjectExists(kind, name string) bool {
 	}
 	return false
 }
+
+func (s *State) ObjectsOfKind(kind string) []focal.CommonObject {
+	for _, res := range s.Resources {
+		if res.Kind == kind {
+			return res.Objects
+		}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// DeletionApprovedPredicate returns true if Nuke is allowed to delete the discovered resources, which is
+// always the case except in the dry-run mode that has not been approved yet.
+func DeletionApprovedPredicate(ctx context.Context, st composed.State) bool {
+	return !st.Obj().(*cloudcontrolv1beta1.Nuke).IsApprovalPending()
+}
@@ -26,4 +26,8 @@ type ProviderResourceObject interface {
 type State interface {
 	focal.State
 	ObjAsNuke() *cloudcontrolv1beta1.Nuke
+	// ObjectExists returns true if the KCP object of the given kind and name was loaded
+	ObjectExists(kind, name string) bool
+	// ObjectsOfKind returns the loaded KCP objects of the given kind
+	ObjectsOfKind(kind string) []focal.CommonObject
 }
@@ -0,0 +1,57 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// waitForApproval stops the dry-run Nuke after all KCP and provider resources are discovered and
+// listed in the status, and keeps refreshing that inventory until the deletion is approved.
+func waitForApproval(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	nuke := state.ObjAsNuke()
+
+	if !nuke.IsApprovalPending() {
+		cond := meta.FindStatusCondition(nuke.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
+		if cond == nil || cond.Reason != cloudcontrolv1beta1.ReasonAwaitingApproval {
+			return nil, ctx
+		}
+		return composed.PatchStatus(nuke).
+			RemoveConditionIfReasonMatched(cloudcontrolv1beta1.ConditionTypeReady, cloudcontrolv1beta1.ReasonAwaitingApproval).
+			ErrorLogMessage("Error patching KCP Nuke status after deletion was approved").
+			SuccessErrorNil().
+			Run(ctx, state)
+	}
+
+	count := 0
+	for _, sk := range nuke.Status.Resources {
+		count += len(sk.Objects)
+	}
+	message := fmt.Sprintf("Discovered %d resources, set spec.approved to delete them", count)
+
+	cond := meta.FindStatusCondition(nuke.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
+	if nuke.Status.State == "AwaitingApproval" && cond != nil && cond.Reason == cloudcontrolv1beta1.ReasonAwaitingApproval && cond.Message == message {
+		composed.LoggerFromCtx(ctx).Info("Nuke dry-run is waiting for approval")
+		return composed.StopWithRequeueDelay(util.Timing.T300000ms()), nil
+	}
+
+	nuke.Status.State = "AwaitingApproval"
+
+	return composed.PatchStatus(nuke).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  cloudcontrolv1beta1.ReasonAwaitingApproval,
+			Message: message,
+		}).
+		ErrorLogMessage("Error patching KCP Nuke status with awaiting approval").
+		SuccessLogMsg("Nuke dry-run completed discovery, waiting for approval").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T300000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,87 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awspostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/postgresinstance"
+	azurepostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance"
+	gcppostgresinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type PostgresInstanceReconciler interface {
+	reconcile.Reconciler
+}
+
+type postgresInstanceReconciler struct {
+	composedStateFactory composed.StateFactory
+	focalStateFactory    focal.StateFactory
+
+	awsStateFactory   awspostgresinstance.StateFactory
+	gcpStateFactory   gcppostgresinstance.StateFactory
+	azureStateFactory azurepostgresinstance.StateFactory
+}
+
+func NewPostgresInstanceReconciler(
+	composedStateFactory composed.StateFactory,
+	focalStateFactory focal.StateFactory,
+	awsStateFactory awspostgresinstance.StateFactory,
+	gcpStateFactory gcppostgresinstance.StateFactory,
+	azureStateFactory azurepostgresinstance.StateFactory,
+) PostgresInstanceReconciler {
+	return &postgresInstanceReconciler{
+		composedStateFactory: composedStateFactory,
+		focalStateFactory:    focalStateFactory,
+		awsStateFactory:      awsStateFactory,
+		gcpStateFactory:      gcpStateFactory,
+		azureStateFactory:    azureStateFactory,
+	}
+}
+
+func (r *postgresInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if Ignore.ShouldIgnoreKey(req) {
+		return ctrl.Result{}, nil
+	}
+
+	state := r.newFocalState(req.NamespacedName)
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("postgresinstance", util.RequestObjToString(req)).
+		Handle(action(ctx, state))
+}
+
+func (r *postgresInstanceReconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"main",
+		feature.LoadFeatureContextFromObj(&cloudcontrolv1beta1.PostgresInstance{}),
+		focal.New(),
+		func(ctx context.Context, st composed.State) (error, context.Context) {
+			return composed.ComposeActions(
+				"postgresInstanceCommon",
+				loadIpRange,
+				composed.BuildSwitchAction(
+					"providerSwitch",
+					nil,
+					composed.NewCase(statewithscope.AwsProviderPredicate, awspostgresinstance.New(r.awsStateFactory)),
+					composed.NewCase(statewithscope.GcpProviderPredicate, gcppostgresinstance.New(r.gcpStateFactory)),
+					composed.NewCase(statewithscope.AzureProviderPredicate, azurepostgresinstance.New(r.azureStateFactory)),
+				),
+			)(ctx, newState(st.(focal.State)))
+		},
+	)
+}
+
+func (r *postgresInstanceReconciler) newFocalState(name types.NamespacedName) focal.State {
+	return r.focalStateFactory.NewState(
+		r.composedStateFactory.NewState(name, &cloudcontrolv1beta1.PostgresInstance{}),
+	)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+)
+
+type State interface {
+	focal.State
+	ObjAsPostgresInstance() *v1beta1.PostgresInstance
+
+	IpRange() *v1beta1.IpRange
+	SetIpRange(r *v1beta1.IpRange)
+}
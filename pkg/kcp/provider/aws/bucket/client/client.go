@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+)
+
+func NewClientProvider() awsclient.SkrClientProvider[Client] {
+	return func(ctx context.Context, account, region, key, secret, role string) (Client, error) {
+		cfg, err := awsclient.NewSkrConfig(ctx, region, key, secret, role)
+		if err != nil {
+			return nil, err
+		}
+		return newClient(
+			awsclient.NewEc2Client(ec2.NewFromConfig(cfg)),
+			awsclient.NewS3Client(s3.NewFromConfig(cfg)),
+			awsclient.NewIamClient(iam.NewFromConfig(cfg)),
+		), nil
+	}
+}
+
+type Client interface {
+	DescribeVpcs(ctx context.Context, name string) ([]ec2types.Vpc, error)
+	DescribeRouteTables(ctx context.Context, vpcId string) ([]ec2types.RouteTable, error)
+	DescribeVpcEndpoints(ctx context.Context, vpcId, serviceName string) ([]ec2types.VpcEndpoint, error)
+	CreateVpcGatewayEndpoint(ctx context.Context, vpcId, serviceName string, routeTableIds []string, tags []ec2types.Tag) (*ec2types.VpcEndpoint, error)
+	DeleteVpcEndpoint(ctx context.Context, vpcEndpointId string) error
+
+	awsclient.S3Client
+	awsclient.IamClient
+}
+
+func newClient(ec2Client awsclient.Ec2Client, s3Client awsclient.S3Client, iamClient awsclient.IamClient) Client {
+	return &client{
+		Ec2Client: ec2Client,
+		S3Client:  s3Client,
+		IamClient: iamClient,
+	}
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	awsclient.Ec2Client
+	awsclient.S3Client
+	awsclient.IamClient
+}
@@ -0,0 +1,57 @@
+package bucket
+
+import (
+	"context"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+// createAccessKey creates the IAM user access key and saves it in the status, since the secret
+// access key can be obtained only at creation. If the key saved in the status does not exist any
+// more, all existing keys of the user are deleted and a new one is created.
+func createAccessKey(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	bucket := state.ObjAsBucket()
+
+	keyExists := pie.Any(state.accessKeys, func(k iamtypes.AccessKeyMetadata) bool {
+		return ptr.Deref(k.AccessKeyId, "") == bucket.Status.AccessKeyId
+	})
+	if keyExists && bucket.Status.SecretAccessKey != "" {
+		return nil, ctx
+	}
+
+	for _, k := range state.accessKeys {
+		err := state.awsClient.DeleteIamAccessKey(ctx, state.IamUserName(), ptr.Deref(k.AccessKeyId, ""))
+		if awsmeta.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return awsmeta.LogErrorAndReturn(err, "Error deleting unknown IAM access key", ctx)
+		}
+	}
+
+	key, err := state.awsClient.CreateIamAccessKey(ctx, state.IamUserName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating IAM access key", ctx)
+	}
+
+	logger.
+		WithValues("accessKeyId", ptr.Deref(key.AccessKeyId, "")).
+		Info("IAM access key created")
+
+	bucket.Status.AccessKeyId = ptr.Deref(key.AccessKeyId, "")
+	bucket.Status.SecretAccessKey = ptr.Deref(key.SecretAccessKey, "")
+
+	err = state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating Bucket status with IAM access key", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,52 @@
+package bucket
+
+import (
+	"context"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func createIamUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.iamUser != nil {
+		return nil, ctx
+	}
+
+	bucket := state.ObjAsBucket()
+
+	user, err := state.awsClient.CreateIamUser(ctx, state.IamUserName(), []iamtypes.Tag{
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(bucket.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(bucket.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating IAM user", ctx)
+	}
+
+	logger.
+		WithValues("iamUser", state.IamUserName()).
+		Info("IAM user created")
+
+	state.iamUser = user
+
+	return nil, ctx
+}
@@ -0,0 +1,63 @@
+package bucket
+
+import (
+	"context"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func createS3Bucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.bucketExists {
+		return nil, ctx
+	}
+
+	bucket := state.ObjAsBucket()
+	objectLockEnabled := bucket.Spec.Bucket.Aws.RetentionDays > 0
+
+	err := state.awsClient.CreateS3Bucket(ctx, state.BucketName(), state.Scope().Spec.Region, objectLockEnabled)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating S3 bucket", ctx)
+	}
+
+	err = state.awsClient.PutS3PublicAccessBlock(ctx, state.BucketName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error blocking public access to S3 bucket", ctx)
+	}
+
+	err = state.awsClient.PutS3BucketTagging(ctx, state.BucketName(), []s3types.Tag{
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(bucket.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(bucket.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error tagging S3 bucket", ctx)
+	}
+
+	logger.
+		WithValues("s3Bucket", state.BucketName()).
+		Info("S3 bucket created")
+
+	state.bucketExists = true
+
+	return nil, ctx
+}
@@ -0,0 +1,58 @@
+package bucket
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+// createVpcEndpoint creates the S3 gateway endpoint in the kyma VPC. The endpoint is shared
+// by all buckets of the scope.
+func createVpcEndpoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.vpcEndpoint != nil {
+		return nil, ctx
+	}
+
+	vpcId := ptr.Deref(state.vpc.VpcId, "")
+	routeTables, err := state.awsClient.DescribeRouteTables(ctx, vpcId)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading VPC route tables", ctx)
+	}
+	routeTableIds := pie.Map(routeTables, func(rt ec2types.RouteTable) string {
+		return ptr.Deref(rt.RouteTableId, "")
+	})
+
+	ep, err := state.awsClient.CreateVpcGatewayEndpoint(ctx, vpcId, GetAwsS3ServiceName(state.Scope().Spec.Region), routeTableIds, []ec2types.Tag{
+		{
+			Key:   new("Name"),
+			Value: new(state.Scope().Spec.Scope.Aws.VpcNetwork + "-s3"),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(state.Scope().Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating S3 VPC endpoint", ctx)
+	}
+
+	logger.
+		WithValues("vpcEndpointId", ptr.Deref(ep.VpcEndpointId, "")).
+		Info("S3 VPC endpoint created")
+
+	state.vpcEndpoint = ep
+
+	return nil, ctx
+}
@@ -0,0 +1,44 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func deleteIamUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.iamUser == nil {
+		return nil, ctx
+	}
+
+	for _, k := range state.accessKeys {
+		err := state.awsClient.DeleteIamAccessKey(ctx, state.IamUserName(), ptr.Deref(k.AccessKeyId, ""))
+		if err != nil && !awsmeta.IsNotFound(err) {
+			return awsmeta.LogErrorAndReturn(err, "Error deleting IAM access key", ctx)
+		}
+	}
+
+	err := state.awsClient.DeleteIamUserPolicy(ctx, state.IamUserName(), iamUserPolicyName)
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting IAM user policy", ctx)
+	}
+
+	logger.
+		WithValues("iamUser", state.IamUserName()).
+		Info("Deleting IAM user")
+
+	err = state.awsClient.DeleteIamUser(ctx, state.IamUserName())
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting IAM user", ctx)
+	}
+
+	state.iamUser = nil
+	state.accessKeys = nil
+
+	return nil, ctx
+}
@@ -0,0 +1,35 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func deleteS3Bucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if !state.bucketExists {
+		return nil, ctx
+	}
+
+	logger.
+		WithValues("s3Bucket", state.BucketName()).
+		Info("Emptying and deleting S3 bucket")
+
+	err := state.awsClient.EmptyS3Bucket(ctx, state.BucketName())
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error emptying S3 bucket", ctx)
+	}
+
+	err = state.awsClient.DeleteS3Bucket(ctx, state.BucketName())
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting S3 bucket", ctx)
+	}
+
+	state.bucketExists = false
+
+	return nil, ctx
+}
@@ -0,0 +1,53 @@
+package bucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// deleteVpcEndpoint deletes the shared S3 gateway endpoint when the last AWS Bucket of the scope
+// is deleted. Endpoints not created by cloud-manager are left intact.
+func deleteVpcEndpoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.vpcEndpoint == nil {
+		return nil, ctx
+	}
+	if awsutil.GetEc2TagValue(state.vpcEndpoint.Tags, common.TagScope) != state.Scope().Name {
+		return nil, ctx
+	}
+
+	list := &cloudcontrolv1beta1.BucketList{}
+	err := state.Cluster().K8sClient().List(ctx, list, client.InNamespace(state.Obj().GetNamespace()))
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing Buckets", composed.StopWithRequeue, ctx)
+	}
+	for _, b := range list.Items {
+		if b.Name == state.Obj().GetName() || !b.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if b.Spec.Scope.Name == state.Scope().Name && b.Spec.Bucket.Aws != nil {
+			logger.Info("S3 VPC endpoint is still used by other buckets")
+			return nil, ctx
+		}
+	}
+
+	logger.
+		WithValues("vpcEndpointId", ptr.Deref(state.vpcEndpoint.VpcEndpointId, "")).
+		Info("Deleting S3 VPC endpoint")
+
+	err = state.awsClient.DeleteVpcEndpoint(ctx, ptr.Deref(state.vpcEndpoint.VpcEndpointId, ""))
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting S3 VPC endpoint", ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,31 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func loadIamUser(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	user, err := state.awsClient.GetIamUser(ctx, state.IamUserName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading IAM user", ctx)
+	}
+	if user == nil {
+		return nil, ctx
+	}
+
+	state.iamUser = user
+
+	accessKeys, err := state.awsClient.ListIamAccessKeys(ctx, state.IamUserName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading IAM user access keys", ctx)
+	}
+
+	state.accessKeys = accessKeys
+
+	return nil, ctx
+}
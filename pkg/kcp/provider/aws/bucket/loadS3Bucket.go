@@ -0,0 +1,21 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func loadS3Bucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	exists, err := state.awsClient.S3BucketExists(ctx, state.BucketName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading S3 bucket", ctx)
+	}
+
+	state.bucketExists = exists
+
+	return nil, ctx
+}
@@ -0,0 +1,61 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func loadVpc(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	vpcNetworkName := state.Scope().Spec.Scope.Aws.VpcNetwork
+
+	vpcList, err := state.awsClient.DescribeVpcs(ctx, vpcNetworkName)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading AWS VPC Networks", ctx)
+	}
+
+	var vpc *ec2types.Vpc
+	for _, v := range vpcList {
+		if awsutil.NameEc2TagEquals(v.Tags, vpcNetworkName) {
+			vpc = &v
+			break
+		}
+	}
+
+	if vpc == nil {
+		logger.WithValues("vpcName", vpcNetworkName).Info("VPC not found")
+
+		if composed.MarkedForDeletionPredicate(ctx, state) {
+			// VPC endpoint can not exist without the VPC, continue deleting other resources
+			return nil, ctx
+		}
+
+		state.ObjAsBucket().SetStatusStateToError()
+		return composed.PatchStatus(state.ObjAsBucket()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonVpcNotFound,
+				Message: fmt.Sprintf("AWS VPC %s not found", vpcNetworkName),
+			}).
+			ErrorLogMessage("Error patching KCP Bucket status with VPC not found").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.vpc = vpc
+
+	logger = logger.WithValues("vpcId", ptr.Deref(vpc.VpcId, ""))
+
+	return nil, composed.LoggerIntoCtx(ctx, logger)
+}
@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func loadVpcEndpoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.vpc == nil {
+		return nil, ctx
+	}
+
+	list, err := state.awsClient.DescribeVpcEndpoints(ctx, ptr.Deref(state.vpc.VpcId, ""), GetAwsS3ServiceName(state.Scope().Spec.Region))
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading S3 VPC endpoint", ctx)
+	}
+
+	if len(list) == 0 {
+		return nil, ctx
+	}
+
+	state.vpcEndpoint = &list[0]
+
+	logger = logger.WithValues("vpcEndpointId", ptr.Deref(state.vpcEndpoint.VpcEndpointId, ""))
+
+	return nil, composed.LoggerIntoCtx(ctx, logger)
+}
@@ -0,0 +1,37 @@
+package bucket
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func modifyBucketPolicy(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	current, err := state.awsClient.GetS3BucketPolicy(ctx, state.BucketName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading S3 bucket policy", ctx)
+	}
+
+	userArn := ptr.Deref(state.iamUser.Arn, "")
+	vpcEndpointId := ptr.Deref(state.vpcEndpoint.VpcEndpointId, "")
+
+	// AWS normalizes the policy document, so only the values that can change are compared
+	if strings.Contains(current, userArn) && strings.Contains(current, vpcEndpointId) {
+		return nil, ctx
+	}
+
+	logger.Info("Putting S3 bucket policy")
+
+	err = state.awsClient.PutS3BucketPolicy(ctx, state.BucketName(), getBucketPolicyDocument(state.BucketName(), userArn, vpcEndpointId))
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error putting S3 bucket policy", ctx)
+	}
+
+	return nil, ctx
+}
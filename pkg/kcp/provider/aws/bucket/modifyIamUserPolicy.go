@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func modifyIamUserPolicy(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	current, err := state.awsClient.GetIamUserPolicy(ctx, state.IamUserName(), iamUserPolicyName)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading IAM user policy", ctx)
+	}
+
+	desired := getIamUserPolicyDocument(state.BucketName())
+	// IAM returns the policy document URL encoded, so it's not compared, only its presence
+	if current != "" {
+		return nil, ctx
+	}
+
+	logger.Info("Putting IAM user policy")
+
+	err = state.awsClient.PutIamUserPolicy(ctx, state.IamUserName(), iamUserPolicyName, desired)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error putting IAM user policy", ctx)
+	}
+
+	return nil, ctx
+}
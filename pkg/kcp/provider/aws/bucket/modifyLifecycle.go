@@ -0,0 +1,34 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func modifyLifecycle(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	current, err := state.awsClient.GetS3BucketLifecycleRules(ctx, state.BucketName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading S3 bucket lifecycle rules", ctx)
+	}
+
+	desired := getLifecycleRules(state.ObjAsBucket().Spec.Bucket.Aws.Lifecycle)
+	if lifecycleRulesEqual(current, desired) {
+		return nil, ctx
+	}
+
+	logger.
+		WithValues("lifecycleRules", len(desired)).
+		Info("Modifying S3 bucket lifecycle rules")
+
+	err = state.awsClient.PutS3BucketLifecycleRules(ctx, state.BucketName(), desired)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error modifying S3 bucket lifecycle rules", ctx)
+	}
+
+	return nil, ctx
+}
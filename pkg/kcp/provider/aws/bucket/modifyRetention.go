@@ -0,0 +1,43 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func modifyRetention(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	cfg, err := state.awsClient.GetS3ObjectLockConfiguration(ctx, state.BucketName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading S3 bucket object lock configuration", ctx)
+	}
+
+	var current int32
+	if cfg != nil && cfg.Rule != nil && cfg.Rule.DefaultRetention != nil {
+		current = ptr.Deref(cfg.Rule.DefaultRetention.Days, 0)
+	}
+
+	desired := state.ObjAsBucket().Spec.Bucket.Aws.RetentionDays
+	if current == desired {
+		return nil, ctx
+	}
+	if cfg == nil && desired == 0 {
+		return nil, ctx
+	}
+
+	logger.
+		WithValues("retentionDays", desired).
+		Info("Modifying S3 bucket default retention")
+
+	err = state.awsClient.PutS3ObjectLockRetentionDays(ctx, state.BucketName(), desired)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error modifying S3 bucket default retention", ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,66 @@
+package bucket
+
+import (
+	"context"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func modifyVersioning(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	current, err := state.awsClient.GetS3BucketVersioning(ctx, state.BucketName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading S3 bucket versioning", ctx)
+	}
+
+	var desired s3types.BucketVersioningStatus
+	if state.ObjAsBucket().Spec.Bucket.Aws.Versioning {
+		desired = s3types.BucketVersioningStatusEnabled
+	} else if current == s3types.BucketVersioningStatusEnabled {
+		// once enabled, versioning can only be suspended
+		desired = s3types.BucketVersioningStatusSuspended
+	} else {
+		return nil, ctx
+	}
+
+	if current == desired {
+		return nil, ctx
+	}
+
+	if desired == s3types.BucketVersioningStatusSuspended {
+		lockCfg, err := state.awsClient.GetS3ObjectLockConfiguration(ctx, state.BucketName())
+		if err != nil {
+			return awsmeta.LogErrorAndReturn(err, "Error loading S3 bucket object lock configuration", ctx)
+		}
+		if lockCfg != nil {
+			state.ObjAsBucket().SetStatusStateToError()
+			return composed.PatchStatus(state.ObjAsBucket()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  cloudcontrolv1beta1.ReasonInvalidSpec,
+					Message: "Versioning can not be disabled on a bucket with object lock enabled",
+				}).
+				ErrorLogMessage("Error patching KCP Bucket status with versioning error").
+				SuccessError(composed.StopAndForget).
+				Run(ctx, state)
+		}
+	}
+
+	logger.
+		WithValues("versioning", desired).
+		Info("Modifying S3 bucket versioning")
+
+	err = state.awsClient.PutS3BucketVersioning(ctx, state.BucketName(), desired)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error modifying S3 bucket versioning", ctx)
+	}
+
+	return nil, ctx
+}
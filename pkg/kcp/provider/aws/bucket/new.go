@@ -0,0 +1,59 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/bucket/types"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		logger := composed.LoggerFromCtx(ctx)
+		bucketState := st.(types.State)
+		state, err := stateFactory.NewState(ctx, bucketState)
+		if err != nil {
+			err = fmt.Errorf("error creating new aws bucket state: %w", err)
+			logger.Error(err, "Error")
+			return composed.StopAndForget, nil
+		}
+
+		return composed.ComposeActions(
+			"awsBucket",
+			actions.AddCommonFinalizer(),
+			loadVpc,
+			loadVpcEndpoint,
+			loadS3Bucket,
+			loadIamUser,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"bucket-create",
+					createVpcEndpoint,
+					createS3Bucket,
+					updateStatusId,
+					modifyVersioning,
+					modifyRetention,
+					modifyLifecycle,
+					createIamUser,
+					modifyIamUserPolicy,
+					createAccessKey,
+					modifyBucketPolicy,
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"bucket-delete",
+					removeReadyCondition,
+					deleteIamUser,
+					deleteS3Bucket,
+					deleteVpcEndpoint,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(awsmeta.SetAwsAccountId(ctx, bucketState.Scope().Spec.Scope.Aws.AccountId), state)
+	}
+}
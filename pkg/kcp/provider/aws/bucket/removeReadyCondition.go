@@ -0,0 +1,32 @@
+package bucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+func removeReadyCondition(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	bucket := state.ObjAsBucket()
+
+	readyCond := meta.FindStatusCondition(*bucket.Conditions(), cloudcontrolv1beta1.ConditionTypeReady)
+	if readyCond == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Removing Ready condition")
+
+	meta.RemoveStatusCondition(bucket.Conditions(), cloudcontrolv1beta1.ConditionTypeReady)
+	bucket.Status.State = cloudcontrolv1beta1.StateDeleting
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating Bucket status after removing Ready condition", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,80 @@
+package bucket
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/bucket/types"
+	awsbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket/client"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+)
+
+type State struct {
+	types.State
+	awsClient awsbucketclient.Client
+
+	vpc          *ec2types.Vpc
+	vpcEndpoint  *ec2types.VpcEndpoint
+	bucketExists bool
+	iamUser      *iamtypes.User
+	accessKeys   []iamtypes.AccessKeyMetadata
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, bucketState types.State) (*State, error)
+}
+
+func NewStateFactory(skrProvider awsclient.SkrClientProvider[awsbucketclient.Client]) StateFactory {
+	return &stateFactory{
+		skrProvider: skrProvider,
+	}
+}
+
+type stateFactory struct {
+	skrProvider awsclient.SkrClientProvider[awsbucketclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, bucketState types.State) (*State, error) {
+	roleName := awsutil.RoleArnDefault(bucketState.Scope().Spec.Scope.Aws.AccountId)
+
+	logger := composed.LoggerFromCtx(ctx)
+	logger.
+		WithValues(
+			"awsRegion", bucketState.Scope().Spec.Region,
+			"awsRole", roleName,
+		).
+		Info("Assuming AWS role")
+
+	c, err := f.skrProvider(
+		ctx,
+		bucketState.Scope().Spec.Scope.Aws.AccountId,
+		bucketState.Scope().Spec.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		roleName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(bucketState, c), nil
+}
+
+func newState(bucketState types.State, awsClient awsbucketclient.Client) *State {
+	return &State{
+		State:     bucketState,
+		awsClient: awsClient,
+	}
+}
+
+func (s *State) BucketName() string {
+	return GetAwsS3BucketName(s.Obj().GetName())
+}
+
+func (s *State) IamUserName() string {
+	return GetAwsIamUserName(s.Obj().GetName())
+}
@@ -0,0 +1,26 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func updateStatusId(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	bucket := state.ObjAsBucket()
+
+	if bucket.Status.Id != "" { // already set
+		return nil, ctx
+	}
+
+	bucket.Status.Id = state.BucketName()
+
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating Bucket .status.id", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,149 @@
+package bucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"k8s.io/utils/ptr"
+)
+
+const iamUserPolicyName = "cm-bucket-access"
+
+func GetAwsS3BucketName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+func GetAwsIamUserName(name string) string {
+	return fmt.Sprintf("cm-bucket-%s", name)
+}
+
+func GetAwsS3ServiceName(region string) string {
+	return fmt.Sprintf("com.amazonaws.%s.s3", region)
+}
+
+func GetAwsS3Endpoint(region string) string {
+	return fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+}
+
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Sid       string                       `json:"Sid,omitempty"`
+	Effect    string                       `json:"Effect"`
+	Principal map[string]string            `json:"Principal,omitempty"`
+	Action    []string                     `json:"Action"`
+	Resource  []string                     `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition,omitempty"`
+}
+
+func toJson(doc policyDocument) string {
+	b, _ := json.Marshal(doc)
+	return string(b)
+}
+
+// getIamUserPolicyDocument allows the bucket IAM user to work with objects of its own bucket only
+func getIamUserPolicyDocument(bucketName string) string {
+	bucketArn := awsutil.S3BucketArn(bucketName)
+	return toJson(policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{
+				Sid:      "BucketAccess",
+				Effect:   "Allow",
+				Action:   []string{"s3:ListBucket", "s3:ListBucketVersions", "s3:ListBucketMultipartUploads", "s3:GetBucketLocation"},
+				Resource: []string{bucketArn},
+			},
+			{
+				Sid:      "ObjectAccess",
+				Effect:   "Allow",
+				Action:   []string{"s3:GetObject", "s3:GetObjectVersion", "s3:PutObject", "s3:DeleteObject", "s3:DeleteObjectVersion", "s3:AbortMultipartUpload", "s3:ListMultipartUploadParts"},
+				Resource: []string{bucketArn + "/*"},
+			},
+		},
+	})
+}
+
+// getBucketPolicyDocument denies all requests of the bucket IAM user that do not come
+// through the S3 gateway endpoint of the kyma VPC
+func getBucketPolicyDocument(bucketName, iamUserArn, vpcEndpointId string) string {
+	bucketArn := awsutil.S3BucketArn(bucketName)
+	return toJson(policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{
+				Sid:       "DenyOutsideOfVpc",
+				Effect:    "Deny",
+				Principal: map[string]string{"AWS": iamUserArn},
+				Action:    []string{"s3:*"},
+				Resource:  []string{bucketArn, bucketArn + "/*"},
+				Condition: map[string]map[string]string{
+					"StringNotEquals": {
+						"aws:SourceVpce": vpcEndpointId,
+					},
+				},
+			},
+		},
+	})
+}
+
+func getLifecycleRules(rules []cloudcontrolv1beta1.BucketLifecycleRule) []s3types.LifecycleRule {
+	var result []s3types.LifecycleRule
+	for i, r := range rules {
+		rule := s3types.LifecycleRule{
+			ID:     new(fmt.Sprintf("cm-rule-%d", i)),
+			Status: s3types.ExpirationStatusEnabled,
+			Filter: &s3types.LifecycleRuleFilter{
+				Prefix: new(r.Prefix),
+			},
+		}
+		if r.ExpirationDays > 0 {
+			rule.Expiration = &s3types.LifecycleExpiration{
+				Days: new(r.ExpirationDays),
+			}
+		}
+		if r.NoncurrentVersionExpirationDays > 0 {
+			rule.NoncurrentVersionExpiration = &s3types.NoncurrentVersionExpiration{
+				NoncurrentDays: new(r.NoncurrentVersionExpirationDays),
+			}
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func lifecycleRuleKey(r s3types.LifecycleRule) string {
+	prefix := ""
+	if r.Filter != nil {
+		prefix = ptr.Deref(r.Filter.Prefix, "")
+	}
+	var expiration, noncurrent int32
+	if r.Expiration != nil {
+		expiration = ptr.Deref(r.Expiration.Days, 0)
+	}
+	if r.NoncurrentVersionExpiration != nil {
+		noncurrent = ptr.Deref(r.NoncurrentVersionExpiration.NoncurrentDays, 0)
+	}
+	return fmt.Sprintf("%s|%s|%s|%d|%d", ptr.Deref(r.ID, ""), r.Status, prefix, expiration, noncurrent)
+}
+
+func lifecycleRulesEqual(a, b []s3types.LifecycleRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ka := make([]string, 0, len(a))
+	kb := make([]string, 0, len(b))
+	for i := range a {
+		ka = append(ka, lifecycleRuleKey(a[i]))
+		kb = append(kb, lifecycleRuleKey(b[i]))
+	}
+	slices.Sort(ka)
+	slices.Sort(kb)
+	return slices.Equal(ka, kb)
+}
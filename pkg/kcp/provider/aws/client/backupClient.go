@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"k8s.io/utils/ptr"
+)
+
+type BackupClient interface {
+	GetRecoveryPointRestoreMetadata(ctx context.Context, accountId, backupVaultName, recoveryPointArn string) (map[string]string, error)
+	// StartEfsRestoreJob starts the restore of the EFS recovery point and returns the restore job id
+	StartEfsRestoreJob(ctx context.Context, recoveryPointArn, iamRoleArn, idempotencyToken string, metadata map[string]string) (string, error)
+	DescribeRestoreJob(ctx context.Context, restoreJobId string) (*backup.DescribeRestoreJobOutput, error)
+}
+
+func NewBackupClient(svc *backup.Client) BackupClient {
+	return &backupClient{svc: svc}
+}
+
+var _ BackupClient = (*backupClient)(nil)
+
+type backupClient struct {
+	svc *backup.Client
+}
+
+func (c *backupClient) GetRecoveryPointRestoreMetadata(ctx context.Context, accountId, backupVaultName, recoveryPointArn string) (map[string]string, error) {
+	out, err := c.svc.GetRecoveryPointRestoreMetadata(ctx, &backup.GetRecoveryPointRestoreMetadataInput{
+		BackupVaultAccountId: new(accountId),
+		BackupVaultName:      new(backupVaultName),
+		RecoveryPointArn:     new(recoveryPointArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.RestoreMetadata, nil
+}
+
+func (c *backupClient) StartEfsRestoreJob(ctx context.Context, recoveryPointArn, iamRoleArn, idempotencyToken string, metadata map[string]string) (string, error) {
+	out, err := c.svc.StartRestoreJob(ctx, &backup.StartRestoreJobInput{
+		IamRoleArn:       new(iamRoleArn),
+		IdempotencyToken: new(idempotencyToken),
+		RecoveryPointArn: new(recoveryPointArn),
+		ResourceType:     new("EFS"),
+		Metadata:         metadata,
+	})
+	if err != nil {
+		return "", err
+	}
+	return ptr.Deref(out.RestoreJobId, ""), nil
+}
+
+func (c *backupClient) DescribeRestoreJob(ctx context.Context, restoreJobId string) (*backup.DescribeRestoreJobOutput, error) {
+	return c.svc.DescribeRestoreJob(ctx, &backup.DescribeRestoreJobInput{
+		RestoreJobId: new(restoreJobId),
+	})
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -41,6 +42,13 @@ type Ec2Client interface {
 	CreateSecurityGroup(ctx context.Context, vpcId, name string, tags []ec2types.Tag) (string, error)
 	DeleteSecurityGroup(ctx context.Context, id string) error
 	AuthorizeSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error
+	RevokeSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error
+	RevokeSecurityGroupEgress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error
+
+	DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error)
+	// CreateS3FlowLogs creates a flow log for all traffic of the VPC delivered to the S3 bucket with the given ARN
+	CreateS3FlowLogs(ctx context.Context, vpcId, bucketArn string, tags []ec2types.Tag) (string, error)
+	DeleteFlowLogs(ctx context.Context, flowLogIds []string) error
 
 	CreateVpcPeeringConnection(ctx context.Context, vpcId, remoteVpcId, remoteRegion, remoteAccountId *string, tag []ec2types.Tag) (*ec2types.VpcPeeringConnection, error)
 	DescribeVpcPeeringConnection(ctx context.Context, vpcPeeringConnectionId string) (*ec2types.VpcPeeringConnection, error)
@@ -50,6 +58,10 @@ type Ec2Client interface {
 	DescribeRouteTables(ctc context.Context, vpcId string) ([]ec2types.RouteTable, error)
 	CreateRoute(ctx context.Context, routeTableId, destinationCidrBlock, vpcPeeringConnectionId *string) error
 	DeleteRoute(ctx context.Context, routeTableId, destinationCidrBlock *string) error
+
+	DescribeVpcEndpoints(ctx context.Context, vpcId, serviceName string) ([]ec2types.VpcEndpoint, error)
+	CreateVpcGatewayEndpoint(ctx context.Context, vpcId, serviceName string, routeTableIds []string, tags []ec2types.Tag) (*ec2types.VpcEndpoint, error)
+	DeleteVpcEndpoint(ctx context.Context, vpcEndpointId string) error
 }
 
 func NewEc2Client(svc *ec2.Client) Ec2Client {
@@ -436,6 +448,76 @@ func (c *ec2Client) AuthorizeSecurityGroupIngress(ctx context.Context, groupId s
 	return nil
 }
 
+func (c *ec2Client) RevokeSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
+	_, err := c.svc.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+		GroupId:       new(groupId),
+		IpPermissions: ipPermissions,
+	})
+	return err
+}
+
+func (c *ec2Client) RevokeSecurityGroupEgress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
+	_, err := c.svc.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+		GroupId:       new(groupId),
+		IpPermissions: ipPermissions,
+	})
+	return err
+}
+
+func (c *ec2Client) DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error) {
+	out, err := c.svc.DescribeFlowLogs(ctx, &ec2.DescribeFlowLogsInput{
+		Filter: []ec2types.Filter{
+			{
+				Name:   new("resource-id"),
+				Values: []string{resourceId},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.FlowLogs, nil
+}
+
+func (c *ec2Client) CreateS3FlowLogs(ctx context.Context, vpcId, bucketArn string, tags []ec2types.Tag) (string, error) {
+	out, err := c.svc.CreateFlowLogs(ctx, &ec2.CreateFlowLogsInput{
+		ResourceIds:        []string{vpcId},
+		ResourceType:       ec2types.FlowLogsResourceTypeVpc,
+		TrafficType:        ec2types.TrafficTypeAll,
+		LogDestinationType: ec2types.LogDestinationTypeS3,
+		LogDestination:     new(bucketArn),
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeVpcFlowLog,
+				Tags:         tags,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		return "", fmt.Errorf("error creating flow logs for vpc %s: %s", vpcId, ptr.Deref(out.Unsuccessful[0].Error.Message, ""))
+	}
+	if len(out.FlowLogIds) == 0 {
+		return "", nil
+	}
+	return out.FlowLogIds[0], nil
+}
+
+func (c *ec2Client) DeleteFlowLogs(ctx context.Context, flowLogIds []string) error {
+	out, err := c.svc.DeleteFlowLogs(ctx, &ec2.DeleteFlowLogsInput{
+		FlowLogIds: flowLogIds,
+	})
+	if err != nil {
+		return err
+	}
+	if len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		return fmt.Errorf("error deleting flow logs %s: %s", ptr.Deref(out.Unsuccessful[0].ResourceId, ""), ptr.Deref(out.Unsuccessful[0].Error.Message, ""))
+	}
+	return nil
+}
+
 func (c *ec2Client) CreateVpcPeeringConnection(ctx context.Context, vpcId, remoteVpcId, remoteRegion, remoteAccountId *string, tags []ec2types.Tag) (*ec2types.VpcPeeringConnection, error) {
 	out, err := c.svc.CreateVpcPeeringConnection(ctx, &ec2.CreateVpcPeeringConnectionInput{
 		VpcId:       vpcId,
@@ -537,3 +619,52 @@ func (c *ec2Client) DeleteRoute(ctx context.Context, routeTableId, destinationCi
 	})
 	return err
 }
+
+func (c *ec2Client) DescribeVpcEndpoints(ctx context.Context, vpcId, serviceName string) ([]ec2types.VpcEndpoint, error) {
+	out, err := c.svc.DescribeVpcEndpoints(ctx, &ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   new("vpc-id"),
+				Values: []string{vpcId},
+			},
+			{
+				Name:   new("service-name"),
+				Values: []string{serviceName},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pie.Filter(out.VpcEndpoints, func(ep ec2types.VpcEndpoint) bool {
+		// the API reports endpoint states in lower case, while the enum values are capitalized
+		return !strings.EqualFold(string(ep.State), string(ec2types.StateDeleted)) &&
+			!strings.EqualFold(string(ep.State), string(ec2types.StateDeleting))
+	}), nil
+}
+
+func (c *ec2Client) CreateVpcGatewayEndpoint(ctx context.Context, vpcId, serviceName string, routeTableIds []string, tags []ec2types.Tag) (*ec2types.VpcEndpoint, error) {
+	out, err := c.svc.CreateVpcEndpoint(ctx, &ec2.CreateVpcEndpointInput{
+		VpcId:           new(vpcId),
+		ServiceName:     new(serviceName),
+		VpcEndpointType: ec2types.VpcEndpointTypeGateway,
+		RouteTableIds:   routeTableIds,
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeVpcEndpoint,
+				Tags:         tags,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.VpcEndpoint, nil
+}
+
+func (c *ec2Client) DeleteVpcEndpoint(ctx context.Context, vpcEndpointId string) error {
+	_, err := c.svc.DeleteVpcEndpoints(ctx, &ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: []string{vpcEndpointId},
+	})
+	return err
+}
@@ -18,6 +18,7 @@ type EfsClient interface {
 		tags []efstypes.Tag,
 	) (*efs.CreateFileSystemOutput, error)
 	DeleteFileSystem(ctx context.Context, fsId string) error
+	TagFileSystem(ctx context.Context, fsId string, tags []efstypes.Tag) error
 	DescribeMountTargets(ctx context.Context, fsId string) ([]efstypes.MountTargetDescription, error)
 	CreateMountTarget(ctx context.Context, fsId, subnetId string, securityGroups []string) (string, error)
 	DeleteMountTarget(ctx context.Context, mountTargetId string) error
@@ -64,6 +65,15 @@ func (c *efsClient) DeleteFileSystem(ctx context.Context, fsId string) error {
 	return err
 }
 
+func (c *efsClient) TagFileSystem(ctx context.Context, fsId string, tags []efstypes.Tag) error {
+	in := &efs.TagResourceInput{
+		ResourceId: new(fsId),
+		Tags:       tags,
+	}
+	_, err := c.svc.TagResource(ctx, in)
+	return err
+}
+
 func (c *efsClient) DescribeMountTargets(ctx context.Context, fsId string) ([]efstypes.MountTargetDescription, error) {
 	out, err := c.svc.DescribeMountTargets(ctx, &efs.DescribeMountTargetsInput{
 		FileSystemId: new(fsId),
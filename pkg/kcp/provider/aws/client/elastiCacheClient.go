@@ -50,6 +50,7 @@ type CreateElastiCacheClusterOptions struct {
 	ClusterMode                bool
 	AutomaticFailoverEnabled   bool
 	MultiAZEnabled             *bool
+	SnapshotName               string // seeds the replication group from the snapshot when set
 }
 
 type ModifyElastiCacheClusterOptions struct {
@@ -63,6 +64,8 @@ type ModifyElastiCacheClusterOptions struct {
 	ParameterGroupName         *string
 	AutomaticFailoverEnabled   *bool
 	MultiAZEnabled             *bool
+	// ApplyInMaintenanceWindow defers the modification to the next preferred maintenance window
+	ApplyInMaintenanceWindow bool
 }
 
 type RescaleElastiCacheClusterShardOptions struct {
@@ -94,6 +97,8 @@ type ElastiCacheClient interface {
 	DeleteAuthTokenSecret(ctx context.Context, secretName string) error
 
 	DescribeElastiCacheReplicationGroup(ctx context.Context, clusterId string) ([]elasticachetypes.ReplicationGroup, error)
+	DescribeElastiCacheReplicationGroups(ctx context.Context) ([]elasticachetypes.ReplicationGroup, error)
+	ListElastiCacheTags(ctx context.Context, arn string) ([]elasticachetypes.Tag, error)
 	CreateElastiCacheReplicationGroup(ctx context.Context, tags []elasticachetypes.Tag, options CreateElastiCacheClusterOptions) (*elasticache.CreateReplicationGroupOutput, error)
 	ModifyElastiCacheReplicationGroup(ctx context.Context, id string, options ModifyElastiCacheClusterOptions) (*elasticache.ModifyReplicationGroupOutput, error)
 	DeleteElastiCacheReplicationGroup(ctx context.Context, id string) error
@@ -101,6 +106,11 @@ type ElastiCacheClient interface {
 	ModifyElastiCacheClusterShardConfiguration(ctx context.Context, options RescaleElastiCacheClusterShardOptions) error
 	ModifyElastiCacheClusterReplicaConfiguration(ctx context.Context, options RescaleElastiCacheClusterReplicaOptions) error
 
+	// DescribeElastiCacheSnapshot returns nil if the snapshot does not exist
+	DescribeElastiCacheSnapshot(ctx context.Context, name string) (*elasticachetypes.Snapshot, error)
+	CreateElastiCacheSnapshot(ctx context.Context, name, replicationGroupId string, tags []elasticachetypes.Tag) error
+	DeleteElastiCacheSnapshot(ctx context.Context, name string) error
+
 	DescribeUserGroup(ctx context.Context, id string) (*elasticachetypes.UserGroup, error)
 	CreateUserGroup(ctx context.Context, id string, tags []elasticachetypes.Tag) (*elasticache.CreateUserGroupOutput, error)
 	DeleteUserGroup(ctx context.Context, id string) error
@@ -335,6 +345,43 @@ func (c *elastiCacheClient) DescribeElastiCacheReplicationGroup(ctx context.Cont
 	return out.ReplicationGroups, nil
 }
 
+func (c *elastiCacheClient) DescribeElastiCacheReplicationGroups(ctx context.Context) ([]elasticachetypes.ReplicationGroup, error) {
+	result := []elasticachetypes.ReplicationGroup{}
+	var marker *string
+	for {
+		out, err := c.elastiCacheSvc.DescribeReplicationGroups(ctx, &elasticache.DescribeReplicationGroupsInput{
+			Marker:     marker,
+			MaxRecords: new(int32(100)),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, out.ReplicationGroups...)
+
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+
+	return result, nil
+}
+
+func (c *elastiCacheClient) ListElastiCacheTags(ctx context.Context, arn string) ([]elasticachetypes.Tag, error) {
+	out, err := c.elastiCacheSvc.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{
+		ResourceName: new(arn),
+	})
+	if err != nil {
+		if awsmeta.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return out.TagList, nil
+}
+
 func (c *elastiCacheClient) CreateElastiCacheReplicationGroup(ctx context.Context, tags []elasticachetypes.Tag, options CreateElastiCacheClusterOptions) (*elasticache.CreateReplicationGroupOutput, error) {
 	clusterMode := elasticachetypes.ClusterModeDisabled
 	if options.ClusterMode {
@@ -362,6 +409,9 @@ func (c *elastiCacheClient) CreateElastiCacheReplicationGroup(ctx context.Contex
 		ReplicasPerNodeGroup:        aws.Int32(options.ReplicasPerNodeGroup),
 		Tags:                        tags,
 	}
+	if options.SnapshotName != "" {
+		params.SnapshotName = aws.String(options.SnapshotName)
+	}
 	res, err := c.elastiCacheSvc.CreateReplicationGroup(ctx, params)
 
 	if err != nil {
@@ -374,7 +424,7 @@ func (c *elastiCacheClient) CreateElastiCacheReplicationGroup(ctx context.Contex
 func (c *elastiCacheClient) ModifyElastiCacheReplicationGroup(ctx context.Context, id string, options ModifyElastiCacheClusterOptions) (*elasticache.ModifyReplicationGroupOutput, error) {
 	params := &elasticache.ModifyReplicationGroupInput{
 		ReplicationGroupId: aws.String(id),
-		ApplyImmediately:   aws.Bool(true),
+		ApplyImmediately:   aws.Bool(!options.ApplyInMaintenanceWindow),
 	}
 	if options.CacheNodeType != nil {
 		params.CacheNodeType = options.CacheNodeType
@@ -479,6 +529,38 @@ func (c *elastiCacheClient) ModifyElastiCacheClusterReplicaConfiguration(ctx con
 	return nil
 }
 
+func (c *elastiCacheClient) DescribeElastiCacheSnapshot(ctx context.Context, name string) (*elasticachetypes.Snapshot, error) {
+	out, err := c.elastiCacheSvc.DescribeSnapshots(ctx, &elasticache.DescribeSnapshotsInput{
+		SnapshotName: new(name),
+	})
+	if err != nil {
+		if awsmeta.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(out.Snapshots) == 0 {
+		return nil, nil
+	}
+	return &out.Snapshots[0], nil
+}
+
+func (c *elastiCacheClient) CreateElastiCacheSnapshot(ctx context.Context, name, replicationGroupId string, tags []elasticachetypes.Tag) error {
+	_, err := c.elastiCacheSvc.CreateSnapshot(ctx, &elasticache.CreateSnapshotInput{
+		SnapshotName:       new(name),
+		ReplicationGroupId: new(replicationGroupId),
+		Tags:               tags,
+	})
+	return err
+}
+
+func (c *elastiCacheClient) DeleteElastiCacheSnapshot(ctx context.Context, name string) error {
+	_, err := c.elastiCacheSvc.DeleteSnapshot(ctx, &elasticache.DeleteSnapshotInput{
+		SnapshotName: new(name),
+	})
+	return err
+}
+
 func (c *elastiCacheClient) DescribeUserGroup(ctx context.Context, id string) (*elasticachetypes.UserGroup, error) {
 	res, err := c.elastiCacheSvc.DescribeUserGroups(ctx, &elasticache.DescribeUserGroupsInput{
 		UserGroupId: new(id),
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+type IamClient interface {
+	// GetIamUser returns nil if the user does not exist
+	GetIamUser(ctx context.Context, name string) (*iamtypes.User, error)
+	CreateIamUser(ctx context.Context, name string, tags []iamtypes.Tag) (*iamtypes.User, error)
+	DeleteIamUser(ctx context.Context, name string) error
+
+	// GetIamUserPolicy returns empty string if the user has no inline policy with the given name
+	GetIamUserPolicy(ctx context.Context, userName, policyName string) (string, error)
+	PutIamUserPolicy(ctx context.Context, userName, policyName, document string) error
+	DeleteIamUserPolicy(ctx context.Context, userName, policyName string) error
+
+	ListIamAccessKeys(ctx context.Context, userName string) ([]iamtypes.AccessKeyMetadata, error)
+	CreateIamAccessKey(ctx context.Context, userName string) (*iamtypes.AccessKey, error)
+	DeleteIamAccessKey(ctx context.Context, userName, accessKeyId string) error
+}
+
+func NewIamClient(svc *iam.Client) IamClient {
+	return &iamClient{svc: svc}
+}
+
+var _ IamClient = (*iamClient)(nil)
+
+type iamClient struct {
+	svc *iam.Client
+}
+
+func (c *iamClient) GetIamUser(ctx context.Context, name string) (*iamtypes.User, error) {
+	out, err := c.svc.GetUser(ctx, &iam.GetUserInput{
+		UserName: new(name),
+	})
+	if awsmeta.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.User, nil
+}
+
+func (c *iamClient) CreateIamUser(ctx context.Context, name string, tags []iamtypes.Tag) (*iamtypes.User, error) {
+	out, err := c.svc.CreateUser(ctx, &iam.CreateUserInput{
+		UserName: new(name),
+		Tags:     tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.User, nil
+}
+
+func (c *iamClient) DeleteIamUser(ctx context.Context, name string) error {
+	_, err := c.svc.DeleteUser(ctx, &iam.DeleteUserInput{
+		UserName: new(name),
+	})
+	return err
+}
+
+func (c *iamClient) GetIamUserPolicy(ctx context.Context, userName, policyName string) (string, error) {
+	out, err := c.svc.GetUserPolicy(ctx, &iam.GetUserPolicyInput{
+		UserName:   new(userName),
+		PolicyName: new(policyName),
+	})
+	if awsmeta.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return *out.PolicyDocument, nil
+}
+
+func (c *iamClient) PutIamUserPolicy(ctx context.Context, userName, policyName, document string) error {
+	_, err := c.svc.PutUserPolicy(ctx, &iam.PutUserPolicyInput{
+		UserName:       new(userName),
+		PolicyName:     new(policyName),
+		PolicyDocument: new(document),
+	})
+	return err
+}
+
+func (c *iamClient) DeleteIamUserPolicy(ctx context.Context, userName, policyName string) error {
+	_, err := c.svc.DeleteUserPolicy(ctx, &iam.DeleteUserPolicyInput{
+		UserName:   new(userName),
+		PolicyName: new(policyName),
+	})
+	return err
+}
+
+func (c *iamClient) ListIamAccessKeys(ctx context.Context, userName string) ([]iamtypes.AccessKeyMetadata, error) {
+	out, err := c.svc.ListAccessKeys(ctx, &iam.ListAccessKeysInput{
+		UserName: new(userName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.AccessKeyMetadata, nil
+}
+
+func (c *iamClient) CreateIamAccessKey(ctx context.Context, userName string) (*iamtypes.AccessKey, error) {
+	out, err := c.svc.CreateAccessKey(ctx, &iam.CreateAccessKeyInput{
+		UserName: new(userName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.AccessKey, nil
+}
+
+func (c *iamClient) DeleteIamAccessKey(ctx context.Context, userName, accessKeyId string) error {
+	_, err := c.svc.DeleteAccessKey(ctx, &iam.DeleteAccessKeyInput{
+		UserName:    new(userName),
+		AccessKeyId: new(accessKeyId),
+	})
+	return err
+}
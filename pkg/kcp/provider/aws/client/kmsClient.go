@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+type KmsClient interface {
+	// DescribeKmsKeyByAlias returns nil if the alias does not exist
+	DescribeKmsKeyByAlias(ctx context.Context, aliasName string) (*kmstypes.KeyMetadata, error)
+	CreateKmsKey(ctx context.Context, description string, tags []kmstypes.Tag) (*kmstypes.KeyMetadata, error)
+	CreateKmsAlias(ctx context.Context, aliasName, keyId string) error
+	DeleteKmsAlias(ctx context.Context, aliasName string) error
+	// ScheduleKmsKeyDeletion disables the key and deletes it after the waiting period of given days
+	ScheduleKmsKeyDeletion(ctx context.Context, keyId string, pendingWindowInDays int32) error
+
+	GetKmsKeyRotationEnabled(ctx context.Context, keyId string) (bool, error)
+	EnableKmsKeyRotation(ctx context.Context, keyId string) error
+}
+
+func NewKmsClient(svc *kms.Client) KmsClient {
+	return &kmsClient{svc: svc}
+}
+
+var _ KmsClient = (*kmsClient)(nil)
+
+type kmsClient struct {
+	svc *kms.Client
+}
+
+func (c *kmsClient) DescribeKmsKeyByAlias(ctx context.Context, aliasName string) (*kmstypes.KeyMetadata, error) {
+	out, err := c.svc.DescribeKey(ctx, &kms.DescribeKeyInput{
+		KeyId: new(aliasName),
+	})
+	if awsmeta.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.KeyMetadata, nil
+}
+
+func (c *kmsClient) CreateKmsKey(ctx context.Context, description string, tags []kmstypes.Tag) (*kmstypes.KeyMetadata, error) {
+	out, err := c.svc.CreateKey(ctx, &kms.CreateKeyInput{
+		Description: new(description),
+		KeySpec:     kmstypes.KeySpecSymmetricDefault,
+		KeyUsage:    kmstypes.KeyUsageTypeEncryptDecrypt,
+		Tags:        tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.KeyMetadata, nil
+}
+
+func (c *kmsClient) CreateKmsAlias(ctx context.Context, aliasName, keyId string) error {
+	_, err := c.svc.CreateAlias(ctx, &kms.CreateAliasInput{
+		AliasName:   new(aliasName),
+		TargetKeyId: new(keyId),
+	})
+	return err
+}
+
+func (c *kmsClient) DeleteKmsAlias(ctx context.Context, aliasName string) error {
+	_, err := c.svc.DeleteAlias(ctx, &kms.DeleteAliasInput{
+		AliasName: new(aliasName),
+	})
+	return err
+}
+
+func (c *kmsClient) ScheduleKmsKeyDeletion(ctx context.Context, keyId string, pendingWindowInDays int32) error {
+	_, err := c.svc.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               new(keyId),
+		PendingWindowInDays: new(pendingWindowInDays),
+	})
+	return err
+}
+
+func (c *kmsClient) GetKmsKeyRotationEnabled(ctx context.Context, keyId string) (bool, error) {
+	out, err := c.svc.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{
+		KeyId: new(keyId),
+	})
+	if err != nil {
+		return false, err
+	}
+	return out.KeyRotationEnabled, nil
+}
+
+func (c *kmsClient) EnableKmsKeyRotation(ctx context.Context, keyId string) error {
+	_, err := c.svc.EnableKeyRotation(ctx, &kms.EnableKeyRotationInput{
+		KeyId: new(keyId),
+	})
+	return err
+}
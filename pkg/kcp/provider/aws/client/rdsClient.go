@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/google/uuid"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func NewRdsClientProvider() SkrClientProvider[RdsClient] {
+	return func(ctx context.Context, account, region, key, secret, role string) (RdsClient, error) {
+		cfg, err := NewSkrConfig(ctx, region, key, secret, role)
+		if err != nil {
+			return nil, err
+		}
+
+		return newRdsClient(
+			ec2.NewFromConfig(cfg),
+			rds.NewFromConfig(cfg),
+			secretsmanager.NewFromConfig(cfg),
+		), nil
+	}
+}
+
+type CreateRdsInstanceOptions struct {
+	Name                       string
+	SubnetGroupName            string
+	InstanceClass              string
+	EngineVersion              string
+	StorageGb                  int32
+	MultiAz                    bool
+	DatabaseName               string
+	MasterUsername             string
+	MasterPassword             string
+	PreferredMaintenanceWindow *string
+	SecurityGroupIds           []string
+}
+
+type ModifyRdsInstanceOptions struct {
+	InstanceClass              *string
+	EngineVersion              *string
+	StorageGb                  *int32
+	MultiAz                    *bool
+	PreferredMaintenanceWindow *string
+}
+
+type RdsClient interface {
+	DescribeRdsSubnetGroup(ctx context.Context, name string) ([]rdstypes.DBSubnetGroup, error)
+	CreateRdsSubnetGroup(ctx context.Context, name string, subnetIds []string, tags []rdstypes.Tag) (*rds.CreateDBSubnetGroupOutput, error)
+	DeleteRdsSubnetGroup(ctx context.Context, name string) error
+
+	GetMasterPasswordSecretValue(ctx context.Context, secretName string) (*secretsmanager.GetSecretValueOutput, error)
+	CreateMasterPasswordSecret(ctx context.Context, secretName string, tags []secretsmanagertypes.Tag) error
+	DeleteMasterPasswordSecret(ctx context.Context, secretName string) error
+
+	DescribeRdsInstance(ctx context.Context, id string) ([]rdstypes.DBInstance, error)
+	CreateRdsInstance(ctx context.Context, tags []rdstypes.Tag, options CreateRdsInstanceOptions) (*rds.CreateDBInstanceOutput, error)
+	ModifyRdsInstance(ctx context.Context, id string, options ModifyRdsInstanceOptions) (*rds.ModifyDBInstanceOutput, error)
+	DeleteRdsInstance(ctx context.Context, id string) error
+
+	DescribeRdsSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error)
+	CreateRdsSecurityGroup(ctx context.Context, vpcId, name string, tags []ec2types.Tag) (string, error)
+	DeleteRdsSecurityGroup(ctx context.Context, id string) error
+	AuthorizeRdsSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error
+}
+
+func newRdsClient(ec2Svc *ec2.Client, rdsSvc *rds.Client, secretsManagerSvc *secretsmanager.Client) RdsClient {
+	return &rdsClient{
+		ec2Svc:            ec2Svc,
+		rdsSvc:            rdsSvc,
+		secretsManagerSvc: secretsManagerSvc,
+	}
+}
+
+type rdsClient struct {
+	ec2Svc            *ec2.Client
+	rdsSvc            *rds.Client
+	secretsManagerSvc *secretsmanager.Client
+}
+
+func (c *rdsClient) DescribeRdsSubnetGroup(ctx context.Context, name string) ([]rdstypes.DBSubnetGroup, error) {
+	out, err := c.rdsSvc.DescribeDBSubnetGroups(ctx, &rds.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: new(name),
+	})
+	if err != nil {
+		if awsmeta.IsNotFound(err) {
+			return []rdstypes.DBSubnetGroup{}, nil
+		}
+
+		return nil, err
+	}
+
+	return out.DBSubnetGroups, nil
+}
+
+func (c *rdsClient) CreateRdsSubnetGroup(ctx context.Context, name string, subnetIds []string, tags []rdstypes.Tag) (*rds.CreateDBSubnetGroupOutput, error) {
+	out, err := c.rdsSvc.CreateDBSubnetGroup(ctx, &rds.CreateDBSubnetGroupInput{
+		DBSubnetGroupDescription: new(fmt.Sprintf("SubnetGroup for RDS %s", name)),
+		DBSubnetGroupName:        new(name),
+		SubnetIds:                subnetIds,
+		Tags:                     tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c *rdsClient) DeleteRdsSubnetGroup(ctx context.Context, name string) error {
+	_, err := c.rdsSvc.DeleteDBSubnetGroup(ctx, &rds.DeleteDBSubnetGroupInput{
+		DBSubnetGroupName: new(name),
+	})
+
+	return err
+}
+
+func (c *rdsClient) GetMasterPasswordSecretValue(ctx context.Context, secretName string) (*secretsmanager.GetSecretValueOutput, error) {
+	out, err := c.secretsManagerSvc.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: new(secretName),
+	})
+	if err != nil {
+		if awsmeta.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c *rdsClient) CreateMasterPasswordSecret(ctx context.Context, secretName string, tags []secretsmanagertypes.Tag) error {
+	_, err := c.secretsManagerSvc.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         new(secretName),
+		SecretString: new(uuid.NewString()),
+		Tags:         tags,
+	})
+
+	return err
+}
+
+func (c *rdsClient) DeleteMasterPasswordSecret(ctx context.Context, secretName string) error {
+	_, err := c.secretsManagerSvc.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   new(secretName),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+
+	return err
+}
+
+func (c *rdsClient) DescribeRdsInstance(ctx context.Context, id string) ([]rdstypes.DBInstance, error) {
+	out, err := c.rdsSvc.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: new(id),
+	})
+	if err != nil {
+		if awsmeta.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return out.DBInstances, nil
+}
+
+func (c *rdsClient) CreateRdsInstance(ctx context.Context, tags []rdstypes.Tag, options CreateRdsInstanceOptions) (*rds.CreateDBInstanceOutput, error) {
+	params := &rds.CreateDBInstanceInput{
+		DBInstanceIdentifier:       aws.String(options.Name),
+		DBInstanceClass:            aws.String(options.InstanceClass),
+		Engine:                     aws.String("postgres"),
+		EngineVersion:              aws.String(options.EngineVersion),
+		AllocatedStorage:           aws.Int32(options.StorageGb),
+		StorageType:                aws.String("gp3"),
+		StorageEncrypted:           aws.Bool(true),
+		MultiAZ:                    aws.Bool(options.MultiAz),
+		DBName:                     aws.String(options.DatabaseName),
+		MasterUsername:             aws.String(options.MasterUsername),
+		MasterUserPassword:         aws.String(options.MasterPassword),
+		DBSubnetGroupName:          aws.String(options.SubnetGroupName),
+		VpcSecurityGroupIds:        options.SecurityGroupIds,
+		PreferredMaintenanceWindow: options.PreferredMaintenanceWindow,
+		PubliclyAccessible:         aws.Bool(false),
+		AutoMinorVersionUpgrade:    aws.Bool(true),
+		CopyTagsToSnapshot:         aws.Bool(true),
+		Tags:                       tags,
+	}
+
+	return c.rdsSvc.CreateDBInstance(ctx, params)
+}
+
+func (c *rdsClient) ModifyRdsInstance(ctx context.Context, id string, options ModifyRdsInstanceOptions) (*rds.ModifyDBInstanceOutput, error) {
+	params := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+		ApplyImmediately:     aws.Bool(true),
+	}
+	if options.InstanceClass != nil {
+		params.DBInstanceClass = options.InstanceClass
+	}
+	if options.EngineVersion != nil {
+		params.EngineVersion = options.EngineVersion
+		params.AllowMajorVersionUpgrade = aws.Bool(true)
+	}
+	if options.StorageGb != nil {
+		params.AllocatedStorage = options.StorageGb
+	}
+	if options.MultiAz != nil {
+		params.MultiAZ = options.MultiAz
+	}
+	if options.PreferredMaintenanceWindow != nil {
+		params.PreferredMaintenanceWindow = options.PreferredMaintenanceWindow
+	}
+
+	return c.rdsSvc.ModifyDBInstance(ctx, params)
+}
+
+func (c *rdsClient) DeleteRdsInstance(ctx context.Context, id string) error {
+	_, err := c.rdsSvc.DeleteDBInstance(ctx, &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:   new(id),
+		SkipFinalSnapshot:      aws.Bool(true),
+		DeleteAutomatedBackups: aws.Bool(true),
+	})
+
+	return err
+}
+
+func (c *rdsClient) DescribeRdsSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error) {
+	out, err := c.ec2Svc.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters:  filters,
+		GroupIds: groupIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.SecurityGroups, nil
+}
+
+func (c *rdsClient) CreateRdsSecurityGroup(ctx context.Context, vpcId, name string, tags []ec2types.Tag) (string, error) {
+	out, err := c.ec2Svc.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{
+		Description: new(fmt.Sprintf("SecurityGroup for RDS %s", name)),
+		GroupName:   new(name),
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeSecurityGroup,
+				Tags:         tags,
+			},
+		},
+		VpcId: new(vpcId),
+	})
+	if err != nil {
+		return "", err
+	}
+	return ptr.Deref(out.GroupId, ""), nil
+}
+
+func (c *rdsClient) DeleteRdsSecurityGroup(ctx context.Context, id string) error {
+	_, err := c.ec2Svc.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
+		GroupId: new(id),
+	})
+	return err
+}
+
+func (c *rdsClient) AuthorizeRdsSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
+	_, err := c.ec2Svc.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       new(groupId),
+		IpPermissions: ipPermissions,
+	})
+	return err
+}
@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+type S3Client interface {
+	S3BucketExists(ctx context.Context, name string) (bool, error)
+	CreateS3Bucket(ctx context.Context, name, region string, objectLockEnabled bool) error
+	DeleteS3Bucket(ctx context.Context, name string) error
+	// EmptyS3Bucket deletes all objects, object versions and delete markers in the bucket,
+	// bypassing the governance mode retention
+	EmptyS3Bucket(ctx context.Context, name string) error
+
+	PutS3BucketTagging(ctx context.Context, name string, tags []s3types.Tag) error
+	PutS3PublicAccessBlock(ctx context.Context, name string) error
+
+	GetS3BucketVersioning(ctx context.Context, name string) (s3types.BucketVersioningStatus, error)
+	PutS3BucketVersioning(ctx context.Context, name string, status s3types.BucketVersioningStatus) error
+
+	GetS3BucketLifecycleRules(ctx context.Context, name string) ([]s3types.LifecycleRule, error)
+	// PutS3BucketLifecycleRules replaces the bucket lifecycle configuration, or deletes it if rules are empty
+	PutS3BucketLifecycleRules(ctx context.Context, name string, rules []s3types.LifecycleRule) error
+
+	// GetS3ObjectLockConfiguration returns nil if object lock is not enabled on the bucket
+	GetS3ObjectLockConfiguration(ctx context.Context, name string) (*s3types.ObjectLockConfiguration, error)
+	// PutS3ObjectLockRetentionDays enables object lock with the governance mode default retention,
+	// or removes the default retention if days is zero
+	PutS3ObjectLockRetentionDays(ctx context.Context, name string, days int32) error
+
+	// GetS3BucketPolicy returns empty string if the bucket has no policy
+	GetS3BucketPolicy(ctx context.Context, name string) (string, error)
+	PutS3BucketPolicy(ctx context.Context, name, policy string) error
+}
+
+func NewS3Client(svc *s3.Client) S3Client {
+	return &s3Client{svc: svc}
+}
+
+var _ S3Client = (*s3Client)(nil)
+
+type s3Client struct {
+	svc *s3.Client
+}
+
+func (c *s3Client) S3BucketExists(ctx context.Context, name string) (bool, error) {
+	_, err := c.svc.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: new(name),
+	})
+	if awsmeta.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *s3Client) CreateS3Bucket(ctx context.Context, name, region string, objectLockEnabled bool) error {
+	in := &s3.CreateBucketInput{
+		Bucket:          new(name),
+		ObjectOwnership: s3types.ObjectOwnershipBucketOwnerEnforced,
+	}
+	if objectLockEnabled {
+		in.ObjectLockEnabledForBucket = new(true)
+	}
+	// us-east-1 is the default location, and it is rejected when specified explicitly
+	if region != "us-east-1" {
+		in.CreateBucketConfiguration = &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraint(region),
+		}
+	}
+	_, err := c.svc.CreateBucket(ctx, in)
+	return err
+}
+
+func (c *s3Client) DeleteS3Bucket(ctx context.Context, name string) error {
+	_, err := c.svc.DeleteBucket(ctx, &s3.DeleteBucketInput{
+		Bucket: new(name),
+	})
+	return err
+}
+
+func (c *s3Client) EmptyS3Bucket(ctx context.Context, name string) error {
+	paginator := s3.NewListObjectVersionsPaginator(c.svc, &s3.ListObjectVersionsInput{
+		Bucket: new(name),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		var objects []s3types.ObjectIdentifier
+		for _, v := range page.Versions {
+			objects = append(objects, s3types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+		for _, m := range page.DeleteMarkers {
+			objects = append(objects, s3types.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+		}
+		if len(objects) == 0 {
+			continue
+		}
+
+		_, err = c.svc.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket:                    new(name),
+			BypassGovernanceRetention: new(true),
+			Delete: &s3types.Delete{
+				Objects: objects,
+				Quiet:   new(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *s3Client) PutS3BucketTagging(ctx context.Context, name string, tags []s3types.Tag) error {
+	_, err := c.svc.PutBucketTagging(ctx, &s3.PutBucketTaggingInput{
+		Bucket: new(name),
+		Tagging: &s3types.Tagging{
+			TagSet: tags,
+		},
+	})
+	return err
+}
+
+func (c *s3Client) PutS3PublicAccessBlock(ctx context.Context, name string) error {
+	_, err := c.svc.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
+		Bucket: new(name),
+		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       new(true),
+			BlockPublicPolicy:     new(true),
+			IgnorePublicAcls:      new(true),
+			RestrictPublicBuckets: new(true),
+		},
+	})
+	return err
+}
+
+func (c *s3Client) GetS3BucketVersioning(ctx context.Context, name string) (s3types.BucketVersioningStatus, error) {
+	out, err := c.svc.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: new(name),
+	})
+	if err != nil {
+		return "", err
+	}
+	return out.Status, nil
+}
+
+func (c *s3Client) PutS3BucketVersioning(ctx context.Context, name string, status s3types.BucketVersioningStatus) error {
+	_, err := c.svc.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket: new(name),
+		VersioningConfiguration: &s3types.VersioningConfiguration{
+			Status: status,
+		},
+	})
+	return err
+}
+
+func (c *s3Client) GetS3BucketLifecycleRules(ctx context.Context, name string) ([]s3types.LifecycleRule, error) {
+	out, err := c.svc.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: new(name),
+	})
+	if awsmeta.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.Rules, nil
+}
+
+func (c *s3Client) PutS3BucketLifecycleRules(ctx context.Context, name string, rules []s3types.LifecycleRule) error {
+	if len(rules) == 0 {
+		_, err := c.svc.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+			Bucket: new(name),
+		})
+		return err
+	}
+	_, err := c.svc.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: new(name),
+		LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	})
+	return err
+}
+
+func (c *s3Client) GetS3ObjectLockConfiguration(ctx context.Context, name string) (*s3types.ObjectLockConfiguration, error) {
+	out, err := c.svc.GetObjectLockConfiguration(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: new(name),
+	})
+	if awsmeta.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.ObjectLockConfiguration, nil
+}
+
+func (c *s3Client) PutS3ObjectLockRetentionDays(ctx context.Context, name string, days int32) error {
+	cfg := &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+	}
+	if days > 0 {
+		cfg.Rule = &s3types.ObjectLockRule{
+			DefaultRetention: &s3types.DefaultRetention{
+				Mode: s3types.ObjectLockRetentionModeGovernance,
+				Days: new(days),
+			},
+		}
+	}
+	_, err := c.svc.PutObjectLockConfiguration(ctx, &s3.PutObjectLockConfigurationInput{
+		Bucket:                  new(name),
+		ObjectLockConfiguration: cfg,
+	})
+	return err
+}
+
+func (c *s3Client) GetS3BucketPolicy(ctx context.Context, name string) (string, error) {
+	out, err := c.svc.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: new(name),
+	})
+	if awsmeta.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return *out.Policy, nil
+}
+
+func (c *s3Client) PutS3BucketPolicy(ctx context.Context, name, policy string) error {
+	_, err := c.svc.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: new(name),
+		Policy: new(policy),
+	})
+	return err
+}
@@ -15,6 +15,7 @@ type AwsConfigStruct struct {
 	EfsCapacityCheckInterval      time.Duration                                        `json:"efsCapacityCheckInterval" yaml:"efsCapacityCheckInterval"`
 	RedisInstanceTierMachineTypes map[cloudresourcesv1beta1.AwsRedisTier]string        `json:"redisInstanceTierMachineTypes" yaml:"redisInstanceTierMachineTypes"`
 	RedisClusterTierMachineTypes  map[cloudresourcesv1beta1.AwsRedisClusterTier]string `json:"redisClusterTierMachineTypes" yaml:"redisClusterTierMachineTypes"`
+	PostgresInstanceTierClasses   map[cloudresourcesv1beta1.AwsPostgresTier]string     `json:"postgresInstanceTierClasses" yaml:"postgresInstanceTierClasses"`
 }
 
 var AwsConfig = &AwsConfigStruct{}
@@ -0,0 +1,81 @@
+package exposedData
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/capability"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// capabilitiesLoad discovers if EFS is available and the ElastiCache redis versions available in the region.
+// On error the previously discovered capabilities are kept, and the static catalog is used if there are none.
+func capabilitiesLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	efsAvailable, err := state.awsClient.IsEfsAvailable(ctx)
+	if err != nil {
+		logger.Error(err, "Error checking AWS EFS availability for region capabilities")
+		return nil, ctx
+	}
+
+	versions, err := state.awsClient.DescribeCacheEngineVersions(ctx, "redis")
+	if err != nil {
+		logger.Error(err, "Error loading AWS ElastiCache engine versions for region capabilities")
+		return nil, ctx
+	}
+
+	static := capability.Static(string(cloudcontrolv1beta1.ProviderAws), state.ObjAsScope().Spec.Region)
+
+	var redisVersions []string
+	for _, v := range versions {
+		skrVersion := redisVersionToSkr(ptr.Deref(v.EngineVersion, ""))
+		if len(skrVersion) == 0 || slices.Contains(redisVersions, skrVersion) {
+			continue
+		}
+		if len(static.RedisVersions) > 0 && !slices.Contains(static.RedisVersions, skrVersion) {
+			continue
+		}
+		redisVersions = append(redisVersions, skrVersion)
+	}
+	slices.Sort(redisVersions)
+	slices.Reverse(redisVersions)
+
+	services := map[string]bool{
+		cloudcontrolv1beta1.CapabilityServiceNfs: efsAvailable,
+	}
+
+	state.ObjAsScope().Status.Capabilities = &cloudcontrolv1beta1.RegionCapabilities{
+		Services:      services,
+		RedisVersions: redisVersions,
+		ReadTime:      new(metav1.Now()),
+	}
+
+	logger.
+		WithValues(
+			"services", fmt.Sprintf("%v", services),
+			"redisVersions", fmt.Sprintf("%v", redisVersions),
+		).
+		Info("AWS region capabilities")
+
+	return nil, ctx
+}
+
+// redisVersionToSkr converts the ElastiCache engine version to the AwsRedisInstance engineVersion,
+// where all 6 versions are given as 6.x and later ones by major and minor
+func redisVersionToSkr(engineVersion string) string {
+	parts := strings.Split(engineVersion, ".")
+	if len(parts) < 2 {
+		return ""
+	}
+	if parts[0] == "6" {
+		return "6.x"
+	}
+	return parts[0] + "." + parts[1]
+}
@@ -2,15 +2,23 @@ package client
 
 import (
 	"context"
+	"errors"
+	"net"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 )
 
 type Client interface {
 	DescribeVpcs(ctx context.Context, name string) ([]ec2types.Vpc, error)
 	DescribeNatGateway(ctx context.Context, vpcId string) ([]ec2types.NatGateway, error)
+	DescribeCacheEngineVersions(ctx context.Context, engine string) ([]elasticachetypes.CacheEngineVersion, error)
+	// IsEfsAvailable returns false if the EFS endpoint of the region does not exist
+	IsEfsAvailable(ctx context.Context) (bool, error)
 }
 
 func NewClientProvider() awsclient.SkrClientProvider[Client] {
@@ -19,16 +27,57 @@ func NewClientProvider() awsclient.SkrClientProvider[Client] {
 		if err != nil {
 			return nil, err
 		}
-		return newClient(awsclient.NewEc2Client(ec2.NewFromConfig(cfg))), nil
+		return newClient(
+			awsclient.NewEc2Client(ec2.NewFromConfig(cfg)),
+			elasticache.NewFromConfig(cfg),
+			efs.NewFromConfig(cfg),
+		), nil
 	}
 }
 
-func newClient(ec2Client awsclient.Ec2Client) Client {
-	return &client{Ec2Client: ec2Client}
+func newClient(ec2Client awsclient.Ec2Client, elastiCacheSvc *elasticache.Client, efsSvc *efs.Client) Client {
+	return &client{Ec2Client: ec2Client, elastiCacheSvc: elastiCacheSvc, efsSvc: efsSvc}
 }
 
 var _ Client = (*client)(nil)
 
 type client struct {
 	awsclient.Ec2Client
+	elastiCacheSvc *elasticache.Client
+	efsSvc         *efs.Client
+}
+
+func (c *client) DescribeCacheEngineVersions(ctx context.Context, engine string) ([]elasticachetypes.CacheEngineVersion, error) {
+	var result []elasticachetypes.CacheEngineVersion
+	var marker *string
+	for {
+		out, err := c.elastiCacheSvc.DescribeCacheEngineVersions(ctx, &elasticache.DescribeCacheEngineVersionsInput{
+			Engine: new(engine),
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, out.CacheEngineVersions...)
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	return result, nil
+}
+
+func (c *client) IsEfsAvailable(ctx context.Context) (bool, error) {
+	_, err := c.efsSvc.DescribeFileSystems(ctx, &efs.DescribeFileSystemsInput{
+		MaxItems: new(int32(1)),
+	})
+	if err == nil {
+		return true, nil
+	}
+	// there is no API listing the EFS regions, and the endpoint host of a region without EFS does not resolve
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return false, nil
+	}
+	return false, err
 }
@@ -9,6 +9,10 @@ import (
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
 
 	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 	"github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -104,7 +108,17 @@ var notFoundErrorCodes = map[string]struct{}{
 	(&efstypes.PolicyNotFound{}).ErrorCode():                        {},
 	(&elasticachetypes.CacheSubnetGroupNotFoundFault{}).ErrorCode(): {},
 	(&elasticachetypes.CacheClusterNotFoundFault{}).ErrorCode():     {},
+	(&elasticachetypes.SnapshotNotFoundFault{}).ErrorCode():         {},
 	(&secretsmanagertypes.ResourceNotFoundException{}).ErrorCode():  {},
+	(&rdstypes.DBInstanceNotFoundFault{}).ErrorCode():               {},
+	(&rdstypes.DBSubnetGroupNotFoundFault{}).ErrorCode():            {},
+	(&s3types.NoSuchBucket{}).ErrorCode():                           {},
+	(&iamtypes.NoSuchEntityException{}).ErrorCode():                 {},
+	(&kmstypes.NotFoundException{}).ErrorCode():                     {},
+	"NoSuchBucketPolicy":                                            {},
+	"NoSuchLifecycleConfiguration":                                  {},
+	"ObjectLockConfigurationNotFoundError":                          {},
+	"InvalidVpcEndpointId.NotFound":                                 {},
 	"InvalidVpcPeeringConnectionID.NotFound":                        {},
 	"InvalidVpcID.NotFound":                                         {},
 	"InvalidRoute.NotFound":                                         {},
@@ -183,3 +197,30 @@ const (
 	ElastiCache_UserGroup_DELETING  ElastiCacheUserGroupState = "deleting"
 	ElastiCache_UserGroup_MODIFYING ElastiCacheUserGroupState = "modifying"
 )
+
+type ElastiCacheSnapshotState = string
+
+// github.com/aws/aws-sdk-go-v2/service/elasticache@v1.52.2/types/types.go
+// SnapshotStatus *string
+// The status of the snapshot. Valid values: creating | available | restoring |
+// copying | deleting .
+const (
+	ElastiCache_Snapshot_AVAILABLE ElastiCacheSnapshotState = "available"
+	ElastiCache_Snapshot_CREATING  ElastiCacheSnapshotState = "creating"
+	ElastiCache_Snapshot_DELETING  ElastiCacheSnapshotState = "deleting"
+	ElastiCache_Snapshot_FAILED    ElastiCacheSnapshotState = "failed"
+)
+
+type RdsInstanceState = string
+
+// github.com/aws/aws-sdk-go-v2/service/rds@v1.101.0/types/types.go
+// DBInstanceStatus *string
+// https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/accessing-monitoring.html#Overview.DBInstance.Status
+const (
+	Rds_AVAILABLE  RdsInstanceState = "available"
+	Rds_CREATING   RdsInstanceState = "creating"
+	Rds_DELETING   RdsInstanceState = "deleting"
+	Rds_MODIFYING  RdsInstanceState = "modifying"
+	Rds_BACKING_UP RdsInstanceState = "backing-up"
+	Rds_FAILED     RdsInstanceState = "failed"
+)
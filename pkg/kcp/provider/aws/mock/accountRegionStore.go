@@ -7,7 +7,10 @@ type accountRegionStore struct {
 	*nfsStore
 	*vpcPeeringStore
 	*elastiCacheClientFake
+	*rdsClientFake
 	*routeTablesStore
+	*bucketStore
+	*hardeningStore
 
 	region string
 }
@@ -19,8 +22,11 @@ func newAccountRegionStore(region string) *accountRegionStore {
 		vpcStore:              newVpcStore(),
 		vpcPeeringStore:       newVpcPeeringStore(),
 		elastiCacheClientFake: newElastiCacheClientFake(),
+		rdsClientFake:         newRdsClientFake(),
 		nfsStore:              &nfsStore{},
 		routeTablesStore:      &routeTablesStore{},
+		bucketStore:           newBucketStore(),
+		hardeningStore:        newHardeningStore(),
 	}
 }
 
@@ -0,0 +1,633 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+// S3Bucket is the mock state of an S3 bucket
+type S3Bucket struct {
+	Name                    string
+	Region                  string
+	Tags                    []s3types.Tag
+	PublicAccessBlocked     bool
+	Versioning              s3types.BucketVersioningStatus
+	LifecycleRules          []s3types.LifecycleRule
+	ObjectLockEnabled       bool
+	ObjectLockRetentionDays int32
+	Policy                  string
+	ObjectCount             int
+}
+
+type AwsBucketMockUtils interface {
+	GetAwsS3Bucket(name string) *S3Bucket
+	// AddAwsS3BucketObjects simulates objects written to the bucket, so it can not be deleted before it's emptied
+	AddAwsS3BucketObjects(name string, count int)
+	GetAwsIamUser(name string) *iamtypes.User
+	GetAwsIamUserPolicy(userName, policyName string) string
+	GetAwsIamAccessKeys(userName string) []iamtypes.AccessKeyMetadata
+	GetAwsVpcEndpoints(vpcId string) []ec2types.VpcEndpoint
+}
+
+type iamUserEntry struct {
+	user       iamtypes.User
+	policies   map[string]string
+	accessKeys []iamtypes.AccessKeyMetadata
+}
+
+type bucketStore struct {
+	m            sync.Mutex
+	buckets      map[string]*S3Bucket
+	users        map[string]*iamUserEntry
+	vpcEndpoints []*ec2types.VpcEndpoint
+}
+
+func newBucketStore() *bucketStore {
+	return &bucketStore{
+		buckets: map[string]*S3Bucket{},
+		users:   map[string]*iamUserEntry{},
+	}
+}
+
+func noSuchBucketError(name string) error {
+	return &s3types.NoSuchBucket{Message: new(fmt.Sprintf("bucket %s does not exist", name))}
+}
+
+func noSuchIamEntityError(name string) error {
+	return &iamtypes.NoSuchEntityException{Message: new(fmt.Sprintf("user %s does not exist", name))}
+}
+
+// AwsBucketMockUtils ==========================================================
+
+func (s *bucketStore) GetAwsS3Bucket(name string) *S3Bucket {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return nil
+	}
+	cpy := *b
+	return &cpy
+}
+
+func (s *bucketStore) AddAwsS3BucketObjects(name string, count int) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if b, ok := s.buckets[name]; ok {
+		b.ObjectCount += count
+	}
+}
+
+func (s *bucketStore) GetAwsIamUser(name string) *iamtypes.User {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[name]
+	if !ok {
+		return nil
+	}
+	cpy := u.user
+	return &cpy
+}
+
+func (s *bucketStore) GetAwsIamUserPolicy(userName, policyName string) string {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return ""
+	}
+	return u.policies[policyName]
+}
+
+func (s *bucketStore) GetAwsIamAccessKeys(userName string) []iamtypes.AccessKeyMetadata {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(u.accessKeys)
+}
+
+func (s *bucketStore) GetAwsVpcEndpoints(vpcId string) []ec2types.VpcEndpoint {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var result []ec2types.VpcEndpoint
+	for _, ep := range s.vpcEndpoints {
+		if ptr.Deref(ep.VpcId, "") == vpcId {
+			result = append(result, *ep)
+		}
+	}
+	return result
+}
+
+// VPC endpoints ===============================================================
+
+func (s *bucketStore) DescribeVpcEndpoints(ctx context.Context, vpcId, serviceName string) ([]ec2types.VpcEndpoint, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var result []ec2types.VpcEndpoint
+	for _, ep := range s.vpcEndpoints {
+		if ptr.Deref(ep.VpcId, "") == vpcId && ptr.Deref(ep.ServiceName, "") == serviceName {
+			result = append(result, *ep)
+		}
+	}
+	return result, nil
+}
+
+func (s *bucketStore) CreateVpcGatewayEndpoint(ctx context.Context, vpcId, serviceName string, routeTableIds []string, tags []ec2types.Tag) (*ec2types.VpcEndpoint, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	ep := &ec2types.VpcEndpoint{
+		VpcEndpointId:     new("vpce-" + uuid.NewString()[:17]),
+		VpcEndpointType:   ec2types.VpcEndpointTypeGateway,
+		VpcId:             new(vpcId),
+		ServiceName:       new(serviceName),
+		RouteTableIds:     slices.Clone(routeTableIds),
+		State:             ec2types.StateAvailable,
+		Tags:              tags,
+		CreationTimestamp: new(time.Now()),
+	}
+	s.vpcEndpoints = append(s.vpcEndpoints, ep)
+	cpy := *ep
+	return &cpy, nil
+}
+
+func (s *bucketStore) DeleteVpcEndpoint(ctx context.Context, vpcEndpointId string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.vpcEndpoints = pie.Filter(s.vpcEndpoints, func(ep *ec2types.VpcEndpoint) bool {
+		return ptr.Deref(ep.VpcEndpointId, "") != vpcEndpointId
+	})
+	return nil
+}
+
+// S3 ==========================================================================
+
+func (s *bucketStore) S3BucketExists(ctx context.Context, name string) (bool, error) {
+	if isContextCanceled(ctx) {
+		return false, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	_, ok := s.buckets[name]
+	return ok, nil
+}
+
+func (s *bucketStore) CreateS3Bucket(ctx context.Context, name, region string, objectLockEnabled bool) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.buckets[name]; ok {
+		return &s3types.BucketAlreadyOwnedByYou{Message: new(fmt.Sprintf("bucket %s already exists", name))}
+	}
+	b := &S3Bucket{
+		Name:              name,
+		Region:            region,
+		ObjectLockEnabled: objectLockEnabled,
+	}
+	if objectLockEnabled {
+		// enabling object lock on bucket creation also enables versioning
+		b.Versioning = s3types.BucketVersioningStatusEnabled
+	}
+	s.buckets[name] = b
+	return nil
+}
+
+func (s *bucketStore) DeleteS3Bucket(ctx context.Context, name string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	if b.ObjectCount > 0 {
+		return &smithy.GenericAPIError{
+			Code:    "BucketNotEmpty",
+			Message: fmt.Sprintf("bucket %s is not empty", name),
+		}
+	}
+	delete(s.buckets, name)
+	return nil
+}
+
+func (s *bucketStore) EmptyS3Bucket(ctx context.Context, name string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	b.ObjectCount = 0
+	return nil
+}
+
+func (s *bucketStore) PutS3BucketTagging(ctx context.Context, name string, tags []s3types.Tag) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	b.Tags = tags
+	return nil
+}
+
+func (s *bucketStore) PutS3PublicAccessBlock(ctx context.Context, name string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	b.PublicAccessBlocked = true
+	return nil
+}
+
+func (s *bucketStore) GetS3BucketVersioning(ctx context.Context, name string) (s3types.BucketVersioningStatus, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return "", noSuchBucketError(name)
+	}
+	return b.Versioning, nil
+}
+
+func (s *bucketStore) PutS3BucketVersioning(ctx context.Context, name string, status s3types.BucketVersioningStatus) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	if b.ObjectLockEnabled && status != s3types.BucketVersioningStatusEnabled {
+		return &smithy.GenericAPIError{
+			Code:    "InvalidBucketState",
+			Message: "An Object Lock configuration is present on this bucket, so the versioning state cannot be changed.",
+		}
+	}
+	b.Versioning = status
+	return nil
+}
+
+func (s *bucketStore) GetS3BucketLifecycleRules(ctx context.Context, name string) ([]s3types.LifecycleRule, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return nil, noSuchBucketError(name)
+	}
+	return slices.Clone(b.LifecycleRules), nil
+}
+
+func (s *bucketStore) PutS3BucketLifecycleRules(ctx context.Context, name string, rules []s3types.LifecycleRule) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	b.LifecycleRules = slices.Clone(rules)
+	return nil
+}
+
+func (s *bucketStore) GetS3ObjectLockConfiguration(ctx context.Context, name string) (*s3types.ObjectLockConfiguration, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return nil, noSuchBucketError(name)
+	}
+	if !b.ObjectLockEnabled {
+		return nil, nil
+	}
+	cfg := &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+	}
+	if b.ObjectLockRetentionDays > 0 {
+		cfg.Rule = &s3types.ObjectLockRule{
+			DefaultRetention: &s3types.DefaultRetention{
+				Mode: s3types.ObjectLockRetentionModeGovernance,
+				Days: new(b.ObjectLockRetentionDays),
+			},
+		}
+	}
+	return cfg, nil
+}
+
+func (s *bucketStore) PutS3ObjectLockRetentionDays(ctx context.Context, name string, days int32) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	if b.Versioning != s3types.BucketVersioningStatusEnabled {
+		return &smithy.GenericAPIError{
+			Code:    "InvalidBucketState",
+			Message: "Versioning must be 'Enabled' on the bucket to apply a Object Lock configuration",
+		}
+	}
+	b.ObjectLockEnabled = true
+	b.ObjectLockRetentionDays = days
+	return nil
+}
+
+func (s *bucketStore) GetS3BucketPolicy(ctx context.Context, name string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return "", noSuchBucketError(name)
+	}
+	return b.Policy, nil
+}
+
+func (s *bucketStore) PutS3BucketPolicy(ctx context.Context, name, policy string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return noSuchBucketError(name)
+	}
+	b.Policy = policy
+	return nil
+}
+
+// IAM =========================================================================
+
+func (s *bucketStore) GetIamUser(ctx context.Context, name string) (*iamtypes.User, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[name]
+	if !ok {
+		return nil, nil
+	}
+	cpy := u.user
+	return &cpy, nil
+}
+
+func (s *bucketStore) CreateIamUser(ctx context.Context, name string, tags []iamtypes.Tag) (*iamtypes.User, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.users[name]; ok {
+		return nil, &iamtypes.EntityAlreadyExistsException{Message: new(fmt.Sprintf("user %s already exists", name))}
+	}
+	u := &iamUserEntry{
+		user: iamtypes.User{
+			UserName:   new(name),
+			UserId:     new(uuid.NewString()),
+			Arn:        new(fmt.Sprintf("arn:aws:iam::%s:user/%s", awsmeta.GetAwsAccountId(ctx), name)),
+			Path:       new("/"),
+			Tags:       tags,
+			CreateDate: new(time.Now()),
+		},
+		policies: map[string]string{},
+	}
+	s.users[name] = u
+	cpy := u.user
+	return &cpy, nil
+}
+
+func (s *bucketStore) DeleteIamUser(ctx context.Context, name string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[name]
+	if !ok {
+		return noSuchIamEntityError(name)
+	}
+	if len(u.policies) > 0 || len(u.accessKeys) > 0 {
+		return &iamtypes.DeleteConflictException{Message: new(fmt.Sprintf("user %s still has policies or access keys", name))}
+	}
+	delete(s.users, name)
+	return nil
+}
+
+func (s *bucketStore) GetIamUserPolicy(ctx context.Context, userName, policyName string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return "", noSuchIamEntityError(userName)
+	}
+	return u.policies[policyName], nil
+}
+
+func (s *bucketStore) PutIamUserPolicy(ctx context.Context, userName, policyName, document string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return noSuchIamEntityError(userName)
+	}
+	u.policies[policyName] = document
+	return nil
+}
+
+func (s *bucketStore) DeleteIamUserPolicy(ctx context.Context, userName, policyName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return noSuchIamEntityError(userName)
+	}
+	if _, ok := u.policies[policyName]; !ok {
+		return noSuchIamEntityError(policyName)
+	}
+	delete(u.policies, policyName)
+	return nil
+}
+
+func (s *bucketStore) ListIamAccessKeys(ctx context.Context, userName string) ([]iamtypes.AccessKeyMetadata, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return nil, noSuchIamEntityError(userName)
+	}
+	return slices.Clone(u.accessKeys), nil
+}
+
+func (s *bucketStore) CreateIamAccessKey(ctx context.Context, userName string) (*iamtypes.AccessKey, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return nil, noSuchIamEntityError(userName)
+	}
+	if len(u.accessKeys) >= 2 {
+		return nil, &iamtypes.LimitExceededException{Message: new("Cannot exceed quota for AccessKeysPerUser: 2")}
+	}
+	key := &iamtypes.AccessKey{
+		UserName:        new(userName),
+		AccessKeyId:     new(fmt.Sprintf("AKIA%d", time.Now().UnixNano())),
+		SecretAccessKey: new(uuid.NewString()),
+		Status:          iamtypes.StatusTypeActive,
+		CreateDate:      new(time.Now()),
+	}
+	u.accessKeys = append(u.accessKeys, iamtypes.AccessKeyMetadata{
+		UserName:    key.UserName,
+		AccessKeyId: key.AccessKeyId,
+		Status:      key.Status,
+		CreateDate:  key.CreateDate,
+	})
+	return key, nil
+}
+
+func (s *bucketStore) DeleteIamAccessKey(ctx context.Context, userName, accessKeyId string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	u, ok := s.users[userName]
+	if !ok {
+		return noSuchIamEntityError(userName)
+	}
+	u.accessKeys = pie.Filter(u.accessKeys, func(k iamtypes.AccessKeyMetadata) bool {
+		return ptr.Deref(k.AccessKeyId, "") != accessKeyId
+	})
+	return nil
+}
@@ -23,10 +23,13 @@ type AwsElastiCacheMockUtils interface {
 	GetAWsElastiCacheNodeByName(name string) *elasticachetypes.CacheCluster
 	SetAwsElastiCacheLifeCycleState(name string, state awsmeta.ElastiCacheState)
 	SetAwsElastiCacheEngineVersion(name, engineVersion string)
+	ApplyAwsElastiCachePendingModifications(name string)
 	SetAwsElastiCacheUserGroupLifeCycleState(name string, state awsmeta.ElastiCacheUserGroupState)
 	DeleteAwsElastiCacheByName(name string)
 	DeleteAwsElastiCacheUserGroupByName(name string)
 	DescribeAwsElastiCacheParametersByName(groupName string) map[string]string
+	GetAwsElastiCacheSnapshotByName(name string) *elasticachetypes.Snapshot
+	SetAwsElastiCacheSnapshotState(name string, state awsmeta.ElastiCacheSnapshotState)
 }
 
 func getDefaultParams() map[string]elasticachetypes.Parameter {
@@ -53,6 +56,7 @@ func getDefaultParams() map[string]elasticachetypes.Parameter {
 type elastiCacheClientFake struct {
 	mutex             sync.Mutex
 	replicationGroups map[string]*elasticachetypes.ReplicationGroup
+	tags              map[string][]elasticachetypes.Tag
 	cacheClusters     map[string]*elasticachetypes.CacheCluster
 	parameters        map[string]map[string]elasticachetypes.Parameter
 	parameterGroups   map[string]*elasticachetypes.CacheParameterGroup
@@ -60,12 +64,14 @@ type elastiCacheClientFake struct {
 	userGroups        map[string]*elasticachetypes.UserGroup
 	secretStore       map[string]*secretsmanager.GetSecretValueOutput
 	securityGroups    []*ec2types.SecurityGroup
+	snapshots         map[string]*elasticachetypes.Snapshot
 }
 
 func newElastiCacheClientFake() *elastiCacheClientFake {
 	return &elastiCacheClientFake{
 		mutex:             sync.Mutex{},
 		replicationGroups: map[string]*elasticachetypes.ReplicationGroup{},
+		tags:              map[string][]elasticachetypes.Tag{},
 		cacheClusters:     map[string]*elasticachetypes.CacheCluster{},
 		subnetGroups:      map[string]*elasticachetypes.CacheSubnetGroup{},
 		parameterGroups:   map[string]*elasticachetypes.CacheParameterGroup{},
@@ -73,6 +79,7 @@ func newElastiCacheClientFake() *elastiCacheClientFake {
 		secretStore:       map[string]*secretsmanager.GetSecretValueOutput{},
 		userGroups:        map[string]*elasticachetypes.UserGroup{},
 		securityGroups:    []*ec2types.SecurityGroup{},
+		snapshots:         map[string]*elasticachetypes.Snapshot{},
 	}
 }
 
@@ -97,6 +104,23 @@ func (client *elastiCacheClientFake) SetAwsElastiCacheEngineVersion(name, engine
 	}
 }
 
+func (client *elastiCacheClientFake) ApplyAwsElastiCachePendingModifications(name string) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	cluster, ok := client.cacheClusters[name]
+	if !ok || cluster.PendingModifiedValues == nil {
+		return
+	}
+	if cluster.PendingModifiedValues.CacheNodeType != nil {
+		cluster.CacheNodeType = cluster.PendingModifiedValues.CacheNodeType
+		if instance, ok := client.replicationGroups[name]; ok {
+			instance.CacheNodeType = cluster.PendingModifiedValues.CacheNodeType
+		}
+	}
+	cluster.PendingModifiedValues = nil
+}
+
 func (client *elastiCacheClientFake) SetAwsElastiCacheUserGroupLifeCycleState(name string, state awsmeta.ElastiCacheUserGroupState) {
 	if instance, ok := client.userGroups[name]; ok {
 		instance.Status = new(state)
@@ -127,6 +151,22 @@ func (client *elastiCacheClientFake) DescribeAwsElastiCacheParametersByName(grou
 	return result
 }
 
+func (client *elastiCacheClientFake) GetAwsElastiCacheSnapshotByName(name string) *elasticachetypes.Snapshot {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return client.snapshots[name]
+}
+
+func (client *elastiCacheClientFake) SetAwsElastiCacheSnapshotState(name string, state awsmeta.ElastiCacheSnapshotState) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if snapshot, ok := client.snapshots[name]; ok {
+		snapshot.SnapshotStatus = new(state)
+	}
+}
+
 func (client *elastiCacheClientFake) DescribeElastiCacheSubnetGroup(ctx context.Context, name string) ([]elasticachetypes.CacheSubnetGroup, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -258,6 +298,21 @@ func (client *elastiCacheClientFake) DescribeEngineDefaultParameters(ctx context
 	return pie.Values(getDefaultParams()), nil
 }
 
+func (client *elastiCacheClientFake) DescribeCacheEngineVersions(ctx context.Context, engine string) ([]elasticachetypes.CacheEngineVersion, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	var result []elasticachetypes.CacheEngineVersion
+	for _, v := range []string{"6.0", "6.2", "7.0", "7.1"} {
+		result = append(result, elasticachetypes.CacheEngineVersion{
+			Engine:        new(engine),
+			EngineVersion: new(v),
+		})
+	}
+	return result, nil
+}
+
 func (client *elastiCacheClientFake) GetAuthTokenSecretValue(ctx context.Context, secretName string) (*secretsmanager.GetSecretValueOutput, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -315,6 +370,33 @@ func (client *elastiCacheClientFake) DescribeElastiCacheReplicationGroup(ctx con
 	return []elasticachetypes.ReplicationGroup{*cacheCluster}, nil
 }
 
+func (client *elastiCacheClientFake) DescribeElastiCacheReplicationGroups(ctx context.Context) ([]elasticachetypes.ReplicationGroup, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	result := make([]elasticachetypes.ReplicationGroup, 0, len(client.replicationGroups))
+	for _, rg := range client.replicationGroups {
+		result = append(result, *rg)
+	}
+
+	return result, nil
+}
+
+func (client *elastiCacheClientFake) ListElastiCacheTags(ctx context.Context, arn string) ([]elasticachetypes.Tag, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return append([]elasticachetypes.Tag{}, client.tags[arn]...), nil
+}
+
 func (client *elastiCacheClientFake) CreateElastiCacheReplicationGroup(ctx context.Context, tags []elasticachetypes.Tag, options awsclient.CreateElastiCacheClusterOptions) (*elasticache.CreateReplicationGroupOutput, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -323,6 +405,12 @@ func (client *elastiCacheClientFake) CreateElastiCacheReplicationGroup(ctx conte
 	client.mutex.Lock()
 	defer client.mutex.Unlock()
 
+	if options.SnapshotName != "" {
+		if _, ok := client.snapshots[options.SnapshotName]; !ok {
+			return nil, &elasticachetypes.SnapshotNotFoundFault{Message: new(fmt.Sprintf("snapshot %s not found", options.SnapshotName))}
+		}
+	}
+
 	client.cacheClusters[options.Name] = &elasticachetypes.CacheCluster{
 		CacheClusterId:             new(options.Name),
 		PreferredMaintenanceWindow: options.PreferredMaintenanceWindow,
@@ -343,9 +431,13 @@ func (client *elastiCacheClientFake) CreateElastiCacheReplicationGroup(ctx conte
 		memberClusters = append(memberClusters, fmt.Sprintf("%s-replica-%d", options.Name, i+1))
 	}
 
+	arn := fmt.Sprintf("arn:aws:elasticache:::replicationgroup:%s", options.Name)
+	client.tags[arn] = append([]elasticachetypes.Tag{}, tags...)
+
 	nodeGroups := createNodeGroups(options.Name, options.ShardCount, options.ReplicasPerNodeGroup)
 	client.replicationGroups[options.Name] = &elasticachetypes.ReplicationGroup{
 		ReplicationGroupId:       new(options.Name),
+		ARN:                      new(arn),
 		Status:                   new("creating"),
 		CacheNodeType:            new(options.CacheNodeType),
 		AutoMinorVersionUpgrade:  new(options.AutoMinorVersionUpgrade),
@@ -400,6 +492,16 @@ func (client *elastiCacheClientFake) ModifyElastiCacheReplicationGroup(ctx conte
 	client.mutex.Lock()
 	defer client.mutex.Unlock()
 
+	if options.ApplyInMaintenanceWindow {
+		if cluster, ok := client.cacheClusters[id]; ok && options.CacheNodeType != nil {
+			if cluster.PendingModifiedValues == nil {
+				cluster.PendingModifiedValues = &elasticachetypes.PendingModifiedValues{}
+			}
+			cluster.PendingModifiedValues.CacheNodeType = options.CacheNodeType
+		}
+		return &elasticache.ModifyReplicationGroupOutput{}, nil
+	}
+
 	if instance, ok := client.replicationGroups[id]; ok {
 		instance.Status = new("modifying")
 		if options.CacheNodeType != nil {
@@ -435,6 +537,10 @@ func (client *elastiCacheClientFake) ModifyElastiCacheReplicationGroup(ctx conte
 				EngineVersion: options.EngineVersion,
 			}
 		}
+
+		if options.CacheNodeType != nil {
+			instance.CacheNodeType = options.CacheNodeType
+		}
 	}
 
 	return &elasticache.ModifyReplicationGroupOutput{}, nil
@@ -488,6 +594,67 @@ func (client *elastiCacheClientFake) ModifyElastiCacheClusterReplicaConfiguratio
 	return nil
 }
 
+func (client *elastiCacheClientFake) DescribeElastiCacheSnapshot(ctx context.Context, name string) (*elasticachetypes.Snapshot, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	snapshot, ok := client.snapshots[name]
+	if !ok {
+		return nil, nil
+	}
+	result := *snapshot
+
+	return &result, nil
+}
+
+func (client *elastiCacheClientFake) CreateElastiCacheSnapshot(ctx context.Context, name, replicationGroupId string, tags []elasticachetypes.Tag) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if _, ok := client.snapshots[name]; ok {
+		return &elasticachetypes.SnapshotAlreadyExistsFault{Message: new(fmt.Sprintf("snapshot %s already exists", name))}
+	}
+	if _, ok := client.replicationGroups[replicationGroupId]; !ok {
+		return &elasticachetypes.ReplicationGroupNotFoundFault{Message: new(fmt.Sprintf("replication group %s not found", replicationGroupId))}
+	}
+
+	client.snapshots[name] = &elasticachetypes.Snapshot{
+		SnapshotName:       new(name),
+		ReplicationGroupId: new(replicationGroupId),
+		SnapshotSource:     new("manual"),
+		SnapshotStatus:     new(awsmeta.ElastiCache_Snapshot_CREATING),
+		ARN:                new(fmt.Sprintf("arn:aws:elasticache:us-east-1:123456789012:snapshot:%s", name)),
+	}
+	client.tags[name] = tags
+
+	return nil
+}
+
+func (client *elastiCacheClientFake) DeleteElastiCacheSnapshot(ctx context.Context, name string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if _, ok := client.snapshots[name]; !ok {
+		return &elasticachetypes.SnapshotNotFoundFault{Message: new(fmt.Sprintf("snapshot %s not found", name))}
+	}
+	delete(client.snapshots, name)
+	delete(client.tags, name)
+
+	return nil
+}
+
 func (client *elastiCacheClientFake) DescribeUserGroup(ctx context.Context, id string) (*elasticachetypes.UserGroup, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
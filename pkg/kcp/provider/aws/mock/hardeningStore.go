@@ -0,0 +1,267 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	"k8s.io/utils/ptr"
+)
+
+type AwsHardeningMockUtils interface {
+	GetAwsKmsKeyByAlias(aliasName string) *kmstypes.KeyMetadata
+	GetAwsKmsKey(keyId string) *kmstypes.KeyMetadata
+	IsAwsKmsKeyRotationEnabled(keyId string) bool
+	GetAwsFlowLogs(vpcId string) []ec2types.FlowLog
+}
+
+type kmsKeyEntry struct {
+	key             kmstypes.KeyMetadata
+	tags            []kmstypes.Tag
+	rotationEnabled bool
+}
+
+// hardeningStore holds the KMS keys and VPC flow logs provisioned for the hardened runtime baseline
+type hardeningStore struct {
+	m        sync.Mutex
+	keys     map[string]*kmsKeyEntry
+	aliases  map[string]string
+	flowLogs []*ec2types.FlowLog
+}
+
+func newHardeningStore() *hardeningStore {
+	return &hardeningStore{
+		keys:    map[string]*kmsKeyEntry{},
+		aliases: map[string]string{},
+	}
+}
+
+func kmsNotFoundError(msg string) error {
+	return &kmstypes.NotFoundException{Message: new(msg)}
+}
+
+// AwsHardeningMockUtils ==========================================================
+
+func (s *hardeningStore) GetAwsKmsKeyByAlias(aliasName string) *kmstypes.KeyMetadata {
+	s.m.Lock()
+	defer s.m.Unlock()
+	keyId, ok := s.aliases[aliasName]
+	if !ok {
+		return nil
+	}
+	key := s.keys[keyId].key
+	return &key
+}
+
+func (s *hardeningStore) GetAwsKmsKey(keyId string) *kmstypes.KeyMetadata {
+	s.m.Lock()
+	defer s.m.Unlock()
+	entry, ok := s.keys[keyId]
+	if !ok {
+		return nil
+	}
+	key := entry.key
+	return &key
+}
+
+func (s *hardeningStore) IsAwsKmsKeyRotationEnabled(keyId string) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	entry, ok := s.keys[keyId]
+	return ok && entry.rotationEnabled
+}
+
+func (s *hardeningStore) GetAwsFlowLogs(vpcId string) []ec2types.FlowLog {
+	s.m.Lock()
+	defer s.m.Unlock()
+	var result []ec2types.FlowLog
+	for _, fl := range s.flowLogs {
+		if ptr.Deref(fl.ResourceId, "") == vpcId {
+			result = append(result, *fl)
+		}
+	}
+	return result
+}
+
+// KMS ==========================================================================
+
+func (s *hardeningStore) DescribeKmsKeyByAlias(ctx context.Context, aliasName string) (*kmstypes.KeyMetadata, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	keyId, ok := s.aliases[aliasName]
+	if !ok {
+		return nil, nil
+	}
+	key := s.keys[keyId].key
+	return &key, nil
+}
+
+func (s *hardeningStore) CreateKmsKey(ctx context.Context, description string, tags []kmstypes.Tag) (*kmstypes.KeyMetadata, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	keyId := uuid.NewString()
+	entry := &kmsKeyEntry{
+		key: kmstypes.KeyMetadata{
+			KeyId:       new(keyId),
+			Arn:         new(fmt.Sprintf("arn:aws:kms:region:account:key/%s", keyId)),
+			Description: new(description),
+			Enabled:     true,
+			KeySpec:     kmstypes.KeySpecSymmetricDefault,
+			KeyUsage:    kmstypes.KeyUsageTypeEncryptDecrypt,
+			KeyState:    kmstypes.KeyStateEnabled,
+		},
+		tags: append([]kmstypes.Tag{}, tags...),
+	}
+	s.keys[keyId] = entry
+	key := entry.key
+	return &key, nil
+}
+
+func (s *hardeningStore) CreateKmsAlias(ctx context.Context, aliasName, keyId string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if !strings.HasPrefix(aliasName, "alias/") {
+		return fmt.Errorf("alias name %s must start with alias/", aliasName)
+	}
+	if _, ok := s.keys[keyId]; !ok {
+		return kmsNotFoundError(fmt.Sprintf("key %s does not exist", keyId))
+	}
+	if _, ok := s.aliases[aliasName]; ok {
+		return &kmstypes.AlreadyExistsException{Message: new(fmt.Sprintf("alias %s already exists", aliasName))}
+	}
+	s.aliases[aliasName] = keyId
+	return nil
+}
+
+func (s *hardeningStore) DeleteKmsAlias(ctx context.Context, aliasName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, ok := s.aliases[aliasName]; !ok {
+		return kmsNotFoundError(fmt.Sprintf("alias %s does not exist", aliasName))
+	}
+	delete(s.aliases, aliasName)
+	return nil
+}
+
+func (s *hardeningStore) ScheduleKmsKeyDeletion(ctx context.Context, keyId string, pendingWindowInDays int32) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	entry, ok := s.keys[keyId]
+	if !ok {
+		return kmsNotFoundError(fmt.Sprintf("key %s does not exist", keyId))
+	}
+	if pendingWindowInDays < 7 || pendingWindowInDays > 30 {
+		return fmt.Errorf("pending window of %d days must be between 7 and 30 days", pendingWindowInDays)
+	}
+	if entry.key.KeyState == kmstypes.KeyStatePendingDeletion {
+		return &kmstypes.KMSInvalidStateException{Message: new(fmt.Sprintf("key %s is pending deletion", keyId))}
+	}
+	entry.key.Enabled = false
+	entry.key.KeyState = kmstypes.KeyStatePendingDeletion
+	entry.key.PendingDeletionWindowInDays = new(pendingWindowInDays)
+	entry.key.DeletionDate = new(time.Now().AddDate(0, 0, int(pendingWindowInDays)))
+	return nil
+}
+
+func (s *hardeningStore) GetKmsKeyRotationEnabled(ctx context.Context, keyId string) (bool, error) {
+	if isContextCanceled(ctx) {
+		return false, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	entry, ok := s.keys[keyId]
+	if !ok {
+		return false, kmsNotFoundError(fmt.Sprintf("key %s does not exist", keyId))
+	}
+	return entry.rotationEnabled, nil
+}
+
+func (s *hardeningStore) EnableKmsKeyRotation(ctx context.Context, keyId string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	entry, ok := s.keys[keyId]
+	if !ok {
+		return kmsNotFoundError(fmt.Sprintf("key %s does not exist", keyId))
+	}
+	entry.rotationEnabled = true
+	return nil
+}
+
+// Flow logs ====================================================================
+
+func (s *hardeningStore) DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	list := pie.Filter(s.flowLogs, func(fl *ec2types.FlowLog) bool {
+		return ptr.Deref(fl.ResourceId, "") == resourceId
+	})
+	return pie.Map(list, func(fl *ec2types.FlowLog) ec2types.FlowLog {
+		return *fl
+	}), nil
+}
+
+func (s *hardeningStore) CreateS3FlowLogs(ctx context.Context, vpcId, bucketArn string, tags []ec2types.Tag) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	fl := &ec2types.FlowLog{
+		FlowLogId:          new("fl-" + uuid.NewString()[:8]),
+		FlowLogStatus:      new("ACTIVE"),
+		LogDestination:     new(bucketArn),
+		LogDestinationType: ec2types.LogDestinationTypeS3,
+		ResourceId:         new(vpcId),
+		TrafficType:        ec2types.TrafficTypeAll,
+		Tags:               append([]ec2types.Tag{}, tags...),
+	}
+	s.flowLogs = append(s.flowLogs, fl)
+	return ptr.Deref(fl.FlowLogId, ""), nil
+}
+
+func (s *hardeningStore) DeleteFlowLogs(ctx context.Context, flowLogIds []string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, id := range flowLogIds {
+		if !slices.ContainsFunc(s.flowLogs, func(fl *ec2types.FlowLog) bool {
+			return ptr.Deref(fl.FlowLogId, "") == id
+		}) {
+			return fmt.Errorf("error deleting flow logs %s: flow log does not exist", id)
+		}
+	}
+	s.flowLogs = pie.Filter(s.flowLogs, func(fl *ec2types.FlowLog) bool {
+		return !slices.Contains(flowLogIds, ptr.Deref(fl.FlowLogId, ""))
+	})
+	return nil
+}
@@ -3,6 +3,8 @@ package mock
 import (
 	"context"
 	"fmt"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
@@ -13,12 +15,19 @@ import (
 	"k8s.io/utils/ptr"
 	"slices"
 	"sync"
+	"time"
 )
 
 type NfsConfig interface {
 	SetFileSystemLifeCycleState(id string, state efstypes.LifeCycleState)
 	GetFileSystemById(id string) *efstypes.FileSystemDescription
 	SetFileSystemStatusCapacityById(id string, capacity resource.Quantity)
+	GetRestoreJobById(jobId string) *backup.DescribeRestoreJobOutput
+	// CompleteRestoreJob creates the file system restored by the job and marks the job as completed
+	CompleteRestoreJob(jobId string)
+	FailRestoreJob(jobId string, message string)
+	// GetRestoreJobBackupVaultName returns the backup vault the restore metadata of the job recovery point was loaded from
+	GetRestoreJobBackupVaultName(jobId string) string
 }
 
 type mountTargetItem struct {
@@ -31,6 +40,15 @@ type nfsStore struct {
 	sg           []*ec2types.SecurityGroup
 	fs           []*efstypes.FileSystemDescription
 	mountTargets map[string][]mountTargetItem
+	restoreJobs  []*restoreJobItem
+	// recoveryPointArn => backupVaultName used to load its restore metadata
+	restoreMetadataVaults map[string]string
+}
+
+type restoreJobItem struct {
+	job             backup.DescribeRestoreJobOutput
+	metadata        map[string]string
+	backupVaultName string
 }
 
 func filterMatchesTags(tags []ec2types.Tag, filter ec2types.Filter) bool {
@@ -92,6 +110,62 @@ func (s *nfsStore) GetFileSystemById(id string) *efstypes.FileSystemDescription
 	return nil
 }
 
+func (s *nfsStore) GetRestoreJobById(jobId string) *backup.DescribeRestoreJobOutput {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, item := range s.restoreJobs {
+		if ptr.Deref(item.job.RestoreJobId, "") == jobId {
+			return &item.job
+		}
+	}
+	return nil
+}
+
+func (s *nfsStore) CompleteRestoreJob(jobId string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, item := range s.restoreJobs {
+		if ptr.Deref(item.job.RestoreJobId, "") != jobId {
+			continue
+		}
+		id := uuid.NewString()
+		s.fs = append(s.fs, &efstypes.FileSystemDescription{
+			CreationToken:   new(item.metadata["CreationToken"]),
+			FileSystemId:    new(id),
+			LifeCycleState:  efstypes.LifeCycleStateAvailable,
+			PerformanceMode: efstypes.PerformanceMode(item.metadata["PerformanceMode"]),
+			Name:            new(id),
+		})
+		item.job.Status = backuptypes.RestoreJobStatusCompleted
+		item.job.CreatedResourceArn = new(awsutil.EfsArn("", "", id))
+		item.job.CompletionDate = new(time.Now())
+		return
+	}
+}
+
+func (s *nfsStore) GetRestoreJobBackupVaultName(jobId string) string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, item := range s.restoreJobs {
+		if ptr.Deref(item.job.RestoreJobId, "") == jobId {
+			return item.backupVaultName
+		}
+	}
+	return ""
+}
+
+func (s *nfsStore) FailRestoreJob(jobId string, message string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, item := range s.restoreJobs {
+		if ptr.Deref(item.job.RestoreJobId, "") == jobId {
+			item.job.Status = backuptypes.RestoreJobStatusFailed
+			item.job.StatusMessage = new(message)
+			return
+		}
+	}
+}
+
 // Client ===============================
 
 func (s *nfsStore) DescribeSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error) {
@@ -171,6 +245,53 @@ func (s *nfsStore) AuthorizeSecurityGroupIngress(ctx context.Context, groupId st
 	return nil
 }
 
+func (s *nfsStore) RevokeSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	securityGroup := s.getSecurityGroupNoLock(groupId)
+	if securityGroup == nil {
+		return fmt.Errorf("security group with id %s does not exist", groupId)
+	}
+	securityGroup.IpPermissions = revokeIpPermissions(securityGroup.IpPermissions, ipPermissions)
+	return nil
+}
+
+func (s *nfsStore) RevokeSecurityGroupEgress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	securityGroup := s.getSecurityGroupNoLock(groupId)
+	if securityGroup == nil {
+		return fmt.Errorf("security group with id %s does not exist", groupId)
+	}
+	securityGroup.IpPermissionsEgress = revokeIpPermissions(securityGroup.IpPermissionsEgress, ipPermissions)
+	return nil
+}
+
+func (s *nfsStore) getSecurityGroupNoLock(groupId string) *ec2types.SecurityGroup {
+	for _, sg := range s.sg {
+		if ptr.Deref(sg.GroupId, "") == groupId {
+			return sg
+		}
+	}
+	return nil
+}
+
+func revokeIpPermissions(existing, revoked []ec2types.IpPermission) []ec2types.IpPermission {
+	return pie.Filter(existing, func(perm ec2types.IpPermission) bool {
+		return !slices.ContainsFunc(revoked, func(r ec2types.IpPermission) bool {
+			return ptr.Deref(r.IpProtocol, "") == ptr.Deref(perm.IpProtocol, "") &&
+				ptr.Deref(r.FromPort, 0) == ptr.Deref(perm.FromPort, 0) &&
+				ptr.Deref(r.ToPort, 0) == ptr.Deref(perm.ToPort, 0)
+		})
+	})
+}
+
 func (s *nfsStore) DescribeFileSystems(ctx context.Context) ([]efstypes.FileSystemDescription, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -184,6 +305,13 @@ func (s *nfsStore) DescribeFileSystems(ctx context.Context) ([]efstypes.FileSyst
 	return result, nil
 }
 
+func (s *nfsStore) IsEfsAvailable(ctx context.Context) (bool, error) {
+	if isContextCanceled(ctx) {
+		return false, context.Canceled
+	}
+	return true, nil
+}
+
 func (s *nfsStore) CreateFileSystem(ctx context.Context, performanceMode efstypes.PerformanceMode, throughputMode efstypes.ThroughputMode, tags []efstypes.Tag) (*efs.CreateFileSystemOutput, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -240,6 +368,30 @@ func (s *nfsStore) DeleteFileSystem(ctx context.Context, fsId string) error {
 	return nil
 }
 
+func (s *nfsStore) TagFileSystem(ctx context.Context, fsId string, tags []efstypes.Tag) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, fs := range s.fs {
+		if ptr.Deref(fs.FileSystemId, "") != fsId {
+			continue
+		}
+		for _, tag := range tags {
+			fs.Tags = pie.Filter(fs.Tags, func(t efstypes.Tag) bool {
+				return ptr.Deref(t.Key, "") != ptr.Deref(tag.Key, "")
+			})
+			fs.Tags = append(fs.Tags, tag)
+		}
+		if name := awsutil.GetEfsTagValue(fs.Tags, "Name"); name != "" {
+			fs.Name = new(name)
+		}
+		return nil
+	}
+	return fmt.Errorf("file system with id %s does not exist", fsId)
+}
+
 func (s *nfsStore) DescribeMountTargets(ctx context.Context, fsId string) ([]efstypes.MountTargetDescription, error) {
 	if isContextCanceled(ctx) {
 		return nil, context.Canceled
@@ -324,3 +476,58 @@ func (s *nfsStore) DescribeMountTargetSecurityGroups(ctx context.Context, mountT
 	}
 	return nil, fmt.Errorf("mount target with id %s does not exist", mountTargetId)
 }
+
+func (s *nfsStore) GetRecoveryPointRestoreMetadata(ctx context.Context, accountId, backupVaultName, recoveryPointArn string) (map[string]string, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.restoreMetadataVaults == nil {
+		s.restoreMetadataVaults = map[string]string{}
+	}
+	s.restoreMetadataVaults[recoveryPointArn] = backupVaultName
+	return map[string]string{
+		"Encrypted":       "true",
+		"PerformanceMode": string(efstypes.PerformanceModeGeneralPurpose),
+	}, nil
+}
+
+func (s *nfsStore) StartEfsRestoreJob(ctx context.Context, recoveryPointArn, iamRoleArn, idempotencyToken string, metadata map[string]string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	item := &restoreJobItem{
+		job: backup.DescribeRestoreJobOutput{
+			CreationDate:     new(time.Now()),
+			IamRoleArn:       new(iamRoleArn),
+			RecoveryPointArn: new(recoveryPointArn),
+			ResourceType:     new("EFS"),
+			RestoreJobId:     new(uuid.NewString()),
+			Status:           backuptypes.RestoreJobStatusRunning,
+		},
+		metadata:        metadata,
+		backupVaultName: s.restoreMetadataVaults[recoveryPointArn],
+	}
+	s.restoreJobs = append(s.restoreJobs, item)
+	return ptr.Deref(item.job.RestoreJobId, ""), nil
+}
+
+func (s *nfsStore) DescribeRestoreJob(ctx context.Context, restoreJobId string) (*backup.DescribeRestoreJobOutput, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	for _, item := range s.restoreJobs {
+		if ptr.Deref(item.job.RestoreJobId, "") == restoreJobId {
+			job := item.job
+			return &job, nil
+		}
+	}
+	return nil, &backuptypes.ResourceNotFoundException{
+		Message: new(fmt.Sprintf("restore job %s not found", restoreJobId)),
+	}
+}
@@ -0,0 +1,345 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+type AwsRdsMockUtils interface {
+	GetAwsRdsInstanceByName(name string) *rdstypes.DBInstance
+	SetAwsRdsInstanceLifeCycleState(name string, state awsmeta.RdsInstanceState)
+	DeleteAwsRdsInstanceByName(name string)
+}
+
+type rdsClientFake struct {
+	mutex          sync.Mutex
+	instances      map[string]*rdstypes.DBInstance
+	subnetGroups   map[string]*rdstypes.DBSubnetGroup
+	secretStore    map[string]*secretsmanager.GetSecretValueOutput
+	securityGroups []*ec2types.SecurityGroup
+}
+
+func newRdsClientFake() *rdsClientFake {
+	return &rdsClientFake{
+		mutex:          sync.Mutex{},
+		instances:      map[string]*rdstypes.DBInstance{},
+		subnetGroups:   map[string]*rdstypes.DBSubnetGroup{},
+		secretStore:    map[string]*secretsmanager.GetSecretValueOutput{},
+		securityGroups: []*ec2types.SecurityGroup{},
+	}
+}
+
+func (client *rdsClientFake) GetAwsRdsInstanceByName(name string) *rdstypes.DBInstance {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return client.instances[name]
+}
+
+func (client *rdsClientFake) SetAwsRdsInstanceLifeCycleState(name string, state awsmeta.RdsInstanceState) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if instance, ok := client.instances[name]; ok {
+		instance.DBInstanceStatus = new(state)
+	}
+}
+
+func (client *rdsClientFake) DeleteAwsRdsInstanceByName(name string) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	delete(client.instances, name)
+}
+
+func (client *rdsClientFake) DescribeRdsSubnetGroup(ctx context.Context, name string) ([]rdstypes.DBSubnetGroup, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	subnetGroup := client.subnetGroups[name]
+	if subnetGroup == nil {
+		return []rdstypes.DBSubnetGroup{}, nil
+	}
+
+	return []rdstypes.DBSubnetGroup{*subnetGroup}, nil
+}
+
+func (client *rdsClientFake) CreateRdsSubnetGroup(ctx context.Context, name string, subnetIds []string, tags []rdstypes.Tag) (*rds.CreateDBSubnetGroupOutput, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.subnetGroups[name] = &rdstypes.DBSubnetGroup{
+		DBSubnetGroupName: new(name),
+		Subnets: pie.Map(subnetIds, func(id string) rdstypes.Subnet {
+			return rdstypes.Subnet{SubnetIdentifier: new(id)}
+		}),
+	}
+
+	return &rds.CreateDBSubnetGroupOutput{
+		DBSubnetGroup: client.subnetGroups[name],
+	}, nil
+}
+
+func (client *rdsClientFake) DeleteRdsSubnetGroup(ctx context.Context, name string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	delete(client.subnetGroups, name)
+
+	return nil
+}
+
+func (client *rdsClientFake) GetMasterPasswordSecretValue(ctx context.Context, secretName string) (*secretsmanager.GetSecretValueOutput, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return client.secretStore[secretName], nil
+}
+
+func (client *rdsClientFake) CreateMasterPasswordSecret(ctx context.Context, secretName string, tags []secretsmanagertypes.Tag) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.secretStore[secretName] = &secretsmanager.GetSecretValueOutput{
+		Name:         new(secretName),
+		SecretString: new(uuid.NewString()),
+	}
+
+	return nil
+}
+
+func (client *rdsClientFake) DeleteMasterPasswordSecret(ctx context.Context, secretName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	delete(client.secretStore, secretName)
+
+	return nil
+}
+
+func (client *rdsClientFake) DescribeRdsInstance(ctx context.Context, id string) ([]rdstypes.DBInstance, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	instance := client.instances[id]
+	if instance == nil {
+		return nil, nil
+	}
+
+	return []rdstypes.DBInstance{*instance}, nil
+}
+
+func (client *rdsClientFake) CreateRdsInstance(ctx context.Context, tags []rdstypes.Tag, options awsclient.CreateRdsInstanceOptions) (*rds.CreateDBInstanceOutput, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	vpcSecurityGroups := pie.Map(options.SecurityGroupIds, func(id string) rdstypes.VpcSecurityGroupMembership {
+		return rdstypes.VpcSecurityGroupMembership{VpcSecurityGroupId: new(id), Status: new("active")}
+	})
+
+	client.instances[options.Name] = &rdstypes.DBInstance{
+		DBInstanceIdentifier: new(options.Name),
+		DBInstanceArn:        new(fmt.Sprintf("arn:aws:rds:region:account:db:%s", options.Name)),
+		DBInstanceClass:      new(options.InstanceClass),
+		DBInstanceStatus:     new(awsmeta.Rds_CREATING),
+		Engine:               new("postgres"),
+		EngineVersion:        new(options.EngineVersion),
+		AllocatedStorage:     new(options.StorageGb),
+		MultiAZ:              new(options.MultiAz),
+		DBName:               new(options.DatabaseName),
+		MasterUsername:       new(options.MasterUsername),
+		Endpoint: &rdstypes.Endpoint{
+			Address: new(fmt.Sprintf("%s.rds.amazonaws.com", options.Name)),
+			Port:    new(int32(5432)),
+		},
+		DBSubnetGroup: &rdstypes.DBSubnetGroup{
+			DBSubnetGroupName: new(options.SubnetGroupName),
+		},
+		VpcSecurityGroups:          vpcSecurityGroups,
+		PreferredMaintenanceWindow: options.PreferredMaintenanceWindow,
+		TagList:                    tags,
+	}
+
+	return &rds.CreateDBInstanceOutput{
+		DBInstance: client.instances[options.Name],
+	}, nil
+}
+
+func (client *rdsClientFake) ModifyRdsInstance(ctx context.Context, id string, options awsclient.ModifyRdsInstanceOptions) (*rds.ModifyDBInstanceOutput, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	instance := client.instances[id]
+	if instance == nil {
+		return nil, awsmeta.NewHttpNotFoundError(fmt.Errorf("rds instance %s not found", id))
+	}
+
+	if options.InstanceClass != nil {
+		instance.DBInstanceClass = options.InstanceClass
+	}
+	if options.EngineVersion != nil {
+		instance.EngineVersion = options.EngineVersion
+	}
+	if options.StorageGb != nil {
+		instance.AllocatedStorage = options.StorageGb
+	}
+	if options.MultiAz != nil {
+		instance.MultiAZ = options.MultiAz
+	}
+	if options.PreferredMaintenanceWindow != nil {
+		instance.PreferredMaintenanceWindow = options.PreferredMaintenanceWindow
+	}
+	instance.DBInstanceStatus = new(awsmeta.Rds_MODIFYING)
+
+	return &rds.ModifyDBInstanceOutput{
+		DBInstance: instance,
+	}, nil
+}
+
+func (client *rdsClientFake) DeleteRdsInstance(ctx context.Context, id string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if instance, ok := client.instances[id]; ok {
+		instance.DBInstanceStatus = new(awsmeta.Rds_DELETING)
+	}
+
+	return nil
+}
+
+func (client *rdsClientFake) DescribeRdsSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	list := append([]*ec2types.SecurityGroup{}, client.securityGroups...)
+	if groupIds != nil {
+		list = pie.Filter(list, func(sg *ec2types.SecurityGroup) bool {
+			return pie.Contains(groupIds, ptr.Deref(sg.GroupId, ""))
+		})
+	}
+	if filters != nil {
+		list = pie.Filter(list, func(sg *ec2types.SecurityGroup) bool {
+			return anyFilterMatchTags(sg.Tags, filters)
+		})
+	}
+	result := make([]ec2types.SecurityGroup, 0, len(list))
+	for _, x := range list {
+		result = append(result, *x)
+	}
+	return result, nil
+}
+
+func (client *rdsClientFake) CreateRdsSecurityGroup(ctx context.Context, vpcId, name string, tags []ec2types.Tag) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	tags = append(tags, ec2types.Tag{
+		Key:   new("vpc-id"),
+		Value: new(vpcId),
+	})
+	sg := &ec2types.SecurityGroup{
+		Description: new(name),
+		GroupId:     new(uuid.NewString()),
+		GroupName:   new(name),
+		Tags:        tags,
+		VpcId:       new(vpcId),
+	}
+	client.securityGroups = append(client.securityGroups, sg)
+	return ptr.Deref(sg.GroupId, ""), nil
+}
+
+func (client *rdsClientFake) AuthorizeRdsSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	var securityGroup *ec2types.SecurityGroup
+	for _, sg := range client.securityGroups {
+		if ptr.Deref(sg.GroupId, "") == groupId {
+			securityGroup = sg
+			break
+		}
+	}
+	if securityGroup == nil {
+		return fmt.Errorf("security group with id %s does not exist", groupId)
+	}
+	securityGroup.IpPermissions = ipPermissions
+	return nil
+}
+
+func (client *rdsClientFake) DeleteRdsSecurityGroup(ctx context.Context, id string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.securityGroups = pie.Filter(client.securityGroups, func(sg *ec2types.SecurityGroup) bool {
+		return ptr.Deref(sg.GroupId, "") != id
+	})
+	return nil
+}
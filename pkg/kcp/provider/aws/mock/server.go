@@ -10,6 +10,7 @@ import (
 	"github.com/aws/smithy-go"
 	"github.com/elliotchance/pie/v2"
 	"github.com/google/uuid"
+	awsbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket/client"
 	awsexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/exposedData/client"
 	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
 	awsvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork/client"
@@ -18,6 +19,8 @@ import (
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/iprange/client"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/runtime/client"
 	awsvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering/client"
 	scopeclient "github.com/kyma-project/cloud-manager/pkg/kcp/scope/client"
 )
@@ -162,6 +165,16 @@ func (s *server) ElastiCacheProviderFake() awsclient.SkrClientProvider[awsclient
 	}
 }
 
+func (s *server) RdsProviderFake() awsclient.SkrClientProvider[awsclient.RdsClient] {
+	return func(ctx context.Context, account, region, key, secret, role string) (awsclient.RdsClient, error) {
+		acc := s.GetAccount(account)
+		if acc == nil {
+			return nil, ErrNoAccount
+		}
+		return acc.Region(region), nil
+	}
+}
+
 func (s *server) ExposedDataProvider() awsclient.SkrClientProvider[awsexposeddataclient.Client] {
 	return func(_ context.Context, account, region, key, secret, role string) (awsexposeddataclient.Client, error) {
 		acc := s.GetAccount(account)
@@ -181,3 +194,33 @@ func (s *server) VpcNetworkProvider() awsclient.SkrClientProvider[awsvpcnetworkc
 		return acc.Region(region), nil
 	}
 }
+
+func (s *server) BucketProvider() awsclient.SkrClientProvider[awsbucketclient.Client] {
+	return func(_ context.Context, account, region, key, secret, role string) (awsbucketclient.Client, error) {
+		acc := s.GetAccount(account)
+		if acc == nil {
+			return nil, ErrNoAccount
+		}
+		return acc.Region(region), nil
+	}
+}
+
+func (s *server) NukeOrphanProvider() awsclient.SkrClientProvider[awsnukeclient.NukeOrphanClient] {
+	return func(_ context.Context, account, region, key, secret, role string) (awsnukeclient.NukeOrphanClient, error) {
+		acc := s.GetAccount(account)
+		if acc == nil {
+			return nil, ErrNoAccount
+		}
+		return acc.Region(region), nil
+	}
+}
+
+func (s *server) RuntimeProvider() awsclient.SkrClientProvider[awsruntimeclient.Client] {
+	return func(_ context.Context, account, region, key, secret, role string) (awsruntimeclient.Client, error) {
+		acc := s.GetAccount(account)
+		if acc == nil {
+			return nil, ErrNoAccount
+		}
+		return acc.Region(region), nil
+	}
+}
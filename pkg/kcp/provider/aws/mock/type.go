@@ -1,10 +1,13 @@
 package mock
 
 import (
+	awsbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/bucket/client"
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/exposedData/client"
 	awsiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/iprange/client"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/runtime/client"
 	awsvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcnetwork/client"
 	awsvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering/client"
 	scopeclient "github.com/kyma-project/cloud-manager/pkg/kcp/scope/client"
@@ -27,6 +30,10 @@ type ElastiCacheClient interface {
 	awsclient.ElastiCacheClient
 }
 
+type RdsClient interface {
+	awsclient.RdsClient
+}
+
 type ScopeClient interface {
 	scopeclient.AwsStsClient
 }
@@ -43,13 +50,29 @@ type VpcNetworkClient interface {
 	awsvpcnetworkclient.Client
 }
 
+type BucketClient interface {
+	awsbucketclient.Client
+}
+
+type NukeOrphanClient interface {
+	awsnukeclient.NukeOrphanClient
+}
+
+type RuntimeClient interface {
+	awsruntimeclient.Client
+}
+
 type Clients interface {
 	IpRangeClient
 	NfsClient
 	VpcPeeringClient
 	ElastiCacheClient
+	RdsClient
 	ExposedDataClient
 	VpcNetworkClient
+	BucketClient
+	NukeOrphanClient
+	RuntimeClient
 }
 
 type Providers interface {
@@ -59,8 +82,12 @@ type Providers interface {
 	NfsInstanceSkrProvider() awsclient.SkrClientProvider[awsnfsinstanceclient.Client]
 	VpcPeeringSkrProvider() awsclient.SkrClientProvider[awsvpcpeeringclient.Client]
 	ElastiCacheProviderFake() awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
+	RdsProviderFake() awsclient.SkrClientProvider[awsclient.RdsClient]
 	ExposedDataProvider() awsclient.SkrClientProvider[awsexposeddataclient.Client]
 	VpcNetworkProvider() awsclient.SkrClientProvider[awsvpcnetworkclient.Client]
+	BucketProvider() awsclient.SkrClientProvider[awsbucketclient.Client]
+	NukeOrphanProvider() awsclient.SkrClientProvider[awsnukeclient.NukeOrphanClient]
+	RuntimeProvider() awsclient.SkrClientProvider[awsruntimeclient.Client]
 }
 
 type Configs interface {
@@ -69,6 +96,9 @@ type Configs interface {
 	VpcPeeringConfig
 	RouteTableConfig
 	AwsElastiCacheMockUtils
+	AwsRdsMockUtils
+	AwsBucketMockUtils
+	AwsHardeningMockUtils
 }
 
 type AccountRegion interface {
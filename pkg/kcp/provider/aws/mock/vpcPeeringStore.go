@@ -59,6 +59,7 @@ func (s *vpcPeeringStore) CreateVpcPeeringConnection(ctx context.Context, vpcId,
 				Code:    ec2types.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
 				Message: nil,
 			},
+			Tags: append([]ec2types.Tag{}, tags...),
 		}
 
 		item := &vpcPeeringEntry{
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/service/backup"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
@@ -24,6 +25,7 @@ func NewClientProvider() awsclient.SkrClientProvider[Client] {
 		return newClient(
 			awsclient.NewEc2Client(ec2.NewFromConfig(cfg)),
 			awsclient.NewEfsClient(efs.NewFromConfig(cfg)),
+			awsclient.NewBackupClient(backup.NewFromConfig(cfg)),
 		), nil
 	}
 }
@@ -43,17 +45,23 @@ type Client interface {
 		tags []efstypes.Tag,
 	) (*efs.CreateFileSystemOutput, error)
 	DeleteFileSystem(ctx context.Context, fsId string) error
+	TagFileSystem(ctx context.Context, fsId string, tags []efstypes.Tag) error
 	DescribeMountTargets(ctx context.Context, fsId string) ([]efstypes.MountTargetDescription, error)
 	CreateMountTarget(ctx context.Context, fsId, subnetId string, securityGroups []string) (string, error)
 	DeleteMountTarget(ctx context.Context, mountTargetId string) error
 
 	DescribeMountTargetSecurityGroups(ctx context.Context, mountTargetId string) ([]string, error)
+
+	GetRecoveryPointRestoreMetadata(ctx context.Context, accountId, backupVaultName, recoveryPointArn string) (map[string]string, error)
+	StartEfsRestoreJob(ctx context.Context, recoveryPointArn, iamRoleArn, idempotencyToken string, metadata map[string]string) (string, error)
+	DescribeRestoreJob(ctx context.Context, restoreJobId string) (*backup.DescribeRestoreJobOutput, error)
 }
 
-func newClient(ec2Client awsclient.Ec2Client, efsClient awsclient.EfsClient) Client {
+func newClient(ec2Client awsclient.Ec2Client, efsClient awsclient.EfsClient, backupClient awsclient.BackupClient) Client {
 	return &client{
-		Ec2Client: ec2Client,
-		EfsClient: efsClient,
+		Ec2Client:    ec2Client,
+		EfsClient:    efsClient,
+		BackupClient: backupClient,
 	}
 }
 
@@ -62,4 +70,5 @@ var _ Client = (*client)(nil)
 type client struct {
 	awsclient.Ec2Client
 	awsclient.EfsClient
+	awsclient.BackupClient
 }
@@ -6,12 +6,10 @@ import (
 	"fmt"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
-	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 	"time"
 )
 
@@ -27,28 +25,7 @@ func createEfs(ctx context.Context, st composed.State) (error, context.Context)
 		ctx,
 		efstypes.PerformanceMode(state.ObjAsNfsInstance().Spec.Instance.Aws.PerformanceMode),
 		efstypes.ThroughputMode(state.ObjAsNfsInstance().Spec.Instance.Aws.Throughput),
-		[]efstypes.Tag{
-			{
-				Key:   new("Name"),
-				Value: new(state.Obj().GetName()),
-			},
-			{
-				Key:   ptr.To(common.TagCloudManagerName),
-				Value: new(state.Name().String()),
-			},
-			{
-				Key:   ptr.To(common.TagCloudManagerRemoteName),
-				Value: new(state.ObjAsNfsInstance().Spec.RemoteRef.String()),
-			},
-			{
-				Key:   ptr.To(common.TagScope),
-				Value: new(state.ObjAsNfsInstance().Spec.Scope.Name),
-			},
-			{
-				Key:   ptr.To(common.TagShoot),
-				Value: new(state.Scope().Spec.ShootName),
-			},
-		},
+		state.getEfsTags(),
 	)
 
 	if err != nil {
@@ -0,0 +1,43 @@
+package nfsinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+// efsRemoved returns true if the EFS of a Ready NfsInstance was removed out of band
+func efsRemoved(_ context.Context, st composed.State) bool {
+	return st.(*State).efs == nil
+}
+
+// detectDrift reports the EFS resources of a Ready NfsInstance that were removed out of band.
+// Mount targets are described here without their security groups, since loadMountTargets runs
+// only after the file system is available and is throttled per mount target.
+func detectDrift(ctx context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	if state.efs == nil {
+		return []string{"fileSystem"}
+	}
+
+	mtList, err := state.awsClient.DescribeMountTargets(ctx, ptr.Deref(state.efs.FileSystemId, ""))
+	if err != nil && !awsmeta.IsNotFound(err) {
+		composed.LoggerFromCtx(ctx).Error(err, "Error loading mount targets for drift detection")
+		return nil
+	}
+
+	mountTargetSubnets := make(map[string]struct{}, len(mtList))
+	for _, mt := range mtList {
+		mountTargetSubnets[ptr.Deref(mt.SubnetId, "")] = struct{}{}
+	}
+	for _, subnet := range state.IpRange().Status.Subnets {
+		if _, ok := mountTargetSubnets[subnet.Id]; !ok {
+			return []string{"mountTargets"}
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	nfsinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/nfsinstance/types"
 	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
 )
@@ -32,6 +33,9 @@ func New(stateFactory StateFactory) composed.Action {
 					loadSecurityGroup,
 					authorizeSecurityGroupIngress,
 					loadEfs,
+					drift.NewWithRemoved(drift.KindNfsInstance, detectDrift, efsRemoved),
+					startEfsRestore,
+					waitEfsRestored,
 					createEfs,
 					waitEfsAvailable,
 					loadMountTargets,
@@ -59,6 +63,7 @@ func New(stateFactory StateFactory) composed.Action {
 
 					deleteSecurityGroup,
 
+					drift.Forget(drift.KindNfsInstance),
 					removeFinalizer,
 
 					StopAndRequeueForCapacityAction(),
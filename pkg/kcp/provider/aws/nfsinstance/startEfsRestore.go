@@ -0,0 +1,92 @@
+package nfsinstance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func startEfsRestore(ctx context.Context, st composed.State) (error, context.Context) {
+	logger := composed.LoggerFromCtx(ctx)
+	state := st.(*State)
+	nfsInstance := state.ObjAsNfsInstance()
+
+	if state.efs != nil || nfsInstance.Spec.Instance.Aws.SourceRecoveryPointId == "" {
+		return nil, nil
+	}
+
+	if nfsInstance.Status.OpIdentifier != "" {
+		// restore job already started
+		return nil, nil
+	}
+
+	recoveryPointArn := state.getSourceRecoveryPointArn()
+
+	metadata, err := state.awsClient.GetRecoveryPointRestoreMetadata(ctx,
+		state.Scope().Spec.Scope.Aws.AccountId,
+		state.getSourceBackupVaultName(),
+		recoveryPointArn,
+	)
+	if err != nil {
+		logger.Error(err, "Error loading AWS recovery point restore metadata")
+		return composed.UpdateStatus(nfsInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Failed loading restore metadata of the recovery point: %s", err),
+			}).
+			ErrorLogMessage("Error updating NfsInstance status due failed loading of recovery point restore metadata").
+			SuccessError(composed.StopWithRequeueDelay(time.Minute)).
+			Run(ctx, state)
+	}
+
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadata["newFileSystem"] = "true"
+	metadata["CreationToken"] = nfsInstance.Name
+	metadata["Encrypted"] = "true"
+	metadata["PerformanceMode"] = string(efstypes.PerformanceMode(nfsInstance.Spec.Instance.Aws.PerformanceMode))
+
+	jobId, err := state.awsClient.StartEfsRestoreJob(ctx,
+		recoveryPointArn,
+		awsutil.RoleArnBackup(state.Scope().Spec.Scope.Aws.AccountId),
+		nfsInstance.Name,
+		metadata,
+	)
+	if err != nil {
+		logger.Error(err, "Error starting AWS EFS restore job")
+		return composed.UpdateStatus(nfsInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Failed starting restore of the file system: %s", err),
+			}).
+			ErrorLogMessage("Error updating NfsInstance status due failed starting of restore job").
+			SuccessError(composed.StopWithRequeueDelay(time.Minute)).
+			Run(ctx, state)
+	}
+
+	logger.Info("AWS EFS restore job started", "restoreJobId", jobId)
+
+	nfsInstance.Status.OpIdentifier = jobId
+	return composed.UpdateStatus(nfsInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeRestoring,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ConditionTypeRestoring,
+			Message: fmt.Sprintf("EFS is being restored from recovery point %s.", nfsInstance.Spec.Instance.Aws.SourceRecoveryPointId),
+		}).
+		ErrorLogMessage("Error updating NfsInstance status with restore job id").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T1000ms())).
+		Run(ctx, state)
+}
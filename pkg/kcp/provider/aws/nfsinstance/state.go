@@ -2,15 +2,18 @@ package nfsinstance
 
 import (
 	"context"
+	"fmt"
 
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	nfsinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/nfsinstance/types"
 	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
 	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
 	awsnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nfsinstance/client"
 	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"k8s.io/utils/ptr"
 )
 
 type State struct {
@@ -64,6 +67,52 @@ func newState(nfsInstanceState nfsinstancetypes.State, c awsnfsinstanceclient.Cl
 	}
 }
 
+func (s *State) getEfsTags() []efstypes.Tag {
+	return []efstypes.Tag{
+		{
+			Key:   new("Name"),
+			Value: new(s.Obj().GetName()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(s.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(s.ObjAsNfsInstance().Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(s.ObjAsNfsInstance().Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(s.Scope().Spec.ShootName),
+		},
+	}
+}
+
+func (s *State) getSourceRecoveryPointArn() string {
+	id := s.ObjAsNfsInstance().Spec.Instance.Aws.SourceRecoveryPointId
+	if id == "" {
+		return ""
+	}
+	return awsutil.BackupRecoveryPointArn(s.Scope().Spec.Region, s.Scope().Spec.Scope.Aws.AccountId, id)
+}
+
+func (s *State) getBackupVaultName() string {
+	return fmt.Sprintf("cm-%s", s.Scope().Name)
+}
+
+// getSourceBackupVaultName returns the vault of the source recovery point, which is the
+// vault of another runtime when restoring a backup shared with this one.
+func (s *State) getSourceBackupVaultName() string {
+	if name := s.ObjAsNfsInstance().Spec.Instance.Aws.SourceBackupVaultName; name != "" {
+		return name
+	}
+	return s.getBackupVaultName()
+}
+
 func stopAndRequeueForCapacity() error {
 	return composed.StopWithRequeueDelay(awsconfig.AwsConfig.EfsCapacityCheckInterval)
 }
@@ -0,0 +1,57 @@
+package nfsinstance
+
+import (
+	"context"
+	"fmt"
+
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// waitEfsRestored waits for the restore job to complete, and tags the restored file system
+// so it is found by loadEfs same as the created one
+func waitEfsRestored(ctx context.Context, st composed.State) (error, context.Context) {
+	logger := composed.LoggerFromCtx(ctx)
+	state := st.(*State)
+	nfsInstance := state.ObjAsNfsInstance()
+
+	if state.efs != nil || nfsInstance.Spec.Instance.Aws.SourceRecoveryPointId == "" {
+		return nil, nil
+	}
+
+	job, err := state.awsClient.DescribeRestoreJob(ctx, nfsInstance.Status.OpIdentifier)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading AWS EFS restore job", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	switch job.Status {
+	case backuptypes.RestoreJobStatusCompleted:
+		fsId := awsutil.ParseArnResourceId(ptr.Deref(job.CreatedResourceArn, ""))
+		err = state.awsClient.TagFileSystem(ctx, fsId, state.getEfsTags())
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error tagging restored AWS EFS", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
+		}
+		logger.Info("AWS EFS restored", "efsId", fsId)
+		return composed.StopWithRequeue, nil
+	case backuptypes.RestoreJobStatusFailed, backuptypes.RestoreJobStatusAborted:
+		return composed.UpdateStatus(nfsInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Restore of the file system ended with status %s: %s", job.Status, ptr.Deref(job.StatusMessage, "")),
+			}).
+			ErrorLogMessage("Error updating NfsInstance status due failed restore job").
+			SuccessLogMsg("AWS EFS restore job failed").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	default:
+		logger.Info("Waiting for AWS EFS restore job to complete", "restoreJobStatus", job.Status)
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+)
+
+const (
+	AwsEfsFileSystem               = "AwsEfsFileSystem"
+	AwsElastiCacheReplicationGroup = "AwsElastiCacheReplicationGroup"
+	AwsVpcPeeringConnection        = "AwsVpcPeeringConnection"
+	AwsSubnet                      = "AwsSubnet"
+)
+
+// NukeOrphanClient lists and deletes provider resources created by cloud-manager
+// that might have been left behind without their KCP object
+type NukeOrphanClient interface {
+	DescribeVpcs(ctx context.Context, name string) ([]ec2types.Vpc, error)
+	DescribeSubnets(ctx context.Context, vpcId string) ([]ec2types.Subnet, error)
+	DeleteSubnet(ctx context.Context, subnetId string) error
+
+	DescribeVpcPeeringConnections(ctx context.Context) ([]ec2types.VpcPeeringConnection, error)
+	DeleteVpcPeeringConnection(ctx context.Context, connectionId *string) error
+
+	DescribeFileSystems(ctx context.Context) ([]efstypes.FileSystemDescription, error)
+	DescribeMountTargets(ctx context.Context, fsId string) ([]efstypes.MountTargetDescription, error)
+	DeleteMountTarget(ctx context.Context, mountTargetId string) error
+	DeleteFileSystem(ctx context.Context, fsId string) error
+
+	DescribeElastiCacheReplicationGroups(ctx context.Context) ([]elasticachetypes.ReplicationGroup, error)
+	ListElastiCacheTags(ctx context.Context, arn string) ([]elasticachetypes.Tag, error)
+	DeleteElastiCacheReplicationGroup(ctx context.Context, id string) error
+}
+
+func NewOrphanClientProvider() awsclient.SkrClientProvider[NukeOrphanClient] {
+	return func(ctx context.Context, account, region, key, secret, role string) (NukeOrphanClient, error) {
+		cfg, err := awsclient.NewSkrConfig(ctx, region, key, secret, role)
+		if err != nil {
+			return nil, err
+		}
+		elastiCacheClient, err := awsclient.NewElastiCacheClientProvider()(ctx, account, region, key, secret, role)
+		if err != nil {
+			return nil, err
+		}
+		return &orphanClient{
+			Ec2Client:         awsclient.NewEc2Client(ec2.NewFromConfig(cfg)),
+			EfsClient:         awsclient.NewEfsClient(efs.NewFromConfig(cfg)),
+			ElastiCacheClient: elastiCacheClient,
+		}, nil
+	}
+}
+
+var _ NukeOrphanClient = (*orphanClient)(nil)
+
+type orphanClient struct {
+	awsclient.Ec2Client
+	awsclient.EfsClient
+	awsclient.ElastiCacheClient
+}
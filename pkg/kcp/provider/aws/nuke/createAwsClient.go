@@ -35,5 +35,19 @@ func createAwsClient(ctx context.Context, st composed.State) (error, context.Con
 
 	state.awsClient = cli
 
+	orphanCli, err := state.awsOrphanClientProvider(
+		ctx,
+		state.Scope().Spec.Scope.Aws.AccountId,
+		state.Scope().Spec.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		roleName,
+	)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error assuming AWS role for orphan resources", err, ctx)
+	}
+
+	state.awsOrphanClient = orphanCli
+
 	return nil, nil
 }
@@ -0,0 +1,57 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	"k8s.io/utils/ptr"
+)
+
+func deleteEfsFileSystems(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == awsnukeclient.AwsEfsFileSystem && rks.Provider == cloudcontrolv1beta1.ProviderAws {
+			for _, obj := range rks.Objects {
+				fs := obj.(AwsEfsFileSystem)
+				if fs.LifeCycleState == efstypes.LifeCycleStateDeleting {
+					continue
+				}
+
+				// file system can be deleted only after all its mount targets are deleted
+				mountTargets, err := state.awsOrphanClient.DescribeMountTargets(ctx, fs.GetId())
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error listing mount targets of Aws EFS file system %s", fs.GetId()))
+					state.AddDeleteError(fmt.Errorf("error listing mount targets of Aws EFS file system %s: %w", fs.GetId(), err))
+					continue
+				}
+				if len(mountTargets) > 0 {
+					for _, mt := range mountTargets {
+						if mt.LifeCycleState == efstypes.LifeCycleStateDeleting {
+							continue
+						}
+						err = state.awsOrphanClient.DeleteMountTarget(ctx, ptr.Deref(mt.MountTargetId, ""))
+						if err != nil {
+							logger.Error(err, fmt.Sprintf("Error requesting Aws EFS mount target deletion %s", ptr.Deref(mt.MountTargetId, "")))
+							state.AddDeleteError(fmt.Errorf("error deleting Aws EFS mount target %s: %w", ptr.Deref(mt.MountTargetId, ""), err))
+						}
+					}
+					continue
+				}
+
+				err = state.awsOrphanClient.DeleteFileSystem(ctx, fs.GetId())
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Aws EFS file system deletion %s", fs.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Aws EFS file system %s: %w", fs.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
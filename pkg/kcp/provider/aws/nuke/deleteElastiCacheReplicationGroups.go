@@ -0,0 +1,35 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	"k8s.io/utils/ptr"
+)
+
+func deleteElastiCacheReplicationGroups(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == awsnukeclient.AwsElastiCacheReplicationGroup && rks.Provider == cloudcontrolv1beta1.ProviderAws {
+			for _, obj := range rks.Objects {
+				rg := obj.(AwsElastiCacheReplicationGroup)
+				if ptr.Deref(rg.Status, "") == awsmeta.ElastiCache_DELETING {
+					continue
+				}
+				err := state.awsOrphanClient.DeleteElastiCacheReplicationGroup(ctx, rg.GetId())
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Aws ElastiCache replication group deletion %s", rg.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Aws ElastiCache replication group %s: %w", rg.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
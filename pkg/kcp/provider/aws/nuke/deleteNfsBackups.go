@@ -25,6 +25,7 @@ func deleteNfsBackup(ctx context.Context, st composed.State) (error, context.Con
 				_, err := state.awsClient.DeleteRecoveryPoint(ctx, vault, arn)
 				if err != nil {
 					logger.Error(err, fmt.Sprintf("Error requesting Aws NfsVolume Backup deletion %s", backup.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Aws NfsVolume Backup %s: %w", backup.GetId(), err))
 				}
 			}
 		}
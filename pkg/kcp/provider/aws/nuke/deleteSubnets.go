@@ -0,0 +1,33 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+)
+
+// deleteSubnets requests deletion of orphan subnets. Subnet can not be deleted while other resources
+// like EFS mount targets or ElastiCache nodes are still using it, so it should run after those are
+// deleted, and failed attempts are retried on the next reconciliation.
+func deleteSubnets(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == awsnukeclient.AwsSubnet && rks.Provider == cloudcontrolv1beta1.ProviderAws {
+			for _, obj := range rks.Objects {
+				subnet := obj.(AwsSubnet)
+				err := state.awsOrphanClient.DeleteSubnet(ctx, subnet.GetId())
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Aws subnet deletion %s", subnet.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Aws subnet %s: %w", subnet.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,34 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+)
+
+func deleteVpcPeeringConnections(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == awsnukeclient.AwsVpcPeeringConnection && rks.Provider == cloudcontrolv1beta1.ProviderAws {
+			for _, obj := range rks.Objects {
+				pc := obj.(AwsVpcPeeringConnection)
+				if pc.Status != nil && pc.Status.Code == ec2types.VpcPeeringConnectionStateReasonCodeDeleting {
+					continue
+				}
+				err := state.awsOrphanClient.DeleteVpcPeeringConnection(ctx, pc.VpcPeeringConnectionId)
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Aws VPC peering connection deletion %s", pc.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Aws VPC peering connection %s: %w", pc.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
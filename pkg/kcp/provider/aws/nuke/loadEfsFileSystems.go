@@ -0,0 +1,68 @@
+package nuke
+
+import (
+	"context"
+
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadEfsFileSystems loads EFS file systems tagged with the Nuke's Scope that have no NfsInstance
+func loadEfsFileSystems(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	list, err := state.awsOrphanClient.DescribeFileSystems(ctx)
+	if err != nil {
+		logger.Error(err, "Error listing Aws EFS file systems")
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAwsEfsFileSystems",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list Aws EFS file systems error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, fs := range list {
+		if fs.LifeCycleState == efstypes.LifeCycleStateDeleted {
+			continue
+		}
+		if !state.IsOrphan(
+			awsutil.GetEfsTagValue(fs.Tags, common.TagScope),
+			awsutil.GetEfsTagValue(fs.Tags, common.TagCloudManagerName),
+			"NfsInstance",
+		) {
+			continue
+		}
+		objects = append(objects, AwsEfsFileSystem{&fs})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Aws EFS file systems", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     awsnukeclient.AwsEfsFileSystem,
+		Provider: cloudcontrolv1beta1.ProviderAws,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
@@ -0,0 +1,84 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// loadElastiCacheReplicationGroups loads ElastiCache replication groups tagged with the Nuke's Scope
+// that have neither RedisInstance nor RedisCluster
+func loadElastiCacheReplicationGroups(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	list, err := state.awsOrphanClient.DescribeElastiCacheReplicationGroups(ctx)
+	if err != nil {
+		logger.Error(err, "Error listing Aws ElastiCache replication groups")
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAwsElastiCacheReplicationGroups",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list Aws ElastiCache replication groups error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, rg := range list {
+		// replication group description does not contain tags, they have to be listed separately
+		tags, err := state.awsOrphanClient.ListElastiCacheTags(ctx, ptr.Deref(rg.ARN, ""))
+		if err != nil {
+			logger.Error(err, "Error listing Aws ElastiCache replication group tags")
+
+			state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+			return composed.PatchStatus(state.ObjAsNuke()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  "ErrorListingAwsElastiCacheTags",
+					Message: err.Error(),
+				}).
+				ErrorLogMessage("Error patching KCP Nuke status after list Aws ElastiCache replication group tags error").
+				SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+				Run(ctx, state)
+		}
+		if !state.IsOrphan(
+			awsutil.GetElastiCacheTagValue(tags, common.TagScope),
+			awsutil.GetElastiCacheTagValue(tags, common.TagCloudManagerName),
+			"RedisInstance", "RedisCluster",
+		) {
+			continue
+		}
+		objects = append(objects, AwsElastiCacheReplicationGroup{&rg})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Aws ElastiCache replication groups", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     awsnukeclient.AwsElastiCacheReplicationGroup,
+		Provider: cloudcontrolv1beta1.ProviderAws,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
@@ -0,0 +1,76 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// loadSubnets loads subnets in the Scope's VPC tagged with the Nuke's Scope that have no IpRange
+func loadSubnets(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	var objects []nuketypes.ProviderResourceObject
+	err := func() error {
+		vpcs, err := state.awsOrphanClient.DescribeVpcs(ctx, state.Scope().Spec.Scope.Aws.VpcNetwork)
+		if err != nil {
+			return err
+		}
+		for _, vpc := range vpcs {
+			subnets, err := state.awsOrphanClient.DescribeSubnets(ctx, ptr.Deref(vpc.VpcId, ""))
+			if err != nil {
+				return err
+			}
+			for _, subnet := range subnets {
+				if !state.IsOrphan(
+					awsutil.GetEc2TagValue(subnet.Tags, common.TagScope),
+					awsutil.GetEc2TagValue(subnet.Tags, common.TagCloudManagerName),
+					"IpRange",
+				) {
+					continue
+				}
+				objects = append(objects, AwsSubnet{&subnet})
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		logger.Error(err, "Error listing Aws subnets")
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAwsSubnets",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list Aws subnets error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Aws subnets", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     awsnukeclient.AwsSubnet,
+		Provider: cloudcontrolv1beta1.ProviderAws,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
@@ -0,0 +1,75 @@
+package nuke
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	awsnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/nuke/client"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadVpcPeeringConnections loads VPC peering connections tagged with the Nuke's Scope that have no VpcPeering
+func loadVpcPeeringConnections(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	list, err := state.awsOrphanClient.DescribeVpcPeeringConnections(ctx)
+	if err != nil {
+		logger.Error(err, "Error listing Aws VPC peering connections")
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAwsVpcPeeringConnections",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list Aws VPC peering connections error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, pc := range list {
+		// connections in the final states are kept listed by AWS for a while, and can not be deleted
+		if pc.Status != nil {
+			switch pc.Status.Code {
+			case ec2types.VpcPeeringConnectionStateReasonCodeDeleted,
+				ec2types.VpcPeeringConnectionStateReasonCodeRejected,
+				ec2types.VpcPeeringConnectionStateReasonCodeFailed,
+				ec2types.VpcPeeringConnectionStateReasonCodeExpired:
+				continue
+			}
+		}
+		if !state.IsOrphan(
+			awsutil.GetEc2TagValue(pc.Tags, common.TagScope),
+			awsutil.GetEc2TagValue(pc.Tags, common.TagCloudManagerName),
+			"VpcPeering",
+		) {
+			continue
+		}
+		objects = append(objects, AwsVpcPeeringConnection{&pc})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Aws VPC peering connections", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     awsnukeclient.AwsVpcPeeringConnection,
+		Provider: cloudcontrolv1beta1.ProviderAws,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
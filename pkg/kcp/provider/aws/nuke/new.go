@@ -32,11 +32,23 @@ func New(stateFactory StateFactory) composed.Action {
 			createAwsClient,
 			loadVault,
 			loadNfsBackups,
+			loadEfsFileSystems,
+			loadElastiCacheReplicationGroups,
+			loadVpcPeeringConnections,
+			loadSubnets,
 			providerResourceStatusDiscovered,
-			deleteNfsBackup,
-			providerResourceStatusDeleting,
-			providerResourceStatusDeleted,
-			checkIfAllProviderResourcesDeleted,
+			composed.If(
+				nuketypes.DeletionApprovedPredicate,
+				deleteNfsBackup,
+				deleteEfsFileSystems,
+				deleteElastiCacheReplicationGroups,
+				deleteVpcPeeringConnections,
+				deleteSubnets,
+				providerResourceStatusDeleting,
+				providerResourceStatusDeleted,
+				providerResourceDeleteErrors,
+				checkIfAllProviderResourcesDeleted,
+			),
 			// continue to parent action
 			func(ctx context.Context, state composed.State) (error, context.Context) {
 				return nil, ctx
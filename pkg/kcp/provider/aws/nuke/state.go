@@ -3,7 +3,12 @@ package nuke
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
@@ -19,23 +24,27 @@ type StateFactory interface {
 
 func NewStateFactory(
 	awsClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeNfsBackupClient],
+	awsOrphanClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeOrphanClient],
 	env abstractions.Environment) StateFactory {
 	return stateFactory{
-		awsClientProvider: awsClientProvider,
-		env:               env,
+		awsClientProvider:       awsClientProvider,
+		awsOrphanClientProvider: awsOrphanClientProvider,
+		env:                     env,
 	}
 }
 
 type stateFactory struct {
-	awsClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeNfsBackupClient]
-	env               abstractions.Environment
+	awsClientProvider       awsclient.SkrClientProvider[awsnukeclient.NukeNfsBackupClient]
+	awsOrphanClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeOrphanClient]
+	env                     abstractions.Environment
 }
 
 func (f stateFactory) NewState(ctx context.Context, nukeState nuketypes.State) (focal.State, error) {
 	return &State{
-		State:             nukeState,
-		awsClientProvider: f.awsClientProvider,
-		env:               f.env,
+		State:                   nukeState,
+		awsClientProvider:       f.awsClientProvider,
+		awsOrphanClientProvider: f.awsOrphanClientProvider,
+		env:                     f.env,
 	}, nil
 }
 
@@ -47,6 +56,12 @@ type State struct {
 	awsClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeNfsBackupClient]
 	env               abstractions.Environment
 	awsClient         awsnukeclient.NukeNfsBackupClient
+
+	awsOrphanClientProvider awsclient.SkrClientProvider[awsnukeclient.NukeOrphanClient]
+	awsOrphanClient         awsnukeclient.NukeOrphanClient
+
+	// deleteErrors holds errors returned by provider delete requests in the current reconciliation
+	deleteErrors []error
 }
 
 type AwsBackup struct {
@@ -61,10 +76,62 @@ func (b AwsBackup) GetObject() any {
 	return b
 }
 
+type AwsEfsFileSystem struct {
+	*efstypes.FileSystemDescription
+}
+
+func (f AwsEfsFileSystem) GetId() string {
+	return ptr.Deref(f.FileSystemId, "")
+}
+
+func (f AwsEfsFileSystem) GetObject() any {
+	return f
+}
+
+type AwsElastiCacheReplicationGroup struct {
+	*elasticachetypes.ReplicationGroup
+}
+
+func (g AwsElastiCacheReplicationGroup) GetId() string {
+	return ptr.Deref(g.ReplicationGroupId, "")
+}
+
+func (g AwsElastiCacheReplicationGroup) GetObject() any {
+	return g
+}
+
+type AwsVpcPeeringConnection struct {
+	*ec2types.VpcPeeringConnection
+}
+
+func (p AwsVpcPeeringConnection) GetId() string {
+	return ptr.Deref(p.VpcPeeringConnectionId, "")
+}
+
+func (p AwsVpcPeeringConnection) GetObject() any {
+	return p
+}
+
+type AwsSubnet struct {
+	*ec2types.Subnet
+}
+
+func (n AwsSubnet) GetId() string {
+	return ptr.Deref(n.SubnetId, "")
+}
+
+func (n AwsSubnet) GetObject() any {
+	return n
+}
+
 type ProviderNukeStatus struct {
 	v1beta1.NukeStatus
 }
 
+func (s *State) AddDeleteError(err error) {
+	s.deleteErrors = append(s.deleteErrors, err)
+}
+
 func (s *State) GetVaultName() string {
 	return fmt.Sprintf("cm-%s", s.Scope().Name)
 }
@@ -72,3 +139,22 @@ func (s *State) GetVaultName() string {
 func (s *State) GetAccountId() string {
 	return s.Scope().Spec.Scope.Aws.AccountId
 }
+
+// IsOrphan returns true if the provider resource with the given cloud-manager tag values was created for
+// the Nuke's Scope, and the KCP object it was created for, of any of the given kinds, does not exist
+func (s *State) IsOrphan(scopeTag, nameTag string, kinds ...string) bool {
+	if scopeTag != s.Scope().Name || nameTag == "" {
+		return false
+	}
+	// name tag is in the namespace/name format
+	name := nameTag
+	if _, n, found := strings.Cut(nameTag, "/"); found {
+		name = n
+	}
+	for _, kind := range kinds {
+		if s.ObjectExists(kind, name) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func addUpdatingCondition(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return nil, ctx
+	}
+
+	cacheState := ptr.Deref(state.rdsInstance.DBInstanceStatus, "")
+	isModifying := cacheState == awsmeta.Rds_MODIFYING
+	hasUpdatingCondition := meta.FindStatusCondition(postgresInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeUpdating) != nil
+
+	if !isModifying && !hasUpdatingCondition {
+		return nil, ctx
+	}
+
+	if isModifying && hasUpdatingCondition {
+		return nil, ctx
+	}
+
+	if isModifying && !hasUpdatingCondition {
+		logger.Info("Adding updating condition to postgres instance.")
+		return composed.UpdateStatus(postgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeUpdating,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ConditionTypeUpdating,
+				Message: "RDS instance is updating.",
+			}).
+			SuccessErrorNil().
+			ErrorLogMessage("Failed to add updating condition to postgres instance").
+			Run(ctx, st)
+	}
+
+	if !isModifying && hasUpdatingCondition {
+		logger.Info("Removing updating condition from postgres instance")
+		return composed.UpdateStatus(postgresInstance).
+			RemoveConditions(cloudcontrolv1beta1.ConditionTypeUpdating).
+			SuccessErrorNil().
+			ErrorLogMessage("Failed to remove updating condition from postgres instance").
+			Run(ctx, st)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,49 @@
+package postgresinstance
+
+import (
+	"context"
+
+	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+
+	"k8s.io/utils/ptr"
+)
+
+func createMasterPasswordSecret(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.masterPasswordValue != nil {
+		return nil, ctx
+	}
+
+	postgresInstance := state.ObjAsPostgresInstance()
+	logger := composed.LoggerFromCtx(ctx)
+
+	err := state.awsClient.CreateMasterPasswordSecret(ctx, GetAwsMasterPasswordSecretName(state.Obj().GetName()), []secretsmanagertypes.Tag{
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(postgresInstance.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(postgresInstance.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating master password secret", ctx)
+	}
+
+	logger.Info("Master password secret created")
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,79 @@
+package postgresinstance
+
+import (
+	"context"
+
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func createRdsInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance != nil {
+		return nil, ctx
+	}
+
+	if state.masterPasswordValue == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	logger.Info("Creating AWS RDS instance")
+
+	_, err := state.awsClient.CreateRdsInstance(ctx, []rdstypes.Tag{
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(postgresInstance.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(postgresInstance.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	}, client.CreateRdsInstanceOptions{
+		Name:                       GetAwsRdsInstanceName(state.Obj().GetName()),
+		SubnetGroupName:            ptr.Deref(state.subnetGroup.DBSubnetGroupName, ""),
+		InstanceClass:              postgresInstance.Spec.Instance.Aws.InstanceClass,
+		EngineVersion:              postgresInstance.Spec.Instance.Aws.EngineVersion,
+		StorageGb:                  postgresInstance.Spec.Instance.Aws.StorageGb,
+		MultiAz:                    postgresInstance.Spec.Instance.Aws.MultiAz,
+		DatabaseName:               postgresInstance.Spec.Instance.Aws.DatabaseName,
+		MasterUsername:             postgresInstance.Spec.Instance.Aws.MasterUsername,
+		MasterPassword:             ptr.Deref(state.masterPasswordValue.SecretString, ""),
+		PreferredMaintenanceWindow: postgresInstance.Spec.Instance.Aws.PreferredMaintenanceWindow,
+		SecurityGroupIds:           []string{state.securityGroupId},
+	})
+
+	if err != nil {
+		logger.Error(err, "Error creating AWS RDS instance")
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to create PostgresInstance",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed aws rds creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeue, nil
+}
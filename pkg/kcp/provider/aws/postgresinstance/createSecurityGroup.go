@@ -0,0 +1,53 @@
+package postgresinstance
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func createSecurityGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.securityGroup != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	sgName := GetAwsRdsSecurityGroupName(state.Obj().GetName())
+	sgId, err := state.awsClient.CreateRdsSecurityGroup(ctx, state.IpRange().Status.VpcId, sgName, []ec2types.Tag{
+		{
+			Key:   new("Name"),
+			Value: new(sgName),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(postgresInstance.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(postgresInstance.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating security group", ctx)
+	}
+
+	logger = logger.WithValues("securityGroupId", sgId)
+	logger.Info("Security group created")
+
+	return composed.StopWithRequeue, nil
+}
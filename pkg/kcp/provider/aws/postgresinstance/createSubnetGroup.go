@@ -0,0 +1,55 @@
+package postgresinstance
+
+import (
+	"context"
+
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/elliotchance/pie/v2"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+
+	"k8s.io/utils/ptr"
+)
+
+func createSubnetGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.subnetGroup != nil {
+		return nil, ctx
+	}
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	logger := composed.LoggerFromCtx(ctx)
+	subnetIds := pie.Map(state.IpRange().Status.Subnets, func(subnet cloudcontrolv1beta1.IpRangeSubnet) string {
+		return subnet.Id
+	})
+
+	out, err := state.awsClient.CreateRdsSubnetGroup(ctx, GetAwsRdsSubnetGroupName(state.Obj().GetName()), subnetIds, []rdstypes.Tag{
+		{
+			Key:   ptr.To(common.TagCloudManagerRemoteName),
+			Value: new(postgresInstance.Spec.RemoteRef.String()),
+		},
+		{
+			Key:   ptr.To(common.TagCloudManagerName),
+			Value: new(state.Name().String()),
+		},
+		{
+			Key:   ptr.To(common.TagScope),
+			Value: new(postgresInstance.Spec.Scope.Name),
+		},
+		{
+			Key:   ptr.To(common.TagShoot),
+			Value: new(state.Scope().Spec.ShootName),
+		},
+	})
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error creating subnet group", ctx)
+	}
+
+	logger = logger.WithValues("subnetGroupName", out.DBSubnetGroup.DBSubnetGroupName)
+	logger.Info("Subnet group created")
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,27 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func deleteMasterPasswordSecret(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.masterPasswordValue == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting master password secret")
+
+	err := state.awsClient.DeleteMasterPasswordSecret(ctx, *state.masterPasswordValue.Name)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting master password secret", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,34 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func deleteRdsInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.rdsInstance == nil {
+		return nil, ctx
+	}
+	instanceState := ptr.Deref(state.rdsInstance.DBInstanceStatus, "")
+	if instanceState == awsmeta.Rds_DELETING {
+		return nil, ctx
+	}
+
+	logger.
+		WithValues("rdsInstance", ptr.Deref(state.rdsInstance.DBInstanceIdentifier, "")).
+		Info("Deleting rds instance")
+
+	err := state.awsClient.DeleteRdsInstance(ctx, ptr.Deref(state.rdsInstance.DBInstanceIdentifier, ""))
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting rds instance", ctx)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+}
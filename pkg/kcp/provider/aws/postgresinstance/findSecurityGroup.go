@@ -0,0 +1,51 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func findSecurityGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.securityGroup != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	list, err := state.awsClient.DescribeRdsSecurityGroups(ctx, []ec2types.Filter{
+		{
+			Name:   new("vpc-id"),
+			Values: []string{state.IpRange().Status.VpcId},
+		},
+		{
+			Name:   new("tag:Name"),
+			Values: []string{GetAwsRdsSecurityGroupName(state.Obj().GetName())},
+		},
+		{
+			Name:   new(fmt.Sprintf("tag:%s", common.TagCloudManagerName)),
+			Values: []string{state.Name().String()},
+		},
+	}, nil)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error listing security groups", ctx)
+	}
+
+	if len(list) > 0 {
+		state.securityGroup = &list[0]
+		state.securityGroupId = ptr.Deref(state.securityGroup.GroupId, "")
+		logger = logger.WithValues("securityGroupId", state.securityGroupId)
+		logger.Info("Postgres security group found")
+		return nil, composed.LoggerIntoCtx(ctx, logger)
+	}
+
+	logger.Info("Security group not found")
+
+	return nil, ctx
+}
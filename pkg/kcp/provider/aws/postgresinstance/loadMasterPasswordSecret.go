@@ -0,0 +1,32 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func loadMasterPasswordSecret(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.masterPasswordValue != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	masterPasswordValue, err := state.awsClient.GetMasterPasswordSecretValue(ctx, GetAwsMasterPasswordSecretName(state.Obj().GetName()))
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error getting master password", ctx)
+	}
+
+	if masterPasswordValue == nil {
+		logger.Info("RDS master password value not found")
+		return nil, ctx
+	}
+
+	state.masterPasswordValue = masterPasswordValue
+	logger.Info("RDS master password value found and loaded")
+
+	return nil, ctx
+}
@@ -0,0 +1,34 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+func loadRdsInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	if state.rdsInstance != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	list, err := state.awsClient.DescribeRdsInstance(ctx, GetAwsRdsInstanceName(state.Obj().GetName()))
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error listing rds instances", ctx)
+	}
+
+	if len(list) > 0 {
+		state.rdsInstance = &list[0]
+		logger = logger.WithValues("rdsInstanceId", ptr.Deref(state.rdsInstance.DBInstanceIdentifier, ""))
+		logger.Info("RDS instance found and loaded")
+		return nil, composed.LoggerIntoCtx(ctx, logger)
+	}
+
+	logger.Info("RDS instance not found")
+
+	return nil, ctx
+}
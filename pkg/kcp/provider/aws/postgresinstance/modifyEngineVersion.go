@@ -0,0 +1,31 @@
+package postgresinstance
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func modifyEngineVersion(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	// spec holds only the major version, minor versions are upgraded automatically
+	currentEngineVersion := ptr.Deref(state.rdsInstance.EngineVersion, "")
+	desiredEngineVersion := postgresInstance.Spec.Instance.Aws.EngineVersion
+
+	if currentEngineVersion == desiredEngineVersion || strings.HasPrefix(currentEngineVersion, desiredEngineVersion+".") {
+		return nil, ctx
+	}
+
+	state.UpdateEngineVersion(desiredEngineVersion)
+
+	return nil, ctx
+}
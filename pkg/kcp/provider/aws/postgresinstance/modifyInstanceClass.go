@@ -0,0 +1,29 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func modifyInstanceClass(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentInstanceClass := ptr.Deref(state.rdsInstance.DBInstanceClass, "")
+	desiredInstanceClass := postgresInstance.Spec.Instance.Aws.InstanceClass
+
+	if currentInstanceClass == desiredInstanceClass {
+		return nil, ctx
+	}
+
+	state.UpdateInstanceClass(desiredInstanceClass)
+
+	return nil, ctx
+}
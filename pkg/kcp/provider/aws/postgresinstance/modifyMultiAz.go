@@ -0,0 +1,29 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func modifyMultiAz(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentMultiAz := ptr.Deref(state.rdsInstance.MultiAZ, false)
+	desiredMultiAz := postgresInstance.Spec.Instance.Aws.MultiAz
+
+	if currentMultiAz == desiredMultiAz {
+		return nil, ctx
+	}
+
+	state.UpdateMultiAz(desiredMultiAz)
+
+	return nil, ctx
+}
@@ -0,0 +1,32 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func modifyPreferredMaintenanceWindow(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentPreferredMaintenanceWindow := ptr.Deref(state.rdsInstance.PreferredMaintenanceWindow, "")
+	desiredPreferredMaintenanceWindow := ptr.Deref(postgresInstance.Spec.Instance.Aws.PreferredMaintenanceWindow, "")
+
+	if currentPreferredMaintenanceWindow == desiredPreferredMaintenanceWindow {
+		return nil, ctx
+	}
+	if desiredPreferredMaintenanceWindow == "" {
+		return nil, ctx
+	}
+
+	state.UpdatePreferredMaintenanceWindow(desiredPreferredMaintenanceWindow)
+
+	return nil, ctx
+}
@@ -0,0 +1,30 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func modifyStorageGb(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentStorageGb := ptr.Deref(state.rdsInstance.AllocatedStorage, 0)
+	desiredStorageGb := postgresInstance.Spec.Instance.Aws.StorageGb
+
+	// RDS allocated storage can only grow
+	if desiredStorageGb <= currentStorageGb {
+		return nil, ctx
+	}
+
+	state.UpdateStorageGb(desiredStorageGb)
+
+	return nil, ctx
+}
@@ -0,0 +1,69 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance/types"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		logger := composed.LoggerFromCtx(ctx)
+		postgresInstanceState := st.(types.State)
+		state, err := stateFactory.NewState(ctx, postgresInstanceState)
+		if err != nil {
+			err = fmt.Errorf("error creating new aws postgresinstance state: %w", err)
+			logger.Error(err, "Error")
+			return composed.StopAndForget, nil
+		}
+
+		return composed.ComposeActions(
+			"awsPostgresInstance",
+			actions.AddCommonFinalizer(),
+			loadSubnetGroup,
+			loadMasterPasswordSecret,
+			findSecurityGroup,
+			loadSecurityGroup,
+			loadRdsInstance,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"postgresInstance-create",
+					createSubnetGroup,
+					createMasterPasswordSecret,
+					createSecurityGroup,
+					authorizeSecurityGroupIngress,
+					createRdsInstance,
+					updateStatusId,
+					addUpdatingCondition,
+					waitRdsInstanceAvailable,
+					modifyInstanceClass,
+					modifyEngineVersion,
+					modifyStorageGb,
+					modifyMultiAz,
+					modifyPreferredMaintenanceWindow,
+					composed.If(
+						shouldUpdateRdsInstancePredicate(),
+						updateRdsInstance,
+					),
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"postgresInstance-delete",
+					removeReadyCondition,
+					deleteRdsInstance,
+					waitRdsInstanceDeleted,
+					deleteSecurityGroup,
+					deleteMasterPasswordSecret,
+					deleteSubnetGroup,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(awsmeta.SetAwsAccountId(ctx, postgresInstanceState.Scope().Spec.Scope.Aws.AccountId), state)
+	}
+}
@@ -0,0 +1,110 @@
+package postgresinstance
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance/types"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+)
+
+type State struct {
+	types.State
+	awsClient awsclient.RdsClient
+
+	subnetGroup         *rdstypes.DBSubnetGroup
+	rdsInstance         *rdstypes.DBInstance
+	masterPasswordValue *secretsmanager.GetSecretValueOutput
+	securityGroup       *ec2types.SecurityGroup
+	securityGroupId     string
+
+	modifyRdsInstanceOptions awsclient.ModifyRdsInstanceOptions
+	updateMask               []string
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, postgresInstance types.State) (*State, error)
+}
+
+func NewStateFactory(skrProvider awsclient.SkrClientProvider[awsclient.RdsClient]) StateFactory {
+	return &stateFactory{
+		skrProvider: skrProvider,
+	}
+}
+
+type stateFactory struct {
+	skrProvider awsclient.SkrClientProvider[awsclient.RdsClient]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, postgresInstance types.State) (*State, error) {
+	roleName := awsutil.RoleArnDefault(postgresInstance.Scope().Spec.Scope.Aws.AccountId)
+
+	logger := composed.LoggerFromCtx(ctx)
+	logger.
+		WithValues(
+			"awsRegion", postgresInstance.Scope().Spec.Region,
+			"awsRole", roleName,
+		).
+		Info("Assuming AWS role")
+
+	c, err := f.skrProvider(
+		ctx,
+		postgresInstance.Scope().Spec.Scope.Aws.AccountId,
+		postgresInstance.Scope().Spec.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		roleName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(postgresInstance, c), nil
+}
+
+func newState(postgresInstance types.State, rdsClient awsclient.RdsClient) *State {
+	return &State{
+		State:                    postgresInstance,
+		awsClient:                rdsClient,
+		modifyRdsInstanceOptions: awsclient.ModifyRdsInstanceOptions{},
+		updateMask:               []string{},
+	}
+}
+
+func (s *State) ShouldUpdateRdsInstance() bool {
+	return len(s.updateMask) > 0
+}
+
+func (s *State) GetModifyRdsInstanceOptions() awsclient.ModifyRdsInstanceOptions {
+	return s.modifyRdsInstanceOptions
+}
+
+func (s *State) UpdateInstanceClass(instanceClass string) {
+	s.modifyRdsInstanceOptions.InstanceClass = new(instanceClass)
+	s.updateMask = append(s.updateMask, "instanceClass")
+}
+
+func (s *State) UpdateEngineVersion(engineVersion string) {
+	s.modifyRdsInstanceOptions.EngineVersion = new(engineVersion)
+	s.updateMask = append(s.updateMask, "engineVersion")
+}
+
+func (s *State) UpdateStorageGb(storageGb int32) {
+	s.modifyRdsInstanceOptions.StorageGb = new(storageGb)
+	s.updateMask = append(s.updateMask, "storageGb")
+}
+
+func (s *State) UpdateMultiAz(multiAz bool) {
+	s.modifyRdsInstanceOptions.MultiAz = new(multiAz)
+	s.updateMask = append(s.updateMask, "multiAz")
+}
+
+func (s *State) UpdatePreferredMaintenanceWindow(preferredMaintenanceWindow string) {
+	s.modifyRdsInstanceOptions.PreferredMaintenanceWindow = new(preferredMaintenanceWindow)
+	s.updateMask = append(s.updateMask, "preferredMaintenanceWindow")
+}
@@ -0,0 +1,56 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func updateRdsInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.rdsInstance == nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("Removing ready state to begin update")
+	meta.RemoveStatusCondition(postgresInstance.Conditions(), cloudcontrolv1beta1.ConditionTypeReady)
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err,
+			"Error updating PostgresInstance status",
+			composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+			ctx,
+		)
+	}
+
+	logger.
+		WithValues("updateMask", state.updateMask).
+		Info("Updating rds instance")
+	_, err = state.awsClient.ModifyRdsInstance(ctx, ptr.Deref(state.rdsInstance.DBInstanceIdentifier, ""), state.GetModifyRdsInstanceOptions())
+
+	if err != nil {
+		logger.Error(err, "Error updating AWS RDS instance")
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to update PostgresInstance",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed aws rds update").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+}
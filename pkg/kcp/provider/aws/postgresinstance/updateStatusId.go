@@ -0,0 +1,32 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func updateStatusId(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if composed.MarkedForDeletionPredicate(ctx, state) {
+		return nil, ctx
+	}
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if postgresInstance.Status.Id != "" { // already set
+		return nil, ctx
+	}
+
+	postgresInstance.Status.Id = ptr.Deref(state.rdsInstance.DBInstanceIdentifier, "")
+
+	err := state.UpdateObjStatus(ctx)
+
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating PostgresInstance .status.id", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
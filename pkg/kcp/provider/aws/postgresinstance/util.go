@@ -0,0 +1,21 @@
+package postgresinstance
+
+import (
+	"fmt"
+)
+
+func GetAwsRdsSubnetGroupName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+func GetAwsRdsSecurityGroupName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+func GetAwsRdsInstanceName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+func GetAwsMasterPasswordSecretName(name string) string {
+	return fmt.Sprintf("cm-%s/masterPassword", name)
+}
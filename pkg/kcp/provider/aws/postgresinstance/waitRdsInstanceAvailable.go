@@ -0,0 +1,58 @@
+package postgresinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func waitRdsInstanceAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.rdsInstance == nil {
+		errorMsg := "error: rds instance is not loaded"
+		logger.Error(errors.New(errorMsg), errorMsg)
+		postgresInstance := state.ObjAsPostgresInstance()
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonUnknown,
+				Message: errorMsg,
+			}).
+			SuccessError(composed.StopAndForget).
+			SuccessLogMsg(errorMsg).
+			Run(ctx, st)
+	}
+
+	instanceState := ptr.Deref(state.rdsInstance.DBInstanceStatus, "")
+	if instanceState == awsmeta.Rds_AVAILABLE {
+		return nil, ctx
+	}
+
+	if instanceState == awsmeta.Rds_FAILED {
+		postgresInstance := state.ObjAsPostgresInstance()
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "RDS instance is in failed state",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status after rds instance failed").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, st)
+	}
+
+	logger.Info("Postgres instance is not ready yet, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+}
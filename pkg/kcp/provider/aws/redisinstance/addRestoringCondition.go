@@ -0,0 +1,58 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func addRestoringCondition(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	redisInstance := state.ObjAsRedisInstance()
+	snapshotName := redisInstance.Spec.Instance.Aws.SnapshotName
+
+	if state.elastiCacheReplicationGroup == nil || snapshotName == "" {
+		return nil, ctx
+	}
+
+	if redisInstance.Status.RestoredFrom == snapshotName {
+		return nil, ctx
+	}
+
+	cacheState := ptr.Deref(state.elastiCacheReplicationGroup.Status, "")
+	hasRestoringCondition := meta.FindStatusCondition(redisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeRestoring) != nil
+
+	if cacheState == awsmeta.ElastiCache_AVAILABLE {
+		logger.Info("Redis instance restored from snapshot", "snapshotName", snapshotName)
+		redisInstance.Status.RestoredFrom = snapshotName
+		return composed.UpdateStatus(redisInstance).
+			RemoveConditions(cloudcontrolv1beta1.ConditionTypeRestoring).
+			SuccessErrorNil().
+			ErrorLogMessage("Failed to remove restoring condition from redis instance").
+			Run(ctx, st)
+	}
+
+	if hasRestoringCondition {
+		return nil, ctx
+	}
+
+	logger.Info("Adding restoring condition to redis instance.")
+	return composed.UpdateStatus(redisInstance).
+		SetCondition(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeRestoring,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ConditionTypeRestoring,
+			Message: fmt.Sprintf("ElastiCache is being restored from snapshot %s.", snapshotName),
+		}).
+		SuccessErrorNil().
+		ErrorLogMessage("Failed to add restoring condition to redis instance").
+		Run(ctx, st)
+}
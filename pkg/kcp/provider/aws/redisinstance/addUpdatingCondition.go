@@ -23,7 +23,9 @@ func addUpdatingCondition(ctx context.Context, st composed.State) (error, contex
 
 	cacheState := ptr.Deref(state.elastiCacheReplicationGroup.Status, "")
 	isModifying := cacheState == awsmeta.ElastiCache_MODIFYING
-	hasUpdatingCondition := meta.FindStatusCondition(redisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeUpdating) != nil
+	updatingCondition := meta.FindStatusCondition(redisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeUpdating)
+	// deferred tier change condition is managed by waitDeferredTierChange
+	hasUpdatingCondition := updatingCondition != nil && updatingCondition.Reason != cloudcontrolv1beta1.ReasonTierChangeDeferred
 
 	if !isModifying && !hasUpdatingCondition {
 		return nil, ctx
@@ -2,6 +2,7 @@ package redisinstance
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
@@ -65,15 +66,22 @@ func createElastiCacheCluster(ctx context.Context, st composed.State) (error, co
 		ClusterMode:                false,
 		AutomaticFailoverEnabled:   automaticFailoverEnabled,
 		MultiAZEnabled:             nil,
+		SnapshotName:               redisInstance.Spec.Instance.Aws.SnapshotName,
 	})
 
 	if err != nil {
 		logger.Error(err, "Error creating AWS ElastiCache")
+		reason := cloudcontrolv1beta1.ReasonCloudProviderError
+		message := "Failed to create RedisInstance"
+		if redisInstance.Spec.Instance.Aws.SnapshotName != "" {
+			reason = cloudcontrolv1beta1.ReasonRestoreFailed
+			message = fmt.Sprintf("Failed to restore RedisInstance from snapshot %s", redisInstance.Spec.Instance.Aws.SnapshotName)
+		}
 		meta.SetStatusCondition(redisInstance.Conditions(), metav1.Condition{
 			Type:    cloudcontrolv1beta1.ConditionTypeError,
 			Status:  "True",
-			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
-			Message: "Failed to create RedisInstance",
+			Reason:  reason,
+			Message: message,
 		})
 		redisInstance.Status.State = cloudcontrolv1beta1.StateError
 		err = state.UpdateObjStatus(ctx)
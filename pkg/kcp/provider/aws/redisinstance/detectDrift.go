@@ -0,0 +1,52 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+// replicationGroupRemoved returns true if the ElastiCache replication group of a Ready RedisInstance was removed out of band
+func replicationGroupRemoved(_ context.Context, st composed.State) bool {
+	return st.(*State).elastiCacheReplicationGroup == nil
+}
+
+// detectDrift reports the spec fields that differ from the ElastiCache replication group and its
+// member clusters. It runs before the parameter group is modified, so with the Report policy the
+// changed parameters are not reverted. A tier change deferred to the maintenance window is not drift.
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+	spec := state.ObjAsRedisInstance().Spec.Instance.Aws
+
+	if state.elastiCacheReplicationGroup == nil {
+		return []string{"replicationGroup"}
+	}
+
+	var fields []string
+
+	currentCacheNodeType := ptr.Deref(state.elastiCacheReplicationGroup.CacheNodeType, "")
+	if currentCacheNodeType != spec.CacheNodeType &&
+		!(spec.DeferTierChange && state.GetPendingMachineType() == spec.CacheNodeType) {
+		fields = append(fields, "cacheNodeType")
+	}
+	if !state.IsRedisVersionUpToDate() {
+		fields = append(fields, "engineVersion")
+	}
+	if ptr.Deref(state.elastiCacheReplicationGroup.AutoMinorVersionUpgrade, false) != spec.AutoMinorVersionUpgrade {
+		fields = append(fields, "autoMinorVersionUpgrade")
+	}
+	if ptr.Deref(state.elastiCacheReplicationGroup.AuthTokenEnabled, false) != spec.AuthEnabled {
+		fields = append(fields, "authEnabled")
+	}
+	desiredPreferredMaintenanceWindow := ptr.Deref(spec.PreferredMaintenanceWindow, "")
+	if desiredPreferredMaintenanceWindow != "" && len(state.memberClusters) > 0 &&
+		ptr.Deref(state.memberClusters[0].PreferredMaintenanceWindow, "") != desiredPreferredMaintenanceWindow {
+		fields = append(fields, "preferredMaintenanceWindow")
+	}
+	if state.parameterGroup != nil && !state.AreMainParamGroupParamsUpToDate() {
+		fields = append(fields, "parameters")
+	}
+
+	return fields
+}
@@ -23,6 +23,13 @@ func modifyCacheNodeType(ctx context.Context, st composed.State) (error, context
 		return nil, ctx
 	}
 
+	if redisInstance.Spec.Instance.Aws.DeferTierChange {
+		if state.GetPendingMachineType() != desiredCacheNodeType {
+			state.ScheduleCacheNodeType(desiredCacheNodeType)
+		}
+		return nil, ctx
+	}
+
 	state.UpdateCacheNodeType(desiredCacheNodeType)
 
 	return nil, ctx
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance/types"
 )
@@ -40,6 +41,7 @@ func New(stateFactory StateFactory) composed.Action {
 				composed.ComposeActions(
 					"redisInstance-create",
 					loadMemberClusters,
+					drift.NewWithRemoved(drift.KindRedisInstance, detectDrift, replicationGroupRemoved),
 					createSubnetGroup,
 					composed.If(
 						shouldDeleteObsoleteMainParamGroupPredicate(),
@@ -72,6 +74,8 @@ func New(stateFactory StateFactory) composed.Action {
 					createElastiCacheCluster,
 					updateStatusId,
 					addUpdatingCondition,
+					updatePendingNodeType,
+					addRestoringCondition,
 					waitElastiCacheAvailable,
 					waitUserGroupActive,
 					modifyCacheNodeType,
@@ -82,6 +86,10 @@ func New(stateFactory StateFactory) composed.Action {
 						shouldUpdateRedisPredicate(),
 						updateElastiCacheCluster(),
 					),
+					composed.If(
+						shouldScheduleCacheNodeTypePredicate(),
+						scheduleCacheNodeTypeChange(),
+					),
 					composed.If(
 						shouldUpgradeRedisPredicate(),
 						upgradeElastiCacheCluster(),
@@ -90,6 +98,7 @@ func New(stateFactory StateFactory) composed.Action {
 						shouldSwitchToMainParamGroupPredicate(),
 						switchToMainParamGroup(),
 					),
+					waitDeferredTierChange,
 					updateStatus,
 				),
 				composed.ComposeActions(
@@ -104,6 +113,7 @@ func New(stateFactory StateFactory) composed.Action {
 					deleteMainParameterGroup(),
 					deleteTempParameterGroup(),
 					deleteSubnetGroup,
+					drift.Forget(drift.KindRedisInstance),
 					actions.RemoveCommonFinalizer(),
 					composed.StopAndForgetAction,
 				),
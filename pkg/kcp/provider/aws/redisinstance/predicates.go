@@ -49,6 +49,13 @@ func shouldUpdateRedisPredicate() composed.Predicate {
 	}
 }
 
+func shouldScheduleCacheNodeTypePredicate() composed.Predicate {
+	return func(ctx context.Context, st composed.State) bool {
+		state := st.(*State)
+		return state.ShouldScheduleCacheNodeType()
+	}
+}
+
 func shouldUpgradeRedisPredicate() composed.Predicate {
 	return func(ctx context.Context, st composed.State) bool {
 		state := st.(*State)
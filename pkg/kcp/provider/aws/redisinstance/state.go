@@ -36,6 +36,7 @@ type State struct {
 
 	modifyElastiCacheClusterOptions awsclient.ModifyElastiCacheClusterOptions
 	updateMask                      []string
+	scheduledCacheNodeType          string
 }
 
 type StateFactory interface {
@@ -100,6 +101,14 @@ func (s *State) UpdateCacheNodeType(cacheNodeType string) {
 	s.updateMask = append(s.updateMask, "cacheNodeType")
 }
 
+func (s *State) ScheduleCacheNodeType(cacheNodeType string) {
+	s.scheduledCacheNodeType = cacheNodeType
+}
+
+func (s *State) ShouldScheduleCacheNodeType() bool {
+	return s.scheduledCacheNodeType != ""
+}
+
 func (s *State) UpdateAutoMinorVersionUpgrade(autoMinorVersionUpgrade bool) {
 	s.modifyElastiCacheClusterOptions.AutoMinorVersionUpgrade = new(autoMinorVersionUpgrade)
 	s.updateMask = append(s.updateMask, "autoMinorVersionUpgrade")
@@ -237,3 +246,11 @@ func (s *State) GetProvisionedReplicaCount() int32 {
 	}
 	return int32(memberCount - 1)
 }
+
+// GetPendingMachineType returns the machine type scheduled to be applied in the next maintenance window
+func (s *State) GetPendingMachineType() string {
+	if len(s.memberClusters) == 0 || s.memberClusters[0].PendingModifiedValues == nil {
+		return ""
+	}
+	return ptr.Deref(s.memberClusters[0].PendingModifiedValues.CacheNodeType, "")
+}
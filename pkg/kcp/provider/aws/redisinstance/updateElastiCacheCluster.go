@@ -69,6 +69,15 @@ func updateElastiCacheCluster() composed.Action {
 	})
 }
 
+func scheduleCacheNodeTypeChange() composed.Action {
+	return modifyElastiCacheReplicationGroup(func(s *State) client.ModifyElastiCacheClusterOptions {
+		return client.ModifyElastiCacheClusterOptions{
+			CacheNodeType:            new(s.scheduledCacheNodeType),
+			ApplyInMaintenanceWindow: true,
+		}
+	})
+}
+
 func upgradeElastiCacheCluster() composed.Action {
 	return modifyElastiCacheReplicationGroup(func(s *State) client.ModifyElastiCacheClusterOptions {
 		return client.ModifyElastiCacheClusterOptions{
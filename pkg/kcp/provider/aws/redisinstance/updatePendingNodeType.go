@@ -0,0 +1,34 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func updatePendingNodeType(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisInstance := state.ObjAsRedisInstance()
+
+	if state.elastiCacheReplicationGroup == nil {
+		return nil, ctx
+	}
+
+	pendingNodeType := ""
+	desiredCacheNodeType := redisInstance.Spec.Instance.Aws.CacheNodeType
+	if state.GetProvisionedMachineType() != desiredCacheNodeType {
+		pendingNodeType = desiredCacheNodeType
+	}
+
+	if redisInstance.Status.PendingNodeType == pendingNodeType {
+		return nil, ctx
+	}
+
+	redisInstance.Status.PendingNodeType = pendingNodeType
+
+	return composed.UpdateStatus(redisInstance).
+		SuccessErrorNil().
+		ErrorLogMessage("Failed to update pending node type of redis instance").
+		Run(ctx, st)
+}
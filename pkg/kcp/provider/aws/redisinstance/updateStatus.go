@@ -6,6 +6,7 @@ import (
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -62,7 +63,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 	hasReadyStatusState := redisInstance.Status.State == cloudcontrolv1beta1.StateReady
 	if !hasChanged && hasReadyCondition && hasReadyStatusState {
 		composed.LoggerFromCtx(ctx).Info("RedisInstance status fields are already up-to-date, StopAndForget-ing")
-		return composed.StopAndForget, nil
+		return drift.StopAndForget(drift.KindRedisInstance), nil
 	}
 
 	redisInstance.Status.State = cloudcontrolv1beta1.StateReady
@@ -75,6 +76,6 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 		}).
 		ErrorLogMessage("Error updating KCP RedisInstance status after setting Ready condition").
 		SuccessLogMsg("KCP RedisInstance is ready").
-		SuccessError(composed.StopAndForget).
+		SuccessError(drift.StopAndForget(drift.KindRedisInstance)).
 		Run(ctx, state)
 }
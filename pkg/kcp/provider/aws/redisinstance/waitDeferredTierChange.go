@@ -0,0 +1,44 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func waitDeferredTierChange(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisInstance := state.ObjAsRedisInstance()
+
+	pendingCacheNodeType := state.GetPendingMachineType()
+	if pendingCacheNodeType == "" {
+		return nil, ctx
+	}
+
+	message := fmt.Sprintf("Change of cache node type to %s is deferred to the maintenance window", pendingCacheNodeType)
+
+	updatingCondition := meta.FindStatusCondition(redisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeUpdating)
+	if redisInstance.Status.State == cloudcontrolv1beta1.StateUpdating &&
+		updatingCondition != nil && updatingCondition.Message == message {
+		return composed.StopWithRequeueDelay(util.Timing.T300000ms()), nil
+	}
+
+	redisInstance.Status.State = cloudcontrolv1beta1.StateUpdating
+	return composed.UpdateStatus(redisInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeUpdating,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonTierChangeDeferred,
+			Message: message,
+		}).
+		ErrorLogMessage("Failed to set deferred tier change condition on redis instance").
+		SuccessLogMsg("Tier change of redis instance is deferred to the maintenance window").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T300000ms())).
+		Run(ctx, st)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+)
+
+type Client interface {
+	DescribeVpcs(ctx context.Context, name string) ([]ec2types.Vpc, error)
+
+	DescribeSecurityGroups(ctx context.Context, filters []ec2types.Filter, groupIds []string) ([]ec2types.SecurityGroup, error)
+	RevokeSecurityGroupIngress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error
+	RevokeSecurityGroupEgress(ctx context.Context, groupId string, ipPermissions []ec2types.IpPermission) error
+
+	DescribeFlowLogs(ctx context.Context, resourceId string) ([]ec2types.FlowLog, error)
+	CreateS3FlowLogs(ctx context.Context, vpcId, bucketArn string, tags []ec2types.Tag) (string, error)
+	DeleteFlowLogs(ctx context.Context, flowLogIds []string) error
+
+	S3BucketExists(ctx context.Context, name string) (bool, error)
+	CreateS3Bucket(ctx context.Context, name, region string, objectLockEnabled bool) error
+	PutS3PublicAccessBlock(ctx context.Context, name string) error
+	EmptyS3Bucket(ctx context.Context, name string) error
+	DeleteS3Bucket(ctx context.Context, name string) error
+
+	awsclient.KmsClient
+}
+
+func NewClientProvider() awsclient.SkrClientProvider[Client] {
+	return func(ctx context.Context, account, region, key, secret, role string) (Client, error) {
+		cfg, err := awsclient.NewSkrConfig(ctx, region, key, secret, role)
+		if err != nil {
+			return nil, err
+		}
+		return newClient(
+			awsclient.NewEc2Client(ec2.NewFromConfig(cfg)),
+			awsclient.NewS3Client(s3.NewFromConfig(cfg)),
+			awsclient.NewKmsClient(kms.NewFromConfig(cfg)),
+		), nil
+	}
+}
+
+func newClient(ec2Client awsclient.Ec2Client, s3Client awsclient.S3Client, kmsClient awsclient.KmsClient) Client {
+	return &client{
+		Ec2Client: ec2Client,
+		S3Client:  s3Client,
+		KmsClient: kmsClient,
+	}
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	awsclient.Ec2Client
+	awsclient.S3Client
+	awsclient.KmsClient
+}
@@ -0,0 +1,62 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+const defaultSecurityGroupName = "default"
+
+// defaultDenyApply removes all rules from the default security group of the shoot VPC, so resources
+// not explicitly assigned to a security group can not send or receive any traffic
+func defaultDenyApply(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	vpcId := ptr.Deref(state.vpc.VpcId, "")
+	list, err := state.awsClient.DescribeSecurityGroups(ctx, []ec2types.Filter{
+		{
+			Name:   new("vpc-id"),
+			Values: []string{vpcId},
+		},
+	}, nil)
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error listing AWS security groups")
+	}
+	list = pie.Filter(list, func(sg ec2types.SecurityGroup) bool {
+		return ptr.Deref(sg.VpcId, "") == vpcId && ptr.Deref(sg.GroupName, "") == defaultSecurityGroupName
+	})
+	if len(list) == 0 {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady,
+			fmt.Errorf("default security group of VPC %s not found", vpcId), "Error loading AWS default security group")
+	}
+	sg := list[0]
+	groupId := ptr.Deref(sg.GroupId, "")
+
+	if len(sg.IpPermissions) > 0 {
+		err = state.awsClient.RevokeSecurityGroupIngress(ctx, groupId, sg.IpPermissions)
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error revoking AWS default security group ingress rules")
+		}
+		logger.
+			WithValues("securityGroupId", groupId).
+			Info("AWS default security group ingress rules revoked")
+	}
+	if len(sg.IpPermissionsEgress) > 0 {
+		err = state.awsClient.RevokeSecurityGroupEgress(ctx, groupId, sg.IpPermissionsEgress)
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error revoking AWS default security group egress rules")
+		}
+		logger.
+			WithValues("securityGroupId", groupId).
+			Info("AWS default security group egress rules revoked")
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, fmt.Sprintf("Default security group %s denies all traffic", groupId))
+}
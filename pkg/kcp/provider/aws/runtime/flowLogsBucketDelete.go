@@ -0,0 +1,39 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+)
+
+func flowLogsBucketDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	bucketName := state.FlowLogsBucketName()
+
+	exists, err := state.awsClient.S3BucketExists(ctx, bucketName)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error checking AWS flow logs S3 bucket", ctx)
+	}
+	if !exists {
+		return nil, ctx
+	}
+
+	err = state.awsClient.EmptyS3Bucket(ctx, bucketName)
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error emptying AWS flow logs S3 bucket", ctx)
+	}
+
+	err = state.awsClient.DeleteS3Bucket(ctx, bucketName)
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting AWS flow logs S3 bucket", ctx)
+	}
+
+	logger.
+		WithValues("s3Bucket", bucketName).
+		Info("AWS flow logs S3 bucket deleted")
+
+	return nil, ctx
+}
@@ -0,0 +1,63 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+func flowLogsCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	bucketName := state.FlowLogsBucketName()
+	bucketArn := awsutil.S3BucketArn(bucketName)
+
+	exists, err := state.awsClient.S3BucketExists(ctx, bucketName)
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error checking AWS flow logs S3 bucket")
+	}
+	if !exists {
+		err = state.awsClient.CreateS3Bucket(ctx, bucketName, state.ObjAsRuntime().Spec.Shoot.Region, false)
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error creating AWS flow logs S3 bucket")
+		}
+		err = state.awsClient.PutS3PublicAccessBlock(ctx, bucketName)
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error blocking public access to AWS flow logs S3 bucket")
+		}
+		logger.
+			WithValues("s3Bucket", bucketName).
+			Info("AWS flow logs S3 bucket created")
+	}
+
+	flowLogs, err := state.awsClient.DescribeFlowLogs(ctx, ptr.Deref(state.vpc.VpcId, ""))
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error loading AWS VPC flow logs")
+	}
+
+	delivered := slices.ContainsFunc(flowLogs, func(fl ec2types.FlowLog) bool {
+		return ptr.Deref(fl.LogDestination, "") == bucketArn
+	})
+	if !delivered {
+		flowLogId, err := state.awsClient.CreateS3FlowLogs(ctx, ptr.Deref(state.vpc.VpcId, ""), bucketArn, awsutil.Ec2Tags(
+			common.TagCloudManagerName, state.Name().String(),
+			common.TagShoot, state.ObjAsRuntime().Spec.Shoot.Name,
+		))
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error creating AWS VPC flow logs")
+		}
+		logger.
+			WithValues("flowLogId", flowLogId).
+			Info("AWS VPC flow logs created")
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, fmt.Sprintf("VPC flow logs delivered to %s", bucketArn))
+}
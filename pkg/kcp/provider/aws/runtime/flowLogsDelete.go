@@ -0,0 +1,52 @@
+package runtime
+
+import (
+	"context"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"k8s.io/utils/ptr"
+)
+
+// flowLogsDelete deletes the VPC flow logs delivered to the runtime bucket, so nothing is written
+// into the bucket while it is emptied
+func flowLogsDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.vpc == nil {
+		return nil, ctx
+	}
+
+	flowLogs, err := state.awsClient.DescribeFlowLogs(ctx, ptr.Deref(state.vpc.VpcId, ""))
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading AWS VPC flow logs", ctx)
+	}
+
+	bucketArn := awsutil.S3BucketArn(state.FlowLogsBucketName())
+	flowLogIds := pie.Map(
+		pie.Filter(flowLogs, func(fl ec2types.FlowLog) bool {
+			return ptr.Deref(fl.LogDestination, "") == bucketArn
+		}),
+		func(fl ec2types.FlowLog) string {
+			return ptr.Deref(fl.FlowLogId, "")
+		},
+	)
+	if len(flowLogIds) == 0 {
+		return nil, ctx
+	}
+
+	err = state.awsClient.DeleteFlowLogs(ctx, flowLogIds)
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting AWS VPC flow logs", ctx)
+	}
+
+	logger.
+		WithValues("flowLogIds", flowLogIds).
+		Info("AWS VPC flow logs deleted")
+
+	return nil, ctx
+}
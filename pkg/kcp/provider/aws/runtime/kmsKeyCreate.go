@@ -0,0 +1,60 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+func kmsKeyCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	key, err := state.awsClient.DescribeKmsKeyByAlias(ctx, state.KmsKeyAlias())
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error loading AWS KMS key")
+	}
+
+	if key == nil {
+		key, err = state.awsClient.CreateKmsKey(ctx, fmt.Sprintf("Customer-managed key of the runtime %s", state.ObjAsRuntime().Name), []kmstypes.Tag{
+			{
+				TagKey:   new(common.TagCloudManagerName),
+				TagValue: new(state.Name().String()),
+			},
+			{
+				TagKey:   new(common.TagShoot),
+				TagValue: new(state.ObjAsRuntime().Spec.Shoot.Name),
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error creating AWS KMS key")
+		}
+
+		err = state.awsClient.CreateKmsAlias(ctx, state.KmsKeyAlias(), ptr.Deref(key.KeyId, ""))
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error creating AWS KMS key alias")
+		}
+
+		logger.
+			WithValues("kmsKeyId", ptr.Deref(key.KeyId, "")).
+			Info("AWS KMS key created")
+	}
+
+	rotationEnabled, err := state.awsClient.GetKmsKeyRotationEnabled(ctx, ptr.Deref(key.KeyId, ""))
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error loading AWS KMS key rotation status")
+	}
+	if !rotationEnabled {
+		err = state.awsClient.EnableKmsKeyRotation(ctx, ptr.Deref(key.KeyId, ""))
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error enabling AWS KMS key rotation")
+		}
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, fmt.Sprintf("KMS key %s", ptr.Deref(key.Arn, "")))
+}
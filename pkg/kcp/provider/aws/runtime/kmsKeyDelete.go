@@ -0,0 +1,46 @@
+package runtime
+
+import (
+	"context"
+
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"k8s.io/utils/ptr"
+)
+
+// kmsKeyPendingWindowInDays is the longest waiting period AWS allows, so the data encrypted
+// with the key can still be recovered by cancelling the deletion
+const kmsKeyPendingWindowInDays int32 = 30
+
+// kmsKeyDelete schedules the KMS key deletion before it deletes the alias, since the alias is
+// the only way the key of the runtime is found
+func kmsKeyDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	key, err := state.awsClient.DescribeKmsKeyByAlias(ctx, state.KmsKeyAlias())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error loading AWS KMS key", ctx)
+	}
+	if key == nil {
+		return nil, ctx
+	}
+
+	if key.KeyState != kmstypes.KeyStatePendingDeletion {
+		err = state.awsClient.ScheduleKmsKeyDeletion(ctx, ptr.Deref(key.KeyId, ""), kmsKeyPendingWindowInDays)
+		if err != nil {
+			return awsmeta.LogErrorAndReturn(err, "Error scheduling AWS KMS key deletion", ctx)
+		}
+		logger.
+			WithValues("kmsKeyId", ptr.Deref(key.KeyId, "")).
+			Info("AWS KMS key deletion scheduled")
+	}
+
+	err = state.awsClient.DeleteKmsAlias(ctx, state.KmsKeyAlias())
+	if err != nil && !awsmeta.IsNotFound(err) {
+		return awsmeta.LogErrorAndReturn(err, "Error deleting AWS KMS key alias", ctx)
+	}
+
+	return nil, ctx
+}
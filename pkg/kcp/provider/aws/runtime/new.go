@@ -4,10 +4,32 @@ import (
 	"context"
 
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
 )
 
 func New(stateFactory StateFactory) composed.Action {
-	return func(ctx context.Context, state composed.State) (error, context.Context) {
-		return nil, nil
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, err := stateFactory.NewState(ctx, st.(runtimetypes.State))
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error creating new AWS Runtime state", composed.StopWithRequeue, ctx)
+		}
+
+		return composed.IfElse(
+			composed.Not(composed.MarkedForDeletionPredicate),
+			composed.ComposeActions(
+				"awsRuntime-create",
+				kmsKeyCreate,
+				vpcLoad,
+				flowLogsCreate,
+				defaultDenyApply,
+			),
+			composed.ComposeActions(
+				"awsRuntime-delete",
+				vpcLoad,
+				flowLogsDelete,
+				flowLogsBucketDelete,
+				kmsKeyDelete,
+			),
+		)(ctx, state)
 	}
 }
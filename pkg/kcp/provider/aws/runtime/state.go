@@ -2,18 +2,73 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awsruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/runtime/client"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
 	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
 )
 
 type State struct {
 	runtimetypes.State
+
+	awsClient awsruntimeclient.Client
+
+	vpc *ec2types.Vpc
 }
 
 type StateFactory interface {
 	NewState(ctx context.Context, runtimeState runtimetypes.State) (*State, error)
 }
 
-func NewStateFactory() StateFactory {
-	return nil
+func NewStateFactory(awsClientProvider awsclient.SkrClientProvider[awsruntimeclient.Client]) StateFactory {
+	return &stateFactory{
+		awsClientProvider: awsClientProvider,
+	}
+}
+
+type stateFactory struct {
+	awsClientProvider awsclient.SkrClientProvider[awsruntimeclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, runtimeState runtimetypes.State) (*State, error) {
+	subscription := runtimeState.Subscription()
+	if subscription.Status.Provider != cloudcontrolv1beta1.ProviderAws {
+		return nil, fmt.Errorf("subscription for Runtime must be of provider AWS, but subscription %q is of provider %q", subscription.Name, subscription.Status.Provider)
+	}
+
+	c, err := f.awsClientProvider(
+		ctx,
+		subscription.Status.SubscriptionInfo.Aws.Account,
+		runtimeState.ObjAsRuntime().Spec.Shoot.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		awsutil.RoleArnDefault(subscription.Status.SubscriptionInfo.Aws.Account),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(runtimeState, c), nil
+}
+
+func newState(runtimeState runtimetypes.State, awsClient awsruntimeclient.Client) *State {
+	return &State{
+		State:     runtimeState,
+		awsClient: awsClient,
+	}
+}
+
+// KmsKeyAlias is the alias of the customer-managed KMS key of the runtime
+func (s *State) KmsKeyAlias() string {
+	return fmt.Sprintf("alias/cloud-manager/%s", s.ObjAsRuntime().Name)
+}
+
+// FlowLogsBucketName is the name of the S3 bucket VPC flow logs are delivered to
+func (s *State) FlowLogsBucketName() string {
+	return fmt.Sprintf("cloud-manager-flow-logs-%s", s.ObjAsRuntime().Name)
 }
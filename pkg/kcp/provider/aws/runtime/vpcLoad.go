@@ -0,0 +1,42 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func vpcLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	vpcList, err := state.awsClient.DescribeVpcs(ctx, state.ShootVpcName())
+	if err != nil {
+		return awsmeta.LogErrorAndReturn(err, "Error listing AWS VPCs", ctx)
+	}
+	if len(vpcList) > 1 {
+		return composed.LogErrorAndReturn(
+			fmt.Errorf("found %d VPCs named %s", len(vpcList), state.ShootVpcName()),
+			"Ambiguous shoot VPC",
+			composed.StopWithRequeueDelay(util.Timing.T300000ms()),
+			ctx,
+		)
+	}
+	if len(vpcList) == 0 && composed.MarkedForDeletionPredicate(ctx, state) {
+		// the shoot is already deleted and its VPC flow logs with it
+		logger.Info("Shoot VPC not found")
+		return nil, ctx
+	}
+	if len(vpcList) == 0 {
+		// the shoot is not provisioned yet
+		logger.Info("Shoot VPC not found")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+	}
+
+	state.vpc = &vpcList[0]
+
+	return nil, composed.LoggerIntoCtx(ctx, logger.WithValues("vpcId", *state.vpc.VpcId))
+}
@@ -90,3 +90,15 @@ func BackupRecoveryPointArn(region, accountId, recoveryPointId string) string {
 	}
 	return a.String()
 }
+
+// S3 =========================================================================
+
+// S3BucketArn returns string arn in form "arn:<partition>:s3:::<bucketName>"
+func S3BucketArn(bucketName string) string {
+	a := &arn.ARN{
+		Partition: awsconfig.AwsConfig.ArnPartition,
+		Service:   "s3",
+		Resource:  bucketName,
+	}
+	return a.String()
+}
@@ -74,6 +74,11 @@ func TestArn(t *testing.T) {
 			assert.Equal(t, "arn:aws-cn:backup:us-east-1:123123:recovery-point:my-point", x)
 		})
 
+		t.Run("S3BucketArn", func(t *testing.T) {
+			x := S3BucketArn("my-bucket")
+			assert.Equal(t, "arn:aws-cn:s3:::my-bucket", x)
+		})
+
 	})
 
 	t.Run("partition default", func(t *testing.T) {
@@ -113,6 +118,11 @@ func TestArn(t *testing.T) {
 			x := BackupRecoveryPointArn("us-east-1", "123123", "my-point")
 			assert.Equal(t, "arn:aws:backup:us-east-1:123123:recovery-point:my-point", x)
 		})
+
+		t.Run("S3BucketArn", func(t *testing.T) {
+			x := S3BucketArn("my-bucket")
+			assert.Equal(t, "arn:aws:s3:::my-bucket", x)
+		})
 	})
 
 }
@@ -3,6 +3,7 @@ package util
 import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"k8s.io/utils/ptr"
 	"strings"
 )
@@ -34,6 +35,15 @@ func GetEfsTagValue(tags []efstypes.Tag, key string) string {
 	return ""
 }
 
+func GetElastiCacheTagValue(tags []elasticachetypes.Tag, key string) string {
+	for _, t := range tags {
+		if ptr.Deref(t.Key, "") == key {
+			return ptr.Deref(t.Value, "")
+		}
+	}
+	return ""
+}
+
 func GetEc2TagValue(tags []ec2types.Tag, key string) string {
 	for _, t := range tags {
 		if ptr.Deref(t.Key, "") == key {
@@ -0,0 +1,50 @@
+package vpcpeering
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"k8s.io/utils/ptr"
+)
+
+// detectDrift reports a peering connection that is no longer active and the peering routes
+// removed from the local or remote route tables, which createRoutes and createRemoteRoutes
+// are able to recreate
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	if state.vpcPeering == nil || state.vpcPeering.Status == nil ||
+		state.vpcPeering.Status.Code != types.VpcPeeringConnectionStateReasonCodeActive {
+		return []string{"peeringConnection"}
+	}
+
+	peeringRouteExists := func(t types.RouteTable, cidrBlock *string) bool {
+		return pie.Any(t.Routes, func(r types.Route) bool {
+			return ptr.Equal(r.VpcPeeringConnectionId, state.vpcPeering.VpcPeeringConnectionId) &&
+				ptr.Equal(r.DestinationCidrBlock, cidrBlock)
+		})
+	}
+
+	var fields []string
+
+	if state.remoteVpc != nil && pie.Any(state.routeTables, func(t types.RouteTable) bool {
+		return pie.Any(state.remoteVpc.CidrBlockAssociationSet, func(a types.VpcCidrBlockAssociation) bool {
+			return !peeringRouteExists(t, a.CidrBlock)
+		})
+	}) {
+		fields = append(fields, "routes")
+	}
+
+	strategy := state.ObjAsVpcPeering().Spec.Details.RemoteRouteTableUpdateStrategy
+	if state.vpc != nil && !awsutil.IsRouteTableUpdateStrategyNone(strategy) && pie.Any(state.remoteRouteTables, func(t types.RouteTable) bool {
+		return awsutil.ShouldUpdateRouteTable(t.Tags, strategy, state.Scope().Spec.ShootName) &&
+			!peeringRouteExists(t, state.vpc.CidrBlock)
+	}) {
+		fields = append(fields, "remoteRoutes")
+	}
+
+	return fields
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering/types"
 )
 
@@ -38,12 +39,14 @@ func New(stateFactory StateFactory) composed.Action {
 					deleteVpcPeering,
 					remoteRoutesDelete,
 					remotePeeringDelete,
+					drift.Forget(drift.KindVpcPeering),
 					actions.PatchRemoveCommonFinalizer(),
 				),
 				composed.ComposeActions(
 					"awsVpcPeering-non-delete",
 					actions.PatchAddCommonFinalizer(),
 					checkNetworkTag,
+					drift.New(drift.KindVpcPeering, detectDrift),
 					createVpcPeeringConnection,
 					waitPendingAcceptance,
 					acceptVpcPeeringConnection,
@@ -51,7 +54,7 @@ func New(stateFactory StateFactory) composed.Action {
 					createRoutes,
 					createRemoteRoutes,
 					updateSuccessStatus,
-					composed.StopAndForgetAction,
+					drift.StopAndForgetAction(drift.KindVpcPeering),
 				),
 			),
 			composed.StopAndForgetAction,
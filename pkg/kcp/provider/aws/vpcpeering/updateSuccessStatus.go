@@ -4,6 +4,7 @@ import (
 	"context"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -33,6 +34,6 @@ func updateSuccessStatus(ctx context.Context, st composed.State) (error, context
 		}).
 		ErrorLogMessage("Error updating VpcPeering success status after setting Ready condition").
 		SuccessLogMsg("KPC VpcPeering is ready").
-		SuccessError(composed.StopAndForget).
+		SuccessError(drift.StopAndForget(drift.KindVpcPeering)).
 		Run(ctx, state)
 }
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+type Client interface {
+	azureclient.StorageAccountClient
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+	azureclient.PrivateDnsZoneClient
+	azureclient.VirtualNetworkLinkClient
+}
+
+func NewClientProvider() azureclient.ClientProvider[Client] {
+	return func(ctx context.Context, clientId, clientSecret, subscriptionId, tenantId string, auxiliaryTenants ...string) (Client, error) {
+
+		cred, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, azureclient.NewCredentialOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		accountsClient, err := armstorage.NewAccountsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		blobContainersClient, err := armstorage.NewBlobContainersClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		blobServicesClient, err := armstorage.NewBlobServicesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		managementPoliciesClient, err := armstorage.NewManagementPoliciesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateEndPointsClient, err := armnetwork.NewPrivateEndpointsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateDnsZoneGroupClient, err := armnetwork.NewPrivateDNSZoneGroupsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateDnsZoneClient, err := armprivatedns.NewPrivateZonesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		virtualNetworkLinkClient, err := armprivatedns.NewVirtualNetworkLinksClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		return newClient(
+			azureclient.NewStorageAccountClient(accountsClient, blobContainersClient, blobServicesClient, managementPoliciesClient),
+			azureclient.NewPrivateEndPointClient(privateEndPointsClient),
+			azureclient.NewPrivateDnsZoneGroupClient(privateDnsZoneGroupClient),
+			azureclient.NewPrivateDnsZoneClient(privateDnsZoneClient),
+			azureclient.NewVirtualNetworkLinkClient(virtualNetworkLinkClient),
+		), nil
+	}
+}
+
+type bucketClient struct {
+	azureclient.StorageAccountClient
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+	azureclient.PrivateDnsZoneClient
+	azureclient.VirtualNetworkLinkClient
+}
+
+func newClient(
+	storageAccountClient azureclient.StorageAccountClient,
+	privateEndPointsClient azureclient.PrivateEndPointsClient,
+	privateDnsZoneGroupClient azureclient.PrivateDnsZoneGroupClient,
+	privateDnsZoneClient azureclient.PrivateDnsZoneClient,
+	virtualNetworkLinkClient azureclient.VirtualNetworkLinkClient,
+) Client {
+	return &bucketClient{
+		StorageAccountClient:      storageAccountClient,
+		PrivateEndPointsClient:    privateEndPointsClient,
+		PrivateDnsZoneGroupClient: privateDnsZoneGroupClient,
+		PrivateDnsZoneClient:      privateDnsZoneClient,
+		VirtualNetworkLinkClient:  virtualNetworkLinkClient,
+	}
+}
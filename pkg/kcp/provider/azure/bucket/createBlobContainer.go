@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func createBlobContainer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.blobContainer != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure blob container")
+
+	err := state.client.CreateBlobContainer(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName())
+	if err != nil {
+		logger.Error(err, "Error creating Azure blob container")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed creating Azure blob container", "Error updating Bucket status due failed azure blob container creation")
+	}
+
+	container, err := state.client.GetBlobContainer(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName())
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading created Azure blob container", composed.StopWithRequeue, ctx)
+	}
+
+	state.blobContainer = container
+
+	return nil, ctx
+}
@@ -0,0 +1,31 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+// createPrivateDnsZone creates the blob privatelink zone in the cloud manager resource group.
+// The zone is shared by all buckets of the runtime and is never deleted by the Bucket,
+// it's removed together with the resource group when the KCP Network is deleted.
+func createPrivateDnsZone(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZone != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure blob Private DnsZone")
+
+	err := state.client.CreatePrivateDnsZone(ctx, state.resourceGroupName, azureutil.NewBlobPrivateDnsZoneName(), nil)
+	if err != nil {
+		logger.Error(err, "Error creating Azure blob Private DnsZone")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed creating Azure blob Private DnsZone", "Error updating Bucket status due failed azure Private DnsZone creation")
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,41 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func createPrivateDnsZoneGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZoneGroup != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure Private DnsZone Group")
+
+	privateDnsZoneName := azureutil.NewBlobPrivateDnsZoneName()
+	err := state.client.CreatePrivateDnsZoneGroup(ctx, state.resourceGroupName, state.Obj().GetName(), state.Obj().GetName(), armnetwork.PrivateDNSZoneGroup{
+		Properties: &armnetwork.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: []*armnetwork.PrivateDNSZoneConfig{
+				{
+					Name: new(privateDnsZoneName),
+					Properties: &armnetwork.PrivateDNSZonePropertiesFormat{
+						PrivateDNSZoneID: new(azureutil.NewPrivateDnsZoneResourceId(state.subscriptionId, state.resourceGroupName, privateDnsZoneName).String()),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		logger.Error(err, "Error creating Azure Private DnsZone Group")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed creating Azure Private DnsZone Group", "Error updating Bucket status due failed azure Private DnsZone Group creation")
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
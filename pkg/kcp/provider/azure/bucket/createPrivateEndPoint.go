@@ -0,0 +1,52 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// createPrivateEndPoint creates the blob private endpoint of the storage account in the cloud manager subnet
+func createPrivateEndPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateEndPoint != nil && ptr.Deref(state.privateEndPoint.Properties.ProvisioningState, "") != armnetwork.ProvisioningStateFailed {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure Private EndPoint")
+
+	privateEndPointName := state.Obj().GetName()
+	subnetName := state.resourceGroupName
+	storageAccountResourceId := azureutil.NewStorageAccountResourceId(state.subscriptionId, state.resourceGroupName, state.StorageAccountName()).String()
+	subnetResourceId := azureutil.NewSubnetResourceId(state.subscriptionId, state.resourceGroupName, subnetName, subnetName).String()
+
+	err := state.client.CreatePrivateEndPoint(ctx, state.resourceGroupName, privateEndPointName, armnetwork.PrivateEndpoint{
+		Location: new(state.Scope().Spec.Region),
+		Properties: &armnetwork.PrivateEndpointProperties{
+			Subnet: &armnetwork.Subnet{
+				ID: new(subnetResourceId),
+			},
+			PrivateLinkServiceConnections: []*armnetwork.PrivateLinkServiceConnection{
+				{
+					Name: new(privateEndPointName),
+					Properties: &armnetwork.PrivateLinkServiceConnectionProperties{
+						PrivateLinkServiceID: new(storageAccountResourceId),
+						GroupIDs:             []*string{new(blobPrivateLinkGroupId)},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		logger.Error(err, "Error creating Azure Private EndPoint")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed creating Azure Private EndPoint", "Error updating Bucket status due failed azure Private EndPoint creation")
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
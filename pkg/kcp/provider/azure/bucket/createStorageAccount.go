@@ -0,0 +1,53 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// createStorageAccount creates a dedicated storage account for the Bucket. The public network access
+// is disabled, and the account is reached only through the private endpoint in the kyma network.
+func createStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.storageAccount != nil {
+		return nil, ctx
+	}
+
+	bucket := state.ObjAsBucket()
+
+	logger.Info("Creating Azure storage account")
+
+	err := state.client.CreateStorageAccount(ctx, state.resourceGroupName, state.StorageAccountName(), armstorage.AccountCreateParameters{
+		Kind:     ptr.To(armstorage.KindStorageV2),
+		Location: new(state.Scope().Spec.Region),
+		SKU: &armstorage.SKU{
+			Name: ptr.To(armstorage.SKUNameStandardLRS),
+		},
+		Properties: &armstorage.AccountPropertiesCreateParameters{
+			AllowBlobPublicAccess:  new(false),
+			EnableHTTPSTrafficOnly: new(true),
+			MinimumTLSVersion:      ptr.To(armstorage.MinimumTLSVersionTLS12),
+			PublicNetworkAccess:    ptr.To(armstorage.PublicNetworkAccessDisabled),
+		},
+		Tags: azureutil.AzureTags(map[string]string{
+			common.TagCloudManagerName:       state.Name().String(),
+			common.TagCloudManagerRemoteName: bucket.Spec.RemoteRef.String(),
+			common.TagScope:                  bucket.Spec.Scope.Name,
+			common.TagShoot:                  state.Scope().Spec.ShootName,
+		}),
+	})
+	if err != nil {
+		logger.Error(err, "Error creating Azure storage account")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed creating Azure storage account", "Error updating Bucket status due failed azure storage account creation")
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,36 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+// createVirtualNetworkLink links the blob privatelink zone to the kyma network so the storage account
+// blob endpoint resolves to the private endpoint address. Same as the zone, the link is shared and not deleted.
+func createVirtualNetworkLink(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.virtualNetworkLink != nil {
+		return nil, ctx
+	}
+	if state.privateDnsZone == nil {
+		logger.Info("Can not create Azure blob VirtualNetworkLink, Private DnsZone is not loaded, requeue")
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+
+	logger.Info("Creating Azure blob VirtualNetworkLink")
+
+	kymaNetworkName := state.Scope().Spec.Scope.Azure.VpcNetwork
+	vnetId := azureutil.NewVirtualNetworkResourceId(state.subscriptionId, kymaNetworkName, kymaNetworkName).String()
+	err := state.client.CreateVirtualNetworkLink(ctx, state.resourceGroupName, azureutil.NewBlobPrivateDnsZoneName(), kymaNetworkName, vnetId)
+	if err != nil {
+		logger.Error(err, "Error creating Azure blob VirtualNetworkLink")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed creating Azure blob VirtualNetworkLink", "Error updating Bucket status due failed azure VirtualNetworkLink creation")
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
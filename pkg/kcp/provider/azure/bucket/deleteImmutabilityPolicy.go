@@ -0,0 +1,32 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"k8s.io/utils/ptr"
+)
+
+// deleteImmutabilityPolicy removes the unlocked container immutability policy, since the
+// storage account can not be deleted while its containers have one
+func deleteImmutabilityPolicy(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.immutabilityPolicy == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting Azure blob container immutability policy")
+
+	err := state.client.DeleteBlobImmutabilityPolicy(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName(), ptr.Deref(state.immutabilityPolicy.Etag, ""))
+	if azuremeta.IgnoreNotFoundError(err) != nil {
+		logger.Error(err, "Error deleting Azure blob container immutability policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed deleting Azure blob container immutability policy", "Error updating Bucket status due failed azure immutability policy deletion")
+	}
+
+	state.immutabilityPolicy = nil
+
+	return nil, ctx
+}
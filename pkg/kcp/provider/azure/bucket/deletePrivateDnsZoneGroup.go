@@ -0,0 +1,36 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func deletePrivateDnsZoneGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZoneGroup == nil {
+		return nil, ctx
+	}
+	if ptr.Deref(state.privateDnsZoneGroup.Properties.ProvisioningState, "") == armnetwork.ProvisioningStateDeleting {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting Azure Private DnsZone Group")
+
+	err := state.client.DeletePrivateDnsZoneGroup(ctx, state.resourceGroupName, state.Obj().GetName(), state.Obj().GetName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			return nil, ctx
+		}
+		logger.Error(err, "Error deleting Azure Private DnsZone Group")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed deleting Azure Private DnsZone Group", "Error updating Bucket status due failed azure Private DnsZone Group deletion")
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,30 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+// deleteStorageAccount deletes the storage account together with the container and all its blobs
+func deleteStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.storageAccount == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting Azure storage account")
+
+	err := state.client.DeleteStorageAccount(ctx, state.resourceGroupName, state.StorageAccountName())
+	if azuremeta.IgnoreNotFoundError(err) != nil {
+		logger.Error(err, "Error deleting Azure storage account")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed deleting Azure storage account", "Error updating Bucket status due failed azure storage account deletion")
+	}
+
+	state.storageAccount = nil
+
+	return nil, ctx
+}
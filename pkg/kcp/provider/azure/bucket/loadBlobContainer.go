@@ -0,0 +1,36 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+func loadBlobContainer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.blobContainer != nil {
+		return nil, ctx
+	}
+	if state.storageAccount == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Loading Azure blob container")
+
+	container, err := state.client.GetBlobContainer(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure blob container not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure blob container")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure blob container", "Error updating Bucket status due failed azure blob container loading")
+	}
+
+	state.blobContainer = container
+
+	return nil, ctx
+}
@@ -0,0 +1,26 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func loadImmutabilityPolicy(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.blobContainer == nil {
+		return nil, ctx
+	}
+
+	policy, err := state.client.GetBlobImmutabilityPolicy(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName())
+	if err != nil {
+		logger.Error(err, "Error loading Azure blob container immutability policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure blob container immutability policy", "Error updating Bucket status due failed azure immutability policy loading")
+	}
+
+	state.immutabilityPolicy = policy
+
+	return nil, ctx
+}
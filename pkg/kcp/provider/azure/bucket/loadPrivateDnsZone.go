@@ -0,0 +1,32 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+)
+
+func loadPrivateDnsZone(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZone != nil {
+		return nil, ctx
+	}
+
+	privateDnsZone, err := state.client.GetPrivateDnsZone(ctx, state.resourceGroupName, azureutil.NewBlobPrivateDnsZoneName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure blob Private DnsZone not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure blob Private DnsZone")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure blob Private DnsZone", "Error updating Bucket status due failed azure Private DnsZone loading")
+	}
+
+	state.privateDnsZone = privateDnsZone
+
+	return nil, ctx
+}
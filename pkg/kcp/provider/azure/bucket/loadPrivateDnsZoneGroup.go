@@ -0,0 +1,36 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+func loadPrivateDnsZoneGroup(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZoneGroup != nil {
+		return nil, ctx
+	}
+	if state.privateEndPoint == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Loading Azure Private DnsZone Group")
+
+	privateDnsZoneGroup, err := state.client.GetPrivateDnsZoneGroup(ctx, state.resourceGroupName, state.Obj().GetName(), state.Obj().GetName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure Private DnsZone Group not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure Private DnsZone Group")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure Private DnsZone Group", "Error updating Bucket status due failed azure Private DnsZone Group loading")
+	}
+
+	state.privateDnsZoneGroup = privateDnsZoneGroup
+
+	return nil, ctx
+}
@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+func loadPrivateEndPoint(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateEndPoint != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Loading Azure Private EndPoint")
+
+	privateEndPoint, err := state.client.GetPrivateEndPoint(ctx, state.resourceGroupName, state.Obj().GetName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure Private EndPoint not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure Private EndPoint")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure Private EndPoint", "Error updating Bucket status due failed azure Private EndPoint loading")
+	}
+
+	state.privateEndPoint = privateEndPoint
+
+	return nil, ctx
+}
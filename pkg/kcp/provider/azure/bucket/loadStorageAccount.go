@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+func loadStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.storageAccount != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Loading Azure storage account")
+
+	account, err := state.client.GetStorageAccount(ctx, state.resourceGroupName, state.StorageAccountName())
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure storage account not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure storage account")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure storage account", "Error updating Bucket status due failed azure storage account loading")
+	}
+
+	state.storageAccount = account
+
+	return nil, ctx
+}
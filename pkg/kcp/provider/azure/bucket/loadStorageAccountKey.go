@@ -0,0 +1,24 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// loadStorageAccountKey loads the storage account key on each reconciliation,
+// so the status follows the key rotations done outside of the cloud manager
+func loadStorageAccountKey(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	key, err := state.client.GetStorageAccountKey(ctx, state.resourceGroupName, state.StorageAccountName())
+	if err != nil {
+		logger.Error(err, "Error loading Azure storage account key")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure storage account key", "Error updating Bucket status due failed azure storage account key loading")
+	}
+
+	state.storageAccountKey = key
+
+	return nil, ctx
+}
@@ -0,0 +1,36 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+)
+
+func loadVirtualNetworkLink(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.virtualNetworkLink != nil {
+		return nil, ctx
+	}
+	if state.privateDnsZone == nil {
+		return nil, ctx
+	}
+
+	virtualNetworkLinkName := state.Scope().Spec.Scope.Azure.VpcNetwork
+	virtualNetworkLink, err := state.client.GetVirtualNetworkLink(ctx, state.resourceGroupName, azureutil.NewBlobPrivateDnsZoneName(), virtualNetworkLinkName)
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure blob VirtualNetworkLink not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure blob VirtualNetworkLink")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure blob VirtualNetworkLink", "Error updating Bucket status due failed azure VirtualNetworkLink loading")
+	}
+
+	state.virtualNetworkLink = virtualNetworkLink
+
+	return nil, ctx
+}
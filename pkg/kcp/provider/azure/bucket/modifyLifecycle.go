@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyLifecycle(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	desired := getManagementPolicyRules(state.BlobContainerName(), state.ObjAsBucket().Spec.Bucket.Azure.Lifecycle)
+
+	current, err := state.client.GetBlobManagementPolicyRules(ctx, state.resourceGroupName, state.StorageAccountName())
+	if err != nil {
+		logger.Error(err, "Error loading Azure storage account management policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure storage account management policy", "Error updating Bucket status due failed azure management policy loading")
+	}
+	if areEqualManagementPolicyRules(current, desired) {
+		return nil, ctx
+	}
+
+	logger.Info("Modifying Azure storage account management policy", "rules", len(desired))
+
+	err = state.client.SetBlobManagementPolicyRules(ctx, state.resourceGroupName, state.StorageAccountName(), desired)
+	if err != nil {
+		logger.Error(err, "Error modifying Azure storage account management policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed modifying Azure storage account management policy", "Error updating Bucket status due failed azure management policy modification")
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,35 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+// modifyRetention keeps the container time-based immutability policy in sync with the retention days.
+// The policy is kept unlocked, so it can be shortened or removed, and the container can be deleted.
+func modifyRetention(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	desired := state.ObjAsBucket().Spec.Bucket.Azure.RetentionDays
+	if state.GetRetentionDays() == desired {
+		return nil, ctx
+	}
+
+	logger.Info("Modifying Azure blob container immutability policy", "retentionDays", desired)
+
+	var err error
+	if desired > 0 {
+		err = state.client.SetBlobImmutabilityPolicy(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName(), desired)
+	} else {
+		err = state.client.DeleteBlobImmutabilityPolicy(ctx, state.resourceGroupName, state.StorageAccountName(), state.BlobContainerName(), ptr.Deref(state.immutabilityPolicy.Etag, ""))
+	}
+	if err != nil {
+		logger.Error(err, "Error modifying Azure blob container immutability policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed modifying Azure blob container immutability policy", "Error updating Bucket status due failed azure immutability policy modification")
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,33 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyVersioning(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	desired := state.ObjAsBucket().Spec.Bucket.Azure.Versioning
+
+	current, err := state.client.GetBlobVersioning(ctx, state.resourceGroupName, state.StorageAccountName())
+	if err != nil {
+		logger.Error(err, "Error loading Azure blob versioning")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed loading Azure blob versioning", "Error updating Bucket status due failed azure blob versioning loading")
+	}
+	if current == desired {
+		return nil, ctx
+	}
+
+	logger.Info("Modifying Azure blob versioning", "versioning", desired)
+
+	err = state.client.SetBlobVersioning(ctx, state.resourceGroupName, state.StorageAccountName(), desired)
+	if err != nil {
+		logger.Error(err, "Error modifying Azure blob versioning")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed modifying Azure blob versioning", "Error updating Bucket status due failed azure blob versioning modification")
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,79 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/bucket/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+
+		state, err := stateFactory.NewState(ctx, st.(types.State))
+		if err != nil {
+			composed.LoggerFromCtx(ctx).Error(err, "Failed to bootstrap Azure Bucket state")
+			bucket := st.Obj().(*cloudcontrolv1beta1.Bucket)
+			bucket.Status.State = cloudcontrolv1beta1.StateError
+			return composed.UpdateStatus(bucket).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+					Message: "Failed to create Bucket state",
+				}).
+				SuccessError(composed.StopAndForget).
+				SuccessLogMsg(fmt.Sprintf("Error creating new Azure Bucket state: %s", err)).
+				Run(ctx, st)
+		}
+
+		return composed.ComposeActions(
+			"azureBucket",
+			actions.AddCommonFinalizer(),
+			loadStorageAccount,
+			loadBlobContainer,
+			loadImmutabilityPolicy,
+			loadPrivateEndPoint,
+			loadPrivateDnsZoneGroup,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"azure-bucket-create",
+					loadPrivateDnsZone,
+					createPrivateDnsZone,
+					loadVirtualNetworkLink,
+					createVirtualNetworkLink,
+					createStorageAccount,
+					waitStorageAccountAvailable,
+					createBlobContainer,
+					updateStatusId,
+					modifyVersioning,
+					modifyLifecycle,
+					loadImmutabilityPolicy,
+					modifyRetention,
+					createPrivateEndPoint,
+					waitPrivateEndPointAvailable,
+					createPrivateDnsZoneGroup,
+					loadStorageAccountKey,
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"azure-bucket-delete",
+					removeReadyCondition,
+					deleteImmutabilityPolicy,
+					deletePrivateDnsZoneGroup,
+					waitPrivateDnsZoneGroupDeleted,
+					deletePrivateEndPoint,
+					waitPrivateEndPointDeleted,
+					deleteStorageAccount,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(ctx, state)
+	}
+}
@@ -0,0 +1,103 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/bucket/types"
+	azurebucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket/client"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	"k8s.io/utils/ptr"
+)
+
+type State struct {
+	types.State
+
+	client         azurebucketclient.Client
+	subscriptionId string
+
+	resourceGroupName   string
+	storageAccount      *armstorage.Account
+	storageAccountKey   string
+	blobContainer       *armstorage.BlobContainer
+	immutabilityPolicy  *armstorage.ImmutabilityPolicy
+	privateDnsZone      *armprivatedns.PrivateZone
+	virtualNetworkLink  *armprivatedns.VirtualNetworkLink
+	privateEndPoint     *armnetwork.PrivateEndpoint
+	privateDnsZoneGroup *armnetwork.PrivateDNSZoneGroup
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, bucketState types.State) (*State, error)
+}
+
+type stateFactory struct {
+	provider azureclient.ClientProvider[azurebucketclient.Client]
+}
+
+func NewStateFactory(provider azureclient.ClientProvider[azurebucketclient.Client]) StateFactory {
+	return &stateFactory{
+		provider: provider,
+	}
+}
+
+func (f *stateFactory) NewState(ctx context.Context, bucketState types.State) (*State, error) {
+	clientId := azureconfig.AzureConfig.DefaultCreds.ClientId
+	clientSecret := azureconfig.AzureConfig.DefaultCreds.ClientSecret
+	subscriptionId := bucketState.Scope().Spec.Scope.Azure.SubscriptionId
+	tenantId := bucketState.Scope().Spec.Scope.Azure.TenantId
+
+	c, err := f.provider(ctx, clientId, clientSecret, subscriptionId, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(bucketState, c, subscriptionId), nil
+}
+
+func newState(state types.State, client azurebucketclient.Client, subscriptionId string) *State {
+	return &State{
+		State:          state,
+		client:         client,
+		subscriptionId: subscriptionId,
+
+		resourceGroupName: azurecommon.AzureCloudManagerResourceGroupName(state.Scope().Spec.Scope.Azure.VpcNetwork),
+	}
+}
+
+func (s *State) StorageAccountName() string {
+	return GetAzureStorageAccountName(s.Obj().GetName())
+}
+
+func (s *State) BlobContainerName() string {
+	return GetAzureBlobContainerName(s.Obj().GetName())
+}
+
+// GetStorageAccountProvisioningState returns the provisioning state of the loaded storage account,
+// or empty string if it's not loaded
+func (s *State) GetStorageAccountProvisioningState() armstorage.ProvisioningState {
+	if s.storageAccount == nil || s.storageAccount.Properties == nil {
+		return ""
+	}
+	return ptr.Deref(s.storageAccount.Properties.ProvisioningState, "")
+}
+
+// GetBlobEndpoint returns the primary blob endpoint of the loaded storage account
+func (s *State) GetBlobEndpoint() string {
+	if s.storageAccount == nil || s.storageAccount.Properties == nil || s.storageAccount.Properties.PrimaryEndpoints == nil {
+		return ""
+	}
+	return ptr.Deref(s.storageAccount.Properties.PrimaryEndpoints.Blob, "")
+}
+
+// GetRetentionDays returns the immutability period of the loaded container policy, or zero if there's none
+func (s *State) GetRetentionDays() int32 {
+	if s.immutabilityPolicy == nil || s.immutabilityPolicy.Properties == nil {
+		return 0
+	}
+	return ptr.Deref(s.immutabilityPolicy.Properties.ImmutabilityPeriodSinceCreationInDays, 0)
+}
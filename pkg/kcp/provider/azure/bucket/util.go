@@ -0,0 +1,126 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// blobPrivateLinkGroupId is the private link sub-resource of the storage account
+	blobPrivateLinkGroupId = "blob"
+
+	blockBlobType = "blockBlob"
+)
+
+// GetAzureStorageAccountName returns the storage account name for the KCP Bucket with the given name.
+// Storage account names are globally unique, 3-24 characters long, and can only contain
+// lowercase letters and numbers.
+func GetAzureStorageAccountName(name string) string {
+	name = strings.ToLower(strings.ReplaceAll(name, "-", ""))
+	if len(name) > 22 {
+		name = name[:22]
+	}
+	return "cm" + name
+}
+
+func GetAzureBlobContainerName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+// getManagementPolicyRules maps the Bucket lifecycle rules to storage account management policy rules.
+// The management policy belongs to the storage account, so the prefix filter starts with the container name.
+func getManagementPolicyRules(containerName string, lifecycle []cloudcontrolv1beta1.BucketLifecycleRule) []*armstorage.ManagementPolicyRule {
+	var result []*armstorage.ManagementPolicyRule
+	for i, r := range lifecycle {
+		actions := &armstorage.ManagementPolicyAction{}
+		if r.ExpirationDays > 0 {
+			actions.BaseBlob = &armstorage.ManagementPolicyBaseBlob{
+				Delete: &armstorage.DateAfterModification{
+					DaysAfterModificationGreaterThan: new(float32(r.ExpirationDays)),
+				},
+			}
+		}
+		if r.NoncurrentVersionExpirationDays > 0 {
+			actions.Version = &armstorage.ManagementPolicyVersion{
+				Delete: &armstorage.DateAfterCreation{
+					DaysAfterCreationGreaterThan: new(float32(r.NoncurrentVersionExpirationDays)),
+				},
+			}
+		}
+		result = append(result, &armstorage.ManagementPolicyRule{
+			Name:    new(fmt.Sprintf("cm-rule-%d", i)),
+			Type:    ptr.To(armstorage.RuleTypeLifecycle),
+			Enabled: new(true),
+			Definition: &armstorage.ManagementPolicyDefinition{
+				Actions: actions,
+				Filters: &armstorage.ManagementPolicyFilter{
+					BlobTypes:   []*string{ptr.To(blockBlobType)},
+					PrefixMatch: []*string{new(fmt.Sprintf("%s/%s", containerName, r.Prefix))},
+				},
+			},
+		})
+	}
+	return result
+}
+
+func managementPolicyRuleKey(r *armstorage.ManagementPolicyRule) string {
+	var prefixes []string
+	var expiration, noncurrentExpiration float32
+	if r.Definition != nil {
+		if r.Definition.Filters != nil {
+			for _, p := range r.Definition.Filters.PrefixMatch {
+				prefixes = append(prefixes, ptr.Deref(p, ""))
+			}
+		}
+		if a := r.Definition.Actions; a != nil {
+			if a.BaseBlob != nil && a.BaseBlob.Delete != nil {
+				expiration = ptr.Deref(a.BaseBlob.Delete.DaysAfterModificationGreaterThan, 0)
+			}
+			if a.Version != nil && a.Version.Delete != nil {
+				noncurrentExpiration = ptr.Deref(a.Version.Delete.DaysAfterCreationGreaterThan, 0)
+			}
+		}
+	}
+	return fmt.Sprintf("%s|%v|%s|%v|%v", ptr.Deref(r.Name, ""), ptr.Deref(r.Enabled, false), strings.Join(prefixes, ","), expiration, noncurrentExpiration)
+}
+
+func areEqualManagementPolicyRules(a, b []*armstorage.ManagementPolicyRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keysA := make([]string, 0, len(a))
+	for _, r := range a {
+		keysA = append(keysA, managementPolicyRuleKey(r))
+	}
+	keysB := make([]string, 0, len(b))
+	for _, r := range b {
+		keysB = append(keysB, managementPolicyRuleKey(r))
+	}
+	slices.Sort(keysA)
+	slices.Sort(keysB)
+	return slices.Equal(keysA, keysB)
+}
+
+func updateStatusWithCloudProviderError(ctx context.Context, state *State, message, logMessage string) (error, context.Context) {
+	bucket := state.ObjAsBucket()
+	bucket.Status.State = cloudcontrolv1beta1.StateError
+	return composed.UpdateStatus(bucket).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: message,
+		}).
+		ErrorLogMessage(logMessage).
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+		Run(ctx, state)
+}
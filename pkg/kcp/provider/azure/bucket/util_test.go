@@ -0,0 +1,29 @@
+package bucket
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAzureStorageAccountName(t *testing.T) {
+	assert.Equal(t, "cm0a1e1caa1d2c4eba848e9b", GetAzureStorageAccountName("0a1e1caa-1d2c-4eba-848e-9bdb3ef1535c"))
+	assert.Equal(t, "cmshort", GetAzureStorageAccountName("Short"))
+}
+
+func TestAreEqualManagementPolicyRules(t *testing.T) {
+	lifecycle := []cloudcontrolv1beta1.BucketLifecycleRule{
+		{Prefix: "logs/", ExpirationDays: 30},
+		{NoncurrentVersionExpirationDays: 7},
+	}
+	a := getManagementPolicyRules("cm-x", lifecycle)
+	b := getManagementPolicyRules("cm-x", lifecycle)
+	assert.True(t, areEqualManagementPolicyRules(a, []*armstorage.ManagementPolicyRule{b[1], b[0]}))
+
+	lifecycle[0].ExpirationDays = 31
+	assert.False(t, areEqualManagementPolicyRules(a, getManagementPolicyRules("cm-x", lifecycle)))
+	assert.False(t, areEqualManagementPolicyRules(a, nil))
+}
@@ -0,0 +1,21 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func waitPrivateDnsZoneGroupDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.privateDnsZoneGroup == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Azure Private DnsZone Group is still being deleted, requeueing with delay")
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
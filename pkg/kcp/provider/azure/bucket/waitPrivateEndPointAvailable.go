@@ -0,0 +1,24 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func waitPrivateEndPointAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	provisioningState := ptr.Deref(state.privateEndPoint.Properties.ProvisioningState, "")
+	if provisioningState == armnetwork.ProvisioningStateSucceeded {
+		return nil, ctx
+	}
+
+	logger.Info("Azure Private EndPoint is not ready yet, requeuing with delay", "provisioningState", provisioningState)
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
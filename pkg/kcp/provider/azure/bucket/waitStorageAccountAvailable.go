@@ -0,0 +1,22 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func waitStorageAccountAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.GetStorageAccountProvisioningState() == armstorage.ProvisioningStateSucceeded {
+		return nil, ctx
+	}
+
+	logger.Info("Azure storage account is not ready yet, requeuing with delay", "provisioningState", state.GetStorageAccountProvisioningState())
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
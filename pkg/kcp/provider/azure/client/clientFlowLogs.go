@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+)
+
+type FlowLogsClient interface {
+	GetFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) (*armnetwork.FlowLog, error)
+	CreateOrUpdateFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string, parameters armnetwork.FlowLog) error
+	DeleteFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) error
+}
+
+func NewFlowLogsClient(svc *armnetwork.FlowLogsClient) FlowLogsClient {
+	return &flowLogsClient{svc: svc}
+}
+
+var _ FlowLogsClient = &flowLogsClient{}
+
+type flowLogsClient struct {
+	svc *armnetwork.FlowLogsClient
+}
+
+func (c *flowLogsClient) GetFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) (*armnetwork.FlowLog, error) {
+	resp, err := c.svc.Get(ctx, resourceGroupName, networkWatcherName, flowLogName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.FlowLog, nil
+}
+
+func (c *flowLogsClient) CreateOrUpdateFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string, parameters armnetwork.FlowLog) error {
+	_, err := c.svc.BeginCreateOrUpdate(ctx, resourceGroupName, networkWatcherName, flowLogName, parameters, nil)
+	return err
+}
+
+func (c *flowLogsClient) DeleteFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) error {
+	_, err := c.svc.BeginDelete(ctx, resourceGroupName, networkWatcherName, flowLogName, nil)
+	return err
+}
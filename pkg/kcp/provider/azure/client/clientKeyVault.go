@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+)
+
+type KeyVaultClient interface {
+	GetKeyVault(ctx context.Context, resourceGroupName, vaultName string) (*armkeyvault.Vault, error)
+	// CreateKeyVault creates the standard sku vault with RBAC authorization, soft delete and purge protection enabled
+	CreateKeyVault(ctx context.Context, resourceGroupName, vaultName, location, tenantId string, tags map[string]string) error
+	// DeleteKeyVault soft deletes the vault with its keys, they are purged after the retention period
+	DeleteKeyVault(ctx context.Context, resourceGroupName, vaultName string) error
+
+	GetKeyVaultKey(ctx context.Context, resourceGroupName, vaultName, keyName string) (*armkeyvault.Key, error)
+	CreateKeyVaultKey(ctx context.Context, resourceGroupName, vaultName, keyName string, properties armkeyvault.KeyProperties) (*armkeyvault.Key, error)
+}
+
+func NewKeyVaultClient(vaultsSvc *armkeyvault.VaultsClient, keysSvc *armkeyvault.KeysClient) KeyVaultClient {
+	return &keyVaultClient{
+		vaultsSvc: vaultsSvc,
+		keysSvc:   keysSvc,
+	}
+}
+
+var _ KeyVaultClient = &keyVaultClient{}
+
+type keyVaultClient struct {
+	vaultsSvc *armkeyvault.VaultsClient
+	keysSvc   *armkeyvault.KeysClient
+}
+
+func (c *keyVaultClient) GetKeyVault(ctx context.Context, resourceGroupName, vaultName string) (*armkeyvault.Vault, error) {
+	resp, err := c.vaultsSvc.Get(ctx, resourceGroupName, vaultName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Vault, nil
+}
+
+func (c *keyVaultClient) CreateKeyVault(ctx context.Context, resourceGroupName, vaultName, location, tenantId string, tags map[string]string) error {
+	var azureTags map[string]*string
+	if tags != nil {
+		azureTags = make(map[string]*string, len(tags))
+		for k, v := range tags {
+			azureTags[k] = new(v)
+		}
+	}
+	_, err := c.vaultsSvc.BeginCreateOrUpdate(ctx, resourceGroupName, vaultName, armkeyvault.VaultCreateOrUpdateParameters{
+		Location: new(location),
+		Tags:     azureTags,
+		Properties: &armkeyvault.VaultProperties{
+			SKU: &armkeyvault.SKU{
+				Family: new(armkeyvault.SKUFamilyA),
+				Name:   new(armkeyvault.SKUNameStandard),
+			},
+			TenantID:                new(tenantId),
+			EnableRbacAuthorization: new(true),
+			EnableSoftDelete:        new(true),
+			EnablePurgeProtection:   new(true),
+		},
+	}, nil)
+	return err
+}
+
+func (c *keyVaultClient) DeleteKeyVault(ctx context.Context, resourceGroupName, vaultName string) error {
+	_, err := c.vaultsSvc.Delete(ctx, resourceGroupName, vaultName, nil)
+	return err
+}
+
+func (c *keyVaultClient) GetKeyVaultKey(ctx context.Context, resourceGroupName, vaultName, keyName string) (*armkeyvault.Key, error) {
+	resp, err := c.keysSvc.Get(ctx, resourceGroupName, vaultName, keyName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Key, nil
+}
+
+func (c *keyVaultClient) CreateKeyVaultKey(ctx context.Context, resourceGroupName, vaultName, keyName string, properties armkeyvault.KeyProperties) (*armkeyvault.Key, error) {
+	resp, err := c.keysSvc.CreateIfNotExist(ctx, resourceGroupName, vaultName, keyName, armkeyvault.KeyCreateParameters{
+		Properties: &properties,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Key, nil
+}
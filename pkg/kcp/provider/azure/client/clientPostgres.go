@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+)
+
+type PostgresClient interface {
+	CreatePostgresServer(ctx context.Context, resourceGroupName, serverName string, parameters armpostgresqlflexibleservers.Server) error
+	UpdatePostgresServer(ctx context.Context, resourceGroupName, serverName string, parameters armpostgresqlflexibleservers.ServerForUpdate) error
+	GetPostgresServer(ctx context.Context, resourceGroupName, serverName string) (*armpostgresqlflexibleservers.Server, error)
+	DeletePostgresServer(ctx context.Context, resourceGroupName, serverName string) error
+
+	CreatePostgresDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error
+	GetPostgresDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (*armpostgresqlflexibleservers.Database, error)
+}
+
+func NewPostgresClient(serversSvc *armpostgresqlflexibleservers.ServersClient, databasesSvc *armpostgresqlflexibleservers.DatabasesClient) PostgresClient {
+	return &postgresClient{
+		serversSvc:   serversSvc,
+		databasesSvc: databasesSvc,
+	}
+}
+
+var _ PostgresClient = &postgresClient{}
+
+type postgresClient struct {
+	serversSvc   *armpostgresqlflexibleservers.ServersClient
+	databasesSvc *armpostgresqlflexibleservers.DatabasesClient
+}
+
+func (c *postgresClient) CreatePostgresServer(ctx context.Context, resourceGroupName, serverName string, parameters armpostgresqlflexibleservers.Server) error {
+	_, err := c.serversSvc.BeginCreate(
+		ctx,
+		resourceGroupName,
+		serverName,
+		parameters,
+		nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *postgresClient) UpdatePostgresServer(ctx context.Context, resourceGroupName, serverName string, parameters armpostgresqlflexibleservers.ServerForUpdate) error {
+	_, err := c.serversSvc.BeginUpdate(
+		ctx,
+		resourceGroupName,
+		serverName,
+		parameters,
+		nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *postgresClient) GetPostgresServer(ctx context.Context, resourceGroupName, serverName string) (*armpostgresqlflexibleservers.Server, error) {
+	serversClientGetResponse, err := c.serversSvc.Get(ctx, resourceGroupName, serverName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &serversClientGetResponse.Server, nil
+}
+
+func (c *postgresClient) DeletePostgresServer(ctx context.Context, resourceGroupName, serverName string) error {
+	_, err := c.serversSvc.BeginDelete(ctx, resourceGroupName, serverName, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *postgresClient) CreatePostgresDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error {
+	_, err := c.databasesSvc.BeginCreate(
+		ctx,
+		resourceGroupName,
+		serverName,
+		databaseName,
+		armpostgresqlflexibleservers.Database{
+			Properties: &armpostgresqlflexibleservers.DatabaseProperties{
+				Charset:   new("UTF8"),
+				Collation: new("en_US.utf8"),
+			},
+		},
+		nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *postgresClient) GetPostgresDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (*armpostgresqlflexibleservers.Database, error) {
+	databasesClientGetResponse, err := c.databasesSvc.Get(ctx, resourceGroupName, serverName, databaseName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &databasesClientGetResponse.Database, nil
+}
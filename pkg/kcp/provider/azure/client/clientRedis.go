@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
 	"k8s.io/utils/ptr"
 )
@@ -10,8 +11,21 @@ type RedisClient interface {
 	CreateRedisInstance(ctx context.Context, resourceGroupName, redisInstanceName string, parameters armredis.CreateParameters) error
 	UpdateRedisInstance(ctx context.Context, resourceGroupName, redisInstanceName string, parameters armredis.UpdateParameters) error
 	GetRedisInstance(ctx context.Context, resourceGroupName, redisInstanceName string) (*armredis.ResourceInfo, error)
+	ListRedisInstances(ctx context.Context, resourceGroupName string) ([]*armredis.ResourceInfo, error)
 	DeleteRedisInstance(ctx context.Context, resourceGroupName, redisInstanceName string) error
 	GetRedisInstanceAccessKeys(ctx context.Context, resourceGroupName, redisInstanceName string) ([]string, error)
+	// ExportRedisInstanceData starts the RDB export into the blob container given by its SAS url and
+	// returns the resume token of the started operation
+	ExportRedisInstanceData(ctx context.Context, resourceGroupName, redisInstanceName, containerSasUrl, prefix string) (string, error)
+	// GetExportRedisInstanceDataStatus returns true once the export operation with the given resume token
+	// is done, with a non-nil error if the export failed
+	GetExportRedisInstanceDataStatus(ctx context.Context, resourceGroupName, redisInstanceName, resumeToken string) (bool, error)
+	// ImportRedisInstanceData starts the RDB import of the given blob SAS urls and returns the resume token
+	// of the started operation
+	ImportRedisInstanceData(ctx context.Context, resourceGroupName, redisInstanceName string, files []string) (string, error)
+	// GetImportRedisInstanceDataStatus returns true once the import operation with the given resume token
+	// is done, with a non-nil error if the import failed
+	GetImportRedisInstanceDataStatus(ctx context.Context, resourceGroupName, redisInstanceName, resumeToken string) (bool, error)
 }
 
 func NewRedisClient(svc *armredis.Client) RedisClient {
@@ -46,6 +60,23 @@ func (c *redisClient) GetRedisInstance(ctx context.Context, resourceGroupName, r
 	return &clientGetResponse.ResourceInfo, nil
 }
 
+func (c *redisClient) ListRedisInstances(ctx context.Context, resourceGroupName string) ([]*armredis.ResourceInfo, error) {
+	pager := c.svc.NewListByResourceGroupPager(resourceGroupName, nil)
+
+	var items []*armredis.ResourceInfo
+
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Value...)
+	}
+
+	return items, nil
+}
+
 func (c *redisClient) DeleteRedisInstance(ctx context.Context, resourceGroupName, redisInstanceName string) error {
 	_, err := c.svc.BeginDelete(ctx, resourceGroupName, redisInstanceName, nil)
 	if err != nil {
@@ -77,3 +108,80 @@ func (c *redisClient) UpdateRedisInstance(ctx context.Context, resourceGroupName
 
 	return nil
 }
+
+func (c *redisClient) ExportRedisInstanceData(ctx context.Context, resourceGroupName, redisInstanceName, containerSasUrl, prefix string) (string, error) {
+	poller, err := c.svc.BeginExportData(
+		ctx,
+		resourceGroupName,
+		redisInstanceName,
+		armredis.ExportRDBParameters{
+			Container: new(containerSasUrl),
+			Prefix:    new(prefix),
+		},
+		nil)
+	if err != nil {
+		return "", err
+	}
+
+	return poller.ResumeToken()
+}
+
+func (c *redisClient) GetExportRedisInstanceDataStatus(ctx context.Context, resourceGroupName, redisInstanceName, resumeToken string) (bool, error) {
+	poller, err := c.svc.BeginExportData(
+		ctx,
+		resourceGroupName,
+		redisInstanceName,
+		armredis.ExportRDBParameters{},
+		&armredis.ClientBeginExportDataOptions{ResumeToken: resumeToken})
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := poller.Poll(ctx); err != nil {
+		return false, err
+	}
+	if !poller.Done() {
+		return false, nil
+	}
+
+	_, err = poller.Result(ctx)
+	return true, err
+}
+
+func (c *redisClient) ImportRedisInstanceData(ctx context.Context, resourceGroupName, redisInstanceName string, files []string) (string, error) {
+	poller, err := c.svc.BeginImportData(
+		ctx,
+		resourceGroupName,
+		redisInstanceName,
+		armredis.ImportRDBParameters{
+			Files: to.SliceOfPtrs(files...),
+		},
+		nil)
+	if err != nil {
+		return "", err
+	}
+
+	return poller.ResumeToken()
+}
+
+func (c *redisClient) GetImportRedisInstanceDataStatus(ctx context.Context, resourceGroupName, redisInstanceName, resumeToken string) (bool, error) {
+	poller, err := c.svc.BeginImportData(
+		ctx,
+		resourceGroupName,
+		redisInstanceName,
+		armredis.ImportRDBParameters{},
+		&armredis.ClientBeginImportDataOptions{ResumeToken: resumeToken})
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := poller.Poll(ctx); err != nil {
+		return false, err
+	}
+	if !poller.Done() {
+		return false, nil
+	}
+
+	_, err = poller.Result(ctx)
+	return true, err
+}
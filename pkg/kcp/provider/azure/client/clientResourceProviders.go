@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"k8s.io/utils/ptr"
+)
+
+type ResourceProvidersClient interface {
+	// GetResourceTypeLocations returns the display names of the locations where the resource type
+	// of the provider namespace is available, e.g. Redis of Microsoft.Cache
+	GetResourceTypeLocations(ctx context.Context, providerNamespace, resourceType string) ([]string, error)
+}
+
+func NewResourceProvidersClient(svc *armresources.ProvidersClient) ResourceProvidersClient {
+	return &resourceProvidersClient{svc: svc}
+}
+
+var _ ResourceProvidersClient = &resourceProvidersClient{}
+
+type resourceProvidersClient struct {
+	svc *armresources.ProvidersClient
+}
+
+func (c *resourceProvidersClient) GetResourceTypeLocations(ctx context.Context, providerNamespace, resourceType string) ([]string, error) {
+	resp, err := c.svc.Get(ctx, providerNamespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range resp.ResourceTypes {
+		if rt == nil || ptr.Deref(rt.ResourceType, "") != resourceType {
+			continue
+		}
+		var result []string
+		for _, loc := range rt.Locations {
+			if loc != nil {
+				result = append(result, *loc)
+			}
+		}
+		return result, nil
+	}
+	return nil, nil
+}
@@ -11,14 +11,28 @@ type SecurityGroupsClient interface {
 	DeleteSecurityGroup(ctx context.Context, resourceGroupName, networkSecurityGroupName string) error
 }
 
+// SecurityRulesClient manages single rules of a security group that is otherwise owned by someone else
+type SecurityRulesClient interface {
+	CreateOrUpdateSecurityRule(ctx context.Context, resourceGroupName, networkSecurityGroupName, securityRuleName string, securityRule armnetwork.SecurityRule) error
+	DeleteSecurityRule(ctx context.Context, resourceGroupName, networkSecurityGroupName, securityRuleName string) error
+}
+
 func NewSecurityGroupsClient(svc *armnetwork.SecurityGroupsClient) SecurityGroupsClient {
 	return &securityGroupsClient{svc: svc}
 }
 
+func NewSecurityRulesClient(svc *armnetwork.SecurityRulesClient) SecurityRulesClient {
+	return &securityRulesClient{svc: svc}
+}
+
 type securityGroupsClient struct {
 	svc *armnetwork.SecurityGroupsClient
 }
 
+type securityRulesClient struct {
+	svc *armnetwork.SecurityRulesClient
+}
+
 func (c *securityGroupsClient) GetSecurityGroup(ctx context.Context, resourceGroupName, networkSecurityGroupName string) (*armnetwork.SecurityGroup, error) {
 	resp, err := c.svc.Get(ctx, resourceGroupName, networkSecurityGroupName, nil)
 	if err != nil {
@@ -52,3 +66,13 @@ func (c *securityGroupsClient) DeleteSecurityGroup(ctx context.Context, resource
 	_, err := c.svc.BeginDelete(ctx, resourceGroupName, networkSecurityGroupName, nil)
 	return err
 }
+
+func (c *securityRulesClient) CreateOrUpdateSecurityRule(ctx context.Context, resourceGroupName, networkSecurityGroupName, securityRuleName string, securityRule armnetwork.SecurityRule) error {
+	_, err := c.svc.BeginCreateOrUpdate(ctx, resourceGroupName, networkSecurityGroupName, securityRuleName, securityRule, nil)
+	return err
+}
+
+func (c *securityRulesClient) DeleteSecurityRule(ctx context.Context, resourceGroupName, networkSecurityGroupName, securityRuleName string) error {
+	_, err := c.svc.BeginDelete(ctx, resourceGroupName, networkSecurityGroupName, securityRuleName, nil)
+	return err
+}
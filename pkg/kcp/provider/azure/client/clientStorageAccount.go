@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"k8s.io/utils/ptr"
+)
+
+type StorageAccountClient interface {
+	CreateStorageAccount(ctx context.Context, resourceGroupName, accountName string, parameters armstorage.AccountCreateParameters) error
+	GetStorageAccount(ctx context.Context, resourceGroupName, accountName string) (*armstorage.Account, error)
+	DeleteStorageAccount(ctx context.Context, resourceGroupName, accountName string) error
+	// GetStorageAccountKey returns the value of the first storage account key
+	GetStorageAccountKey(ctx context.Context, resourceGroupName, accountName string) (string, error)
+
+	CreateBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) error
+	GetBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) (*armstorage.BlobContainer, error)
+	DeleteBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) error
+	// GetBlobContainerSasUrl returns the container url with a read-write service SAS token valid until the given expiry
+	GetBlobContainerSasUrl(ctx context.Context, resourceGroupName, accountName, containerName string, expiry time.Time) (string, error)
+
+	GetBlobVersioning(ctx context.Context, resourceGroupName, accountName string) (bool, error)
+	SetBlobVersioning(ctx context.Context, resourceGroupName, accountName string, enabled bool) error
+
+	// GetBlobManagementPolicyRules returns nil if the storage account has no management policy
+	GetBlobManagementPolicyRules(ctx context.Context, resourceGroupName, accountName string) ([]*armstorage.ManagementPolicyRule, error)
+	// SetBlobManagementPolicyRules replaces the storage account management policy, or deletes it if rules are empty
+	SetBlobManagementPolicyRules(ctx context.Context, resourceGroupName, accountName string, rules []*armstorage.ManagementPolicyRule) error
+
+	// GetBlobImmutabilityPolicy returns nil if the container has no immutability policy
+	GetBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName string) (*armstorage.ImmutabilityPolicy, error)
+	// SetBlobImmutabilityPolicy creates or updates the unlocked time-based immutability policy of the container
+	SetBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName string, days int32) error
+	DeleteBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName, etag string) error
+}
+
+func NewStorageAccountClient(
+	accountsSvc *armstorage.AccountsClient,
+	blobContainersSvc *armstorage.BlobContainersClient,
+	blobServicesSvc *armstorage.BlobServicesClient,
+	managementPoliciesSvc *armstorage.ManagementPoliciesClient,
+) StorageAccountClient {
+	return &storageAccountClient{
+		accountsSvc:           accountsSvc,
+		blobContainersSvc:     blobContainersSvc,
+		blobServicesSvc:       blobServicesSvc,
+		managementPoliciesSvc: managementPoliciesSvc,
+	}
+}
+
+var _ StorageAccountClient = &storageAccountClient{}
+
+type storageAccountClient struct {
+	accountsSvc           *armstorage.AccountsClient
+	blobContainersSvc     *armstorage.BlobContainersClient
+	blobServicesSvc       *armstorage.BlobServicesClient
+	managementPoliciesSvc *armstorage.ManagementPoliciesClient
+}
+
+func (c *storageAccountClient) CreateStorageAccount(ctx context.Context, resourceGroupName, accountName string, parameters armstorage.AccountCreateParameters) error {
+	_, err := c.accountsSvc.BeginCreate(ctx, resourceGroupName, accountName, parameters, nil)
+	return err
+}
+
+func (c *storageAccountClient) GetStorageAccount(ctx context.Context, resourceGroupName, accountName string) (*armstorage.Account, error) {
+	resp, err := c.accountsSvc.GetProperties(ctx, resourceGroupName, accountName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Account, nil
+}
+
+func (c *storageAccountClient) DeleteStorageAccount(ctx context.Context, resourceGroupName, accountName string) error {
+	_, err := c.accountsSvc.Delete(ctx, resourceGroupName, accountName, nil)
+	return err
+}
+
+func (c *storageAccountClient) GetStorageAccountKey(ctx context.Context, resourceGroupName, accountName string) (string, error) {
+	resp, err := c.accountsSvc.ListKeys(ctx, resourceGroupName, accountName, nil)
+	if err != nil {
+		return "", err
+	}
+	for _, k := range resp.Keys {
+		if k != nil && k.Value != nil {
+			return *k.Value, nil
+		}
+	}
+	return "", nil
+}
+
+func (c *storageAccountClient) CreateBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) error {
+	_, err := c.blobContainersSvc.Create(ctx, resourceGroupName, accountName, containerName, armstorage.BlobContainer{
+		ContainerProperties: &armstorage.ContainerProperties{
+			PublicAccess: ptr.To(armstorage.PublicAccessNone),
+		},
+	}, nil)
+	return err
+}
+
+func (c *storageAccountClient) GetBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) (*armstorage.BlobContainer, error) {
+	resp, err := c.blobContainersSvc.Get(ctx, resourceGroupName, accountName, containerName, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.BlobContainer, nil
+}
+
+func (c *storageAccountClient) DeleteBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) error {
+	_, err := c.blobContainersSvc.Delete(ctx, resourceGroupName, accountName, containerName, nil)
+	return err
+}
+
+func (c *storageAccountClient) GetBlobContainerSasUrl(ctx context.Context, resourceGroupName, accountName, containerName string, expiry time.Time) (string, error) {
+	account, err := c.GetStorageAccount(ctx, resourceGroupName, accountName)
+	if err != nil {
+		return "", err
+	}
+	if account.Properties == nil || account.Properties.PrimaryEndpoints == nil || account.Properties.PrimaryEndpoints.Blob == nil {
+		return "", fmt.Errorf("storage account %s has no blob endpoint", accountName)
+	}
+
+	resp, err := c.accountsSvc.ListServiceSAS(ctx, resourceGroupName, accountName, armstorage.ServiceSasParameters{
+		CanonicalizedResource:  new(fmt.Sprintf("/blob/%s/%s", accountName, containerName)),
+		Resource:               ptr.To(armstorage.SignedResourceC),
+		Permissions:            ptr.To(armstorage.Permissions("rwdl")),
+		Protocols:              ptr.To(armstorage.HTTPProtocolHTTPS),
+		SharedAccessExpiryTime: new(expiry),
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s?%s", strings.TrimSuffix(*account.Properties.PrimaryEndpoints.Blob, "/"), containerName, ptr.Deref(resp.ServiceSasToken, "")), nil
+}
+
+func (c *storageAccountClient) GetBlobVersioning(ctx context.Context, resourceGroupName, accountName string) (bool, error) {
+	resp, err := c.blobServicesSvc.GetServiceProperties(ctx, resourceGroupName, accountName, nil)
+	if err != nil {
+		return false, err
+	}
+	if resp.BlobServiceProperties.BlobServiceProperties == nil {
+		return false, nil
+	}
+	return ptr.Deref(resp.BlobServiceProperties.BlobServiceProperties.IsVersioningEnabled, false), nil
+}
+
+func (c *storageAccountClient) SetBlobVersioning(ctx context.Context, resourceGroupName, accountName string, enabled bool) error {
+	_, err := c.blobServicesSvc.SetServiceProperties(ctx, resourceGroupName, accountName, armstorage.BlobServiceProperties{
+		BlobServiceProperties: &armstorage.BlobServicePropertiesProperties{
+			IsVersioningEnabled: new(enabled),
+		},
+	}, nil)
+	return err
+}
+
+func (c *storageAccountClient) GetBlobManagementPolicyRules(ctx context.Context, resourceGroupName, accountName string) ([]*armstorage.ManagementPolicyRule, error) {
+	resp, err := c.managementPoliciesSvc.Get(ctx, resourceGroupName, accountName, armstorage.ManagementPolicyNameDefault, nil)
+	if azuremeta.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.Properties == nil || resp.Properties.Policy == nil {
+		return nil, nil
+	}
+	return resp.Properties.Policy.Rules, nil
+}
+
+func (c *storageAccountClient) SetBlobManagementPolicyRules(ctx context.Context, resourceGroupName, accountName string, rules []*armstorage.ManagementPolicyRule) error {
+	if len(rules) == 0 {
+		_, err := c.managementPoliciesSvc.Delete(ctx, resourceGroupName, accountName, armstorage.ManagementPolicyNameDefault, nil)
+		return azuremeta.IgnoreNotFoundError(err)
+	}
+	_, err := c.managementPoliciesSvc.CreateOrUpdate(ctx, resourceGroupName, accountName, armstorage.ManagementPolicyNameDefault, armstorage.ManagementPolicy{
+		Properties: &armstorage.ManagementPolicyProperties{
+			Policy: &armstorage.ManagementPolicySchema{
+				Rules: rules,
+			},
+		},
+	}, nil)
+	return err
+}
+
+func (c *storageAccountClient) GetBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName string) (*armstorage.ImmutabilityPolicy, error) {
+	resp, err := c.blobContainersSvc.GetImmutabilityPolicy(ctx, resourceGroupName, accountName, containerName, nil)
+	if azuremeta.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// a container without the policy returns an empty deleted policy
+	if resp.Properties == nil || ptr.Deref(resp.Properties.State, "") == "" || ptr.Deref(resp.Properties.ImmutabilityPeriodSinceCreationInDays, 0) == 0 {
+		return nil, nil
+	}
+	return &resp.ImmutabilityPolicy, nil
+}
+
+func (c *storageAccountClient) SetBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName string, days int32) error {
+	_, err := c.blobContainersSvc.CreateOrUpdateImmutabilityPolicy(ctx, resourceGroupName, accountName, containerName, &armstorage.BlobContainersClientCreateOrUpdateImmutabilityPolicyOptions{
+		Parameters: &armstorage.ImmutabilityPolicy{
+			Properties: &armstorage.ImmutabilityPolicyProperty{
+				ImmutabilityPeriodSinceCreationInDays: new(days),
+				AllowProtectedAppendWrites:            new(false),
+			},
+		},
+	})
+	return err
+}
+
+func (c *storageAccountClient) DeleteBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName, etag string) error {
+	_, err := c.blobContainersSvc.DeleteImmutabilityPolicy(ctx, resourceGroupName, accountName, containerName, etag, nil)
+	return err
+}
@@ -0,0 +1,49 @@
+package exposedData
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// capabilitiesLoad discovers if Azure Cache for Redis is available in the region. Azure does not list the
+// Redis SKUs per region, so tiers are taken from the static catalog, where regions with fewer SKUs can be
+// restricted. On error the previously discovered capabilities are kept.
+func capabilitiesLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	locations, err := state.azureClient.GetResourceTypeLocations(ctx, "Microsoft.Cache", "Redis")
+	if err != nil {
+		logger.Error(err, "Error loading Azure Microsoft.Cache locations for region capabilities")
+		return nil, ctx
+	}
+	if len(locations) == 0 {
+		return nil, ctx
+	}
+
+	// provider locations are display names like "West Europe", while the scope region is "westeurope"
+	for i, loc := range locations {
+		locations[i] = strings.ToLower(strings.ReplaceAll(loc, " ", ""))
+	}
+
+	services := map[string]bool{
+		cloudcontrolv1beta1.CapabilityServiceRedis: slices.Contains(locations, state.ObjAsScope().Spec.Region),
+	}
+
+	state.ObjAsScope().Status.Capabilities = &cloudcontrolv1beta1.RegionCapabilities{
+		Services: services,
+		ReadTime: new(metav1.Now()),
+	}
+
+	logger.
+		WithValues("services", fmt.Sprintf("%v", services)).
+		Info("Azure region capabilities")
+
+	return nil, ctx
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 )
 
@@ -12,6 +13,7 @@ type Client interface {
 	azureclient.SubnetsClient
 	azureclient.NatGatewayClient
 	azureclient.PublicIPAddressesClient
+	azureclient.ResourceProvidersClient
 }
 
 func NewClientProvider() azureclient.ClientProvider[Client] {
@@ -26,11 +28,17 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 			return nil, err
 		}
 
+		resourcesClientFactory, err := armresources.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
 		return newClient(
 			azureclient.NewNetworkClient(networkClientFactory.NewVirtualNetworksClient()),
 			azureclient.NewSubnetsClient(networkClientFactory.NewSubnetsClient()),
 			azureclient.NewNatGatewayClient(networkClientFactory.NewNatGatewaysClient()),
 			azureclient.NewPublicIPAddressesClient(networkClientFactory.NewPublicIPAddressesClient()),
+			azureclient.NewResourceProvidersClient(resourcesClientFactory.NewProvidersClient()),
 		), nil
 	}
 }
@@ -40,12 +48,14 @@ func newClient(
 	subnetsClient azureclient.SubnetsClient,
 	natGatewayClient azureclient.NatGatewayClient,
 	publicIpAddressClient azureclient.PublicIPAddressesClient,
+	resourceProvidersClient azureclient.ResourceProvidersClient,
 ) *client {
 	return &client{
 		NetworkClient:           networkClient,
 		NatGatewayClient:        natGatewayClient,
 		SubnetsClient:           subnetsClient,
 		PublicIPAddressesClient: publicIpAddressClient,
+		ResourceProvidersClient: resourceProvidersClient,
 	}
 }
 
@@ -54,4 +64,5 @@ type client struct {
 	azureclient.SubnetsClient
 	azureclient.NatGatewayClient
 	azureclient.PublicIPAddressesClient
+	azureclient.ResourceProvidersClient
 }
@@ -3,7 +3,9 @@ package exposedData
 import (
 	"context"
 	"fmt"
+
 	"github.com/elliotchance/pie/v2"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"k8s.io/utils/ptr"
 )
@@ -19,12 +21,29 @@ func exposedDataSetToScope(ctx context.Context, st composed.State) (error, conte
 		}
 	}
 
-	state.ObjAsScope().Status.ExposedData.NatGatewayIps = pie.Sort(pie.Unique(list))
+	exposedData := &state.ObjAsScope().Status.ExposedData
+	network := state.ObjAsScope().Spec.Scope.Azure.Network
+
+	exposedData.NatGatewayIps = pie.Sort(pie.Unique(list))
+	exposedData.Region = state.ObjAsScope().Spec.Region
+	exposedData.Zones = pie.Sort(pie.Unique(pie.Map(network.Zones, func(z cloudcontrolv1beta1.AzureNetworkZone) string {
+		return z.Name
+	})))
+	exposedData.NodesCidr = network.Nodes
+	exposedData.PodsCidr = network.Pods
+	exposedData.ServicesCidr = network.Services
+	exposedData.NetworkId = ""
+	if state.vnet != nil {
+		exposedData.NetworkId = ptr.Deref(state.vnet.ID, "")
+	}
 
 	logger := composed.LoggerFromCtx(ctx)
 	logger.
-		WithValues("natGatewayIps", fmt.Sprintf("%v", state.ObjAsScope().Status.ExposedData.NatGatewayIps)).
-		Info("Exposed Data Azure Nat Gateway IP addresses")
+		WithValues(
+			"natGatewayIps", fmt.Sprintf("%v", exposedData.NatGatewayIps),
+			"networkId", exposedData.NetworkId,
+		).
+		Info("Exposed Data Azure network")
 
 	return nil, ctx
 }
@@ -0,0 +1,24 @@
+package exposedData
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	scopetypes "github.com/kyma-project/cloud-manager/pkg/kcp/scope/types"
+)
+
+// NewCapabilities discovers the region capabilities into the Scope status. It does not depend on
+// the exposed data flow, so the capabilities are discovered also when exposing data is disabled.
+func NewCapabilities(sf StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		scopeState := st.(scopetypes.State)
+
+		state, err := sf.NewState(ctx, scopeState)
+		if err != nil {
+			composed.LoggerFromCtx(ctx).Error(err, "Error creating Azure client for region capabilities")
+			return nil, ctx
+		}
+
+		return capabilitiesLoad(ctx, state)
+	}
+}
@@ -0,0 +1,410 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/google/uuid"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+var _ StorageAccountClient = &blobStorageStore{}
+var _ StorageAccountConfig = &blobStorageStore{}
+
+var storageAccountNameRegex = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+
+func newBlobStorageStore(subscription string) *blobStorageStore {
+	return &blobStorageStore{
+		subscription: subscription,
+		items:        map[string]map[string]*storageAccountInfo{},
+	}
+}
+
+type storageAccountInfo struct {
+	account            *armstorage.Account
+	key                string
+	versioning         bool
+	managementPolicy   []*armstorage.ManagementPolicyRule
+	containers         map[string]*armstorage.BlobContainer
+	immutabilityPolicy map[string]*armstorage.ImmutabilityPolicy
+}
+
+type blobStorageStore struct {
+	m sync.Mutex
+
+	subscription string
+
+	// items is a map of resourceGroup => accountName => *storageAccountInfo
+	items map[string]map[string]*storageAccountInfo
+}
+
+// Config =================================================================================================
+
+func (s *blobStorageStore) AzureSetStorageAccountProvisioningState(ctx context.Context, resourceGroupName, accountName string, state armstorage.ProvisioningState) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+
+	info.account.Properties.ProvisioningState = new(state)
+
+	return nil
+}
+
+// StorageAccountClient ===================================================================================
+
+func (s *blobStorageStore) CreateStorageAccount(ctx context.Context, resourceGroupName, accountName string, parameters armstorage.AccountCreateParameters) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if !storageAccountNameRegex.MatchString(accountName) {
+		return fmt.Errorf("storage account name %s is invalid, it must be between 3 and 24 characters in length and use numbers and lower-case letters only", accountName)
+	}
+
+	_, ok := s.items[resourceGroupName]
+	if !ok {
+		s.items[resourceGroupName] = map[string]*storageAccountInfo{}
+	}
+	_, ok = s.items[resourceGroupName][accountName]
+	if ok {
+		return fmt.Errorf("storage account %s already exist", azureutil.NewStorageAccountResourceId(s.subscription, resourceGroupName, accountName).String())
+	}
+
+	props := &armstorage.AccountProperties{}
+	if parameters.Properties != nil {
+		err := util.JsonCloneInto(parameters.Properties, props)
+		if err != nil {
+			return err
+		}
+	}
+	props.ProvisioningState = ptr.To(armstorage.ProvisioningStateSucceeded)
+	props.PrimaryEndpoints = &armstorage.Endpoints{
+		Blob: new(fmt.Sprintf("https://%s.blob.core.windows.net/", accountName)),
+	}
+
+	s.items[resourceGroupName][accountName] = &storageAccountInfo{
+		account: &armstorage.Account{
+			ID:         new(azureutil.NewStorageAccountResourceId(s.subscription, resourceGroupName, accountName).String()),
+			Name:       new(accountName),
+			Kind:       parameters.Kind,
+			Location:   parameters.Location,
+			SKU:        parameters.SKU,
+			Tags:       parameters.Tags,
+			Properties: props,
+		},
+		key:                uuid.NewString(),
+		containers:         map[string]*armstorage.BlobContainer{},
+		immutabilityPolicy: map[string]*armstorage.ImmutabilityPolicy{},
+	}
+
+	return nil
+}
+
+func (s *blobStorageStore) GetStorageAccount(ctx context.Context, resourceGroupName, accountName string) (*armstorage.Account, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.JsonClone(info.account)
+}
+
+func (s *blobStorageStore) DeleteStorageAccount(ctx context.Context, resourceGroupName, accountName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	for containerName := range info.immutabilityPolicy {
+		return fmt.Errorf("storage account %s can not be deleted since container %s has an immutability policy", accountName, containerName)
+	}
+
+	delete(s.items[resourceGroupName], accountName)
+
+	return nil
+}
+
+func (s *blobStorageStore) GetStorageAccountKey(ctx context.Context, resourceGroupName, accountName string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return "", err
+	}
+
+	return info.key, nil
+}
+
+func (s *blobStorageStore) CreateBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	if _, ok := info.containers[containerName]; ok {
+		return fmt.Errorf("blob container %s already exist in storage account %s", containerName, accountName)
+	}
+
+	info.containers[containerName] = &armstorage.BlobContainer{
+		ID:   new(fmt.Sprintf("%s/blobServices/default/containers/%s", ptr.Deref(info.account.ID, ""), containerName)),
+		Name: new(containerName),
+		ContainerProperties: &armstorage.ContainerProperties{
+			PublicAccess: ptr.To(armstorage.PublicAccessNone),
+		},
+	}
+
+	return nil
+}
+
+func (s *blobStorageStore) GetBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) (*armstorage.BlobContainer, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	container, ok := info.containers[containerName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+
+	return util.JsonClone(container)
+}
+
+func (s *blobStorageStore) DeleteBlobContainer(ctx context.Context, resourceGroupName, accountName, containerName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	if _, ok := info.immutabilityPolicy[containerName]; ok {
+		return fmt.Errorf("blob container %s can not be deleted since it has an immutability policy", containerName)
+	}
+	delete(info.containers, containerName)
+
+	return nil
+}
+
+func (s *blobStorageStore) GetBlobContainerSasUrl(ctx context.Context, resourceGroupName, accountName, containerName string, expiry time.Time) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := info.containers[containerName]; !ok {
+		return "", azuremeta.NewAzureNotFoundError()
+	}
+
+	return fmt.Sprintf("%s%s?sp=rwdl&se=%s&sig=%s", ptr.Deref(info.account.Properties.PrimaryEndpoints.Blob, ""), containerName, expiry.UTC().Format(time.RFC3339), uuid.NewString()), nil
+}
+
+func (s *blobStorageStore) GetBlobVersioning(ctx context.Context, resourceGroupName, accountName string) (bool, error) {
+	if isContextCanceled(ctx) {
+		return false, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return false, err
+	}
+
+	return info.versioning, nil
+}
+
+func (s *blobStorageStore) SetBlobVersioning(ctx context.Context, resourceGroupName, accountName string, enabled bool) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	info.versioning = enabled
+
+	return nil
+}
+
+func (s *blobStorageStore) GetBlobManagementPolicyRules(ctx context.Context, resourceGroupName, accountName string) ([]*armstorage.ManagementPolicyRule, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.managementPolicy) == 0 {
+		return nil, nil
+	}
+
+	return util.JsonClone(info.managementPolicy)
+}
+
+func (s *blobStorageStore) SetBlobManagementPolicyRules(ctx context.Context, resourceGroupName, accountName string, rules []*armstorage.ManagementPolicyRule) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		info.managementPolicy = nil
+		return nil
+	}
+	for _, r := range rules {
+		if r.Definition != nil && r.Definition.Actions != nil && r.Definition.Actions.Version != nil && !info.versioning {
+			return fmt.Errorf("management policy rule %s has a version action, but versioning is not enabled on storage account %s", ptr.Deref(r.Name, ""), accountName)
+		}
+	}
+	info.managementPolicy, err = util.JsonClone(rules)
+
+	return err
+}
+
+func (s *blobStorageStore) GetBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName string) (*armstorage.ImmutabilityPolicy, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := info.containers[containerName]; !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	policy, ok := info.immutabilityPolicy[containerName]
+	if !ok {
+		return nil, nil
+	}
+
+	return util.JsonClone(policy)
+}
+
+func (s *blobStorageStore) SetBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName string, days int32) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	if _, ok := info.containers[containerName]; !ok {
+		return azuremeta.NewAzureNotFoundError()
+	}
+	if days < 1 {
+		return fmt.Errorf("immutability period must be at least 1 day")
+	}
+
+	info.immutabilityPolicy[containerName] = &armstorage.ImmutabilityPolicy{
+		Etag: new(uuid.NewString()),
+		Properties: &armstorage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: new(days),
+			AllowProtectedAppendWrites:            new(false),
+			State:                                 ptr.To(armstorage.ImmutabilityPolicyStateUnlocked),
+		},
+	}
+
+	return nil
+}
+
+func (s *blobStorageStore) DeleteBlobImmutabilityPolicy(ctx context.Context, resourceGroupName, accountName, containerName, etag string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getStorageAccountInfoNonLocking(resourceGroupName, accountName)
+	if err != nil {
+		return err
+	}
+	policy, ok := info.immutabilityPolicy[containerName]
+	if !ok {
+		return azuremeta.NewAzureNotFoundError()
+	}
+	if ptr.Deref(policy.Etag, "") != etag {
+		return fmt.Errorf("immutability policy etag %s does not match", etag)
+	}
+	delete(info.immutabilityPolicy, containerName)
+
+	return nil
+}
+
+func (s *blobStorageStore) getStorageAccountInfoNonLocking(resourceGroupName, accountName string) (*storageAccountInfo, error) {
+	group, ok := s.items[resourceGroupName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	info, ok := group[accountName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	return info, nil
+}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
@@ -24,40 +25,59 @@ type fileShareStore struct {
 	fileshares   map[string]*armstorage.FileShareItem
 }
 
-func (s *fileShareStore) CreateFileShare(ctx context.Context, id string) error {
-	s.m.Lock()
-	defer s.m.Unlock()
+// fileShareKey identifies a file share by its resource group, storage account and name,
+// so lookups do not depend on the secret namespace part of the volume handle
+func fileShareKey(resourceGroupName, storageAccountName, fileShareName string) string {
+	return fmt.Sprintf("%s/%s/%s", resourceGroupName, storageAccountName, fileShareName)
+}
 
-	_, _, fileShareName, _, _, err := client.ParsePvVolumeHandle(id)
+func (s *fileShareStore) CreateFileShare(ctx context.Context, id string) error {
+	resourceGroupName, storageAccountName, fileShareName, _, _, err := client.ParsePvVolumeHandle(id)
 	if err != nil {
 		return err
 	}
 
+	s.createFileShare(ctx, resourceGroupName, storageAccountName, fileShareName)
+	return nil
+}
+
+func (s *fileShareStore) createFileShare(ctx context.Context, resourceGroupName, storageAccountName, fileShareName string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
 	//Add to the map.
-	s.fileshares[id] = &armstorage.FileShareItem{
+	s.fileshares[fileShareKey(resourceGroupName, storageAccountName, fileShareName)] = &armstorage.FileShareItem{
 		Name: new(fileShareName),
-		ID:   new(id),
+		ID:   new(client.GetStorageAccountPath(s.subscription, resourceGroupName, storageAccountName) + "/fileServices/default/shares/" + fileShareName),
 	}
 
-	composed.LoggerFromCtx(ctx).Info("mock: Create/Update FileShare", "share-id", id, "count", len(s.fileshares))
-
-	return nil
+	composed.LoggerFromCtx(ctx).Info("mock: Create/Update FileShare", "share", fileShareName, "count", len(s.fileshares))
 }
 
 func (s *fileShareStore) GetFileShare(ctx context.Context, id string) (*armstorage.FileShareItem, error) {
+	resourceGroupName, storageAccountName, fileShareName, _, _, err := client.ParsePvVolumeHandle(id)
+	if err != nil {
+		return nil, err
+	}
+
 	s.m.Lock()
 	defer s.m.Unlock()
 
-	fs := s.fileshares[id]
+	fs := s.fileshares[fileShareKey(resourceGroupName, storageAccountName, fileShareName)]
 	composed.LoggerFromCtx(ctx).Info("mock: GetFileShare()", "length", len(s.fileshares))
 
 	return fs, nil
 }
 
 func (s *fileShareStore) DeleteFileShare(ctx context.Context, id string) error {
+	resourceGroupName, storageAccountName, fileShareName, _, _, err := client.ParsePvVolumeHandle(id)
+	if err != nil {
+		return err
+	}
+
 	s.m.Lock()
 	defer s.m.Unlock()
-	delete(s.fileshares, id)
+	delete(s.fileshares, fileShareKey(resourceGroupName, storageAccountName, fileShareName))
 	composed.LoggerFromCtx(ctx).Info("mock: DeleteFileShare()", "id", id, "length", len(s.fileshares))
 	return nil
 }
@@ -0,0 +1,88 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+var _ FlowLogsClient = &flowLogsStore{}
+
+func newFlowLogsStore(subscription string) *flowLogsStore {
+	return &flowLogsStore{
+		subscription: subscription,
+		items:        map[string]*armnetwork.FlowLog{},
+	}
+}
+
+type flowLogsStore struct {
+	m sync.Mutex
+
+	subscription string
+
+	// items is a map of flow log resource id => FlowLog
+	items map[string]*armnetwork.FlowLog
+}
+
+// FlowLogsClient ===================================================================================
+
+func (s *flowLogsStore) GetFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) (*armnetwork.FlowLog, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id := azureutil.NewFlowLogResourceId(s.subscription, resourceGroupName, networkWatcherName, flowLogName).String()
+	fl, ok := s.items[id]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	return util.JsonClone(fl)
+}
+
+func (s *flowLogsStore) CreateOrUpdateFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string, parameters armnetwork.FlowLog) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if parameters.Properties == nil || ptr.Deref(parameters.Properties.TargetResourceID, "") == "" || ptr.Deref(parameters.Properties.StorageID, "") == "" {
+		return fmt.Errorf("flow log %s must have target resource and storage account", flowLogName)
+	}
+
+	fl, err := util.JsonClone(&parameters)
+	if err != nil {
+		return err
+	}
+	id := azureutil.NewFlowLogResourceId(s.subscription, resourceGroupName, networkWatcherName, flowLogName).String()
+	fl.ID = new(id)
+	fl.Name = new(flowLogName)
+	fl.Properties.ProvisioningState = ptr.To(armnetwork.ProvisioningStateSucceeded)
+	s.items[id] = fl
+
+	return nil
+}
+
+func (s *flowLogsStore) DeleteFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id := azureutil.NewFlowLogResourceId(s.subscription, resourceGroupName, networkWatcherName, flowLogName).String()
+	if _, ok := s.items[id]; !ok {
+		return azuremeta.NewAzureNotFoundError()
+	}
+	delete(s.items, id)
+
+	return nil
+}
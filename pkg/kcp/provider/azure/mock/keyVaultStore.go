@@ -0,0 +1,175 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+var _ KeyVaultClient = &keyVaultStore{}
+
+var keyVaultNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`)
+
+func newKeyVaultStore(subscription string) *keyVaultStore {
+	return &keyVaultStore{
+		subscription: subscription,
+		items:        map[string]map[string]*keyVaultInfo{},
+	}
+}
+
+type keyVaultInfo struct {
+	vault *armkeyvault.Vault
+	keys  map[string]*armkeyvault.Key
+}
+
+type keyVaultStore struct {
+	m sync.Mutex
+
+	subscription string
+
+	// items is a map of resourceGroup => vaultName => *keyVaultInfo
+	items map[string]map[string]*keyVaultInfo
+}
+
+func (s *keyVaultStore) getKeyVaultInfoNoLock(resourceGroupName, vaultName string) (*keyVaultInfo, error) {
+	group, ok := s.items[resourceGroupName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	info, ok := group[vaultName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	return info, nil
+}
+
+// KeyVaultClient ===================================================================================
+
+func (s *keyVaultStore) GetKeyVault(ctx context.Context, resourceGroupName, vaultName string) (*armkeyvault.Vault, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getKeyVaultInfoNoLock(resourceGroupName, vaultName)
+	if err != nil {
+		return nil, err
+	}
+	return util.JsonClone(info.vault)
+}
+
+func (s *keyVaultStore) CreateKeyVault(ctx context.Context, resourceGroupName, vaultName, location, tenantId string, tags map[string]string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if !keyVaultNameRegex.MatchString(vaultName) {
+		return fmt.Errorf("key vault name %s is invalid, it must be between 3 and 24 characters in length and use alphanumerics and hyphens only", vaultName)
+	}
+	if _, err := s.getKeyVaultInfoNoLock(resourceGroupName, vaultName); err == nil {
+		return azuremeta.NewAzureConflictError()
+	}
+	if _, ok := s.items[resourceGroupName]; !ok {
+		s.items[resourceGroupName] = map[string]*keyVaultInfo{}
+	}
+
+	s.items[resourceGroupName][vaultName] = &keyVaultInfo{
+		vault: &armkeyvault.Vault{
+			ID:       new(azureutil.NewKeyVaultResourceId(s.subscription, resourceGroupName, vaultName).String()),
+			Name:     new(vaultName),
+			Location: new(location),
+			Tags:     azureutil.AzureTags(tags),
+			Properties: &armkeyvault.VaultProperties{
+				SKU: &armkeyvault.SKU{
+					Family: ptr.To(armkeyvault.SKUFamilyA),
+					Name:   ptr.To(armkeyvault.SKUNameStandard),
+				},
+				TenantID:                new(tenantId),
+				EnableRbacAuthorization: new(true),
+				EnableSoftDelete:        new(true),
+				EnablePurgeProtection:   new(true),
+				ProvisioningState:       ptr.To(armkeyvault.VaultProvisioningStateSucceeded),
+				VaultURI:                new(fmt.Sprintf("https://%s.vault.azure.net/", vaultName)),
+			},
+		},
+		keys: map[string]*armkeyvault.Key{},
+	}
+
+	return nil
+}
+
+func (s *keyVaultStore) DeleteKeyVault(ctx context.Context, resourceGroupName, vaultName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, err := s.getKeyVaultInfoNoLock(resourceGroupName, vaultName); err != nil {
+		return err
+	}
+	delete(s.items[resourceGroupName], vaultName)
+
+	return nil
+}
+
+func (s *keyVaultStore) GetKeyVaultKey(ctx context.Context, resourceGroupName, vaultName, keyName string) (*armkeyvault.Key, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getKeyVaultInfoNoLock(resourceGroupName, vaultName)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := info.keys[keyName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	return util.JsonClone(key)
+}
+
+func (s *keyVaultStore) CreateKeyVaultKey(ctx context.Context, resourceGroupName, vaultName, keyName string, properties armkeyvault.KeyProperties) (*armkeyvault.Key, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getKeyVaultInfoNoLock(resourceGroupName, vaultName)
+	if err != nil {
+		return nil, err
+	}
+	if key, ok := info.keys[keyName]; ok {
+		// create if not exist semantics
+		return util.JsonClone(key)
+	}
+
+	props, err := util.JsonClone(&properties)
+	if err != nil {
+		return nil, err
+	}
+	props.KeyURI = new(fmt.Sprintf("https://%s.vault.azure.net/keys/%s", vaultName, keyName))
+	props.KeyURIWithVersion = new(fmt.Sprintf("%s/%s", *props.KeyURI, util.RandomString(32)))
+
+	key := &armkeyvault.Key{
+		ID:         new(fmt.Sprintf("%s/keys/%s", ptr.Deref(info.vault.ID, ""), keyName)),
+		Name:       new(keyName),
+		Properties: props,
+	}
+	info.keys[keyName] = key
+
+	return util.JsonClone(key)
+}
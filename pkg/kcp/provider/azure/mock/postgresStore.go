@@ -0,0 +1,243 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	"github.com/imdario/mergo"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+var _ PostgresClient = &postgresStore{}
+var _ PostgresConfig = &postgresStore{}
+
+func newPostgresStore(subscription string) *postgresStore {
+	return &postgresStore{
+		subscription: subscription,
+		items:        map[string]map[string]*postgresServerInfo{},
+	}
+}
+
+type postgresServerInfo struct {
+	server    *armpostgresqlflexibleservers.Server
+	databases map[string]*armpostgresqlflexibleservers.Database
+}
+
+type postgresStore struct {
+	m sync.Mutex
+
+	subscription string
+
+	// items is a map of resourceGroup => serverName => *postgresServerInfo
+	items map[string]map[string]*postgresServerInfo
+}
+
+// Config =================================================================================================
+
+func (s *postgresStore) AzureRemovePostgresServer(ctx context.Context, resourceGroupName, serverName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	_, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return err
+	}
+	delete(s.items[resourceGroupName], serverName)
+
+	return nil
+}
+
+func (s *postgresStore) AzureSetPostgresServerState(ctx context.Context, resourceGroupName, serverName string, state armpostgresqlflexibleservers.ServerState) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return err
+	}
+
+	info.server.Properties.State = new(state)
+
+	return nil
+}
+
+// PostgresClient =========================================================================================
+
+func (s *postgresStore) CreatePostgresServer(ctx context.Context, resourceGroupName, serverName string, parameters armpostgresqlflexibleservers.Server) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	_, ok := s.items[resourceGroupName]
+	if !ok {
+		s.items[resourceGroupName] = map[string]*postgresServerInfo{}
+	}
+	_, ok = s.items[resourceGroupName][serverName]
+	if ok {
+		return fmt.Errorf("postgres server %s already exist", azureutil.NewPostgresServerResourceId(s.subscription, resourceGroupName, serverName).String())
+	}
+
+	server, err := util.JsonClone(&parameters)
+	if err != nil {
+		return err
+	}
+	if server.Properties == nil {
+		server.Properties = &armpostgresqlflexibleservers.ServerProperties{}
+	}
+
+	// the password is write-only and never returned by Azure
+	server.Properties.AdministratorLoginPassword = nil
+	server.ID = new(azureutil.NewPostgresServerResourceId(s.subscription, resourceGroupName, serverName).String())
+	server.Name = new(serverName)
+	server.Properties.State = ptr.To(armpostgresqlflexibleservers.ServerStateReady)
+	server.Properties.FullyQualifiedDomainName = new(fmt.Sprintf("%s.postgres.database.azure.com", serverName))
+
+	s.items[resourceGroupName][serverName] = &postgresServerInfo{
+		server: server,
+		databases: map[string]*armpostgresqlflexibleservers.Database{
+			"postgres": {
+				Name: new("postgres"),
+			},
+		},
+	}
+
+	return nil
+}
+
+func (s *postgresStore) UpdatePostgresServer(ctx context.Context, resourceGroupName, serverName string, parameters armpostgresqlflexibleservers.ServerForUpdate) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return err
+	}
+
+	if parameters.SKU != nil {
+		info.server.SKU = parameters.SKU
+	}
+
+	if parameters.Properties != nil {
+		props := &armpostgresqlflexibleservers.ServerProperties{}
+		err = util.JsonCloneInto(parameters.Properties, props)
+		if err != nil {
+			return err
+		}
+		props.AdministratorLoginPassword = nil
+		if err = mergo.Merge(info.server.Properties, props, mergo.WithOverride); err != nil {
+			return err
+		}
+	}
+
+	if parameters.Tags != nil {
+		if info.server.Tags == nil {
+			info.server.Tags = map[string]*string{}
+		}
+		maps.Copy(info.server.Tags, parameters.Tags)
+	}
+
+	return nil
+}
+
+func (s *postgresStore) GetPostgresServer(ctx context.Context, resourceGroupName, serverName string) (*armpostgresqlflexibleservers.Server, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.JsonClone(info.server)
+}
+
+func (s *postgresStore) getPostgresServerInfoNonLocking(resourceGroupName, serverName string) (*postgresServerInfo, error) {
+	group, ok := s.items[resourceGroupName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	info, ok := group[serverName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+	return info, nil
+}
+
+func (s *postgresStore) DeletePostgresServer(ctx context.Context, resourceGroupName, serverName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return err
+	}
+
+	info.server.Properties.State = ptr.To(armpostgresqlflexibleservers.ServerStateDropping)
+
+	return nil
+}
+
+func (s *postgresStore) CreatePostgresDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return err
+	}
+
+	info.databases[databaseName] = &armpostgresqlflexibleservers.Database{
+		Name: new(databaseName),
+		Properties: &armpostgresqlflexibleservers.DatabaseProperties{
+			Charset:   new("UTF8"),
+			Collation: new("en_US.utf8"),
+		},
+	}
+
+	return nil
+}
+
+func (s *postgresStore) GetPostgresDatabase(ctx context.Context, resourceGroupName, serverName, databaseName string) (*armpostgresqlflexibleservers.Database, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getPostgresServerInfoNonLocking(resourceGroupName, serverName)
+	if err != nil {
+		return nil, err
+	}
+	database, ok := info.databases[databaseName]
+	if !ok {
+		return nil, azuremeta.NewAzureNotFoundError()
+	}
+
+	return util.JsonClone(database)
+}
@@ -21,6 +21,8 @@ func newRedisStore(subscription string) *redisStore {
 	return &redisStore{
 		subscription: subscription,
 		items:        map[string]map[string]*instanceInfo{},
+		exports:      map[string]string{},
+		imports:      map[string]string{},
 	}
 }
 
@@ -36,6 +38,12 @@ type redisStore struct {
 
 	// items is a map of resourceGroup => redisName => *armredis.ResourceInfo
 	items map[string]map[string]*instanceInfo
+
+	// exports is a map of resumeToken => redis instance resource id
+	exports map[string]string
+
+	// imports is a map of resumeToken => redis instance resource id
+	imports map[string]string
 }
 
 // Config =================================================================================================
@@ -192,6 +200,25 @@ func (s *redisStore) GetRedisInstance(ctx context.Context, resourceGroupName, re
 	return res, nil
 }
 
+func (s *redisStore) ListRedisInstances(ctx context.Context, resourceGroupName string) ([]*armredis.ResourceInfo, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	var results []*armredis.ResourceInfo
+	for _, info := range s.items[resourceGroupName] {
+		res, err := util.JsonClone(info.redis)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
 func (s *redisStore) getRedisInfoNonLocking(resourceGroupName, redisInstanceName string) (*instanceInfo, error) {
 	group, ok := s.items[resourceGroupName]
 	if !ok {
@@ -235,3 +262,83 @@ func (s *redisStore) GetRedisInstanceAccessKeys(ctx context.Context, resourceGro
 
 	return []string{ptr.Deref(info.accessKeys.PrimaryKey, ""), ptr.Deref(info.accessKeys.SecondaryKey, "")}, nil
 }
+
+func (s *redisStore) ExportRedisInstanceData(ctx context.Context, resourceGroupName, redisInstanceName, containerSasUrl, prefix string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getRedisInfoNonLocking(resourceGroupName, redisInstanceName)
+	if err != nil {
+		return "", err
+	}
+	if ptr.Deref(info.redis.Properties.ProvisioningState, "") != armredis.ProvisioningStateSucceeded {
+		return "", fmt.Errorf("redis instance %s is not in succeeded state", redisInstanceName)
+	}
+	if containerSasUrl == "" || prefix == "" {
+		return "", fmt.Errorf("container and prefix are required")
+	}
+
+	resumeToken := uuid.NewString()
+	s.exports[resumeToken] = azureutil.NewRedisInstanceResourceId(s.subscription, resourceGroupName, redisInstanceName).String()
+
+	return resumeToken, nil
+}
+
+func (s *redisStore) GetExportRedisInstanceDataStatus(ctx context.Context, resourceGroupName, redisInstanceName, resumeToken string) (bool, error) {
+	if isContextCanceled(ctx) {
+		return false, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id, ok := s.exports[resumeToken]
+	if !ok || id != azureutil.NewRedisInstanceResourceId(s.subscription, resourceGroupName, redisInstanceName).String() {
+		return false, azuremeta.NewAzureNotFoundError()
+	}
+
+	// export in the mock completes immediately
+	return true, nil
+}
+
+func (s *redisStore) ImportRedisInstanceData(ctx context.Context, resourceGroupName, redisInstanceName string, files []string) (string, error) {
+	if isContextCanceled(ctx) {
+		return "", context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	info, err := s.getRedisInfoNonLocking(resourceGroupName, redisInstanceName)
+	if err != nil {
+		return "", err
+	}
+	if ptr.Deref(info.redis.Properties.ProvisioningState, "") != armredis.ProvisioningStateSucceeded {
+		return "", fmt.Errorf("redis instance %s is not in succeeded state", redisInstanceName)
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("files are required")
+	}
+
+	resumeToken := uuid.NewString()
+	s.imports[resumeToken] = azureutil.NewRedisInstanceResourceId(s.subscription, resourceGroupName, redisInstanceName).String()
+
+	return resumeToken, nil
+}
+
+func (s *redisStore) GetImportRedisInstanceDataStatus(ctx context.Context, resourceGroupName, redisInstanceName, resumeToken string) (bool, error) {
+	if isContextCanceled(ctx) {
+		return false, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	id, ok := s.imports[resumeToken]
+	if !ok || id != azureutil.NewRedisInstanceResourceId(s.subscription, resourceGroupName, redisInstanceName).String() {
+		return false, azuremeta.NewAzureNotFoundError()
+	}
+
+	// import in the mock completes immediately
+	return true, nil
+}
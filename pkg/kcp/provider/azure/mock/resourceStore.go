@@ -11,6 +11,7 @@ import (
 )
 
 var _ ResourceGroupsClient = &resourceStore{}
+var _ ResourceProvidersClient = &resourceStore{}
 
 func newResourceStore(subscription string) *resourceStore {
 	return &resourceStore{
@@ -92,3 +93,11 @@ func (s *resourceStore) DeleteResourceGroup(ctx context.Context, name string) er
 
 	return nil
 }
+
+// GetResourceTypeLocations returns no locations so the region capabilities are not restricted by the mock
+func (s *resourceStore) GetResourceTypeLocations(ctx context.Context, providerNamespace, resourceType string) ([]string, error) {
+	if isContextCanceled(ctx) {
+		return nil, context.Canceled
+	}
+	return nil, nil
+}
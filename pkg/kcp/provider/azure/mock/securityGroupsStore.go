@@ -11,6 +11,7 @@ import (
 )
 
 var _ SecurityGroupsClient = &securityGroupsStore{}
+var _ SecurityRulesClient = &securityGroupsStore{}
 
 func newSecurityGroupsStore(subscription string) *securityGroupsStore {
 	return &securityGroupsStore{
@@ -99,3 +100,58 @@ func (s *securityGroupsStore) DeleteSecurityGroup(ctx context.Context, resourceG
 
 	return nil
 }
+
+func (s *securityGroupsStore) CreateOrUpdateSecurityRule(ctx context.Context, resourceGroupName, networkSecurityGroupName, securityRuleName string, securityRule armnetwork.SecurityRule) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sg, err := s.getSecurityGroupNoLock(resourceGroupName, networkSecurityGroupName)
+	if err != nil {
+		return err
+	}
+	if sg.Properties == nil {
+		sg.Properties = &armnetwork.SecurityGroupPropertiesFormat{}
+	}
+
+	rule := securityRule
+	rule.ID = new(fmt.Sprintf("%s/securityRules/%s", ptr.Deref(sg.ID, ""), securityRuleName))
+	rule.Name = new(securityRuleName)
+
+	for i, r := range sg.Properties.SecurityRules {
+		if ptr.Deref(r.Name, "") == securityRuleName {
+			sg.Properties.SecurityRules[i] = &rule
+			return nil
+		}
+	}
+	sg.Properties.SecurityRules = append(sg.Properties.SecurityRules, &rule)
+
+	return nil
+}
+
+func (s *securityGroupsStore) DeleteSecurityRule(ctx context.Context, resourceGroupName, networkSecurityGroupName, securityRuleName string) error {
+	if isContextCanceled(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	sg, err := s.getSecurityGroupNoLock(resourceGroupName, networkSecurityGroupName)
+	if err != nil {
+		return err
+	}
+	if sg.Properties == nil {
+		return azuremeta.NewAzureNotFoundError()
+	}
+
+	for i, r := range sg.Properties.SecurityRules {
+		if ptr.Deref(r.Name, "") == securityRuleName {
+			sg.Properties.SecurityRules = append(sg.Properties.SecurityRules[:i], sg.Properties.SecurityRules[i+1:]...)
+			return nil
+		}
+	}
+
+	return azuremeta.NewAzureNotFoundError()
+}
@@ -9,12 +9,17 @@ import (
 	azurevnetlinkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vnetlink/dnszone/client"
 	azurevpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcnetwork/client"
 
+	azurebucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket/client"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 	azureexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/exposedData/client"
 	azureiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/iprange/client"
 	azurenetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/network/client"
+	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	azurepostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance/client"
+	azureredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisbackup/client"
 	azureredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/rediscluster/client"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
+	azureruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/runtime/client"
 	azurevpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering/client"
 	azurerwxpvclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxpv/client"
 	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
@@ -71,6 +76,24 @@ func (s *server) RedisClusterClientProvider() azureclient.ClientProvider[azurere
 	}
 }
 
+func (s *server) PostgresClientProvider() azureclient.ClientProvider[azurepostgresinstanceclient.Client] {
+	return func(ctx context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azurepostgresinstanceclient.Client, error) {
+		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
+	}
+}
+
+func (s *server) BucketClientProvider() azureclient.ClientProvider[azurebucketclient.Client] {
+	return func(ctx context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azurebucketclient.Client, error) {
+		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
+	}
+}
+
+func (s *server) RedisBackupClientProvider() azureclient.ClientProvider[azureredisbackupclient.Client] {
+	return func(ctx context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azureredisbackupclient.Client, error) {
+		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
+	}
+}
+
 func (s *server) NetworkProvider() azureclient.ClientProvider[azurenetworkclient.Client] {
 	return func(_ context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azurenetworkclient.Client, error) {
 		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
@@ -101,6 +124,12 @@ func (s *server) DnsResolverVNetLinkProvider() azureclient.ClientProvider[dnsres
 	}
 }
 
+func (s *server) NukeOrphanProvider() azureclient.ClientProvider[azurenukeclient.NukeOrphanClient] {
+	return func(_ context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azurenukeclient.NukeOrphanClient, error) {
+		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
+	}
+}
+
 func (s *server) RwxPvProvider() azureclient.ClientProvider[azurerwxpvclient.Client] {
 	rwxBackupProvider := azurerwxvolumebackupclient.RwxBackupClientProvider(s.StorageProvider())
 	fileShareProvider := s.FileShareProvider()
@@ -117,6 +146,12 @@ func (s *server) MockConfigs(subscription, tenant string) TenantSubscription {
 	return s.getTenantStoreSubscriptionContext(subscription, tenant)
 }
 
+func (s *server) RuntimeProvider() azureclient.ClientProvider[azureruntimeclient.Client] {
+	return func(_ context.Context, _, _, subscription, tenant string, auxiliaryTenants ...string) (azureruntimeclient.Client, error) {
+		return s.getTenantStoreSubscriptionContext(subscription, tenant), nil
+	}
+}
+
 func (s *server) getTenantStoreSubscriptionContext(subscription, tenant string) *tenantSubscriptionStore {
 	s.m.Lock()
 	defer s.m.Unlock()
@@ -28,6 +28,7 @@ var _ client.BackupProtectableItemsClient = &storageStore{}
 type storageStore struct {
 	m            sync.Mutex
 	subscription string
+	fileShares   *fileShareStore
 
 	jobs                   map[string]*armrecoveryservicesbackup.JobDetailsClientGetResponse
 	vaults                 []*armrecoveryservices.Vault
@@ -217,6 +218,10 @@ func (s *storageStore) CreateVault(ctx context.Context, resourceGroupName string
 			"cloud-manager": new("rwxVolumeBackup"),
 		},
 		Properties: &armrecoveryservices.VaultProperties{
+			RedundancySettings: &armrecoveryservices.VaultPropertiesRedundancySettings{
+				StandardTierStorageRedundancy: ptr.To(armrecoveryservices.StandardTierStorageRedundancyGeoRedundant),
+				CrossRegionRestore:            ptr.To(armrecoveryservices.CrossRegionRestoreEnabled),
+			},
 			SecuritySettings: &armrecoveryservices.SecuritySettings{
 				SoftDeleteSettings: &armrecoveryservices.SoftDeleteSettings{
 					SoftDeleteState:                 ptr.To(armrecoveryservices.SoftDeleteStateEnabled),
@@ -308,6 +313,11 @@ func (s *storageStore) TriggerRestore(ctx context.Context, request client.Restor
 		},
 	}
 	s.jobs[jobId] = &JobDetailsClientGetResponse
+
+	// Azure creates the target file share if it does not exist yet
+	if _, resourceGroupName, storageAccountName, err := client.ParseStorageAccountPath(request.TargetStorageAccountPath); err == nil && s.fileShares != nil {
+		s.fileShares.createFileShare(ctx, resourceGroupName, storageAccountName, request.TargetFileShareName)
+	}
 	return &jobId, nil
 }
 
@@ -329,7 +339,7 @@ func (s *storageStore) UnregisterContainer(ctx context.Context, resourceGroupNam
 	return nil
 }
 
-func newStorageStore(subscription string) *storageStore {
+func newStorageStore(subscription string, fileShares *fileShareStore) *storageStore {
 
 	backupProtectableItems := []*armrecoveryservicesbackup.WorkloadProtectableItemResource{
 		{
@@ -354,6 +364,7 @@ func newStorageStore(subscription string) *storageStore {
 
 	return &storageStore{
 		subscription:           subscription,
+		fileShares:             fileShares,
 		jobs:                   make(map[string]*armrecoveryservicesbackup.JobDetailsClientGetResponse),
 		protectedItems:         make(map[string][]*armrecoveryservicesbackup.ProtectedItemResource),
 		backupProtectableItems: backupProtectableItems,
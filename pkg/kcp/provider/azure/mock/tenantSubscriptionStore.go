@@ -9,6 +9,8 @@ type tenantSubscriptionStore struct {
 	*networkStore
 	*securityGroupsStore
 	*redisStore
+	*postgresStore
+	*blobStorageStore
 	*privateEndPointsStore
 	*privateDnsZoneStore
 	*virtualNetworkLinkStore
@@ -19,26 +21,33 @@ type tenantSubscriptionStore struct {
 	*fileShareStore
 	*dnsResolverVNetLinkStore
 	*dnsForwardingRulesetStore
+	*keyVaultStore
+	*flowLogsStore
 	tenant       string
 	subscription string
 }
 
 func newTenantSubscriptionStore(tenant, subscription string) *tenantSubscriptionStore {
+	fileShares := newFileShareStore(subscription)
 	return &tenantSubscriptionStore{
 		resourceStore:             newResourceStore(subscription),
 		networkStore:              newNetworkStore(subscription),
 		securityGroupsStore:       newSecurityGroupsStore(subscription),
 		redisStore:                newRedisStore(subscription),
+		postgresStore:             newPostgresStore(subscription),
+		blobStorageStore:          newBlobStorageStore(subscription),
 		privateEndPointsStore:     newPrivateEndPointsStore(subscription),
 		privateDnsZoneStore:       newPrivateDnsZoneStore(subscription),
 		virtualNetworkLinkStore:   newVirtualNetworkLinkStore(subscription),
 		privateDnsZoneGroupStore:  newPrivateDnsZoneGroupStore(subscription),
-		storageStore:              newStorageStore(subscription),
+		storageStore:              newStorageStore(subscription, fileShares),
 		natGatewayStore:           newNatGatewayStore(subscription),
 		publicIpAddressStore:      newPublicIpAddressStore(subscription),
-		fileShareStore:            newFileShareStore(subscription),
+		fileShareStore:            fileShares,
 		dnsForwardingRulesetStore: newDnsForwardingRulesetStore(subscription),
 		dnsResolverVNetLinkStore:  newDnsResolverVNetLinkStore(subscription),
+		keyVaultStore:             newKeyVaultStore(subscription),
+		flowLogsStore:             newFlowLogsStore(subscription),
 		tenant:                    tenant,
 		subscription:              subscription,
 	}
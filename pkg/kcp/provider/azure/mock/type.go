@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azurebucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/bucket/client"
 	dnsresolverclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vnetlink/dnsresolver/client"
 	azurevnetlinkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vnetlink/dnszone/client"
 	azurevpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcnetwork/client"
@@ -13,8 +16,12 @@ import (
 	azureexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/exposedData/client"
 	azureiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/iprange/client"
 	azurenetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/network/client"
+	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	azurepostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance/client"
+	azureredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisbackup/client"
 	azureredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/rediscluster/client"
 	azureredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance/client"
+	azureruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/runtime/client"
 	azurevpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering/client"
 	azurerwxpvclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxpv/client"
 	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
@@ -36,6 +43,18 @@ type SecurityGroupsClient interface {
 	azureclient.SecurityGroupsClient
 }
 
+type SecurityRulesClient interface {
+	azureclient.SecurityRulesClient
+}
+
+type FlowLogsClient interface {
+	azureclient.FlowLogsClient
+}
+
+type KeyVaultClient interface {
+	azureclient.KeyVaultClient
+}
+
 type VirtualNetworkLinkClient interface {
 	azureclient.VirtualNetworkLinkClient
 }
@@ -68,6 +87,14 @@ type RedisInstanceClient interface {
 	azureclient.RedisClient
 }
 
+type PostgresClient interface {
+	azureclient.PostgresClient
+}
+
+type StorageAccountClient interface {
+	azureclient.StorageAccountClient
+}
+
 type NatGatewayClient interface {
 	azureclient.NatGatewayClient
 }
@@ -76,13 +103,23 @@ type PublicIpAddressesClient interface {
 	azureclient.PublicIPAddressesClient
 }
 
+type ResourceProvidersClient interface {
+	azureclient.ResourceProvidersClient
+}
+
 type Clients interface {
 	ResourceGroupsClient
+	ResourceProvidersClient
 	NetworkClient
 	SecurityGroupsClient
+	SecurityRulesClient
+	FlowLogsClient
+	KeyVaultClient
 	SubnetsClient
 	VpcPeeringClient
 	RedisInstanceClient
+	PostgresClient
+	StorageAccountClient
 	VirtualNetworkLinkClient
 	PrivateDnsZoneClient
 	PrivateEndPointsClient
@@ -99,12 +136,17 @@ type Providers interface {
 	IpRangeProvider() azureclient.ClientProvider[azureiprangeclient.Client]
 	RedisClientProvider() azureclient.ClientProvider[azureredisinstanceclient.Client]
 	RedisClusterClientProvider() azureclient.ClientProvider[azureredisclusterclient.Client]
+	PostgresClientProvider() azureclient.ClientProvider[azurepostgresinstanceclient.Client]
+	BucketClientProvider() azureclient.ClientProvider[azurebucketclient.Client]
 	NetworkProvider() azureclient.ClientProvider[azurenetworkclient.Client]
 	StorageProvider() azureclient.ClientProvider[azurerwxvolumebackupclient.Client]
 	ExposeDataProvider() azureclient.ClientProvider[azureexposeddataclient.Client]
 	RwxPvProvider() azureclient.ClientProvider[azurerwxpvclient.Client]
 	DnsZoneVNetLinkProvider() azureclient.ClientProvider[azurevnetlinkclient.Client]
 	DnsResolverVNetLinkProvider() azureclient.ClientProvider[dnsresolverclient.Client]
+	NukeOrphanProvider() azureclient.ClientProvider[azurenukeclient.NukeOrphanClient]
+	RedisBackupClientProvider() azureclient.ClientProvider[azureredisbackupclient.Client]
+	RuntimeProvider() azureclient.ClientProvider[azureruntimeclient.Client]
 }
 
 type NetworkConfig interface {
@@ -128,9 +170,20 @@ type RedisConfig interface {
 	AzureSetRedisInstanceState(ctx context.Context, resourceGroupName, redisInstanceName string, state armredis.ProvisioningState) error
 }
 
+type PostgresConfig interface {
+	AzureRemovePostgresServer(ctx context.Context, resourceGroupName, serverName string) error
+	AzureSetPostgresServerState(ctx context.Context, resourceGroupName, serverName string, state armpostgresqlflexibleservers.ServerState) error
+}
+
+type StorageAccountConfig interface {
+	AzureSetStorageAccountProvisioningState(ctx context.Context, resourceGroupName, accountName string, state armstorage.ProvisioningState) error
+}
+
 type Configs interface {
 	NetworkConfig
 	RedisConfig
+	PostgresConfig
+	StorageAccountConfig
 	DnsForwardingRulesetConfig
 	DnsResolverVNetLinkConfig
 }
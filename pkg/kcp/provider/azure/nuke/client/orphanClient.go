@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+const (
+	AzureRedisInstance         = "AzureRedisInstance"
+	AzureVirtualNetworkPeering = "AzureVirtualNetworkPeering"
+)
+
+// NukeOrphanClient lists and deletes provider resources created by cloud-manager
+// that might have been left behind without their KCP object
+type NukeOrphanClient interface {
+	ListRedisInstances(ctx context.Context, resourceGroupName string) ([]*armredis.ResourceInfo, error)
+	DeleteRedisInstance(ctx context.Context, resourceGroupName, redisInstanceName string) error
+
+	ListPeerings(ctx context.Context, resourceGroupName string, virtualNetworkName string) ([]*armnetwork.VirtualNetworkPeering, error)
+	DeletePeering(ctx context.Context, resourceGroupName, virtualNetworkName, virtualNetworkPeeringName string) error
+}
+
+func NewOrphanClientProvider() azureclient.ClientProvider[NukeOrphanClient] {
+	return func(ctx context.Context, clientId, clientSecret, subscriptionId, tenantId string, auxiliaryTenants ...string) (NukeOrphanClient, error) {
+		cred, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, azureclient.NewCredentialOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		redisClient, err := armredis.NewClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		peeringClient, err := armnetwork.NewVirtualNetworkPeeringsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		return &orphanClient{
+			RedisClient:                 azureclient.NewRedisClient(redisClient),
+			VirtualNetworkPeeringClient: azureclient.NewVirtualNetworkPeeringClient(peeringClient),
+		}, nil
+	}
+}
+
+var _ NukeOrphanClient = (*orphanClient)(nil)
+
+type orphanClient struct {
+	azureclient.RedisClient
+	azureclient.VirtualNetworkPeeringClient
+}
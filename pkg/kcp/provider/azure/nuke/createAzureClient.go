@@ -22,5 +22,12 @@ func createAzureClient(ctx context.Context, st composed.State) (error, context.C
 
 	state.azureClient = cli
 
+	orphanCli, err := state.azureOrphanClientProvider(ctx, clientId, clientSecret, subscriptionId, tenantId)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "error creating azure orphan client", err, ctx)
+	}
+
+	state.azureOrphanClient = orphanCli
+
 	return nil, nil
 }
@@ -0,0 +1,38 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	"k8s.io/utils/ptr"
+)
+
+func deleteAzureRedisInstances(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("deleteAzureRedisInstances")
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == azurenukeclient.AzureRedisInstance && rks.Provider == cloudcontrolv1beta1.ProviderAzure {
+			for _, obj := range rks.Objects {
+
+				item := obj.(azureRedisInstance)
+				if item.Properties != nil && ptr.Deref(item.Properties.ProvisioningState, "") == armredis.ProvisioningStateDeleting {
+					continue
+				}
+
+				err := state.azureOrphanClient.DeleteRedisInstance(ctx, item.resourceGroup, ptr.Deref(item.Name, ""))
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Azure Redis Instance deletion %s", obj.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Azure Redis Instance %s: %w", obj.GetId(), err))
+				}
+			}
+		}
+
+	}
+	return nil, nil
+}
@@ -0,0 +1,33 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	"k8s.io/utils/ptr"
+)
+
+func deleteAzureVirtualNetworkPeerings(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("deleteAzureVirtualNetworkPeerings")
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == azurenukeclient.AzureVirtualNetworkPeering && rks.Provider == cloudcontrolv1beta1.ProviderAzure {
+			for _, obj := range rks.Objects {
+
+				item := obj.(azureVirtualNetworkPeering)
+				err := state.azureOrphanClient.DeletePeering(ctx, state.GetVpcNetwork(), state.GetVpcNetwork(), ptr.Deref(item.Name, ""))
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Azure Virtual Network Peering deletion %s", obj.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Azure Virtual Network Peering %s: %w", obj.GetId(), err))
+				}
+			}
+		}
+
+	}
+	return nil, nil
+}
@@ -0,0 +1,69 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// loadAzureRedisInstances loads redis instances from the cloud-manager resource group
+// that have neither RedisInstance nor RedisCluster
+func loadAzureRedisInstances(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	resourceGroupName := azurecommon.AzureCloudManagerResourceGroupName(state.GetVpcNetwork())
+
+	logger.Info("Load AzureRedisInstances")
+	list, err := state.azureOrphanClient.ListRedisInstances(ctx, resourceGroupName)
+	if azuremeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAzureRedisInstances",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list AzureRedisInstances error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, redis := range list {
+		name := ptr.Deref(redis.Name, "")
+		if !state.IsOrphanRedis(name) {
+			continue
+		}
+		objects = append(objects, azureRedisInstance{
+			ResourceInfo:  redis,
+			id:            azureutil.NewRedisInstanceResourceId(state.GetSubscriptionId(), resourceGroupName, name).String(),
+			resourceGroup: resourceGroupName,
+		})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     azurenukeclient.AzureRedisInstance,
+		Provider: cloudcontrolv1beta1.ProviderAzure,
+		Objects:  objects,
+	})
+	return nil, ctx
+}
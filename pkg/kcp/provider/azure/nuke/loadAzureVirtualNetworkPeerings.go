@@ -0,0 +1,60 @@
+package nuke
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azurenukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/nuke/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// loadAzureVirtualNetworkPeerings loads peerings of the kyma network that have no VpcPeering.
+// Azure peerings can not be tagged, so they are matched by the local peering name.
+func loadAzureVirtualNetworkPeerings(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("Load AzureVirtualNetworkPeerings")
+	list, err := state.azureOrphanClient.ListPeerings(ctx, state.GetVpcNetwork(), state.GetVpcNetwork())
+	if azuremeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorListingAzureVirtualNetworkPeerings",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after list AzureVirtualNetworkPeerings error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, peering := range list {
+		if !state.IsOrphanPeering(ptr.Deref(peering.Name, "")) {
+			continue
+		}
+		objects = append(objects, azureVirtualNetworkPeering{peering})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     azurenukeclient.AzureVirtualNetworkPeering,
+		Provider: cloudcontrolv1beta1.ProviderAzure,
+		Objects:  objects,
+	})
+	return nil, ctx
+}
@@ -34,14 +34,22 @@ func New(stateFactory StateFactory) composed.Action {
 			loadAzureRecoveryVaults,
 			loadAzureContainers,
 			loadAzureBackups,
+			loadAzureRedisInstances,
+			loadAzureVirtualNetworkPeerings,
 			providerResourceStatusDiscovered,
-			disableSoftDelete,
-			deleteAzureBackups,
-			deleteAzureContainers,
-			deleteAzureVaults,
-			providerResourceStatusDeleting,
-			providerResourceStatusDeleted,
-			checkIfAllProviderResourcesDeleted,
+			composed.If(
+				nuketypes.DeletionApprovedPredicate,
+				disableSoftDelete,
+				deleteAzureBackups,
+				deleteAzureContainers,
+				deleteAzureVaults,
+				deleteAzureRedisInstances,
+				deleteAzureVirtualNetworkPeerings,
+				providerResourceStatusDeleting,
+				providerResourceStatusDeleted,
+				providerResourceDeleteErrors,
+				checkIfAllProviderResourcesDeleted,
+			),
 			// continue to parent action
 			func(ctx context.Context, state composed.State) (error, context.Context) {
 				return nil, ctx
@@ -0,0 +1,46 @@
+package nuke
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const reasonErrorDeletingAzureResources = "ErrorDeletingAzureResources"
+
+// providerResourceDeleteErrors sets the Error condition if any of the delete requests failed, so that
+// the Nuke does not look like it is progressing while provider resources are left behind. Deletion is
+// retried on the next reconciliation, and the condition is removed once all delete requests succeed.
+func providerResourceDeleteErrors(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if len(state.deleteErrors) == 0 {
+		cond := meta.FindStatusCondition(state.ObjAsNuke().Status.Conditions, cloudcontrolv1beta1.ConditionTypeError)
+		if cond == nil || cond.Reason != reasonErrorDeletingAzureResources {
+			return nil, ctx
+		}
+		return composed.PatchStatus(state.ObjAsNuke()).
+			RemoveConditionIfReasonMatched(cloudcontrolv1beta1.ConditionTypeError, reasonErrorDeletingAzureResources).
+			ErrorLogMessage("Error patching KCP Nuke status after Azure provider resources delete errors are resolved").
+			SuccessErrorNil().
+			Run(ctx, state)
+	}
+
+	state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+	return composed.PatchStatus(state.ObjAsNuke()).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  reasonErrorDeletingAzureResources,
+			Message: errors.Join(state.deleteErrors...).Error(),
+		}).
+		ErrorLogMessage("Error patching KCP Nuke status after Azure provider resources delete errors").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		Run(ctx, state)
+}
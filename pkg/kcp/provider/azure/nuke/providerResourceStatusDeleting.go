@@ -25,7 +25,8 @@ func providerResourceStatusDeleting(ctx context.Context, st composed.State) (err
 		}
 	}
 
-	if state.ObjAsNuke().Status.State != "Deleting" {
+	// failed delete requests are reported by providerResourceDeleteErrors with the Error state
+	if len(state.deleteErrors) == 0 && state.ObjAsNuke().Status.State != "Deleting" {
 		changed = true
 		state.ObjAsNuke().Status.State = "Deleting"
 	}
@@ -2,9 +2,13 @@ package nuke
 
 import (
 	"context"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
+	"github.com/google/uuid"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
@@ -20,23 +24,27 @@ type StateFactory interface {
 
 func NewStateFactory(
 	azureClientProvider azureclient.ClientProvider[client.NukeRwxBackupClient],
+	azureOrphanClientProvider azureclient.ClientProvider[client.NukeOrphanClient],
 	env abstractions.Environment) StateFactory {
 	return stateFactory{
-		azureClientProvider: azureClientProvider,
-		env:                 env,
+		azureClientProvider:       azureClientProvider,
+		azureOrphanClientProvider: azureOrphanClientProvider,
+		env:                       env,
 	}
 }
 
 type stateFactory struct {
-	azureClientProvider azureclient.ClientProvider[client.NukeRwxBackupClient]
-	env                 abstractions.Environment
+	azureClientProvider       azureclient.ClientProvider[client.NukeRwxBackupClient]
+	azureOrphanClientProvider azureclient.ClientProvider[client.NukeOrphanClient]
+	env                       abstractions.Environment
 }
 
 func (f stateFactory) NewState(ctx context.Context, nukeState nuketypes.State) (focal.State, error) {
 	return &State{
-		State:               nukeState,
-		azureClientProvider: f.azureClientProvider,
-		env:                 f.env,
+		State:                     nukeState,
+		azureClientProvider:       f.azureClientProvider,
+		azureOrphanClientProvider: f.azureOrphanClientProvider,
+		env:                       f.env,
 	}, nil
 }
 
@@ -44,13 +52,18 @@ type State struct {
 	nuketypes.State
 	ProviderResources []*nuketypes.ProviderResourceKindState
 
-	azureClientProvider azureclient.ClientProvider[client.NukeRwxBackupClient]
-	env                 abstractions.Environment
-	azureClient         client.NukeRwxBackupClient
+	azureClientProvider       azureclient.ClientProvider[client.NukeRwxBackupClient]
+	azureOrphanClientProvider azureclient.ClientProvider[client.NukeOrphanClient]
+	env                       abstractions.Environment
+	azureClient               client.NukeRwxBackupClient
+	azureOrphanClient         client.NukeOrphanClient
 
 	recoveryVaults       []*armrecoveryservices.Vault
 	protectionContainers map[string]*armrecoveryservicesbackup.AzureStorageContainer
 	protectedItems       map[string]*armrecoveryservicesbackup.AzureFileshareProtectedItem
+
+	// deleteErrors holds errors returned by provider delete requests in the current reconciliation
+	deleteErrors []error
 }
 
 type azureFileshare struct {
@@ -91,10 +104,70 @@ func (v azureContainer) GetObject() any {
 	return v
 }
 
+type azureRedisInstance struct {
+	*armredis.ResourceInfo
+	id            string
+	resourceGroup string
+}
+
+func (r azureRedisInstance) GetId() string {
+	return r.id
+}
+
+func (r azureRedisInstance) GetObject() any {
+	return r
+}
+
+type azureVirtualNetworkPeering struct {
+	*armnetwork.VirtualNetworkPeering
+}
+
+func (p azureVirtualNetworkPeering) GetId() string {
+	return ptr.Deref(p.ID, ptr.Deref(p.Name, ""))
+}
+
+func (p azureVirtualNetworkPeering) GetObject() any {
+	return p
+}
+
 type ProviderNukeStatus struct {
 	cloudcontrolv1beta1.NukeStatus
 }
 
+func (s *State) AddDeleteError(err error) {
+	s.deleteErrors = append(s.deleteErrors, err)
+}
+
 func (s *State) GetSubscriptionId() string {
 	return s.Scope().Spec.Scope.Azure.SubscriptionId
 }
+
+func (s *State) GetVpcNetwork() string {
+	return s.Scope().Spec.Scope.Azure.VpcNetwork
+}
+
+// IsOrphanRedis returns true if there is no RedisInstance nor RedisCluster with the given name
+func (s *State) IsOrphanRedis(name string) bool {
+	return !s.ObjectExists("RedisInstance", name) && !s.ObjectExists("RedisCluster", name)
+}
+
+// IsOrphanPeering returns true if the local peering name is one cloud-manager gives to peerings,
+// and there is no VpcPeering with it. Peerings named otherwise are never orphans.
+func (s *State) IsOrphanPeering(name string) bool {
+	if !isCloudManagerPeeringName(name) {
+		return false
+	}
+	for _, obj := range s.ObjectsOfKind("VpcPeering") {
+		peering, ok := obj.(*cloudcontrolv1beta1.VpcPeering)
+		if ok && peering.GetLocalPeeringName() == name {
+			return false
+		}
+	}
+	return true
+}
+
+// isCloudManagerPeeringName returns true for the KCP VpcPeering name, which is a UUID and the default
+// local peering name, and for the "cm--" prefixed local peering name of the kyma network peering
+func isCloudManagerPeeringName(name string) bool {
+	return strings.HasPrefix(name, "cm--") || uuid.Validate(name) == nil
+}
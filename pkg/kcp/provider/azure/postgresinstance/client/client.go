@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+type Client interface {
+	azureclient.PostgresClient
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+	azureclient.PrivateDnsZoneClient
+	azureclient.VirtualNetworkLinkClient
+}
+
+func NewClientProvider() azureclient.ClientProvider[Client] {
+	return func(ctx context.Context, clientId, clientSecret, subscriptionId, tenantId string, auxiliaryTenants ...string) (Client, error) {
+
+		cred, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, azureclient.NewCredentialOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		serversClient, err := armpostgresqlflexibleservers.NewServersClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		databasesClient, err := armpostgresqlflexibleservers.NewDatabasesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateEndPointsClient, err := armnetwork.NewPrivateEndpointsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateDnsZoneGroupClient, err := armnetwork.NewPrivateDNSZoneGroupsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		privateDnsZoneClient, err := armprivatedns.NewPrivateZonesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		virtualNetworkLinkClient, err := armprivatedns.NewVirtualNetworkLinksClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		return newClient(
+			azureclient.NewPostgresClient(serversClient, databasesClient),
+			azureclient.NewPrivateEndPointClient(privateEndPointsClient),
+			azureclient.NewPrivateDnsZoneGroupClient(privateDnsZoneGroupClient),
+			azureclient.NewPrivateDnsZoneClient(privateDnsZoneClient),
+			azureclient.NewVirtualNetworkLinkClient(virtualNetworkLinkClient),
+		), nil
+	}
+}
+
+type postgresInstanceClient struct {
+	azureclient.PostgresClient
+	azureclient.PrivateEndPointsClient
+	azureclient.PrivateDnsZoneGroupClient
+	azureclient.PrivateDnsZoneClient
+	azureclient.VirtualNetworkLinkClient
+}
+
+func newClient(
+	postgresClient azureclient.PostgresClient,
+	privateEndPointsClient azureclient.PrivateEndPointsClient,
+	privateDnsZoneGroupClient azureclient.PrivateDnsZoneGroupClient,
+	privateDnsZoneClient azureclient.PrivateDnsZoneClient,
+	virtualNetworkLinkClient azureclient.VirtualNetworkLinkClient,
+) Client {
+	return &postgresInstanceClient{
+		PostgresClient:            postgresClient,
+		PrivateEndPointsClient:    privateEndPointsClient,
+		PrivateDnsZoneGroupClient: privateDnsZoneGroupClient,
+		PrivateDnsZoneClient:      privateDnsZoneClient,
+		VirtualNetworkLinkClient:  virtualNetworkLinkClient,
+	}
+}
@@ -0,0 +1,53 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createDatabase creates the database specified in the spec, unless it is the default postgres database
+// that Azure creates together with the flexible server.
+func createDatabase(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+	databaseName := postgresInstance.Spec.Instance.Azure.DatabaseName
+
+	if databaseName == "" || databaseName == postgresDefaultDatabase {
+		return nil, ctx
+	}
+
+	_, err := state.client.GetPostgresDatabase(ctx, state.resourceGroupName, postgresInstance.Name, databaseName)
+	if err == nil {
+		return nil, ctx
+	}
+	if !azuremeta.IsNotFound(err) {
+		return composed.LogErrorAndReturn(err, "Error loading Azure Postgres database", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	logger.Info("Creating Azure Postgres database")
+
+	err = state.client.CreatePostgresDatabase(ctx, state.resourceGroupName, postgresInstance.Name, databaseName)
+	if err != nil {
+		logger.Error(err, "Error creating Azure Postgres database")
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to create PostgresInstance database",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed azure postgres database creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeue, nil
+}
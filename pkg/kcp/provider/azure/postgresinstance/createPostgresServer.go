@@ -0,0 +1,79 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createPostgresServer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.postgresServer != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating Azure Postgres server")
+
+	err := state.client.CreatePostgresServer(
+		ctx,
+		state.resourceGroupName,
+		state.ObjAsPostgresInstance().Name,
+		getCreateParams(state),
+	)
+	if err != nil {
+		logger.Error(err, "Error creating Azure Postgres server")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed creating Azure Postgres server: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure postgres server creation",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
+
+// getCreateParams builds the flexible server without delegated subnet and without firewall rules,
+// so the server has no public access and is reachable only through the private endpoint.
+func getCreateParams(state *State) armpostgresqlflexibleservers.Server {
+	postgresInstance := state.ObjAsPostgresInstance()
+	spec := postgresInstance.Spec.Instance.Azure
+
+	return armpostgresqlflexibleservers.Server{
+		Location: new(state.Scope().Spec.Region),
+		SKU: &armpostgresqlflexibleservers.SKU{
+			Name: new(spec.SkuName),
+			Tier: new(armpostgresqlflexibleservers.SKUTier(spec.SkuTier)),
+		},
+		Properties: &armpostgresqlflexibleservers.ServerProperties{
+			AdministratorLogin:         new(postgresInstance.Status.Username),
+			AdministratorLoginPassword: new(postgresInstance.Status.Password),
+			CreateMode:                 new(armpostgresqlflexibleservers.CreateModeCreate),
+			Version:                    new(armpostgresqlflexibleservers.ServerVersion(spec.Version)),
+			Storage: &armpostgresqlflexibleservers.Storage{
+				StorageSizeGB: new(spec.StorageGb),
+			},
+			HighAvailability: &armpostgresqlflexibleservers.HighAvailability{
+				Mode: new(toHighAvailabilityMode(spec.HighAvailability)),
+			},
+		},
+	}
+}
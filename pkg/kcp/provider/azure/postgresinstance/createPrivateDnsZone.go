@@ -0,0 +1,45 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createPrivateDnsZone creates the postgres privatelink zone in the cloud manager resource group.
+// The zone is shared by all postgres instances of the runtime and is never deleted by the
+// PostgresInstance, it's removed together with the resource group when the KCP Network is deleted.
+func createPrivateDnsZone(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	if state.privateDnsZone != nil {
+		return nil, ctx
+	}
+	logger.Info("Creating Azure Postgres Private DnsZone")
+	err := state.client.CreatePrivateDnsZone(ctx, state.resourceGroupName, azureutil.NewPostgresPrivateDnsZoneName(), nil)
+	if err != nil {
+		logger.Error(err, "Error creating Azure Postgres Private DnsZone")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed creating Azure Postgres Private DnsZone: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure Private DnsZone creation",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,50 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createVirtualNetworkLink links the postgres privatelink zone to the kyma network so the server FQDN
+// resolves to the private endpoint address. Same as the zone, the link is shared and not deleted.
+func createVirtualNetworkLink(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	if state.virtualNetworkLink != nil {
+		return nil, ctx
+	}
+	if state.privateDnsZone == nil {
+		logger.Info("Can not create Azure Postgres VirtualNetworkLink, Private DnsZone is not loaded, requeue")
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+	logger.Info("Creating Azure Postgres VirtualNetworkLink")
+	kymaNetworkName := state.Scope().Spec.Scope.Azure.VpcNetwork
+	vnetId := azureutil.NewVirtualNetworkResourceId(state.subscriptionId, kymaNetworkName, kymaNetworkName).String()
+	err := state.client.CreateVirtualNetworkLink(ctx, state.resourceGroupName, azureutil.NewPostgresPrivateDnsZoneName(), kymaNetworkName, vnetId)
+	if err != nil {
+		logger.Error(err, "Error creating Azure Postgres VirtualNetworkLink")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed creating Azure Postgres VirtualNetworkLink: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure VirtualNetworkLink creation",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,56 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deletePostgresServer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.postgresServer == nil {
+		return nil, ctx
+	}
+
+	if state.GetServerState() == armpostgresqlflexibleservers.ServerStateDropping {
+		return nil, ctx // delete is waited in next action
+	}
+
+	logger.Info("Deleting Azure Postgres server")
+
+	err := state.client.DeletePostgresServer(ctx, state.resourceGroupName, state.ObjAsPostgresInstance().Name)
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			return nil, ctx
+		}
+
+		logger.Error(err, "Error deleting Azure Postgres server")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed deleting Azure Postgres server: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure postgres server deleting",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
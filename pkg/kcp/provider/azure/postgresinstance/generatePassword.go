@@ -0,0 +1,33 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// generatePassword generates the administrator password and persists it in the KCP status before the
+// flexible server is created, since Azure never returns the administrator password once it's set.
+func generatePassword(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if postgresInstance.Status.Password != "" {
+		return nil, ctx
+	}
+	if state.postgresServer != nil {
+		composed.LoggerFromCtx(ctx).Info("Azure Postgres server exists, but PostgresInstance has no password in status")
+		return nil, ctx
+	}
+
+	postgresInstance.Status.Username = postgresDefaultUsername
+	postgresInstance.Status.Password = generateAdministratorPassword()
+
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating PostgresInstance status with generated password", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,55 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func loadPostgresServer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.postgresServer != nil {
+		logger.Info("Azure Postgres server already loaded")
+		return nil, ctx
+	}
+
+	logger.Info("Loading Azure Postgres server")
+
+	server, err := state.client.GetPostgresServer(ctx, state.resourceGroupName, state.ObjAsPostgresInstance().Name)
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure Postgres server not found")
+			return nil, ctx
+		}
+
+		logger.Error(err, "Error loading Azure Postgres server")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed loading Azure Postgres server: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure postgres server loading",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+
+	state.postgresServer = server
+
+	return nil, ctx
+}
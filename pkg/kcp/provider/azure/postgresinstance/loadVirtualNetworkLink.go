@@ -0,0 +1,54 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func loadVirtualNetworkLink(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	if state.virtualNetworkLink != nil {
+		logger.Info("Azure Postgres VirtualNetworkLink already loaded")
+		return nil, ctx
+	}
+	if state.privateDnsZone == nil {
+		logger.Info("Skipping Azure Postgres VirtualNetworkLink loading, Private DnsZone is not loaded")
+		return nil, ctx
+	}
+	logger.Info("Loading Azure Postgres VirtualNetworkLink")
+	virtualNetworkLinkName := state.Scope().Spec.Scope.Azure.VpcNetwork
+	virtualNetworkLink, err := state.client.GetVirtualNetworkLink(ctx, state.resourceGroupName, azureutil.NewPostgresPrivateDnsZoneName(), virtualNetworkLinkName)
+	if err != nil {
+		if azuremeta.IsNotFound(err) {
+			logger.Info("Azure Postgres VirtualNetworkLink not found")
+			return nil, ctx
+		}
+		logger.Error(err, "Error loading Azure Postgres VirtualNetworkLink")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed loading Azure Postgres VirtualNetworkLink: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure VirtualNetworkLink loading",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+	state.virtualNetworkLink = virtualNetworkLink
+	return nil, ctx
+}
@@ -0,0 +1,95 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func modifyPostgresServer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.postgresServer == nil {
+		return nil, ctx
+	}
+
+	updateParams, changed := getUpdateParams(state)
+	if !changed {
+		return nil, ctx
+	}
+
+	logger.Info("Detected modified Postgres configuration")
+	err := state.client.UpdatePostgresServer(
+		ctx,
+		state.resourceGroupName,
+		state.ObjAsPostgresInstance().Name,
+		updateParams,
+	)
+	if err != nil {
+		logger.Error(err, "Error updating Azure Postgres server")
+		meta.SetStatusCondition(state.ObjAsPostgresInstance().Conditions(), metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: fmt.Sprintf("Failed to modify Azure Postgres server: %s", err),
+		})
+		err = state.UpdateObjStatus(ctx)
+		if err != nil {
+			return composed.LogErrorAndReturn(err,
+				"Error updating PostgresInstance status due failed azure postgres server update",
+				composed.StopWithRequeueDelay(util.Timing.T10000ms()),
+				ctx,
+			)
+		}
+
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
+
+// getUpdateParams returns the flexible server update with only the fields that differ from the spec.
+// The server version can not be updated in place, so it is immutable in the spec.
+func getUpdateParams(state *State) (armpostgresqlflexibleservers.ServerForUpdate, bool) {
+	spec := state.ObjAsPostgresInstance().Spec.Instance.Azure
+	updateParams := armpostgresqlflexibleservers.ServerForUpdate{
+		Properties: &armpostgresqlflexibleservers.ServerPropertiesForUpdate{},
+	}
+	changed := false
+
+	currentTier := ""
+	if state.postgresServer.SKU != nil {
+		currentTier = string(ptr.Deref(state.postgresServer.SKU.Tier, ""))
+	}
+	if state.GetProvisionedSkuName() != spec.SkuName || currentTier != spec.SkuTier {
+		updateParams.SKU = &armpostgresqlflexibleservers.SKU{
+			Name: new(spec.SkuName),
+			Tier: new(armpostgresqlflexibleservers.SKUTier(spec.SkuTier)),
+		}
+		changed = true
+	}
+
+	if state.GetProvisionedStorageGb() < spec.StorageGb {
+		updateParams.Properties.Storage = &armpostgresqlflexibleservers.Storage{
+			StorageSizeGB: new(spec.StorageGb),
+		}
+		changed = true
+	}
+
+	if state.IsProvisionedHighAvailability() != spec.HighAvailability {
+		updateParams.Properties.HighAvailability = &armpostgresqlflexibleservers.HighAvailability{
+			Mode: new(toHighAvailabilityMode(spec.HighAvailability)),
+		}
+		changed = true
+	}
+
+	return updateParams, changed
+}
@@ -0,0 +1,75 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+
+		state, err := stateFactory.NewState(ctx, st.(types.State))
+		if err != nil {
+			composed.LoggerFromCtx(ctx).Error(err, "Failed to bootstrap Azure PostgresInstance state")
+			postgresInstance := st.Obj().(*cloudcontrolv1beta1.PostgresInstance)
+			postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+			return composed.UpdateStatus(postgresInstance).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+					Message: "Failed to create PostgresInstance state",
+				}).
+				SuccessError(composed.StopAndForget).
+				SuccessLogMsg(fmt.Sprintf("Error creating new Azure PostgresInstance state: %s", err)).
+				Run(ctx, st)
+		}
+
+		return composed.ComposeActions(
+			"azurePostgresInstance",
+			actions.AddCommonFinalizer(),
+			loadPrivateEndPoint,
+			loadPrivateDnsZoneGroup,
+			loadPostgresServer,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"azure-postgresInstance-create",
+					loadPrivateDnsZone,
+					createPrivateDnsZone,
+					loadVirtualNetworkLink,
+					createVirtualNetworkLink,
+					generatePassword,
+					createPostgresServer,
+					updateStatusId,
+					addUpdatingCondition,
+					waitPostgresServerAvailable,
+					createDatabase,
+					createPrivateEndPoint,
+					waitPrivateEndPointAvailable,
+					createPrivateDnsZoneGroup,
+					modifyPostgresServer,
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"azure-postgresInstance-delete",
+					removeReadyCondition,
+					deletePrivateDnsZoneGroup,
+					waitPrivateDnsZoneGroupDeleted,
+					deletePrivateEndPoint,
+					waitPrivateEndPointDeleted,
+					deletePostgresServer,
+					waitPostgresServerDeleted,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(ctx, state)
+	}
+}
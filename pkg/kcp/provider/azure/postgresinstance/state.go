@@ -0,0 +1,115 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/privatedns/armprivatedns"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance/types"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	azurepostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/postgresinstance/client"
+	"k8s.io/utils/ptr"
+)
+
+type State struct {
+	types.State
+
+	client         azurepostgresinstanceclient.Client
+	provider       azureclient.ClientProvider[azurepostgresinstanceclient.Client]
+	clientId       string
+	clientSecret   string
+	subscriptionId string
+	tenantId       string
+
+	resourceGroupName   string
+	privateDnsZone      *armprivatedns.PrivateZone
+	virtualNetworkLink  *armprivatedns.VirtualNetworkLink
+	privateEndPoint     *armnetwork.PrivateEndpoint
+	privateDnsZoneGroup *armnetwork.PrivateDNSZoneGroup
+	postgresServer      *armpostgresqlflexibleservers.Server
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, postgresInstanceState types.State) (*State, error)
+}
+
+type stateFactory struct {
+	provider azureclient.ClientProvider[azurepostgresinstanceclient.Client]
+}
+
+func NewStateFactory(provider azureclient.ClientProvider[azurepostgresinstanceclient.Client]) StateFactory {
+	return &stateFactory{
+		provider: provider,
+	}
+}
+
+func (f *stateFactory) NewState(ctx context.Context, postgresInstanceState types.State) (*State, error) {
+	clientId := azureconfig.AzureConfig.DefaultCreds.ClientId
+	clientSecret := azureconfig.AzureConfig.DefaultCreds.ClientSecret
+	subscriptionId := postgresInstanceState.Scope().Spec.Scope.Azure.SubscriptionId
+	tenantId := postgresInstanceState.Scope().Spec.Scope.Azure.TenantId
+
+	c, err := f.provider(ctx, clientId, clientSecret, subscriptionId, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return newState(postgresInstanceState, c, f.provider, clientId, clientSecret, subscriptionId, tenantId), nil
+}
+
+func newState(
+	state types.State,
+	client azurepostgresinstanceclient.Client,
+	provider azureclient.ClientProvider[azurepostgresinstanceclient.Client],
+	clientId string,
+	clientSecret string,
+	subscriptionId string,
+	tenantId string,
+) *State {
+	return &State{
+		State:          state,
+		client:         client,
+		provider:       provider,
+		clientId:       clientId,
+		clientSecret:   clientSecret,
+		subscriptionId: subscriptionId,
+		tenantId:       tenantId,
+
+		resourceGroupName: azurecommon.AzureCloudManagerResourceGroupName(state.Scope().Spec.Scope.Azure.VpcNetwork),
+	}
+}
+
+// GetServerState returns the state of the loaded flexible server, or empty string if it's not loaded
+func (s *State) GetServerState() armpostgresqlflexibleservers.ServerState {
+	if s.postgresServer == nil || s.postgresServer.Properties == nil {
+		return ""
+	}
+	return ptr.Deref(s.postgresServer.Properties.State, "")
+}
+
+// GetProvisionedSkuName returns the compute SKU name of the loaded flexible server
+func (s *State) GetProvisionedSkuName() string {
+	if s.postgresServer == nil || s.postgresServer.SKU == nil {
+		return ""
+	}
+	return ptr.Deref(s.postgresServer.SKU.Name, "")
+}
+
+// GetProvisionedStorageGb returns the storage size of the loaded flexible server
+func (s *State) GetProvisionedStorageGb() int32 {
+	if s.postgresServer == nil || s.postgresServer.Properties == nil || s.postgresServer.Properties.Storage == nil {
+		return 0
+	}
+	return ptr.Deref(s.postgresServer.Properties.Storage.StorageSizeGB, 0)
+}
+
+// IsProvisionedHighAvailability returns true if the loaded flexible server has zone redundant standby
+func (s *State) IsProvisionedHighAvailability() bool {
+	if s.postgresServer == nil || s.postgresServer.Properties == nil || s.postgresServer.Properties.HighAvailability == nil {
+		return false
+	}
+	return ptr.Deref(s.postgresServer.Properties.HighAvailability.Mode, "") == armpostgresqlflexibleservers.HighAvailabilityModeZoneRedundant
+}
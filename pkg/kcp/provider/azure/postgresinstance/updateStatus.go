@@ -0,0 +1,66 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+	hasChanged := false
+
+	// the FQDN resolves to the private endpoint address through the postgres privatelink zone
+	primaryEndpoint := ""
+	if fqdn := ptr.Deref(state.postgresServer.Properties.FullyQualifiedDomainName, ""); fqdn != "" {
+		primaryEndpoint = fmt.Sprintf("%s:%d", fqdn, postgresPort)
+	}
+	if postgresInstance.Status.PrimaryEndpoint != primaryEndpoint {
+		postgresInstance.Status.PrimaryEndpoint = primaryEndpoint
+		hasChanged = true
+	}
+
+	if postgresInstance.Status.Username != postgresDefaultUsername {
+		postgresInstance.Status.Username = postgresDefaultUsername
+		hasChanged = true
+	}
+
+	instanceClass := state.GetProvisionedSkuName()
+	if postgresInstance.Status.InstanceClass != instanceClass {
+		postgresInstance.Status.InstanceClass = instanceClass
+		hasChanged = true
+	}
+
+	storageGb := state.GetProvisionedStorageGb()
+	if postgresInstance.Status.StorageGb != storageGb {
+		postgresInstance.Status.StorageGb = storageGb
+		hasChanged = true
+	}
+
+	hasReadyCondition := meta.FindStatusCondition(postgresInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady) != nil
+	hasReadyStatusState := postgresInstance.Status.State == cloudcontrolv1beta1.StateReady
+	if !hasChanged && hasReadyCondition && hasReadyStatusState {
+		composed.LoggerFromCtx(ctx).Info("PostgresInstance status fields are already up-to-date, StopAndForget-ing")
+		return composed.StopAndForget, nil
+	}
+
+	postgresInstance.Status.State = cloudcontrolv1beta1.StateReady
+	return composed.UpdateStatus(postgresInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonReady,
+			Message: "Postgres instance is ready",
+		}).
+		ErrorLogMessage("Error updating KCP PostgresInstance status after setting Ready condition").
+		SuccessLogMsg("KCP PostgresInstance is ready").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,27 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+func updateStatusId(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if postgresInstance.Status.Id != "" { // already set
+		return nil, ctx
+	}
+
+	postgresInstance.Status.Id = ptr.Deref(state.postgresServer.Name, "")
+
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating Azure PostgresInstance success .status.id", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,34 @@
+package postgresinstance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+const (
+	postgresPort            = 5432
+	postgresDefaultDatabase = "postgres"
+
+	// postgresDefaultUsername is the flexible server administrator login. Azure reserves names like
+	// admin, administrator, root and postgres, so a cloud-manager specific one is used.
+	postgresDefaultUsername = "cmadmin"
+
+	// postgresPrivateLinkGroupId is the private link sub-resource of the flexible server
+	postgresPrivateLinkGroupId = "postgresqlServer"
+)
+
+// generateAdministratorPassword returns a random password that satisfies the flexible server
+// complexity requirement of having characters from at least three categories.
+func generateAdministratorPassword() string {
+	return fmt.Sprintf("%s-%s", util.RandomString(16), strings.ToUpper(util.RandomString(16)))
+}
+
+func toHighAvailabilityMode(highAvailability bool) armpostgresqlflexibleservers.HighAvailabilityMode {
+	if highAvailability {
+		return armpostgresqlflexibleservers.HighAvailabilityModeZoneRedundant
+	}
+	return armpostgresqlflexibleservers.HighAvailabilityModeDisabled
+}
@@ -0,0 +1,38 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func waitPostgresServerAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.postgresServer == nil {
+		errorMsg := "Error: azure postgres server is not loaded"
+		postgresInstance := st.Obj().(*cloudcontrolv1beta1.PostgresInstance)
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ConditionTypeError,
+				Message: errorMsg,
+			}).
+			SuccessError(composed.StopAndForget).
+			SuccessLogMsg(errorMsg).
+			Run(ctx, st)
+	}
+
+	if state.GetServerState() == armpostgresqlflexibleservers.ServerStateReady {
+		return nil, ctx
+	}
+
+	logger.Info("Azure Postgres server is not ready yet, requeuing with delay", "serverState", state.GetServerState())
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+}
@@ -0,0 +1,20 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func waitPostgresServerDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.postgresServer == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Azure Postgres server is still being deleted, requeueing with delay", "serverState", state.GetServerState())
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+}
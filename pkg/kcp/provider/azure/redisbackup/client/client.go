@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+type Client interface {
+	azureclient.RedisClient
+	azureclient.StorageAccountClient
+}
+
+func NewClientProvider() azureclient.ClientProvider[Client] {
+	return func(ctx context.Context, clientId, clientSecret, subscriptionId, tenantId string, auxiliaryTenants ...string) (Client, error) {
+
+		cred, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, azureclient.NewCredentialOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		armRedisClientInstance, err := armredis.NewClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		accountsClient, err := armstorage.NewAccountsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		blobContainersClient, err := armstorage.NewBlobContainersClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		blobServicesClient, err := armstorage.NewBlobServicesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		managementPoliciesClient, err := armstorage.NewManagementPoliciesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		return newClient(
+			azureclient.NewRedisClient(armRedisClientInstance),
+			azureclient.NewStorageAccountClient(accountsClient, blobContainersClient, blobServicesClient, managementPoliciesClient),
+		), nil
+	}
+}
+
+type redisBackupClient struct {
+	azureclient.RedisClient
+	azureclient.StorageAccountClient
+}
+
+func newClient(redisClient azureclient.RedisClient, storageAccountClient azureclient.StorageAccountClient) Client {
+	return &redisBackupClient{
+		RedisClient:          redisClient,
+		StorageAccountClient: storageAccountClient,
+	}
+}
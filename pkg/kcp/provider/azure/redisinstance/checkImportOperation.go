@@ -0,0 +1,55 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func checkImportOperation(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	redisInstance := state.ObjAsRedisInstance()
+
+	resumeToken := redisInstance.Status.RestoreOpIdentifier
+	if resumeToken == "" {
+		return nil, ctx
+	}
+
+	done, err := state.client.GetImportRedisInstanceDataStatus(ctx, state.resourceGroupName, redisInstance.Name, resumeToken)
+	if err != nil && !done {
+		return composed.LogErrorAndReturn(err, "Error getting Azure Redis import status", composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+	}
+	if err != nil {
+		logger.Error(err, "Azure Redis import operation failed")
+		redisInstance.Status.State = v1beta1.StateError
+		return composed.UpdateStatus(redisInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Import operation failed: %s", err),
+			}).
+			SuccessError(composed.StopAndForget).
+			ErrorLogMessage("Error updating RedisInstance status due failed azure redis import").
+			Run(ctx, st)
+	}
+
+	if !done {
+		logger.Info("Redis import is not done yet, requeueing with delay")
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+
+	logger.Info("Redis instance restored", "blobUrl", redisInstance.Spec.Instance.Azure.ImportBlobUrl)
+	redisInstance.Status.RestoreOpIdentifier = ""
+	redisInstance.Status.RestoredFrom = redisInstance.Spec.Instance.Azure.ImportBlobUrl
+	return composed.UpdateStatus(redisInstance).
+		RemoveConditions(v1beta1.ConditionTypeRestoring).
+		SuccessErrorNil().
+		ErrorLogMessage("Failed to remove restoring condition from redis instance").
+		Run(ctx, st)
+}
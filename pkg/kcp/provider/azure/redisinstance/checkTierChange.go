@@ -0,0 +1,43 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func checkTierChange(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisInstance := state.ObjAsRedisInstance()
+
+	if state.azureRedisInstance == nil || state.azureRedisInstance.Properties == nil || state.azureRedisInstance.Properties.SKU == nil {
+		return nil, ctx
+	}
+
+	currentSku := state.azureRedisInstance.Properties.SKU
+	desiredSkuName, desiredSkuFamily := getSKUParams(state)
+
+	if ptr.Deref(currentSku.Family, "") == *desiredSkuFamily {
+		return nil, ctx
+	}
+
+	// Azure scales Basic caches only to Standard and Premium caches never back down,
+	// so moving between the Basic and Premium offerings always requires a new cache
+	redisInstance.Status.State = v1beta1.StateError
+	return composed.UpdateStatus(redisInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    v1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1beta1.ReasonUnsupportedTierChange,
+			Message: fmt.Sprintf("Changing the service tier from %s to %s is not supported", ptr.Deref(currentSku.Name, ""), *desiredSkuName),
+		}).
+		ErrorLogMessage("Error updating RedisInstance status with unsupported tier change").
+		SuccessLogMsg("Refused unsupported tier change of redis instance").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, st)
+}
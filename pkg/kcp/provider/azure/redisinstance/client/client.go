@@ -5,6 +5,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 )
 
@@ -12,6 +13,7 @@ type Client interface {
 	azureclient.RedisClient
 	azureclient.PrivateEndPointsClient
 	azureclient.PrivateDnsZoneGroupClient
+	azureclient.StorageAccountClient
 }
 
 func NewClientProvider() azureclient.ClientProvider[Client] {
@@ -38,10 +40,31 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 			return nil, err
 		}
 
+		accountsClient, err := armstorage.NewAccountsClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		blobContainersClient, err := armstorage.NewBlobContainersClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		blobServicesClient, err := armstorage.NewBlobServicesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		managementPoliciesClient, err := armstorage.NewManagementPoliciesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
 		return newClient(
 			azureclient.NewRedisClient(armRedisClientInstance),
 			azureclient.NewPrivateEndPointClient(privateEndPointsClient),
 			azureclient.NewPrivateDnsZoneGroupClient(privateDnsZoneGroupClient),
+			azureclient.NewStorageAccountClient(accountsClient, blobContainersClient, blobServicesClient, managementPoliciesClient),
 		), nil
 	}
 }
@@ -50,12 +73,14 @@ type redisInstanceClient struct {
 	azureclient.RedisClient
 	azureclient.PrivateEndPointsClient
 	azureclient.PrivateDnsZoneGroupClient
+	azureclient.StorageAccountClient
 }
 
-func newClient(redisClient azureclient.RedisClient, privateEndPointsClient azureclient.PrivateEndPointsClient, privateDnsZoneGroupClient azureclient.PrivateDnsZoneGroupClient) Client {
+func newClient(redisClient azureclient.RedisClient, privateEndPointsClient azureclient.PrivateEndPointsClient, privateDnsZoneGroupClient azureclient.PrivateDnsZoneGroupClient, storageAccountClient azureclient.StorageAccountClient) Client {
 	return &redisInstanceClient{
 		RedisClient:               redisClient,
 		PrivateEndPointsClient:    privateEndPointsClient,
 		PrivateDnsZoneGroupClient: privateDnsZoneGroupClient,
+		StorageAccountClient:      storageAccountClient,
 	}
 }
@@ -0,0 +1,37 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+// redisRemoved returns true if the Azure Redis cache of a Ready RedisInstance was removed out of band
+func redisRemoved(_ context.Context, st composed.State) bool {
+	return st.(*State).azureRedisInstance == nil
+}
+
+// detectDrift reports the Azure resources of a Ready RedisInstance that were removed out of band and the
+// changed capacity, which the create actions and modifyRedis are able to bring back to the spec
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	if state.azureRedisInstance == nil {
+		return []string{"redis"}
+	}
+
+	var fields []string
+	if props := state.azureRedisInstance.Properties; props != nil && props.SKU != nil &&
+		int(ptr.Deref(props.SKU.Capacity, 0)) != state.ObjAsRedisInstance().Spec.Instance.Azure.SKU.Capacity {
+		fields = append(fields, "sku.capacity")
+	}
+	if state.privateEndPoint == nil {
+		fields = append(fields, "privateEndPoint")
+	}
+	if state.privateDnsZoneGroup == nil {
+		fields = append(fields, "privateDnsZoneGroup")
+	}
+
+	return fields
+}
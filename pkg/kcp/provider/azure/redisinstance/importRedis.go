@@ -0,0 +1,81 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// importSasValidity is how long the blob SAS handed over to Azure Cache stays valid
+const importSasValidity = 24 * time.Hour
+
+func importRedis(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	redisInstance := state.ObjAsRedisInstance()
+
+	blobUrl := redisInstance.Spec.Instance.Azure.ImportBlobUrl
+	if blobUrl == "" || redisInstance.Status.RestoredFrom == blobUrl || redisInstance.Status.RestoreOpIdentifier != "" {
+		return nil, ctx
+	}
+
+	fileSasUrl, err := getBlobSasUrl(ctx, state, blobUrl)
+	if err == nil {
+		logger.Info("Importing RDB file into Azure Redis", "blobUrl", blobUrl)
+		redisInstance.Status.RestoreOpIdentifier, err = state.client.ImportRedisInstanceData(ctx, state.resourceGroupName, redisInstance.Name, []string{fileSasUrl})
+	}
+	if err != nil {
+		logger.Error(err, "Error importing RDB file into Azure Redis")
+		redisInstance.Status.State = v1beta1.StateError
+		return composed.UpdateStatus(redisInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    v1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  v1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Failed to import %s into RedisInstance", blobUrl),
+			}).
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			ErrorLogMessage("Error updating RedisInstance status due failed azure redis import").
+			Run(ctx, st)
+	}
+
+	return composed.UpdateStatus(redisInstance).
+		SetCondition(metav1.Condition{
+			Type:    v1beta1.ConditionTypeRestoring,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1beta1.ConditionTypeRestoring,
+			Message: fmt.Sprintf("Redis is being restored from %s.", blobUrl),
+		}).
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		ErrorLogMessage("Error updating RedisInstance status with restore operation").
+		Run(ctx, st)
+}
+
+// getBlobSasUrl returns the given blob url extended with a read SAS token of its container.
+// The storage account is expected in the cloud-manager resource group.
+func getBlobSasUrl(ctx context.Context, state *State, blobUrl string) (string, error) {
+	u, err := url.Parse(blobUrl)
+	if err != nil {
+		return "", fmt.Errorf("invalid blob url: %w", err)
+	}
+	accountName, _, _ := strings.Cut(u.Host, ".")
+	containerName, blobName, ok := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	if accountName == "" || !ok || blobName == "" {
+		return "", fmt.Errorf("invalid blob url %s", blobUrl)
+	}
+
+	sasUrl, err := state.client.GetBlobContainerSasUrl(ctx, state.resourceGroupName, accountName, containerName, time.Now().Add(importSasValidity))
+	if err != nil {
+		return "", err
+	}
+	containerUrl, sasToken, _ := strings.Cut(sasUrl, "?")
+
+	return fmt.Sprintf("%s/%s?%s", containerUrl, blobName, sasToken), nil
+}
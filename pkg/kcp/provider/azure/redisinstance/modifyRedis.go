@@ -61,7 +61,18 @@ func modifyRedis(ctx context.Context, st composed.State) (error, context.Context
 		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
 	}
 
-	return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+	requestedAzureRedisInstance.Status.PendingNodeType = state.GetDesiredMachineType()
+	requestedAzureRedisInstance.Status.State = v1beta1.StateUpdating
+	return composed.UpdateStatus(requestedAzureRedisInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    v1beta1.ConditionTypeUpdating,
+			Status:  metav1.ConditionTrue,
+			Reason:  v1beta1.ConditionTypeUpdating,
+			Message: fmt.Sprintf("Azure Redis is scaling to %s", requestedAzureRedisInstance.Status.PendingNodeType),
+		}).
+		ErrorLogMessage("Error updating RedisInstance status with updating condition").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T1000ms())).
+		Run(ctx, st)
 }
 
 func getUpdateParams(state *State) (armredis.UpdateParameters, bool) {
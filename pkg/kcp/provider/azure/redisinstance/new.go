@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -40,12 +41,16 @@ func New(stateFactory StateFactory) composed.Action {
 			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
 				composed.ComposeActions(
 					"azure-redisInstance-create",
+					drift.NewWithRemoved(drift.KindRedisInstance, detectDrift, redisRemoved),
 					createRedis,
 					updateStatusId,
+					checkImportOperation,
 					waitRedisAvailable,
+					importRedis,
 					createPrivateEndPoint,
 					waitPrivateEndPointAvailable,
 					createPrivateDnsZoneGroup,
+					checkTierChange,
 					modifyRedis,
 					updateStatus,
 				),
@@ -57,6 +62,7 @@ func New(stateFactory StateFactory) composed.Action {
 					waitPrivateDnsZoneGroupDeleted,
 					deletePrivateEndPoint,
 					waitPrivateEndPointDeleted,
+					drift.Forget(drift.KindRedisInstance),
 					actions.RemoveCommonFinalizer(),
 					composed.StopAndForgetAction,
 				),
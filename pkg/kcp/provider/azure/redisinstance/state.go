@@ -114,3 +114,9 @@ func (s *State) GetProvisionedReplicaCount() int32 {
 
 	return 0
 }
+
+// GetDesiredMachineType returns the machine type requested by the RedisInstance spec in the GetProvisionedMachineType format
+func (s *State) GetDesiredMachineType() string {
+	_, skuFamily := getSKUParams(s)
+	return fmt.Sprintf("%s%d", *skuFamily, s.ObjAsRedisInstance().Spec.Instance.Azure.SKU.Capacity)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/elliotchance/pie/v2"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -60,12 +61,17 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 		hasChanged = true
 	}
 
+	if redisInstance.Status.PendingNodeType != "" {
+		redisInstance.Status.PendingNodeType = ""
+		hasChanged = true
+	}
+
 	hasReadyCondition := meta.FindStatusCondition(redisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady) != nil
 	hasReadyStatusState := redisInstance.Status.State == cloudcontrolv1beta1.StateReady
 
 	if !hasChanged && hasReadyCondition && hasReadyStatusState {
 		composed.LoggerFromCtx(ctx).Info("RedisInstance status fields are already up-to-date, StopAndForget-ing")
-		return composed.StopAndForget, nil
+		return drift.StopAndForget(drift.KindRedisInstance), nil
 	}
 
 	redisInstance.Status.State = cloudcontrolv1beta1.StateReady
@@ -79,6 +85,6 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 		}).
 		ErrorLogMessage("Error updating KCP RedisInstance status after setting Ready condition").
 		SuccessLogMsg("KCP RedisInstance is ready").
-		SuccessError(composed.StopAndForget).
+		SuccessError(drift.StopAndForget(drift.KindRedisInstance)).
 		Run(ctx, state)
 }
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+)
+
+type Client interface {
+	azureclient.NetworkClient
+	azureclient.SecurityGroupsClient
+	azureclient.SecurityRulesClient
+	azureclient.FlowLogsClient
+	azureclient.StorageAccountClient
+	azureclient.KeyVaultClient
+}
+
+func NewClientProvider() azureclient.ClientProvider[Client] {
+	return func(ctx context.Context, clientId, clientSecret, subscriptionId, tenantId string, auxiliaryTenants ...string) (Client, error) {
+		cred, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, azureclient.NewCredentialOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		networkClientFactory, err := armnetwork.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		storageClientFactory, err := armstorage.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		keyVaultClientFactory, err := armkeyvault.NewClientFactory(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+		if err != nil {
+			return nil, err
+		}
+
+		return &client{
+			NetworkClient:        azureclient.NewNetworkClient(networkClientFactory.NewVirtualNetworksClient()),
+			SecurityGroupsClient: azureclient.NewSecurityGroupsClient(networkClientFactory.NewSecurityGroupsClient()),
+			SecurityRulesClient:  azureclient.NewSecurityRulesClient(networkClientFactory.NewSecurityRulesClient()),
+			FlowLogsClient:       azureclient.NewFlowLogsClient(networkClientFactory.NewFlowLogsClient()),
+			StorageAccountClient: azureclient.NewStorageAccountClient(
+				storageClientFactory.NewAccountsClient(),
+				storageClientFactory.NewBlobContainersClient(),
+				storageClientFactory.NewBlobServicesClient(),
+				storageClientFactory.NewManagementPoliciesClient(),
+			),
+			KeyVaultClient: azureclient.NewKeyVaultClient(
+				keyVaultClientFactory.NewVaultsClient(),
+				keyVaultClientFactory.NewKeysClient(),
+			),
+		}, nil
+	}
+}
+
+type client struct {
+	azureclient.NetworkClient
+	azureclient.SecurityGroupsClient
+	azureclient.SecurityRulesClient
+	azureclient.FlowLogsClient
+	azureclient.StorageAccountClient
+	azureclient.KeyVaultClient
+}
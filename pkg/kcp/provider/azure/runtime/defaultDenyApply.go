@@ -0,0 +1,62 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultDenyRuleName = "cloud-manager-deny-internet-inbound"
+	// defaultDenyPriority is the last priority available for custom rules, so the allow rules the cloud provider
+	// creates for the load balancer services take precedence
+	defaultDenyPriority int32 = 4096
+)
+
+// defaultDenyApply denies all inbound traffic from the internet into the worker subnets, that is not explicitly allowed
+func defaultDenyApply(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	sg, err := state.azureClient.GetSecurityGroup(ctx, state.ResourceGroupName(), state.WorkersSecurityGroupName())
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error loading Azure workers network security group")
+	}
+
+	var rules []*armnetwork.SecurityRule
+	if sg.Properties != nil {
+		rules = sg.Properties.SecurityRules
+	}
+	exists := slices.ContainsFunc(rules, func(r *armnetwork.SecurityRule) bool {
+		return ptr.Deref(r.Name, "") == defaultDenyRuleName
+	})
+	if !exists {
+		err = state.azureClient.CreateOrUpdateSecurityRule(ctx, state.ResourceGroupName(), state.WorkersSecurityGroupName(), defaultDenyRuleName, armnetwork.SecurityRule{
+			Name: new(defaultDenyRuleName),
+			Properties: &armnetwork.SecurityRulePropertiesFormat{
+				Description:              new(fmt.Sprintf("Default deny internet inbound of the runtime %s", state.ObjAsRuntime().Name)),
+				Access:                   ptr.To(armnetwork.SecurityRuleAccessDeny),
+				Direction:                ptr.To(armnetwork.SecurityRuleDirectionInbound),
+				Priority:                 new(defaultDenyPriority),
+				Protocol:                 ptr.To(armnetwork.SecurityRuleProtocolAsterisk),
+				SourceAddressPrefix:      new("Internet"),
+				SourcePortRange:          new("*"),
+				DestinationAddressPrefix: new("*"),
+				DestinationPortRange:     new("*"),
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error creating Azure default deny security rule")
+		}
+		logger.
+			WithValues("securityGroup", state.WorkersSecurityGroupName()).
+			Info("Azure default deny security rule created")
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, fmt.Sprintf("Network security group %s denies internet inbound", state.WorkersSecurityGroupName()))
+}
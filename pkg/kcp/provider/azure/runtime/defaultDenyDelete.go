@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"k8s.io/utils/ptr"
+)
+
+func defaultDenyDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	sg, err := state.azureClient.GetSecurityGroup(ctx, state.ResourceGroupName(), state.WorkersSecurityGroupName())
+	if azuremeta.IsNotFound(err) {
+		// the shoot is already deleted and the security group with it
+		return nil, ctx
+	}
+	if err != nil {
+		return azuremeta.LogErrorAndReturn(err, "Error loading Azure workers network security group", ctx)
+	}
+
+	var rules []*armnetwork.SecurityRule
+	if sg.Properties != nil {
+		rules = sg.Properties.SecurityRules
+	}
+	exists := slices.ContainsFunc(rules, func(r *armnetwork.SecurityRule) bool {
+		return ptr.Deref(r.Name, "") == defaultDenyRuleName
+	})
+	if !exists {
+		return nil, ctx
+	}
+
+	err = state.azureClient.DeleteSecurityRule(ctx, state.ResourceGroupName(), state.WorkersSecurityGroupName(), defaultDenyRuleName)
+	if err != nil && !azuremeta.IsNotFound(err) {
+		return azuremeta.LogErrorAndReturn(err, "Error deleting Azure default deny security rule", ctx)
+	}
+
+	logger.
+		WithValues("securityGroup", state.WorkersSecurityGroupName()).
+		Info("Azure default deny security rule deleted")
+
+	return nil, ctx
+}
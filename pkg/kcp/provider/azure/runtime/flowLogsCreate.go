@@ -0,0 +1,91 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// flowLogsCreate delivers VNet flow logs of the shoot network into a dedicated storage account in the shoot resource group
+func flowLogsCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	account, err := state.azureClient.GetStorageAccount(ctx, state.ResourceGroupName(), state.FlowLogsStorageAccountName())
+	if azuremeta.IsNotFound(err) {
+		err = state.azureClient.CreateStorageAccount(ctx, state.ResourceGroupName(), state.FlowLogsStorageAccountName(), armstorage.AccountCreateParameters{
+			Kind:     ptr.To(armstorage.KindStorageV2),
+			Location: state.vnet.Location,
+			SKU: &armstorage.SKU{
+				Name: ptr.To(armstorage.SKUNameStandardLRS),
+			},
+			Tags: azureutil.AzureTags(map[string]string{
+				common.TagCloudManagerName: state.Name().String(),
+				common.TagShoot:            state.ObjAsRuntime().Spec.Shoot.Name,
+			}),
+			Properties: &armstorage.AccountPropertiesCreateParameters{
+				AllowBlobPublicAccess:  new(false),
+				EnableHTTPSTrafficOnly: new(true),
+				MinimumTLSVersion:      ptr.To(armstorage.MinimumTLSVersionTLS12),
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error creating Azure flow logs storage account")
+		}
+		logger.
+			WithValues("storageAccount", state.FlowLogsStorageAccountName()).
+			Info("Azure flow logs storage account created")
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error loading Azure flow logs storage account")
+	}
+	if account.Properties == nil || ptr.Deref(account.Properties.ProvisioningState, "") != armstorage.ProvisioningStateSucceeded {
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+
+	flowLog, err := state.azureClient.GetFlowLog(ctx, networkWatcherResourceGroupName, state.NetworkWatcherName(), state.FlowLogName())
+	if err != nil && !azuremeta.IsNotFound(err) {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error loading Azure VNet flow log")
+	}
+	if flowLog == nil || flowLog.Properties == nil || !ptr.Deref(flowLog.Properties.Enabled, false) {
+		err = state.azureClient.CreateOrUpdateFlowLog(ctx, networkWatcherResourceGroupName, state.NetworkWatcherName(), state.FlowLogName(), armnetwork.FlowLog{
+			Location: state.vnet.Location,
+			Tags: azureutil.AzureTags(map[string]string{
+				common.TagCloudManagerName: state.Name().String(),
+				common.TagShoot:            state.ObjAsRuntime().Spec.Shoot.Name,
+			}),
+			Properties: &armnetwork.FlowLogPropertiesFormat{
+				TargetResourceID: state.vnet.ID,
+				StorageID:        account.ID,
+				Enabled:          new(true),
+				Format: &armnetwork.FlowLogFormatParameters{
+					Type:    ptr.To(armnetwork.FlowLogFormatTypeJSON),
+					Version: new(int32(2)),
+				},
+				RetentionPolicy: &armnetwork.RetentionPolicyParameters{
+					Enabled: new(true),
+					Days:    new(int32(90)),
+				},
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error creating Azure VNet flow log")
+		}
+		logger.
+			WithValues("flowLog", state.FlowLogName()).
+			Info("Azure VNet flow log created")
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, fmt.Sprintf("VNet flow logs delivered to storage account %s", state.FlowLogsStorageAccountName()))
+}
@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+// flowLogsDelete deletes the VNet flow log and then its storage account. The flow log lives in the
+// network watcher resource group, so it is not deleted together with the shoot resource group.
+func flowLogsDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	_, err := state.azureClient.GetFlowLog(ctx, networkWatcherResourceGroupName, state.NetworkWatcherName(), state.FlowLogName())
+	if err != nil && !azuremeta.IsNotFound(err) {
+		return azuremeta.LogErrorAndReturn(err, "Error loading Azure VNet flow log", ctx)
+	}
+	if err == nil {
+		err = state.azureClient.DeleteFlowLog(ctx, networkWatcherResourceGroupName, state.NetworkWatcherName(), state.FlowLogName())
+		if err != nil && !azuremeta.IsNotFound(err) {
+			return azuremeta.LogErrorAndReturn(err, "Error deleting Azure VNet flow log", ctx)
+		}
+		logger.
+			WithValues("flowLog", state.FlowLogName()).
+			Info("Azure VNet flow log deleted")
+	}
+
+	_, err = state.azureClient.GetStorageAccount(ctx, state.ResourceGroupName(), state.FlowLogsStorageAccountName())
+	if azuremeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		return azuremeta.LogErrorAndReturn(err, "Error loading Azure flow logs storage account", ctx)
+	}
+
+	err = state.azureClient.DeleteStorageAccount(ctx, state.ResourceGroupName(), state.FlowLogsStorageAccountName())
+	if err != nil && !azuremeta.IsNotFound(err) {
+		return azuremeta.LogErrorAndReturn(err, "Error deleting Azure flow logs storage account", ctx)
+	}
+	logger.
+		WithValues("storageAccount", state.FlowLogsStorageAccountName()).
+		Info("Azure flow logs storage account deleted")
+
+	return nil, ctx
+}
@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+// keyVaultDelete deletes the key vault with the runtime key. The vault has purge protection enabled, so
+// it stays recoverable in the soft deleted state until it is purged after the retention period.
+func keyVaultDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	_, err := state.azureClient.GetKeyVault(ctx, state.ResourceGroupName(), state.KeyVaultName())
+	if azuremeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		return azuremeta.LogErrorAndReturn(err, "Error loading Azure key vault", ctx)
+	}
+
+	err = state.azureClient.DeleteKeyVault(ctx, state.ResourceGroupName(), state.KeyVaultName())
+	if err != nil && !azuremeta.IsNotFound(err) {
+		return azuremeta.LogErrorAndReturn(err, "Error deleting Azure key vault", ctx)
+	}
+
+	logger.
+		WithValues("keyVault", state.KeyVaultName()).
+		Info("Azure key vault deleted")
+
+	return nil, ctx
+}
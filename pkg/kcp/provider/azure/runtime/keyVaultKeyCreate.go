@@ -0,0 +1,74 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func keyVaultKeyCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	vault, err := state.azureClient.GetKeyVault(ctx, state.ResourceGroupName(), state.KeyVaultName())
+	if azuremeta.IsNotFound(err) {
+		err = state.azureClient.CreateKeyVault(ctx, state.ResourceGroupName(), state.KeyVaultName(), ptr.Deref(state.vnet.Location, ""), state.TenantId(), map[string]string{
+			common.TagCloudManagerName: state.Name().String(),
+			common.TagShoot:            state.ObjAsRuntime().Spec.Shoot.Name,
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error creating Azure key vault")
+		}
+		logger.
+			WithValues("keyVault", state.KeyVaultName()).
+			Info("Azure key vault created")
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+	if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error loading Azure key vault")
+	}
+	if vault.Properties == nil || ptr.Deref(vault.Properties.ProvisioningState, "") != armkeyvault.VaultProvisioningStateSucceeded {
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx
+	}
+
+	key, err := state.azureClient.GetKeyVaultKey(ctx, state.ResourceGroupName(), state.KeyVaultName(), keyVaultKeyName)
+	if azuremeta.IsNotFound(err) {
+		key, err = state.azureClient.CreateKeyVaultKey(ctx, state.ResourceGroupName(), state.KeyVaultName(), keyVaultKeyName, armkeyvault.KeyProperties{
+			Kty:     ptr.To(armkeyvault.JSONWebKeyTypeRSA),
+			KeySize: new(int32(3072)),
+			RotationPolicy: &armkeyvault.RotationPolicy{
+				Attributes: &armkeyvault.KeyRotationPolicyAttributes{
+					ExpiryTime: new("P1Y"),
+				},
+				LifetimeActions: []*armkeyvault.LifetimeAction{
+					{
+						Action:  &armkeyvault.Action{Type: ptr.To(armkeyvault.KeyRotationPolicyActionTypeRotate)},
+						Trigger: &armkeyvault.Trigger{TimeAfterCreate: new("P90D")},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error creating Azure key vault key")
+		}
+		logger.
+			WithValues("keyVault", state.KeyVaultName()).
+			Info("Azure key vault key created")
+	} else if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, err, "Error loading Azure key vault key")
+	}
+
+	keyUri := ""
+	if key.Properties != nil {
+		keyUri = ptr.Deref(key.Properties.KeyURI, "")
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeKmsKeyReady, fmt.Sprintf("KMS key %s", keyUri))
+}
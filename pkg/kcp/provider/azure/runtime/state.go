@@ -2,18 +2,114 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	azureruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/runtime/client"
 	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// networkWatcherResourceGroupName is the resource group Azure automatically creates the regional network watchers in
+	networkWatcherResourceGroupName = "NetworkWatcherRG"
+	keyVaultKeyName                 = "cloud-manager"
 )
 
 type State struct {
 	runtimetypes.State
+
+	azureClient azureruntimeclient.Client
+
+	vnet *armnetwork.VirtualNetwork
 }
 
 type StateFactory interface {
 	NewState(ctx context.Context, runtimeState runtimetypes.State) (*State, error)
 }
 
-func NewStateFactory() StateFactory {
-	return nil
+func NewStateFactory(azureClientProvider azureclient.ClientProvider[azureruntimeclient.Client]) StateFactory {
+	return &stateFactory{
+		azureClientProvider: azureClientProvider,
+	}
+}
+
+type stateFactory struct {
+	azureClientProvider azureclient.ClientProvider[azureruntimeclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, runtimeState runtimetypes.State) (*State, error) {
+	subscription := runtimeState.Subscription()
+	if subscription.Status.Provider != cloudcontrolv1beta1.ProviderAzure {
+		return nil, fmt.Errorf("subscription for Runtime must be of provider Azure, but subscription %q is of provider %q", subscription.Name, subscription.Status.Provider)
+	}
+
+	c, err := f.azureClientProvider(
+		ctx,
+		azureconfig.AzureConfig.DefaultCreds.ClientId,
+		azureconfig.AzureConfig.DefaultCreds.ClientSecret,
+		subscription.Status.SubscriptionInfo.Azure.SubscriptionId,
+		subscription.Status.SubscriptionInfo.Azure.TenantId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating azure client: %w", err)
+	}
+
+	return newState(runtimeState, c), nil
+}
+
+func newState(runtimeState runtimetypes.State, azureClient azureruntimeclient.Client) *State {
+	return &State{
+		State:       runtimeState,
+		azureClient: azureClient,
+	}
+}
+
+func (s *State) TenantId() string {
+	return s.Subscription().Status.SubscriptionInfo.Azure.TenantId
+}
+
+// ResourceGroupName is the shoot resource group, Gardener names it same as the shoot vnet
+func (s *State) ResourceGroupName() string {
+	return s.ShootVpcName()
+}
+
+// nameHash is a short stable hash of the runtime name, for Azure resources with tight, globally unique naming rules
+func (s *State) nameHash() string {
+	sum := sha256.Sum256([]byte(s.ObjAsRuntime().Name))
+	return hex.EncodeToString(sum[:])
+}
+
+// KeyVaultName is globally unique and at most 24 alphanumerics and hyphens long
+func (s *State) KeyVaultName() string {
+	return fmt.Sprintf("cm-%s", s.nameHash()[:16])
+}
+
+// FlowLogsStorageAccountName is globally unique and at most 24 lowercase alphanumerics long
+func (s *State) FlowLogsStorageAccountName() string {
+	return fmt.Sprintf("cmfl%s", s.nameHash()[:20])
+}
+
+// NetworkWatcherName is the network watcher Azure creates in the location of the shoot. The shoot
+// region is used when the VNet is already deleted.
+func (s *State) NetworkWatcherName() string {
+	location := s.ObjAsRuntime().Spec.Shoot.Region
+	if s.vnet != nil {
+		location = ptr.Deref(s.vnet.Location, location)
+	}
+	return fmt.Sprintf("NetworkWatcher_%s", location)
+}
+
+func (s *State) FlowLogName() string {
+	return fmt.Sprintf("cloud-manager-%s", s.ObjAsRuntime().Name)
+}
+
+// WorkersSecurityGroupName is the network security group Gardener attaches to the shoot worker subnets
+func (s *State) WorkersSecurityGroupName() string {
+	return fmt.Sprintf("%s-workers", s.ShootVpcName())
 }
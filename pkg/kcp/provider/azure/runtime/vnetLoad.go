@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func vnetLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	vnet, err := state.azureClient.GetNetwork(ctx, state.ResourceGroupName(), state.ShootVpcName())
+	if azuremeta.IsNotFound(err) && composed.MarkedForDeletionPredicate(ctx, state) {
+		// the shoot is already deleted, the cloud-manager resources in the shoot resource group with it
+		logger.Info("Shoot VNet not found")
+		return nil, ctx
+	}
+	if azuremeta.IsNotFound(err) {
+		// the shoot is not provisioned yet
+		logger.Info("Shoot VNet not found")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+	}
+	if err != nil {
+		return azuremeta.LogErrorAndReturn(err, "Error loading Azure shoot VNet", ctx)
+	}
+
+	state.vnet = vnet
+
+	return nil, ctx
+}
@@ -223,6 +223,46 @@ func NewPrivateDnsZoneName() string {
 	return "privatelink.redis.cache.windows.net"
 }
 
+// NewPostgresServerResourceId /subscriptions/subId/resourceGroups/rg1/providers/Microsoft.DBforPostgreSQL/flexibleServers/server1
+func NewPostgresServerResourceId(subscription, resourceGroup, serverName string) *ResourceDetails {
+	return &ResourceDetails{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Provider:      "Microsoft.DBforPostgreSQL",
+		ResourceType:  "flexibleServers",
+		ResourceName:  serverName,
+		valid:         len(subscription) > 0 && len(resourceGroup) > 0 && len(serverName) > 0,
+	}
+}
+
+// NewPostgresPrivateDnsZoneName is the recommended private DNS zone name for the PostgreSQL flexible server private endpoints
+func NewPostgresPrivateDnsZoneName() string {
+	if azureconfig.AzureConfig.ClientOptions.Cloud == "AzureChina" {
+		return "privatelink.postgres.database.chinacloudapi.cn"
+	}
+	return "privatelink.postgres.database.azure.com"
+}
+
+// NewStorageAccountResourceId /subscriptions/subId/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1
+func NewStorageAccountResourceId(subscription, resourceGroup, storageAccountName string) *ResourceDetails {
+	return &ResourceDetails{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Provider:      "Microsoft.Storage",
+		ResourceType:  "storageAccounts",
+		ResourceName:  storageAccountName,
+		valid:         len(subscription) > 0 && len(resourceGroup) > 0 && len(storageAccountName) > 0,
+	}
+}
+
+// NewBlobPrivateDnsZoneName is the recommended private DNS zone name for the storage account blob private endpoints
+func NewBlobPrivateDnsZoneName() string {
+	if azureconfig.AzureConfig.ClientOptions.Cloud == "AzureChina" {
+		return "privatelink.blob.core.chinacloudapi.cn"
+	}
+	return "privatelink.blob.core.windows.net"
+}
+
 // NewPrivateDnsZoneGroupResourceId /subscriptions/subId/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/zone1.com
 func NewPrivateDnsZoneGroupResourceId(subscription, resourceGroup, privateDnsZoneInstanceName string) *ResourceDetails {
 	return &ResourceDetails{
@@ -305,3 +345,29 @@ func NewDnsForwardingRulesetResourceId(subscription, resourceGroup, dnsForwardin
 		valid:         len(subscription) > 0 && len(resourceGroup) > 0 && len(dnsForwardingRulesetName) > 0,
 	}
 }
+
+// NewKeyVaultResourceId /subscriptions/subId/resourceGroups/rg1/providers/Microsoft.KeyVault/vaults/vault1
+func NewKeyVaultResourceId(subscription, resourceGroup, vaultName string) *ResourceDetails {
+	return &ResourceDetails{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Provider:      "Microsoft.KeyVault",
+		ResourceType:  "vaults",
+		ResourceName:  vaultName,
+		valid:         len(subscription) > 0 && len(resourceGroup) > 0 && len(vaultName) > 0,
+	}
+}
+
+// NewFlowLogResourceId /subscriptions/subId/resourceGroups/NetworkWatcherRG/providers/Microsoft.Network/networkWatchers/NetworkWatcher_westeurope/flowLogs/flowLog1
+func NewFlowLogResourceId(subscription, resourceGroup, networkWatcherName, flowLogName string) *ResourceDetails {
+	return &ResourceDetails{
+		Subscription:    subscription,
+		ResourceGroup:   resourceGroup,
+		Provider:        "Microsoft.Network",
+		ResourceType:    "networkWatchers",
+		ResourceName:    networkWatcherName,
+		SubResourceType: "flowLogs",
+		SubResourceName: flowLogName,
+		valid:           len(subscription) > 0 && len(resourceGroup) > 0 && len(networkWatcherName) > 0 && len(flowLogName) > 0,
+	}
+}
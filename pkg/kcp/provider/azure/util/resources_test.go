@@ -168,3 +168,15 @@ func TestRedisResourceId(t *testing.T) {
 	actual := NewRedisInstanceResourceId("10375acd-7dc2-47f3-adf2-4627c93b7d36", "MyRG08", "MyRedis08").String()
 	assert.Equal(t, expected, actual)
 }
+
+func TestPostgresServerResourceId(t *testing.T) {
+	expected := "/subscriptions/10375acd-7dc2-47f3-adf2-4627c93b7d36/resourceGroups/MyRG08/providers/Microsoft.DBforPostgreSQL/flexibleServers/MyPostgres08"
+	actual := NewPostgresServerResourceId("10375acd-7dc2-47f3-adf2-4627c93b7d36", "MyRG08", "MyPostgres08").String()
+	assert.Equal(t, expected, actual)
+}
+
+func TestStorageAccountResourceId(t *testing.T) {
+	expected := "/subscriptions/10375acd-7dc2-47f3-adf2-4627c93b7d36/resourceGroups/MyRG08/providers/Microsoft.Storage/storageAccounts/mystorage08"
+	actual := NewStorageAccountResourceId("10375acd-7dc2-47f3-adf2-4627c93b7d36", "MyRG08", "mystorage08").String()
+	assert.Equal(t, expected, actual)
+}
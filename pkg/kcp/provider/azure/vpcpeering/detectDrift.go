@@ -0,0 +1,30 @@
+package vpcpeering
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v5"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/utils/ptr"
+)
+
+// detectDrift reports peerings that are not connected on either side. The removed ones are
+// recreated by peeringLocalCreate and peeringRemoteCreate.
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	var fields []string
+	if !isPeeringConnected(state.localPeering) {
+		fields = append(fields, "localPeering")
+	}
+	if !isPeeringConnected(state.remotePeering) {
+		fields = append(fields, "remotePeering")
+	}
+
+	return fields
+}
+
+func isPeeringConnected(peering *armnetwork.VirtualNetworkPeering) bool {
+	return peering != nil && peering.Properties != nil &&
+		ptr.Deref(peering.Properties.PeeringState, "") == armnetwork.VirtualNetworkPeeringStateConnected
+}
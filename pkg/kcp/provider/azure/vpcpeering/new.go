@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering/types"
 )
 
@@ -34,11 +35,13 @@ func New(stateFactory StateFactory) composed.Action {
 					"azureVpcPeering-delete",
 					peeringRemoteDelete,
 					deleteVpcPeering,
+					drift.Forget(drift.KindVpcPeering),
 					actions.PatchRemoveCommonFinalizer(),
 				),
 				composed.ComposeActions(
 					"azureVpcPeering-non-delete",
 					actions.PatchAddCommonFinalizer(),
+					drift.New(drift.KindVpcPeering, detectDrift),
 					peeringRemoteRequireSpecifiedName,
 					composed.If(
 						predicateRequireVNetShootTag,
@@ -50,7 +53,7 @@ func New(stateFactory StateFactory) composed.Action {
 					peeringRemoteCreate,
 					peeringLocalWaitReady,
 					statusReady,
-					composed.StopAndForgetAction,
+					drift.StopAndForgetAction(drift.KindVpcPeering),
 				),
 			),
 			composed.StopAndForgetAction,
@@ -4,6 +4,7 @@ import (
 	"context"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -51,6 +52,6 @@ func statusReady(ctx context.Context, st composed.State) (error, context.Context
 	return composed.PatchStatus(state.ObjAsVpcPeering()).
 		ErrorLogMessage("Error patching KCP VpcPeering status to ready").
 		SuccessLogMsg("Success patching KCP VpcPeering status to ready").
-		SuccessError(composed.StopAndForget).
+		SuccessError(drift.StopAndForget(drift.KindVpcPeering)).
 		Run(ctx, state)
 }
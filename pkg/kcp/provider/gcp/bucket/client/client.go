@@ -0,0 +1,22 @@
+package client
+
+import gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+
+type Client interface {
+	gcpclient.StorageClient
+	gcpclient.IamServiceAccountClient
+}
+
+func NewClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProvider[Client] {
+	return func(_ string) Client {
+		return &client{
+			StorageClient:           gcpClients.StorageWrapped(),
+			IamServiceAccountClient: gcpClients.IamServiceAccountWrapped(),
+		}
+	}
+}
+
+type client struct {
+	gcpclient.StorageClient
+	gcpclient.IamServiceAccountClient
+}
@@ -0,0 +1,70 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/storage/v1"
+)
+
+func createBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.bucket != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating GCP Cloud Storage bucket")
+
+	gcpScope := state.Scope().Spec.Scope.Gcp
+	gcpSpec := state.ObjAsBucket().Spec.Bucket.Gcp
+
+	labels := util.NewLabelBuilder().WithGcpLabels(
+		state.ObjAsBucket().Spec.Scope.Name,
+		state.Scope().Spec.ShootName,
+	).Build()
+
+	bucket := &storage.Bucket{
+		Name:     state.GetRemoteBucketName(),
+		Location: state.Scope().Spec.Region,
+		Labels:   labels,
+		IamConfiguration: &storage.BucketIamConfiguration{
+			UniformBucketLevelAccess: &storage.BucketIamConfigurationUniformBucketLevelAccess{
+				Enabled: true,
+			},
+			PublicAccessPrevention: "enforced",
+		},
+		// only requests from the kyma VPC are allowed
+		IpFilter: &storage.BucketIpFilter{
+			Mode:                       "Enabled",
+			AllowAllServiceAgentAccess: true,
+			VpcNetworkSources: []*storage.BucketIpFilterVpcNetworkSources{
+				{
+					Network:             gcputil.NewGlobalNetworkName(gcpScope.Project, gcpScope.VpcNetwork).String(),
+					AllowedIpCidrRanges: []string{"0.0.0.0/0"},
+				},
+			},
+			ForceSendFields: []string{"AllowAllServiceAgentAccess"},
+		},
+		Versioning: GetBucketVersioning(gcpSpec),
+		Lifecycle:  GetBucketLifecycle(gcpSpec),
+	}
+	if gcpSpec.RetentionDays > 0 {
+		bucket.RetentionPolicy = &storage.BucketRetentionPolicy{
+			RetentionPeriod: int64(gcpSpec.RetentionDays) * secondsPerDay,
+		}
+	}
+
+	created, err := state.gcpClient.InsertStorageBucket(ctx, gcpScope.Project, bucket)
+	if err != nil {
+		logger.Error(err, "Error creating GCP Cloud Storage bucket")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to create Bucket", "Error updating Bucket status due failed gcp bucket creation")
+	}
+
+	state.bucket = created
+
+	return nil, ctx
+}
@@ -0,0 +1,45 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"google.golang.org/api/storage/v1"
+)
+
+// createHmacKey creates the HMAC key of the bucket service account and saves it in the status, since the secret
+// can be obtained only at creation. If the key saved in the status does not exist any more, a new one is created.
+func createHmacKey(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	bucket := state.ObjAsBucket()
+
+	keyExists := pie.Any(state.hmacKeys, func(k *storage.HmacKeyMetadata) bool {
+		return k.AccessId == bucket.Status.AccessKeyId && k.State == "ACTIVE"
+	})
+	if keyExists && bucket.Status.SecretAccessKey != "" {
+		return nil, ctx
+	}
+
+	logger.Info("Creating GCP bucket HMAC key")
+
+	key, err := state.gcpClient.CreateStorageHmacKey(ctx, state.GetProjectId(), state.GetServiceAccountEmail())
+	if err != nil {
+		logger.Error(err, "Error creating GCP bucket HMAC key")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to create Bucket HMAC key", "Error updating Bucket status due failed gcp hmac key creation")
+	}
+
+	bucket.Status.AccessKeyId = key.Metadata.AccessId
+	bucket.Status.SecretAccessKey = key.Secret
+
+	err = state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating Bucket status with HMAC key", composed.StopWithRequeue, ctx)
+	}
+
+	state.hmacKeys = append(state.hmacKeys, key.Metadata)
+
+	return nil, ctx
+}
@@ -0,0 +1,29 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func createServiceAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.serviceAccount != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating GCP bucket service account")
+
+	sa, err := state.gcpClient.CreateServiceAccount(ctx, state.GetProjectId(), state.GetServiceAccountId(), fmt.Sprintf("Bucket %s", state.GetRemoteBucketName()))
+	if err != nil {
+		logger.Error(err, "Error creating GCP bucket service account")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to create Bucket service account", "Error updating Bucket status due failed gcp service account creation")
+	}
+
+	state.serviceAccount = sa
+
+	return nil, ctx
+}
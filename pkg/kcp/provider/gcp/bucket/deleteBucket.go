@@ -0,0 +1,51 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"google.golang.org/api/storage/v1"
+)
+
+// deleteBucket removes the retention policy, so objects under retention can be deleted, then deletes all objects
+// and finally the bucket itself
+func deleteBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.bucket == nil {
+		return nil, ctx
+	}
+
+	if GetRetentionPeriodSeconds(state.bucket) > 0 {
+		logger.Info("Removing GCP Cloud Storage bucket retention policy")
+
+		bucket, err := state.gcpClient.PatchStorageBucket(ctx, state.GetRemoteBucketName(), &storage.Bucket{
+			NullFields: []string{"RetentionPolicy"},
+		})
+		if err != nil {
+			logger.Error(err, "Error removing GCP Cloud Storage bucket retention policy")
+			return updateStatusWithCloudProviderError(ctx, state, "Failed to delete Bucket", "Error updating Bucket status due failed gcp bucket retention policy removal")
+		}
+		state.bucket = bucket
+	}
+
+	logger.Info("Deleting GCP Cloud Storage bucket")
+
+	err := state.gcpClient.DeleteStorageBucketObjects(ctx, state.GetRemoteBucketName())
+	if err != nil && !gcpmeta.IsNotFound(err) {
+		logger.Error(err, "Error deleting GCP Cloud Storage bucket objects")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to delete Bucket", "Error updating Bucket status due failed gcp bucket objects deletion")
+	}
+
+	err = state.gcpClient.DeleteStorageBucket(ctx, state.GetRemoteBucketName())
+	if err != nil && !gcpmeta.IsNotFound(err) {
+		logger.Error(err, "Error deleting GCP Cloud Storage bucket")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to delete Bucket", "Error updating Bucket status due failed gcp bucket deletion")
+	}
+
+	state.bucket = nil
+
+	return nil, ctx
+}
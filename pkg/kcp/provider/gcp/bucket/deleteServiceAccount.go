@@ -0,0 +1,29 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+)
+
+func deleteServiceAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.serviceAccount == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting GCP bucket service account")
+
+	err := state.gcpClient.DeleteServiceAccount(ctx, state.GetProjectId(), state.GetServiceAccountId())
+	if err != nil && !gcpmeta.IsNotFound(err) {
+		logger.Error(err, "Error deleting GCP bucket service account")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to delete Bucket service account", "Error updating Bucket status due failed gcp service account deletion")
+	}
+
+	state.serviceAccount = nil
+
+	return nil, ctx
+}
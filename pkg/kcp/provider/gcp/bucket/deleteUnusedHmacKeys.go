@@ -0,0 +1,42 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// deleteUnusedHmacKeys deletes all HMAC keys of the bucket service account except the one saved in the status.
+// On deletion the status key is not kept, so all keys are deleted.
+func deleteUnusedHmacKeys(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	keep := state.ObjAsBucket().Status.AccessKeyId
+	if composed.MarkedForDeletionPredicate(ctx, state) {
+		keep = ""
+	}
+
+	for _, k := range state.hmacKeys {
+		if k.AccessId == keep {
+			continue
+		}
+
+		logger.WithValues("accessId", k.AccessId).Info("Deleting GCP bucket HMAC key")
+
+		if k.State == "ACTIVE" {
+			err := state.gcpClient.DeactivateStorageHmacKey(ctx, state.GetProjectId(), k.AccessId)
+			if err != nil {
+				logger.Error(err, "Error deactivating GCP bucket HMAC key")
+				return updateStatusWithCloudProviderError(ctx, state, "Failed to delete Bucket HMAC key", "Error updating Bucket status due failed gcp hmac key deactivation")
+			}
+		}
+		err := state.gcpClient.DeleteStorageHmacKey(ctx, state.GetProjectId(), k.AccessId)
+		if err != nil {
+			logger.Error(err, "Error deleting GCP bucket HMAC key")
+			return updateStatusWithCloudProviderError(ctx, state, "Failed to delete Bucket HMAC key", "Error updating Bucket status due failed gcp hmac key deletion")
+		}
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,34 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+)
+
+func loadBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.bucket != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Loading GCP Cloud Storage bucket")
+
+	bucket, err := state.gcpClient.GetStorageBucket(ctx, state.GetRemoteBucketName())
+	if err != nil {
+		if gcpmeta.IsNotFound(err) {
+			logger.Info("target Cloud Storage bucket not found, continuing")
+			return nil, ctx
+		}
+
+		logger.Error(err, "Error loading GCP Cloud Storage bucket")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to load Bucket", "Error updating Bucket status due failed gcp bucket loading")
+	}
+
+	state.bucket = bucket
+
+	return nil, ctx
+}
@@ -0,0 +1,34 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+)
+
+func loadServiceAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	sa, err := state.gcpClient.GetServiceAccount(ctx, state.GetProjectId(), state.GetServiceAccountId())
+	if gcpmeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		logger.Error(err, "Error loading GCP bucket service account")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to load Bucket service account", "Error updating Bucket status due failed gcp service account loading")
+	}
+
+	state.serviceAccount = sa
+
+	hmacKeys, err := state.gcpClient.ListStorageHmacKeys(ctx, state.GetProjectId(), state.GetServiceAccountEmail())
+	if err != nil {
+		logger.Error(err, "Error loading GCP bucket HMAC keys")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to load Bucket HMAC keys", "Error updating Bucket status due failed gcp hmac keys loading")
+	}
+
+	state.hmacKeys = hmacKeys
+
+	return nil, ctx
+}
@@ -0,0 +1,52 @@
+package bucket
+
+import (
+	"context"
+	"slices"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"google.golang.org/api/storage/v1"
+)
+
+// modifyBucketIamPolicy grants the bucket service account the object admin role on the bucket
+func modifyBucketIamPolicy(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	policy, err := state.gcpClient.GetStorageBucketIamPolicy(ctx, state.GetRemoteBucketName())
+	if err != nil {
+		logger.Error(err, "Error loading GCP Cloud Storage bucket IAM policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to load Bucket IAM policy", "Error updating Bucket status due failed gcp bucket iam policy loading")
+	}
+
+	member := "serviceAccount:" + state.GetServiceAccountEmail()
+
+	for _, b := range policy.Bindings {
+		if b.Role == bucketObjectRole && slices.Contains(b.Members, member) {
+			return nil, ctx
+		}
+	}
+
+	logger.Info("Setting GCP Cloud Storage bucket IAM policy")
+
+	var binding *storage.PolicyBindings
+	for _, b := range policy.Bindings {
+		if b.Role == bucketObjectRole {
+			binding = b
+			break
+		}
+	}
+	if binding == nil {
+		binding = &storage.PolicyBindings{Role: bucketObjectRole}
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+	binding.Members = append(binding.Members, member)
+
+	_, err = state.gcpClient.SetStorageBucketIamPolicy(ctx, state.GetRemoteBucketName(), policy)
+	if err != nil {
+		logger.Error(err, "Error setting GCP Cloud Storage bucket IAM policy")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to set Bucket IAM policy", "Error updating Bucket status due failed gcp bucket iam policy update")
+	}
+
+	return nil, ctx
+}
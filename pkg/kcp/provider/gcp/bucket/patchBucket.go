@@ -0,0 +1,63 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"google.golang.org/api/storage/v1"
+)
+
+// patchBucket applies versioning, lifecycle and retention changes of the spec to the bucket in a single patch
+func patchBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	gcpSpec := state.ObjAsBucket().Spec.Bucket.Gcp
+
+	patch := &storage.Bucket{}
+	changed := false
+
+	currentVersioning := state.bucket.Versioning != nil && state.bucket.Versioning.Enabled
+	if currentVersioning != gcpSpec.Versioning {
+		patch.Versioning = GetBucketVersioning(gcpSpec)
+		changed = true
+	}
+
+	desiredLifecycle := GetBucketLifecycle(gcpSpec)
+	if !AreEqualLifecycles(state.bucket.Lifecycle, desiredLifecycle) {
+		if len(desiredLifecycle.Rule) == 0 {
+			patch.NullFields = append(patch.NullFields, "Lifecycle")
+		} else {
+			patch.Lifecycle = desiredLifecycle
+		}
+		changed = true
+	}
+
+	desiredRetention := int64(gcpSpec.RetentionDays) * secondsPerDay
+	if GetRetentionPeriodSeconds(state.bucket) != desiredRetention {
+		if desiredRetention == 0 {
+			patch.NullFields = append(patch.NullFields, "RetentionPolicy")
+		} else {
+			patch.RetentionPolicy = &storage.BucketRetentionPolicy{
+				RetentionPeriod: desiredRetention,
+			}
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil, ctx
+	}
+
+	logger.Info("Patching GCP Cloud Storage bucket")
+
+	bucket, err := state.gcpClient.PatchStorageBucket(ctx, state.GetRemoteBucketName(), patch)
+	if err != nil {
+		logger.Error(err, "Error patching GCP Cloud Storage bucket")
+		return updateStatusWithCloudProviderError(ctx, state, "Failed to update Bucket", "Error updating Bucket status due failed gcp bucket patch")
+	}
+
+	state.bucket = bucket
+
+	return nil, ctx
+}
@@ -0,0 +1,64 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/kcp/bucket/types"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket/client"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/storage/v1"
+)
+
+type State struct {
+	types.State
+
+	gcpClient client.Client
+
+	bucket         *storage.Bucket
+	serviceAccount *iam.ServiceAccount
+	hmacKeys       []*storage.HmacKeyMetadata
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, bucketState types.State) (*State, error)
+}
+
+func NewStateFactory(clientProvider gcpclient.GcpClientProvider[client.Client]) StateFactory {
+	return &stateFactory{
+		clientProvider: clientProvider,
+	}
+}
+
+type stateFactory struct {
+	clientProvider gcpclient.GcpClientProvider[client.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, bucketState types.State) (*State, error) {
+	c := f.clientProvider(bucketState.Scope().Spec.Scope.Gcp.Project)
+
+	return newState(bucketState, c), nil
+}
+
+func newState(bucketState types.State, c client.Client) *State {
+	return &State{
+		State:     bucketState,
+		gcpClient: c,
+	}
+}
+
+func (s *State) GetProjectId() string {
+	return s.Scope().Spec.Scope.Gcp.Project
+}
+
+func (s *State) GetRemoteBucketName() string {
+	return GetGcpBucketName(s.Obj().GetName())
+}
+
+func (s *State) GetServiceAccountId() string {
+	return GetGcpServiceAccountId(s.Obj().GetName())
+}
+
+func (s *State) GetServiceAccountEmail() string {
+	return gcpclient.ServiceAccountEmail(s.GetProjectId(), s.GetServiceAccountId())
+}
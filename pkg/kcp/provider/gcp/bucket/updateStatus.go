@@ -0,0 +1,47 @@
+package bucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	bucket := state.ObjAsBucket()
+	hasChanged := false
+
+	if bucket.Status.Endpoint != gcsEndpoint {
+		bucket.Status.Endpoint = gcsEndpoint
+		hasChanged = true
+	}
+
+	if bucket.Status.Location != state.Scope().Spec.Region {
+		bucket.Status.Location = state.Scope().Spec.Region
+		hasChanged = true
+	}
+
+	hasReadyCondition := meta.FindStatusCondition(bucket.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady) != nil
+	hasReadyStatusState := bucket.Status.State == cloudcontrolv1beta1.StateReady
+	if !hasChanged && hasReadyCondition && hasReadyStatusState {
+		composed.LoggerFromCtx(ctx).Info("Bucket status fields are already up-to-date, StopAndForget-ing")
+		return composed.StopAndForget, nil
+	}
+
+	bucket.Status.State = cloudcontrolv1beta1.StateReady
+	return composed.UpdateStatus(bucket).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonReady,
+			Message: "Bucket is ready",
+		}).
+		ErrorLogMessage("Error updating KCP Bucket status after setting Ready condition").
+		SuccessLogMsg("KCP Bucket is ready").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
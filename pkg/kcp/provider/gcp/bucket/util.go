@@ -0,0 +1,134 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	gcsEndpoint = "https://storage.googleapis.com"
+
+	bucketObjectRole = "roles/storage.objectAdmin"
+
+	secondsPerDay = 24 * 60 * 60
+)
+
+// GetGcpBucketName returns the Cloud Storage bucket name. Bucket names are global and must start with a letter
+// or a number, so the KCP object name, which is a UUID, is prefixed.
+func GetGcpBucketName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+// GetGcpServiceAccountId returns the bucket service account id. Service account ids are limited to 30 characters,
+// so dashes are removed from the KCP object name and it's shortened.
+func GetGcpServiceAccountId(name string) string {
+	id := strings.ToLower(strings.ReplaceAll(name, "-", ""))
+	if len(id) > 23 {
+		id = id[:23]
+	}
+	return fmt.Sprintf("cm-bkt-%s", id)
+}
+
+func GetBucketVersioning(gcpSpec *cloudcontrolv1beta1.BucketGcp) *storage.BucketVersioning {
+	return &storage.BucketVersioning{
+		Enabled:         gcpSpec.Versioning,
+		ForceSendFields: []string{"Enabled"},
+	}
+}
+
+func GetBucketLifecycle(gcpSpec *cloudcontrolv1beta1.BucketGcp) *storage.BucketLifecycle {
+	lifecycle := &storage.BucketLifecycle{
+		ForceSendFields: []string{"Rule"},
+	}
+	for _, r := range gcpSpec.Lifecycle {
+		var prefixes []string
+		if r.Prefix != "" {
+			prefixes = []string{r.Prefix}
+		}
+		if r.ExpirationDays > 0 {
+			lifecycle.Rule = append(lifecycle.Rule, &storage.BucketLifecycleRule{
+				Action: &storage.BucketLifecycleRuleAction{Type: "Delete"},
+				Condition: &storage.BucketLifecycleRuleCondition{
+					Age:           new(int64(r.ExpirationDays)),
+					MatchesPrefix: prefixes,
+				},
+			})
+		}
+		if r.NoncurrentVersionExpirationDays > 0 {
+			lifecycle.Rule = append(lifecycle.Rule, &storage.BucketLifecycleRule{
+				Action: &storage.BucketLifecycleRuleAction{Type: "Delete"},
+				Condition: &storage.BucketLifecycleRuleCondition{
+					DaysSinceNoncurrentTime: int64(r.NoncurrentVersionExpirationDays),
+					IsLive:                  new(false),
+					MatchesPrefix:           prefixes,
+				},
+			})
+		}
+	}
+	return lifecycle
+}
+
+func lifecycleRuleKey(r *storage.BucketLifecycleRule) string {
+	action := ""
+	if r.Action != nil {
+		action = r.Action.Type
+	}
+	if r.Condition == nil {
+		return action
+	}
+	c := r.Condition
+	return fmt.Sprintf("%s|%d|%d|%v|%s", action, ptr.Deref(c.Age, 0), c.DaysSinceNoncurrentTime, ptr.Deref(c.IsLive, true), strings.Join(c.MatchesPrefix, ","))
+}
+
+func AreEqualLifecycles(current, desired *storage.BucketLifecycle) bool {
+	var currentRules, desiredRules []*storage.BucketLifecycleRule
+	if current != nil {
+		currentRules = current.Rule
+	}
+	if desired != nil {
+		desiredRules = desired.Rule
+	}
+	if len(currentRules) != len(desiredRules) {
+		return false
+	}
+	keys := func(rules []*storage.BucketLifecycleRule) []string {
+		result := make([]string, 0, len(rules))
+		for _, r := range rules {
+			result = append(result, lifecycleRuleKey(r))
+		}
+		slices.Sort(result)
+		return result
+	}
+	return slices.Equal(keys(currentRules), keys(desiredRules))
+}
+
+func GetRetentionPeriodSeconds(bucket *storage.Bucket) int64 {
+	if bucket == nil || bucket.RetentionPolicy == nil {
+		return 0
+	}
+	return bucket.RetentionPolicy.RetentionPeriod
+}
+
+func updateStatusWithCloudProviderError(ctx context.Context, state *State, message, logMessage string) (error, context.Context) {
+	bucket := state.ObjAsBucket()
+	bucket.Status.State = cloudcontrolv1beta1.StateError
+	return composed.UpdateStatus(bucket).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: message,
+		}).
+		ErrorLogMessage(logMessage).
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/api/sqladmin/v1"
+)
+
+type CloudSqlClient interface {
+	GetSqlInstance(ctx context.Context, projectId, instanceId string) (*sqladmin.DatabaseInstance, error)
+	InsertSqlInstance(ctx context.Context, projectId string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error)
+	PatchSqlInstance(ctx context.Context, projectId, instanceId string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error)
+	DeleteSqlInstance(ctx context.Context, projectId, instanceId string) (*sqladmin.Operation, error)
+
+	GetSqlDatabase(ctx context.Context, projectId, instanceId, databaseId string) (*sqladmin.Database, error)
+	InsertSqlDatabase(ctx context.Context, projectId, instanceId string, database *sqladmin.Database) (*sqladmin.Operation, error)
+
+	GetSqlOperation(ctx context.Context, projectId, operationId string) (*sqladmin.Operation, error)
+}
+
+var _ CloudSqlClient = (*cloudSqlClient)(nil)
+
+type cloudSqlClient struct {
+	inner *sqladmin.Service
+}
+
+func (c *cloudSqlClient) GetSqlInstance(ctx context.Context, projectId, instanceId string) (*sqladmin.DatabaseInstance, error) {
+	return c.inner.Instances.Get(projectId, instanceId).Context(ctx).Do()
+}
+
+func (c *cloudSqlClient) InsertSqlInstance(ctx context.Context, projectId string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+	return c.inner.Instances.Insert(projectId, instance).Context(ctx).Do()
+}
+
+func (c *cloudSqlClient) PatchSqlInstance(ctx context.Context, projectId, instanceId string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+	return c.inner.Instances.Patch(projectId, instanceId, instance).Context(ctx).Do()
+}
+
+func (c *cloudSqlClient) DeleteSqlInstance(ctx context.Context, projectId, instanceId string) (*sqladmin.Operation, error) {
+	return c.inner.Instances.Delete(projectId, instanceId).Context(ctx).Do()
+}
+
+func (c *cloudSqlClient) GetSqlDatabase(ctx context.Context, projectId, instanceId, databaseId string) (*sqladmin.Database, error) {
+	return c.inner.Databases.Get(projectId, instanceId, databaseId).Context(ctx).Do()
+}
+
+func (c *cloudSqlClient) InsertSqlDatabase(ctx context.Context, projectId, instanceId string, database *sqladmin.Database) (*sqladmin.Operation, error) {
+	return c.inner.Databases.Insert(projectId, instanceId, database).Context(ctx).Do()
+}
+
+func (c *cloudSqlClient) GetSqlOperation(ctx context.Context, projectId, operationId string) (*sqladmin.Operation, error) {
+	return c.inner.Operations.Get(projectId, operationId).Context(ctx).Do()
+}
@@ -8,6 +8,7 @@ import (
 	"cloud.google.com/go/filestore/apiv1/filestorepb"
 	"cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"github.com/googleapis/gax-go/v2"
+	locationpb "google.golang.org/genproto/googleapis/cloud/location"
 )
 
 type FilestoreClient interface {
@@ -27,6 +28,8 @@ type FilestoreClient interface {
 
 	GetFilestoreOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...gax.CallOption) (*longrunningpb.Operation, error)
 	ListFilestoreOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...gax.CallOption) Iterator[*longrunningpb.Operation]
+
+	ListFilestoreLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) Iterator[*locationpb.Location]
 }
 
 var _ FilestoreClient = (*filestoreClient)(nil)
@@ -93,6 +96,12 @@ func (c *filestoreClient) ListFilestoreOperations(ctx context.Context, req *long
 	return c.inner.ListOperations(ctx, req, opts...)
 }
 
+// Location =====================================
+
+func (c *filestoreClient) ListFilestoreLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) Iterator[*locationpb.Location] {
+	return c.inner.ListLocations(ctx, req, opts...)
+}
+
 // High level functions =====================================
 
 func (c *filestoreClient) FindFilestoreRestoreOperation(ctx context.Context, projectId, location, instanceId string) (*longrunningpb.Operation, error) {
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2"
+)
+
+type FirewallsClient interface {
+	GetFirewall(ctx context.Context, req *computepb.GetFirewallRequest, opts ...gax.CallOption) (*computepb.Firewall, error)
+	InsertFirewall(ctx context.Context, req *computepb.InsertFirewallRequest, opts ...gax.CallOption) (VoidOperation, error)
+	DeleteFirewall(ctx context.Context, req *computepb.DeleteFirewallRequest, opts ...gax.CallOption) (VoidOperation, error)
+}
+
+var _ FirewallsClient = (*firewallsClient)(nil)
+
+type firewallsClient struct {
+	inner *compute.FirewallsClient
+}
+
+func (c *firewallsClient) GetFirewall(ctx context.Context, req *computepb.GetFirewallRequest, opts ...gax.CallOption) (*computepb.Firewall, error) {
+	return c.inner.Get(ctx, req, opts...)
+}
+
+func (c *firewallsClient) InsertFirewall(ctx context.Context, req *computepb.InsertFirewallRequest, opts ...gax.CallOption) (VoidOperation, error) {
+	return c.inner.Insert(ctx, req, opts...)
+}
+
+func (c *firewallsClient) DeleteFirewall(ctx context.Context, req *computepb.DeleteFirewallRequest, opts ...gax.CallOption) (VoidOperation, error) {
+	return c.inner.Delete(ctx, req, opts...)
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/iam/v1"
+)
+
+type IamServiceAccountClient interface {
+	GetServiceAccount(ctx context.Context, projectId, accountId string) (*iam.ServiceAccount, error)
+	CreateServiceAccount(ctx context.Context, projectId, accountId, displayName string) (*iam.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, projectId, accountId string) error
+}
+
+// ServiceAccountEmail returns the email of the user-managed service account with the given account id
+func ServiceAccountEmail(projectId, accountId string) string {
+	return fmt.Sprintf("%s@%s.iam.gserviceaccount.com", accountId, projectId)
+}
+
+func serviceAccountName(projectId, accountId string) string {
+	return fmt.Sprintf("projects/%s/serviceAccounts/%s", projectId, ServiceAccountEmail(projectId, accountId))
+}
+
+var _ IamServiceAccountClient = (*iamServiceAccountClient)(nil)
+
+type iamServiceAccountClient struct {
+	inner *iam.Service
+}
+
+func (c *iamServiceAccountClient) GetServiceAccount(ctx context.Context, projectId, accountId string) (*iam.ServiceAccount, error) {
+	return c.inner.Projects.ServiceAccounts.Get(serviceAccountName(projectId, accountId)).Context(ctx).Do()
+}
+
+func (c *iamServiceAccountClient) CreateServiceAccount(ctx context.Context, projectId, accountId, displayName string) (*iam.ServiceAccount, error) {
+	return c.inner.Projects.ServiceAccounts.Create(fmt.Sprintf("projects/%s", projectId), &iam.CreateServiceAccountRequest{
+		AccountId: accountId,
+		ServiceAccount: &iam.ServiceAccount{
+			DisplayName: displayName,
+		},
+	}).Context(ctx).Do()
+}
+
+func (c *iamServiceAccountClient) DeleteServiceAccount(ctx context.Context, projectId, accountId string) error {
+	_, err := c.inner.Projects.ServiceAccounts.Delete(serviceAccountName(projectId, accountId)).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/api/cloudkms/v1"
+)
+
+type KmsClient interface {
+	GetKmsKeyRing(ctx context.Context, name string) (*cloudkms.KeyRing, error)
+	CreateKmsKeyRing(ctx context.Context, parent, keyRingId string) (*cloudkms.KeyRing, error)
+
+	GetKmsCryptoKey(ctx context.Context, name string) (*cloudkms.CryptoKey, error)
+	CreateKmsCryptoKey(ctx context.Context, parent, cryptoKeyId string, cryptoKey *cloudkms.CryptoKey) (*cloudkms.CryptoKey, error)
+	// PatchKmsCryptoKey updates only the crypto key fields listed in the comma separated updateMask
+	PatchKmsCryptoKey(ctx context.Context, name, updateMask string, cryptoKey *cloudkms.CryptoKey) (*cloudkms.CryptoKey, error)
+
+	ListKmsCryptoKeyVersions(ctx context.Context, parent string) ([]*cloudkms.CryptoKeyVersion, error)
+	// DestroyKmsCryptoKeyVersion schedules the crypto key version for destruction, crypto keys themselves can not be deleted
+	DestroyKmsCryptoKeyVersion(ctx context.Context, name string) (*cloudkms.CryptoKeyVersion, error)
+}
+
+var _ KmsClient = (*kmsClient)(nil)
+
+type kmsClient struct {
+	inner *cloudkms.Service
+}
+
+func (c *kmsClient) GetKmsKeyRing(ctx context.Context, name string) (*cloudkms.KeyRing, error) {
+	return c.inner.Projects.Locations.KeyRings.Get(name).Context(ctx).Do()
+}
+
+func (c *kmsClient) CreateKmsKeyRing(ctx context.Context, parent, keyRingId string) (*cloudkms.KeyRing, error) {
+	return c.inner.Projects.Locations.KeyRings.Create(parent, &cloudkms.KeyRing{}).KeyRingId(keyRingId).Context(ctx).Do()
+}
+
+func (c *kmsClient) GetKmsCryptoKey(ctx context.Context, name string) (*cloudkms.CryptoKey, error) {
+	return c.inner.Projects.Locations.KeyRings.CryptoKeys.Get(name).Context(ctx).Do()
+}
+
+func (c *kmsClient) CreateKmsCryptoKey(ctx context.Context, parent, cryptoKeyId string, cryptoKey *cloudkms.CryptoKey) (*cloudkms.CryptoKey, error) {
+	return c.inner.Projects.Locations.KeyRings.CryptoKeys.Create(parent, cryptoKey).CryptoKeyId(cryptoKeyId).Context(ctx).Do()
+}
+
+func (c *kmsClient) PatchKmsCryptoKey(ctx context.Context, name, updateMask string, cryptoKey *cloudkms.CryptoKey) (*cloudkms.CryptoKey, error) {
+	return c.inner.Projects.Locations.KeyRings.CryptoKeys.Patch(name, cryptoKey).UpdateMask(updateMask).Context(ctx).Do()
+}
+
+func (c *kmsClient) ListKmsCryptoKeyVersions(ctx context.Context, parent string) ([]*cloudkms.CryptoKeyVersion, error) {
+	var results []*cloudkms.CryptoKeyVersion
+	err := c.inner.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.List(parent).Pages(ctx, func(resp *cloudkms.ListCryptoKeyVersionsResponse) error {
+		results = append(results, resp.CryptoKeyVersions...)
+		return nil
+	})
+	return results, err
+}
+
+func (c *kmsClient) DestroyKmsCryptoKeyVersion(ctx context.Context, name string) (*cloudkms.CryptoKeyVersion, error) {
+	return c.inner.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.Destroy(name, &cloudkms.DestroyCryptoKeyVersionRequest{}).Context(ctx).Do()
+}
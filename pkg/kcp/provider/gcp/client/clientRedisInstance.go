@@ -7,18 +7,24 @@ import (
 	redis "cloud.google.com/go/redis/apiv1"
 	"cloud.google.com/go/redis/apiv1/redispb"
 	"github.com/googleapis/gax-go/v2"
+	locationpb "google.golang.org/genproto/googleapis/cloud/location"
 )
 
 type RedisInstanceClient interface {
 	CreateRedisInstance(ctx context.Context, req *redispb.CreateInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error)
 	GetRedisInstance(ctx context.Context, req *redispb.GetInstanceRequest, opts ...gax.CallOption) (*redispb.Instance, error)
+	ListRedisInstances(ctx context.Context, req *redispb.ListInstancesRequest, opts ...gax.CallOption) Iterator[*redispb.Instance]
 	GetRedisInstanceAuthString(ctx context.Context, req *redispb.GetInstanceAuthStringRequest, opts ...gax.CallOption) (*redispb.InstanceAuthString, error)
 	UpdateRedisInstance(ctx context.Context, req *redispb.UpdateInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error)
 	UpgradeRedisInstance(ctx context.Context, req *redispb.UpgradeInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error)
 	DeleteRedisInstance(ctx context.Context, req *redispb.DeleteInstanceRequest, opts ...gax.CallOption) (VoidOperation, error)
+	ExportRedisInstance(ctx context.Context, req *redispb.ExportInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error)
+	ImportRedisInstance(ctx context.Context, req *redispb.ImportInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error)
 
 	GetRedisInstanceOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...gax.CallOption) (*longrunningpb.Operation, error)
 	ListRedisInstanceOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...gax.CallOption) Iterator[*longrunningpb.Operation]
+
+	ListRedisLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) Iterator[*locationpb.Location]
 }
 
 var _ RedisInstanceClient = (*redisInstanceClient)(nil)
@@ -35,6 +41,10 @@ func (c *redisInstanceClient) GetRedisInstance(ctx context.Context, req *redispb
 	return c.inner.GetInstance(ctx, req)
 }
 
+func (c *redisInstanceClient) ListRedisInstances(ctx context.Context, req *redispb.ListInstancesRequest, opts ...gax.CallOption) Iterator[*redispb.Instance] {
+	return c.inner.ListInstances(ctx, req, opts...)
+}
+
 func (c *redisInstanceClient) GetRedisInstanceAuthString(ctx context.Context, req *redispb.GetInstanceAuthStringRequest, opts ...gax.CallOption) (*redispb.InstanceAuthString, error) {
 	return c.inner.GetInstanceAuthString(ctx, req, opts...)
 }
@@ -51,6 +61,14 @@ func (c *redisInstanceClient) DeleteRedisInstance(ctx context.Context, req *redi
 	return c.inner.DeleteInstance(ctx, req, opts...)
 }
 
+func (c *redisInstanceClient) ExportRedisInstance(ctx context.Context, req *redispb.ExportInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error) {
+	return c.inner.ExportInstance(ctx, req, opts...)
+}
+
+func (c *redisInstanceClient) ImportRedisInstance(ctx context.Context, req *redispb.ImportInstanceRequest, opts ...gax.CallOption) (ResultOperation[*redispb.Instance], error) {
+	return c.inner.ImportInstance(ctx, req, opts...)
+}
+
 func (c *redisInstanceClient) GetRedisInstanceOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...gax.CallOption) (*longrunningpb.Operation, error) {
 	return c.inner.GetOperation(ctx, req, opts...)
 }
@@ -58,3 +76,7 @@ func (c *redisInstanceClient) GetRedisInstanceOperation(ctx context.Context, req
 func (c *redisInstanceClient) ListRedisInstanceOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...gax.CallOption) Iterator[*longrunningpb.Operation] {
 	return c.inner.ListOperations(ctx, req, opts...)
 }
+
+func (c *redisInstanceClient) ListRedisLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) Iterator[*locationpb.Location] {
+	return c.inner.ListLocations(ctx, req, opts...)
+}
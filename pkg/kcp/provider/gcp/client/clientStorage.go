@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/api/storage/v1"
+)
+
+type StorageClient interface {
+	GetStorageBucket(ctx context.Context, bucketName string) (*storage.Bucket, error)
+	InsertStorageBucket(ctx context.Context, projectId string, bucket *storage.Bucket) (*storage.Bucket, error)
+	PatchStorageBucket(ctx context.Context, bucketName string, bucket *storage.Bucket) (*storage.Bucket, error)
+	DeleteStorageBucket(ctx context.Context, bucketName string) error
+	// DeleteStorageBucketObjects deletes all objects in the bucket, including noncurrent versions
+	DeleteStorageBucketObjects(ctx context.Context, bucketName string) error
+
+	GetStorageObject(ctx context.Context, bucketName, objectName string) (*storage.Object, error)
+	DeleteStorageObject(ctx context.Context, bucketName, objectName string) error
+
+	GetStorageBucketIamPolicy(ctx context.Context, bucketName string) (*storage.Policy, error)
+	SetStorageBucketIamPolicy(ctx context.Context, bucketName string, policy *storage.Policy) (*storage.Policy, error)
+
+	ListStorageHmacKeys(ctx context.Context, projectId, serviceAccountEmail string) ([]*storage.HmacKeyMetadata, error)
+	CreateStorageHmacKey(ctx context.Context, projectId, serviceAccountEmail string) (*storage.HmacKey, error)
+	// DeactivateStorageHmacKey sets the key state to INACTIVE, which is required before the key can be deleted
+	DeactivateStorageHmacKey(ctx context.Context, projectId, accessId string) error
+	DeleteStorageHmacKey(ctx context.Context, projectId, accessId string) error
+}
+
+var _ StorageClient = (*storageClient)(nil)
+
+type storageClient struct {
+	inner *storage.Service
+}
+
+func (c *storageClient) GetStorageBucket(ctx context.Context, bucketName string) (*storage.Bucket, error) {
+	return c.inner.Buckets.Get(bucketName).Context(ctx).Do()
+}
+
+func (c *storageClient) InsertStorageBucket(ctx context.Context, projectId string, bucket *storage.Bucket) (*storage.Bucket, error) {
+	return c.inner.Buckets.Insert(projectId, bucket).Context(ctx).Do()
+}
+
+func (c *storageClient) PatchStorageBucket(ctx context.Context, bucketName string, bucket *storage.Bucket) (*storage.Bucket, error) {
+	return c.inner.Buckets.Patch(bucketName, bucket).Context(ctx).Do()
+}
+
+func (c *storageClient) DeleteStorageBucket(ctx context.Context, bucketName string) error {
+	return c.inner.Buckets.Delete(bucketName).Context(ctx).Do()
+}
+
+func (c *storageClient) DeleteStorageBucketObjects(ctx context.Context, bucketName string) error {
+	return c.inner.Objects.List(bucketName).Versions(true).Pages(ctx, func(objects *storage.Objects) error {
+		for _, obj := range objects.Items {
+			err := c.inner.Objects.Delete(bucketName, obj.Name).Generation(obj.Generation).Context(ctx).Do()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *storageClient) GetStorageObject(ctx context.Context, bucketName, objectName string) (*storage.Object, error) {
+	return c.inner.Objects.Get(bucketName, objectName).Context(ctx).Do()
+}
+
+func (c *storageClient) DeleteStorageObject(ctx context.Context, bucketName, objectName string) error {
+	return c.inner.Objects.Delete(bucketName, objectName).Context(ctx).Do()
+}
+
+func (c *storageClient) GetStorageBucketIamPolicy(ctx context.Context, bucketName string) (*storage.Policy, error) {
+	return c.inner.Buckets.GetIamPolicy(bucketName).OptionsRequestedPolicyVersion(3).Context(ctx).Do()
+}
+
+func (c *storageClient) SetStorageBucketIamPolicy(ctx context.Context, bucketName string, policy *storage.Policy) (*storage.Policy, error) {
+	return c.inner.Buckets.SetIamPolicy(bucketName, policy).Context(ctx).Do()
+}
+
+func (c *storageClient) ListStorageHmacKeys(ctx context.Context, projectId, serviceAccountEmail string) ([]*storage.HmacKeyMetadata, error) {
+	var result []*storage.HmacKeyMetadata
+	err := c.inner.Projects.HmacKeys.List(projectId).ServiceAccountEmail(serviceAccountEmail).Pages(ctx, func(page *storage.HmacKeysMetadata) error {
+		result = append(result, page.Items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *storageClient) CreateStorageHmacKey(ctx context.Context, projectId, serviceAccountEmail string) (*storage.HmacKey, error) {
+	return c.inner.Projects.HmacKeys.Create(projectId, serviceAccountEmail).Context(ctx).Do()
+}
+
+func (c *storageClient) DeactivateStorageHmacKey(ctx context.Context, projectId, accessId string) error {
+	_, err := c.inner.Projects.HmacKeys.Update(projectId, accessId, &storage.HmacKeyMetadata{State: "INACTIVE"}).Context(ctx).Do()
+	return err
+}
+
+func (c *storageClient) DeleteStorageHmacKey(ctx context.Context, projectId, accessId string) error {
+	return c.inner.Projects.HmacKeys.Delete(projectId, accessId).Context(ctx).Do()
+}
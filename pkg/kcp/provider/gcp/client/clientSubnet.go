@@ -13,6 +13,8 @@ type SubnetClient interface {
 	GetSubnet(ctx context.Context, req *computepb.GetSubnetworkRequest, opts ...gax.CallOption) (*computepb.Subnetwork, error)
 	ListSubnets(ctx context.Context, req *computepb.ListSubnetworksRequest, opts ...gax.CallOption) Iterator[*computepb.Subnetwork]
 	DeleteSubnet(ctx context.Context, req *computepb.DeleteSubnetworkRequest, opts ...gax.CallOption) (VoidOperation, error)
+	// PatchSubnet requires the current fingerprint of the subnet in the SubnetworkResource
+	PatchSubnet(ctx context.Context, req *computepb.PatchSubnetworkRequest, opts ...gax.CallOption) (VoidOperation, error)
 }
 
 var _ SubnetClient = (*subnetClient)(nil)
@@ -36,3 +38,7 @@ func (c *subnetClient) ListSubnets(ctx context.Context, req *computepb.ListSubne
 func (c *subnetClient) DeleteSubnet(ctx context.Context, req *computepb.DeleteSubnetworkRequest, opts ...gax.CallOption) (VoidOperation, error) {
 	return c.inner.Delete(ctx, req, opts...)
 }
+
+func (c *subnetClient) PatchSubnet(ctx context.Context, req *computepb.PatchSubnetworkRequest, opts ...gax.CallOption) (VoidOperation, error) {
+	return c.inner.Patch(ctx, req, opts...)
+}
@@ -18,9 +18,13 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
 	"golang.org/x/oauth2"
+	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
 	"google.golang.org/api/servicenetworking/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
 	"google.golang.org/grpc"
 )
 
@@ -30,6 +34,7 @@ type GcpClients struct {
 	ComputeGlobalAddresses                    *compute.GlobalAddressesClient // For IpRange global address operations
 	ComputeRouters                            *compute.RoutersClient
 	ComputeSubnetworks                        *compute.SubnetworksClient
+	ComputeFirewalls                          *compute.FirewallsClient
 	RegionOperations                          *compute.RegionOperationsClient
 	ComputeGlobalOperations                   *compute.GlobalOperationsClient // For IpRange global operation tracking
 	NetworkConnectivityCrossNetworkAutomation *networkconnectivity.CrossNetworkAutomationClient
@@ -38,6 +43,10 @@ type GcpClients struct {
 	Filestore                                 *filestore.CloudFilestoreManagerClient // For NfsInstance v2
 	ServiceNetworking                         *servicenetworking.APIService          // For IpRange PSA connections (OLD pattern API)
 	CloudResourceManager                      *cloudresourcemanager.Service          // For IpRange project number lookup (OLD pattern API)
+	CloudSql                                  *sqladmin.Service                      // For PostgresInstance (OLD pattern API)
+	Storage                                   *storage.Service                       // For Bucket (OLD pattern API)
+	Iam                                       *iam.Service                           // For Bucket service accounts (OLD pattern API)
+	Kms                                       *cloudkms.Service                      // For Runtime hardening (OLD pattern API)
 	VpcPeeringClients                         *VpcPeeringClients
 }
 
@@ -97,6 +106,12 @@ func NewGcpClients(ctx context.Context, credentialsFile string, peeringCredentia
 		return nil, fmt.Errorf("create compute subnetworks client: %w", err)
 	}
 
+	computeFirewalls, err := compute.NewFirewallsRESTClient(ctx,
+		option.WithHTTPClient(computeHTTPClient))
+	if err != nil {
+		return nil, fmt.Errorf("create compute firewalls client: %w", err)
+	}
+
 	computeRegionOperations, err := compute.NewRegionOperationsRESTClient(ctx,
 		option.WithHTTPClient(computeHTTPClient))
 	if err != nil {
@@ -205,6 +220,60 @@ func NewGcpClients(ctx context.Context, credentialsFile string, peeringCredentia
 		return nil, fmt.Errorf("create cloud resource manager client: %w", err)
 	}
 
+	// cloud sql ----------------
+	// Cloud SQL Admin uses OLD pattern API (google.golang.org/api/sqladmin/v1)
+	// because Google does not provide a modern Cloud Client Library for Cloud SQL Admin API
+	cloudSqlTokenProvider, err := b.WithScopes([]string{
+		"https://www.googleapis.com/auth/cloud-platform",
+		"https://www.googleapis.com/auth/sqlservice.admin",
+	}).BuildTokenProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build cloud sql token provider: %w", err)
+	}
+	cloudSqlHTTPClient := metrics.NewMetricsHTTPClient(oauth2.NewClient(ctx, oauth2adapt.TokenSourceFromTokenProvider(cloudSqlTokenProvider)).Transport)
+	cloudSql, err := sqladmin.NewService(ctx, option.WithHTTPClient(cloudSqlHTTPClient))
+	if err != nil {
+		return nil, fmt.Errorf("create cloud sql client: %w", err)
+	}
+
+	// storage and iam ----------------
+	// Bucket uses OLD pattern APIs (google.golang.org/api/storage/v1 and google.golang.org/api/iam/v1)
+	// because HMAC keys and service accounts are not managed by the modern Cloud Client Libraries
+	storageTokenProvider, err := b.WithScopes([]string{
+		storage.CloudPlatformScope,
+		storage.DevstorageFullControlScope,
+	}).BuildTokenProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build storage token provider: %w", err)
+	}
+	storageHTTPClient := metrics.NewMetricsHTTPClient(oauth2.NewClient(ctx, oauth2adapt.TokenSourceFromTokenProvider(storageTokenProvider)).Transport)
+	storageService, err := storage.NewService(ctx, option.WithHTTPClient(storageHTTPClient))
+	if err != nil {
+		return nil, fmt.Errorf("create storage client: %w", err)
+	}
+
+	iamTokenProvider, err := b.WithScopes([]string{iam.CloudPlatformScope}).BuildTokenProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build iam token provider: %w", err)
+	}
+	iamHTTPClient := metrics.NewMetricsHTTPClient(oauth2.NewClient(ctx, oauth2adapt.TokenSourceFromTokenProvider(iamTokenProvider)).Transport)
+	iamService, err := iam.NewService(ctx, option.WithHTTPClient(iamHTTPClient))
+	if err != nil {
+		return nil, fmt.Errorf("create iam client: %w", err)
+	}
+
+	// kms ----------------
+	// Cloud KMS uses OLD pattern API (google.golang.org/api/cloudkms/v1) same as other non-compute services above
+	kmsTokenProvider, err := b.WithScopes([]string{cloudkms.CloudkmsScope}).BuildTokenProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kms token provider: %w", err)
+	}
+	kmsHTTPClient := metrics.NewMetricsHTTPClient(oauth2.NewClient(ctx, oauth2adapt.TokenSourceFromTokenProvider(kmsTokenProvider)).Transport)
+	kmsService, err := cloudkms.NewService(ctx, option.WithHTTPClient(kmsHTTPClient))
+	if err != nil {
+		return nil, fmt.Errorf("create kms client: %w", err)
+	}
+
 	// vpc peering clients ----------------
 	// Compute networks client for VPC peering, uses a different service account
 	vpcPeeringComputeNetworksTokenProvider, err := vpcPeeringClientBuilder.WithScopes(compute.DefaultAuthScopes()).BuildTokenProvider()
@@ -246,6 +315,7 @@ func NewGcpClients(ctx context.Context, credentialsFile string, peeringCredentia
 		ComputeGlobalAddresses:                    computeGlobalAddresses,
 		ComputeRouters:                            computeRouters,
 		ComputeSubnetworks:                        computeSubnetworks,
+		ComputeFirewalls:                          computeFirewalls,
 		RegionOperations:                          computeRegionOperations,
 		ComputeGlobalOperations:                   computeGlobalOperations,
 		NetworkConnectivityCrossNetworkAutomation: ncCrossNetworkAutomation,
@@ -254,6 +324,10 @@ func NewGcpClients(ctx context.Context, credentialsFile string, peeringCredentia
 		Filestore:                                 filestoreClient,
 		ServiceNetworking:                         serviceNetworking,
 		CloudResourceManager:                      cloudResourceManager,
+		CloudSql:                                  cloudSql,
+		Storage:                                   storageService,
+		Iam:                                       iamService,
+		Kms:                                       kmsService,
 		VpcPeeringClients: &VpcPeeringClients{
 			ComputeGlobalOperations:    vpcPeeringComputeGlobalOperations,
 			ComputeNetworks:            vpcPeeringComputeNetworks,
@@ -325,6 +399,27 @@ func (c *GcpClients) ServiceNetworkingWrapped() ServiceNetworkingClient {
 	return &serviceNetworkingClient{inner: c.ServiceNetworking, crm: c.CloudResourceManager}
 }
 
+func (c *GcpClients) CloudSqlWrapped() CloudSqlClient {
+	return &cloudSqlClient{inner: c.CloudSql}
+}
+
+func (c *GcpClients) StorageWrapped() StorageClient {
+	return &storageClient{inner: c.Storage}
+}
+
+func (c *GcpClients) IamServiceAccountWrapped() IamServiceAccountClient {
+	return &iamServiceAccountClient{inner: c.Iam}
+}
+
+func (c *GcpClients) KmsWrapped() KmsClient {
+	return &kmsClient{inner: c.Kms}
+}
+
+// FirewallsWrapped wraps the field ComputeFirewalls
+func (c *GcpClients) FirewallsWrapped() FirewallsClient {
+	return &firewallsClient{inner: c.ComputeFirewalls}
+}
+
 func (c *VpcPeeringClients) Close() error {
 	return reflectingClose(c)
 }
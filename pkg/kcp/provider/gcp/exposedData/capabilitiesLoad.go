@@ -0,0 +1,55 @@
+package exposedData
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// capabilitiesLoad discovers if Memorystore and Filestore are available in the region. Redis versions and
+// tiers can not be listed from the API so they are taken from the static catalog. On error the previously
+// discovered capabilities are kept.
+func capabilitiesLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	project := state.ObjAsScope().Spec.Scope.Gcp.Project
+	region := state.ObjAsScope().Spec.Region
+
+	redisLocations, err := state.gcpClient.GetRedisLocationIds(ctx, project)
+	if err != nil {
+		logger.Error(err, "Error listing GCP Memorystore locations for region capabilities")
+		return nil, ctx
+	}
+	filestoreLocations, err := state.gcpClient.GetFilestoreLocationIds(ctx, project)
+	if err != nil {
+		logger.Error(err, "Error listing GCP Filestore locations for region capabilities")
+		return nil, ctx
+	}
+
+	services := map[string]bool{}
+	if len(redisLocations) > 0 {
+		services[cloudcontrolv1beta1.CapabilityServiceRedis] = slices.Contains(redisLocations, region)
+	}
+	if len(filestoreLocations) > 0 {
+		services[cloudcontrolv1beta1.CapabilityServiceNfs] = slices.Contains(filestoreLocations, region)
+	}
+	if len(services) == 0 {
+		return nil, ctx
+	}
+
+	state.ObjAsScope().Status.Capabilities = &cloudcontrolv1beta1.RegionCapabilities{
+		Services: services,
+		ReadTime: new(metav1.Now()),
+	}
+
+	logger.
+		WithValues("services", fmt.Sprintf("%v", services)).
+		Info("GCP region capabilities")
+
+	return nil, ctx
+}
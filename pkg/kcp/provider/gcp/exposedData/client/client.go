@@ -3,9 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 	"strings"
 
 	"cloud.google.com/go/compute/apiv1/computepb"
+	locationpb "google.golang.org/genproto/googleapis/cloud/location"
 
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 )
@@ -13,13 +15,24 @@ import (
 type Client interface {
 	GetVpcRouters(ctx context.Context, project string, region string, vpcName string) ([]*computepb.Router, error)
 	GetRouterIpAddresses(ctx context.Context, project string, region string, routerName string) ([]*computepb.Address, error)
+	LocationsClient
+}
+
+// LocationsClient lists the locations where services are available, used for the region capabilities
+type LocationsClient interface {
+	// GetRedisLocationIds returns the ids of the locations where Memorystore for Redis is available
+	GetRedisLocationIds(ctx context.Context, project string) ([]string, error)
+	// GetFilestoreLocationIds returns the ids of the locations where Filestore is available
+	GetFilestoreLocationIds(ctx context.Context, project string) ([]string, error)
 }
 
 func NewClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProvider[Client] {
 	return func(_ string) Client {
 		return &client{
-			routersClient: gcpClients.RoutersWrapped(),
-			addressClient: gcpClients.AddressesWrapped(),
+			routersClient:   gcpClients.RoutersWrapped(),
+			addressClient:   gcpClients.AddressesWrapped(),
+			redisClient:     gcpClients.RedisInstanceWrapped(),
+			filestoreClient: gcpClients.FilestoreWrapped(),
 		}
 	}
 }
@@ -27,8 +40,10 @@ func NewClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProv
 var _ Client = (*client)(nil)
 
 type client struct {
-	addressClient gcpclient.RegionalAddressesClient
-	routersClient gcpclient.RoutersClient
+	addressClient   gcpclient.RegionalAddressesClient
+	routersClient   gcpclient.RoutersClient
+	redisClient     gcpclient.RedisInstanceClient
+	filestoreClient gcpclient.FilestoreClient
 }
 
 func (c *client) GetVpcRouters(ctx context.Context, project string, region string, vpcName string) ([]*computepb.Router, error) {
@@ -67,3 +82,26 @@ func (c *client) GetRouterIpAddresses(ctx context.Context, project string, regio
 	}
 	return results, nil
 }
+
+func (c *client) GetRedisLocationIds(ctx context.Context, project string) ([]string, error) {
+	return locationIds(c.redisClient.ListRedisLocations(ctx, &locationpb.ListLocationsRequest{
+		Name: fmt.Sprintf("projects/%s", project),
+	}).All())
+}
+
+func (c *client) GetFilestoreLocationIds(ctx context.Context, project string) ([]string, error) {
+	return locationIds(c.filestoreClient.ListFilestoreLocations(ctx, &locationpb.ListLocationsRequest{
+		Name: fmt.Sprintf("projects/%s", project),
+	}).All())
+}
+
+func locationIds(it iter.Seq2[*locationpb.Location, error]) ([]string, error) {
+	var results []string
+	for x, err := range it {
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, x.GetLocationId())
+	}
+	return results, nil
+}
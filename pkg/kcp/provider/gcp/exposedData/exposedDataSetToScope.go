@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/elliotchance/pie/v2"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
 	"k8s.io/utils/ptr"
 )
 
@@ -21,12 +23,26 @@ func exposedDataSetToScope(ctx context.Context, st composed.State) (error, conte
 		list = append(list, ip)
 	}
 
-	state.ObjAsScope().Status.ExposedData.NatGatewayIps = pie.Sort(pie.Unique(list))
+	exposedData := &state.ObjAsScope().Status.ExposedData
+	gcpScope := state.ObjAsScope().Spec.Scope.Gcp
+
+	exposedData.NatGatewayIps = pie.Sort(pie.Unique(list))
+	exposedData.Region = state.ObjAsScope().Spec.Region
+	exposedData.Zones = pie.Sort(pie.Unique(pie.Flat(pie.Map(gcpScope.Workers, func(w cloudcontrolv1beta1.GcpWorkers) []string {
+		return w.Zones
+	}))))
+	exposedData.NodesCidr = gcpScope.Network.Nodes
+	exposedData.PodsCidr = gcpScope.Network.Pods
+	exposedData.ServicesCidr = gcpScope.Network.Services
+	exposedData.NetworkId = gcputil.NewGlobalNetworkName(gcpScope.Project, gcpScope.VpcNetwork).String()
 
 	logger := composed.LoggerFromCtx(ctx)
 	logger.
-		WithValues("natGatewayIps", fmt.Sprintf("%v", state.ObjAsScope().Status.ExposedData.NatGatewayIps)).
-		Info("Exposed Data GCP Nat Gateway IP addresses")
+		WithValues(
+			"natGatewayIps", fmt.Sprintf("%v", exposedData.NatGatewayIps),
+			"networkId", exposedData.NetworkId,
+		).
+		Info("Exposed Data GCP network")
 
 	return nil, ctx
 }
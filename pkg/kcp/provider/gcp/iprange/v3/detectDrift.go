@@ -0,0 +1,24 @@
+package v3
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// detectDrift reports the GCP resources of a Ready IpRange that were removed out of band.
+// Both the address and the range reservation in the PSA connection are recreated by the
+// create-update flow, so the drift can be corrected.
+func detectDrift(ctx context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	if state.address == nil {
+		return []string{"address"}
+	}
+
+	if needsPsaConnection(ctx, st) && state.DoesConnectionIncludeRange() < 0 {
+		return []string{"psaConnection"}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	iprangetypes "github.com/kyma-project/cloud-manager/pkg/kcp/iprange/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -53,6 +54,9 @@ func New(stateFactory StateFactory) composed.Action {
 					actions.AddCommonFinalizer(),
 					updateStatusId,
 
+					// Compare GCP resources with the spec of Ready IpRange
+					drift.New(drift.KindIpRange, detectDrift),
+
 					// Create address if needed
 					createAddress,
 
@@ -71,6 +75,7 @@ func New(stateFactory StateFactory) composed.Action {
 
 					// Final status update
 					updateStatus,
+					drift.StopAndForgetAction(drift.KindIpRange),
 				),
 				composed.ComposeActions(
 					"delete",
@@ -80,6 +85,8 @@ func New(stateFactory StateFactory) composed.Action {
 					// Then delete address
 					deleteAddress,
 
+					drift.Forget(drift.KindIpRange),
+
 					// Remove finalizer
 					actions.RemoveCommonFinalizer(),
 				),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -67,7 +68,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 			Reason:  v1beta1.ReasonReady,
 			Message: "IpRange provisioned in GCP",
 		}).
-		SuccessError(composed.StopAndForget).
+		SuccessError(drift.StopAndForget(drift.KindIpRange)).
 		SuccessLogMsg("IpRange is Ready").
 		Run(ctx, state)
 }
@@ -167,3 +167,19 @@ func (s *exposedDataStore) GetRouterIpAddresses(ctx context.Context, project str
 
 	return results, nil
 }
+
+// GetRedisLocationIds returns no locations, so the mock does not restrict region capabilities
+func (s *exposedDataStore) GetRedisLocationIds(ctx context.Context, project string) ([]string, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	return nil, nil
+}
+
+// GetFilestoreLocationIds returns no locations, so the mock does not restrict region capabilities
+func (s *exposedDataStore) GetFilestoreLocationIds(ctx context.Context, project string) ([]string, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	return nil, nil
+}
@@ -5,14 +5,19 @@ import (
 	"sync"
 
 	"github.com/elliotchance/pie/v2"
+	gcpbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket/client"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/exposedData/client"
 	gcpiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/iprange/client"
 	gcpnfsbackupclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
 	gcpnfsinstancev2client "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsinstance/v2/client"
 	gcpnfsrestoreclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsrestore/client/v2"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcppostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance/client"
+	gcpredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisbackup/client"
 	gcpredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/rediscluster/client"
 	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
+	gcpruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/runtime/client"
 	gcpsubnetclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/subnet/client"
 	gcpvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcnetwork/client"
 	"github.com/kyma-project/cloud-manager/pkg/util"
@@ -137,6 +142,36 @@ func (s *server) IpRangeServiceNetworkingProvider() gcpclient.GcpClientProvider[
 	}
 }
 
+func (s *server) PostgresInstanceProvider() gcpclient.GcpClientProvider[gcppostgresinstanceclient.Client] {
+	return func(projectId string) gcppostgresinstanceclient.Client {
+		return s.GetSubscription(projectId)
+	}
+}
+
+func (s *server) BucketProvider() gcpclient.GcpClientProvider[gcpbucketclient.Client] {
+	return func(projectId string) gcpbucketclient.Client {
+		return s.GetSubscription(projectId)
+	}
+}
+
+func (s *server) NukeOrphanProvider() gcpclient.GcpClientProvider[gcpnukeclient.NukeOrphanClient] {
+	return func(projectId string) gcpnukeclient.NukeOrphanClient {
+		return s.GetSubscription(projectId)
+	}
+}
+
+func (s *server) RedisBackupProvider() gcpclient.GcpClientProvider[gcpredisbackupclient.Client] {
+	return func(projectId string) gcpredisbackupclient.Client {
+		return s.GetSubscription(projectId)
+	}
+}
+
+func (s *server) RuntimeProvider() gcpclient.GcpClientProvider[gcpruntimeclient.Client] {
+	return func(projectId string) gcpruntimeclient.Client {
+		return s.GetSubscription(projectId)
+	}
+}
+
 // Providers END - add new provider methods above ===========================================
 
 func (s *server) NewSubscription(prefix string) Store {
@@ -10,7 +10,11 @@ import (
 	"cloud.google.com/go/redis/cluster/apiv1/clusterpb"
 	"cloud.google.com/go/resourcemanager/apiv3/resourcemanagerpb"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/iam/v1"
 	"google.golang.org/api/servicenetworking/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
 )
 
 func newStore(projectId string, server Server) Store {
@@ -26,6 +30,7 @@ func newStore(projectId string, server Server) Store {
 		subnets:       MustNewFilterableList[*computepb.Subnetwork](),
 		routers:       MustNewFilterableList[*computepb.Router](),
 		addresses:     MustNewFilterableList[*computepb.Address](),
+		firewalls:     make(map[string]*computepb.Firewall),
 
 		serviceConnectionPolicies: MustNewFilterableList[*networkconnectivitypb.ServiceConnectionPolicy](),
 
@@ -40,6 +45,20 @@ func newStore(projectId string, server Server) Store {
 		serviceNetworkingOperations: MustNewFilterableList[*servicenetworking.Operation](),
 		serviceConnections:          MustNewFilterableList[*servicenetworking.Connection](),
 
+		sqlInstances:  MustNewFilterableList[*sqladmin.DatabaseInstance](),
+		sqlDatabases:  MustNewFilterableList[*sqladmin.Database](),
+		sqlOperations: MustNewFilterableList[*sqladmin.Operation](),
+
+		storageBuckets:        make(map[string]*storage.Bucket),
+		storageBucketObjects:  make(map[string]int),
+		storageObjects:        make(map[string]map[string]*storage.Object),
+		storageBucketPolicies: make(map[string]*storage.Policy),
+		storageHmacKeys:       make(map[string]*storage.HmacKeyMetadata),
+		iamServiceAccounts:    make(map[string]*iam.ServiceAccount),
+
+		kmsKeyRings:   make(map[string]*cloudkms.KeyRing),
+		kmsCryptoKeys: make(map[string]*cloudkms.CryptoKey),
+
 		tagKeys:     MustNewFilterableList[*resourcemanagerpb.TagKey](),
 		tagValues:   MustNewFilterableList[*resourcemanagerpb.TagValue](),
 		tagBindings: MustNewFilterableList[*resourcemanagerpb.TagBinding](),
@@ -64,6 +83,7 @@ type store struct {
 	subnets       *FilterableList[*computepb.Subnetwork]
 	routers       *FilterableList[*computepb.Router]
 	addresses     *FilterableList[*computepb.Address]
+	firewalls     map[string]*computepb.Firewall
 
 	serviceConnectionPolicies *FilterableList[*networkconnectivitypb.ServiceConnectionPolicy]
 
@@ -78,6 +98,20 @@ type store struct {
 	serviceNetworkingOperations *FilterableList[*servicenetworking.Operation]
 	serviceConnections          *FilterableList[*servicenetworking.Connection]
 
+	sqlInstances  *FilterableList[*sqladmin.DatabaseInstance]
+	sqlDatabases  *FilterableList[*sqladmin.Database]
+	sqlOperations *FilterableList[*sqladmin.Operation]
+
+	storageBuckets        map[string]*storage.Bucket
+	storageBucketObjects  map[string]int
+	storageObjects        map[string]map[string]*storage.Object
+	storageBucketPolicies map[string]*storage.Policy
+	storageHmacKeys       map[string]*storage.HmacKeyMetadata
+	iamServiceAccounts    map[string]*iam.ServiceAccount
+
+	kmsKeyRings   map[string]*cloudkms.KeyRing
+	kmsCryptoKeys map[string]*cloudkms.CryptoKey
+
 	tagKeys     *FilterableList[*resourcemanagerpb.TagKey]
 	tagValues   *FilterableList[*resourcemanagerpb.TagValue]
 	tagBindings *FilterableList[*resourcemanagerpb.TagBinding]
@@ -96,6 +130,11 @@ var _ gcpclient.RedisClusterClient = (*store)(nil)
 var _ gcpclient.NetworkConnectivityClient = (*store)(nil)
 var _ gcpclient.ResourceManagerClient = (*store)(nil)
 var _ gcpclient.ServiceNetworkingClient = (*store)(nil)
+var _ gcpclient.CloudSqlClient = (*store)(nil)
+var _ gcpclient.StorageClient = (*store)(nil)
+var _ gcpclient.IamServiceAccountClient = (*store)(nil)
+var _ gcpclient.KmsClient = (*store)(nil)
+var _ gcpclient.FirewallsClient = (*store)(nil)
 
 func (s *store) ProjectId() string {
 	return s.projectId
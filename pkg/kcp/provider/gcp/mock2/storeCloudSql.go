@@ -0,0 +1,375 @@
+package mock2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/servicenetworking/v1"
+	"google.golang.org/api/sqladmin/v1"
+)
+
+/*
+backendType: SECOND_GEN
+connectionName: my-project:europe-west1:my-instance
+databaseInstalledVersion: POSTGRES_16_4
+databaseVersion: POSTGRES_16
+gceZone: europe-west1-b
+instanceType: CLOUD_SQL_INSTANCE
+ipAddresses:
+- ipAddress: 10.250.4.3
+  type: PRIVATE
+kind: sql#instance
+name: my-instance
+project: my-project
+region: europe-west1
+selfLink: https://sqladmin.googleapis.com/sql/v1beta4/projects/my-project/instances/my-instance
+settings:
+  availabilityType: ZONAL
+  dataDiskSizeGb: '20'
+  edition: ENTERPRISE
+  ipConfiguration:
+    allocatedIpRange: my-psa-range
+    ipv4Enabled: false
+    privateNetwork: projects/my-project/global/networks/my-network
+    sslMode: ENCRYPTED_ONLY
+  maintenanceWindow:
+    day: 7
+    hour: 23
+  tier: db-custom-2-8192
+  userLabels:
+    cloud-manager: kcp
+state: RUNNABLE
+*/
+
+type CloudSqlOperationsConfig interface {
+	// ResolveCloudSqlInstanceOperations completes all pending operations targeting the given Cloud SQL instance.
+	// Created instances become RUNNABLE and deleted instances are removed.
+	ResolveCloudSqlInstanceOperations(ctx context.Context, instanceId string) error
+}
+
+const (
+	cloudSqlOperationStatusRunning = "RUNNING"
+	cloudSqlOperationStatusDone    = "DONE"
+
+	cloudSqlOperationTypeCreate         = "CREATE"
+	cloudSqlOperationTypeUpdate         = "UPDATE"
+	cloudSqlOperationTypeDelete         = "DELETE"
+	cloudSqlOperationTypeCreateDatabase = "CREATE_DATABASE"
+
+	cloudSqlInstanceStateRunnable      = "RUNNABLE"
+	cloudSqlInstanceStatePendingCreate = "PENDING_CREATE"
+	cloudSqlInstanceStatePendingDelete = "PENDING_DELETE"
+)
+
+// private methods ============================================================================
+
+func (s *store) getSqlInstanceNoLock(projectId, instanceId string) (*sqladmin.DatabaseInstance, error) {
+	name := gcputil.NewSqlInstanceName(projectId, instanceId)
+	inst, found := s.sqlInstances.FindByName(name)
+	if !found {
+		return nil, gcpmeta.NewNotFoundError("sql instance %s not found", name.String())
+	}
+	return inst, nil
+}
+
+func (s *store) newSqlOperationNoLock(projectId, instanceId, opType, status string) *sqladmin.Operation {
+	opName := s.newLongRunningOperationName()
+	op := &sqladmin.Operation{
+		Kind:          "sql#operation",
+		Name:          opName.ResourceId(),
+		OperationType: opType,
+		Status:        status,
+		TargetId:      instanceId,
+		TargetProject: projectId,
+	}
+	s.sqlOperations.Add(op, opName)
+	return op
+}
+
+// CloudSqlOperationsConfig ==================================================================
+
+func (s *store) ResolveCloudSqlInstanceOperations(ctx context.Context, instanceId string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	instName := gcputil.NewSqlInstanceName(s.projectId, instanceId)
+	inst, found := s.sqlInstances.FindByName(instName)
+	if !found {
+		return gcpmeta.NewNotFoundError("sql instance %s not found", instName.String())
+	}
+
+	deleted := false
+	for _, item := range s.sqlOperations.items {
+		op := item.Obj
+		if op.TargetId != instanceId || op.Status == cloudSqlOperationStatusDone {
+			continue
+		}
+		op.Status = cloudSqlOperationStatusDone
+		switch op.OperationType {
+		case cloudSqlOperationTypeDelete:
+			deleted = true
+		default:
+			inst.State = cloudSqlInstanceStateRunnable
+		}
+	}
+
+	if deleted {
+		s.sqlInstances = s.sqlInstances.FilterNotByCallback(func(item FilterableListItem[*sqladmin.DatabaseInstance]) bool {
+			return item.Name.Equal(instName)
+		})
+		s.sqlDatabases = s.sqlDatabases.FilterNotByCallback(func(item FilterableListItem[*sqladmin.Database]) bool {
+			return item.Obj.Instance == instanceId
+		})
+	}
+
+	return nil
+}
+
+// CloudSqlClient =============================================================================
+
+func (s *store) GetSqlInstance(ctx context.Context, projectId, instanceId string) (*sqladmin.DatabaseInstance, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	inst, err := s.getSqlInstanceNoLock(projectId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	return util.Clone(inst)
+}
+
+func (s *store) InsertSqlInstance(ctx context.Context, projectId string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if instance == nil || instance.Name == "" {
+		return nil, gcpmeta.NewBadRequestError("instance name is required")
+	}
+	instName := gcputil.NewSqlInstanceName(projectId, instance.Name)
+	if _, found := s.sqlInstances.FindByName(instName); found {
+		return nil, gcpmeta.NewBadRequestError("sql instance %s already exists", instName.String())
+	}
+
+	validVersions := []string{"POSTGRES_15", "POSTGRES_16", "POSTGRES_17"}
+	if !slices.Contains(validVersions, instance.DatabaseVersion) {
+		return nil, gcpmeta.NewBadRequestError("invalid databaseVersion %q, must be one of %v", instance.DatabaseVersion, validVersions)
+	}
+	if instance.Settings == nil || instance.Settings.Tier == "" {
+		return nil, gcpmeta.NewBadRequestError("settings.tier is required")
+	}
+
+	// network validation
+	ipConfig := instance.Settings.IpConfiguration
+	if ipConfig == nil || ipConfig.PrivateNetwork == "" {
+		return nil, gcpmeta.NewBadRequestError("only private ip instances are supported in mock, settings.ipConfiguration.privateNetwork is required")
+	}
+	netName, err := gcputil.ParseNameDetail(ipConfig.PrivateNetwork)
+	if err != nil {
+		return nil, gcpmeta.NewBadRequestError("invalid private network name: %v", err)
+	}
+	if _, err := s.GetNetworkNoLock(netName.ProjectId(), netName.ResourceId()); err != nil {
+		return nil, gcpmeta.NewNotFoundError("private network %s not found", netName.String())
+	}
+
+	// PSA address range validation
+	if ipConfig.AllocatedIpRange == "" {
+		return nil, gcpmeta.NewBadRequestError("settings.ipConfiguration.allocatedIpRange is required")
+	}
+	addr, err := s.getAddressNoLock(netName.ProjectId(), "", ipConfig.AllocatedIpRange)
+	if err != nil {
+		return nil, gcpmeta.NewNotFoundError("allocated ip range %s not found", ipConfig.AllocatedIpRange)
+	}
+	if !netName.EqualString(addr.GetNetwork()) {
+		return nil, gcpmeta.NewBadRequestError("allocated ip range %q belongs to network %q, while sql instance is in network %q", ipConfig.AllocatedIpRange, addr.GetNetwork(), netName.String())
+	}
+	if addr.GetPurpose() != "VPC_PEERING" {
+		return nil, gcpmeta.NewBadRequestError("only address range of purpose VPC_PEERING can be used, but got %q", addr.GetPurpose())
+	}
+
+	serviceConnections := s.serviceConnections.
+		FilterByCallback(func(item FilterableListItem[*servicenetworking.Connection]) bool {
+			return netName.EqualString(item.Obj.Network)
+		}).
+		FilterByCallback(func(item FilterableListItem[*servicenetworking.Connection]) bool {
+			return pie.Contains(item.Obj.ReservedPeeringRanges, ipConfig.AllocatedIpRange)
+		})
+	if serviceConnections.Len() == 0 {
+		return nil, gcpmeta.NewNotFoundError("service connection in network %s linked to address %s not found", netName.String(), ipConfig.AllocatedIpRange)
+	}
+
+	// create sql instance
+	inst, err := util.Clone(instance)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone sql instance: %w", common.ErrLogical, err)
+	}
+	inst.Kind = "sql#instance"
+	inst.Project = projectId
+	inst.ConnectionName = fmt.Sprintf("%s:%s:%s", projectId, inst.Region, inst.Name)
+	inst.SelfLink = fmt.Sprintf("https://sqladmin.googleapis.com/sql/v1beta4/%s", instName.String())
+	inst.State = cloudSqlInstanceStatePendingCreate
+	// simplified takes first ip of the range
+	inst.IpAddresses = []*sqladmin.IpMapping{
+		{IpAddress: addr.GetAddress(), Type: "PRIVATE"},
+	}
+	// root password is write only
+	inst.RootPassword = ""
+
+	s.sqlInstances.Add(inst, instName)
+
+	// the default postgres database always exists
+	s.sqlDatabases.Add(&sqladmin.Database{
+		Kind:     "sql#database",
+		Name:     "postgres",
+		Instance: inst.Name,
+		Project:  projectId,
+	}, gcputil.NewSqlDatabaseName(projectId, inst.Name, "postgres"))
+
+	op := s.newSqlOperationNoLock(projectId, inst.Name, cloudSqlOperationTypeCreate, cloudSqlOperationStatusRunning)
+	return util.Clone(op)
+}
+
+func (s *store) PatchSqlInstance(ctx context.Context, projectId, instanceId string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	inst, err := s.getSqlInstanceNoLock(projectId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	if inst.State != cloudSqlInstanceStateRunnable {
+		return nil, gcpmeta.NewBadRequestError("sql instance %s is in state %s and can not be patched", instanceId, inst.State)
+	}
+	if instance == nil {
+		return nil, gcpmeta.NewBadRequestError("instance is required")
+	}
+
+	// simplified patch semantics, only the fields cloud-manager changes are applied
+	if instance.DatabaseVersion != "" {
+		inst.DatabaseVersion = instance.DatabaseVersion
+	}
+	if instance.Settings != nil {
+		if inst.Settings == nil {
+			inst.Settings = &sqladmin.Settings{}
+		}
+		if instance.Settings.Tier != "" {
+			inst.Settings.Tier = instance.Settings.Tier
+		}
+		if instance.Settings.DataDiskSizeGb != 0 {
+			if instance.Settings.DataDiskSizeGb < inst.Settings.DataDiskSizeGb {
+				return nil, gcpmeta.NewBadRequestError("dataDiskSizeGb can not be decreased")
+			}
+			inst.Settings.DataDiskSizeGb = instance.Settings.DataDiskSizeGb
+		}
+		if instance.Settings.AvailabilityType != "" {
+			inst.Settings.AvailabilityType = instance.Settings.AvailabilityType
+		}
+		if instance.Settings.MaintenanceWindow != nil {
+			inst.Settings.MaintenanceWindow = instance.Settings.MaintenanceWindow
+		}
+	}
+
+	// patch is applied immediately
+	op := s.newSqlOperationNoLock(projectId, instanceId, cloudSqlOperationTypeUpdate, cloudSqlOperationStatusDone)
+	return util.Clone(op)
+}
+
+func (s *store) DeleteSqlInstance(ctx context.Context, projectId, instanceId string) (*sqladmin.Operation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	inst, err := s.getSqlInstanceNoLock(projectId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	inst.State = cloudSqlInstanceStatePendingDelete
+
+	op := s.newSqlOperationNoLock(projectId, instanceId, cloudSqlOperationTypeDelete, cloudSqlOperationStatusRunning)
+	return util.Clone(op)
+}
+
+func (s *store) GetSqlDatabase(ctx context.Context, projectId, instanceId, databaseId string) (*sqladmin.Database, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	dbName := gcputil.NewSqlDatabaseName(projectId, instanceId, databaseId)
+	db, found := s.sqlDatabases.FindByName(dbName)
+	if !found {
+		return nil, gcpmeta.NewNotFoundError("sql database %s not found", dbName.String())
+	}
+	return util.Clone(db)
+}
+
+func (s *store) InsertSqlDatabase(ctx context.Context, projectId, instanceId string, database *sqladmin.Database) (*sqladmin.Operation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	inst, err := s.getSqlInstanceNoLock(projectId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	if inst.State != cloudSqlInstanceStateRunnable {
+		return nil, gcpmeta.NewBadRequestError("sql instance %s is in state %s and can not create databases", instanceId, inst.State)
+	}
+	if database == nil || database.Name == "" {
+		return nil, gcpmeta.NewBadRequestError("database name is required")
+	}
+
+	dbName := gcputil.NewSqlDatabaseName(projectId, instanceId, database.Name)
+	if _, found := s.sqlDatabases.FindByName(dbName); found {
+		return nil, gcpmeta.NewBadRequestError("sql database %s already exists", dbName.String())
+	}
+
+	db, err := util.Clone(database)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone sql database: %w", common.ErrLogical, err)
+	}
+	db.Kind = "sql#database"
+	db.Instance = instanceId
+	db.Project = projectId
+	s.sqlDatabases.Add(db, dbName)
+
+	op := s.newSqlOperationNoLock(projectId, instanceId, cloudSqlOperationTypeCreateDatabase, cloudSqlOperationStatusDone)
+	return util.Clone(op)
+}
+
+func (s *store) GetSqlOperation(ctx context.Context, projectId, operationId string) (*sqladmin.Operation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	opName := gcputil.NewOperationName(projectId, operationId)
+	op, found := s.sqlOperations.FindByName(opName)
+	if !found {
+		return nil, gcpmeta.NewNotFoundError("sql operation %s not found", opName.String())
+	}
+	return util.Clone(op)
+}
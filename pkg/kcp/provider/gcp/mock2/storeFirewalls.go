@@ -0,0 +1,125 @@
+package mock2
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// firewallKey returns the relative resource name of the global firewall rule
+func firewallKey(project, firewall string) string {
+	return fmt.Sprintf("projects/%s/global/firewalls/%s", project, firewall)
+}
+
+func (s *store) GetFirewall(ctx context.Context, req *computepb.GetFirewallRequest, _ ...gax.CallOption) (*computepb.Firewall, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if req.Project == "" {
+		return nil, gcpmeta.NewBadRequestError("project is required")
+	}
+	if req.Firewall == "" {
+		return nil, gcpmeta.NewBadRequestError("firewall is required")
+	}
+
+	fw, ok := s.firewalls[firewallKey(req.Project, req.Firewall)]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("firewall %s not found", firewallKey(req.Project, req.Firewall))
+	}
+	return util.Clone(fw)
+}
+
+func (s *store) InsertFirewall(ctx context.Context, req *computepb.InsertFirewallRequest, _ ...gax.CallOption) (gcpclient.VoidOperation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if req.Project == "" {
+		return nil, gcpmeta.NewBadRequestError("project is required")
+	}
+	if req.FirewallResource == nil || req.FirewallResource.GetName() == "" {
+		return nil, gcpmeta.NewBadRequestError("firewall name is required")
+	}
+	key := firewallKey(req.Project, req.FirewallResource.GetName())
+	if _, ok := s.firewalls[key]; ok {
+		return nil, gcpmeta.NewBadRequestError("firewall %s already exists", key)
+	}
+
+	networkName, err := gcputil.ParseNameDetail(req.FirewallResource.GetNetwork())
+	if err != nil {
+		networkName = gcputil.NewGlobalNetworkName(req.Project, req.FirewallResource.GetNetwork())
+	}
+	if _, err := s.GetNetworkNoLock(networkName.ProjectId(), networkName.ResourceId()); err != nil {
+		return nil, gcpmeta.NewBadRequestError("network %s not found", networkName.String())
+	}
+	if len(req.FirewallResource.Allowed) > 0 && len(req.FirewallResource.Denied) > 0 {
+		return nil, gcpmeta.NewBadRequestError("firewall can not have both allowed and denied rules")
+	}
+
+	fw, err := util.Clone(req.FirewallResource)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone firewall: %w", common.ErrLogical, err)
+	}
+	id := rand.Uint64()
+	fw.Id = new(id)
+	fw.Kind = new("compute#firewall")
+	fw.SelfLink = new("https://www.googleapis.com/compute/v1/" + key)
+	fw.Network = new(networkName.PrefixWithGoogleApisComputeV1())
+	if fw.Direction == nil {
+		fw.Direction = new(computepb.Firewall_INGRESS.String())
+	}
+	if fw.Priority == nil {
+		fw.Priority = new(int32(1000))
+	}
+	s.firewalls[key] = fw
+
+	op := s.createComputeOperationNoLock(req.Project, "", "insert", fw.GetSelfLink(), id)
+	op.Status = ptr.To(computepb.Operation_DONE)
+	op.EndTime = new(time.Now().Format(time.RFC3339))
+	op.Progress = new(int32(100))
+
+	return newComputeOperation(op), nil
+}
+
+func (s *store) DeleteFirewall(ctx context.Context, req *computepb.DeleteFirewallRequest, _ ...gax.CallOption) (gcpclient.VoidOperation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if req.Project == "" {
+		return nil, gcpmeta.NewBadRequestError("project is required")
+	}
+	if req.Firewall == "" {
+		return nil, gcpmeta.NewBadRequestError("firewall is required")
+	}
+	key := firewallKey(req.Project, req.Firewall)
+	fw, ok := s.firewalls[key]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("firewall %s not found", key)
+	}
+	delete(s.firewalls, key)
+
+	op := s.createComputeOperationNoLock(req.Project, "", "delete", fw.GetSelfLink(), fw.GetId())
+	op.Status = ptr.To(computepb.Operation_DONE)
+	op.EndTime = new(time.Now().Format(time.RFC3339))
+	op.Progress = new(int32(100))
+
+	return newComputeOperation(op), nil
+}
@@ -0,0 +1,181 @@
+package mock2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+// KmsClient ===========================================================================
+
+func (s *store) GetKmsKeyRing(ctx context.Context, name string) (*cloudkms.KeyRing, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	kr, ok := s.kmsKeyRings[name]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("key ring %s not found", name)
+	}
+	return util.Clone(kr)
+}
+
+func (s *store) CreateKmsKeyRing(ctx context.Context, parent, keyRingId string) (*cloudkms.KeyRing, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if !strings.HasPrefix(parent, fmt.Sprintf("projects/%s/locations/", s.projectId)) {
+		return nil, gcpmeta.NewBadRequestError("invalid key ring parent %s", parent)
+	}
+	if keyRingId == "" {
+		return nil, gcpmeta.NewBadRequestError("key ring id is required")
+	}
+	name := fmt.Sprintf("%s/keyRings/%s", parent, keyRingId)
+	if _, ok := s.kmsKeyRings[name]; ok {
+		return nil, gcpmeta.NewBadRequestError("key ring %s already exists", name)
+	}
+
+	kr := &cloudkms.KeyRing{
+		Name:       name,
+		CreateTime: time.Now().Format(time.RFC3339),
+	}
+	s.kmsKeyRings[name] = kr
+	return util.Clone(kr)
+}
+
+func (s *store) GetKmsCryptoKey(ctx context.Context, name string) (*cloudkms.CryptoKey, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	ck, ok := s.kmsCryptoKeys[name]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("crypto key %s not found", name)
+	}
+	return util.Clone(ck)
+}
+
+func (s *store) CreateKmsCryptoKey(ctx context.Context, parent, cryptoKeyId string, cryptoKey *cloudkms.CryptoKey) (*cloudkms.CryptoKey, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if _, ok := s.kmsKeyRings[parent]; !ok {
+		return nil, gcpmeta.NewNotFoundError("key ring %s not found", parent)
+	}
+	if cryptoKeyId == "" {
+		return nil, gcpmeta.NewBadRequestError("crypto key id is required")
+	}
+	if cryptoKey == nil {
+		return nil, gcpmeta.NewBadRequestError("crypto key is required")
+	}
+	if cryptoKey.RotationPeriod != "" && cryptoKey.NextRotationTime == "" {
+		return nil, gcpmeta.NewBadRequestError("next rotation time is required when rotation period is set")
+	}
+	name := fmt.Sprintf("%s/cryptoKeys/%s", parent, cryptoKeyId)
+	if _, ok := s.kmsCryptoKeys[name]; ok {
+		return nil, gcpmeta.NewBadRequestError("crypto key %s already exists", name)
+	}
+
+	ck, err := util.Clone(cryptoKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone crypto key: %w", common.ErrLogical, err)
+	}
+	ck.Name = name
+	ck.CreateTime = time.Now().Format(time.RFC3339)
+	ck.Primary = &cloudkms.CryptoKeyVersion{
+		Name:  fmt.Sprintf("%s/cryptoKeyVersions/1", name),
+		State: "ENABLED",
+	}
+	s.kmsCryptoKeys[name] = ck
+	return util.Clone(ck)
+}
+
+func (s *store) PatchKmsCryptoKey(ctx context.Context, name, updateMask string, cryptoKey *cloudkms.CryptoKey) (*cloudkms.CryptoKey, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	ck, ok := s.kmsCryptoKeys[name]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("crypto key %s not found", name)
+	}
+	if cryptoKey == nil {
+		return nil, gcpmeta.NewBadRequestError("crypto key is required")
+	}
+	for _, field := range strings.Split(updateMask, ",") {
+		switch strings.TrimSpace(field) {
+		case "rotationPeriod", "rotation_period":
+			ck.RotationPeriod = cryptoKey.RotationPeriod
+		case "nextRotationTime", "next_rotation_time":
+			ck.NextRotationTime = cryptoKey.NextRotationTime
+		case "labels":
+			ck.Labels = cryptoKey.Labels
+		default:
+			return nil, gcpmeta.NewBadRequestError("unsupported crypto key update mask field %q", field)
+		}
+	}
+	return util.Clone(ck)
+}
+
+// ListKmsCryptoKeyVersions returns the primary version, the only one the mock creates since it does not rotate keys
+func (s *store) ListKmsCryptoKeyVersions(ctx context.Context, parent string) ([]*cloudkms.CryptoKeyVersion, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	ck, ok := s.kmsCryptoKeys[parent]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("crypto key %s not found", parent)
+	}
+	if ck.Primary == nil {
+		return nil, nil
+	}
+	v, err := util.Clone(ck.Primary)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone crypto key version: %w", common.ErrLogical, err)
+	}
+	return []*cloudkms.CryptoKeyVersion{v}, nil
+}
+
+func (s *store) DestroyKmsCryptoKeyVersion(ctx context.Context, name string) (*cloudkms.CryptoKeyVersion, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	parent, _, found := strings.Cut(name, "/cryptoKeyVersions/")
+	if !found {
+		return nil, gcpmeta.NewBadRequestError("invalid crypto key version name %s", name)
+	}
+	ck, ok := s.kmsCryptoKeys[parent]
+	if !ok || ck.Primary == nil || ck.Primary.Name != name {
+		return nil, gcpmeta.NewNotFoundError("crypto key version %s not found", name)
+	}
+	if ck.Primary.State != "ENABLED" && ck.Primary.State != "DISABLED" {
+		return nil, gcpmeta.NewBadRequestError("crypto key version %s is in state %s and can not be destroyed", name, ck.Primary.State)
+	}
+	ck.Primary.State = "DESTROY_SCHEDULED"
+	ck.Primary.DestroyTime = time.Now().Add(30 * 24 * time.Hour).Format(time.RFC3339)
+	return util.Clone(ck.Primary)
+}
@@ -0,0 +1,54 @@
+package mock2
+
+import (
+	"context"
+
+	"github.com/googleapis/gax-go/v2"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	locationpb "google.golang.org/genproto/googleapis/cloud/location"
+)
+
+// ListRedisLocations returns no locations, so the mock does not restrict region capabilities
+func (s *store) ListRedisLocations(ctx context.Context, _ *locationpb.ListLocationsRequest, _ ...gax.CallOption) gcpclient.Iterator[*locationpb.Location] {
+	if util.IsContextDone(ctx) {
+		return &iteratorMocked[*locationpb.Location]{
+			err: ctx.Err(),
+		}
+	}
+	return &iteratorMocked[*locationpb.Location]{}
+}
+
+// ListFilestoreLocations returns no locations, so the mock does not restrict region capabilities
+func (s *store) ListFilestoreLocations(ctx context.Context, _ *locationpb.ListLocationsRequest, _ ...gax.CallOption) gcpclient.Iterator[*locationpb.Location] {
+	if util.IsContextDone(ctx) {
+		return &iteratorMocked[*locationpb.Location]{
+			err: ctx.Err(),
+		}
+	}
+	return &iteratorMocked[*locationpb.Location]{}
+}
+
+func (s *store) GetRedisLocationIds(ctx context.Context, project string) ([]string, error) {
+	return locationIds(s.ListRedisLocations(ctx, &locationpb.ListLocationsRequest{
+		Name: "projects/" + project,
+	}))
+}
+
+func (s *store) GetFilestoreLocationIds(ctx context.Context, project string) ([]string, error) {
+	return locationIds(s.ListFilestoreLocations(ctx, &locationpb.ListLocationsRequest{
+		Name: "projects/" + project,
+	}))
+}
+
+func locationIds(it gcpclient.Iterator[*locationpb.Location]) ([]string, error) {
+	list, err := IteratorToSlice(it.All())
+	if err != nil {
+		return nil, err
+	}
+	var results []string
+	for _, x := range list {
+		results = append(results, x.GetLocationId())
+	}
+	return results, nil
+}
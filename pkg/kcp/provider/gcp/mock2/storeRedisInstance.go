@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"cloud.google.com/go/redis/apiv1/redispb"
 	"github.com/elliotchance/pie/v2"
@@ -193,6 +194,29 @@ func (s *store) GetRedisInstance(ctx context.Context, req *redispb.GetInstanceRe
 	return util.Clone(ri)
 }
 
+func (s *store) ListRedisInstances(ctx context.Context, req *redispb.ListInstancesRequest, _ ...gax.CallOption) gcpclient.Iterator[*redispb.Instance] {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return &iteratorMocked[*redispb.Instance]{
+			err: ctx.Err(),
+		}
+	}
+
+	list := s.redisInstances
+	if req.Parent != "" {
+		parentNd, err := gcputil.ParseNameDetail(req.Parent)
+		if err != nil {
+			return &iteratorMocked[*redispb.Instance]{
+				err: fmt.Errorf("invalid parent name: %w", err),
+			}
+		}
+		list = list.FilterByParent(parentNd)
+	}
+
+	return list.ToIterator()
+}
+
 func (s *store) GetRedisInstanceAuthString(ctx context.Context, req *redispb.GetInstanceAuthStringRequest, _ ...gax.CallOption) (*redispb.InstanceAuthString, error) {
 	s.m.Lock()
 	defer s.m.Unlock()
@@ -309,3 +333,83 @@ func (s *store) DeleteRedisInstance(ctx context.Context, req *redispb.DeleteInst
 
 	return b.BuildVoidOperation(), nil
 }
+
+func (s *store) ExportRedisInstance(ctx context.Context, req *redispb.ExportInstanceRequest, _ ...gax.CallOption) (gcpclient.ResultOperation[*redispb.Instance], error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	riName, err := gcputil.ParseNameDetail(req.Name)
+	if err != nil {
+		return nil, gcpmeta.NewBadRequestError("invalid redisInstance instance name: %v", err)
+	}
+
+	ri, err := s.getRedisInstanceNoLock(riName.String())
+	if err != nil {
+		return nil, gcpmeta.NewNotFoundError("redisInstance %s not found", riName.String())
+	}
+	if ri.State != redispb.Instance_READY {
+		return nil, gcpmeta.NewBadRequestError("redisInstance %s is not ready", riName.String())
+	}
+
+	uri := req.GetOutputConfig().GetGcsDestination().GetUri()
+	bucketName, objectName, ok := strings.Cut(strings.TrimPrefix(uri, "gs://"), "/")
+	if !strings.HasPrefix(uri, "gs://") || !ok || objectName == "" {
+		return nil, gcpmeta.NewBadRequestError("invalid output uri %s", uri)
+	}
+	if err := s.insertStorageObjectNoLock(bucketName, objectName); err != nil {
+		return nil, err
+	}
+
+	opName := s.newLongRunningOperationName()
+	b := NewOperationLongRunningBuilder(opName.String(), riName)
+	if err := b.WithRedisInstanceMetadata(riName, "export"); err != nil {
+		return nil, fmt.Errorf("%w: failed setting export redisInstance operation metadata: %w", common.ErrLogical, err)
+	}
+	s.longRunningOperations.Add(b, opName)
+
+	return NewResultOperation[*redispb.Instance](b.GetOperationPB()), nil
+}
+
+func (s *store) ImportRedisInstance(ctx context.Context, req *redispb.ImportInstanceRequest, _ ...gax.CallOption) (gcpclient.ResultOperation[*redispb.Instance], error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	riName, err := gcputil.ParseNameDetail(req.Name)
+	if err != nil {
+		return nil, gcpmeta.NewBadRequestError("invalid redisInstance instance name: %v", err)
+	}
+
+	ri, err := s.getRedisInstanceNoLock(riName.String())
+	if err != nil {
+		return nil, gcpmeta.NewNotFoundError("redisInstance %s not found", riName.String())
+	}
+	if ri.State != redispb.Instance_READY {
+		return nil, gcpmeta.NewBadRequestError("redisInstance %s is not ready", riName.String())
+	}
+
+	uri := req.GetInputConfig().GetGcsSource().GetUri()
+	bucketName, objectName, ok := strings.Cut(strings.TrimPrefix(uri, "gs://"), "/")
+	if !strings.HasPrefix(uri, "gs://") || !ok || objectName == "" {
+		return nil, gcpmeta.NewBadRequestError("invalid input uri %s", uri)
+	}
+	if _, ok := s.storageObjects[bucketName][objectName]; !ok {
+		return nil, gcpmeta.NewNotFoundError("object %s not found in bucket %s", objectName, bucketName)
+	}
+
+	ri.State = redispb.Instance_IMPORTING
+
+	opName := s.newLongRunningOperationName()
+	b := NewOperationLongRunningBuilder(opName.String(), riName)
+	if err := b.WithRedisInstanceMetadata(riName, "import"); err != nil {
+		return nil, fmt.Errorf("%w: failed setting import redisInstance operation metadata: %w", common.ErrLogical, err)
+	}
+	s.longRunningOperations.Add(b, opName)
+
+	return NewResultOperation[*redispb.Instance](b.GetOperationPB()), nil
+}
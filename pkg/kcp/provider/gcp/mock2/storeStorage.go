@@ -0,0 +1,497 @@
+package mock2
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/google/uuid"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/storage/v1"
+)
+
+type StorageConfig interface {
+	// AddStorageBucketObjects simulates workloads writing the given number of objects into the bucket
+	AddStorageBucketObjects(ctx context.Context, bucketName string, count int) error
+	GetStorageBucketObjectCount(ctx context.Context, bucketName string) (int, error)
+	// InsertStorageObject simulates a named object being written into the bucket, like an exported RDB snapshot
+	InsertStorageObject(ctx context.Context, bucketName, objectName string) error
+}
+
+const (
+	storageHmacKeyStateActive   = "ACTIVE"
+	storageHmacKeyStateInactive = "INACTIVE"
+)
+
+// StorageConfig ===========================================================================
+
+func (s *store) AddStorageBucketObjects(ctx context.Context, bucketName string, count int) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	s.storageBucketObjects[bucketName] += count
+	return nil
+}
+
+func (s *store) GetStorageBucketObjectCount(ctx context.Context, bucketName string) (int, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return 0, ctx.Err()
+	}
+
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return 0, gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	return s.storageBucketObjects[bucketName], nil
+}
+
+func (s *store) InsertStorageObject(ctx context.Context, bucketName, objectName string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	return s.insertStorageObjectNoLock(bucketName, objectName)
+}
+
+func (s *store) insertStorageObjectNoLock(bucketName, objectName string) error {
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	if _, ok := s.storageObjects[bucketName]; !ok {
+		s.storageObjects[bucketName] = map[string]*storage.Object{}
+	}
+	if _, ok := s.storageObjects[bucketName][objectName]; !ok {
+		s.storageBucketObjects[bucketName]++
+	}
+	s.storageObjects[bucketName][objectName] = &storage.Object{
+		Kind:       "storage#object",
+		Id:         fmt.Sprintf("%s/%s", bucketName, objectName),
+		Bucket:     bucketName,
+		Name:       objectName,
+		Generation: rand.Int63(),
+		SelfLink:   fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s/o/%s", bucketName, objectName),
+	}
+	return nil
+}
+
+// StorageClient ===========================================================================
+
+func (s *store) GetStorageBucket(ctx context.Context, bucketName string) (*storage.Bucket, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	b, ok := s.storageBuckets[bucketName]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	return util.Clone(b)
+}
+
+func (s *store) InsertStorageBucket(ctx context.Context, projectId string, bucket *storage.Bucket) (*storage.Bucket, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if bucket == nil || bucket.Name == "" {
+		return nil, gcpmeta.NewBadRequestError("bucket name is required")
+	}
+	if _, ok := s.storageBuckets[bucket.Name]; ok {
+		return nil, gcpmeta.NewBadRequestError("bucket %s already exists", bucket.Name)
+	}
+	if bucket.Versioning != nil && bucket.Versioning.Enabled && bucket.RetentionPolicy != nil {
+		return nil, gcpmeta.NewBadRequestError("retention policy can not be used together with object versioning")
+	}
+	if bucket.IpFilter != nil {
+		for _, src := range bucket.IpFilter.VpcNetworkSources {
+			netName, err := gcputil.ParseNameDetail(src.Network)
+			if err != nil {
+				return nil, gcpmeta.NewBadRequestError("invalid ip filter network name: %v", err)
+			}
+			if _, err := s.GetNetworkNoLock(netName.ProjectId(), netName.ResourceId()); err != nil {
+				return nil, gcpmeta.NewBadRequestError("ip filter network %s not found", src.Network)
+			}
+		}
+	}
+
+	b, err := util.Clone(bucket)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone bucket: %w", common.ErrLogical, err)
+	}
+	b.Kind = "storage#bucket"
+	b.Id = b.Name
+	b.Location = strings.ToUpper(b.Location)
+	b.Metageneration = 1
+	b.SelfLink = fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s", b.Name)
+	b.ProjectNumber = rand.Uint64()
+
+	s.storageBuckets[b.Name] = b
+	s.storageBucketObjects[b.Name] = 0
+
+	return util.Clone(b)
+}
+
+func (s *store) PatchStorageBucket(ctx context.Context, bucketName string, bucket *storage.Bucket) (*storage.Bucket, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	b, ok := s.storageBuckets[bucketName]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	if bucket == nil {
+		return nil, gcpmeta.NewBadRequestError("bucket is required")
+	}
+
+	// simplified patch semantics, only the fields cloud-manager changes are applied
+	if bucket.Versioning != nil {
+		b.Versioning = bucket.Versioning
+	}
+	if bucket.Lifecycle != nil {
+		b.Lifecycle = bucket.Lifecycle
+	}
+	if bucket.Labels != nil {
+		b.Labels = bucket.Labels
+	}
+	if bucket.RetentionPolicy != nil {
+		if b.RetentionPolicy != nil && b.RetentionPolicy.IsLocked && bucket.RetentionPolicy.RetentionPeriod < b.RetentionPolicy.RetentionPeriod {
+			return nil, gcpmeta.NewBadRequestError("locked retention policy can not be reduced")
+		}
+		b.RetentionPolicy = bucket.RetentionPolicy
+	}
+	if slices.Contains(bucket.NullFields, "RetentionPolicy") {
+		if b.RetentionPolicy != nil && b.RetentionPolicy.IsLocked {
+			return nil, gcpmeta.NewBadRequestError("locked retention policy can not be removed")
+		}
+		b.RetentionPolicy = nil
+	}
+	if slices.Contains(bucket.NullFields, "Lifecycle") {
+		b.Lifecycle = nil
+	}
+	if b.Versioning != nil && b.Versioning.Enabled && b.RetentionPolicy != nil {
+		return nil, gcpmeta.NewBadRequestError("retention policy can not be used together with object versioning")
+	}
+	b.Metageneration++
+
+	return util.Clone(b)
+}
+
+func (s *store) DeleteStorageBucket(ctx context.Context, bucketName string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	if s.storageBucketObjects[bucketName] > 0 {
+		return gcpmeta.NewBadRequestError("the bucket %s you tried to delete is not empty", bucketName)
+	}
+
+	delete(s.storageBuckets, bucketName)
+	delete(s.storageBucketObjects, bucketName)
+	delete(s.storageObjects, bucketName)
+	delete(s.storageBucketPolicies, bucketName)
+	return nil
+}
+
+func (s *store) DeleteStorageBucketObjects(ctx context.Context, bucketName string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	b, ok := s.storageBuckets[bucketName]
+	if !ok {
+		return gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	if b.RetentionPolicy != nil && b.RetentionPolicy.RetentionPeriod > 0 && s.storageBucketObjects[bucketName] > 0 {
+		return gcpmeta.NewNotAuthorizedError("objects in bucket %s are subject to the bucket's retention policy and can not be deleted", bucketName)
+	}
+	s.storageBucketObjects[bucketName] = 0
+	delete(s.storageObjects, bucketName)
+	return nil
+}
+
+func (s *store) GetStorageObject(ctx context.Context, bucketName, objectName string) (*storage.Object, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return nil, gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	obj, ok := s.storageObjects[bucketName][objectName]
+	if !ok {
+		return nil, gcpmeta.NewNotFoundError("object %s not found in bucket %s", objectName, bucketName)
+	}
+	return util.Clone(obj)
+}
+
+func (s *store) DeleteStorageObject(ctx context.Context, bucketName, objectName string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	b, ok := s.storageBuckets[bucketName]
+	if !ok {
+		return gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	if _, ok := s.storageObjects[bucketName][objectName]; !ok {
+		return gcpmeta.NewNotFoundError("object %s not found in bucket %s", objectName, bucketName)
+	}
+	if b.RetentionPolicy != nil && b.RetentionPolicy.RetentionPeriod > 0 {
+		return gcpmeta.NewNotAuthorizedError("object %s in bucket %s is subject to the bucket's retention policy and can not be deleted", objectName, bucketName)
+	}
+	delete(s.storageObjects[bucketName], objectName)
+	s.storageBucketObjects[bucketName]--
+	return nil
+}
+
+func (s *store) GetStorageBucketIamPolicy(ctx context.Context, bucketName string) (*storage.Policy, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return nil, gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	p, ok := s.storageBucketPolicies[bucketName]
+	if !ok {
+		return &storage.Policy{
+			Kind:       "storage#policy",
+			ResourceId: fmt.Sprintf("projects/_/buckets/%s", bucketName),
+			Version:    1,
+			Etag:       "CAE=",
+		}, nil
+	}
+	return util.Clone(p)
+}
+
+func (s *store) SetStorageBucketIamPolicy(ctx context.Context, bucketName string, policy *storage.Policy) (*storage.Policy, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if _, ok := s.storageBuckets[bucketName]; !ok {
+		return nil, gcpmeta.NewNotFoundError("bucket %s not found", bucketName)
+	}
+	for _, b := range policy.Bindings {
+		for _, m := range b.Members {
+			email, isSa := strings.CutPrefix(m, "serviceAccount:")
+			if isSa && s.getServiceAccountByEmailNoLock(email) == nil {
+				return nil, gcpmeta.NewBadRequestError("service account %s does not exist", email)
+			}
+		}
+	}
+
+	p, err := util.Clone(policy)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone bucket policy: %w", common.ErrLogical, err)
+	}
+	p.Kind = "storage#policy"
+	p.ResourceId = fmt.Sprintf("projects/_/buckets/%s", bucketName)
+	p.Etag = uuid.NewString()
+	s.storageBucketPolicies[bucketName] = p
+
+	return util.Clone(p)
+}
+
+func (s *store) ListStorageHmacKeys(ctx context.Context, projectId, serviceAccountEmail string) ([]*storage.HmacKeyMetadata, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	var result []*storage.HmacKeyMetadata
+	for _, k := range s.storageHmacKeys {
+		if k.ProjectId == projectId && k.ServiceAccountEmail == serviceAccountEmail {
+			result = append(result, k)
+		}
+	}
+	slices.SortFunc(result, func(a, b *storage.HmacKeyMetadata) int {
+		return strings.Compare(a.AccessId, b.AccessId)
+	})
+	return util.Clone(result)
+}
+
+func (s *store) CreateStorageHmacKey(ctx context.Context, projectId, serviceAccountEmail string) (*storage.HmacKey, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if s.getServiceAccountByEmailNoLock(serviceAccountEmail) == nil {
+		return nil, gcpmeta.NewBadRequestError("service account %s does not exist", serviceAccountEmail)
+	}
+
+	accessId := "GOOG1E" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", ""))
+	meta := &storage.HmacKeyMetadata{
+		Kind:                "storage#hmacKeyMetadata",
+		AccessId:            accessId,
+		Id:                  fmt.Sprintf("%s/%s", projectId, accessId),
+		ProjectId:           projectId,
+		ServiceAccountEmail: serviceAccountEmail,
+		State:               storageHmacKeyStateActive,
+	}
+	s.storageHmacKeys[accessId] = meta
+
+	m, err := util.Clone(meta)
+	if err != nil {
+		return nil, fmt.Errorf("%w failed to clone hmac key: %w", common.ErrLogical, err)
+	}
+	return &storage.HmacKey{
+		Kind:     "storage#hmacKey",
+		Metadata: m,
+		Secret:   uuid.NewString(),
+	}, nil
+}
+
+func (s *store) DeactivateStorageHmacKey(ctx context.Context, projectId, accessId string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	k, ok := s.storageHmacKeys[accessId]
+	if !ok || k.ProjectId != projectId {
+		return gcpmeta.NewNotFoundError("hmac key %s not found", accessId)
+	}
+	k.State = storageHmacKeyStateInactive
+	return nil
+}
+
+func (s *store) DeleteStorageHmacKey(ctx context.Context, projectId, accessId string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	k, ok := s.storageHmacKeys[accessId]
+	if !ok || k.ProjectId != projectId {
+		return gcpmeta.NewNotFoundError("hmac key %s not found", accessId)
+	}
+	if k.State != storageHmacKeyStateInactive {
+		return gcpmeta.NewBadRequestError("hmac key %s must be inactive before it can be deleted", accessId)
+	}
+	delete(s.storageHmacKeys, accessId)
+	return nil
+}
+
+// IamServiceAccountClient =================================================================
+
+func (s *store) getServiceAccountByEmailNoLock(email string) *iam.ServiceAccount {
+	for _, sa := range s.iamServiceAccounts {
+		if sa.Email == email {
+			return sa
+		}
+	}
+	return nil
+}
+
+func (s *store) GetServiceAccount(ctx context.Context, projectId, accountId string) (*iam.ServiceAccount, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	sa := s.getServiceAccountByEmailNoLock(gcpclient.ServiceAccountEmail(projectId, accountId))
+	if sa == nil {
+		return nil, gcpmeta.NewNotFoundError("service account %s not found", accountId)
+	}
+	return util.Clone(sa)
+}
+
+func (s *store) CreateServiceAccount(ctx context.Context, projectId, accountId, displayName string) (*iam.ServiceAccount, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if len(accountId) < 6 || len(accountId) > 30 {
+		return nil, gcpmeta.NewBadRequestError("service account id %q must be between 6 and 30 characters", accountId)
+	}
+	email := gcpclient.ServiceAccountEmail(projectId, accountId)
+	if s.getServiceAccountByEmailNoLock(email) != nil {
+		return nil, gcpmeta.NewBadRequestError("service account %s already exists", email)
+	}
+
+	sa := &iam.ServiceAccount{
+		Name:        fmt.Sprintf("projects/%s/serviceAccounts/%s", projectId, email),
+		ProjectId:   projectId,
+		Email:       email,
+		DisplayName: displayName,
+		UniqueId:    fmt.Sprintf("%d", rand.Uint64()),
+	}
+	s.iamServiceAccounts[email] = sa
+
+	return util.Clone(sa)
+}
+
+func (s *store) DeleteServiceAccount(ctx context.Context, projectId, accountId string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return ctx.Err()
+	}
+
+	email := gcpclient.ServiceAccountEmail(projectId, accountId)
+	if s.getServiceAccountByEmailNoLock(email) == nil {
+		return gcpmeta.NewNotFoundError("service account %s not found", email)
+	}
+	delete(s.iamServiceAccounts, email)
+
+	// bindings of the deleted service account remain in policies, with the deleted: prefix
+	for _, p := range s.storageBucketPolicies {
+		for _, b := range p.Bindings {
+			b.Members = pie.Map(b.Members, func(m string) string {
+				if m == "serviceAccount:"+email {
+					return "deleted:" + m
+				}
+				return m
+			})
+		}
+	}
+	return nil
+}
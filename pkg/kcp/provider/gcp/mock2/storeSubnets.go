@@ -247,3 +247,41 @@ func (s *store) DeleteSubnet(ctx context.Context, req *computepb.DeleteSubnetwor
 
 	return newComputeOperation(compOp), nil
 }
+
+func (s *store) PatchSubnet(ctx context.Context, req *computepb.PatchSubnetworkRequest, _ ...gax.CallOption) (gcpclient.VoidOperation, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if util.IsContextDone(ctx) {
+		return nil, ctx.Err()
+	}
+
+	if req.SubnetworkResource == nil {
+		return nil, gcpmeta.NewBadRequestError("subnetwork resource is required")
+	}
+
+	sub, err := s.getSubnetNoLock(req.Project, req.Region, req.Subnetwork)
+	if err != nil {
+		return nil, err
+	}
+	if sub.GetFingerprint() != req.SubnetworkResource.GetFingerprint() {
+		return nil, gcpmeta.NewBadRequestError("subnet %s fingerprint mismatch", sub.GetSelfLink())
+	}
+
+	// only the fields the cloud-manager patches are supported
+	if req.SubnetworkResource.LogConfig != nil {
+		lc, err := util.Clone(req.SubnetworkResource.LogConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to clone subnet log config: %w", err)
+		}
+		sub.LogConfig = lc
+		sub.EnableFlowLogs = lc.Enable
+	}
+	sub.Fingerprint = new(util.RandomString(12))
+
+	compOp := s.createComputeOperationNoLock(req.Project, req.Region, "patch", sub.GetSelfLink(), sub.GetId())
+	compOp.Status = ptr.To(computepb.Operation_DONE)
+	compOp.EndTime = new(time.Now().Format(time.RFC3339))
+	compOp.Progress = new(int32(100))
+
+	return newComputeOperation(compOp), nil
+}
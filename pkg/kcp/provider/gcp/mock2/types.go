@@ -1,14 +1,19 @@
 package mock2
 
 import (
+	gcpbucketclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/bucket/client"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/exposedData/client"
 	gcpiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/iprange/client"
 	gcpnfsbackupclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
 	gcpnfsinstancev2client "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsinstance/v2/client"
 	gcpnfsrestoreclientv2 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsrestore/client/v2"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcppostgresinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance/client"
+	gcpredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisbackup/client"
 	gcpredisclusterclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/rediscluster/client"
 	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
+	gcpruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/runtime/client"
 	gcpsubnetclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/subnet/client"
 	gcpvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcnetwork/client"
 )
@@ -27,6 +32,12 @@ type Clients interface {
 	gcpclient.ServiceNetworkingClient
 	gcpclient.SubnetClient
 	gcpclient.ResourceManagerClient
+	gcpclient.CloudSqlClient
+	gcpclient.StorageClient
+	gcpclient.IamServiceAccountClient
+	gcpclient.KmsClient
+	gcpclient.FirewallsClient
+	gcpexposeddataclient.LocationsClient
 }
 
 type Providers interface {
@@ -42,6 +53,11 @@ type Providers interface {
 	NfsRestoreV2Provider() gcpclient.GcpClientProvider[gcpnfsrestoreclientv2.FileRestoreClient]
 	IpRangeComputeProvider() gcpclient.GcpClientProvider[gcpiprangeclient.ComputeClient]
 	IpRangeServiceNetworkingProvider() gcpclient.GcpClientProvider[gcpiprangeclient.ServiceNetworkingClient]
+	PostgresInstanceProvider() gcpclient.GcpClientProvider[gcppostgresinstanceclient.Client]
+	BucketProvider() gcpclient.GcpClientProvider[gcpbucketclient.Client]
+	NukeOrphanProvider() gcpclient.GcpClientProvider[gcpnukeclient.NukeOrphanClient]
+	RedisBackupProvider() gcpclient.GcpClientProvider[gcpredisbackupclient.Client]
+	RuntimeProvider() gcpclient.GcpClientProvider[gcpruntimeclient.Client]
 	// all others feature's providers as they are refactored to switch using these new GCP clients
 }
 
@@ -55,6 +71,8 @@ type Configs interface {
 	RedisClusterOperationsConfig
 	ServiceConnectionPolicyOperationsConfig
 	ResourceManagerConfig
+	CloudSqlOperationsConfig
+	StorageConfig
 }
 
 type Store interface {
@@ -3,6 +3,7 @@ package v2
 import (
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 )
 
 // composeActions creates the main action pipeline for GCP NfsInstance reconciliation.
@@ -22,14 +23,17 @@ func composeActions() composed.Action {
 				createInstance,
 				waitInstanceReady,
 				modifyCapacityGb,
+				drift.New(drift.KindNfsInstance, detectDrift),
 				updateInstance,
 				updateStatus,
+				drift.StopAndForgetAction(drift.KindNfsInstance),
 			),
 			composed.ComposeActions(
 				"delete",
 				removeReadyCondition,
 				deleteInstance,
 				waitInstanceDeleted,
+				drift.Forget(drift.KindNfsInstance),
 				actions.RemoveCommonFinalizer(),
 			),
 		),
@@ -0,0 +1,21 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// detectDrift reports the spec fields that modifyCapacityGb found different from the Filestore instance.
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	var fields []string
+	for _, path := range state.updateMask {
+		if path == "file_shares" {
+			fields = append(fields, "capacityGb")
+		}
+	}
+
+	return fields
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
 )
@@ -106,7 +107,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 					Reason:  v1beta1.ReasonReady,
 					Message: "Filestore instance provisioned in GCP.",
 				}).
-				SuccessError(composed.StopAndForget).
+				SuccessError(drift.StopAndForget(drift.KindNfsInstance)).
 				Run(ctx, state)
 		}
 		return nil, ctx
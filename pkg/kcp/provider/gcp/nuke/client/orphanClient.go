@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	"cloud.google.com/go/redis/apiv1/redispb"
+	"github.com/googleapis/gax-go/v2"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+)
+
+const (
+	GcpRedisInstance     = "GcpRedisInstance"
+	GcpFilestoreInstance = "GcpFilestoreInstance"
+	GcpGlobalAddress     = "GcpGlobalAddress"
+	GcpNetworkPeering    = "GcpNetworkPeering"
+)
+
+// NukeOrphanClient lists and deletes provider resources created by cloud-manager
+// that might have been left behind without their KCP object
+type NukeOrphanClient interface {
+	ListRedisInstances(ctx context.Context, req *redispb.ListInstancesRequest, opts ...gax.CallOption) gcpclient.Iterator[*redispb.Instance]
+	DeleteRedisInstance(ctx context.Context, req *redispb.DeleteInstanceRequest, opts ...gax.CallOption) (gcpclient.VoidOperation, error)
+
+	ListFilestoreInstances(ctx context.Context, req *filestorepb.ListInstancesRequest, opts ...gax.CallOption) gcpclient.Iterator[*filestorepb.Instance]
+	DeleteFilestoreInstance(ctx context.Context, req *filestorepb.DeleteInstanceRequest, opts ...gax.CallOption) (gcpclient.VoidOperation, error)
+
+	ListGlobalAddresses(ctx context.Context, req *computepb.ListGlobalAddressesRequest, opts ...gax.CallOption) gcpclient.Iterator[*computepb.Address]
+	DeleteGlobalAddress(ctx context.Context, req *computepb.DeleteGlobalAddressRequest, opts ...gax.CallOption) (gcpclient.VoidOperation, error)
+
+	GetNetwork(ctx context.Context, req *computepb.GetNetworkRequest, opts ...gax.CallOption) (*computepb.Network, error)
+	RemovePeering(ctx context.Context, req *computepb.RemovePeeringNetworkRequest, opts ...gax.CallOption) (gcpclient.VoidOperation, error)
+}
+
+func NewOrphanClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProvider[NukeOrphanClient] {
+	return func(_ string) NukeOrphanClient {
+		return &orphanClient{
+			RedisInstanceClient:   gcpClients.RedisInstanceWrapped(),
+			FilestoreClient:       gcpClients.FilestoreWrapped(),
+			GlobalAddressesClient: gcpClients.GlobalAddressesWrapped(),
+			VpcNetworkClient:      gcpClients.NetworkWrapped(),
+		}
+	}
+}
+
+var _ NukeOrphanClient = (*orphanClient)(nil)
+
+type orphanClient struct {
+	gcpclient.RedisInstanceClient
+	gcpclient.FilestoreClient
+	gcpclient.GlobalAddressesClient
+	gcpclient.VpcNetworkClient
+}
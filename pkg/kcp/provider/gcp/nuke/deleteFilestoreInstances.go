@@ -0,0 +1,37 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+)
+
+func deleteFilestoreInstances(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == gcpnukeclient.GcpFilestoreInstance && rks.Provider == cloudcontrolv1beta1.ProviderGCP {
+			for _, obj := range rks.Objects {
+				instance := obj.(GcpFilestoreInstance)
+				if instance.State == filestorepb.Instance_DELETING {
+					continue
+				}
+				_, err := state.orphanClient.DeleteFilestoreInstance(ctx, &filestorepb.DeleteInstanceRequest{
+					Name:  instance.Name,
+					Force: true,
+				})
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Gcp Filestore instance deletion %s", instance.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Gcp Filestore instance %s: %w", instance.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,37 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+)
+
+// deleteGlobalAddresses requests deletion of orphan global addresses. Address can not be deleted while
+// it is used by other resources, so it should run after those are deleted, and failed attempts are retried
+// on the next reconciliation.
+func deleteGlobalAddresses(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == gcpnukeclient.GcpGlobalAddress && rks.Provider == cloudcontrolv1beta1.ProviderGCP {
+			for _, obj := range rks.Objects {
+				addr := obj.(GcpGlobalAddress)
+				_, err := state.orphanClient.DeleteGlobalAddress(ctx, &computepb.DeleteGlobalAddressRequest{
+					Project: state.GetProject(),
+					Address: addr.GetName(),
+				})
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Gcp global address deletion %s", addr.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Gcp global address %s: %w", addr.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,37 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+)
+
+func deleteNetworkPeerings(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == gcpnukeclient.GcpNetworkPeering && rks.Provider == cloudcontrolv1beta1.ProviderGCP {
+			for _, obj := range rks.Objects {
+				peering := obj.(GcpNetworkPeering)
+				_, err := state.orphanClient.RemovePeering(ctx, &computepb.RemovePeeringNetworkRequest{
+					Project: state.GetProject(),
+					Network: state.GetVpcNetwork(),
+					NetworksRemovePeeringRequestResource: &computepb.NetworksRemovePeeringRequest{
+						Name: peering.Name,
+					},
+				})
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Gcp network peering removal %s", peering.GetId()))
+					state.AddDeleteError(fmt.Errorf("error removing Gcp network peering %s: %w", peering.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
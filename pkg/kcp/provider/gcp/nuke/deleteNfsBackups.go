@@ -23,6 +23,7 @@ func deleteNfsBackup(ctx context.Context, st composed.State) (error, context.Con
 				_, err := state.fileBackupClient.DeleteFileBackup(ctx, project, location, name)
 				if err != nil {
 					logger.Error(err, fmt.Sprintf("Error requesting Gcp Filestore Backup deletion %s", backup.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Gcp Filestore Backup %s: %w", backup.GetId(), err))
 				}
 			}
 		}
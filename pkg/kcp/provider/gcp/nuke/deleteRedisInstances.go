@@ -0,0 +1,36 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/redis/apiv1/redispb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+)
+
+func deleteRedisInstances(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, rks := range state.ProviderResources {
+		if rks.Kind == gcpnukeclient.GcpRedisInstance && rks.Provider == cloudcontrolv1beta1.ProviderGCP {
+			for _, obj := range rks.Objects {
+				instance := obj.(GcpRedisInstance)
+				if instance.State == redispb.Instance_DELETING {
+					continue
+				}
+				_, err := state.orphanClient.DeleteRedisInstance(ctx, &redispb.DeleteInstanceRequest{
+					Name: instance.Name,
+				})
+				if err != nil {
+					logger.Error(err, fmt.Sprintf("Error requesting Gcp Redis instance deletion %s", instance.GetId()))
+					state.AddDeleteError(fmt.Errorf("error deleting Gcp Redis instance %s: %w", instance.GetId(), err))
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,75 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadFilestoreInstances loads Filestore instances in the Scope's VPC network that have no NfsInstance
+func loadFilestoreInstances(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	network := gcputil.NewGlobalNetworkName(state.GetProject(), state.GetVpcNetwork())
+
+	var objects []nuketypes.ProviderResourceObject
+	it := state.orphanClient.ListFilestoreInstances(ctx, &filestorepb.ListInstancesRequest{
+		Parent: fmt.Sprintf("projects/%s/locations/-", state.GetProject()),
+	})
+	for instance, err := range it.All() {
+		if err != nil {
+			logger.Error(err, "Error listing Gcp Filestore instances")
+
+			state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+			return composed.PatchStatus(state.ObjAsNuke()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  "ErrorListingGcpFilestoreInstances",
+					Message: err.Error(),
+				}).
+				ErrorLogMessage("Error patching KCP Nuke status after list GCP Filestore instances error").
+				SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+				Run(ctx, state)
+		}
+		inNetwork := false
+		for _, nc := range instance.Networks {
+			if nc.Network == state.GetVpcNetwork() || network.EqualString(nc.Network) {
+				inNetwork = true
+				break
+			}
+		}
+		if !inNetwork {
+			continue
+		}
+		if !state.IsOrphan(path.Base(instance.Name), "NfsInstance") {
+			continue
+		}
+		objects = append(objects, GcpFilestoreInstance{instance})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Gcp Filestore instances", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     gcpnukeclient.GcpFilestoreInstance,
+		Provider: cloudcontrolv1beta1.ProviderGCP,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
@@ -0,0 +1,66 @@
+package nuke
+
+import (
+	"context"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadGlobalAddresses loads global addresses in the Scope's VPC network that have no IpRange
+func loadGlobalAddresses(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	network := gcputil.NewGlobalNetworkName(state.GetProject(), state.GetVpcNetwork())
+
+	var objects []nuketypes.ProviderResourceObject
+	it := state.orphanClient.ListGlobalAddresses(ctx, &computepb.ListGlobalAddressesRequest{
+		Project: state.GetProject(),
+	})
+	for addr, err := range it.All() {
+		if err != nil {
+			logger.Error(err, "Error listing Gcp global addresses")
+
+			state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+			return composed.PatchStatus(state.ObjAsNuke()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  "ErrorListingGcpGlobalAddresses",
+					Message: err.Error(),
+				}).
+				ErrorLogMessage("Error patching KCP Nuke status after list GCP global addresses error").
+				SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+				Run(ctx, state)
+		}
+		if !network.EqualString(addr.GetNetwork()) {
+			continue
+		}
+		if !state.IsOrphan(addr.GetName(), "IpRange") {
+			continue
+		}
+		objects = append(objects, GcpGlobalAddress{addr})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Gcp global addresses", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     gcpnukeclient.GcpGlobalAddress,
+		Provider: cloudcontrolv1beta1.ProviderGCP,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
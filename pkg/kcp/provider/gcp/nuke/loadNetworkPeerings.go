@@ -0,0 +1,66 @@
+package nuke
+
+import (
+	"context"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadNetworkPeerings loads peerings of the Scope's VPC network that have no VpcPeering
+func loadNetworkPeerings(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	network, err := state.orphanClient.GetNetwork(ctx, &computepb.GetNetworkRequest{
+		Project: state.GetProject(),
+		Network: state.GetVpcNetwork(),
+	})
+	if gcpmeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		logger.Error(err, "Error loading Gcp VPC network")
+
+		state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+		return composed.PatchStatus(state.ObjAsNuke()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrorLoadingGcpVpcNetwork",
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching KCP Nuke status after load GCP VPC network error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+			Run(ctx, state)
+	}
+
+	var objects []nuketypes.ProviderResourceObject
+	for _, peering := range network.Peerings {
+		if !state.IsOrphan(peering.GetName(), "VpcPeering") {
+			continue
+		}
+		objects = append(objects, GcpNetworkPeering{peering})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Gcp network peerings", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     gcpnukeclient.GcpNetworkPeering,
+		Provider: cloudcontrolv1beta1.ProviderGCP,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
@@ -0,0 +1,68 @@
+package nuke
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"cloud.google.com/go/redis/apiv1/redispb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	nuketypes "github.com/kyma-project/cloud-manager/pkg/kcp/nuke/types"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadRedisInstances loads Memorystore Redis instances in the Scope's VPC network that have no RedisInstance
+func loadRedisInstances(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	network := gcputil.NewGlobalNetworkName(state.GetProject(), state.GetVpcNetwork())
+
+	var objects []nuketypes.ProviderResourceObject
+	it := state.orphanClient.ListRedisInstances(ctx, &redispb.ListInstancesRequest{
+		Parent: fmt.Sprintf("projects/%s/locations/-", state.GetProject()),
+	})
+	for instance, err := range it.All() {
+		if err != nil {
+			logger.Error(err, "Error listing Gcp Redis instances")
+
+			state.ObjAsNuke().Status.State = string(cloudcontrolv1beta1.StateError)
+
+			return composed.PatchStatus(state.ObjAsNuke()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  "ErrorListingGcpRedisInstances",
+					Message: err.Error(),
+				}).
+				ErrorLogMessage("Error patching KCP Nuke status after list GCP Redis instances error").
+				SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+				Run(ctx, state)
+		}
+		if !network.EqualString(instance.AuthorizedNetwork) {
+			continue
+		}
+		if !state.IsOrphanRedis(path.Base(instance.Name)) {
+			continue
+		}
+		objects = append(objects, GcpRedisInstance{instance})
+	}
+
+	if len(objects) == 0 {
+		return nil, ctx
+	}
+
+	logger.Info("Found orphan Gcp Redis instances", "count", len(objects))
+
+	state.ProviderResources = append(state.ProviderResources, &nuketypes.ProviderResourceKindState{
+		Kind:     gcpnukeclient.GcpRedisInstance,
+		Provider: cloudcontrolv1beta1.ProviderGCP,
+		Objects:  objects,
+	})
+
+	return nil, ctx
+}
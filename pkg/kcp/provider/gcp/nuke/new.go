@@ -30,11 +30,23 @@ func New(stateFactory StateFactory) composed.Action {
 		return composed.ComposeActions(
 			"gcpNuke",
 			loadNfsBackups,
+			loadRedisInstances,
+			loadFilestoreInstances,
+			loadNetworkPeerings,
+			loadGlobalAddresses,
 			providerResourceStatusDiscovered,
-			deleteNfsBackup,
-			providerResourceStatusDeleting,
-			providerResourceStatusDeleted,
-			checkIfAllProviderResourcesDeleted,
+			composed.If(
+				nuketypes.DeletionApprovedPredicate,
+				deleteNfsBackup,
+				deleteRedisInstances,
+				deleteFilestoreInstances,
+				deleteNetworkPeerings,
+				deleteGlobalAddresses,
+				providerResourceStatusDeleting,
+				providerResourceStatusDeleted,
+				providerResourceDeleteErrors,
+				checkIfAllProviderResourcesDeleted,
+			),
 			// continue to parent action
 			func(ctx context.Context, state composed.State) (error, context.Context) {
 				return nil, ctx
@@ -2,6 +2,11 @@ package nuke
 
 import (
 	"context"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	"cloud.google.com/go/redis/apiv1/redispb"
 	"github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
@@ -9,6 +14,8 @@ import (
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
 	gcpnfsbackupclientv1 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v1"
+	gcpnukeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nuke/client"
+	gcpredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
 	"google.golang.org/api/file/v1"
 )
 
@@ -18,15 +25,18 @@ type StateFactory interface {
 
 func NewStateFactory(
 	fileBackupClientProvider gcpclient.ClientProvider[gcpnfsbackupclientv1.FileBackupClient],
+	orphanClientProvider gcpclient.GcpClientProvider[gcpnukeclient.NukeOrphanClient],
 	env abstractions.Environment) StateFactory {
 	return stateFactory{
 		fileBackupClientProvider: fileBackupClientProvider,
+		orphanClientProvider:     orphanClientProvider,
 		env:                      env,
 	}
 }
 
 type stateFactory struct {
 	fileBackupClientProvider gcpclient.ClientProvider[gcpnfsbackupclientv1.FileBackupClient]
+	orphanClientProvider     gcpclient.GcpClientProvider[gcpnukeclient.NukeOrphanClient]
 	env                      abstractions.Environment
 }
 
@@ -41,13 +51,18 @@ func (f stateFactory) NewState(ctx context.Context, nukeState nuketypes.State) (
 	return &State{
 		State:            nukeState,
 		fileBackupClient: fbc,
+		orphanClient:     f.orphanClientProvider(nukeState.Scope().Spec.Scope.Gcp.Project),
 	}, nil
 }
 
 type State struct {
 	nuketypes.State
 	fileBackupClient  gcpnfsbackupclientv1.FileBackupClient
+	orphanClient      gcpnukeclient.NukeOrphanClient
 	ProviderResources []*nuketypes.ProviderResourceKindState
+
+	// deleteErrors holds errors returned by provider delete requests in the current reconciliation
+	deleteErrors []error
 }
 
 type GcpBackup struct {
@@ -62,6 +77,91 @@ func (b GcpBackup) GetObject() any {
 	return b.Backup
 }
 
+type GcpRedisInstance struct {
+	*redispb.Instance
+}
+
+func (r GcpRedisInstance) GetId() string {
+	return r.Name
+}
+
+func (r GcpRedisInstance) GetObject() any {
+	return r.Instance
+}
+
+type GcpFilestoreInstance struct {
+	*filestorepb.Instance
+}
+
+func (f GcpFilestoreInstance) GetId() string {
+	return f.Name
+}
+
+func (f GcpFilestoreInstance) GetObject() any {
+	return f.Instance
+}
+
+type GcpGlobalAddress struct {
+	*computepb.Address
+}
+
+func (a GcpGlobalAddress) GetId() string {
+	return a.GetName()
+}
+
+func (a GcpGlobalAddress) GetObject() any {
+	return a.Address
+}
+
+type GcpNetworkPeering struct {
+	*computepb.NetworkPeering
+}
+
+func (p GcpNetworkPeering) GetId() string {
+	return p.GetName()
+}
+
+func (p GcpNetworkPeering) GetObject() any {
+	return p.NetworkPeering
+}
+
 type ProviderNukeStatus struct {
 	v1beta1.NukeStatus
 }
+
+func (s *State) AddDeleteError(err error) {
+	s.deleteErrors = append(s.deleteErrors, err)
+}
+
+func (s *State) GetProject() string {
+	return s.Scope().Spec.Scope.Gcp.Project
+}
+
+func (s *State) GetVpcNetwork() string {
+	return s.Scope().Spec.Scope.Gcp.VpcNetwork
+}
+
+// IsOrphan returns true if the provider resource named with the cloud-manager "cm-" prefix was created
+// for the KCP object of any of the given kinds, and that object does not exist
+func (s *State) IsOrphan(resourceId string, kinds ...string) bool {
+	name, found := strings.CutPrefix(resourceId, "cm-")
+	if !found {
+		return false
+	}
+	for _, kind := range kinds {
+		if s.ObjectExists(kind, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOrphanRedis returns true if the Memorystore instance id is the one redisinstance gives to a KCP RedisInstance,
+// and that RedisInstance does not exist
+func (s *State) IsOrphanRedis(instanceId string) bool {
+	name, found := gcpredisinstanceclient.GetKcpRedisInstanceName(instanceId)
+	if !found {
+		return false
+	}
+	return !s.ObjectExists("RedisInstance", name)
+}
@@ -0,0 +1,19 @@
+package client
+
+import gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+
+type Client interface {
+	gcpclient.CloudSqlClient
+}
+
+func NewClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProvider[Client] {
+	return func(_ string) Client {
+		return &client{
+			CloudSqlClient: gcpClients.CloudSqlWrapped(),
+		}
+	}
+}
+
+type client struct {
+	gcpclient.CloudSqlClient
+}
@@ -0,0 +1,56 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/sqladmin/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createDatabase creates the database specified in the spec, unless it is the default postgres database
+// that Cloud SQL creates together with the instance.
+func createDatabase(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+	databaseName := postgresInstance.Spec.Instance.Gcp.DatabaseName
+
+	if databaseName == "" || databaseName == postgresDefaultDatabase {
+		return nil, ctx
+	}
+
+	_, err := state.cloudSqlClient.GetSqlDatabase(ctx, state.GetProjectId(), state.GetRemoteSqlInstanceName(), databaseName)
+	if err == nil {
+		return nil, ctx
+	}
+	if !gcpmeta.IsNotFound(err) {
+		return composed.LogErrorAndReturn(err, "Error loading GCP Cloud SQL database", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	logger.Info("Creating GCP Cloud SQL database")
+
+	_, err = state.cloudSqlClient.InsertSqlDatabase(ctx, state.GetProjectId(), state.GetRemoteSqlInstanceName(), &sqladmin.Database{
+		Name: databaseName,
+	})
+	if err != nil {
+		logger.Error(err, "Error creating GCP Cloud SQL database")
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to create PostgresInstance database",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed gcp cloud sql database creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,73 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/api/sqladmin/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createSqlInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.sqlInstance != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Creating GCP Cloud SQL instance")
+
+	gcpScope := state.Scope().Spec.Scope.Gcp
+	gcpSpec := postgresInstance.Spec.Instance.Gcp
+
+	labels := util.NewLabelBuilder().WithGcpLabels(
+		postgresInstance.Spec.Scope.Name,
+		state.Scope().Spec.ShootName,
+	).Build()
+
+	_, err := state.cloudSqlClient.InsertSqlInstance(ctx, gcpScope.Project, &sqladmin.DatabaseInstance{
+		Name:            state.GetRemoteSqlInstanceName(),
+		Region:          state.Scope().Spec.Region,
+		DatabaseVersion: gcpSpec.DatabaseVersion,
+		RootPassword:    postgresInstance.Status.Password,
+		Settings: &sqladmin.Settings{
+			Tier:                      gcpSpec.Tier,
+			Edition:                   "ENTERPRISE",
+			DataDiskSizeGb:            int64(gcpSpec.DataDiskSizeGb),
+			AvailabilityType:          gcpSpec.AvailabilityType,
+			DeletionProtectionEnabled: false,
+			UserLabels:                labels,
+			MaintenanceWindow:         ToSqlMaintenanceWindow(gcpSpec.MaintenancePolicy),
+			IpConfiguration: &sqladmin.IpConfiguration{
+				Ipv4Enabled:      false,
+				PrivateNetwork:   gcputil.NewGlobalNetworkName(gcpScope.Project, gcpScope.VpcNetwork).String(),
+				AllocatedIpRange: state.IpRange().Status.Id,
+				SslMode:          "ENCRYPTED_ONLY",
+				ForceSendFields:  []string{"Ipv4Enabled"},
+			},
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "Error creating GCP Cloud SQL instance")
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to create PostgresInstance",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed gcp cloud sql creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,51 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteSqlInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.sqlInstance == nil {
+		return nil, ctx
+	}
+	if state.sqlInstance.State == sqlInstanceStatePendingDelete {
+		return nil, ctx
+	}
+
+	logger.
+		WithValues("sqlInstance", state.sqlInstance.Name).
+		Info("Deleting cloud sql instance")
+
+	_, err := state.cloudSqlClient.DeleteSqlInstance(ctx, state.GetProjectId(), state.sqlInstance.Name)
+	if err != nil {
+		if gcpmeta.IsNotFound(err) {
+			logger.Info("target cloud sql instance for delete not found, continuing to next loop")
+			return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+		}
+
+		logger.Error(err, "Error deleting GCP Cloud SQL instance")
+		postgresInstance := state.ObjAsPostgresInstance()
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to delete PostgresInstance",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed gcp cloud sql deleting").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
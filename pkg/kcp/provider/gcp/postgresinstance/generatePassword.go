@@ -0,0 +1,34 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+// generatePassword generates the postgres user password and persists it in the KCP status before the
+// Cloud SQL instance is created, since Cloud SQL does not return the root password once it's set.
+func generatePassword(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if postgresInstance.Status.Password != "" {
+		return nil, ctx
+	}
+	if state.sqlInstance != nil {
+		composed.LoggerFromCtx(ctx).Info("Cloud SQL instance exists, but PostgresInstance has no password in status")
+		return nil, ctx
+	}
+
+	postgresInstance.Status.Username = postgresDefaultUsername
+	postgresInstance.Status.Password = util.RandomString(32)
+
+	err := state.UpdateObjStatus(ctx)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating PostgresInstance status with generated password", composed.StopWithRequeue, ctx)
+	}
+
+	return composed.StopWithRequeue, nil
+}
@@ -0,0 +1,48 @@
+package postgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func loadSqlInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.sqlInstance != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Loading GCP Cloud SQL instance")
+
+	sqlInstance, err := state.cloudSqlClient.GetSqlInstance(ctx, state.GetProjectId(), state.GetRemoteSqlInstanceName())
+	if err != nil {
+		if gcpmeta.IsNotFound(err) {
+			logger.Info("target Cloud SQL instance not found, continuing")
+			return nil, ctx
+		}
+
+		logger.Error(err, "Error loading GCP Cloud SQL instance")
+		postgresInstance := state.ObjAsPostgresInstance()
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Failed to load PostgresInstance",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status due failed gcp cloud sql loading").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	state.sqlInstance = sqlInstance
+
+	return nil, ctx
+}
@@ -0,0 +1,28 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyAvailabilityType(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.sqlInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentAvailabilityType := state.sqlInstance.Settings.AvailabilityType
+	desiredAvailabilityType := postgresInstance.Spec.Instance.Gcp.AvailabilityType
+
+	if desiredAvailabilityType == "" || currentAvailabilityType == desiredAvailabilityType {
+		return nil, ctx
+	}
+
+	state.UpdateAvailabilityType(desiredAvailabilityType)
+
+	return nil, ctx
+}
@@ -0,0 +1,29 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyDataDiskSizeGb(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.sqlInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentDataDiskSizeGb := state.sqlInstance.Settings.DataDiskSizeGb
+	desiredDataDiskSizeGb := int64(postgresInstance.Spec.Instance.Gcp.DataDiskSizeGb)
+
+	// Cloud SQL disks can not shrink
+	if currentDataDiskSizeGb >= desiredDataDiskSizeGb {
+		return nil, ctx
+	}
+
+	state.UpdateDataDiskSizeGb(desiredDataDiskSizeGb)
+
+	return nil, ctx
+}
@@ -0,0 +1,28 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyDatabaseVersion(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.sqlInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentDatabaseVersion := state.sqlInstance.DatabaseVersion
+	desiredDatabaseVersion := postgresInstance.Spec.Instance.Gcp.DatabaseVersion
+
+	if currentDatabaseVersion == desiredDatabaseVersion {
+		return nil, ctx
+	}
+
+	state.UpdateDatabaseVersion(desiredDatabaseVersion)
+
+	return nil, ctx
+}
@@ -0,0 +1,28 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyMaintenanceWindow(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.sqlInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentWindow := state.sqlInstance.Settings.MaintenanceWindow
+	desiredWindow := ToSqlMaintenanceWindow(postgresInstance.Spec.Instance.Gcp.MaintenancePolicy)
+
+	if AreEqualMaintenanceWindows(currentWindow, desiredWindow) {
+		return nil, ctx
+	}
+
+	state.UpdateMaintenanceWindow(desiredWindow)
+
+	return nil, ctx
+}
@@ -0,0 +1,28 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyTier(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	postgresInstance := state.ObjAsPostgresInstance()
+
+	if state.sqlInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentTier := state.sqlInstance.Settings.Tier
+	desiredTier := postgresInstance.Spec.Instance.Gcp.Tier
+
+	if currentTier == desiredTier {
+		return nil, ctx
+	}
+
+	state.UpdateTier(desiredTier)
+
+	return nil, ctx
+}
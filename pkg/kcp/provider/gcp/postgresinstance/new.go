@@ -0,0 +1,70 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+
+		state, err := stateFactory.NewState(ctx, st.(types.State))
+		if err != nil {
+			composed.LoggerFromCtx(ctx).Error(err, "Failed to bootstrap GCP PostgresInstance state")
+			postgresInstance := st.Obj().(*cloudcontrolv1beta1.PostgresInstance)
+			postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+			return composed.UpdateStatus(postgresInstance).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudcontrolv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+					Message: "Failed to create PostgresInstance state",
+				}).
+				SuccessError(composed.StopAndForget).
+				SuccessLogMsg(fmt.Sprintf("Error creating new GCP PostgresInstance state: %s", err)).
+				Run(ctx, st)
+		}
+
+		return composed.ComposeActions(
+			"gcpPostgresInstance",
+			actions.AddCommonFinalizer(),
+			loadSqlInstance,
+			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
+				composed.ComposeActions(
+					"postgresInstance-create",
+					generatePassword,
+					createSqlInstance,
+					updateStatusId,
+					addUpdatingCondition,
+					waitSqlInstanceAvailable,
+					createDatabase,
+					modifyTier,
+					modifyDataDiskSizeGb,
+					modifyAvailabilityType,
+					modifyMaintenanceWindow,
+					modifyDatabaseVersion,
+					composed.If(
+						shouldPatchSqlInstancePredicate(),
+						patchSqlInstance,
+					),
+					updateStatus,
+				),
+				composed.ComposeActions(
+					"postgresInstance-delete",
+					removeReadyCondition,
+					deleteSqlInstance,
+					waitSqlInstanceDeleted,
+					actions.RemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+			),
+			composed.StopAndForgetAction,
+		)(ctx, state)
+	}
+}
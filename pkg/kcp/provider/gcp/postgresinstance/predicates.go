@@ -0,0 +1,14 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func shouldPatchSqlInstancePredicate() composed.Predicate {
+	return func(ctx context.Context, st composed.State) bool {
+		state := st.(*State)
+		return state.ShouldPatchSqlInstance()
+	}
+}
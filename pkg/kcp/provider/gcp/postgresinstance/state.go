@@ -0,0 +1,87 @@
+package postgresinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/kcp/postgresinstance/types"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/postgresinstance/client"
+	"google.golang.org/api/sqladmin/v1"
+)
+
+type State struct {
+	types.State
+
+	cloudSqlClient client.Client
+
+	sqlInstance *sqladmin.DatabaseInstance
+
+	patchInstance *sqladmin.DatabaseInstance
+	updateMask    []string
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, postgresInstanceState types.State) (*State, error)
+}
+
+func NewStateFactory(clientProvider gcpclient.GcpClientProvider[client.Client]) StateFactory {
+	return &stateFactory{
+		clientProvider: clientProvider,
+	}
+}
+
+type stateFactory struct {
+	clientProvider gcpclient.GcpClientProvider[client.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, postgresInstanceState types.State) (*State, error) {
+	c := f.clientProvider(postgresInstanceState.Scope().Spec.Scope.Gcp.Project)
+
+	return newState(postgresInstanceState, c), nil
+}
+
+func newState(postgresInstanceState types.State, c client.Client) *State {
+	return &State{
+		State:          postgresInstanceState,
+		cloudSqlClient: c,
+		patchInstance:  &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{}},
+		updateMask:     []string{},
+	}
+}
+
+func (s *State) GetProjectId() string {
+	return s.Scope().Spec.Scope.Gcp.Project
+}
+
+func (s *State) GetRemoteSqlInstanceName() string {
+	return GetGcpSqlInstanceName(s.Obj().GetName())
+}
+
+func (s *State) ShouldPatchSqlInstance() bool {
+	return len(s.updateMask) > 0
+}
+
+func (s *State) UpdateTier(tier string) {
+	s.patchInstance.Settings.Tier = tier
+	s.updateMask = append(s.updateMask, "settings.tier")
+}
+
+func (s *State) UpdateDataDiskSizeGb(dataDiskSizeGb int64) {
+	s.patchInstance.Settings.DataDiskSizeGb = dataDiskSizeGb
+	s.updateMask = append(s.updateMask, "settings.dataDiskSizeGb")
+}
+
+func (s *State) UpdateAvailabilityType(availabilityType string) {
+	s.patchInstance.Settings.AvailabilityType = availabilityType
+	s.updateMask = append(s.updateMask, "settings.availabilityType")
+}
+
+func (s *State) UpdateMaintenanceWindow(window *sqladmin.MaintenanceWindow) {
+	s.patchInstance.Settings.MaintenanceWindow = window
+	s.updateMask = append(s.updateMask, "settings.maintenanceWindow")
+}
+
+func (s *State) UpdateDatabaseVersion(databaseVersion string) {
+	s.patchInstance.DatabaseVersion = databaseVersion
+	s.updateMask = append(s.updateMask, "databaseVersion")
+}
@@ -0,0 +1,71 @@
+package postgresinstance
+
+import (
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"google.golang.org/api/sqladmin/v1"
+)
+
+const (
+	sqlInstanceStateRunnable      = "RUNNABLE"
+	sqlInstanceStateMaintenance   = "MAINTENANCE"
+	sqlInstanceStatePendingDelete = "PENDING_DELETE"
+	sqlInstanceStateFailed        = "FAILED"
+	sqlInstanceStateSuspended     = "SUSPENDED"
+
+	sqlIpAddressTypePrivate = "PRIVATE"
+
+	postgresPort            = 5432
+	postgresDefaultDatabase = "postgres"
+	postgresDefaultUsername = "postgres"
+)
+
+// GetGcpSqlInstanceName returns the Cloud SQL instance name. Cloud SQL instance names must start with a letter,
+// so the KCP object name, which is a UUID, is prefixed.
+func GetGcpSqlInstanceName(name string) string {
+	return fmt.Sprintf("cm-%s", name)
+}
+
+var maintenanceDays = map[string]int64{
+	"MONDAY":    1,
+	"TUESDAY":   2,
+	"WEDNESDAY": 3,
+	"THURSDAY":  4,
+	"FRIDAY":    5,
+	"SATURDAY":  6,
+	"SUNDAY":    7,
+}
+
+// ToSqlMaintenanceWindow converts the Memorystore-like maintenance policy into the Cloud SQL maintenance window.
+// Cloud SQL schedules maintenance by the hour, so minutes of the start time are ignored.
+func ToSqlMaintenanceWindow(policy *cloudcontrolv1beta1.MaintenancePolicyGcp) *sqladmin.MaintenanceWindow {
+	if policy == nil || policy.DayOfWeek == nil {
+		return nil
+	}
+	return &sqladmin.MaintenanceWindow{
+		Day:             maintenanceDays[policy.DayOfWeek.Day],
+		Hour:            int64(policy.DayOfWeek.StartTime.Hours),
+		ForceSendFields: []string{"Day", "Hour"},
+	}
+}
+
+func AreEqualMaintenanceWindows(current, desired *sqladmin.MaintenanceWindow) bool {
+	if desired == nil {
+		// nothing desired, whatever cloud sql has is fine
+		return true
+	}
+	if current == nil {
+		return false
+	}
+	return current.Day == desired.Day && current.Hour == desired.Hour
+}
+
+func GetPrivateIpAddress(instance *sqladmin.DatabaseInstance) string {
+	for _, ip := range instance.IpAddresses {
+		if ip.Type == sqlIpAddressTypePrivate {
+			return ip.IpAddress
+		}
+	}
+	return ""
+}
@@ -0,0 +1,54 @@
+package postgresinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func waitSqlInstanceAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.sqlInstance == nil {
+		errorMsg := "error: cloud sql instance is not loaded"
+		logger.Error(errors.New(errorMsg), errorMsg)
+		postgresInstance := state.ObjAsPostgresInstance()
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonUnknown,
+				Message: errorMsg,
+			}).
+			SuccessError(composed.StopAndForget).
+			SuccessLogMsg(errorMsg).
+			Run(ctx, st)
+	}
+
+	switch state.sqlInstance.State {
+	case sqlInstanceStateRunnable:
+		return nil, ctx
+	case sqlInstanceStateFailed, sqlInstanceStateSuspended:
+		postgresInstance := state.ObjAsPostgresInstance()
+		postgresInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(postgresInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Cloud SQL instance is in " + state.sqlInstance.State + " state",
+			}).
+			ErrorLogMessage("Error updating PostgresInstance status after cloud sql instance failed").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, st)
+	}
+
+	logger.Info("Postgres instance is not ready yet, requeueing with delay")
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+}
@@ -0,0 +1,22 @@
+package client
+
+import gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+
+type Client interface {
+	gcpclient.RedisInstanceClient
+	gcpclient.StorageClient
+}
+
+func NewClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProvider[Client] {
+	return func(_ string) Client {
+		return &client{
+			RedisInstanceClient: gcpClients.RedisInstanceWrapped(),
+			StorageClient:       gcpClients.StorageWrapped(),
+		}
+	}
+}
+
+type client struct {
+	gcpclient.RedisInstanceClient
+	gcpclient.StorageClient
+}
@@ -0,0 +1,61 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func checkImportOperation(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	redisInstance := state.ObjAsRedisInstance()
+
+	opName := redisInstance.Status.RestoreOpIdentifier
+	if opName == "" {
+		return nil, ctx
+	}
+
+	op, err := state.memorystoreClient.GetRedisInstanceOperation(ctx, &longrunningpb.GetOperationRequest{
+		Name: opName,
+	})
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error getting GCP Redis import operation", composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+	}
+
+	if !op.Done {
+		logger.Info("Redis import is not done yet, requeueing with delay")
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), nil
+	}
+
+	if op.GetError() != nil {
+		opErr := status.FromProto(op.GetError())
+		logger.Error(opErr.Err(), "GCP Redis import operation failed", "code", opErr.Code(), "message", opErr.Message())
+		redisInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Import operation failed: %s", opErr.Message()),
+			}).
+			SuccessError(composed.StopAndForget).
+			ErrorLogMessage("Error updating RedisInstance status due failed gcp redis import").
+			Run(ctx, st)
+	}
+
+	logger.Info("Redis instance restored", "importUri", redisInstance.Spec.Instance.Gcp.ImportUri)
+	redisInstance.Status.RestoreOpIdentifier = ""
+	redisInstance.Status.RestoredFrom = redisInstance.Spec.Instance.Gcp.ImportUri
+	return composed.UpdateStatus(redisInstance).
+		RemoveConditions(cloudcontrolv1beta1.ConditionTypeRestoring).
+		SuccessErrorNil().
+		ErrorLogMessage("Failed to remove restoring condition from redis instance").
+		Run(ctx, st)
+}
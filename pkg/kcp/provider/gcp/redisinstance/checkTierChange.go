@@ -0,0 +1,40 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func checkTierChange(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisInstance := state.ObjAsRedisInstance()
+
+	if state.gcpRedisInstance == nil {
+		return composed.StopWithRequeue, nil
+	}
+
+	currentTier := state.gcpRedisInstance.Tier.String()
+	desiredTier := redisInstance.Spec.Instance.Gcp.Tier
+
+	if currentTier == desiredTier {
+		return nil, ctx
+	}
+
+	redisInstance.Status.State = cloudcontrolv1beta1.StateError
+	return composed.UpdateStatus(redisInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonUnsupportedTierChange,
+			Message: fmt.Sprintf("Changing the service tier from %s to %s is not supported", currentTier, desiredTier),
+		}).
+		ErrorLogMessage("Error updating RedisInstance status with unsupported tier change").
+		SuccessLogMsg("Refused unsupported tier change of redis instance").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, st)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"strings"
 
 	"cloud.google.com/go/redis/apiv1/redispb"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
@@ -17,6 +18,12 @@ func GetGcpMemoryStoreRedisInstanceId(instanceId string) string {
 	return fmt.Sprintf("cm-%s", instanceId)
 }
 
+// GetKcpRedisInstanceName is the inverse of GetGcpMemoryStoreRedisInstanceId. It returns the name of the
+// KCP RedisInstance the Memorystore instance id was created for, and false if the id was not created by it.
+func GetKcpRedisInstanceName(memorystoreInstanceId string) (string, bool) {
+	return strings.CutPrefix(memorystoreInstanceId, "cm-")
+}
+
 func ToMaintenancePolicy(maintenancePolicy *cloudcontrolv1beta1.MaintenancePolicyGcp) *redispb.MaintenancePolicy {
 	if maintenancePolicy == nil {
 		return nil
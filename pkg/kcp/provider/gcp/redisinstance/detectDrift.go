@@ -0,0 +1,31 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+var updateMaskSpecFields = map[string]string{
+	"memory_size_gb":     "memorySizeGb",
+	"replica_count":      "replicaCount",
+	"redis_configs":      "redisConfigs",
+	"maintenance_policy": "maintenancePolicy",
+	"auth_enabled":       "authEnabled",
+}
+
+// detectDrift reports the spec fields that the preceding modify actions found different
+// from the GCP redis instance, which is the same set updateRedis and upgradeRedis would apply
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	var fields []string
+	for _, path := range state.updateMask {
+		fields = append(fields, updateMaskSpecFields[path])
+	}
+	if state.ShouldUpgradeRedisInstance() {
+		fields = append(fields, "redisVersion")
+	}
+
+	return fields
+}
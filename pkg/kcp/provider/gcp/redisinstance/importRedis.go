@@ -0,0 +1,64 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/redis/apiv1/redispb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func importRedis(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	redisInstance := state.ObjAsRedisInstance()
+
+	importUri := redisInstance.Spec.Instance.Gcp.ImportUri
+	if importUri == "" || redisInstance.Status.RestoredFrom == importUri || redisInstance.Status.RestoreOpIdentifier != "" {
+		return nil, ctx
+	}
+
+	logger.Info("Importing RDB file into GCP Redis", "importUri", importUri)
+	gcpScope := state.Scope().Spec.Scope.Gcp
+	region := state.Scope().Spec.Region
+	op, err := state.memorystoreClient.ImportRedisInstance(ctx, &redispb.ImportInstanceRequest{
+		Name: client.GetGcpMemoryStoreRedisName(gcpScope.Project, region, state.GetRemoteRedisName()),
+		InputConfig: &redispb.InputConfig{
+			Source: &redispb.InputConfig_GcsSource{
+				GcsSource: &redispb.GcsSource{
+					Uri: importUri,
+				},
+			},
+		},
+	})
+	if err != nil {
+		logger.Error(err, "Error importing RDB file into GCP Redis")
+		redisInstance.Status.State = cloudcontrolv1beta1.StateError
+		return composed.UpdateStatus(redisInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonRestoreFailed,
+				Message: fmt.Sprintf("Failed to import %s into RedisInstance", importUri),
+			}).
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			ErrorLogMessage("Error updating RedisInstance status due failed gcp redis import").
+			Run(ctx, st)
+	}
+
+	redisInstance.Status.RestoreOpIdentifier = op.Name()
+	return composed.UpdateStatus(redisInstance).
+		SetCondition(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeRestoring,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ConditionTypeRestoring,
+			Message: fmt.Sprintf("Redis is being restored from %s.", importUri),
+		}).
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		ErrorLogMessage("Error updating RedisInstance status with restore operation").
+		Run(ctx, st)
+}
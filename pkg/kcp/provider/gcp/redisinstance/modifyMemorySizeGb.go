@@ -2,6 +2,7 @@ package redisinstance
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 )
@@ -22,6 +23,11 @@ func modifyMemorySizeGb(ctx context.Context, st composed.State) (error, context.
 		return nil, ctx
 	}
 
+	if state.ShouldDeferTierChange(time.Now()) {
+		state.DeferTierChange()
+		return nil, ctx
+	}
+
 	state.UpdateMemorySizeGb(desiredMemorySizeGb)
 
 	return nil, ctx
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -41,14 +42,20 @@ func New(stateFactory StateFactory) composed.Action {
 					createRedis,
 					updateStatusId,
 					addUpdatingCondition,
+					updatePendingMemorySizeGb,
+					checkImportOperation,
 					waitRedisAvailable,
+					importRedis,
+					checkTierChange,
 					modifyMemorySizeGb,
 					modifyMemoryReplicaCount,
 					modifyRedisConfigs,
 					modifyMaintenancePolicy,
 					modifyAuthEnabled,
+					drift.New(drift.KindRedisInstance, detectDrift),
 					updateRedis,
 					upgradeRedis,
+					waitDeferredTierChange,
 					updateStatus,
 				),
 				composed.ComposeActions(
@@ -56,6 +63,7 @@ func New(stateFactory StateFactory) composed.Action {
 					removeReadyCondition,
 					deleteRedis,
 					waitRedisDeleted,
+					drift.Forget(drift.KindRedisInstance),
 					actions.RemoveCommonFinalizer(),
 					composed.StopAndForgetAction,
 				),
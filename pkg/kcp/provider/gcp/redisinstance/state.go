@@ -2,6 +2,7 @@ package redisinstance
 
 import (
 	"context"
+	"time"
 
 	"cloud.google.com/go/redis/apiv1/redispb"
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
@@ -19,7 +20,8 @@ type State struct {
 	gcpRedisInstanceAuth *redispb.InstanceAuthString
 	memorystoreClient    client.MemorystoreClient
 
-	updateMask []string
+	updateMask         []string
+	tierChangeDeferred bool
 }
 
 type StateFactory interface {
@@ -75,6 +77,19 @@ func (s *State) UpdateMemorySizeGb(memorySizeGb int32) {
 	s.gcpRedisInstance.MemorySizeGb = memorySizeGb
 }
 
+// ShouldDeferTierChange returns true if a memory size change has to wait for the next maintenance window
+func (s *State) ShouldDeferTierChange(now time.Time) bool {
+	gcp := s.ObjAsRedisInstance().Spec.Instance.Gcp
+	if !gcp.DeferTierChange || gcp.MaintenancePolicy == nil {
+		return false
+	}
+	return !IsInMaintenanceWindow(gcp.MaintenancePolicy, now)
+}
+
+func (s *State) DeferTierChange() {
+	s.tierChangeDeferred = true
+}
+
 func (s *State) UpdateMaintenancePolicy(policy *redispb.MaintenancePolicy) {
 	s.updateMask = append(s.updateMask, "maintenance_policy")
 	s.gcpRedisInstance.MaintenancePolicy = policy
@@ -0,0 +1,34 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func updatePendingMemorySizeGb(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	redisInstance := state.ObjAsRedisInstance()
+
+	if state.gcpRedisInstance == nil {
+		return nil, ctx
+	}
+
+	pendingMemorySizeGb := int32(0)
+	desiredMemorySizeGb := redisInstance.Spec.Instance.Gcp.MemorySizeGb
+	if state.gcpRedisInstance.MemorySizeGb != desiredMemorySizeGb {
+		pendingMemorySizeGb = desiredMemorySizeGb
+	}
+
+	if redisInstance.Status.PendingMemorySizeGb == pendingMemorySizeGb {
+		return nil, ctx
+	}
+
+	redisInstance.Status.PendingMemorySizeGb = pendingMemorySizeGb
+
+	return composed.UpdateStatus(redisInstance).
+		SuccessErrorNil().
+		ErrorLogMessage("Failed to update pending memory size of redis instance").
+		Run(ctx, st)
+}
@@ -1,6 +1,12 @@
 package redisinstance
 
-import "cloud.google.com/go/redis/apiv1/redispb"
+import (
+	"strings"
+	"time"
+
+	"cloud.google.com/go/redis/apiv1/redispb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+)
 
 func AreConfigsMissmatched(currentParameters, desiredParameters map[string]string) bool {
 	if len(currentParameters) != len(desiredParameters) {
@@ -44,3 +50,29 @@ func AreEqualPolicies(current, desired *redispb.MaintenancePolicy) bool {
 
 	return true
 }
+
+const maintenanceWindowDuration = time.Hour
+
+// IsInMaintenanceWindow returns true if now falls into the one hour weekly window of the maintenance policy
+func IsInMaintenanceWindow(policy *cloudcontrolv1beta1.MaintenancePolicyGcp, now time.Time) bool {
+	if policy == nil || policy.DayOfWeek == nil {
+		return false
+	}
+
+	now = now.UTC()
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.ToUpper(day.String()) != policy.DayOfWeek.Day {
+			continue
+		}
+		daysSince := (int(now.Weekday()) - int(day) + 7) % 7
+		windowStart := time.Date(now.Year(), now.Month(), now.Day()-daysSince,
+			int(policy.DayOfWeek.StartTime.Hours), int(policy.DayOfWeek.StartTime.Minutes), 0, 0, time.UTC)
+		if windowStart.After(now) {
+			// today's window has not started yet, check the one from the previous week
+			windowStart = windowStart.AddDate(0, 0, -7)
+		}
+		return now.Sub(windowStart) < maintenanceWindowDuration
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package redisinstance
+
+import (
+	"testing"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsInMaintenanceWindow(t *testing.T) {
+
+	policy := func(day string, hours, minutes int32) *cloudcontrolv1beta1.MaintenancePolicyGcp {
+		return &cloudcontrolv1beta1.MaintenancePolicyGcp{
+			DayOfWeek: &cloudcontrolv1beta1.DayOfWeekPolicyGcp{
+				Day:       day,
+				StartTime: cloudcontrolv1beta1.TimeOfDayGcp{Hours: hours, Minutes: minutes},
+			},
+		}
+	}
+
+	// 2024-01-07 is a Sunday
+	testCases := []struct {
+		name     string
+		policy   *cloudcontrolv1beta1.MaintenancePolicyGcp
+		now      time.Time
+		expected bool
+	}{
+		{"no policy", nil, time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC), false},
+		{"window start", policy("SUNDAY", 10, 0), time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC), true},
+		{"inside window", policy("SUNDAY", 10, 0), time.Date(2024, 1, 7, 10, 59, 0, 0, time.UTC), true},
+		{"window end", policy("SUNDAY", 10, 0), time.Date(2024, 1, 7, 11, 0, 0, 0, time.UTC), false},
+		{"before window", policy("SUNDAY", 10, 0), time.Date(2024, 1, 7, 9, 59, 0, 0, time.UTC), false},
+		{"other day", policy("MONDAY", 10, 0), time.Date(2024, 1, 7, 10, 30, 0, 0, time.UTC), false},
+		{"window over midnight", policy("SATURDAY", 23, 30), time.Date(2024, 1, 7, 0, 15, 0, 0, time.UTC), true},
+		{"non UTC time", policy("SUNDAY", 10, 0), time.Date(2024, 1, 7, 11, 30, 0, 0, time.FixedZone("CET", 3600)), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsInMaintenanceWindow(tc.policy, tc.now))
+		})
+	}
+}
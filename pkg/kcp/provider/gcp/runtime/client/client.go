@@ -0,0 +1,25 @@
+package client
+
+import gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+
+type Client interface {
+	gcpclient.KmsClient
+	gcpclient.SubnetClient
+	gcpclient.FirewallsClient
+}
+
+func NewClientProvider(gcpClients *gcpclient.GcpClients) gcpclient.GcpClientProvider[Client] {
+	return func(_ string) Client {
+		return &client{
+			KmsClient:       gcpClients.KmsWrapped(),
+			SubnetClient:    gcpClients.SubnetWrapped(),
+			FirewallsClient: gcpClients.FirewallsWrapped(),
+		}
+	}
+}
+
+type client struct {
+	gcpclient.KmsClient
+	gcpclient.SubnetClient
+	gcpclient.FirewallsClient
+}
@@ -0,0 +1,59 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+// defaultDenyPriority is just above the implied deny rule, so all allow rules Gardener creates take precedence,
+// while the denied traffic that would otherwise silently hit the implied rule is logged
+const defaultDenyPriority int32 = 65534
+
+func defaultDenyApply(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	_, err := state.gcpClient.GetFirewall(ctx, &computepb.GetFirewallRequest{
+		Project:  state.ProjectId(),
+		Firewall: state.DefaultDenyFirewallName(),
+	})
+	if gcpmeta.IsNotFound(err) {
+		op, err := state.gcpClient.InsertFirewall(ctx, &computepb.InsertFirewallRequest{
+			Project: state.ProjectId(),
+			FirewallResource: &computepb.Firewall{
+				Name:        new(state.DefaultDenyFirewallName()),
+				Description: new(fmt.Sprintf("Default deny ingress of the runtime %s", state.ObjAsRuntime().Name)),
+				Network:     new(gcputil.NewGlobalNetworkName(state.ProjectId(), state.ShootVpcName()).String()),
+				Direction:   ptr.To(computepb.Firewall_INGRESS.String()),
+				Priority:    new(defaultDenyPriority),
+				Denied: []*computepb.Denied{
+					{IPProtocol: new("all")},
+				},
+				SourceRanges: []string{"0.0.0.0/0"},
+				LogConfig: &computepb.FirewallLogConfig{
+					Enable: new(true),
+				},
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error creating GCP default deny firewall")
+		}
+		if err := op.Wait(ctx); err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error waiting GCP default deny firewall created")
+		}
+		logger.
+			WithValues("firewall", state.DefaultDenyFirewallName()).
+			Info("GCP default deny firewall created")
+	} else if err != nil {
+		return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, err, "Error loading GCP default deny firewall")
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeDefaultDenyReady, fmt.Sprintf("Firewall %s denies all ingress", state.DefaultDenyFirewallName()))
+}
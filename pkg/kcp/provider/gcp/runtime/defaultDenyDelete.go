@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"context"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+)
+
+func defaultDenyDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	op, err := state.gcpClient.DeleteFirewall(ctx, &computepb.DeleteFirewallRequest{
+		Project:  state.ProjectId(),
+		Firewall: state.DefaultDenyFirewallName(),
+	})
+	if gcpmeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error deleting GCP default deny firewall", composed.StopWithRequeue, ctx)
+	}
+	if err := op.Wait(ctx); err != nil {
+		return composed.LogErrorAndReturn(err, "Error waiting GCP default deny firewall deleted", composed.StopWithRequeue, ctx)
+	}
+
+	logger.
+		WithValues("firewall", state.DefaultDenyFirewallName()).
+		Info("GCP default deny firewall deleted")
+
+	return nil, ctx
+}
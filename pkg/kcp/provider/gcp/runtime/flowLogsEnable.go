@@ -0,0 +1,56 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcputil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/util"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	"k8s.io/utils/ptr"
+)
+
+// flowLogsEnable turns on VPC flow logs with full metadata on every subnet of the shoot network
+func flowLogsEnable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, subnet := range state.subnets {
+		if subnet.GetLogConfig().GetEnable() {
+			continue
+		}
+
+		subnetName, err := gcputil.ParseNameDetail(subnet.GetSelfLink())
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error parsing GCP subnet name")
+		}
+
+		op, err := state.gcpClient.PatchSubnet(ctx, &computepb.PatchSubnetworkRequest{
+			Project:    state.ProjectId(),
+			Region:     state.Region(),
+			Subnetwork: subnetName.ResourceId(),
+			SubnetworkResource: &computepb.Subnetwork{
+				Fingerprint: subnet.Fingerprint,
+				LogConfig: &computepb.SubnetworkLogConfig{
+					Enable:              new(true),
+					AggregationInterval: ptr.To(computepb.SubnetworkLogConfig_INTERVAL_5_SEC.String()),
+					FlowSampling:        new(float32(0.5)),
+					Metadata:            ptr.To(computepb.SubnetworkLogConfig_INCLUDE_ALL_METADATA.String()),
+				},
+			},
+		})
+		if err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error enabling GCP subnet flow logs")
+		}
+		if err := op.Wait(ctx); err != nil {
+			return runtimetypes.SecurityStepFailed(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, err, "Error waiting GCP subnet flow logs enabled")
+		}
+
+		logger.
+			WithValues("subnet", subnetName.ResourceId()).
+			Info("GCP subnet flow logs enabled")
+	}
+
+	return runtimetypes.SecurityStepReady(ctx, state, runtimetypes.ConditionTypeFlowLogsReady, fmt.Sprintf("VPC flow logs enabled on %d subnets", len(state.subnets)))
+}
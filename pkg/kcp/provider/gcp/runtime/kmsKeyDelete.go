@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+// kmsKeyDelete stops the rotation of the runtime crypto key and schedules all its enabled versions for
+// destruction. GCP does not allow deleting crypto keys and key rings, so those remain in the project.
+func kmsKeyDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	key, err := state.gcpClient.GetKmsCryptoKey(ctx, state.KmsCryptoKeyName())
+	if gcpmeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading GCP KMS crypto key", composed.StopWithRequeue, ctx)
+	}
+
+	if key.RotationPeriod != "" {
+		_, err = state.gcpClient.PatchKmsCryptoKey(ctx, state.KmsCryptoKeyName(), "rotationPeriod,nextRotationTime", &cloudkms.CryptoKey{})
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error disabling GCP KMS crypto key rotation", composed.StopWithRequeue, ctx)
+		}
+	}
+
+	versions, err := state.gcpClient.ListKmsCryptoKeyVersions(ctx, state.KmsCryptoKeyName())
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing GCP KMS crypto key versions", composed.StopWithRequeue, ctx)
+	}
+
+	for _, v := range versions {
+		if v.State != "ENABLED" && v.State != "DISABLED" {
+			continue
+		}
+		_, err = state.gcpClient.DestroyKmsCryptoKeyVersion(ctx, v.Name)
+		if err != nil && !gcpmeta.IsNotFound(err) {
+			return composed.LogErrorAndReturn(err, "Error destroying GCP KMS crypto key version", composed.StopWithRequeue, ctx)
+		}
+		logger.
+			WithValues("kmsCryptoKeyVersion", v.Name).
+			Info("GCP KMS crypto key version scheduled for destruction")
+	}
+
+	return nil, ctx
+}
@@ -4,10 +4,31 @@ import (
 	"context"
 
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
 )
 
 func New(stateFactory StateFactory) composed.Action {
-	return func(ctx context.Context, state composed.State) (error, context.Context) {
-		return nil, nil
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, err := stateFactory.NewState(ctx, st.(runtimetypes.State))
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error creating new GCP Runtime state", composed.StopWithRequeue, ctx)
+		}
+
+		return composed.IfElse(
+			composed.Not(composed.MarkedForDeletionPredicate),
+			composed.ComposeActions(
+				"gcpRuntime-create",
+				kmsKeyCreate,
+				subnetsLoad,
+				flowLogsEnable,
+				defaultDenyApply,
+			),
+			composed.ComposeActions(
+				"gcpRuntime-delete",
+				// flow logs are a subnet setting and go away with the shoot subnets
+				defaultDenyDelete,
+				kmsKeyDelete,
+			),
+		)(ctx, state)
 	}
 }
@@ -2,18 +2,75 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 
+	"cloud.google.com/go/compute/apiv1/computepb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcpruntimeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/runtime/client"
 	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
 )
 
+// kmsKeyRingId is the key ring in each region holding the crypto keys of all runtimes in that region
+const kmsKeyRingId = "cloud-manager"
+
 type State struct {
 	runtimetypes.State
+
+	gcpClient gcpruntimeclient.Client
+
+	subnets []*computepb.Subnetwork
 }
 
 type StateFactory interface {
 	NewState(ctx context.Context, runtimeState runtimetypes.State) (*State, error)
 }
 
-func NewStateFactory() StateFactory {
-	return nil
+func NewStateFactory(gcpClientProvider gcpclient.GcpClientProvider[gcpruntimeclient.Client]) StateFactory {
+	return &stateFactory{
+		gcpClientProvider: gcpClientProvider,
+	}
+}
+
+type stateFactory struct {
+	gcpClientProvider gcpclient.GcpClientProvider[gcpruntimeclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, runtimeState runtimetypes.State) (*State, error) {
+	subscription := runtimeState.Subscription()
+	if subscription.Status.Provider != cloudcontrolv1beta1.ProviderGCP {
+		return nil, fmt.Errorf("subscription for Runtime must be of provider GCP, but subscription %q is of provider %q", subscription.Name, subscription.Status.Provider)
+	}
+
+	return newState(runtimeState, f.gcpClientProvider(subscription.Status.SubscriptionInfo.Gcp.Project)), nil
+}
+
+func newState(runtimeState runtimetypes.State, gcpClient gcpruntimeclient.Client) *State {
+	return &State{
+		State:     runtimeState,
+		gcpClient: gcpClient,
+	}
+}
+
+func (s *State) ProjectId() string {
+	return s.Subscription().Status.SubscriptionInfo.Gcp.Project
+}
+
+func (s *State) Region() string {
+	return s.ObjAsRuntime().Spec.Shoot.Region
+}
+
+// KmsKeyRingName is the full resource name of the regional key ring holding the crypto key of the runtime
+func (s *State) KmsKeyRingName() string {
+	return fmt.Sprintf("projects/%s/locations/%s/keyRings/%s", s.ProjectId(), s.Region(), kmsKeyRingId)
+}
+
+// KmsCryptoKeyName is the full resource name of the customer-managed crypto key of the runtime
+func (s *State) KmsCryptoKeyName() string {
+	return fmt.Sprintf("%s/cryptoKeys/%s", s.KmsKeyRingName(), s.ObjAsRuntime().Name)
+}
+
+// DefaultDenyFirewallName is the name of the logged firewall rule denying all ingress into the shoot network
+func (s *State) DefaultDenyFirewallName() string {
+	return fmt.Sprintf("%s-cm-deny-all-ingress", s.ShootVpcName())
 }
@@ -0,0 +1,39 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func subnetsLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	it := state.gcpClient.ListSubnets(ctx, &computepb.ListSubnetworksRequest{
+		Project: state.ProjectId(),
+		Region:  state.Region(),
+	})
+	var subnets []*computepb.Subnetwork
+	for subnet, err := range it.All() {
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error listing GCP subnets", composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+		}
+		if strings.HasSuffix(subnet.GetNetwork(), "/networks/"+state.ShootVpcName()) {
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	if len(subnets) == 0 {
+		// the shoot is not provisioned yet
+		logger.Info("Shoot subnets not found")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+	}
+
+	state.subnets = subnets
+
+	return nil, ctx
+}
@@ -119,6 +119,14 @@ func NewSubnetworkName(projectId, regionId, subnetworkId string) NameDetail {
 	return newNameDetail(ResourceTypeSubnetwork, nameDefnProject.Value(projectId), nameDefnRegion.Value(regionId), nameDefnSubnetwork.Value(subnetworkId))
 }
 
+func NewSqlInstanceName(projectId, instanceId string) NameDetail {
+	return newNameDetail(ResourceTypeSqlInstance, nameDefnProject.Value(projectId), nameDefnInstance.Value(instanceId))
+}
+
+func NewSqlDatabaseName(projectId, instanceId, databaseId string) NameDetail {
+	return newNameDetail(ResourceTypeSqlDatabase, nameDefnProject.Value(projectId), nameDefnInstance.Value(instanceId), nameDefnDatabase.Value(databaseId))
+}
+
 func NewTagKeyName(tagKeyId string) NameDetail {
 	return newNameDetail(ResourceTypeTagKey, nameDefnTagKey.Value(tagKeyId))
 }
@@ -279,6 +287,8 @@ func (n NameDetail) ResourceId() string {
 
 // projects/%s/global/operations/%s
 // projects/%s/operations/%s
+// projects/%s/instances/%s
+// projects/%s/instances/%s/databases/%s
 // projects/%s/locations/%s/operations/%s
 // projects/%s/global/networks/%s
 // projects/%s/locations/%s
@@ -315,6 +325,8 @@ const (
 	ResourceTypeRegionalAddress         ResourceType = "regionalAddress"
 	ResourceTypeSubnetwork              ResourceType = "subnetwork"
 	ResourceTypeServiceConnectionPolicy ResourceType = "serviceConnectionPolicy"
+	ResourceTypeSqlInstance             ResourceType = "sqlInstance"
+	ResourceTypeSqlDatabase             ResourceType = "sqlDatabase"
 	ResourceTypeTagKey                  ResourceType = "tagKey"
 	ResourceTypeTagValue                ResourceType = "tagValue"
 	ResourceTypeTagBinding              ResourceType = "tagBinding"
@@ -425,6 +437,7 @@ var (
 	nameDefnRouter                  = newNamePartDefn("routers/%s")
 	nameDefnSubnetwork              = newNamePartDefn("subnetworks/%s")
 	nameDefnServiceConnectionPolicy = newNamePartDefn("serviceConnectionPolicies/%s")
+	nameDefnDatabase                = newNamePartDefn("databases/%s")
 
 	nameDefnTagKey     = newNamePartDefn("tagKeys/%s")
 	nameDefnTagValue   = newNamePartDefn("tagValues/%s")
@@ -447,6 +460,7 @@ var allNamePartDefns = []namePartDefn{
 	nameDefnRegion,
 	nameDefnRouter,
 	nameDefnSubnetwork,
+	nameDefnDatabase,
 	nameDefnTagKey,
 	nameDefnTagValue,
 	nameDefnTagBinding,
@@ -466,6 +480,8 @@ var allNamePartDefns = []namePartDefn{
 // projects/%s/regions/%s/addresses/%s
 // projects/%s/regions/%s/subnetworks/%s
 // projects/%s/operations/%s
+// projects/%s/instances/%s
+// projects/%s/instances/%s/databases/%s
 // tagKeys/281234912342923
 // tagValues/212346412347300
 // tagBindings/PathEscape(parent)/tagValues/212346412347300
@@ -487,6 +503,8 @@ var validSequences = map[ResourceType][]namePartDefn{
 	ResourceTypeRouter:                  {nameDefnProject, nameDefnRegion, nameDefnRouter},
 	ResourceTypeRegionalAddress:         {nameDefnProject, nameDefnRegion, nameDefnAddresses},
 	ResourceTypeSubnetwork:              {nameDefnProject, nameDefnRegion, nameDefnSubnetwork},
+	ResourceTypeSqlInstance:             {nameDefnProject, nameDefnInstance},
+	ResourceTypeSqlDatabase:             {nameDefnProject, nameDefnInstance, nameDefnDatabase},
 
 	ResourceTypeTagKey:     {nameDefnTagKey},
 	ResourceTypeTagValue:   {nameDefnTagValue},
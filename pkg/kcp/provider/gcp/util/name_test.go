@@ -67,6 +67,13 @@ func TestNames(t *testing.T) {
 				ResourceTypeLocation,
 				"my-project", "my-location", "", "my-location",
 			},
+			{
+				"projects/my-project/instances/my-instance",
+				[]*namePartDefn{&nameDefnProject, &nameDefnInstance},
+				[]string{"my-project", "my-instance"},
+				ResourceTypeSqlInstance,
+				"my-project", "", "", "my-instance",
+			},
 			{
 				"projects/my-project/locations/my-location/instances/my-instance",
 				[]*namePartDefn{&nameDefnProject, &nameDefnLocation, &nameDefnInstance},
@@ -246,6 +253,8 @@ func TestNames(t *testing.T) {
 			{NewLocationalOperationName, []string{"my-project", "my-location", "my-operation"}, "projects/my-project/locations/my-location/operations/my-operation", ResourceTypeLocationalOperation},
 			{NewOperationName, []string{"my-project", "my-operation"}, "projects/my-project/operations/my-operation", ResourceTypeOperation},
 			{NewGlobalOperationName, []string{"my-project", "my-operation"}, "projects/my-project/global/operations/my-operation", ResourceTypeGlobalOperation},
+			{NewSqlInstanceName, []string{"my-project", "my-instance"}, "projects/my-project/instances/my-instance", ResourceTypeSqlInstance},
+			{NewSqlDatabaseName, []string{"my-project", "my-instance", "my-database"}, "projects/my-project/instances/my-instance/databases/my-database", ResourceTypeSqlDatabase},
 			{NewTagKeyName, []string{"1234567890"}, "tagKeys/1234567890", ResourceTypeTagKey},
 			{NewTagValueName, []string{"1234567890"}, "tagValues/1234567890", ResourceTypeTagValue},
 			{NewTagBindingName, []string{"//compute.googleapis.com/projects/812343123418/global/networks/6812341912344012340", "1234567890"}, "tagBindings/%2F%2Fcompute.googleapis.com%2Fprojects%2F812343123418%2Fglobal%2Fnetworks%2F6812341912344012340/tagValues/1234567890", ResourceTypeTagBinding},
@@ -0,0 +1,24 @@
+package vpcpeering
+
+import (
+	"context"
+
+	pb "cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// detectDrift reports peerings that were removed or became inactive on either side, which
+// createRemoteVpcPeering and createLocalVpcPeering are able to recreate
+func detectDrift(_ context.Context, st composed.State) []string {
+	state := st.(*State)
+
+	var fields []string
+	if state.remoteVpcPeering == nil || state.remoteVpcPeering.GetState() != pb.NetworkPeering_ACTIVE.String() {
+		fields = append(fields, "remotePeering")
+	}
+	if state.localVpcPeering == nil || state.localVpcPeering.GetState() != pb.NetworkPeering_ACTIVE.String() {
+		fields = append(fields, "localPeering")
+	}
+
+	return fields
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/drift"
 	"github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering/types"
 )
 
@@ -31,12 +32,14 @@ func New(stateFactory StateFactory) composed.Action {
 			composed.IfElse(composed.Not(composed.MarkedForDeletionPredicate),
 				composed.ComposeActions(
 					"gcpVpcPeering-create",
+					drift.New(drift.KindVpcPeering, detectDrift),
 					checkIfRemoteVpcIsTagged,
 					createRemoteVpcPeering,
 					waitRemoteVpcPeeringAvailable,
 					createLocalVpcPeering,
 					waitVpcPeeringActive,
 					updateStatus,
+					drift.StopAndForgetAction(drift.KindVpcPeering),
 				),
 				composed.ComposeActions(
 					"gcpVpcPeering-delete",
@@ -44,6 +47,7 @@ func New(stateFactory StateFactory) composed.Action {
 					deleteVpcPeering,
 					waitLocalVpcPeeringDeletion,
 					deleteRemoteVpcPeering,
+					drift.Forget(drift.KindVpcPeering),
 					actions.RemoveCommonFinalizer(),
 					composed.StopAndForgetAction,
 				),
@@ -14,6 +14,7 @@ type ClientFactory struct {
 	pi       *ProvidedInfo
 	netSvc   *gophercloud.ServiceClient
 	shareSvc *gophercloud.ServiceClient
+	dbSvc    *gophercloud.ServiceClient
 }
 
 func NewClientFactory(pp ProviderParams) *ClientFactory {
@@ -67,6 +68,21 @@ func (b *ClientFactory) ensureShareSvc(ctx context.Context) error {
 	return nil
 }
 
+func (b *ClientFactory) ensureDbSvc(ctx context.Context) error {
+	if b.dbSvc != nil {
+		return nil
+	}
+	if err := b.ensureProviderInfo(ctx); err != nil {
+		return err
+	}
+	dbSvc, err := openstack.NewDBV1(b.pi.ProviderClient, b.pi.EndpointOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create database v1 client: %w", err)
+	}
+	b.dbSvc = dbSvc
+	return nil
+}
+
 func (b *ClientFactory) NetworkClient(ctx context.Context) (NetworkClient, error) {
 	if err := b.ensureNetSvc(ctx); err != nil {
 		return nil, err
@@ -95,6 +111,13 @@ func (b *ClientFactory) PortClient(ctx context.Context) (PortClient, error) {
 	return &portClient{netSvc: b.netSvc}, nil
 }
 
+func (b *ClientFactory) RbacPolicyClient(ctx context.Context) (RbacPolicyClient, error) {
+	if err := b.ensureNetSvc(ctx); err != nil {
+		return nil, err
+	}
+	return &rbacPolicyClient{netSvc: b.netSvc}, nil
+}
+
 func (b *ClientFactory) ShareClient(ctx context.Context) (ShareClient, error) {
 	if err := b.ensureShareSvc(ctx); err != nil {
 		return nil, err
@@ -108,3 +131,10 @@ func (b *ClientFactory) SnapshotClient(ctx context.Context) (SnapshotClient, err
 	}
 	return &snapshotClient{shareSvc: b.shareSvc}, nil
 }
+
+func (b *ClientFactory) DatabaseClient(ctx context.Context) (DatabaseClient, error) {
+	if err := b.ensureDbSvc(ctx); err != nil {
+		return nil, err
+	}
+	return &databaseClient{dbSvc: b.dbSvc}, nil
+}
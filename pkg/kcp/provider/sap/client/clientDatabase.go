@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/users"
+)
+
+type DatabaseClient interface {
+	// ListDatabaseInstances
+	// instance.status possible values https://docs.openstack.org/trove/latest/user/manage-db-and-users.html
+	// * BUILD
+	// * ACTIVE
+	// * ERROR
+	// * RESIZE
+	// * REBOOT
+	// * SHUTOFF
+	ListDatabaseInstances(ctx context.Context) ([]instances.Instance, error)
+	GetDatabaseInstance(ctx context.Context, id string) (*instances.Instance, error)
+	CreateDatabaseInstance(ctx context.Context, opts instances.CreateOpts) (*instances.Instance, error)
+	DeleteDatabaseInstance(ctx context.Context, id string) error
+	EnableDatabaseInstanceRootUser(ctx context.Context, id string) (*users.User, error)
+
+	ListDatabaseFlavors(ctx context.Context) ([]flavors.Flavor, error)
+
+	// high level opinionated
+
+	GetDatabaseInstanceByName(ctx context.Context, name string) (*instances.Instance, error)
+	GetDatabaseFlavorByName(ctx context.Context, name string) (*flavors.Flavor, error)
+}
+
+var _ DatabaseClient = (*databaseClient)(nil)
+
+type databaseClient struct {
+	dbSvc *gophercloud.ServiceClient
+}
+
+// low level methods matching the gophercloud api ================================
+
+func (c *databaseClient) ListDatabaseInstances(ctx context.Context) ([]instances.Instance, error) {
+	page, err := instances.List(c.dbSvc).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := instances.ExtractInstances(page)
+	if err != nil {
+		return nil, err
+	}
+	return arr, nil
+}
+
+func (c *databaseClient) GetDatabaseInstance(ctx context.Context, id string) (*instances.Instance, error) {
+	instance, err := instances.Get(ctx, c.dbSvc, id).Extract()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+func (c *databaseClient) CreateDatabaseInstance(ctx context.Context, opts instances.CreateOpts) (*instances.Instance, error) {
+	instance, err := instances.Create(ctx, c.dbSvc, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+func (c *databaseClient) DeleteDatabaseInstance(ctx context.Context, id string) error {
+	err := instances.Delete(ctx, c.dbSvc, id).ExtractErr()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *databaseClient) EnableDatabaseInstanceRootUser(ctx context.Context, id string) (*users.User, error) {
+	user, err := instances.EnableRootUser(ctx, c.dbSvc, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (c *databaseClient) ListDatabaseFlavors(ctx context.Context) ([]flavors.Flavor, error) {
+	page, err := flavors.List(c.dbSvc).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := flavors.ExtractFlavors(page)
+	if err != nil {
+		return nil, err
+	}
+	return arr, nil
+}
+
+// high level derived methods ============================================================
+
+func (c *databaseClient) GetDatabaseInstanceByName(ctx context.Context, name string) (*instances.Instance, error) {
+	arr, err := c.ListDatabaseInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range arr {
+		if instance.Name == name {
+			return &instance, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *databaseClient) GetDatabaseFlavorByName(ctx context.Context, name string) (*flavors.Flavor, error) {
+	arr, err := c.ListDatabaseFlavors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, flavor := range arr {
+		if flavor.Name == name {
+			return &flavor, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/rbacpolicies"
+)
+
+type RbacPolicyClient interface {
+	ListRbacPolicies(ctx context.Context, opts rbacpolicies.ListOpts) ([]rbacpolicies.RBACPolicy, error)
+	GetRbacPolicy(ctx context.Context, id string) (*rbacpolicies.RBACPolicy, error)
+	CreateRbacPolicy(ctx context.Context, opts rbacpolicies.CreateOpts) (*rbacpolicies.RBACPolicy, error)
+	DeleteRbacPolicy(ctx context.Context, id string) error
+
+	GetNetworkSharedRbacPolicy(ctx context.Context, networkId string, targetProject string) (*rbacpolicies.RBACPolicy, error)
+	ShareNetwork(ctx context.Context, networkId string, targetProject string) (*rbacpolicies.RBACPolicy, error)
+}
+
+var _ RbacPolicyClient = (*rbacPolicyClient)(nil)
+
+type rbacPolicyClient struct {
+	netSvc *gophercloud.ServiceClient
+}
+
+// low level methods matching the gophercloud api ================================
+
+func (c *rbacPolicyClient) ListRbacPolicies(ctx context.Context, opts rbacpolicies.ListOpts) ([]rbacpolicies.RBACPolicy, error) {
+	page, err := rbacpolicies.List(c.netSvc, opts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	arr, err := rbacpolicies.ExtractRBACPolicies(page)
+	if err != nil {
+		return nil, err
+	}
+	return arr, nil
+}
+
+func (c *rbacPolicyClient) GetRbacPolicy(ctx context.Context, id string) (*rbacpolicies.RBACPolicy, error) {
+	policy, err := rbacpolicies.Get(ctx, c.netSvc, id).Extract()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (c *rbacPolicyClient) CreateRbacPolicy(ctx context.Context, opts rbacpolicies.CreateOpts) (*rbacpolicies.RBACPolicy, error) {
+	policy, err := rbacpolicies.Create(ctx, c.netSvc, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (c *rbacPolicyClient) DeleteRbacPolicy(ctx context.Context, id string) error {
+	err := rbacpolicies.Delete(ctx, c.netSvc, id).ExtractErr()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
+
+// high level derived methods ============================================================
+
+func (c *rbacPolicyClient) GetNetworkSharedRbacPolicy(ctx context.Context, networkId string, targetProject string) (*rbacpolicies.RBACPolicy, error) {
+	arr, err := c.ListRbacPolicies(ctx, rbacpolicies.ListOpts{
+		ObjectType:   "network",
+		ObjectID:     networkId,
+		Action:       rbacpolicies.ActionAccessShared,
+		TargetTenant: targetProject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(arr) > 0 {
+		return &arr[0], nil
+	}
+	return nil, nil
+}
+
+func (c *rbacPolicyClient) ShareNetwork(ctx context.Context, networkId string, targetProject string) (*rbacpolicies.RBACPolicy, error) {
+	return c.CreateRbacPolicy(ctx, rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.ActionAccessShared,
+		ObjectType:   "network",
+		ObjectID:     networkId,
+		TargetTenant: targetProject,
+	})
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
 )
 
 type Client interface {
 	GetRouterByName(ctx context.Context, routerName string) (*routers.Router, error)
+	GetNetworkByName(ctx context.Context, networkName string) (*networks.Network, error)
 }
 
 var _ Client = &client{}
@@ -54,3 +56,22 @@ func (c *client) GetRouterByName(ctx context.Context, routerName string) (*route
 
 	return &allRouters[0], nil
 }
+
+func (c *client) GetNetworkByName(ctx context.Context, networkName string) (*networks.Network, error) {
+	pg, err := networks.List(c.netSvc, networks.ListOpts{
+		Name: networkName,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing networks: %w", err)
+	}
+	allNetworks, err := networks.ExtractNetworks(pg)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting networks: %w", err)
+	}
+
+	if len(allNetworks) == 0 {
+		return nil, nil
+	}
+
+	return &allNetworks[0], nil
+}
@@ -20,11 +20,26 @@ func exposedDataSetToScope(ctx context.Context, st composed.State) (error, conte
 		list = pie.Sort(pie.Unique(list))
 	}
 
-	state.ObjAsScope().Status.ExposedData.NatGatewayIps = list
+	exposedData := &state.ObjAsScope().Status.ExposedData
+	network := state.ObjAsScope().Spec.Scope.OpenStack.Network
+
+	exposedData.NatGatewayIps = list
+	exposedData.Region = state.ObjAsScope().Spec.Region
+	exposedData.Zones = pie.Sort(pie.Unique(network.Zones))
+	exposedData.NodesCidr = network.Nodes
+	exposedData.PodsCidr = network.Pods
+	exposedData.ServicesCidr = network.Services
+	exposedData.NetworkId = ""
+	if state.network != nil {
+		exposedData.NetworkId = state.network.ID
+	}
 
 	logger.
-		WithValues("natGatewayIps", fmt.Sprintf("%v", state.ObjAsScope().Status.ExposedData.NatGatewayIps)).
-		Info("Exposed Data SAP Nat Gateway IP addresses")
+		WithValues(
+			"natGatewayIps", fmt.Sprintf("%v", exposedData.NatGatewayIps),
+			"networkId", exposedData.NetworkId,
+		).
+		Info("Exposed Data SAP network")
 
 	return nil, ctx
 }
@@ -0,0 +1,22 @@
+package exposedData
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func networkLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	networkName := state.ObjAsScope().Spec.Scope.OpenStack.VpcNetwork
+
+	network, err := state.sapClient.GetNetworkByName(ctx, networkName)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error getting SAP network", composed.StopWithRequeue, ctx)
+	}
+
+	state.network = network
+
+	return nil, ctx
+}
@@ -27,6 +27,7 @@ func New(sf StateFactory) composed.Action {
 
 		return composed.ComposeActionsNoName(
 			routerLoad,
+			networkLoad,
 			exposedDataSetToScope,
 		)(ctx, state)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
 	sapconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/config"
@@ -50,5 +51,6 @@ type State struct {
 
 	sapClient sapexposeddataclient.Client
 
-	router *routers.Router
+	router  *routers.Router
+	network *networks.Network
 }
@@ -10,8 +10,13 @@ import (
 	"github.com/elliotchance/pie/v2"
 	"github.com/google/uuid"
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/datastores"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/users"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/rbacpolicies"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
@@ -37,6 +42,11 @@ type NfsConfig interface {
 	SetSnapshotStatus(id, status string)
 }
 
+type RedisConfig interface {
+	AddDatabaseFlavor(name string) *flavors.Flavor
+	SetDatabaseInstanceStatus(id, status string)
+}
+
 func newMainStore() *mainStore {
 	return &mainStore{
 		subnets:        map[string][]subnetInfoType{},
@@ -63,6 +73,9 @@ type mainStore struct {
 	shares           map[string][]*shares.Share
 	access           map[string][]*sapclient.ShareAccess
 	shareSnapshots   []*snapshots.Snapshot
+	rbacPolicies     []*rbacpolicies.RBACPolicy
+	dbFlavors        []*flavors.Flavor
+	dbInstances      []*instances.Instance
 }
 
 // NfsConfig implementation ======================================================================
@@ -126,6 +139,34 @@ func (s *mainStore) SetSnapshotStatus(id, status string) {
 	}
 }
 
+// RedisConfig implementation ======================================================================
+
+func (s *mainStore) AddDatabaseFlavor(name string) *flavors.Flavor {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	f := &flavors.Flavor{
+		ID:    len(s.dbFlavors) + 1,
+		Name:  name,
+		RAM:   1024,
+		StrID: uuid.NewString(),
+	}
+	s.dbFlavors = append(s.dbFlavors, f)
+	return f
+}
+
+func (s *mainStore) SetDatabaseInstanceStatus(id, status string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	for _, instance := range s.dbInstances {
+		if instance.ID == id {
+			instance.Status = status
+			return
+		}
+	}
+}
+
 // Clients implementation ==============================================================
 
 var _ Clients = (*mainStore)(nil)
@@ -1565,3 +1606,281 @@ func (s *mainStore) RevertShareToSnapshot(ctx context.Context, shareId string, s
 		Actual: http.StatusNotFound,
 	}
 }
+
+// RbacPolicyClient implementation ---------------------------------------------
+
+func (s *mainStore) ListRbacPolicies(ctx context.Context, opts rbacpolicies.ListOpts) ([]rbacpolicies.RBACPolicy, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	var result []rbacpolicies.RBACPolicy
+	for _, policy := range s.rbacPolicies {
+		isMatch := true
+		if opts.ObjectType != "" && policy.ObjectType != opts.ObjectType {
+			isMatch = false
+		}
+		if opts.ObjectID != "" && policy.ObjectID != opts.ObjectID {
+			isMatch = false
+		}
+		if opts.Action != "" && policy.Action != opts.Action {
+			isMatch = false
+		}
+		if opts.TargetTenant != "" && policy.TargetTenant != opts.TargetTenant {
+			isMatch = false
+		}
+		if isMatch {
+			result = append(result, *policy)
+		}
+	}
+	return result, nil
+}
+
+func (s *mainStore) GetRbacPolicy(ctx context.Context, id string) (*rbacpolicies.RBACPolicy, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	for _, policy := range s.rbacPolicies {
+		if policy.ID == id {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *mainStore) CreateRbacPolicy(ctx context.Context, opts rbacpolicies.CreateOpts) (*rbacpolicies.RBACPolicy, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	policy := &rbacpolicies.RBACPolicy{
+		ID:           uuid.NewString(),
+		Action:       opts.Action,
+		ObjectType:   opts.ObjectType,
+		ObjectID:     opts.ObjectID,
+		TargetTenant: opts.TargetTenant,
+	}
+	s.rbacPolicies = append(s.rbacPolicies, policy)
+	return policy, nil
+}
+
+func (s *mainStore) DeleteRbacPolicy(ctx context.Context, id string) error {
+	if util.IsContextDone(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	s.rbacPolicies = pie.Filter(s.rbacPolicies, func(x *rbacpolicies.RBACPolicy) bool {
+		return x.ID != id
+	})
+	return nil
+}
+
+func (s *mainStore) GetNetworkSharedRbacPolicy(ctx context.Context, networkId string, targetProject string) (*rbacpolicies.RBACPolicy, error) {
+	arr, err := s.ListRbacPolicies(ctx, rbacpolicies.ListOpts{
+		ObjectType:   "network",
+		ObjectID:     networkId,
+		Action:       rbacpolicies.ActionAccessShared,
+		TargetTenant: targetProject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(arr) > 0 {
+		return &arr[0], nil
+	}
+	return nil, nil
+}
+
+func (s *mainStore) ShareNetwork(ctx context.Context, networkId string, targetProject string) (*rbacpolicies.RBACPolicy, error) {
+	return s.CreateRbacPolicy(ctx, rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.ActionAccessShared,
+		ObjectType:   "network",
+		ObjectID:     networkId,
+		TargetTenant: targetProject,
+	})
+}
+
+// DatabaseClient implementation -----------------------------------------------
+
+func (s *mainStore) ListDatabaseInstances(ctx context.Context) ([]instances.Instance, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	return deptrSlice(s.dbInstances), nil
+}
+
+func (s *mainStore) GetDatabaseInstance(ctx context.Context, id string) (*instances.Instance, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	for _, instance := range s.dbInstances {
+		if instance.ID == id {
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *mainStore) CreateDatabaseInstance(ctx context.Context, opts instances.CreateOpts) (*instances.Instance, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	var flavor *flavors.Flavor
+	for _, f := range s.dbFlavors {
+		if f.StrID == opts.FlavorRef {
+			flavor = f
+			break
+		}
+	}
+	if flavor == nil {
+		return nil, sapmeta.NewNotFoundError("Flavor not found")
+	}
+	if opts.Datastore == nil {
+		return nil, sapmeta.NewBadRequestError("Datastore is empty")
+	}
+	if len(opts.Networks) != 1 || opts.Networks[0].Port == "" {
+		return nil, sapmeta.NewBadRequestError("Exactly one network with port is expected")
+	}
+	port, err := s.getPortNoLock(ctx, opts.Networks[0].Port)
+	if err != nil {
+		return nil, err
+	}
+	if port == nil {
+		return nil, sapmeta.NewNotFoundError("Port not found")
+	}
+	if port.DeviceID != "" {
+		return nil, sapmeta.NewBadRequestError("Port already in use")
+	}
+
+	id := uuid.NewString()
+	port.DeviceID = id
+
+	var addresses []instances.Address
+	for _, ip := range port.FixedIPs {
+		addresses = append(addresses, instances.Address{
+			Type:    "private",
+			Address: ip.IPAddress,
+		})
+	}
+
+	instance := &instances.Instance{
+		ID:      id,
+		Name:    opts.Name,
+		Created: time.Now(),
+		Updated: time.Now(),
+		Flavor: instances.Flavor{
+			ID: flavor.StrID,
+		},
+		Status: "BUILD",
+		Volume: instances.Volume{
+			Size: opts.Size,
+		},
+		Datastore: datastores.DatastorePartial{
+			Type:    opts.Datastore.Type,
+			Version: opts.Datastore.Version,
+		},
+		Addresses: addresses,
+	}
+	s.dbInstances = append(s.dbInstances, instance)
+	return instance, nil
+}
+
+func (s *mainStore) DeleteDatabaseInstance(ctx context.Context, id string) error {
+	if util.IsContextDone(ctx) {
+		return context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	for _, port := range s.ports {
+		if port.DeviceID == id {
+			port.DeviceID = ""
+		}
+	}
+	s.dbInstances = pie.Filter(s.dbInstances, func(x *instances.Instance) bool {
+		return x.ID != id
+	})
+	return nil
+}
+
+func (s *mainStore) EnableDatabaseInstanceRootUser(ctx context.Context, id string) (*users.User, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	for _, instance := range s.dbInstances {
+		if instance.ID == id {
+			return &users.User{
+				Name:     "root",
+				Password: util.RandomString(24),
+			}, nil
+		}
+	}
+	return nil, sapmeta.NewNotFoundError("Database instance not found")
+}
+
+func (s *mainStore) ListDatabaseFlavors(ctx context.Context) ([]flavors.Flavor, error) {
+	if util.IsContextDone(ctx) {
+		return nil, context.Canceled
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	time.Sleep(time.Millisecond)
+
+	return deptrSlice(s.dbFlavors), nil
+}
+
+func (s *mainStore) GetDatabaseInstanceByName(ctx context.Context, name string) (*instances.Instance, error) {
+	arr, err := s.ListDatabaseInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range arr {
+		if instance.Name == name {
+			return &instance, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *mainStore) GetDatabaseFlavorByName(ctx context.Context, name string) (*flavors.Flavor, error) {
+	arr, err := s.ListDatabaseFlavors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, flavor := range arr {
+		if flavor.Name == name {
+			return &flavor, nil
+		}
+	}
+	return nil, nil
+}
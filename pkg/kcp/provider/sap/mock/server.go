@@ -9,7 +9,9 @@ import (
 	sapexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/exposedData/client"
 	sapiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/iprange/client"
 	sapnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nfsinstance/client"
+	sapredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance/client"
 	sapvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcnetwork/client"
+	sapvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering/client"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 )
 
@@ -63,6 +65,26 @@ func (s *server) VpcNetworkProvider() sapclient.SapClientProvider[sapvpcnetworkc
 	}
 }
 
+func (s *server) VpcPeeringProvider() sapclient.SapClientProvider[sapvpcpeeringclient.Client] {
+	return func(ctx context.Context, pp sapclient.ProviderParams) (sapvpcpeeringclient.Client, error) {
+		p := s.GetProjectByProviderParams(pp)
+		if p == nil {
+			return nil, fmt.Errorf("no project found for %s", pp.String())
+		}
+		return s.GetProjectByProviderParams(pp), nil
+	}
+}
+
+func (s *server) RedisInstanceProvider() sapclient.SapClientProvider[sapredisinstanceclient.Client] {
+	return func(ctx context.Context, pp sapclient.ProviderParams) (sapredisinstanceclient.Client, error) {
+		p := s.GetProjectByProviderParams(pp)
+		if p == nil {
+			return nil, fmt.Errorf("no project found for %s", pp.String())
+		}
+		return s.GetProjectByProviderParams(pp), nil
+	}
+}
+
 func (s *server) SnapshotClientProvider() sapclient.SapClientProvider[sapclient.SnapshotClient] {
 	return func(ctx context.Context, pp sapclient.ProviderParams) (sapclient.SnapshotClient, error) {
 		p := s.GetProjectByProviderParams(pp)
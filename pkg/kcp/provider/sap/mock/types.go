@@ -5,12 +5,16 @@ import (
 	sapexposeddataclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/exposedData/client"
 	sapiprangeclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/iprange/client"
 	sapnfsinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/nfsinstance/client"
+	sapredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance/client"
 	sapvpcnetworkclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcnetwork/client"
+	sapvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering/client"
 )
 
 type Clients interface {
+	sapclient.DatabaseClient
 	sapclient.NetworkClient
 	sapclient.PortClient
+	sapclient.RbacPolicyClient
 	sapclient.RouterClient
 	sapclient.ShareClient
 	sapclient.SnapshotClient
@@ -22,12 +26,15 @@ type Providers interface {
 	NfsInstanceProvider() sapclient.SapClientProvider[sapnfsinstanceclient.Client]
 	ExposedDataProvider() sapclient.SapClientProvider[sapexposeddataclient.Client]
 	VpcNetworkProvider() sapclient.SapClientProvider[sapvpcnetworkclient.Client]
+	VpcPeeringProvider() sapclient.SapClientProvider[sapvpcpeeringclient.Client]
+	RedisInstanceProvider() sapclient.SapClientProvider[sapredisinstanceclient.Client]
 	SnapshotClientProvider() sapclient.SapClientProvider[sapclient.SnapshotClient]
 	ShareClientProvider() sapclient.SapClientProvider[sapclient.ShareClient]
 }
 
 type Config interface {
 	NfsConfig
+	RedisConfig
 }
 
 type Server interface {
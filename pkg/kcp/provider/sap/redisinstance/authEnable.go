@@ -0,0 +1,36 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// authEnable enables the root user on the database instance and keeps the generated password
+// in the status, since it is returned only once when root user is enabled.
+func authEnable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if !state.ObjAsRedisInstance().Spec.Instance.OpenStack.AuthEnabled {
+		return nil, ctx
+	}
+	if state.ObjAsRedisInstance().Status.AuthString != "" {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("Enabling SAP database instance root user")
+
+	user, err := state.sapClient.EnableDatabaseInstanceRootUser(ctx, state.instance.ID)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error enabling SAP database instance root user", composed.StopWithRequeue, ctx)
+	}
+
+	state.ObjAsRedisInstance().Status.AuthString = user.Password
+
+	return composed.PatchStatus(state.ObjAsRedisInstance()).
+		ErrorLogMessage("Error patching SAP RedisInstance status with auth string").
+		SuccessErrorNil().
+		Run(ctx, state)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+)
+
+type Client interface {
+	sapclient.PortClient
+	sapclient.DatabaseClient
+}
+
+func NewClientProvider() sapclient.SapClientProvider[Client] {
+	return func(ctx context.Context, pp sapclient.ProviderParams) (Client, error) {
+		f := sapclient.NewClientFactory(pp)
+		pc, err := f.PortClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dc, err := f.DatabaseClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &client{
+			PortClient:     pc,
+			DatabaseClient: dc,
+		}, nil
+	}
+}
+
+type client struct {
+	sapclient.PortClient
+	sapclient.DatabaseClient
+}
@@ -0,0 +1,24 @@
+package redisinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func conditionsInit(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.ObjAsRedisInstance().Status.State != "" {
+		return nil, ctx
+	}
+
+	state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateProcessing
+
+	return composed.PatchStatus(state.ObjAsRedisInstance()).
+		ErrorLogMessage("Error updating SAP RedisInstance initial status state").
+		FailedError(composed.StopWithRequeue).
+		SuccessErrorNil().
+		Run(ctx, state)
+}
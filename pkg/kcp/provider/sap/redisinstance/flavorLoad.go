@@ -0,0 +1,48 @@
+package redisinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func flavorLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.instance != nil {
+		// flavor is needed only to create the instance
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	flavorName := state.ObjAsRedisInstance().Spec.Instance.OpenStack.FlavorName
+
+	flavor, err := state.sapClient.GetDatabaseFlavorByName(ctx, flavorName)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading SAP database flavor", composed.StopWithRequeue, ctx)
+	}
+
+	if flavor == nil {
+		logger.Error(errors.New("no flavor"), "SAP database flavor not found", "flavorName", flavorName)
+		state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateError
+		return composed.PatchStatus(state.ObjAsRedisInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Flavor not found",
+			}).
+			ErrorLogMessage("Error patching SAP RedisInstance status with error when flavor not found").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T300000ms())).
+			Run(ctx, state)
+	}
+
+	state.flavor = flavor
+
+	return nil, ctx
+}
@@ -0,0 +1,67 @@
+package redisinstance
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func instanceCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.instance != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("Creating SAP database instance")
+
+	flavorRef := state.flavor.StrID
+	if flavorRef == "" {
+		flavorRef = strconv.Itoa(state.flavor.ID)
+	}
+
+	openStack := state.ObjAsRedisInstance().Spec.Instance.OpenStack
+	instance, err := state.sapClient.CreateDatabaseInstance(ctx, instances.CreateOpts{
+		Name:      state.InstanceName(),
+		FlavorRef: flavorRef,
+		Size:      openStack.VolumeSizeGb,
+		Datastore: &instances.DatastoreOpts{
+			Type:    openStack.Engine,
+			Version: openStack.EngineVersion,
+		},
+		Networks: []instances.NetworkOpts{
+			{Port: state.port.ID},
+		},
+	})
+	if err != nil {
+		logger.Error(err, "Error creating SAP database instance")
+		state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateError
+		return composed.PatchStatus(state.ObjAsRedisInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Error creating SAP database instance",
+			}).
+			ErrorLogMessage("Error patching SAP RedisInstance status with error state after failed instance creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	state.instance = instance
+
+	state.ObjAsRedisInstance().Status.Id = instance.ID
+	state.ObjAsRedisInstance().Status.State = "Creating"
+
+	return composed.PatchStatus(state.ObjAsRedisInstance()).
+		ErrorLogMessage("Error patching SAP RedisInstance status with created instance id").
+		SuccessErrorNil().
+		Run(ctx, state)
+}
@@ -0,0 +1,45 @@
+package redisinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func instanceDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.instance == nil {
+		return nil, ctx
+	}
+	if state.instance.Status == "SHUTDOWN" {
+		// deletion already in progress
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("Deleting SAP database instance")
+
+	err := state.sapClient.DeleteDatabaseInstance(ctx, state.instance.ID)
+	if err != nil {
+		logger.Error(err, "Error deleting SAP database instance")
+		state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateError
+		return composed.PatchStatus(state.ObjAsRedisInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Error deleting SAP database instance",
+			}).
+			ErrorLogMessage("Error patching SAP RedisInstance status after error deleting instance").
+			FailedError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,36 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func instanceLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	var instance *instances.Instance
+	var err error
+	if id := state.ObjAsRedisInstance().Status.Id; id != "" {
+		instance, err = state.sapClient.GetDatabaseInstance(ctx, id)
+	} else {
+		instance, err = state.sapClient.GetDatabaseInstanceByName(ctx, state.InstanceName())
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading SAP database instance", composed.StopWithRequeue, ctx)
+	}
+
+	state.instance = instance
+
+	if state.instance != nil {
+		logger = logger.WithValues(
+			"sapDatabaseInstanceId", state.instance.ID,
+			"sapDatabaseInstanceStatus", state.instance.Status,
+		)
+		ctx = composed.LoggerIntoCtx(ctx, logger)
+	}
+
+	return nil, ctx
+}
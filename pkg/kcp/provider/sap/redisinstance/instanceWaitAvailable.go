@@ -0,0 +1,46 @@
+package redisinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func instanceWaitAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.instance.Status == "ACTIVE" {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.instance.Status != "ERROR" {
+		logger.Info("Waiting for SAP database instance to be active")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	// instance is in error state
+	message := "SAP database instance in error state"
+	if state.instance.Fault != nil && state.instance.Fault.Message != "" {
+		message = state.instance.Fault.Message
+	}
+	logger.Error(errors.New(message), "SAP database instance error")
+
+	state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateError
+
+	return composed.PatchStatus(state.ObjAsRedisInstance()).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+			Message: message,
+		}).
+		ErrorLogMessage("Error patching SAP RedisInstance status after instance in error state").
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T300000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,20 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func instanceWaitDeleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.instance == nil {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Waiting for SAP database instance to be deleted")
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
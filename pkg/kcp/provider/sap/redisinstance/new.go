@@ -0,0 +1,59 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	sapmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/meta"
+	redisinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance/types"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		logger := composed.LoggerFromCtx(ctx)
+		redisState := st.(redisinstancetypes.State)
+		state, err := stateFactory.NewState(ctx, redisState)
+		if err != nil {
+			err = fmt.Errorf("error creating new sap redisinstance state: %w", err)
+			logger.Error(err, "Error")
+			return composed.StopAndForget, nil
+		}
+
+		return composed.ComposeActions(
+			"sapRedisInstance",
+			actions.AddCommonFinalizer(),
+			conditionsInit,
+			portLoad,
+			instanceLoad,
+			composed.IfElse(
+				composed.MarkedForDeletionPredicate,
+				composed.ComposeActions(
+					"sapRedisInstance-delete",
+					statusDeleting,
+					instanceDelete,
+					instanceWaitDeleted,
+					portDelete,
+					actions.PatchRemoveCommonFinalizer(),
+					composed.StopAndForgetAction,
+				),
+				composed.ComposeActions(
+					"sapRedisInstance-create",
+					flavorLoad,
+					portCreate,
+					instanceCreate,
+					instanceWaitAvailable,
+					authEnable,
+					statusReady,
+					composed.StopAndForgetAction,
+				),
+			),
+		)(sapmeta.SetSapDomainProjectRegion(
+			ctx,
+			redisState.Scope().Spec.Scope.OpenStack.DomainName,
+			redisState.Scope().Spec.Scope.OpenStack.TenantName,
+			redisState.Scope().Spec.Region,
+		), state)
+	}
+}
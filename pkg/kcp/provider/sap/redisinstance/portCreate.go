@@ -0,0 +1,55 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func portCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.port != nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	ipRange := state.IpRange()
+	if ipRange.Status.VpcId == "" || len(ipRange.Status.Subnets) == 0 || ipRange.Status.Subnets[0].Id == "" {
+		logger.Info("Waiting for SAP KCP IpRange subnet")
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	logger.Info("Creating SAP RedisInstance port")
+
+	port, err := state.sapClient.CreatePort(ctx, ports.CreateOpts{
+		Name:      state.PortName(),
+		NetworkID: ipRange.Status.VpcId,
+		FixedIPs: []ports.IP{
+			{SubnetID: ipRange.Status.Subnets[0].Id},
+		},
+	})
+	if err != nil {
+		logger.Error(err, "Error creating SAP RedisInstance port")
+		state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateError
+		return composed.PatchStatus(state.ObjAsRedisInstance()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudcontrolv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: "Error creating SAP port",
+			}).
+			ErrorLogMessage("Error patching SAP RedisInstance status with error state after failed port creation").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	state.port = port
+
+	return nil, ctx
+}
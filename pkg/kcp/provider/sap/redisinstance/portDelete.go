@@ -0,0 +1,28 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func portDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.port == nil {
+		return nil, ctx
+	}
+
+	logger := composed.LoggerFromCtx(ctx)
+
+	logger.Info("Deleting SAP RedisInstance port")
+
+	err := state.sapClient.DeletePort(ctx, state.port.ID)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error deleting SAP RedisInstance port", composed.StopWithRequeue, ctx)
+	}
+
+	state.port = nil
+
+	return nil, ctx
+}
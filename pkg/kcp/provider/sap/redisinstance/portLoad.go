@@ -0,0 +1,30 @@
+package redisinstance
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func portLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	arr, err := state.sapClient.ListPorts(ctx, ports.ListOpts{
+		Name: state.PortName(),
+	})
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing SAP RedisInstance ports", composed.StopWithRequeue, ctx)
+	}
+	if len(arr) == 0 {
+		return nil, ctx
+	}
+
+	state.port = &arr[0]
+
+	logger = logger.WithValues("sapPortId", state.port.ID)
+	ctx = composed.LoggerIntoCtx(ctx, logger)
+
+	return nil, ctx
+}
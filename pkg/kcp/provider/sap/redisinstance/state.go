@@ -0,0 +1,78 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/db/v1/instances"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	sapconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/config"
+	sapredisinstanceclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance/client"
+	redisinstancetypes "github.com/kyma-project/cloud-manager/pkg/kcp/redisinstance/types"
+)
+
+const redisPort = 6379
+
+type State struct {
+	redisinstancetypes.State
+
+	sapClient sapredisinstanceclient.Client
+
+	flavor   *flavors.Flavor
+	port     *ports.Port
+	instance *instances.Instance
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, redisInstanceState redisinstancetypes.State) (*State, error)
+}
+
+func NewStateFactory(clientProvider sapclient.SapClientProvider[sapredisinstanceclient.Client]) StateFactory {
+	return &stateFactory{
+		clientProvider: clientProvider,
+	}
+}
+
+type stateFactory struct {
+	clientProvider sapclient.SapClientProvider[sapredisinstanceclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, redisInstanceState redisinstancetypes.State) (*State, error) {
+	pp := sapclient.NewProviderParamsFromConfig(sapconfig.SapConfig).
+		WithDomain(redisInstanceState.Scope().Spec.Scope.OpenStack.DomainName).
+		WithProject(redisInstanceState.Scope().Spec.Scope.OpenStack.TenantName).
+		WithRegion(redisInstanceState.Scope().Spec.Region)
+	sapClient, err := f.clientProvider(ctx, pp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating sap client for redis: %w", err)
+	}
+
+	return &State{
+		State:     redisInstanceState,
+		sapClient: sapClient,
+	}, nil
+}
+
+func (s *State) InstanceName() string {
+	return fmt.Sprintf("cm-%s", s.ObjAsRedisInstance().Name)
+}
+
+func (s *State) PortName() string {
+	return fmt.Sprintf("cm-%s", s.ObjAsRedisInstance().Name)
+}
+
+// InstanceAddress returns the first address of the loaded instance, or empty string if it has none
+func (s *State) InstanceAddress() string {
+	if s.instance == nil {
+		return ""
+	}
+	if len(s.instance.Addresses) > 0 {
+		return s.instance.Addresses[0].Address
+	}
+	if len(s.instance.IP) > 0 {
+		return s.instance.IP[0]
+	}
+	return s.instance.Hostname
+}
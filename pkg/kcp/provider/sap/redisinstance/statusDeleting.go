@@ -0,0 +1,23 @@
+package redisinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func statusDeleting(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.ObjAsRedisInstance().Status.State == cloudcontrolv1beta1.StateDeleting {
+		return nil, ctx
+	}
+
+	state.ObjAsRedisInstance().Status.State = cloudcontrolv1beta1.StateDeleting
+
+	return composed.PatchStatus(state.ObjAsRedisInstance()).
+		ErrorLogMessage("Error patching SAP RedisInstance with deleting status state").
+		SuccessErrorNil().
+		Run(ctx, state)
+}
@@ -0,0 +1,64 @@
+package redisinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func statusReady(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	redisInstance := state.ObjAsRedisInstance()
+
+	changed := false
+
+	if redisInstance.Status.Id != state.instance.ID {
+		redisInstance.Status.Id = state.instance.ID
+		changed = true
+	}
+
+	primaryEndpoint := fmt.Sprintf("%s:%d", state.InstanceAddress(), redisPort)
+	if redisInstance.Status.PrimaryEndpoint != primaryEndpoint {
+		redisInstance.Status.PrimaryEndpoint = primaryEndpoint
+		changed = true
+	}
+
+	if redisInstance.Status.NodeType != redisInstance.Spec.Instance.OpenStack.FlavorName {
+		redisInstance.Status.NodeType = redisInstance.Spec.Instance.OpenStack.FlavorName
+		changed = true
+	}
+
+	if redisInstance.Status.State != cloudcontrolv1beta1.StateReady {
+		redisInstance.Status.State = cloudcontrolv1beta1.StateReady
+		changed = true
+	}
+
+	if len(redisInstance.Status.Conditions) != 1 {
+		changed = true
+	}
+
+	cond := meta.FindStatusCondition(*redisInstance.Conditions(), cloudcontrolv1beta1.ConditionTypeReady)
+	if cond == nil {
+		changed = true
+	} else if cond.Status != metav1.ConditionTrue || cond.Reason != cloudcontrolv1beta1.ReasonReady {
+		changed = true
+	}
+
+	if !changed {
+		return nil, ctx
+	}
+
+	return composed.PatchStatus(redisInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudcontrolv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudcontrolv1beta1.ReasonReady,
+			Message: "Ready",
+		}).
+		ErrorLogMessage("Error patching SAP RedisInstance status after setting ready condition").
+		Run(ctx, state)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+)
+
+type Client interface {
+	sapclient.NetworkClient
+	sapclient.SubnetClient
+	sapclient.RouterClient
+	sapclient.PortClient
+	sapclient.RbacPolicyClient
+}
+
+func NewClientProvider() sapclient.SapClientProvider[Client] {
+	return func(ctx context.Context, pp sapclient.ProviderParams) (Client, error) {
+		f := sapclient.NewClientFactory(pp)
+		nc, err := f.NetworkClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sc, err := f.SubnetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rc, err := f.RouterClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pc, err := f.PortClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rbc, err := f.RbacPolicyClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &client{
+			NetworkClient:    nc,
+			SubnetClient:     sc,
+			RouterClient:     rc,
+			PortClient:       pc,
+			RbacPolicyClient: rbc,
+		}, nil
+	}
+}
+
+type client struct {
+	sapclient.NetworkClient
+	sapclient.SubnetClient
+	sapclient.RouterClient
+	sapclient.PortClient
+	sapclient.RbacPolicyClient
+}
@@ -0,0 +1,46 @@
+package vpcpeering
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func localNetworkLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	net, err := state.sapClient.GetNetworkByName(ctx, state.LocalNetwork().Status.Network.OpenStack.NetworkName)
+	if err != nil {
+		logger.Error(err, "Error loading SAP KCP VpcPeering local openstack network")
+		return composed.StopWithRequeue, ctx
+	}
+
+	if net == nil && composed.IsMarkedForDeletion(state.Obj()) {
+		return nil, ctx
+	}
+
+	if net == nil {
+		logger.Error(errors.New("no network"), "SAP KCP VpcPeering local openstack network not found")
+		state.ObjAsVpcPeering().Status.State = string(cloudcontrolv1beta1.StateError)
+		return composed.PatchStatus(state.ObjAsVpcPeering()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:               cloudcontrolv1beta1.ConditionTypeError,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: state.ObjAsVpcPeering().Generation,
+				Reason:             cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message:            "Local network not found",
+			}).
+			ErrorLogMessage("Error patching SAP KCP VpcPeering status with error when local network not found").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	state.localNet = net
+
+	return nil, ctx
+}
@@ -0,0 +1,77 @@
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	vpcpeeringtypes "github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering/types"
+)
+
+func New(stateFactory StateFactory) composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		logger := composed.LoggerFromCtx(ctx)
+		state, err := stateFactory.NewState(ctx, st.(vpcpeeringtypes.State))
+		if err != nil {
+			err = fmt.Errorf("error creating new sap vpcpeering state: %w", err)
+			logger.Error(err, "Error")
+			return composed.StopAndForget, nil
+		}
+
+		return composed.ComposeActions(
+			"sapVpcPeering",
+			statusInitiated,
+			localNetworkLoad,
+			composed.IfElse(
+				predicateModeNetworkRbac,
+				composed.ComposeActions(
+					"sapVpcPeering-networkRbac",
+					rbacPolicyLoad,
+					composed.IfElse(
+						composed.MarkedForDeletionPredicate,
+						composed.ComposeActions(
+							"sapVpcPeering-networkRbac-delete",
+							rbacPolicyDelete,
+							actions.PatchRemoveCommonFinalizer(),
+						),
+						composed.ComposeActions(
+							"sapVpcPeering-networkRbac-create",
+							actions.PatchAddCommonFinalizer(),
+							rbacPolicyCreate,
+							statusReady,
+						),
+					),
+				),
+				composed.ComposeActions(
+					"sapVpcPeering-routerInterface",
+					routerLoad,
+					routerInterfacesLoad,
+					composed.IfElse(
+						composed.MarkedForDeletionPredicate,
+						composed.ComposeActions(
+							"sapVpcPeering-routerInterface-delete",
+							routerInterfacesRemove,
+							actions.PatchRemoveCommonFinalizer(),
+						),
+						composed.ComposeActions(
+							"sapVpcPeering-routerInterface-create",
+							actions.PatchAddCommonFinalizer(),
+							remoteNetworkLoad,
+							remoteSubnetsLoad,
+							routerInterfacesAdd,
+							statusReady,
+						),
+					),
+				),
+			),
+			composed.StopAndForgetAction,
+		)(ctx, state)
+	}
+}
+
+func predicateModeNetworkRbac(ctx context.Context, st composed.State) bool {
+	state := st.(*State)
+	return state.Mode() == cloudcontrolv1beta1.OpenStackVpcPeeringModeNetworkRbac
+}
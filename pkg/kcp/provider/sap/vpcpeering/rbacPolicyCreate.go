@@ -0,0 +1,42 @@
+package vpcpeering
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func rbacPolicyCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.rbacPolicy != nil {
+		return nil, ctx
+	}
+
+	logger.Info("Sharing SAP KCP VpcPeering local network with remote project")
+
+	policy, err := state.sapClient.ShareNetwork(ctx, state.localNet.ID, state.RemoteProject())
+	if err != nil {
+		logger.Error(err, "Error creating SAP KCP VpcPeering network rbac policy")
+		state.ObjAsVpcPeering().Status.State = string(cloudcontrolv1beta1.StateError)
+		return composed.PatchStatus(state.ObjAsVpcPeering()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:               cloudcontrolv1beta1.ConditionTypeError,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: state.ObjAsVpcPeering().Generation,
+				Reason:             cloudcontrolv1beta1.ReasonFailedCreatingVpcPeeringConnection,
+				Message:            "Failed sharing local network with the remote project",
+			}).
+			ErrorLogMessage("Error patching SAP KCP VpcPeering status with error when creating network rbac policy").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	state.rbacPolicy = policy
+
+	return nil, ctx
+}
@@ -0,0 +1,26 @@
+package vpcpeering
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func rbacPolicyDelete(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.rbacPolicy == nil {
+		return nil, ctx
+	}
+
+	logger.Info("Deleting SAP KCP VpcPeering network rbac policy", "rbacPolicyId", state.rbacPolicy.ID)
+
+	err := state.sapClient.DeleteRbacPolicy(ctx, state.rbacPolicy.ID)
+	if err != nil {
+		logger.Error(err, "Error deleting SAP KCP VpcPeering network rbac policy")
+		return composed.StopWithRequeue, ctx
+	}
+
+	return nil, ctx
+}
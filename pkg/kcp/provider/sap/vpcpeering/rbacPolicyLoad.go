@@ -0,0 +1,26 @@
+package vpcpeering
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func rbacPolicyLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.localNet == nil {
+		return nil, ctx
+	}
+
+	policy, err := state.sapClient.GetNetworkSharedRbacPolicy(ctx, state.localNet.ID, state.RemoteProject())
+	if err != nil {
+		logger.Error(err, "Error loading SAP KCP VpcPeering network rbac policy")
+		return composed.StopWithRequeue, ctx
+	}
+
+	state.rbacPolicy = policy
+
+	return nil, ctx
+}
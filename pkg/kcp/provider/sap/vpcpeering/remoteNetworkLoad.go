@@ -0,0 +1,43 @@
+package vpcpeering
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func remoteNetworkLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	net, err := state.sapClient.GetNetwork(ctx, state.RemoteNetworkId())
+	if err != nil {
+		logger.Error(err, "Error loading SAP KCP VpcPeering remote openstack network")
+		return composed.StopWithRequeue, ctx
+	}
+
+	if net == nil {
+		// remote network is visible to the kyma project only when its owner has shared it with an RBAC policy
+		logger.Error(errors.New("no remote network"), "SAP KCP VpcPeering remote openstack network not found")
+		state.ObjAsVpcPeering().Status.State = string(cloudcontrolv1beta1.StateError)
+		return composed.PatchStatus(state.ObjAsVpcPeering()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:               cloudcontrolv1beta1.ConditionTypeError,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: state.ObjAsVpcPeering().Generation,
+				Reason:             cloudcontrolv1beta1.ReasonFailedLoadingRemoteVpcNetwork,
+				Message:            "Remote network not found or not shared with the Kyma project",
+			}).
+			ErrorLogMessage("Error patching SAP KCP VpcPeering status with error when remote network not found").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	state.remoteNet = net
+
+	return nil, ctx
+}
@@ -0,0 +1,22 @@
+package vpcpeering
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func remoteSubnetsLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	arr, err := state.sapClient.ListSubnetsByNetworkId(ctx, state.remoteNet.ID)
+	if err != nil {
+		logger.Error(err, "Error listing SAP KCP VpcPeering remote network subnets")
+		return composed.StopWithRequeue, ctx
+	}
+
+	state.remoteSubnets = arr
+
+	return nil, ctx
+}
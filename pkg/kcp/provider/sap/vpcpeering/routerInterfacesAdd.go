@@ -0,0 +1,43 @@
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func routerInterfacesAdd(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	for _, subnet := range state.remoteSubnets {
+		if slices.Contains(state.routerSubnetIds, subnet.ID) {
+			continue
+		}
+		logger.Info("Adding remote subnet to SAP KCP VpcPeering router", "subnetId", subnet.ID)
+		_, err := state.sapClient.AddSubnetToRouter(ctx, state.router.ID, subnet.ID)
+		if err != nil {
+			logger.Error(err, "Error adding remote subnet to SAP KCP VpcPeering router", "subnetId", subnet.ID)
+			state.ObjAsVpcPeering().Status.State = string(cloudcontrolv1beta1.StateError)
+			return composed.PatchStatus(state.ObjAsVpcPeering()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:               cloudcontrolv1beta1.ConditionTypeError,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: state.ObjAsVpcPeering().Generation,
+					Reason:             cloudcontrolv1beta1.ReasonFailedCreatingRoutes,
+					Message:            fmt.Sprintf("Failed adding remote subnet %s to the router", subnet.ID),
+				}).
+				ErrorLogMessage("Error patching SAP KCP VpcPeering status with error when adding router interface").
+				SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+				Run(ctx, state)
+		}
+		state.routerSubnetIds = append(state.routerSubnetIds, subnet.ID)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,34 @@
+package vpcpeering
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func routerInterfacesLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.router == nil {
+		return nil, ctx
+	}
+
+	arr, err := state.sapClient.ListPorts(ctx, ports.ListOpts{
+		DeviceID:  state.router.ID,
+		NetworkID: state.RemoteNetworkId(),
+	})
+	if err != nil {
+		logger.Error(err, "Error listing SAP KCP VpcPeering router ports")
+		return composed.StopWithRequeue, ctx
+	}
+
+	for _, port := range arr {
+		for _, ip := range port.FixedIPs {
+			state.routerSubnetIds = append(state.routerSubnetIds, ip.SubnetID)
+		}
+	}
+
+	return nil, ctx
+}
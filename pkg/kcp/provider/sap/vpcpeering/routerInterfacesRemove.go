@@ -0,0 +1,42 @@
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func routerInterfacesRemove(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.router == nil {
+		return nil, ctx
+	}
+
+	for _, subnetId := range state.routerSubnetIds {
+		logger.Info("Removing remote subnet from SAP KCP VpcPeering router", "subnetId", subnetId)
+		err := state.sapClient.RemoveSubnetFromRouter(ctx, state.router.ID, subnetId)
+		if err != nil {
+			logger.Error(err, "Error removing remote subnet from SAP KCP VpcPeering router", "subnetId", subnetId)
+			state.ObjAsVpcPeering().Status.State = string(cloudcontrolv1beta1.StateError)
+			return composed.PatchStatus(state.ObjAsVpcPeering()).
+				SetExclusiveConditions(metav1.Condition{
+					Type:               cloudcontrolv1beta1.ConditionTypeError,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: state.ObjAsVpcPeering().Generation,
+					Reason:             cloudcontrolv1beta1.ReasonFailedDeletingRoutes,
+					Message:            fmt.Sprintf("Failed removing remote subnet %s from the router", subnetId),
+				}).
+				ErrorLogMessage("Error patching SAP KCP VpcPeering status with error when removing router interface").
+				SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+				Run(ctx, state)
+		}
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,87 @@
+package vpcpeering
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/rbacpolicies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	sapclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/client"
+	sapconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/config"
+	sapvpcpeeringclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering/client"
+	vpcpeeringtypes "github.com/kyma-project/cloud-manager/pkg/kcp/vpcpeering/types"
+)
+
+type State struct {
+	vpcpeeringtypes.State
+	sapClient sapvpcpeeringclient.Client
+
+	localNet *networks.Network
+	router   *routers.Router
+
+	remoteNet     *networks.Network
+	remoteSubnets []subnets.Subnet
+
+	// routerSubnetIds are the ids of the remote network subnets already attached to the kyma router
+	routerSubnetIds []string
+
+	rbacPolicy *rbacpolicies.RBACPolicy
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, vpcPeeringState vpcpeeringtypes.State) (*State, error)
+}
+
+func NewStateFactory(clientProvider sapclient.SapClientProvider[sapvpcpeeringclient.Client]) StateFactory {
+	return &stateFactory{
+		clientProvider: clientProvider,
+	}
+}
+
+type stateFactory struct {
+	clientProvider sapclient.SapClientProvider[sapvpcpeeringclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, vpcPeeringState vpcpeeringtypes.State) (*State, error) {
+	pp := sapclient.NewProviderParamsFromConfig(sapconfig.SapConfig).
+		WithDomain(vpcPeeringState.Scope().Spec.Scope.OpenStack.DomainName).
+		WithProject(vpcPeeringState.Scope().Spec.Scope.OpenStack.TenantName).
+		WithRegion(vpcPeeringState.Scope().Spec.Region)
+	sapClient, err := f.clientProvider(ctx, pp)
+	if err != nil {
+		return nil, fmt.Errorf("error creating sap client for vpcpeering: %w", err)
+	}
+
+	return &State{
+		State:     vpcPeeringState,
+		sapClient: sapClient,
+	}, nil
+}
+
+func (s *State) Mode() cloudcontrolv1beta1.OpenStackVpcPeeringMode {
+	if s.ObjAsVpcPeering().Spec.Details == nil || s.ObjAsVpcPeering().Spec.Details.OpenStackPeeringMode == "" {
+		return cloudcontrolv1beta1.OpenStackVpcPeeringModeRouterInterface
+	}
+	return s.ObjAsVpcPeering().Spec.Details.OpenStackPeeringMode
+}
+
+func (s *State) remoteNetworkReference() *cloudcontrolv1beta1.OpenStackNetworkReference {
+	if s.RemoteNetwork().Status.Network != nil && s.RemoteNetwork().Status.Network.OpenStack != nil {
+		return s.RemoteNetwork().Status.Network.OpenStack
+	}
+	if s.RemoteNetwork().Spec.Network.Reference != nil && s.RemoteNetwork().Spec.Network.Reference.OpenStack != nil {
+		return s.RemoteNetwork().Spec.Network.Reference.OpenStack
+	}
+	return &cloudcontrolv1beta1.OpenStackNetworkReference{}
+}
+
+func (s *State) RemoteProject() string {
+	return s.remoteNetworkReference().Project
+}
+
+func (s *State) RemoteNetworkId() string {
+	return s.remoteNetworkReference().NetworkId
+}
@@ -0,0 +1,23 @@
+package vpcpeering
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func statusInitiated(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.ObjAsVpcPeering().Status.State != "" {
+		return nil, ctx
+	}
+
+	state.ObjAsVpcPeering().Status.State = cloudcontrolv1beta1.VirtualNetworkPeeringStateInitiated
+
+	return composed.PatchStatus(state.ObjAsVpcPeering()).
+		ErrorLogMessage("Error setting SAP KCP VpcPeering initiated status state").
+		SuccessErrorNil().
+		Run(ctx, state)
+}
@@ -0,0 +1,61 @@
+package vpcpeering
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func statusReady(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	changed := false
+
+	id := ""
+	if state.rbacPolicy != nil {
+		id = state.rbacPolicy.ID
+	} else if state.router != nil {
+		id = state.router.ID
+	}
+
+	if state.ObjAsVpcPeering().Status.State != cloudcontrolv1beta1.VirtualNetworkPeeringStateConnected {
+		state.ObjAsVpcPeering().Status.State = cloudcontrolv1beta1.VirtualNetworkPeeringStateConnected
+		changed = true
+	}
+
+	if state.ObjAsVpcPeering().Status.Id != id {
+		state.ObjAsVpcPeering().Status.Id = id
+		changed = true
+	}
+
+	if state.ObjAsVpcPeering().Status.RemoteId != state.RemoteNetworkId() {
+		state.ObjAsVpcPeering().Status.RemoteId = state.RemoteNetworkId()
+		changed = true
+	}
+
+	if meta.RemoveStatusCondition(state.ObjAsVpcPeering().Conditions(), cloudcontrolv1beta1.ConditionTypeError) {
+		changed = true
+	}
+
+	if meta.SetStatusCondition(state.ObjAsVpcPeering().Conditions(), metav1.Condition{
+		Type:    cloudcontrolv1beta1.ConditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  cloudcontrolv1beta1.ReasonReady,
+		Message: cloudcontrolv1beta1.ReasonReady,
+	}) {
+		changed = true
+	}
+
+	if !changed {
+		return nil, ctx
+	}
+
+	return composed.PatchStatus(state.ObjAsVpcPeering()).
+		ErrorLogMessage("Error patching SAP KCP VpcPeering status to ready").
+		SuccessLogMsg("Success patching SAP KCP VpcPeering status to ready").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
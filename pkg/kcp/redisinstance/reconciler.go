@@ -10,10 +10,12 @@ import (
 	awsredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/redisinstance"
 	azureredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisinstance"
 	gcpredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisinstance"
+	sapredisinstance "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/redisinstance"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,6 +32,7 @@ type redisInstanceReconciler struct {
 	gcpStateFactory   gcpredisinstance.StateFactory
 	azureStateFactory azureredisinstance.StateFactory
 	awsStateFactory   awsredisinstance.StateFactory
+	sapStateFactory   sapredisinstance.StateFactory
 }
 
 func NewRedisInstanceReconciler(
@@ -38,6 +41,7 @@ func NewRedisInstanceReconciler(
 	gcpStateFactory gcpredisinstance.StateFactory,
 	azureStateFactory azureredisinstance.StateFactory,
 	awsStateFactory awsredisinstance.StateFactory,
+	sapStateFactory sapredisinstance.StateFactory,
 ) RedisInstanceReconciler {
 	return &redisInstanceReconciler{
 		composedStateFactory: composedStateFactory,
@@ -45,6 +49,7 @@ func NewRedisInstanceReconciler(
 		gcpStateFactory:      gcpStateFactory,
 		azureStateFactory:    azureStateFactory,
 		awsStateFactory:      awsStateFactory,
+		sapStateFactory:      sapStateFactory,
 	}
 }
 
@@ -69,6 +74,7 @@ func (r *redisInstanceReconciler) newAction() composed.Action {
 		func(ctx context.Context, st composed.State) (error, context.Context) {
 			return composed.ComposeActions(
 				"redisInstanceCommon",
+				pricing.New(pricing.KindRedisInstance, pricing.EstimateRedisInstance),
 				loadIpRange,
 				composed.BuildSwitchAction(
 					"providerSwitch",
@@ -76,6 +82,7 @@ func (r *redisInstanceReconciler) newAction() composed.Action {
 					composed.NewCase(statewithscope.GcpProviderPredicate, gcpredisinstance.New(r.gcpStateFactory)),
 					composed.NewCase(statewithscope.AzureProviderPredicate, azureredisinstance.New(r.azureStateFactory)),
 					composed.NewCase(statewithscope.AwsProviderPredicate, awsredisinstance.New(r.awsStateFactory)),
+					composed.NewCase(statewithscope.OpenStackProviderPredicate, sapredisinstance.New(r.sapStateFactory)),
 				),
 			)(ctx, newState(st.(focal.State)))
 		},
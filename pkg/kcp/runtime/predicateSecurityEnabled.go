@@ -6,11 +6,8 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 )
 
-const tmpSecurityEnabledLabel = "cloud-manager.kyma-project.io/tmp-security-enabled"
-
 func predicateSecurityEnabled(_ context.Context, st composed.State) bool {
 	state := st.(*State)
-	// a temporary mean to annotate runtime with security enabled until a filed is added
-	_, ok := state.ObjAsRuntime().Labels[tmpSecurityEnabledLabel]
-	return ok
+	hardening := state.ObjAsRuntime().Spec.Security.Hardening
+	return hardening != nil && hardening.Enabled
 }
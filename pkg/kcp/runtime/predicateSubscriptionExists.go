@@ -0,0 +1,12 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func predicateSubscriptionExists(_ context.Context, st composed.State) bool {
+	state := st.(*State)
+	return state.subscription != nil
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/external/infrastructuremanagerv1"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
@@ -69,6 +70,14 @@ func (r *runtimeReconciler) newAction() composed.Action {
 		composed.If(
 			// delete =======================================
 			composed.MarkedForDeletionPredicate,
+			composed.If(
+				// without the subscription the cloud resources can not be reached, they are left to the account cleanup
+				composed.All(predicateSecurityEnabled, predicateSubscriptionExists),
+				subscriptionWaitReady,
+				r.providerAction(),
+			),
+			actions.PatchRemoveCommonFinalizer(),
+			composed.StopAndForgetAction,
 		),
 		composed.If(
 			// create/update =======================================
@@ -76,14 +85,19 @@ func (r *runtimeReconciler) newAction() composed.Action {
 			subscriptionCreate,
 			composed.If(
 				predicateSecurityEnabled,
+				actions.PatchAddCommonFinalizer(),
 				subscriptionWaitReady,
-				composed.Switch(
-					nil,
-					composed.NewCase(awsProviderPredicate, awsruntime.New(r.awsStateFactory)),
-					composed.NewCase(azureProviderPredicate, azureruntime.New(r.azureStateFactory)),
-					composed.NewCase(gcpProviderPredicate, gcpruntime.New(r.gcpStateFactory)),
-				),
+				r.providerAction(),
 			),
 		),
 	)
 }
+
+func (r *runtimeReconciler) providerAction() composed.Action {
+	return composed.Switch(
+		nil,
+		composed.NewCase(awsProviderPredicate, awsruntime.New(r.awsStateFactory)),
+		composed.NewCase(azureProviderPredicate, azureruntime.New(r.azureStateFactory)),
+		composed.NewCase(gcpProviderPredicate, gcpruntime.New(r.gcpStateFactory)),
+	)
+}
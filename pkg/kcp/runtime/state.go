@@ -2,9 +2,11 @@ package runtime
 
 import (
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/external/infrastructuremanagerv1"
 	runtimetypes "github.com/kyma-project/cloud-manager/pkg/kcp/runtime/types"
+	scopeconfig "github.com/kyma-project/cloud-manager/pkg/kcp/scope/config"
 )
 
 var _ runtimetypes.State = &State{}
@@ -12,10 +14,18 @@ var _ runtimetypes.State = &State{}
 type State struct {
 	composed.State
 
-	Subscription *cloudcontrolv1beta1.Subscription
+	subscription *cloudcontrolv1beta1.Subscription
 	VpcNetwork   *cloudcontrolv1beta1.VpcNetwork
 }
 
 func (s *State) ObjAsRuntime() *infrastructuremanagerv1.Runtime {
 	return s.Obj().(*infrastructuremanagerv1.Runtime)
 }
+
+func (s *State) Subscription() *cloudcontrolv1beta1.Subscription {
+	return s.subscription
+}
+
+func (s *State) ShootVpcName() string {
+	return common.GardenerVpcName(scopeconfig.ScopeConfig.GardenerNamespace, s.ObjAsRuntime().Spec.Shoot.Name)
+}
@@ -24,7 +24,7 @@ func subscriptionLoad(ctx context.Context, st composed.State) (error, context.Co
 		subscription = nil
 	}
 
-	state.Subscription = subscription
+	state.subscription = subscription
 
 	ctx = composed.LoggerIntoCtx(ctx, composed.LoggerFromCtx(ctx).WithValues("subscription", state.ObjAsRuntime().Spec.Shoot.SecretBindingName))
 
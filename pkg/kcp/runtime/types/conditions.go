@@ -0,0 +1,66 @@
+package types
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Runtime status conditions of the security hardening steps. They are prefixed since the Runtime
+// status is owned by the infrastructure manager.
+const (
+	ConditionTypeKmsKeyReady      = "CloudManagerKmsKeyReady"
+	ConditionTypeFlowLogsReady    = "CloudManagerFlowLogsReady"
+	ConditionTypeDefaultDenyReady = "CloudManagerDefaultDenyReady"
+)
+
+const (
+	ConditionReasonProvisioned   = "Provisioned"
+	ConditionReasonProviderError = "ProviderError"
+)
+
+// SecurityStepReady sets the step condition to True and continues the flow
+func SecurityStepReady(ctx context.Context, state State, conditionType, message string) (error, context.Context) {
+	err := patchCondition(ctx, state, metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  ConditionReasonProvisioned,
+		Message: message,
+	})
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error patching Runtime status with security step condition", composed.StopWithRequeue, ctx)
+	}
+	return nil, ctx
+}
+
+// SecurityStepFailed sets the step condition to False with the provider error and stops the flow
+// with a delayed requeue
+func SecurityStepFailed(ctx context.Context, state State, conditionType string, providerErr error, msg string) (error, context.Context) {
+	composed.LoggerFromCtx(ctx).Error(providerErr, msg, "conditionType", conditionType)
+	err := patchCondition(ctx, state, metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  ConditionReasonProviderError,
+		Message: providerErr.Error(),
+	})
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error patching Runtime status with security step condition", composed.StopWithRequeue, ctx)
+	}
+	return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+}
+
+// patchCondition patches only the changed condition with the optimistic lock so conditions
+// concurrently set by the infrastructure manager are not lost
+func patchCondition(ctx context.Context, state State, condition metav1.Condition) error {
+	runtime := state.ObjAsRuntime()
+	original := runtime.DeepCopy()
+	condition.ObservedGeneration = runtime.Generation
+	if !meta.SetStatusCondition(&runtime.Status.Conditions, condition) {
+		return nil
+	}
+	return state.Cluster().K8sClient().Status().Patch(ctx, runtime, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+}
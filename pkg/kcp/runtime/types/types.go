@@ -1,6 +1,7 @@
 package types
 
 import (
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/external/infrastructuremanagerv1"
 )
@@ -8,4 +9,7 @@ import (
 type State interface {
 	composed.State
 	ObjAsRuntime() *infrastructuremanagerv1.Runtime
+	Subscription() *cloudcontrolv1beta1.Subscription
+	// ShootVpcName returns the name of the network Gardener created for the runtime shoot
+	ShootVpcName() string
 }
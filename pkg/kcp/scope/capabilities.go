@@ -0,0 +1,55 @@
+package scope
+
+import (
+	"context"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	scopeconfig "github.com/kyma-project/cloud-manager/pkg/kcp/scope/config"
+)
+
+// isCapabilitiesReadNeeded returns true if the region capabilities of a provider with capabilities
+// discovery were never discovered or are due for the periodic refresh. Unlike the exposed data, it
+// does not depend on the ExposeData feature and trial runtimes, since SKR specs are validated against them.
+func isCapabilitiesReadNeeded(ctx context.Context, st composed.State) bool {
+	if !composed.IsObjLoaded(ctx, st) {
+		return false
+	}
+
+	state := st.(*State)
+
+	switch state.ObjAsScope().Spec.Provider {
+	case cloudcontrolv1beta1.ProviderAws, cloudcontrolv1beta1.ProviderAzure, cloudcontrolv1beta1.ProviderGCP:
+	default:
+		return false
+	}
+
+	capabilities := state.ObjAsScope().Status.Capabilities
+	if capabilities == nil || capabilities.ReadTime == nil {
+		return true
+	}
+
+	interval := scopeconfig.ScopeConfig.CapabilitiesRefreshInterval
+	if interval <= 0 {
+		return false
+	}
+
+	return time.Since(capabilities.ReadTime.Time) >= interval
+}
+
+func capabilitiesSaveToScope(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if isCapabilitiesReadNeeded(ctx, state) {
+		// discovery failed and was logged, the previously discovered capabilities are kept
+		return nil, ctx
+	}
+
+	return composed.PatchStatus(state.ObjAsScope()).
+		ErrorLogMessage("Error updating region capabilities").
+		FailedError(composed.StopWithRequeue).
+		SuccessErrorNil().
+		SuccessLogMsg("Region capabilities updated").
+		Run(ctx, state)
+}
@@ -1,9 +1,17 @@
 package config
 
-import "github.com/kyma-project/cloud-manager/pkg/config"
+import (
+	"time"
+
+	"github.com/kyma-project/cloud-manager/pkg/config"
+)
 
 type ScopeConfigStruct struct {
 	GardenerNamespace string `yaml:"gardenerNamespace,omitempty" json:"gardenerNamespace,omitempty"`
+	// ExposedDataRefreshInterval is the interval of the periodic re-read of the exposed data, zero disables the refresh
+	ExposedDataRefreshInterval time.Duration `yaml:"exposedDataRefreshInterval,omitempty" json:"exposedDataRefreshInterval,omitempty"`
+	// CapabilitiesRefreshInterval is the interval of the periodic re-discovery of the region capabilities, zero disables the refresh
+	CapabilitiesRefreshInterval time.Duration `yaml:"capabilitiesRefreshInterval,omitempty" json:"capabilitiesRefreshInterval,omitempty"`
 }
 
 var ScopeConfig = &ScopeConfigStruct{}
@@ -18,5 +26,15 @@ func InitConfig(cfg config.Config) {
 			config.DefaultScalar("garden-kyma-dev"),
 			config.SourceEnv("GARDENER_NAMESPACE"),
 		),
+		config.Path(
+			"exposedDataRefreshInterval",
+			config.DefaultScalar("1h"),
+			config.SourceEnv("SCOPE_EXPOSED_DATA_REFRESH_INTERVAL"),
+		),
+		config.Path(
+			"capabilitiesRefreshInterval",
+			config.DefaultScalar("24h"),
+			config.SourceEnv("SCOPE_CAPABILITIES_REFRESH_INTERVAL"),
+		),
 	)
 }
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	"github.com/kyma-project/cloud-manager/pkg/config"
@@ -12,13 +13,17 @@ import (
 
 func TestConfigFromEnv(t *testing.T) {
 	env := abstractions.NewMockedEnvironment(map[string]string{
-		"GARDENER_NAMESPACE": "ns-env",
+		"GARDENER_NAMESPACE":                  "ns-env",
+		"SCOPE_EXPOSED_DATA_REFRESH_INTERVAL": "15m",
+		"SCOPE_CAPABILITIES_REFRESH_INTERVAL": "6h",
 	})
 	cfg := config.NewConfig(env)
 	InitConfig(cfg)
 	cfg.Read()
 
 	assert.Equal(t, "ns-env", ScopeConfig.GardenerNamespace)
+	assert.Equal(t, 15*time.Minute, ScopeConfig.ExposedDataRefreshInterval)
+	assert.Equal(t, 6*time.Hour, ScopeConfig.CapabilitiesRefreshInterval)
 }
 
 func TestConfigFromFile(t *testing.T) {
@@ -29,6 +34,8 @@ func TestConfigFromFile(t *testing.T) {
 	}()
 	err = os.WriteFile(filepath.Join(dir, "scope.yaml"), []byte(`
 gardenerNamespace: ns-file
+exposedDataRefreshInterval: 30m
+capabilitiesRefreshInterval: 12h
 `), 0644)
 	assert.NoError(t, err, "error creating key file")
 
@@ -41,4 +48,6 @@ gardenerNamespace: ns-file
 	cfg.Read()
 
 	assert.Equal(t, "ns-env", ScopeConfig.GardenerNamespace)
+	assert.Equal(t, 30*time.Minute, ScopeConfig.ExposedDataRefreshInterval)
+	assert.Equal(t, 12*time.Hour, ScopeConfig.CapabilitiesRefreshInterval)
 }
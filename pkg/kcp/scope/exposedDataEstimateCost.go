@@ -0,0 +1,24 @@
+package scope
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// exposedDataEstimateCost sets the estimated monthly cost of the NAT gateways from the exposed data.
+// The status is saved by the following exposedDataSaveToScope.
+func exposedDataEstimateCost(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if !composed.IsObjLoaded(ctx, state) || !pricing.Config.Enabled {
+		return nil, ctx
+	}
+
+	scope := state.ObjAsScope()
+	amount, ok := pricing.EstimateNatGateways(pricing.DefaultCatalog, scope)
+	pricing.SetEstimate(pricing.KindScope, scope, scope, amount, ok)
+
+	return nil, ctx
+}
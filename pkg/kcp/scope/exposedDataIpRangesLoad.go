@@ -0,0 +1,38 @@
+package scope
+
+import (
+	"context"
+
+	"github.com/elliotchance/pie/v2"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// exposedDataIpRangesLoad sets the CIDRs of the KCP IpRanges of the Scope to the exposed data.
+// The status is saved by the following exposedDataSaveToScope.
+func exposedDataIpRangesLoad(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if !composed.IsObjLoaded(ctx, state) {
+		return nil, ctx
+	}
+
+	list := &cloudcontrolv1beta1.IpRangeList{}
+	err := state.Cluster().K8sClient().List(ctx, list, client.InNamespace(state.ObjAsScope().Namespace))
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing KCP IpRanges for exposed data", composed.StopWithRequeue, ctx)
+	}
+
+	var cidrs []string
+	for _, ipRange := range list.Items {
+		if ipRange.Spec.Scope.Name != state.ObjAsScope().Name || ipRange.Status.Cidr == "" {
+			continue
+		}
+		cidrs = append(cidrs, ipRange.Status.Cidr)
+	}
+
+	state.ObjAsScope().Status.ExposedData.IpRangeCidrs = pie.Sort(pie.Unique(cidrs))
+
+	return nil, ctx
+}
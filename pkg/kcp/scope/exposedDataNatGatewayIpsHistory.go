@@ -0,0 +1,67 @@
+package scope
+
+import (
+	"context"
+	"slices"
+
+	"github.com/elliotchance/pie/v2"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	natGatewayIpsHistoryLimit = 10
+
+	eventReasonNatGatewayIpsChanged = "NatGatewayIpsChanged"
+)
+
+// exposedDataNatGatewayIpsHistory appends the read NAT gateway IPs to the history when they differ
+// from the last recorded set, and emits an event and a metric if the set has changed.
+// The status is saved by the following exposedDataSaveToScope.
+func exposedDataNatGatewayIpsHistory(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	exposedData := &state.ObjAsScope().Status.ExposedData
+	current := pie.Sort(pie.Unique(exposedData.NatGatewayIps))
+
+	var previous *cloudcontrolv1beta1.NatGatewayIpsRecord
+	if len(exposedData.NatGatewayIpsHistory) > 0 {
+		previous = &exposedData.NatGatewayIpsHistory[len(exposedData.NatGatewayIpsHistory)-1]
+		if slices.Equal(previous.NatGatewayIps, current) {
+			return nil, ctx
+		}
+	}
+
+	if previous != nil {
+		logger.
+			WithValues(
+				"previousNatGatewayIps", previous.NatGatewayIps,
+				"natGatewayIps", current,
+			).
+			Info("Exposed NAT gateway IPs changed")
+
+		metrics.KcpScopeNatGatewayIpsChangeTotal.WithLabelValues(string(state.ObjAsScope().Spec.Provider)).Inc()
+
+		if recorder := state.Cluster().EventRecorder(); recorder != nil {
+			recorder.Eventf(
+				state.ObjAsScope(), nil, corev1.EventTypeWarning, eventReasonNatGatewayIpsChanged, "ExposedDataRefresh",
+				"NAT gateway IPs changed from [%s] to [%s]",
+				pie.Join(previous.NatGatewayIps, ", "), pie.Join(current, ", "),
+			)
+		}
+	}
+
+	exposedData.NatGatewayIpsHistory = append(exposedData.NatGatewayIpsHistory, cloudcontrolv1beta1.NatGatewayIpsRecord{
+		Time:          metav1.Now(),
+		NatGatewayIps: current,
+	})
+	if len(exposedData.NatGatewayIpsHistory) > natGatewayIpsHistoryLimit {
+		exposedData.NatGatewayIpsHistory = exposedData.NatGatewayIpsHistory[len(exposedData.NatGatewayIpsHistory)-natGatewayIpsHistoryLimit:]
+	}
+
+	return nil, ctx
+}
@@ -2,13 +2,18 @@ package scope
 
 import (
 	"context"
+	"maps"
 
 	"github.com/elliotchance/pie/v2"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/capability"
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	skrmanager "github.com/kyma-project/cloud-manager/pkg/skr/runtime/manager"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -70,7 +75,16 @@ func exposedDataSaveToSkr(ctx context.Context, st composed.State) (error, contex
 	if cm.Data == nil {
 		cm.Data = make(map[string]string)
 	}
-	cm.Data["cloud.natGatewayIps"] = pie.Join(state.ObjAsScope().Status.ExposedData.NatGatewayIps, ", ")
+	exposedData := state.ObjAsScope().Status.ExposedData
+	cm.Data["cloud.natGatewayIps"] = pie.Join(exposedData.NatGatewayIps, ", ")
+	cm.Data["cloud.region"] = exposedData.Region
+	cm.Data["cloud.zones"] = pie.Join(exposedData.Zones, ", ")
+	cm.Data["cloud.networkId"] = exposedData.NetworkId
+	cm.Data["cloud.nodesCidr"] = exposedData.NodesCidr
+	cm.Data["cloud.podsCidr"] = exposedData.PodsCidr
+	cm.Data["cloud.servicesCidr"] = exposedData.ServicesCidr
+	cm.Data["cloud.ipRangeCidrs"] = pie.Join(exposedData.IpRangeCidrs, ", ")
+	maps.Copy(cm.Data, capability.ForScope(state.ObjAsScope()).ConfigMapData())
 
 	if cm.ResourceVersion == "" {
 		err := skrClient.Create(ctx, cm)
@@ -84,5 +98,47 @@ func exposedDataSaveToSkr(ctx context.Context, st composed.State) (error, contex
 		}
 	}
 
+	err = cloudNetworkInfoSaveToSkr(ctx, skrClient, state.ObjAsScope())
+	if meta.IsNoMatchError(err) {
+		// the SKR looper installs the CRD, it will be saved on the next exposed data read
+		composed.LoggerFromCtx(ctx).Info("CloudNetworkInfo CRD is not installed in SKR")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error saving SKR CloudNetworkInfo", composed.StopWithRequeue, ctx)
+	}
+
 	return nil, ctx
 }
+
+func cloudNetworkInfoSaveToSkr(ctx context.Context, skrClient ctrlclient.Client, scope *cloudcontrolv1beta1.Scope) error {
+	info := &cloudresourcesv1beta1.CloudNetworkInfo{}
+	err := skrClient.Get(ctx, types.NamespacedName{Name: cloudresourcesv1beta1.CloudNetworkInfoName}, info)
+	if apierrors.IsNotFound(err) {
+		info = &cloudresourcesv1beta1.CloudNetworkInfo{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: cloudresourcesv1beta1.CloudNetworkInfoName,
+			},
+		}
+		err = skrClient.Create(ctx, info)
+	}
+	if err != nil {
+		return err
+	}
+
+	exposedData := scope.Status.ExposedData
+	info.Status = cloudresourcesv1beta1.CloudNetworkInfoStatus{
+		Provider:      string(scope.Spec.Provider),
+		Region:        exposedData.Region,
+		Zones:         exposedData.Zones,
+		NetworkId:     exposedData.NetworkId,
+		NodesCidr:     exposedData.NodesCidr,
+		PodsCidr:      exposedData.PodsCidr,
+		ServicesCidr:  exposedData.ServicesCidr,
+		NatGatewayIps: exposedData.NatGatewayIps,
+		IpRangeCidrs:  exposedData.IpRangeCidrs,
+		ReadTime:      exposedData.ReadTime,
+	}
+
+	return skrClient.Status().Update(ctx, info)
+}
@@ -15,6 +15,7 @@ import (
 	gcpexposeddata "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/exposedData"
 	sapexposeddata "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/exposedData"
 	scopeclient "github.com/kyma-project/cloud-manager/pkg/kcp/scope/client"
+	scopeconfig "github.com/kyma-project/cloud-manager/pkg/kcp/scope/config"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -130,6 +131,18 @@ func (r *scopeReconciler) newAction() composed.Action {
 				networkReferenceKymaWaitReady,
 				apiEnable,
 
+				// discover region capabilities from cloud providers
+				composed.If(
+					isCapabilitiesReadNeeded,
+					composed.Switch(
+						nil,
+						composed.NewCase(statewithscope.AwsProviderPredicate, awsexposeddata.NewCapabilities(r.awsStateFactory)),
+						composed.NewCase(statewithscope.AzureProviderPredicate, azureexposeddata.NewCapabilities(r.azureStateFactory)),
+						composed.NewCase(statewithscope.GcpProviderPredicate, gcpexposeddata.NewCapabilities(r.gcpStateFactory)),
+					),
+					capabilitiesSaveToScope,
+				),
+
 				// collect exposed data from cloud providers
 				composed.If(
 					isExposedDataReadNeeded,
@@ -140,11 +153,15 @@ func (r *scopeReconciler) newAction() composed.Action {
 						composed.NewCase(statewithscope.GcpProviderPredicate, gcpexposeddata.New(r.gcpStateFactory)),
 						composed.NewCase(statewithscope.OpenStackProviderPredicate, sapexposeddata.New(r.sapStateFactory)),
 					),
+					exposedDataNatGatewayIpsHistory,
+					exposedDataIpRangesLoad,
+					exposedDataEstimateCost,
 					exposedDataSaveToScope,
 					exposedDataSaveToSkr,
 				),
 
 				conditionReady,
+				refreshRequeue,
 			),
 
 			composed.ComposeActionsNoName(
@@ -215,7 +232,7 @@ func isScopeCreateOrUpdateNeeded(ctx context.Context, st composed.State) bool {
 	return false
 }
 
-func isExposedDataReadNeeded(ctx context.Context, st composed.State) bool {
+func isExposedDataEnabled(ctx context.Context, st composed.State) bool {
 	if !feature.ExposeData.Value(ctx) {
 		return false
 	}
@@ -225,15 +242,26 @@ func isExposedDataReadNeeded(ctx context.Context, st composed.State) bool {
 
 	state := st.(*State)
 
-	if statewithscope.IsTrialPredicate(ctx, state) {
+	return !statewithscope.IsTrialPredicate(ctx, state)
+}
+
+func isExposedDataReadNeeded(ctx context.Context, st composed.State) bool {
+	if !isExposedDataEnabled(ctx, st) {
 		return false
 	}
 
+	state := st.(*State)
+
 	if state.ObjAsScope().Status.ExposedData.ReadTime == nil {
 		return true
 	}
 
+	interval := scopeconfig.ScopeConfig.ExposedDataRefreshInterval
+	if interval <= 0 {
+		return false
+	}
+
 	diff := time.Since(state.ObjAsScope().Status.ExposedData.ReadTime.Time)
 
-	return diff > time.Hour
+	return diff >= interval
 }
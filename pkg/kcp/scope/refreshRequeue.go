@@ -0,0 +1,42 @@
+package scope
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	scopeconfig "github.com/kyma-project/cloud-manager/pkg/kcp/scope/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// refreshRequeue requeues the Scope when the exposed data or the region capabilities are due for the
+// periodic refresh, so NAT gateway IPs changed by the Gardener egress reconciliation get to the SKR
+// without other Kyma changes
+func refreshRequeue(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	var delays []time.Duration
+	if isExposedDataEnabled(ctx, state) {
+		if delay, ok := refreshDelay(state.ObjAsScope().Status.ExposedData.ReadTime, scopeconfig.ScopeConfig.ExposedDataRefreshInterval); ok {
+			delays = append(delays, delay)
+		}
+	}
+	if capabilities := state.ObjAsScope().Status.Capabilities; capabilities != nil {
+		if delay, ok := refreshDelay(capabilities.ReadTime, scopeconfig.ScopeConfig.CapabilitiesRefreshInterval); ok {
+			delays = append(delays, delay)
+		}
+	}
+	if len(delays) == 0 {
+		return nil, ctx
+	}
+
+	return composed.StopWithRequeueDelay(slices.Min(delays)), ctx
+}
+
+func refreshDelay(readTime *metav1.Time, interval time.Duration) (time.Duration, bool) {
+	if interval <= 0 || readTime == nil {
+		return 0, false
+	}
+	return max(interval-time.Since(readTime.Time), time.Second), true
+}
@@ -3,6 +3,7 @@ package scope
 import (
 	"context"
 	"github.com/kyma-project/cloud-manager/api"
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,5 +35,7 @@ func scopeDelete(ctx context.Context, st composed.State) (error, context.Context
 		return composed.LogErrorAndReturn(err, "Error deleting Scope", composed.StopWithRequeue, ctx)
 	}
 
+	pricing.Forget(pricing.KindScope, state.Name().Name)
+
 	return nil, ctx
 }
@@ -4,12 +4,14 @@ import (
 	"context"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/common/actions/focal"
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/common/statewithscope"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	aws "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/vpcpeering"
 	azure "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/vpcpeering"
 	gcp "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/vpcpeering"
+	sap "github.com/kyma-project/cloud-manager/pkg/kcp/provider/sap/vpcpeering"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -27,6 +29,7 @@ type vpcPeeringReconciler struct {
 	awsStateFactory   aws.StateFactory
 	azureStateFactory azure.StateFactory
 	gcpStateFactory   gcp.StateFactory
+	sapStateFactory   sap.StateFactory
 }
 
 func NewVpcPeeringReconciler(
@@ -35,6 +38,7 @@ func NewVpcPeeringReconciler(
 	awsStateFactory aws.StateFactory,
 	azureStateFactory azure.StateFactory,
 	gcpStateFactory gcp.StateFactory,
+	sapStateFactory sap.StateFactory,
 ) VPCPeeringReconciler {
 	return &vpcPeeringReconciler{
 		composedStateFactory: composedStateFactory,
@@ -42,6 +46,7 @@ func NewVpcPeeringReconciler(
 		awsStateFactory:      awsStateFactory,
 		azureStateFactory:    azureStateFactory,
 		gcpStateFactory:      gcpStateFactory,
+		sapStateFactory:      sapStateFactory,
 	}
 }
 
@@ -72,6 +77,7 @@ func (r *vpcPeeringReconciler) newAction() composed.Action {
 		func(ctx context.Context, st composed.State) (error, context.Context) {
 			return composed.ComposeActions(
 				"vpcPeeringCommon",
+				pricing.New(pricing.KindVpcPeering, pricing.EstimateVpcPeering),
 				kcpNetworkLocalLoad,
 				kcpNetworkLocalWait,
 				kcpNetworkRemoteLoad,
@@ -82,6 +88,7 @@ func (r *vpcPeeringReconciler) newAction() composed.Action {
 					composed.NewCase(statewithscope.AwsProviderPredicate, aws.New(r.awsStateFactory)),
 					composed.NewCase(statewithscope.AzureProviderPredicate, azure.New(r.azureStateFactory)),
 					composed.NewCase(statewithscope.GcpProviderPredicate, gcp.New(r.gcpStateFactory)),
+					composed.NewCase(statewithscope.OpenStackProviderPredicate, sap.New(r.sapStateFactory)),
 				),
 			)(ctx, newState(st.(focal.State)))
 		},
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	KcpDriftedFields = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_kcp_drifted_fields",
+		Help: "Number of spec fields that differ from the actual cloud provider state per KCP object",
+	}, []string{"kind", "name"})
+
+	KcpDriftCorrectionTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloud_manager_kcp_drift_correction_total",
+		Help: "Total number of automatic drift corrections started per KCP kind",
+	}, []string{"kind"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		KcpDriftedFields,
+		KcpDriftCorrectionTotal,
+	)
+}
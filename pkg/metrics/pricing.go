@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	EstimatedMonthlyCost = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_estimated_monthly_cost",
+		Help: "Estimated monthly cost of the cloud resources per object, computed from the price catalog",
+	}, []string{"kind", "name", "globalAccountId", "subAccountId", "provider", "region", "currency"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		EstimatedMonthlyCost,
+	)
+}
@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	KcpScopeNatGatewayIpsChangeTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cloud_manager_kcp_scope_nat_gateway_ips_change_total",
+		Help: "Total number of observed changes of the exposed NAT gateway IPs per provider",
+	}, []string{"provider"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		KcpScopeNatGatewayIpsChangeTotal,
+	)
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	SkrResourceState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_resource_state",
+		Help: "Current status state of the SKR object, set to 1 for the state label",
+	}, []string{"kyma", "kind", "namespace", "name", "state"})
+
+	SkrResourceReady = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_resource_ready",
+		Help: "Whether the SKR object has the Ready condition with status True",
+	}, []string{"kyma", "kind", "namespace", "name"})
+
+	SkrResourceStateDurationSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_resource_state_duration_seconds",
+		Help: "Seconds since the last condition transition of the SKR object",
+	}, []string{"kyma", "kind", "namespace", "name"})
+
+	SkrResourceCapacityBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cloud_manager_skr_resource_capacity_bytes",
+		Help: "Provisioned capacity of the SKR NFS volume or backup in bytes",
+	}, []string{"kyma", "kind", "namespace", "name"})
+)
+
+// DeleteSkrResourceMetrics removes the per object metrics of all objects in the given SKR
+func DeleteSkrResourceMetrics(kymaName string) {
+	labels := prometheus.Labels{"kyma": kymaName}
+	SkrResourceState.DeletePartialMatch(labels)
+	SkrResourceReady.DeletePartialMatch(labels)
+	SkrResourceStateDurationSeconds.DeletePartialMatch(labels)
+	SkrResourceCapacityBytes.DeletePartialMatch(labels)
+}
+
+func init() {
+	metrics.Registry.MustRegister(
+		SkrResourceState,
+		SkrResourceReady,
+		SkrResourceStateDurationSeconds,
+		SkrResourceCapacityBytes,
+	)
+}
@@ -9,6 +9,18 @@ import (
 	"strings"
 )
 
+// Quota names. The full quota name is in the form `[lower(Kind)].[Group]/[quotaName]`
+const (
+	// QuotaTotalCount is the max number of objects of a kind in the SKR
+	QuotaTotalCount = "totalCount"
+	// QuotaTotalCapacityGb is the max sum of capacityGb of all volumes of a kind in the SKR
+	QuotaTotalCapacityGb = "totalCapacityGb"
+	// QuotaMaxTier is the max numeric level of the redisTier, ie 3 allows S1-S3 and P1-P3
+	QuotaMaxTier = "maxTier"
+	// QuotaBackupsPerVolume is the max number of backups of a kind made of a single volume
+	QuotaBackupsPerVolume = "backupsPerVolume"
+)
+
 func InitConfig(cfg config.Config) {
 	cfg.Path(
 		"resourceQuota.skr",
@@ -21,6 +33,10 @@ func InitConfig(cfg config.Config) {
 type SkrQuotaIntf interface {
 	TotalCountForObj(obj runtime.Object, scheme *runtime.Scheme, skr string) int
 	Override(obj runtime.Object, scheme *runtime.Scheme, skr string, value int)
+
+	// LimitForObj returns the limit of the given quota for the obj kind and true if such limit is defined
+	LimitForObj(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string) (int, bool)
+	OverrideLimit(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string, value int)
 }
 
 func DefaultSkrQuota() SkrQuotaIntf {
@@ -44,48 +60,67 @@ type skrQuotaSpec map[string]int
 
 var SkrQuota SkrQuotaIntf = DefaultSkrQuota()
 
-func (q *skrQuotaConfig) TotalCountForObj(obj runtime.Object, scheme *runtime.Scheme, skr string) (result int) {
-	result = util.MaxInt
+// ResourceName returns the quota resource name of the obj kind in the form `[lower(Kind)].[Group]`
+func ResourceName(obj runtime.Object, scheme *runtime.Scheme) (string, error) {
 	gvk, err := apiutil.GVKForObject(obj, scheme)
 	if err != nil {
-		return
+		return "", err
+	}
+	return fmt.Sprintf("%s.%s", strings.ToLower(gvk.Kind), gvk.Group), nil
+}
+
+func (q *skrQuotaConfig) TotalCountForObj(obj runtime.Object, scheme *runtime.Scheme, skr string) int {
+	result, ok := q.LimitForObj(obj, scheme, skr, QuotaTotalCount)
+	if !ok {
+		return util.MaxInt
+	}
+	return result
+}
+
+func (q *skrQuotaConfig) Override(obj runtime.Object, scheme *runtime.Scheme, skr string, value int) {
+	q.OverrideLimit(obj, scheme, skr, QuotaTotalCount, value)
+}
+
+func (q *skrQuotaConfig) LimitForObj(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string) (int, bool) {
+	resource, err := ResourceName(obj, scheme)
+	if err != nil {
+		return 0, false
 	}
-	name := fmt.Sprintf("%s.%s/totalCount", strings.ToLower(gvk.Kind), gvk.Group)
+	name := fmt.Sprintf("%s/%s", resource, quotaName)
 	if q.Overrides != nil {
 		skrSpec, ok := q.Overrides[skr]
 		if ok {
 			val, ok := skrSpec[name]
 			if ok {
-				result = val
-				return
+				return val, true
 			}
 		}
 	}
 	val, ok := q.Defaults[name]
-	if ok {
-		result = val
-	}
-	return
+	return val, ok
 }
 
-func (q *skrQuotaConfig) Override(obj runtime.Object, scheme *runtime.Scheme, skr string, value int) {
-	gvk, err := apiutil.GVKForObject(obj, scheme)
+func (q *skrQuotaConfig) OverrideLimit(obj runtime.Object, scheme *runtime.Scheme, skr string, quotaName string, value int) {
+	resource, err := ResourceName(obj, scheme)
 	if err != nil {
 		return
 	}
-	name := fmt.Sprintf("%s.%s/totalCount", strings.ToLower(gvk.Kind), gvk.Group)
+	name := fmt.Sprintf("%s/%s", resource, quotaName)
 	if len(skr) > 0 {
 		if q.Overrides == nil {
 			q.Overrides = map[string]skrQuotaSpec{}
 		}
 		spec, ok := q.Overrides[skr]
 		if !ok {
-			spec := skrQuotaSpec{}
+			spec = skrQuotaSpec{}
 			q.Overrides[skr] = spec
 		}
 		spec[name] = value
 		return
 	}
 
+	if q.Defaults == nil {
+		q.Defaults = skrQuotaSpec{}
+	}
 	q.Defaults[name] = value
 }
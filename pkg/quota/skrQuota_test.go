@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
 	"github.com/kyma-project/cloud-manager/pkg/config"
+	"github.com/kyma-project/cloud-manager/pkg/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +43,26 @@ func TestSkrConfig(t *testing.T) {
 	assert.Equal(t, 100, SkrQuota.TotalCountForObj(&cloudresourcesv1beta1.IpRange{}, commonscheme.SkrScheme, "anyskr"))
 	assert.Equal(t, 200, SkrQuota.TotalCountForObj(&cloudresourcesv1beta1.IpRange{}, commonscheme.SkrScheme, "skr123"))
 }
+
+func TestSkrLimitForObj(t *testing.T) {
+	q := DefaultSkrQuota()
+
+	_, ok := q.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "anyskr", QuotaTotalCapacityGb)
+	assert.False(t, ok, "limit is not defined by default")
+
+	q.OverrideLimit(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "", QuotaTotalCapacityGb, 10240)
+	q.OverrideLimit(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "skr123", QuotaTotalCapacityGb, 20480)
+
+	limit, ok := q.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "anyskr", QuotaTotalCapacityGb)
+	assert.True(t, ok)
+	assert.Equal(t, 10240, limit)
+
+	limit, ok = q.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "skr123", QuotaTotalCapacityGb)
+	assert.True(t, ok)
+	assert.Equal(t, 20480, limit)
+
+	// other quotas of the same kind are not affected
+	_, ok = q.LimitForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "skr123", QuotaTotalCount)
+	assert.False(t, ok)
+	assert.Equal(t, util.MaxInt, q.TotalCountForObj(&cloudresourcesv1beta1.GcpNfsVolume{}, commonscheme.SkrScheme, "skr123"))
+}
@@ -0,0 +1,164 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type usageDefinition struct {
+	quotaName string
+	obj       client.Object
+	newList   func() client.ObjectList
+	// usage returns used amount per subject, with empty subject for quotas that are not counted per subject
+	usage func(items []runtime.Object) map[string]int64
+}
+
+var skrUsageDefinitions = []usageDefinition{
+	// totalCount
+	{QuotaTotalCount, &cloudresourcesv1beta1.IpRange{}, func() client.ObjectList { return &cloudresourcesv1beta1.IpRangeList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.AwsNfsVolume{}, func() client.ObjectList { return &cloudresourcesv1beta1.AwsNfsVolumeList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.GcpNfsVolume{}, func() client.ObjectList { return &cloudresourcesv1beta1.GcpNfsVolumeList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.SapNfsVolume{}, func() client.ObjectList { return &cloudresourcesv1beta1.SapNfsVolumeList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.AwsRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.AwsRedisInstanceList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.GcpRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.GcpRedisInstanceList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.AzureRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.AzureRedisInstanceList{} }, count},
+	{QuotaTotalCount, &cloudresourcesv1beta1.SapRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.SapRedisInstanceList{} }, count},
+
+	// totalCapacityGb
+	{QuotaTotalCapacityGb, &cloudresourcesv1beta1.AwsNfsVolume{}, func() client.ObjectList { return &cloudresourcesv1beta1.AwsNfsVolumeList{} },
+		sumOf(func(x *cloudresourcesv1beta1.AwsNfsVolume) int64 { return CapacityGb(x.Spec.Capacity) })},
+	{QuotaTotalCapacityGb, &cloudresourcesv1beta1.GcpNfsVolume{}, func() client.ObjectList { return &cloudresourcesv1beta1.GcpNfsVolumeList{} },
+		sumOf(func(x *cloudresourcesv1beta1.GcpNfsVolume) int64 { return int64(x.Spec.CapacityGb) })},
+	{QuotaTotalCapacityGb, &cloudresourcesv1beta1.SapNfsVolume{}, func() client.ObjectList { return &cloudresourcesv1beta1.SapNfsVolumeList{} },
+		sumOf(func(x *cloudresourcesv1beta1.SapNfsVolume) int64 { return int64(x.Spec.CapacityGb) })},
+
+	// maxTier
+	{QuotaMaxTier, &cloudresourcesv1beta1.AwsRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.AwsRedisInstanceList{} },
+		maxOf(func(x *cloudresourcesv1beta1.AwsRedisInstance) int64 { return TierLevel(string(x.Spec.RedisTier)) })},
+	{QuotaMaxTier, &cloudresourcesv1beta1.GcpRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.GcpRedisInstanceList{} },
+		maxOf(func(x *cloudresourcesv1beta1.GcpRedisInstance) int64 { return TierLevel(string(x.Spec.RedisTier)) })},
+	{QuotaMaxTier, &cloudresourcesv1beta1.AzureRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.AzureRedisInstanceList{} },
+		maxOf(func(x *cloudresourcesv1beta1.AzureRedisInstance) int64 { return TierLevel(string(x.Spec.RedisTier)) })},
+	{QuotaMaxTier, &cloudresourcesv1beta1.SapRedisInstance{}, func() client.ObjectList { return &cloudresourcesv1beta1.SapRedisInstanceList{} },
+		maxOf(func(x *cloudresourcesv1beta1.SapRedisInstance) int64 { return TierLevel(string(x.Spec.RedisTier)) })},
+
+	// backupsPerVolume
+	{QuotaBackupsPerVolume, &cloudresourcesv1beta1.GcpNfsVolumeBackup{}, func() client.ObjectList { return &cloudresourcesv1beta1.GcpNfsVolumeBackupList{} },
+		countBy(func(x *cloudresourcesv1beta1.GcpNfsVolumeBackup) string {
+			return x.Spec.Source.Volume.ToNamespacedName(x.Namespace).String()
+		})},
+	{QuotaBackupsPerVolume, &cloudresourcesv1beta1.AwsNfsVolumeBackup{}, func() client.ObjectList { return &cloudresourcesv1beta1.AwsNfsVolumeBackupList{} },
+		countBy(func(x *cloudresourcesv1beta1.AwsNfsVolumeBackup) string {
+			return x.Spec.Source.Volume.ToNamespacedName(x.Namespace).String()
+		})},
+}
+
+// TierLevel returns the numeric level of the redis tier, ie 3 for S3 and P3, or zero if tier is not valid
+func TierLevel(tier string) int64 {
+	if len(tier) < 2 {
+		return 0
+	}
+	level, err := strconv.ParseInt(tier[1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return level
+}
+
+// CapacityGb returns the capacity quantity in whole GiB, rounded up so that a partially used GiB is counted
+func CapacityGb(q resource.Quantity) int64 {
+	const gib = 1 << 30
+	return (q.Value() + gib - 1) / gib
+}
+
+// SkrUsage calculates the usage of all quotas that have a limit defined for the given SKR.
+// Kinds that are not installed in the SKR are skipped.
+func SkrUsage(ctx context.Context, reader client.Reader, scheme *runtime.Scheme, skr string) ([]cloudresourcesv1beta1.QuotaUsage, error) {
+	var result []cloudresourcesv1beta1.QuotaUsage
+	for _, def := range skrUsageDefinitions {
+		limit, ok := SkrQuota.LimitForObj(def.obj, scheme, skr, def.quotaName)
+		if !ok {
+			continue
+		}
+		resource, err := ResourceName(def.obj, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("error getting quota resource name for %T: %w", def.obj, err)
+		}
+		list := def.newList()
+		err = reader.List(ctx, list)
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s for quota usage: %w", resource, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("error extracting %s list items for quota usage: %w", resource, err)
+		}
+		used := def.usage(items)
+		subjects := make([]string, 0, len(used))
+		for subject := range used {
+			subjects = append(subjects, subject)
+		}
+		sort.Strings(subjects)
+		for _, subject := range subjects {
+			result = append(result, cloudresourcesv1beta1.QuotaUsage{
+				Resource: resource,
+				Quota:    def.quotaName,
+				Subject:  subject,
+				Limit:    int64(limit),
+				Used:     used[subject],
+			})
+		}
+	}
+	return result, nil
+}
+
+func count(items []runtime.Object) map[string]int64 {
+	return map[string]int64{"": int64(len(items))}
+}
+
+func sumOf[T runtime.Object](f func(T) int64) func([]runtime.Object) map[string]int64 {
+	return func(items []runtime.Object) map[string]int64 {
+		var sum int64
+		for _, item := range items {
+			if x, ok := item.(T); ok {
+				sum += f(x)
+			}
+		}
+		return map[string]int64{"": sum}
+	}
+}
+
+func maxOf[T runtime.Object](f func(T) int64) func([]runtime.Object) map[string]int64 {
+	return func(items []runtime.Object) map[string]int64 {
+		var result int64
+		for _, item := range items {
+			if x, ok := item.(T); ok {
+				result = max(result, f(x))
+			}
+		}
+		return map[string]int64{"": result}
+	}
+}
+
+func countBy[T runtime.Object](subject func(T) string) func([]runtime.Object) map[string]int64 {
+	return func(items []runtime.Object) map[string]int64 {
+		result := map[string]int64{}
+		for _, item := range items {
+			if x, ok := item.(T); ok {
+				result[subject(x)]++
+			}
+		}
+		return result
+	}
+}
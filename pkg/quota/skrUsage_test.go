@@ -0,0 +1,107 @@
+package quota
+
+import (
+	"context"
+	"testing"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSkrUsage(t *testing.T) {
+	orig := SkrQuota
+	defer func() {
+		SkrQuota = orig
+	}()
+	SkrQuota = DefaultSkrQuota()
+	scheme := commonscheme.SkrScheme
+	SkrQuota.OverrideLimit(&cloudresourcesv1beta1.GcpNfsVolume{}, scheme, "", QuotaTotalCapacityGb, 10240)
+	SkrQuota.OverrideLimit(&cloudresourcesv1beta1.AwsNfsVolume{}, scheme, "", QuotaTotalCapacityGb, 4096)
+	SkrQuota.OverrideLimit(&cloudresourcesv1beta1.GcpRedisInstance{}, scheme, "skr123", QuotaMaxTier, 3)
+	SkrQuota.OverrideLimit(&cloudresourcesv1beta1.GcpNfsVolumeBackup{}, scheme, "", QuotaBackupsPerVolume, 2)
+
+	clnt := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&cloudresourcesv1beta1.IpRange{ObjectMeta: metav1.ObjectMeta{Namespace: "kyma-system", Name: "default"}},
+			&cloudresourcesv1beta1.AwsNfsVolume{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "awsvol1"},
+				Spec:       cloudresourcesv1beta1.AwsNfsVolumeSpec{Capacity: resource.MustParse("100Gi")},
+			},
+			&cloudresourcesv1beta1.AwsNfsVolume{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "awsvol2"},
+				Spec:       cloudresourcesv1beta1.AwsNfsVolumeSpec{Capacity: resource.MustParse("1536Mi")},
+			},
+			&cloudresourcesv1beta1.GcpNfsVolume{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "vol1"},
+				Spec:       cloudresourcesv1beta1.GcpNfsVolumeSpec{CapacityGb: 2560},
+			},
+			&cloudresourcesv1beta1.GcpNfsVolume{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "vol2"},
+				Spec:       cloudresourcesv1beta1.GcpNfsVolumeSpec{CapacityGb: 1024},
+			},
+			&cloudresourcesv1beta1.GcpRedisInstance{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "redis1"},
+				Spec:       cloudresourcesv1beta1.GcpRedisInstanceSpec{RedisTier: cloudresourcesv1beta1.GcpRedisTierP2},
+			},
+			&cloudresourcesv1beta1.GcpNfsVolumeBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "backup1"},
+				Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+					Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{Name: "vol1"}},
+				},
+			},
+			&cloudresourcesv1beta1.GcpNfsVolumeBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns3", Name: "backup2"},
+				Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+					Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{Name: "vol1", Namespace: "ns1"}},
+				},
+			},
+			&cloudresourcesv1beta1.GcpNfsVolumeBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "backup3"},
+				Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+					Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{Name: "vol2"}},
+				},
+			},
+		).
+		Build()
+
+	t.Run("usage of defined quotas", func(t *testing.T) {
+		usage, err := SkrUsage(context.Background(), clnt, scheme, "anyskr")
+		assert.NoError(t, err)
+		assert.Equal(t, []cloudresourcesv1beta1.QuotaUsage{
+			{Resource: "iprange.cloud-resources.kyma-project.io", Quota: QuotaTotalCount, Limit: 1, Used: 1},
+			{Resource: "awsnfsvolume.cloud-resources.kyma-project.io", Quota: QuotaTotalCount, Limit: 5, Used: 2},
+			{Resource: "awsnfsvolume.cloud-resources.kyma-project.io", Quota: QuotaTotalCapacityGb, Limit: 4096, Used: 102},
+			{Resource: "gcpnfsvolume.cloud-resources.kyma-project.io", Quota: QuotaTotalCapacityGb, Limit: 10240, Used: 3584},
+			{Resource: "gcpnfsvolumebackup.cloud-resources.kyma-project.io", Quota: QuotaBackupsPerVolume, Subject: "ns1/vol1", Limit: 2, Used: 2},
+			{Resource: "gcpnfsvolumebackup.cloud-resources.kyma-project.io", Quota: QuotaBackupsPerVolume, Subject: "ns2/vol2", Limit: 2, Used: 1},
+		}, usage)
+	})
+
+	t.Run("usage of skr specific quota", func(t *testing.T) {
+		usage, err := SkrUsage(context.Background(), clnt, scheme, "skr123")
+		assert.NoError(t, err)
+		assert.Contains(t, usage, cloudresourcesv1beta1.QuotaUsage{
+			Resource: "gcpredisinstance.cloud-resources.kyma-project.io", Quota: QuotaMaxTier, Limit: 3, Used: 2,
+		})
+	})
+}
+
+func TestCapacityGb(t *testing.T) {
+	assert.Equal(t, int64(100), CapacityGb(resource.MustParse("100Gi")))
+	assert.Equal(t, int64(2), CapacityGb(resource.MustParse("1536Mi")))
+	assert.Equal(t, int64(1024), CapacityGb(resource.MustParse("1Ti")))
+	assert.Equal(t, int64(0), CapacityGb(resource.Quantity{}))
+}
+
+func TestTierLevel(t *testing.T) {
+	assert.Equal(t, int64(1), TierLevel("S1"))
+	assert.Equal(t, int64(6), TierLevel("P6"))
+	assert.Equal(t, int64(0), TierLevel("P"))
+	assert.Equal(t, int64(0), TierLevel(""))
+	assert.Equal(t, int64(0), TierLevel("Sx"))
+}
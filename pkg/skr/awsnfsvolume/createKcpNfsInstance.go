@@ -48,8 +48,10 @@ func createKcpNfsInstance(ctx context.Context, st composed.State) (error, contex
 			},
 			Instance: cloudcontrolv1beta1.NfsInstanceInfo{
 				Aws: &cloudcontrolv1beta1.NfsInstanceAws{
-					PerformanceMode: cloudcontrolv1beta1.AwsPerformanceMode(state.ObjAsAwsNfsVolume().Spec.PerformanceMode),
-					Throughput:      cloudcontrolv1beta1.AwsThroughputMode(state.ObjAsAwsNfsVolume().Spec.Throughput),
+					PerformanceMode:       cloudcontrolv1beta1.AwsPerformanceMode(state.ObjAsAwsNfsVolume().Spec.PerformanceMode),
+					Throughput:            cloudcontrolv1beta1.AwsThroughputMode(state.ObjAsAwsNfsVolume().Spec.Throughput),
+					SourceRecoveryPointId: state.SourceRecoveryPointId,
+					SourceBackupVaultName: state.SourceBackupVaultName,
 				},
 			},
 		},
@@ -0,0 +1,104 @@
+package awsnfsvolume
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func loadSourceBackup(ctx context.Context, st composed.State) (error, context.Context) {
+	// loadSourceBackup loads the AwsNfsVolumeBackup object from the volume.Spec.SourceBackup value
+	// or parses the volume.Spec.SourceBackupUrl before the KCP NfsInstance is created,
+	// and stores the recovery point id and its backup vault in the state.
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	volume := state.ObjAsAwsNfsVolume()
+
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, ctx
+	}
+
+	if state.KcpNfsInstance != nil {
+		return nil, ctx
+	}
+
+	if volume.Spec.SourceBackupUrl != "" {
+		vaultName, recoveryPointId, ok := awsnfsvolumebackup.ParseBackupUrl(volume.Spec.SourceBackupUrl)
+		if !ok {
+			volume.Status.State = cloudresourcesv1beta1.StateError
+			return composed.PatchStatus(volume).
+				SetExclusiveConditions(metav1.Condition{
+					Type:    cloudresourcesv1beta1.ConditionTypeError,
+					Status:  metav1.ConditionTrue,
+					Reason:  cloudresourcesv1beta1.ConditionReasonMissingNfsVolumeBackup,
+					Message: "Invalid SourceBackupUrl, expected format is {backupVaultName}/{recoveryPointId}",
+				}).
+				SuccessError(composed.StopAndForget).
+				SuccessLogMsg("Error parsing AwsNfsVolume SourceBackupUrl").
+				Run(ctx, state)
+		}
+		state.SourceBackupVaultName = vaultName
+		state.SourceRecoveryPointId = recoveryPointId
+		return nil, ctx
+	}
+
+	if volume.Spec.SourceBackup == nil {
+		return nil, ctx
+	}
+
+	backupKey := volume.Spec.SourceBackup.ToNamespacedName(volume.Namespace)
+	logger.WithValues("SourceBackup", backupKey).Info("Loading AwsNfsVolumeBackup")
+
+	backup := &cloudresourcesv1beta1.AwsNfsVolumeBackup{}
+	err := state.Cluster().K8sClient().Get(ctx, backupKey, backup)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading SKR AwsNfsVolumeBackup", composed.StopWithRequeue, ctx)
+	}
+	if err != nil {
+		volume.Status.State = cloudresourcesv1beta1.StateError
+		return composed.PatchStatus(volume).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonMissingNfsVolumeBackup,
+				Message: "Error loading AwsNfsVolumeBackup",
+			}).
+			SuccessError(composed.StopWithRequeueDelay(3*util.Timing.T1000ms())).
+			SuccessLogMsg("Error getting AwsNfsVolumeBackup").
+			Run(ctx, state)
+	}
+
+	if !meta.IsStatusConditionTrue(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) || len(backup.Status.Id) == 0 {
+		logger.WithValues("AwsNfsVolumeBackup", backup.Name).Info("AwsNfsVolumeBackup is not ready")
+		volume.Status.State = cloudresourcesv1beta1.StateError
+		return composed.PatchStatus(volume).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonNfsVolumeBackupNotReady,
+				Message: "AwsNfsVolumeBackup is not ready",
+			}).
+			SuccessError(composed.StopWithRequeueDelay(3*util.Timing.T1000ms())).
+			SuccessLogMsg("Error loading AwsNfsVolumeBackup").
+			Run(ctx, state)
+	}
+
+	state.SourceRecoveryPointId = backup.Status.Id
+
+	// clear the error left over while waiting for the backup
+	if meta.FindStatusCondition(volume.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError) != nil {
+		return composed.PatchStatus(volume).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			SuccessErrorNil().
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
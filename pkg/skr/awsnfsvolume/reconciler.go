@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsresize"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -70,10 +71,13 @@ func (r *reconciler) newAction() composed.Action {
 		addFinalizer,
 		updateId,
 		loadKcpNfsInstance,
+		loadSourceBackup,
+		validateRegionCapabilities,
 		createKcpNfsInstance,
 		updateStatus,
 		createVolume,
 		createPersistentVolumeClaim,
+		nfsresize.New(),
 		requeueWaitKcpStatus,
 		stopIfNotBeingDeleted,
 
@@ -7,12 +7,16 @@ import (
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsresize"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+var _ nfsresize.State = &State{}
+
 type State struct {
 	composed.State
 	KymaRef    klog.ObjectRef
@@ -22,6 +26,9 @@ type State struct {
 	KcpNfsInstance *cloudcontrolv1beta1.NfsInstance
 	Volume         *corev1.PersistentVolume
 	PVC            *corev1.PersistentVolumeClaim
+
+	SourceRecoveryPointId string
+	SourceBackupVaultName string
 }
 
 func newStateFactory(
@@ -69,3 +76,33 @@ func (s *State) SetSkrIpRange(skrIpRange *cloudresourcesv1beta1.IpRange) {
 func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
 	return s.ObjAsAwsNfsVolume()
 }
+
+func (s *State) GetPV() *corev1.PersistentVolume {
+	return s.Volume
+}
+
+func (s *State) GetPVC() *corev1.PersistentVolumeClaim {
+	return s.PVC
+}
+
+func (s *State) RequestedCapacity() resource.Quantity {
+	return s.ObjAsAwsNfsVolume().Spec.Capacity
+}
+
+// BackingCapacity returns nil since EFS is elastic and has no provisioned capacity, the
+// metered size is reported in the status capacity
+func (s *State) BackingCapacity() *resource.Quantity {
+	return nil
+}
+
+func (s *State) ProvisionedCapacity() *resource.Quantity {
+	return nil
+}
+
+func (s *State) ValidateResize(_, _ resource.Quantity) error {
+	return nil
+}
+
+func (s *State) ResizeBacking(_ context.Context, _ resource.Quantity) error {
+	return nil
+}
@@ -27,16 +27,38 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 	capacityChanged := !state.ObjAsAwsNfsVolume().Status.Capacity.Equal(state.KcpNfsInstance.Status.Capacity)
 	state.ObjAsAwsNfsVolume().Status.Capacity = state.KcpNfsInstance.Status.Capacity
 
+	kcpCondRestoring := meta.FindStatusCondition(state.KcpNfsInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeRestoring)
+	skrHasRestoringCondition := meta.FindStatusCondition(state.ObjAsAwsNfsVolume().Status.Conditions, cloudresourcesv1beta1.ConditionTypeRestoring) != nil
+
+	if kcpCondRestoring != nil && skrCondErr == nil && !skrHasRestoringCondition {
+		state.ObjAsAwsNfsVolume().Status.State = cloudresourcesv1beta1.StateRestoring
+		return composed.UpdateStatus(state.ObjAsAwsNfsVolume()).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeRestoring,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeRestoring,
+				Message: kcpCondRestoring.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error updating SKR AwsNfsVolume status with restoring condition").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
 	if kcpCondErr != nil && skrCondErr == nil {
+		errReason := cloudresourcesv1beta1.ConditionReasonError
+		if kcpCondErr.Reason == cloudcontrolv1beta1.ReasonRestoreFailed {
+			errReason = cloudresourcesv1beta1.ConditionReasonNfsRestoreFailed
+		}
 		state.ObjAsAwsNfsVolume().Status.State = cloudresourcesv1beta1.StateError
 		return composed.UpdateStatus(state.ObjAsAwsNfsVolume()).
 			SetCondition(metav1.Condition{
 				Type:    cloudresourcesv1beta1.ConditionTypeError,
 				Status:  metav1.ConditionTrue,
-				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Reason:  errReason,
 				Message: kcpCondErr.Message,
 			}).
-			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady, cloudresourcesv1beta1.ConditionTypeRestoring).
 			ErrorLogMessage("Error updating KCP AwsNfsVolume status with not ready condition due to KCP error").
 			SuccessLogMsg("Updated and forgot SKR AwsNfsVolume status with Error condition").
 			SuccessError(composed.StopAndForget).
@@ -56,7 +78,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
 				Message: kcpCondReady.Message,
 			}).
-			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError, cloudresourcesv1beta1.ConditionTypeRestoring).
 			ErrorLogMessage("Error updating KCP AwsNfsVolume status with ready condition").
 			SuccessError(composed.StopWithRequeue).
 			Run(ctx, state)
@@ -19,6 +19,8 @@ type Client interface {
 	LocalClient
 
 	ListTags(ctx context.Context, resourceArn string) (map[string]string, error)
+	TagResource(ctx context.Context, resourceArn string, tags map[string]string) error
+	UntagResource(ctx context.Context, resourceArn string, tagKeys []string) error
 
 	ListBackupVaults(ctx context.Context) ([]backuptypes.BackupVaultListMember, error)
 	DescribeBackupVault(ctx context.Context, backupVaultName string) (*backup.DescribeBackupVaultOutput, error)
@@ -112,6 +114,24 @@ func (c *client) ListTags(ctx context.Context, resourceArn string) (map[string]s
 	return out.Tags, nil
 }
 
+func (c *client) TagResource(ctx context.Context, resourceArn string, tags map[string]string) error {
+	in := &backup.TagResourceInput{
+		ResourceArn: new(resourceArn),
+		Tags:        tags,
+	}
+	_, err := c.svc.TagResource(ctx, in)
+	return err
+}
+
+func (c *client) UntagResource(ctx context.Context, resourceArn string, tagKeys []string) error {
+	in := &backup.UntagResourceInput{
+		ResourceArn: new(resourceArn),
+		TagKeyList:  tagKeys,
+	}
+	_, err := c.svc.UntagResource(ctx, in)
+	return err
+}
+
 func (c *client) ListBackupVaults(ctx context.Context) ([]backuptypes.BackupVaultListMember, error) {
 	in := &backup.ListBackupVaultsInput{}
 	out, err := c.svc.ListBackupVaults(ctx, in)
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math/rand/v2"
 	"time"
 
@@ -59,6 +60,23 @@ func (s *mockClient) ListTags(ctx context.Context, resourceArn string) (map[stri
 	return s.tags[resourceArn], nil
 }
 
+func (s *mockClient) TagResource(ctx context.Context, resourceArn string, tags map[string]string) error {
+	if s.tags[resourceArn] == nil {
+		s.tags[resourceArn] = make(map[string]string)
+	}
+	for k, v := range tags {
+		s.tags[resourceArn][k] = v
+	}
+	return nil
+}
+
+func (s *mockClient) UntagResource(ctx context.Context, resourceArn string, tagKeys []string) error {
+	for _, k := range tagKeys {
+		delete(s.tags[resourceArn], k)
+	}
+	return nil
+}
+
 func (s *mockClient) ListBackupVaults(ctx context.Context) ([]backuptypes.BackupVaultListMember, error) {
 	logger := composed.LoggerFromCtx(ctx)
 	var vaultsList []backuptypes.BackupVaultListMember
@@ -155,6 +173,7 @@ func (s *mockClient) StartBackupJob(ctx context.Context, params *StartBackupJobI
 	}
 	s.backupJobs = append(s.backupJobs, job)
 	s.recoveryPoints = append(s.recoveryPoints, rPoint)
+	s.tags[arnTxt] = maps.Clone(params.RecoveryPointTags)
 	logger.WithName("StartBackupJob - mock").Info(
 		fmt.Sprintf("Backup ID :: %s, RecoveryPointArn :: %s", jobId, arnTxt))
 
@@ -184,6 +203,7 @@ func (s *mockClient) ListRecoveryPointsForVault(ctx context.Context, accountId,
 				BackupSizeInBytes: rp.BackupSizeInBytes,
 				BackupVaultArn:    rp.BackupVaultArn,
 				BackupVaultName:   rp.BackupVaultName,
+				CreationDate:      rp.CreationDate,
 				IamRoleArn:        rp.IamRoleArn,
 				RecoveryPointArn:  rp.RecoveryPointArn,
 				ResourceArn:       rp.ResourceArn,
@@ -84,6 +84,7 @@ func (r *reconciler) newAction() composed.Action {
 		loadAwsBackupJob,
 		loadLocalAwsBackup,
 		createAwsBackup,
+		updateAccessibleFrom,
 		composed.If(
 			RemoteBackupPredicate,
 			loadDestVault,
@@ -22,7 +22,8 @@ func shortCircuitCompleted(ctx context.Context, st composed.State) (error, conte
 		return composed.StopAndForget, nil
 	}
 
-	if backupState == v1beta1.StateReady && !state.isTimeForCapacityUpdate() {
+	if backupState == v1beta1.StateReady && !state.isTimeForCapacityUpdate() &&
+		backup.Status.AccessibleFrom == state.specCommaSeparatedAccessibleFrom() {
 		composed.LoggerFromCtx(ctx).Info("NfsVolumeBackup is ready, short-circuiting into requeueForCapacity")
 		return stopAndRequeueForCapacity(), nil
 	}
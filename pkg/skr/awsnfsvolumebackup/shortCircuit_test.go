@@ -77,6 +77,30 @@ func (s *shortCircuitSuite) TestWhenBackupIsReady() {
 	s.Equal(v1beta1.StateReady, fromK8s.Status.State)
 }
 
+func (s *shortCircuitSuite) TestWhenBackupIsReadyAndAccessibleFromChanged() {
+
+	config.AwsConfig.EfsCapacityCheckInterval = time.Hour * 6
+
+	obj := awsNfsVolumeBackup.DeepCopy()
+	obj.Status.State = v1beta1.StateReady
+	obj.Status.LastCapacityUpdate = &metav1.Time{Time: time.Now().Add(-1 * time.Hour)}
+	obj.Spec.AccessibleFrom = []string{"all"}
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	//Get state object with AwsNfsVolume
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+
+	err, _ctx := shortCircuitCompleted(ctx, state)
+
+	//validate expected return values
+	s.Nil(err)
+	s.Equal(ctx, _ctx)
+}
+
 func (s *shortCircuitSuite) TestWhenBackupIsInError() {
 
 	obj := awsNfsVolumeBackup.DeepCopy()
@@ -3,6 +3,8 @@ package awsnfsvolumebackup
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/backup"
@@ -117,6 +119,39 @@ func (s *State) GetTags() map[string]string {
 	}
 }
 
+func (s *State) specCommaSeparatedAccessibleFrom() string {
+	backup := s.ObjAsAwsNfsVolumeBackup()
+	// Sort a copy of backup.Spec.AccessibleFrom to have a consistent value
+	accessibleFrom := slices.Clone(backup.Spec.AccessibleFrom)
+	slices.Sort(accessibleFrom)
+	return strings.Join(accessibleFrom, ",")
+}
+
+// getAccessibleFromTagChanges compares the AccessibleFrom tags on the recovery point
+// with the spec, and returns the tags to add and the tag keys to remove.
+func (s *State) getAccessibleFromTagChanges(current map[string]string) (map[string]string, []string) {
+	backup := s.ObjAsAwsNfsVolumeBackup()
+	toAdd := map[string]string{}
+	for _, shoot := range backup.Spec.AccessibleFrom {
+		key := ConvertToAccessibleFromKey(shoot)
+		if current[key] != AccessibleFromTagValue {
+			toAdd[key] = AccessibleFromTagValue
+		}
+	}
+	var toRemove []string
+	for key := range current {
+		if !IsAccessibleFromKey(key) {
+			continue
+		}
+		if slices.Contains(backup.Spec.AccessibleFrom, StripAccessibleFromPrefix(key)) {
+			continue
+		}
+		toRemove = append(toRemove, key)
+	}
+	slices.Sort(toRemove)
+	return toAdd, toRemove
+}
+
 func (s *State) isTimeForCapacityUpdate() bool {
 	lastUpdate := s.ObjAsAwsNfsVolumeBackup().Status.LastCapacityUpdate
 	configInterval := awsconfig.AwsConfig.EfsCapacityCheckInterval
@@ -147,6 +182,35 @@ func (s *State) GetDestinationRecoveryPointArn() string {
 	return arn
 }
 
+const AccessibleFromTagValue = "cloud-manager"
+
+func ConvertToAccessibleFromKey(name string) string {
+	return fmt.Sprintf("cm-allow-%s", name)
+}
+
+// BackupUrl returns the {backupVaultName}/{recoveryPointId} reference of a recovery point
+// that can be used as AwsNfsVolume.Spec.SourceBackupUrl.
+func BackupUrl(vaultName, recoveryPointId string) string {
+	return fmt.Sprintf("%s/%s", vaultName, recoveryPointId)
+}
+
+// ParseBackupUrl splits the reference returned by BackupUrl into the backup vault name and recovery point id.
+func ParseBackupUrl(url string) (vaultName string, recoveryPointId string, ok bool) {
+	vaultName, recoveryPointId, ok = strings.Cut(url, "/")
+	if !ok || vaultName == "" || recoveryPointId == "" {
+		return "", "", false
+	}
+	return vaultName, recoveryPointId, true
+}
+
+func IsAccessibleFromKey(key string) bool {
+	return strings.HasPrefix(key, "cm-allow-")
+}
+
+func StripAccessibleFromPrefix(key string) string {
+	return strings.TrimPrefix(key, "cm-allow-")
+}
+
 func stopAndRequeueForCapacity() error {
 	return composed.StopWithRequeueDelay(awsconfig.AwsConfig.EfsCapacityCheckInterval)
 }
@@ -0,0 +1,54 @@
+package awsnfsvolumebackup
+
+import (
+	"context"
+
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func updateAccessibleFrom(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsAwsNfsVolumeBackup()
+
+	//If the object is being deleted, continue...
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, nil
+	}
+
+	//If the recovery point is not yet completed, continue...
+	if state.recoveryPoint == nil || state.recoveryPoint.Status != backuptypes.RecoveryPointStatusCompleted {
+		return nil, nil
+	}
+
+	arn := state.GetRecoveryPointArn()
+	tags, err := state.awsClient.ListTags(ctx, arn)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing tags of AWS RecoveryPoint", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+
+	toAdd, toRemove := state.getAccessibleFromTagChanges(tags)
+	if len(toAdd) > 0 {
+		logger.Info("Adding AccessibleFrom tags to AWS RecoveryPoint")
+		if err := state.awsClient.TagResource(ctx, arn, toAdd); err != nil {
+			return composed.LogErrorAndReturn(err, "Error tagging AWS RecoveryPoint", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+		}
+	}
+	if len(toRemove) > 0 {
+		logger.Info("Removing AccessibleFrom tags from AWS RecoveryPoint")
+		if err := state.awsClient.UntagResource(ctx, arn, toRemove); err != nil {
+			return composed.LogErrorAndReturn(err, "Error untagging AWS RecoveryPoint", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+		}
+	}
+
+	if backup.Status.AccessibleFrom == state.specCommaSeparatedAccessibleFrom() {
+		return nil, nil
+	}
+
+	backup.Status.AccessibleFrom = state.specCommaSeparatedAccessibleFrom()
+	return composed.PatchStatus(backup).
+		SuccessErrorNil().
+		Run(ctx, state)
+}
@@ -0,0 +1,146 @@
+package awsnfsvolumebackup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type updateAccessibleFromSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *updateAccessibleFromSuite) SetupTest() {
+	s.ctx = log.IntoContext(context.Background(), logr.Discard())
+}
+
+func (s *updateAccessibleFromSuite) TestWhenBackupIsDeleting() {
+
+	obj := deletingAwsNfsVolumeBackup.DeepCopy()
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	//Get state object with AwsNfsVolume
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+
+	err, _ctx := updateAccessibleFrom(ctx, state)
+
+	//validate expected return values
+	s.Nil(err)
+	s.Nil(_ctx)
+}
+
+func (s *updateAccessibleFromSuite) TestWhenRecoveryPointNotExists() {
+
+	obj := awsNfsVolumeBackup.DeepCopy()
+	obj.Spec.AccessibleFrom = []string{"shoot-b"}
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	//Get state object with AwsNfsVolume
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+
+	err, _ctx := updateAccessibleFrom(ctx, state)
+
+	//validate expected return values
+	s.Nil(err)
+	s.Nil(_ctx)
+
+	fromK8s := &cloudresourcesv1beta1.AwsNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(ctx,
+		types.NamespacedName{Name: obj.Name,
+			Namespace: obj.Namespace},
+		fromK8s)
+	s.Nil(err)
+
+	s.Equal("", fromK8s.Status.AccessibleFrom)
+}
+
+func (s *updateAccessibleFromSuite) TestWhenAccessibleFromChanged() {
+
+	obj := awsNfsVolumeBackup.DeepCopy()
+	obj.Spec.AccessibleFrom = []string{"shoot-c", "shoot-b"}
+	factory, err := newStateFactoryWithObj(obj)
+	s.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	//Get state object with AwsNfsVolume
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+
+	//load the scope object into state
+	awsScope := scope.DeepCopy()
+	state.SetScope(awsScope)
+
+	//createAwsClient
+	err, _ = createAwsClient(ctx, state)
+	s.Nil(err)
+
+	//loadVault
+	err, _ = loadLocalVault(ctx, state)
+	s.Nil(err)
+
+	//createAwsBackup with a stale AccessibleFrom tag
+	tags := state.GetTags()
+	tags[ConvertToAccessibleFromKey("shoot-a")] = AccessibleFromTagValue
+	res, err := state.awsClient.StartBackupJob(ctx, &client.StartBackupJobInput{
+		BackupVaultName:   state.GetVaultName(),
+		IamRoleArn:        state.roleName,
+		ResourceArn:       state.GetFileSystemArn(),
+		RecoveryPointTags: tags,
+	})
+	s.Nil(err)
+
+	obj.Status.Id = awsutil.ParseArnResourceId(ptr.Deref(res.RecoveryPointArn, ""))
+	obj.Status.JobId = ptr.Deref(res.BackupJobId, "")
+	err = factory.skrCluster.K8sClient().Status().Update(ctx, obj)
+	s.Nil(err)
+
+	//loadAwsBackup
+	err, _ = loadLocalAwsBackup(ctx, state)
+	s.Nil(err)
+
+	err, _ctx := updateAccessibleFrom(ctx, state)
+
+	//validate expected return values
+	s.Nil(err)
+	s.Equal(ctx, _ctx)
+
+	rpTags, err := state.awsClient.ListTags(ctx, state.GetRecoveryPointArn())
+	s.Nil(err)
+	s.Equal(AccessibleFromTagValue, rpTags[ConvertToAccessibleFromKey("shoot-b")])
+	s.Equal(AccessibleFromTagValue, rpTags[ConvertToAccessibleFromKey("shoot-c")])
+	s.NotContains(rpTags, ConvertToAccessibleFromKey("shoot-a"))
+	s.Equal(state.GetBackupName(), rpTags["Name"])
+
+	fromK8s := &cloudresourcesv1beta1.AwsNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(ctx,
+		types.NamespacedName{Name: obj.Name,
+			Namespace: obj.Namespace},
+		fromK8s)
+	s.Nil(err)
+
+	s.Equal("shoot-b,shoot-c", fromK8s.Status.AccessibleFrom)
+	s.Equal([]string{"shoot-c", "shoot-b"}, fromK8s.Spec.AccessibleFrom)
+}
+
+func TestUpdateAccessibleFrom(t *testing.T) {
+	suite.Run(t, new(updateAccessibleFromSuite))
+}
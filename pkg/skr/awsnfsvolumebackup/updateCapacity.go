@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,8 @@ func updateCapacity(ctx context.Context, st composed.State) (error, context.Cont
 	backup.Status.Capacity = *capacity
 	backup.Status.LastCapacityUpdate = &metav1.Time{Time: time.Now().UTC()}
 
+	pricing.ObserveBackup(pricing.KindAwsNfsVolumeBackup, state.Scope(), backup, backup.Status.Capacity)
+
 	return composed.PatchStatus(backup).
 		SuccessErrorNil().
 		Run(ctx, state)
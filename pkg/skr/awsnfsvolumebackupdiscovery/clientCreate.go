@@ -0,0 +1,44 @@
+package awsnfsvolumebackupdiscovery
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func clientCreate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backupDiscovery := state.ObjAsAwsNfsVolumeBackupDiscovery()
+
+	cli, err := state.awsClientProvider(
+		ctx,
+		state.Scope.Spec.Scope.Aws.AccountId,
+		state.Scope.Spec.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		awsutil.RoleArnDefault(state.Scope.Spec.Scope.Aws.AccountId),
+	)
+	if err != nil {
+		backupDiscovery.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(backupDiscovery).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: "Failed to create AWS client",
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error creating AWS client").
+			SuccessLogMsg("Updated and forgot SKR AwsNfsVolumeBackupDiscovery status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.awsClient = cli
+
+	return nil, ctx
+}
@@ -0,0 +1,5 @@
+package awsnfsvolumebackupdiscovery
+
+import "github.com/kyma-project/cloud-manager/pkg/common/ignorant"
+
+var Ignore = ignorant.New()
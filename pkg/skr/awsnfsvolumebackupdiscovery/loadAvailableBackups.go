@@ -0,0 +1,89 @@
+package awsnfsvolumebackupdiscovery
+
+import (
+	"context"
+	"strings"
+
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func loadAvailableBackups(ctx context.Context, st composed.State) (error, context.Context) {
+	logger := composed.LoggerFromCtx(ctx)
+	state := st.(*State)
+	backupDiscovery := state.ObjAsAwsNfsVolumeBackupDiscovery()
+
+	backups, err := listSharedRecoveryPoints(ctx, state)
+	if err != nil {
+		logger.Error(err, "Error listing shared AWS RecoveryPoints")
+
+		backupDiscovery.Status.State = cloudresourcesv1beta1.StateError
+		errMsg := "Failed to load shared Nfs Volume Backups from AWS"
+		return composed.UpdateStatus(backupDiscovery).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage(errMsg).
+			SuccessLogMsg("Updated and forgot SKR AwsNfsVolumeBackupDiscovery status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.backups = backups
+
+	return nil, ctx
+}
+
+// listSharedRecoveryPoints returns completed recovery points from all cloud-manager backup vaults
+// in the account and region of the scope that are tagged as accessible from this shoot.
+func listSharedRecoveryPoints(ctx context.Context, state *State) ([]sharedRecoveryPoint, error) {
+	accountId := state.Scope.Spec.Scope.Aws.AccountId
+	allowedKeys := []string{
+		awsnfsvolumebackup.ConvertToAccessibleFromKey(state.Scope.Spec.ShootName),
+		awsnfsvolumebackup.ConvertToAccessibleFromKey("all"),
+	}
+
+	vaults, err := state.awsClient.ListBackupVaults(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []sharedRecoveryPoint
+	for _, vault := range vaults {
+		vaultName := ptr.Deref(vault.BackupVaultName, "")
+		if !strings.HasPrefix(vaultName, "cm-") {
+			continue
+		}
+
+		recoveryPoints, err := state.awsClient.ListRecoveryPointsForVault(ctx, accountId, vaultName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rp := range recoveryPoints {
+			if rp.Status != backuptypes.RecoveryPointStatusCompleted {
+				continue
+			}
+			tags, err := state.awsClient.ListTags(ctx, ptr.Deref(rp.RecoveryPointArn, ""))
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range allowedKeys {
+				if tags[key] == awsnfsvolumebackup.AccessibleFromTagValue {
+					result = append(result, sharedRecoveryPoint{vaultName: vaultName, recoveryPoint: rp, tags: tags})
+					break
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package awsnfsvolumebackupdiscovery
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+type Reconciler struct {
+	composedStateFactory composed.StateFactory
+	stateFactory         StateFactory
+}
+
+func (r *Reconciler) Run(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if Ignore.ShouldIgnoreKey(req) {
+		return ctrl.Result{}, nil
+	}
+	logger := composed.LoggerFromCtx(ctx)
+
+	state, err := r.newState(ctx, req.NamespacedName)
+	if err != nil {
+		logger.Error(err, "Error getting the AwsNfsVolumeBackupDiscovery state object")
+	}
+
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("awsnfsvolumebackupdiscovery", util.RequestObjToString(req)).
+		WithNoLog().
+		Handle(action(ctx, state))
+}
+
+func (r *Reconciler) newState(ctx context.Context, name types.NamespacedName) (*State, error) {
+	return r.stateFactory.NewState(ctx,
+		r.composedStateFactory.NewState(name, &cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery{}),
+	)
+}
+
+func (r *Reconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"crAwsNfsVolumeBackupDiscoveryMain",
+		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery{}),
+		composed.LoadObj,
+		setProcessing,
+		shortCircuit,
+		loadScope,
+		clientCreate,
+		loadAvailableBackups,
+		updateStatus,
+		composed.StopAndForgetAction,
+	)
+}
+
+func NewReconciler(scopeProvider scopeprovider.ScopeProvider, kcpCluster cluster.Cluster, skrCluster cluster.Cluster,
+	awsClientProvider awsclient.SkrClientProvider[awsnfsvolumebackupclient.Client]) Reconciler {
+	compSkrCluster := composed.NewStateClusterFromCluster(skrCluster)
+	compKcpCluster := composed.NewStateClusterFromCluster(kcpCluster)
+	composedStateFactory := composed.NewStateFactory(compSkrCluster)
+	stateFactory := NewStateFactory(scopeProvider, compKcpCluster, compSkrCluster, awsClientProvider)
+	return Reconciler{
+		composedStateFactory: composedStateFactory,
+		stateFactory:         stateFactory,
+	}
+}
@@ -0,0 +1,29 @@
+package awsnfsvolumebackupdiscovery
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func setProcessing(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	backupDiscovery := state.ObjAsAwsNfsVolumeBackupDiscovery()
+
+	if backupDiscovery.Status.State == cloudresourcesv1beta1.JobStateProcessing {
+		return nil, ctx
+	}
+
+	if backupDiscovery.Status.State != "" {
+		return nil, nil
+	}
+
+	backupDiscovery.Status.State = cloudresourcesv1beta1.JobStateProcessing
+	return composed.UpdateStatus(backupDiscovery).
+		ErrorLogMessage("Error: failed to set Processing status on AwsNfsVolumeBackupDiscovery").
+		SuccessError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
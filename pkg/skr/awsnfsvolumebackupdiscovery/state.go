@@ -0,0 +1,74 @@
+package awsnfsvolumebackupdiscovery
+
+import (
+	"context"
+
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	awsnfsvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup/client"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"k8s.io/klog/v2"
+)
+
+type State struct {
+	composed.State
+	KymaRef    klog.ObjectRef
+	KcpCluster composed.StateCluster
+	SkrCluster composed.StateCluster
+
+	Scope *cloudcontrolv1beta1.Scope
+
+	awsClientProvider awsclient.SkrClientProvider[awsnfsvolumebackupclient.Client]
+	awsClient         awsnfsvolumebackupclient.Client
+
+	backups []sharedRecoveryPoint
+}
+
+type sharedRecoveryPoint struct {
+	vaultName     string
+	recoveryPoint backuptypes.RecoveryPointByBackupVault
+	tags          map[string]string
+}
+
+type StateFactory interface {
+	NewState(ctx context.Context, baseState composed.State) (*State, error)
+}
+
+func NewStateFactory(scopeProvider scopeprovider.ScopeProvider, kcpCluster composed.StateCluster, skrCluster composed.StateCluster,
+	awsClientProvider awsclient.SkrClientProvider[awsnfsvolumebackupclient.Client]) StateFactory {
+
+	return &stateFactory{
+		scopeProvider:     scopeProvider,
+		kcpCluster:        kcpCluster,
+		skrCluster:        skrCluster,
+		awsClientProvider: awsClientProvider,
+	}
+}
+
+type stateFactory struct {
+	scopeProvider     scopeprovider.ScopeProvider
+	kcpCluster        composed.StateCluster
+	skrCluster        composed.StateCluster
+	awsClientProvider awsclient.SkrClientProvider[awsnfsvolumebackupclient.Client]
+}
+
+func (f *stateFactory) NewState(ctx context.Context, baseState composed.State) (*State, error) {
+	kymaRef, err := f.scopeProvider.GetScope(ctx, baseState.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:             baseState,
+		KymaRef:           kymaRef,
+		KcpCluster:        f.kcpCluster,
+		SkrCluster:        f.skrCluster,
+		awsClientProvider: f.awsClientProvider,
+	}, nil
+}
+
+func (s *State) ObjAsAwsNfsVolumeBackupDiscovery() *cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery {
+	return s.Obj().(*cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery)
+}
@@ -0,0 +1,65 @@
+package awsnfsvolumebackupdiscovery
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	"github.com/kyma-project/cloud-manager/pkg/skr/awsnfsvolumebackup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	backupDiscovery := state.ObjAsAwsNfsVolumeBackupDiscovery()
+
+	backupDiscovery.Status.DiscoverySnapshotTime = new(metav1.NewTime(time.Now()))
+	backupDiscovery.Status.State = cloudresourcesv1beta1.JobStateDone
+	backupDiscovery.Status.AvailableBackupsCount = new(len(state.backups))
+	backupDiscovery.Status.AvailableBackupUris = make([]string, 0, len(state.backups))
+	backupDiscovery.Status.AvailableBackups = make([]cloudresourcesv1beta1.AvailableBackup, 0, len(state.backups))
+	for _, b := range state.backups {
+		recoveryPointArn := ptr.Deref(b.recoveryPoint.RecoveryPointArn, "")
+		// the uri is accepted by AwsNfsVolume.Spec.SourceBackupUrl
+		uri := awsnfsvolumebackup.BackupUrl(b.vaultName, awsutil.ParseArnResourceId(recoveryPointArn))
+		backupDiscovery.Status.AvailableBackupUris = append(backupDiscovery.Status.AvailableBackupUris, uri)
+
+		availableBackup := cloudresourcesv1beta1.AvailableBackup{
+			Uri:       uri,
+			ShootName: b.tags[common.TagShoot],
+		}
+		if a, err := arn.Parse(recoveryPointArn); err == nil {
+			availableBackup.Location = a.Region
+		}
+
+		// The Name tag of the recovery point holds the namespace/name of the AwsNfsVolumeBackup
+		if namespace, name, ok := strings.Cut(b.tags["Name"], "/"); ok {
+			availableBackup.BackupNamespace = namespace
+			availableBackup.BackupName = name
+		}
+
+		if b.recoveryPoint.CreationDate != nil {
+			availableBackup.CreationTime = new(metav1.NewTime(*b.recoveryPoint.CreationDate))
+		}
+
+		backupDiscovery.Status.AvailableBackups = append(backupDiscovery.Status.AvailableBackups, availableBackup)
+	}
+
+	return composed.UpdateStatus(backupDiscovery).
+		SetCondition(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonReady,
+			Message: "Successfully discovered available Nfs Volume Backups from AWS",
+		}).
+		ErrorLogMessage("Error: failed to set Done status on AwsNfsVolumeBackupDiscovery").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,95 @@
+package awspostgresinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpPostgresInstance != nil {
+		return nil, ctx
+	}
+
+	awsPostgresInstance := state.ObjAsAwsPostgresInstance()
+
+	instanceClass, err := postgresTierToInstanceClassConvertor(awsPostgresInstance.Spec.PostgresTier, awsconfig.AwsConfig.PostgresInstanceTierClasses)
+
+	if err != nil {
+		errMsg := "failed to map postgresTier to instanceClass"
+		logger.Error(errors.New(errMsg), errMsg, "postgresTier", awsPostgresInstance.Spec.PostgresTier)
+		awsPostgresInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(awsPostgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AwsPostgresInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR AwsPostgresInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpPostgresInstance = &cloudcontrolv1beta1.PostgresInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      awsPostgresInstance.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      awsPostgresInstance.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: awsPostgresInstance.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.PostgresInstanceSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: awsPostgresInstance.Namespace,
+				Name:      awsPostgresInstance.Name,
+			},
+			IpRange: cloudcontrolv1beta1.IpRangeRef{
+				Name: state.SkrIpRange.Status.Id,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Instance: cloudcontrolv1beta1.PostgresInstanceInfo{
+				Aws: &cloudcontrolv1beta1.PostgresInstanceAws{
+					InstanceClass:              instanceClass,
+					EngineVersion:              awsPostgresInstance.Spec.EngineVersion,
+					StorageGb:                  awsPostgresInstance.Spec.StorageGb,
+					MultiAz:                    postgresTierToMultiAz(awsPostgresInstance.Spec.PostgresTier),
+					DatabaseName:               awsPostgresInstance.Spec.DatabaseName,
+					PreferredMaintenanceWindow: awsPostgresInstance.Spec.PreferredMaintenanceWindow,
+				},
+			},
+		},
+	}
+
+	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP PostgresInstance")
+
+	awsPostgresInstance.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(awsPostgresInstance).
+		ErrorLogMessage("Error setting Creating state on AwsPostgresInstance").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
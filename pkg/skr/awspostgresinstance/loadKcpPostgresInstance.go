@@ -0,0 +1,43 @@
+package awspostgresinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func loadKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.ObjAsAwsPostgresInstance().Status.Id == "" {
+		return composed.LogErrorAndReturn(
+			errors.New("missing SKR AwsPostgresInstance state.id"),
+			"Logical error in loadKcpPostgresInstance",
+			composed.StopAndForget,
+			ctx,
+		)
+	}
+
+	kcpPostgresInstance := &cloudcontrolv1beta1.PostgresInstance{}
+	err := state.KcpCluster.K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: state.KymaRef.Namespace,
+		Name:      state.ObjAsAwsPostgresInstance().Status.Id,
+	}, kcpPostgresInstance)
+	if apierrors.IsNotFound(err) {
+		state.KcpPostgresInstance = nil
+		logger.Info("KCP PostgresInstance does not exist")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	state.KcpPostgresInstance = kcpPostgresInstance
+
+	return nil, ctx
+}
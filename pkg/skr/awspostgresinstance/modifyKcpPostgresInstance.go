@@ -0,0 +1,60 @@
+package awspostgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func modifyKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	awsPostgresInstance := state.ObjAsAwsPostgresInstance()
+
+	if state.KcpPostgresInstance == nil {
+		logger.Error(fmt.Errorf("kcpPostgresInstance not found"), "KcpPostgresInstance not found")
+		return composed.StopWithRequeue, nil
+	}
+
+	if !state.ShouldModifyKcp() {
+		return nil, ctx
+	}
+
+	instanceClass, err := postgresTierToInstanceClassConvertor(awsPostgresInstance.Spec.PostgresTier, awsconfig.AwsConfig.PostgresInstanceTierClasses)
+
+	if err != nil {
+		errMsg := "failed to map postgresTier to instanceClass"
+		logger.Error(err, errMsg, "postgresTier", awsPostgresInstance.Spec.PostgresTier)
+		awsPostgresInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(awsPostgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AwsPostgresInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR AwsPostgresInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpPostgresInstance.Spec.Instance.Aws.InstanceClass = instanceClass
+	state.KcpPostgresInstance.Spec.Instance.Aws.MultiAz = postgresTierToMultiAz(awsPostgresInstance.Spec.PostgresTier)
+	state.KcpPostgresInstance.Spec.Instance.Aws.EngineVersion = awsPostgresInstance.Spec.EngineVersion
+	state.KcpPostgresInstance.Spec.Instance.Aws.StorageGb = awsPostgresInstance.Spec.StorageGb
+	state.KcpPostgresInstance.Spec.Instance.Aws.PreferredMaintenanceWindow = awsPostgresInstance.Spec.PreferredMaintenanceWindow
+
+	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,110 @@
+package awspostgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	composed.State
+	KymaRef    klog.ObjectRef
+	KcpCluster composed.StateCluster
+
+	SkrIpRange          *cloudresourcesv1beta1.IpRange
+	KcpPostgresInstance *cloudcontrolv1beta1.PostgresInstance
+
+	AuthSecret *corev1.Secret
+}
+
+func newStateFactory(
+	baseStateFactory composed.StateFactory,
+	scopeProvider scopeprovider.ScopeProvider,
+	kcpCluster composed.StateCluster,
+) *stateFactory {
+	return &stateFactory{
+		baseStateFactory: baseStateFactory,
+		scopeProvider:    scopeProvider,
+		kcpCluster:       kcpCluster,
+	}
+}
+
+type stateFactory struct {
+	baseStateFactory composed.StateFactory
+	scopeProvider    scopeprovider.ScopeProvider
+	kcpCluster       composed.StateCluster
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	kymaRef, err := f.scopeProvider.GetScope(ctx, req.NamespacedName)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:      f.baseStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.AwsPostgresInstance{}),
+		KymaRef:    kymaRef,
+		KcpCluster: f.kcpCluster,
+	}, nil
+}
+
+func (s *State) ObjAsAwsPostgresInstance() *cloudresourcesv1beta1.AwsPostgresInstance {
+	return s.Obj().(*cloudresourcesv1beta1.AwsPostgresInstance)
+}
+
+func (s *State) GetSkrIpRange() *cloudresourcesv1beta1.IpRange {
+	return s.SkrIpRange
+}
+
+func (s *State) SetSkrIpRange(skrIpRange *cloudresourcesv1beta1.IpRange) {
+	s.SkrIpRange = skrIpRange
+}
+
+func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
+	return s.ObjAsAwsPostgresInstance()
+}
+
+func (s *State) GetAuthSecretData() map[string][]byte {
+	authSecretBaseData := getAuthSecretBaseData(s.KcpPostgresInstance)
+	postgresInstance := s.ObjAsAwsPostgresInstance()
+	if postgresInstance.Spec.AuthSecret == nil {
+		return authSecretBaseData
+	}
+
+	parsedAuthSecretExtraData := parseAuthSecretExtraData(postgresInstance.Spec.AuthSecret.ExtraData, authSecretBaseData)
+
+	return util.MergeMaps(authSecretBaseData, parsedAuthSecretExtraData, false)
+}
+
+func (s *State) ShouldModifyKcp() bool {
+	awsPostgresInstance := s.ObjAsAwsPostgresInstance()
+
+	instanceClass, err := postgresTierToInstanceClassConvertor(awsPostgresInstance.Spec.PostgresTier, awsconfig.AwsConfig.PostgresInstanceTierClasses)
+	if err != nil {
+		return true
+	}
+
+	kcpAws := s.KcpPostgresInstance.Spec.Instance.Aws
+
+	isInstanceClassDifferent := kcpAws.InstanceClass != instanceClass
+	isMultiAzDifferent := kcpAws.MultiAz != postgresTierToMultiAz(awsPostgresInstance.Spec.PostgresTier)
+	isEngineVersionDifferent := kcpAws.EngineVersion != awsPostgresInstance.Spec.EngineVersion
+	isStorageGbDifferent := kcpAws.StorageGb != awsPostgresInstance.Spec.StorageGb
+	isPreferredMaintenanceWindowDifferent := ptr.Deref(kcpAws.PreferredMaintenanceWindow, "") != ptr.Deref(awsPostgresInstance.Spec.PreferredMaintenanceWindow, "")
+
+	return isInstanceClassDifferent ||
+		isMultiAzDifferent ||
+		isEngineVersionDifferent ||
+		isStorageGbDifferent ||
+		isPreferredMaintenanceWindowDifferent
+}
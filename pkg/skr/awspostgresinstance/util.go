@@ -0,0 +1,115 @@
+package awspostgresinstance
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(awsPostgres *cloudresourcesv1beta1.AwsPostgresInstance) string {
+	if awsPostgres.Spec.AuthSecret != nil && len(awsPostgres.Spec.AuthSecret.Name) > 0 {
+		return awsPostgres.Spec.AuthSecret.Name
+	}
+
+	return awsPostgres.Name
+}
+
+func getAuthSecretLabels(awsPostgres *cloudresourcesv1beta1.AwsPostgresInstance) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if awsPostgres.Spec.AuthSecret != nil {
+		for labelName, labelValue := range awsPostgres.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelPostgresInstanceStatusId, awsPostgres.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelPostgresInstanceNamespace, awsPostgres.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+
+	return labelsBuilder.Build()
+}
+
+func getAuthSecretAnnotations(awsPostgres *cloudresourcesv1beta1.AwsPostgresInstance) map[string]string {
+	if awsPostgres.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, awsPostgres.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpPostgres *cloudcontrolv1beta1.PostgresInstance) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpPostgres.Status.PrimaryEndpoint) > 0 {
+		result["primaryEndpoint"] = []byte(kcpPostgres.Status.PrimaryEndpoint)
+
+		splitEndpoint := strings.Split(kcpPostgres.Status.PrimaryEndpoint, ":")
+		if len(splitEndpoint) >= 2 {
+			result["host"] = []byte(splitEndpoint[0])
+			result["port"] = []byte(splitEndpoint[1])
+		}
+	}
+
+	if kcpPostgres.Spec.Instance.Aws != nil && len(kcpPostgres.Spec.Instance.Aws.DatabaseName) > 0 {
+		result["database"] = []byte(kcpPostgres.Spec.Instance.Aws.DatabaseName)
+	}
+
+	if len(kcpPostgres.Status.Username) > 0 {
+		result["username"] = []byte(kcpPostgres.Status.Username)
+	}
+
+	if len(kcpPostgres.Status.Password) > 0 {
+		result["password"] = []byte(kcpPostgres.Status.Password)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+var awsPostgresTierToInstanceClassMap = map[cloudresourcesv1beta1.AwsPostgresTier]string{
+	cloudresourcesv1beta1.AwsPostgresTierS1: "db.t4g.medium",
+	cloudresourcesv1beta1.AwsPostgresTierS2: "db.m7g.large",
+	cloudresourcesv1beta1.AwsPostgresTierS3: "db.m7g.xlarge",
+	cloudresourcesv1beta1.AwsPostgresTierS4: "db.m7g.2xlarge",
+
+	cloudresourcesv1beta1.AwsPostgresTierP1: "db.t4g.medium",
+	cloudresourcesv1beta1.AwsPostgresTierP2: "db.m7g.large",
+	cloudresourcesv1beta1.AwsPostgresTierP3: "db.m7g.xlarge",
+	cloudresourcesv1beta1.AwsPostgresTierP4: "db.m7g.2xlarge",
+}
+
+func postgresTierToInstanceClassConvertor(awsPostgresTier cloudresourcesv1beta1.AwsPostgresTier, fromConfigOverwrite map[cloudresourcesv1beta1.AwsPostgresTier]string) (string, error) {
+	if fromConfigOverwrite != nil {
+		if overrideClass, exists := fromConfigOverwrite[awsPostgresTier]; exists {
+			return overrideClass, nil
+		}
+	}
+
+	instanceClass, exists := awsPostgresTierToInstanceClassMap[awsPostgresTier]
+
+	if !exists {
+		return "", errors.New("unknown postgres tier")
+	}
+
+	return instanceClass, nil
+}
+
+func postgresTierToMultiAz(awsPostgresTier cloudresourcesv1beta1.AwsPostgresTier) bool {
+	return strings.HasPrefix(string(awsPostgresTier), "P")
+}
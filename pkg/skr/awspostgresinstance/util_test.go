@@ -0,0 +1,93 @@
+package awspostgresinstance
+
+import (
+	"fmt"
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+type converterTestCase struct {
+	InputPostgresTier     cloudresourcesv1beta1.AwsPostgresTier
+	ExpectedInstanceClass string
+	ExpectedMultiAz       bool
+}
+
+func TestUtil(t *testing.T) {
+
+	t.Run("postgresTierToInstanceClassConvertor", func(t *testing.T) {
+
+		testCases := []converterTestCase{
+			{cloudresourcesv1beta1.AwsPostgresTierS1, "db.t4g.medium", false},
+			{cloudresourcesv1beta1.AwsPostgresTierS2, "db.m7g.large", false},
+			{cloudresourcesv1beta1.AwsPostgresTierS3, "db.m7g.xlarge", false},
+			{cloudresourcesv1beta1.AwsPostgresTierS4, "db.m7g.2xlarge", false},
+
+			{cloudresourcesv1beta1.AwsPostgresTierP1, "db.t4g.medium", true},
+			{cloudresourcesv1beta1.AwsPostgresTierP2, "db.m7g.large", true},
+			{cloudresourcesv1beta1.AwsPostgresTierP3, "db.m7g.xlarge", true},
+			{cloudresourcesv1beta1.AwsPostgresTierP4, "db.m7g.2xlarge", true},
+		}
+
+		overwritesFromConfig := map[cloudresourcesv1beta1.AwsPostgresTier]string{
+			cloudresourcesv1beta1.AwsPostgresTierS1: "custom.99.medium",
+			cloudresourcesv1beta1.AwsPostgresTierP4: "custom.99.2xlarge",
+		}
+
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("should return expected result for input (%s)", testCase.InputPostgresTier), func(t *testing.T) {
+				instanceClass, err := postgresTierToInstanceClassConvertor(testCase.InputPostgresTier, nil)
+
+				assert.Equal(t, testCase.ExpectedInstanceClass, instanceClass, "resulting instanceClass does not match expected instanceClass")
+				assert.Nil(t, err, "expected nil error, got an error")
+				assert.Equal(t, testCase.ExpectedMultiAz, postgresTierToMultiAz(testCase.InputPostgresTier), "resulting multiAz does not match expected multiAz")
+			})
+
+			t.Run(fmt.Sprintf("should return expected result for input (%s) (with overwrite config)", testCase.InputPostgresTier), func(t *testing.T) {
+				instanceClass, err := postgresTierToInstanceClassConvertor(testCase.InputPostgresTier, overwritesFromConfig)
+
+				expected, overwritten := overwritesFromConfig[testCase.InputPostgresTier]
+				if !overwritten {
+					expected = testCase.ExpectedInstanceClass
+				}
+				assert.Equal(t, expected, instanceClass, "resulting instanceClass does not match expected instanceClass")
+				assert.Nil(t, err, "expected nil error, got an error")
+			})
+		}
+
+		t.Run("should return error for unknown input", func(t *testing.T) {
+			instanceClass, err := postgresTierToInstanceClassConvertor("unknown", nil)
+
+			assert.NotNil(t, err, "expected defined error, got nil")
+			assert.Equal(t, "", instanceClass, "expected instanceClass to have zero value")
+		})
+	})
+
+	t.Run("getAuthSecretBaseData", func(t *testing.T) {
+		kcpPostgres := &cloudcontrolv1beta1.PostgresInstance{
+			Spec: cloudcontrolv1beta1.PostgresInstanceSpec{
+				Instance: cloudcontrolv1beta1.PostgresInstanceInfo{
+					Aws: &cloudcontrolv1beta1.PostgresInstanceAws{
+						DatabaseName: "app",
+					},
+				},
+			},
+			Status: cloudcontrolv1beta1.PostgresInstanceStatus{
+				PrimaryEndpoint: "cm-abc.rds.amazonaws.com:5432",
+				Username:        "postgres",
+				Password:        "secret",
+			},
+		}
+
+		data := getAuthSecretBaseData(kcpPostgres)
+
+		assert.Equal(t, "cm-abc.rds.amazonaws.com:5432", string(data["primaryEndpoint"]))
+		assert.Equal(t, "cm-abc.rds.amazonaws.com", string(data["host"]))
+		assert.Equal(t, "5432", string(data["port"]))
+		assert.Equal(t, "app", string(data["database"]))
+		assert.Equal(t, "postgres", string(data["username"]))
+		assert.Equal(t, "secret", string(data["password"]))
+	})
+}
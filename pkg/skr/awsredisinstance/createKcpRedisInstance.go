@@ -77,6 +77,8 @@ func createKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 					PreferredMaintenanceWindow: awsRedisInstance.Spec.PreferredMaintenanceWindow,
 					Parameters:                 awsRedisInstance.Spec.Parameters,
 					ReadReplicas:               replicaCount,
+					SnapshotName:               state.SourceSnapshotName,
+					DeferTierChange:            awsRedisInstance.Spec.DeferTierChange,
 				},
 			},
 		},
@@ -53,6 +53,7 @@ func modifyKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 	state.KcpRedisInstance.Spec.Instance.Aws.AuthEnabled = awsRedisInstance.Spec.AuthEnabled
 	state.KcpRedisInstance.Spec.Instance.Aws.PreferredMaintenanceWindow = awsRedisInstance.Spec.PreferredMaintenanceWindow
 	state.KcpRedisInstance.Spec.Instance.Aws.EngineVersion = awsRedisInstance.Spec.EngineVersion
+	state.KcpRedisInstance.Spec.Instance.Aws.DeferTierChange = awsRedisInstance.Spec.DeferTierChange
 
 	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpRedisInstance)
 	if err != nil {
@@ -66,6 +66,8 @@ func (r *reconciler) newAction() composed.Action {
 			composed.ComposeActions(
 				"awsRedisInstance-create",
 				actions.AddCommonFinalizer(),
+				loadSourceBackup,
+				validateRegionCapabilities,
 				createKcpRedisInstance,
 				waitKcpStatusUpdate,
 				updateStatus,
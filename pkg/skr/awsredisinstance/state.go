@@ -27,6 +27,8 @@ type State struct {
 	KcpRedisInstance *cloudcontrolv1beta1.RedisInstance
 
 	AuthSecret *corev1.Secret
+
+	SourceSnapshotName string
 }
 
 func newStateFactory(
@@ -100,11 +102,13 @@ func (s *State) ShouldModifyKcp() bool {
 	isAuthEnabledDifferent := s.KcpRedisInstance.Spec.Instance.Aws.AuthEnabled != awsRedisInstance.Spec.AuthEnabled
 	arePreferredMaintenanceWindowDifferent := ptr.Deref(s.KcpRedisInstance.Spec.Instance.Aws.PreferredMaintenanceWindow, "") != ptr.Deref(awsRedisInstance.Spec.PreferredMaintenanceWindow, "")
 	isEngineVersionDifferent := s.KcpRedisInstance.Spec.Instance.Aws.EngineVersion != awsRedisInstance.Spec.EngineVersion
+	isDeferTierChangeDifferent := s.KcpRedisInstance.Spec.Instance.Aws.DeferTierChange != awsRedisInstance.Spec.DeferTierChange
 
 	return !maps.Equal(s.KcpRedisInstance.Spec.Instance.Aws.Parameters, awsRedisInstance.Spec.Parameters) ||
 		areCacheNodeTypesDifferent ||
 		isAutoMinorVersionUpgradeDifferent ||
 		isAuthEnabledDifferent ||
 		arePreferredMaintenanceWindowDifferent ||
-		isEngineVersionDifferent
+		isEngineVersionDifferent ||
+		isDeferTierChangeDifferent
 }
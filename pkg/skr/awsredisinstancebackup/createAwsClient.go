@@ -0,0 +1,52 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/config"
+	awsutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createAwsClient(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	roleName := awsutil.RoleArnDefault(state.Scope().Spec.Scope.Aws.AccountId)
+
+	logger.
+		WithValues(
+			"awsRegion", state.Scope().Spec.Region,
+			"awsRole", roleName,
+		).
+		Info("Assuming AWS role")
+
+	cli, err := state.awsClientProvider(
+		ctx,
+		state.Scope().Spec.Scope.Aws.AccountId,
+		state.Scope().Spec.Region,
+		awsconfig.AwsConfig.Default.AccessKeyId,
+		awsconfig.AwsConfig.Default.SecretAccessKey,
+		roleName,
+	)
+	if err != nil {
+		return composed.PatchStatus(state.ObjAsAwsRedisInstanceBackup()).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ConditionTypeError,
+				Message: err.Error(),
+			}).
+			SuccessLogMsg(fmt.Sprintf("Error assuming AWS role : %s", err)).
+			SuccessError(err).
+			Run(ctx, state)
+	}
+
+	state.awsClient = cli
+
+	return nil, ctx
+}
@@ -0,0 +1,64 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createSnapshot(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAwsRedisInstanceBackup()
+
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+
+	if state.snapshot != nil {
+		return nil, ctx
+	}
+
+	// snapshot was created before and is gone now
+	if len(backup.Status.Id) > 0 {
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ConditionTypeError,
+				Message: "ElastiCache snapshot does not exist",
+			}).
+			SuccessLogMsg("AwsRedisInstanceBackup snapshot is missing").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	snapshotName := state.GetSnapshotName()
+	composed.LoggerFromCtx(ctx).Info("Creating ElastiCache snapshot", "snapshotName", snapshotName)
+
+	err := state.awsClient.CreateElastiCacheSnapshot(ctx, snapshotName, state.kcpRedisInstance.Status.Id, state.GetTags())
+	if err != nil {
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ConditionTypeError,
+				Message: err.Error(),
+			}).
+			SuccessLogMsg("Error creating ElastiCache snapshot").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	backup.Status.Id = snapshotName
+	backup.SetState(cloudresourcesv1beta1.StateCreating)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions().
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,54 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func deleteSnapshot(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAwsRedisInstanceBackup()
+
+	if !composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+
+	if state.snapshot == nil {
+		return nil, ctx
+	}
+
+	if ptr.Deref(state.snapshot.SnapshotStatus, "") == awsmeta.ElastiCache_Snapshot_DELETING {
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Deleting ElastiCache snapshot", "snapshotName", state.GetSnapshotName())
+
+	err := state.awsClient.DeleteElastiCacheSnapshot(ctx, state.GetSnapshotName())
+	if err != nil && !awsmeta.IsNotFound(err) {
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ConditionTypeError,
+				Message: err.Error(),
+			}).
+			SuccessLogMsg(fmt.Sprintf("Error deleting ElastiCache snapshot: %s", err)).
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	backup.SetState(cloudresourcesv1beta1.StateDeleting)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions().
+		SuccessError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
@@ -0,0 +1,20 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func loadSnapshot(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	snapshot, err := state.awsClient.DescribeElastiCacheSnapshot(ctx, state.GetSnapshotName())
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading ElastiCache snapshot", composed.StopWithRequeue, ctx)
+	}
+
+	state.snapshot = snapshot
+
+	return nil, ctx
+}
@@ -0,0 +1,88 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func NewReconcilerFactory(
+	awsClientProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient],
+	env abstractions.Environment,
+) skrruntime.ReconcilerFactory {
+	return &reconcilerFactory{
+		awsClientProvider: awsClientProvider,
+		env:               env,
+	}
+}
+
+type reconcilerFactory struct {
+	awsClientProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
+	env               abstractions.Environment
+}
+
+func (f *reconcilerFactory) New(args skrruntime.ReconcilerArguments) reconcile.Reconciler {
+	return &reconciler{
+		factory: newStateFactory(
+			composed.NewStateFactory(composed.NewStateClusterFromCluster(args.SkrCluster)),
+			commonscope.NewStateFactory(
+				composed.NewStateClusterFromCluster(args.KcpCluster),
+				args.ScopeProvider,
+			),
+			f.awsClientProvider,
+			f.env,
+		),
+	}
+}
+
+type reconciler struct {
+	factory *stateFactory
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	if Ignore.ShouldIgnoreKey(request) {
+		return ctrl.Result{}, nil
+	}
+
+	state, err := r.factory.NewState(ctx, request)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error creating AwsRedisInstanceBackup state: %w", err)
+	}
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("awsredisinstancebackup", util.RequestObjToString(request)).
+		WithNoLog().
+		Handle(action(ctx, state))
+}
+
+func (r *reconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"AwsRedisInstanceBackupMain",
+		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.AwsRedisInstanceBackup{}),
+		commonscope.New(),
+		shortCircuitCompleted,
+		markFailed,
+		actions.AddCommonFinalizer(),
+		loadSkrAwsRedisInstance,
+		stopIfRedisInstanceNotReady,
+		loadKcpRedisInstance,
+		createAwsClient,
+		loadSnapshot,
+		createSnapshot,
+		deleteSnapshot,
+		removeFinalizer,
+		updateStatus,
+	)
+}
@@ -0,0 +1,28 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func removeFinalizer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if !composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	// snapshot still exists, wait for it to be deleted
+	if state.snapshot != nil {
+		return nil, nil
+	}
+
+	err, _ := actions.RemoveCommonFinalizer()(ctx, st)
+	if err != nil {
+		return err, ctx
+	}
+
+	return composed.StopAndForget, nil
+}
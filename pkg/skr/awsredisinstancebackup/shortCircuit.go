@@ -0,0 +1,25 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func shortCircuitCompleted(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	//If deletion, continue.
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	backupState := state.ObjAsAwsRedisInstanceBackup().Status.State
+	if backupState == cloudresourcesv1beta1.StateFailed || backupState == cloudresourcesv1beta1.StateReady {
+		composed.LoggerFromCtx(ctx).Info("AwsRedisInstanceBackup is completed, short-circuiting into StopAndForget")
+		return composed.StopAndForget, nil
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,85 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/client"
+	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	commonscope.State
+	awsClientProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
+	env               abstractions.Environment
+
+	awsClient        awsclient.ElastiCacheClient
+	skrRedisInstance *cloudresourcesv1beta1.AwsRedisInstance
+	kcpRedisInstance *cloudcontrolv1beta1.RedisInstance
+	snapshot         *elasticachetypes.Snapshot
+}
+
+func newStateFactory(
+	composedStateFactory composed.StateFactory,
+	commonScopeStateFactory commonscope.StateFactory,
+	awsClientProvider awsclient.SkrClientProvider[awsclient.ElastiCacheClient],
+	env abstractions.Environment,
+) *stateFactory {
+	return &stateFactory{
+		composedStateFactory:    composedStateFactory,
+		commonScopeStateFactory: commonScopeStateFactory,
+		awsClientProvider:       awsClientProvider,
+		env:                     env,
+	}
+}
+
+type stateFactory struct {
+	composedStateFactory    composed.StateFactory
+	commonScopeStateFactory commonscope.StateFactory
+	awsClientProvider       awsclient.SkrClientProvider[awsclient.ElastiCacheClient]
+	env                     abstractions.Environment
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	scopeState, err := f.commonScopeStateFactory.NewState(
+		ctx,
+		req.NamespacedName,
+		f.composedStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.AwsRedisInstanceBackup{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:             scopeState,
+		awsClientProvider: f.awsClientProvider,
+		env:               f.env,
+	}, nil
+}
+
+func (s *State) ObjAsAwsRedisInstanceBackup() *cloudresourcesv1beta1.AwsRedisInstanceBackup {
+	return s.Obj().(*cloudresourcesv1beta1.AwsRedisInstanceBackup)
+}
+
+// GetSnapshotName returns the ElastiCache snapshot name, stable for the lifetime of the backup object
+func (s *State) GetSnapshotName() string {
+	if id := s.ObjAsAwsRedisInstanceBackup().Status.Id; len(id) > 0 {
+		return id
+	}
+	return fmt.Sprintf("cm-%s", s.Obj().GetUID())
+}
+
+func (s *State) GetTags() []elasticachetypes.Tag {
+	return []elasticachetypes.Tag{
+		{Key: new("Name"), Value: new(fmt.Sprintf("%s/%s", s.Obj().GetNamespace(), s.Obj().GetName()))},
+		{Key: new(common.TagCloudManagerName), Value: new(s.Name().String())},
+		{Key: new(common.TagScope), Value: new(s.Scope().Name)},
+		{Key: new(common.TagShoot), Value: new(s.Scope().Spec.ShootName)},
+	}
+}
@@ -0,0 +1,52 @@
+package awsredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	awsmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/aws/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAwsRedisInstanceBackup()
+
+	if composed.IsMarkedForDeletion(backup) {
+		return composed.StopWithRequeueDelay(util.Timing.T1000ms()), nil
+	}
+
+	snapshotStatus := ptr.Deref(state.snapshot.SnapshotStatus, "")
+	switch snapshotStatus {
+	case awsmeta.ElastiCache_Snapshot_AVAILABLE:
+		backup.SetState(cloudresourcesv1beta1.StateReady)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeReady,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
+				Message: "Backup is ready for use.",
+			}).
+			SuccessLogMsg("AwsRedisInstanceBackup status got updated with Ready condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	case awsmeta.ElastiCache_Snapshot_FAILED:
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeError,
+				Message: fmt.Sprintf("ElastiCache snapshot %s failed", state.GetSnapshotName()),
+			}).
+			SuccessLogMsg("AwsRedisInstanceBackup status got updated with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	default:
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+}
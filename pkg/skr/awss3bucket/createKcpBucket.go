@@ -0,0 +1,67 @@
+package awss3bucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpBucket != nil {
+		return nil, ctx
+	}
+
+	awsS3Bucket := state.ObjAsAwsS3Bucket()
+
+	state.KcpBucket = &cloudcontrolv1beta1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      awsS3Bucket.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      awsS3Bucket.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: awsS3Bucket.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.BucketSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: awsS3Bucket.Namespace,
+				Name:      awsS3Bucket.Name,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Bucket: cloudcontrolv1beta1.BucketInfo{
+				Aws: &cloudcontrolv1beta1.BucketAws{
+					Versioning:    awsS3Bucket.Spec.Versioning,
+					Lifecycle:     toKcpLifecycle(awsS3Bucket.Spec.Lifecycle),
+					RetentionDays: awsS3Bucket.Spec.RetentionDays,
+				},
+			},
+		},
+	}
+
+	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpBucket)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP Bucket", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP Bucket")
+
+	awsS3Bucket.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(awsS3Bucket).
+		ErrorLogMessage("Error setting Creating state on AwsS3Bucket").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
@@ -0,0 +1,43 @@
+package awss3bucket
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func loadKcpBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.ObjAsAwsS3Bucket().Status.Id == "" {
+		return composed.LogErrorAndReturn(
+			errors.New("missing SKR AwsS3Bucket state.id"),
+			"Logical error in loadKcpBucket",
+			composed.StopAndForget,
+			ctx,
+		)
+	}
+
+	kcpBucket := &cloudcontrolv1beta1.Bucket{}
+	err := state.KcpCluster.K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: state.KymaRef.Namespace,
+		Name:      state.ObjAsAwsS3Bucket().Status.Id,
+	}, kcpBucket)
+	if apierrors.IsNotFound(err) {
+		state.KcpBucket = nil
+		logger.Info("KCP Bucket does not exist")
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading KCP Bucket", composed.StopWithRequeue, ctx)
+	}
+
+	state.KcpBucket = kcpBucket
+
+	return nil, ctx
+}
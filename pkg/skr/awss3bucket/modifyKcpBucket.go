@@ -0,0 +1,35 @@
+package awss3bucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyKcpBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	awsS3Bucket := state.ObjAsAwsS3Bucket()
+
+	if state.KcpBucket == nil {
+		logger.Error(fmt.Errorf("kcpBucket not found"), "KcpBucket not found")
+		return composed.StopWithRequeue, nil
+	}
+
+	if !state.ShouldModifyKcp() {
+		return nil, ctx
+	}
+
+	state.KcpBucket.Spec.Bucket.Aws.Versioning = awsS3Bucket.Spec.Versioning
+	state.KcpBucket.Spec.Bucket.Aws.Lifecycle = toKcpLifecycle(awsS3Bucket.Spec.Lifecycle)
+	state.KcpBucket.Spec.Bucket.Aws.RetentionDays = awsS3Bucket.Spec.RetentionDays
+
+	err := state.KcpCluster.K8sClient().Update(ctx, state.KcpBucket)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP Bucket", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
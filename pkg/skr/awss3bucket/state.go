@@ -0,0 +1,85 @@
+package awss3bucket
+
+import (
+	"context"
+	"slices"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	composed.State
+	KymaRef    klog.ObjectRef
+	KcpCluster composed.StateCluster
+
+	KcpBucket *cloudcontrolv1beta1.Bucket
+
+	AuthSecret *corev1.Secret
+}
+
+func newStateFactory(
+	baseStateFactory composed.StateFactory,
+	scopeProvider scopeprovider.ScopeProvider,
+	kcpCluster composed.StateCluster,
+) *stateFactory {
+	return &stateFactory{
+		baseStateFactory: baseStateFactory,
+		scopeProvider:    scopeProvider,
+		kcpCluster:       kcpCluster,
+	}
+}
+
+type stateFactory struct {
+	baseStateFactory composed.StateFactory
+	scopeProvider    scopeprovider.ScopeProvider
+	kcpCluster       composed.StateCluster
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	kymaRef, err := f.scopeProvider.GetScope(ctx, req.NamespacedName)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:      f.baseStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.AwsS3Bucket{}),
+		KymaRef:    kymaRef,
+		KcpCluster: f.kcpCluster,
+	}, nil
+}
+
+func (s *State) ObjAsAwsS3Bucket() *cloudresourcesv1beta1.AwsS3Bucket {
+	return s.Obj().(*cloudresourcesv1beta1.AwsS3Bucket)
+}
+
+func (s *State) GetAuthSecretData() map[string][]byte {
+	authSecretBaseData := getAuthSecretBaseData(s.KcpBucket)
+	bucket := s.ObjAsAwsS3Bucket()
+	if bucket.Spec.AuthSecret == nil {
+		return authSecretBaseData
+	}
+
+	parsedAuthSecretExtraData := parseAuthSecretExtraData(bucket.Spec.AuthSecret.ExtraData, authSecretBaseData)
+
+	return util.MergeMaps(authSecretBaseData, parsedAuthSecretExtraData, false)
+}
+
+func (s *State) ShouldModifyKcp() bool {
+	awsS3Bucket := s.ObjAsAwsS3Bucket()
+	kcpAws := s.KcpBucket.Spec.Bucket.Aws
+
+	isVersioningDifferent := kcpAws.Versioning != awsS3Bucket.Spec.Versioning
+	isLifecycleDifferent := !slices.Equal(kcpAws.Lifecycle, toKcpLifecycle(awsS3Bucket.Spec.Lifecycle))
+	isRetentionDaysDifferent := kcpAws.RetentionDays != awsS3Bucket.Spec.RetentionDays
+
+	return isVersioningDifferent ||
+		isLifecycleDifferent ||
+		isRetentionDaysDifferent
+}
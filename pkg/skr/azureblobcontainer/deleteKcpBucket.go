@@ -0,0 +1,56 @@
+package azureblobcontainer
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteKcpBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpBucket == nil {
+		return nil, ctx
+	}
+
+	if composed.IsMarkedForDeletion(state.KcpBucket) {
+		return nil, ctx
+	}
+
+	bucket := state.ObjAsAzureBlobContainer()
+
+	err, _ := composed.UpdateStatus(bucket).
+		SetCondition(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeDeleting,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonDeletingInstance,
+			Message: fmt.Sprintf("Deleting Bucket %s", state.Name()),
+		}).
+		ErrorLogMessage("Error setting ConditionReasonDeletingInstance condition on AzureBlobContainer").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+	if err != nil {
+		return err, ctx
+	}
+
+	logger.Info("Deleting KCP Bucket for AzureBlobContainer")
+
+	err = state.KcpCluster.K8sClient().Delete(ctx, state.KcpBucket)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error deleting KCP Bucket for AzureBlobContainer", composed.StopWithRequeue, ctx)
+	}
+
+	bucket.Status.State = cloudresourcesv1beta1.StateDeleting
+	err = state.UpdateObjStatus(ctx)
+
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Failed status update on AzureBlobContainer", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,96 @@
+package azureblobcontainer
+
+import (
+	"fmt"
+	"maps"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(azureBlobContainer *cloudresourcesv1beta1.AzureBlobContainer) string {
+	if azureBlobContainer.Spec.AuthSecret != nil && len(azureBlobContainer.Spec.AuthSecret.Name) > 0 {
+		return azureBlobContainer.Spec.AuthSecret.Name
+	}
+
+	return azureBlobContainer.Name
+}
+
+func getAuthSecretLabels(azureBlobContainer *cloudresourcesv1beta1.AzureBlobContainer) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if azureBlobContainer.Spec.AuthSecret != nil {
+		for labelName, labelValue := range azureBlobContainer.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelBucketStatusId, azureBlobContainer.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelBucketNamespace, azureBlobContainer.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+
+	return labelsBuilder.Build()
+}
+
+func getAuthSecretAnnotations(azureBlobContainer *cloudresourcesv1beta1.AzureBlobContainer) map[string]string {
+	if azureBlobContainer.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, azureBlobContainer.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpBucket *cloudcontrolv1beta1.Bucket) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpBucket.Status.AccessKeyId) > 0 {
+		result["storageAccountName"] = []byte(kcpBucket.Status.AccessKeyId)
+	}
+
+	if len(kcpBucket.Status.Id) > 0 {
+		result["containerName"] = []byte(kcpBucket.Status.Id)
+	}
+
+	if len(kcpBucket.Status.Endpoint) > 0 {
+		result["endpoint"] = []byte(kcpBucket.Status.Endpoint)
+	}
+
+	if len(kcpBucket.Status.SecretAccessKey) > 0 {
+		result["accountKey"] = []byte(kcpBucket.Status.SecretAccessKey)
+	}
+
+	if len(kcpBucket.Status.AccessKeyId) > 0 && len(kcpBucket.Status.SecretAccessKey) > 0 && len(kcpBucket.Status.Endpoint) > 0 {
+		result["connectionString"] = []byte(fmt.Sprintf(
+			"DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;BlobEndpoint=%s",
+			kcpBucket.Status.AccessKeyId,
+			kcpBucket.Status.SecretAccessKey,
+			kcpBucket.Status.Endpoint,
+		))
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+func toKcpLifecycle(rules []cloudresourcesv1beta1.BucketLifecycleRule) []cloudcontrolv1beta1.BucketLifecycleRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	result := make([]cloudcontrolv1beta1.BucketLifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, cloudcontrolv1beta1.BucketLifecycleRule(rule))
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package azureblobcontainer
+
+import (
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUtil(t *testing.T) {
+
+	t.Run("getAuthSecretBaseData", func(t *testing.T) {
+		kcpBucket := &cloudcontrolv1beta1.Bucket{
+			Status: cloudcontrolv1beta1.BucketStatus{
+				Id:              "cm-abc",
+				Location:        "westeurope",
+				Endpoint:        "https://cmabc.blob.core.windows.net/",
+				AccessKeyId:     "cmabc",
+				SecretAccessKey: "secret",
+			},
+		}
+
+		data := getAuthSecretBaseData(kcpBucket)
+
+		assert.Equal(t, "cmabc", string(data["storageAccountName"]))
+		assert.Equal(t, "cm-abc", string(data["containerName"]))
+		assert.Equal(t, "https://cmabc.blob.core.windows.net/", string(data["endpoint"]))
+		assert.Equal(t, "secret", string(data["accountKey"]))
+		assert.Equal(t, "DefaultEndpointsProtocol=https;AccountName=cmabc;AccountKey=secret;BlobEndpoint=https://cmabc.blob.core.windows.net/", string(data["connectionString"]))
+	})
+
+	t.Run("getAuthSecretBaseData without key", func(t *testing.T) {
+		data := getAuthSecretBaseData(&cloudcontrolv1beta1.Bucket{
+			Status: cloudcontrolv1beta1.BucketStatus{
+				Id: "cm-abc",
+			},
+		})
+
+		assert.Equal(t, "cm-abc", string(data["containerName"]))
+		assert.NotContains(t, data, "connectionString")
+	})
+
+	t.Run("toKcpLifecycle", func(t *testing.T) {
+		assert.Nil(t, toKcpLifecycle(nil))
+
+		rules := toKcpLifecycle([]cloudresourcesv1beta1.BucketLifecycleRule{
+			{Prefix: "logs/", ExpirationDays: 30},
+			{NoncurrentVersionExpirationDays: 7},
+		})
+
+		assert.Equal(t, []cloudcontrolv1beta1.BucketLifecycleRule{
+			{Prefix: "logs/", ExpirationDays: 30},
+			{NoncurrentVersionExpirationDays: 7},
+		}, rules)
+	})
+}
@@ -0,0 +1,94 @@
+package azurepostgresinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpPostgresInstance != nil {
+		return nil, ctx
+	}
+
+	azurePostgresInstance := state.ObjAsAzurePostgresInstance()
+
+	skuName, err := postgresTierToSkuNameConvertor(azurePostgresInstance.Spec.PostgresTier)
+
+	if err != nil {
+		errMsg := "failed to map postgresTier to sku name"
+		logger.Error(errors.New(errMsg), errMsg, "postgresTier", azurePostgresInstance.Spec.PostgresTier)
+		azurePostgresInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(azurePostgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AzurePostgresInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR AzurePostgresInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpPostgresInstance = &cloudcontrolv1beta1.PostgresInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      azurePostgresInstance.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      azurePostgresInstance.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: azurePostgresInstance.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.PostgresInstanceSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: azurePostgresInstance.Namespace,
+				Name:      azurePostgresInstance.Name,
+			},
+			IpRange: cloudcontrolv1beta1.IpRangeRef{
+				Name: state.SkrIpRange.Status.Id,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Instance: cloudcontrolv1beta1.PostgresInstanceInfo{
+				Azure: &cloudcontrolv1beta1.PostgresInstanceAzure{
+					SkuName:          skuName,
+					SkuTier:          azurePostgresSkuTier,
+					Version:          azurePostgresInstance.Spec.PostgresVersion,
+					StorageGb:        azurePostgresInstance.Spec.StorageGb,
+					HighAvailability: postgresTierToHighAvailability(azurePostgresInstance.Spec.PostgresTier),
+					DatabaseName:     azurePostgresInstance.Spec.DatabaseName,
+				},
+			},
+		},
+	}
+
+	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP PostgresInstance")
+
+	azurePostgresInstance.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(azurePostgresInstance).
+		ErrorLogMessage("Error setting Creating state on AzurePostgresInstance").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
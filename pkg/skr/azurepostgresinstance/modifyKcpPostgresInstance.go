@@ -0,0 +1,57 @@
+package azurepostgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func modifyKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	azurePostgresInstance := state.ObjAsAzurePostgresInstance()
+
+	if state.KcpPostgresInstance == nil {
+		logger.Error(fmt.Errorf("kcpPostgresInstance not found"), "KcpPostgresInstance not found")
+		return composed.StopWithRequeue, nil
+	}
+
+	if !state.ShouldModifyKcp() {
+		return nil, ctx
+	}
+
+	skuName, err := postgresTierToSkuNameConvertor(azurePostgresInstance.Spec.PostgresTier)
+
+	if err != nil {
+		errMsg := "failed to map postgresTier to sku name"
+		logger.Error(err, errMsg, "postgresTier", azurePostgresInstance.Spec.PostgresTier)
+		azurePostgresInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(azurePostgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AzurePostgresInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR AzurePostgresInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpPostgresInstance.Spec.Instance.Azure.SkuName = skuName
+	state.KcpPostgresInstance.Spec.Instance.Azure.HighAvailability = postgresTierToHighAvailability(azurePostgresInstance.Spec.PostgresTier)
+	state.KcpPostgresInstance.Spec.Instance.Azure.StorageGb = azurePostgresInstance.Spec.StorageGb
+
+	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
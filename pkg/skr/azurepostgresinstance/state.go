@@ -0,0 +1,104 @@
+package azurepostgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	composed.State
+	KymaRef    klog.ObjectRef
+	KcpCluster composed.StateCluster
+
+	SkrIpRange          *cloudresourcesv1beta1.IpRange
+	KcpPostgresInstance *cloudcontrolv1beta1.PostgresInstance
+
+	AuthSecret *corev1.Secret
+}
+
+func newStateFactory(
+	baseStateFactory composed.StateFactory,
+	scopeProvider scopeprovider.ScopeProvider,
+	kcpCluster composed.StateCluster,
+) *stateFactory {
+	return &stateFactory{
+		baseStateFactory: baseStateFactory,
+		scopeProvider:    scopeProvider,
+		kcpCluster:       kcpCluster,
+	}
+}
+
+type stateFactory struct {
+	baseStateFactory composed.StateFactory
+	scopeProvider    scopeprovider.ScopeProvider
+	kcpCluster       composed.StateCluster
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	kymaRef, err := f.scopeProvider.GetScope(ctx, req.NamespacedName)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:      f.baseStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.AzurePostgresInstance{}),
+		KymaRef:    kymaRef,
+		KcpCluster: f.kcpCluster,
+	}, nil
+}
+
+func (s *State) ObjAsAzurePostgresInstance() *cloudresourcesv1beta1.AzurePostgresInstance {
+	return s.Obj().(*cloudresourcesv1beta1.AzurePostgresInstance)
+}
+
+func (s *State) GetSkrIpRange() *cloudresourcesv1beta1.IpRange {
+	return s.SkrIpRange
+}
+
+func (s *State) SetSkrIpRange(skrIpRange *cloudresourcesv1beta1.IpRange) {
+	s.SkrIpRange = skrIpRange
+}
+
+func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
+	return s.ObjAsAzurePostgresInstance()
+}
+
+func (s *State) GetAuthSecretData() map[string][]byte {
+	authSecretBaseData := getAuthSecretBaseData(s.KcpPostgresInstance)
+	postgresInstance := s.ObjAsAzurePostgresInstance()
+	if postgresInstance.Spec.AuthSecret == nil {
+		return authSecretBaseData
+	}
+
+	parsedAuthSecretExtraData := parseAuthSecretExtraData(postgresInstance.Spec.AuthSecret.ExtraData, authSecretBaseData)
+
+	return util.MergeMaps(authSecretBaseData, parsedAuthSecretExtraData, false)
+}
+
+func (s *State) ShouldModifyKcp() bool {
+	azurePostgresInstance := s.ObjAsAzurePostgresInstance()
+
+	skuName, err := postgresTierToSkuNameConvertor(azurePostgresInstance.Spec.PostgresTier)
+	if err != nil {
+		return true
+	}
+
+	kcpAzure := s.KcpPostgresInstance.Spec.Instance.Azure
+
+	isSkuNameDifferent := kcpAzure.SkuName != skuName
+	isHighAvailabilityDifferent := kcpAzure.HighAvailability != postgresTierToHighAvailability(azurePostgresInstance.Spec.PostgresTier)
+	isStorageGbDifferent := kcpAzure.StorageGb != azurePostgresInstance.Spec.StorageGb
+
+	return isSkuNameDifferent ||
+		isHighAvailabilityDifferent ||
+		isStorageGbDifferent
+}
@@ -0,0 +1,112 @@
+package azurepostgresinstance
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(azurePostgres *cloudresourcesv1beta1.AzurePostgresInstance) string {
+	if azurePostgres.Spec.AuthSecret != nil && len(azurePostgres.Spec.AuthSecret.Name) > 0 {
+		return azurePostgres.Spec.AuthSecret.Name
+	}
+
+	return azurePostgres.Name
+}
+
+func getAuthSecretLabels(azurePostgres *cloudresourcesv1beta1.AzurePostgresInstance) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if azurePostgres.Spec.AuthSecret != nil {
+		for labelName, labelValue := range azurePostgres.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelPostgresInstanceStatusId, azurePostgres.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelPostgresInstanceNamespace, azurePostgres.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+
+	return labelsBuilder.Build()
+}
+
+func getAuthSecretAnnotations(azurePostgres *cloudresourcesv1beta1.AzurePostgresInstance) map[string]string {
+	if azurePostgres.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, azurePostgres.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpPostgres *cloudcontrolv1beta1.PostgresInstance) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpPostgres.Status.PrimaryEndpoint) > 0 {
+		result["primaryEndpoint"] = []byte(kcpPostgres.Status.PrimaryEndpoint)
+
+		splitEndpoint := strings.Split(kcpPostgres.Status.PrimaryEndpoint, ":")
+		if len(splitEndpoint) >= 2 {
+			result["host"] = []byte(splitEndpoint[0])
+			result["port"] = []byte(splitEndpoint[1])
+		}
+	}
+
+	if kcpPostgres.Spec.Instance.Azure != nil && len(kcpPostgres.Spec.Instance.Azure.DatabaseName) > 0 {
+		result["database"] = []byte(kcpPostgres.Spec.Instance.Azure.DatabaseName)
+	}
+
+	if len(kcpPostgres.Status.Username) > 0 {
+		result["username"] = []byte(kcpPostgres.Status.Username)
+	}
+
+	if len(kcpPostgres.Status.Password) > 0 {
+		result["password"] = []byte(kcpPostgres.Status.Password)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+var azurePostgresTierToSkuNameMap = map[cloudresourcesv1beta1.AzurePostgresTier]string{
+	cloudresourcesv1beta1.AzurePostgresTierS1: "Standard_D2ds_v5",
+	cloudresourcesv1beta1.AzurePostgresTierS2: "Standard_D4ds_v5",
+	cloudresourcesv1beta1.AzurePostgresTierS3: "Standard_D8ds_v5",
+	cloudresourcesv1beta1.AzurePostgresTierS4: "Standard_D16ds_v5",
+
+	cloudresourcesv1beta1.AzurePostgresTierP1: "Standard_D2ds_v5",
+	cloudresourcesv1beta1.AzurePostgresTierP2: "Standard_D4ds_v5",
+	cloudresourcesv1beta1.AzurePostgresTierP3: "Standard_D8ds_v5",
+	cloudresourcesv1beta1.AzurePostgresTierP4: "Standard_D16ds_v5",
+}
+
+// all tiers use the General Purpose compute, since Burstable does not support high availability
+const azurePostgresSkuTier = "GeneralPurpose"
+
+func postgresTierToSkuNameConvertor(azurePostgresTier cloudresourcesv1beta1.AzurePostgresTier) (string, error) {
+	skuName, exists := azurePostgresTierToSkuNameMap[azurePostgresTier]
+
+	if !exists {
+		return "", errors.New("unknown postgres tier")
+	}
+
+	return skuName, nil
+}
+
+func postgresTierToHighAvailability(azurePostgresTier cloudresourcesv1beta1.AzurePostgresTier) bool {
+	return strings.HasPrefix(string(azurePostgresTier), "P")
+}
@@ -0,0 +1,77 @@
+package azurepostgresinstance
+
+import (
+	"fmt"
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+type converterTestCase struct {
+	InputPostgresTier        cloudresourcesv1beta1.AzurePostgresTier
+	ExpectedSkuName          string
+	ExpectedHighAvailability bool
+}
+
+func TestUtil(t *testing.T) {
+
+	t.Run("postgresTierToSkuNameConvertor", func(t *testing.T) {
+
+		testCases := []converterTestCase{
+			{cloudresourcesv1beta1.AzurePostgresTierS1, "Standard_D2ds_v5", false},
+			{cloudresourcesv1beta1.AzurePostgresTierS2, "Standard_D4ds_v5", false},
+			{cloudresourcesv1beta1.AzurePostgresTierS3, "Standard_D8ds_v5", false},
+			{cloudresourcesv1beta1.AzurePostgresTierS4, "Standard_D16ds_v5", false},
+
+			{cloudresourcesv1beta1.AzurePostgresTierP1, "Standard_D2ds_v5", true},
+			{cloudresourcesv1beta1.AzurePostgresTierP2, "Standard_D4ds_v5", true},
+			{cloudresourcesv1beta1.AzurePostgresTierP3, "Standard_D8ds_v5", true},
+			{cloudresourcesv1beta1.AzurePostgresTierP4, "Standard_D16ds_v5", true},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("should return expected result for input (%s)", testCase.InputPostgresTier), func(t *testing.T) {
+				skuName, err := postgresTierToSkuNameConvertor(testCase.InputPostgresTier)
+
+				assert.Equal(t, testCase.ExpectedSkuName, skuName, "resulting sku name does not match expected sku name")
+				assert.Nil(t, err, "expected nil error, got an error")
+				assert.Equal(t, testCase.ExpectedHighAvailability, postgresTierToHighAvailability(testCase.InputPostgresTier), "resulting highAvailability does not match expected highAvailability")
+			})
+		}
+
+		t.Run("should return error for unknown input", func(t *testing.T) {
+			skuName, err := postgresTierToSkuNameConvertor("unknown")
+
+			assert.NotNil(t, err, "expected defined error, got nil")
+			assert.Equal(t, "", skuName, "expected sku name to have zero value")
+		})
+	})
+
+	t.Run("getAuthSecretBaseData", func(t *testing.T) {
+		kcpPostgres := &cloudcontrolv1beta1.PostgresInstance{
+			Spec: cloudcontrolv1beta1.PostgresInstanceSpec{
+				Instance: cloudcontrolv1beta1.PostgresInstanceInfo{
+					Azure: &cloudcontrolv1beta1.PostgresInstanceAzure{
+						DatabaseName: "app",
+					},
+				},
+			},
+			Status: cloudcontrolv1beta1.PostgresInstanceStatus{
+				PrimaryEndpoint: "my-server.postgres.database.azure.com:5432",
+				Username:        "cmadmin",
+				Password:        "secret",
+			},
+		}
+
+		data := getAuthSecretBaseData(kcpPostgres)
+
+		assert.Equal(t, "my-server.postgres.database.azure.com:5432", string(data["primaryEndpoint"]))
+		assert.Equal(t, "my-server.postgres.database.azure.com", string(data["host"]))
+		assert.Equal(t, "5432", string(data["port"]))
+		assert.Equal(t, "app", string(data["database"]))
+		assert.Equal(t, "cmadmin", string(data["username"]))
+		assert.Equal(t, "secret", string(data["password"]))
+	})
+}
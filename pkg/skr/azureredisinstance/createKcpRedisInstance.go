@@ -77,6 +77,7 @@ func createKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 						MaxMemoryReserved:              azureRedisInstance.Spec.RedisConfiguration.MaxMemoryReserved,
 						NotifyKeyspaceEvents:           azureRedisInstance.Spec.RedisConfiguration.NotifyKeyspaceEvents,
 					},
+					ImportBlobUrl: state.SourceBlobUrl,
 				},
 			},
 		},
@@ -15,7 +15,11 @@ func modifyKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 
 	azureRedisInstance := state.ObjAsAzureRedisInstance()
 
-	if !meta.IsStatusConditionTrue(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) {
+	// an unsupported tier change is the only error that can be fixed by modifying the spec
+	condErr := meta.FindStatusCondition(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+	hasUnsupportedTierChange := condErr != nil && condErr.Reason == cloudresourcesv1beta1.ConditionReasonUnsupportedTierChange
+
+	if !meta.IsStatusConditionTrue(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) && !hasUnsupportedTierChange {
 		return nil, ctx
 	}
 
@@ -44,14 +48,15 @@ func modifyKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 	}
 
 	capacityChanged := state.KcpRedisInstance.Spec.Instance.Azure.SKU.Capacity != redisSKUCapacity
+	familyChanged := state.KcpRedisInstance.Spec.Instance.Azure.SKU.Family != redisSKUFamily
 
-	if !capacityChanged {
+	if !capacityChanged && !familyChanged {
 		return nil, ctx
 	}
 
 	state.KcpRedisInstance.Spec.Instance.Azure.SKU.Capacity = redisSKUCapacity
 	state.KcpRedisInstance.Spec.Instance.Azure.SKU.Family = redisSKUFamily
-	logger.Info("Detected modified Redis SKU capacity")
+	logger.Info("Detected modified Redis SKU")
 	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpRedisInstance)
 
 	if err != nil {
@@ -63,6 +63,8 @@ func (r *reconciler) newAction() composed.Action {
 			composed.ComposeActions(
 				"azureRedisInstance-create",
 				actions.AddCommonFinalizer(),
+				loadSourceBackup,
+				validateRegionCapabilities,
 				createKcpRedisInstance,
 				modifyKcpRedisInstance,
 				waitKcpStatusUpdate,
@@ -23,6 +23,8 @@ type State struct {
 	KcpRedisInstance *cloudcontrolv1beta1.RedisInstance
 	SkrIpRange       *cloudresourcesv1beta1.IpRange
 	AuthSecret       *corev1.Secret
+
+	SourceBlobUrl string
 }
 
 func newStateFactory(
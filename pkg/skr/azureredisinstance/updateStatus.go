@@ -16,22 +16,77 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 
 	azureRedisInstance := state.ObjAsAzureRedisInstance()
 
+	pendingRedisTier := cloudresourcesv1beta1.AzureRedisTier("")
+	if state.KcpRedisInstance.Status.PendingNodeType != "" {
+		pendingRedisTier = azureRedisInstance.Spec.RedisTier
+	}
+	if azureRedisInstance.Status.PendingRedisTier != pendingRedisTier {
+		azureRedisInstance.Status.PendingRedisTier = pendingRedisTier
+		return composed.UpdateStatus(azureRedisInstance).
+			ErrorLogMessage("Error: updating AzureRedisInstance status with pending redisTier").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
 	kcpCondErr := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeError)
 	kcpCondReady := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
 
+	kcpCondUpdating := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeUpdating)
+
 	skrCondErr := meta.FindStatusCondition(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
 	skrCondReady := meta.FindStatusCondition(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady)
+	skrCondUpdating := meta.FindStatusCondition(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeUpdating)
+
+	if kcpCondUpdating != nil && skrCondErr == nil && (skrCondUpdating == nil || skrCondUpdating.Message != kcpCondUpdating.Message) {
+		azureRedisInstance.Status.State = cloudresourcesv1beta1.StateUpdating
+		return composed.UpdateStatus(azureRedisInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeUpdating,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeUpdating,
+				Message: kcpCondUpdating.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AzureRedisInstance status with updating conditions").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
+	kcpCondRestoring := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeRestoring)
+	skrHasRestoringCondition := meta.FindStatusCondition(azureRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeRestoring) != nil
+
+	if kcpCondRestoring != nil && skrCondErr == nil && !skrHasRestoringCondition {
+		azureRedisInstance.Status.State = cloudresourcesv1beta1.StateRestoring
+		return composed.UpdateStatus(azureRedisInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeRestoring,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeRestoring,
+				Message: kcpCondRestoring.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating AzureRedisInstance status with restoring conditions").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
 
 	if kcpCondErr != nil && skrCondErr == nil {
+		errReason := cloudresourcesv1beta1.ConditionReasonError
+		switch kcpCondErr.Reason {
+		case cloudcontrolv1beta1.ReasonRestoreFailed:
+			errReason = cloudresourcesv1beta1.ConditionReasonRedisRestoreFailed
+		case cloudcontrolv1beta1.ReasonUnsupportedTierChange:
+			errReason = cloudresourcesv1beta1.ConditionReasonUnsupportedTierChange
+		}
 		azureRedisInstance.Status.State = cloudresourcesv1beta1.StateError
 		return composed.UpdateStatus(azureRedisInstance).
 			SetCondition(metav1.Condition{
 				Type:    cloudresourcesv1beta1.ConditionTypeError,
 				Status:  metav1.ConditionTrue,
-				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Reason:  errReason,
 				Message: kcpCondErr.Message,
 			}).
-			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady, cloudresourcesv1beta1.ConditionTypeUpdating, cloudresourcesv1beta1.ConditionTypeRestoring).
 			ErrorLogMessage("Error: updating AzureRedisInstance status with not ready condition due to KCP error").
 			SuccessLogMsg("Updated and forgot SKR AzureRedisInstance status with Error condition").
 			SuccessError(composed.StopAndForget).
@@ -48,7 +103,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
 				Message: kcpCondReady.Message,
 			}).
-			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError, cloudresourcesv1beta1.ConditionTypeUpdating, cloudresourcesv1beta1.ConditionTypeRestoring).
 			ErrorLogMessage("Error updating SKR AzureRedisInstance status with ready condition").
 			SuccessError(composed.StopWithRequeue).
 			Run(ctx, state)
@@ -116,3 +116,9 @@ func RedisTierToSKUCapacityConverter(redisTier cloudresourcesv1beta1.AzureRedisT
 
 	return azureRedisSKUValue.Tier, azureRedisSKUValue.Capacity, nil
 }
+
+// ValidateRedisTier returns an error if the redis tier can not be provisioned
+func ValidateRedisTier(redisTier cloudresourcesv1beta1.AzureRedisTier) error {
+	_, _, err := RedisTierToSKUCapacityConverter(redisTier)
+	return err
+}
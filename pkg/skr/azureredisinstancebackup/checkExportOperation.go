@@ -0,0 +1,62 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func checkExportOperation(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	// the export writing into the container does not block its deletion
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+
+	resumeToken := backup.Status.OpIdentifier
+	if resumeToken == "" {
+		return nil, ctx
+	}
+
+	done, err := state.azureClient.GetExportRedisInstanceDataStatus(ctx, state.resourceGroupName, state.kcpRedisInstance.Status.Id, resumeToken)
+	if !done {
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error checking Azure Redis export status", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+		}
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+	}
+
+	backup.Status.OpIdentifier = ""
+
+	if err != nil {
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: fmt.Sprintf("Export operation failed: %s", err),
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	backup.SetState(cloudresourcesv1beta1.StateReady)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonReady,
+			Message: "Backup is ready for use.",
+		}).
+		SuccessLogMsg("AzureRedisInstanceBackup status got updated with Ready condition").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,30 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurecommon "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/common"
+	azureconfig "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/config"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func createAzureClient(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	cli, err := state.azureClientProvider(
+		ctx,
+		azureconfig.AzureConfig.DefaultCreds.ClientId,
+		azureconfig.AzureConfig.DefaultCreds.ClientSecret,
+		state.Scope().Spec.Scope.Azure.SubscriptionId,
+		state.Scope().Spec.Scope.Azure.TenantId,
+	)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating Azure client", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
+	}
+
+	state.azureClient = cli
+	state.resourceGroupName = azurecommon.AzureCloudManagerResourceGroupName(state.Scope().Spec.Scope.Azure.VpcNetwork)
+
+	return nil, ctx
+}
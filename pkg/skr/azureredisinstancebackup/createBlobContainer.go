@@ -0,0 +1,53 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createBlobContainer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+	// export was already started
+	if len(backup.Status.Id) > 0 {
+		return nil, ctx
+	}
+
+	_, err := state.azureClient.GetBlobContainer(ctx, state.resourceGroupName, state.GetStorageAccountName(), state.GetBlobContainerName())
+	if err == nil {
+		return nil, ctx
+	}
+	if !azuremeta.IsNotFound(err) {
+		return composed.LogErrorAndReturn(err, "Error loading Azure blob container", composed.StopWithRequeue, ctx)
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Creating Azure blob container for redis backup", "container", state.GetBlobContainerName())
+
+	err = state.azureClient.CreateBlobContainer(ctx, state.resourceGroupName, state.GetStorageAccountName(), state.GetBlobContainerName())
+	if err != nil {
+		composed.LoggerFromCtx(ctx).Error(err, "Error creating Azure blob container for redis backup")
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching AzureRedisInstanceBackup status with blob container create error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
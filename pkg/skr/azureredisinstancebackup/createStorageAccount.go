@@ -0,0 +1,64 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureutil "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/util"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// createStorageAccount creates the storage account the redis data is exported into. It is shared
+// by all redis backups of the scope and is deleted together with the last backup.
+func createStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+	if state.storageAccount != nil {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Creating Azure storage account for redis backups", "storageAccount", state.GetStorageAccountName())
+
+	err := state.azureClient.CreateStorageAccount(ctx, state.resourceGroupName, state.GetStorageAccountName(), armstorage.AccountCreateParameters{
+		Kind:     ptr.To(armstorage.KindStorageV2),
+		Location: new(state.Scope().Spec.Region),
+		SKU: &armstorage.SKU{
+			Name: ptr.To(armstorage.SKUNameStandardLRS),
+		},
+		Properties: &armstorage.AccountPropertiesCreateParameters{
+			AllowBlobPublicAccess:  new(false),
+			EnableHTTPSTrafficOnly: new(true),
+			MinimumTLSVersion:      ptr.To(armstorage.MinimumTLSVersionTLS12),
+		},
+		Tags: azureutil.AzureTags(map[string]string{
+			common.TagScope: state.Scope().Name,
+			common.TagShoot: state.Scope().Spec.ShootName,
+		}),
+	})
+	if err != nil {
+		composed.LoggerFromCtx(ctx).Error(err, "Error creating Azure storage account for redis backups")
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching AzureRedisInstanceBackup status with storage account create error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
@@ -0,0 +1,44 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteBlobContainer(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	if !composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+	if state.storageAccount == nil {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Deleting Azure blob container of redis backup", "container", state.GetBlobContainerName())
+
+	err := state.azureClient.DeleteBlobContainer(ctx, state.resourceGroupName, state.GetStorageAccountName(), state.GetBlobContainerName())
+	if err != nil && !azuremeta.IsNotFound(err) {
+		composed.LoggerFromCtx(ctx).Error(err, "Error deleting Azure blob container of redis backup")
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching AzureRedisInstanceBackup status with blob container delete error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,58 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// deleteStorageAccount deletes the storage account shared by the redis backups when the last
+// AzureRedisInstanceBackup in the cluster is deleted.
+func deleteStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	if !composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+	if state.storageAccount == nil {
+		return nil, ctx
+	}
+
+	list := &cloudresourcesv1beta1.AzureRedisInstanceBackupList{}
+	err := state.Cluster().K8sClient().List(ctx, list)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error listing AzureRedisInstanceBackups", composed.StopWithRequeue, ctx)
+	}
+	for _, item := range list.Items {
+		if item.UID != backup.UID {
+			return nil, ctx
+		}
+	}
+
+	logger.Info("Deleting Azure storage account of redis backups", "storageAccount", state.GetStorageAccountName())
+
+	err = state.azureClient.DeleteStorageAccount(ctx, state.resourceGroupName, state.GetStorageAccountName())
+	if err != nil && !azuremeta.IsNotFound(err) {
+		logger.Error(err, "Error deleting Azure storage account of redis backups")
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching AzureRedisInstanceBackup status with storage account delete error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,64 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// exportSasValidity is how long the container SAS token given to the export operation is valid
+const exportSasValidity = 24 * time.Hour
+
+func exportRedisInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+
+	// export was already started and its outcome recorded
+	if len(backup.Status.Id) > 0 {
+		return composed.StopAndForget, nil
+	}
+
+	sasUrl, err := state.azureClient.GetBlobContainerSasUrl(ctx, state.resourceGroupName, state.GetStorageAccountName(), state.GetBlobContainerName(), time.Now().Add(exportSasValidity))
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error getting Azure blob container SAS url", composed.StopWithRequeue, ctx)
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Exporting Azure Redis instance", "container", state.GetBlobContainerName())
+
+	resumeToken, err := state.azureClient.ExportRedisInstanceData(ctx, state.resourceGroupName, state.kcpRedisInstance.Status.Id, sasUrl, backup.Name)
+	if err != nil {
+		composed.LoggerFromCtx(ctx).Error(err, "Error exporting Azure Redis instance")
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonCloudProviderError,
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching AzureRedisInstanceBackup status with export error").
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+
+	// the container url without the SAS token
+	containerUrl, _, _ := strings.Cut(sasUrl, "?")
+
+	backup.Status.Id = containerUrl
+	backup.Status.OpIdentifier = resumeToken
+	backup.SetState(cloudresourcesv1beta1.StateCreating)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions().
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T10000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,50 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func loadKcpRedisInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	//If the object is being deleted continue...
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, nil
+	}
+
+	kcpRedisInstance := &cloudcontrolv1beta1.RedisInstance{}
+	err := state.KcpCluster().K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: state.KymaRef().Namespace,
+		Name:      state.skrRedisInstance.Status.Id,
+	}, kcpRedisInstance)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading KCP RedisInstance", composed.StopWithRequeue, ctx)
+	}
+
+	if err == nil && len(kcpRedisInstance.Status.Id) > 0 {
+		state.kcpRedisInstance = kcpRedisInstance
+		return nil, nil
+	}
+
+	backup.SetState(cloudresourcesv1beta1.StateError)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonMissingRedisInstance,
+			Message: fmt.Sprintf("RedisInstance %s does not exist", state.skrRedisInstance.Status.Id),
+		}).
+		ErrorLogMessage("Failed updating AzureRedisInstanceBackup status with error state due to missing RedisInstance").
+		SuccessLogMsg("Forgetting AzureRedisInstanceBackup with missing RedisInstance").
+		FailedError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,24 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azuremeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/meta"
+)
+
+func loadStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	account, err := state.azureClient.GetStorageAccount(ctx, state.resourceGroupName, state.GetStorageAccountName())
+	if azuremeta.IsNotFound(err) {
+		return nil, ctx
+	}
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading Azure storage account", composed.StopWithRequeue, ctx)
+	}
+
+	state.storageAccount = account
+
+	return nil, ctx
+}
@@ -0,0 +1,82 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
+	azureredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/redisbackup/client"
+	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	commonscope.State
+	azureClientProvider azureclient.ClientProvider[azureredisbackupclient.Client]
+	env                 abstractions.Environment
+
+	azureClient       azureredisbackupclient.Client
+	resourceGroupName string
+	storageAccount    *armstorage.Account
+	skrRedisInstance  *cloudresourcesv1beta1.AzureRedisInstance
+	kcpRedisInstance  *cloudcontrolv1beta1.RedisInstance
+}
+
+func newStateFactory(
+	composedStateFactory composed.StateFactory,
+	commonScopeStateFactory commonscope.StateFactory,
+	azureClientProvider azureclient.ClientProvider[azureredisbackupclient.Client],
+	env abstractions.Environment,
+) *stateFactory {
+	return &stateFactory{
+		composedStateFactory:    composedStateFactory,
+		commonScopeStateFactory: commonScopeStateFactory,
+		azureClientProvider:     azureClientProvider,
+		env:                     env,
+	}
+}
+
+type stateFactory struct {
+	composedStateFactory    composed.StateFactory
+	commonScopeStateFactory commonscope.StateFactory
+	azureClientProvider     azureclient.ClientProvider[azureredisbackupclient.Client]
+	env                     abstractions.Environment
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	scopeState, err := f.commonScopeStateFactory.NewState(
+		ctx,
+		req.NamespacedName,
+		f.composedStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.AzureRedisInstanceBackup{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:               scopeState,
+		azureClientProvider: f.azureClientProvider,
+		env:                 f.env,
+	}, nil
+}
+
+func (s *State) ObjAsAzureRedisInstanceBackup() *cloudresourcesv1beta1.AzureRedisInstanceBackup {
+	return s.Obj().(*cloudresourcesv1beta1.AzureRedisInstanceBackup)
+}
+
+// GetStorageAccountName returns the name of the storage account shared by all redis backups
+// in the cloud-manager resource group. Storage account names are globally unique, 3-24 characters
+// long, and can only contain lowercase letters and numbers.
+func (s *State) GetStorageAccountName() string {
+	hash := sha256.Sum256([]byte(s.Scope().Spec.Scope.Azure.SubscriptionId + "/" + s.resourceGroupName))
+	return fmt.Sprintf("cmrb%x", hash)[:24]
+}
+
+func (s *State) GetBlobContainerName() string {
+	return fmt.Sprintf("cm-%s", s.Obj().GetUID())
+}
@@ -0,0 +1,42 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func stopIfRedisInstanceNotReady(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsAzureRedisInstanceBackup()
+
+	//If the object is being deleted continue...
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, nil
+	}
+
+	// once the snapshot is started the source readiness is no longer relevant
+	if len(backup.Status.Id) > 0 {
+		return nil, nil
+	}
+
+	if meta.IsStatusConditionTrue(state.skrRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) {
+		return nil, nil
+	}
+
+	backup.SetState(cloudresourcesv1beta1.StateError)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonRedisInstanceNotReady,
+			Message: "The AzureRedisInstance is not ready",
+		}).
+		ErrorLogMessage("Failed updating AzureRedisInstanceBackup error status with RedisInstanceNotReady condition").
+		SuccessLogMsg("Forgetting AzureRedisInstanceBackup with AzureRedisInstance not ready").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
@@ -0,0 +1,27 @@
+package azureredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+func waitStorageAccountAvailable(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if composed.IsMarkedForDeletion(state.Obj()) {
+		return nil, ctx
+	}
+
+	if state.storageAccount.Properties != nil &&
+		ptr.Deref(state.storageAccount.Properties.ProvisioningState, "") == armstorage.ProvisioningStateSucceeded {
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Azure storage account for redis backups is not available yet")
+
+	return composed.StopWithRequeueDelay(util.Timing.T10000ms()), nil
+}
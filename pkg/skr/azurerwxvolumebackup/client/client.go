@@ -5,6 +5,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
 )
 
@@ -19,6 +20,7 @@ type Client interface {
 	ProtectedItemsClient
 	BackupProtectedItemsClient
 	VaultConfigClient
+	FileShareClient
 }
 
 type client struct {
@@ -32,6 +34,7 @@ type client struct {
 	ProtectedItemsClient
 	BackupProtectedItemsClient
 	VaultConfigClient
+	FileShareClient
 }
 
 func NewClientProvider() azureclient.ClientProvider[Client] {
@@ -55,6 +58,12 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 			return nil, err
 		}
 
+		fileSharesClient, err := armstorage.NewFileSharesClient(subscriptionId, cred, azureclient.NewClientOptionsBuilder().Build())
+
+		if err != nil {
+			return nil, err
+		}
+
 		c = client{
 			NewVaultClient(recoveryServicesFactory.NewVaultsClient()),
 			NewBackupClient(recoveryServicesBackupFactory.NewBackupsClient()),
@@ -70,6 +79,7 @@ func NewClientProvider() azureclient.ClientProvider[Client] {
 				recoveryServicesBackupFactory.NewBackupProtectionContainersClient(),
 				recoveryServicesBackupFactory.NewProtectionContainersClient(),
 			),
+			&fileShareClient{azureFsClient: fileSharesClient},
 		}
 
 		return c, nil
@@ -35,6 +35,8 @@ const pvVolumeHandlePattern = "(?<resourceGroupName>[^\\#]*)#(?<storageAccountNa
 const recoverPointIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)\\/protectedItems\\/(?<protectedItem>[^\\/]*)\\/recoveryPoints\\/(?<recoveryPointId>[^\\/]*)"
 const vaultIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)"
 const protectedItemIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)\\/protectedItems\\/AzureFileShare;(?<protectedItem>[^\\/]*)"
+const protectedItemPathIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)\\/protectedItems\\/(?<protectedItem>[^\\/]*)$"
+const storageAccountIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.Storage\\/storageAccounts\\/(?<storageAccount>[^\\/]*)"
 const containerIdPattern = "\\/subscriptions\\/(?<subscription>[^\\/]*)\\/resourceGroups\\/(?<resourceGroup>[^\\/]*)\\/providers\\/Microsoft.RecoveryServices\\/vaults\\/(?<vault>[^\\/]*)\\/backupFabrics\\/Azure\\/protectionContainers\\/(?<container>[^\\/]*)"
 
 const (
@@ -45,10 +47,12 @@ const (
 	fileShareNamePattern      = "AzureFileShare;%v"
 	recoveryPointPathPattern  = "/subscriptions/%v/resourceGroups/%v/providers/Microsoft.RecoveryServices/vaults/%v/backupFabrics/Azure/protectionContainers/%v/protectedItems/%v/recoveryPoints/%v"
 	fileSharePathPattern      = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/Azure/protectionContainers/%s/protectedItems/AzureFileShare;%s"
+	protectedItemPathPattern  = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupFabrics/Azure/protectionContainers/%s/protectedItems/%s"
+	pvVolumeHandleFormat      = "%s#%s#%s###%s"
 )
 
-const storageProvisionerKey = "volume.kubernetes.io/storage-provisioner"
-const azureFileShareProvisioner = "file.csi.azure.com"
+const StorageProvisionerKey = "volume.kubernetes.io/storage-provisioner"
+const AzureFileShareProvisioner = "file.csi.azure.com"
 
 const AzureFabricName = "Azure"
 const TagNameCloudManager = "cloud-manager"
@@ -85,6 +89,16 @@ func GetFileSharePath(subscriptionId, resourceGroupName, vaultName, containerNam
 	return fmt.Sprintf(fileSharePathPattern, subscriptionId, resourceGroupName, vaultName, containerName, fileShareName)
 }
 
+// GetProtectedItemPath returns the id of the protected item with the given name, e.g. "AzureFileShare;<id>".
+func GetProtectedItemPath(subscriptionId, resourceGroupName, vaultName, containerName, protectedItemName string) string {
+	return fmt.Sprintf(protectedItemPathPattern, subscriptionId, resourceGroupName, vaultName, containerName, protectedItemName)
+}
+
+// GetPvVolumeHandle returns the volume handle of a statically provisioned Azure File CSI PV.
+func GetPvVolumeHandle(resourceGroupName, storageAccountName, fileShareName, secretNamespace string) string {
+	return fmt.Sprintf(pvVolumeHandleFormat, resourceGroupName, storageAccountName, fileShareName, secretNamespace)
+}
+
 func ParsePvVolumeHandle(pvVolumeHandle string) (resourceGroupName string, storageAccountName string, fileShareName string, uuid string, secretNamespace string, err error) {
 	re := regexp.MustCompile(pvVolumeHandlePattern)
 	match := re.FindStringSubmatch(pvVolumeHandle)
@@ -116,8 +130,8 @@ func ParseRecoveryPointId(recoveryPointId string) (subscription string, resource
 }
 
 func IsPvcProvisionerAzureCsiDriver(annotations map[string]string) bool {
-	provisioner, ok := annotations[storageProvisionerKey]
-	return ok && provisioner == azureFileShareProvisioner
+	provisioner, ok := annotations[StorageProvisionerKey]
+	return ok && provisioner == AzureFileShareProvisioner
 }
 
 func AzureStorageErrorInfoToJson(details []*armrecoveryservicesbackup.AzureStorageErrorInfo) (string, error) {
@@ -158,6 +172,38 @@ func ParseProtectedItemId(protectedId string) (subscription string, resourceGrou
 	return result["subscription"], result["resourceGroup"], result["vault"], result["container"], result["protectedItem"], nil
 }
 
+// ParseProtectedItemPath is the inverse of GetProtectedItemPath. Unlike ParseProtectedItemId the
+// returned protectedItem keeps its type prefix.
+func ParseProtectedItemPath(protectedItemPath string) (subscription string, resourceGroup string, vault string, container string, protectedItem string, err error) {
+	re := regexp.MustCompile(protectedItemPathIdPattern)
+	match := re.FindStringSubmatch(protectedItemPath)
+	if match == nil {
+		return "", "", "", "", "", fmt.Errorf("protectedItemPath %s does not match pattern %s", protectedItemPath, protectedItemPathIdPattern)
+	}
+	result := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if i != 0 && name != "" {
+			result[name] = match[i]
+		}
+	}
+	return result["subscription"], result["resourceGroup"], result["vault"], result["container"], result["protectedItem"], nil
+}
+
+func ParseStorageAccountPath(storageAccountPath string) (subscription string, resourceGroup string, storageAccount string, err error) {
+	re := regexp.MustCompile(storageAccountIdPattern)
+	match := re.FindStringSubmatch(storageAccountPath)
+	if match == nil {
+		return "", "", "", fmt.Errorf("storage account path %s does not match pattern %s", storageAccountPath, storageAccountIdPattern)
+	}
+	result := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if i != 0 && name != "" {
+			result[name] = match[i]
+		}
+	}
+	return result["subscription"], result["resourceGroup"], result["storageAccount"], nil
+}
+
 func ParseContainerId(containerId string) (subscription string, resourceGroup string, vault string, container string, err error) {
 	re := regexp.MustCompile(containerIdPattern)
 	match := re.FindStringSubmatch(containerId)
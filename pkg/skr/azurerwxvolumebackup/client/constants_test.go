@@ -30,6 +30,44 @@ func (s *constantsSuite) TestParsePvVolumeHandle() {
 	s.Equal("default", secretNamespace)
 }
 
+func (s *constantsSuite) TestGetPvVolumeHandle() {
+	volumeHandle := GetPvVolumeHandle("test-rg", "testsa", "restore-share", "test-ns")
+	s.Equal("test-rg#testsa#restore-share###test-ns", volumeHandle)
+	resourceGroupName, storageAccountName, fileShareName, _, secretNamespace, err := ParsePvVolumeHandle(volumeHandle)
+	s.Nil(err)
+	s.Equal("test-rg", resourceGroupName)
+	s.Equal("testsa", storageAccountName)
+	s.Equal("restore-share", fileShareName)
+	s.Equal("test-ns", secretNamespace)
+}
+
+func (s *constantsSuite) TestParseStorageAccountPath() {
+	samplePath := "/subscriptions/3f1d2fbd-117a-4742-8bde-6edbcdee6a04/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/testsa"
+	subscription, resourceGroup, storageAccount, err := ParseStorageAccountPath(samplePath)
+	s.Nil(err)
+	s.Equal("3f1d2fbd-117a-4742-8bde-6edbcdee6a04", subscription)
+	s.Equal("test-rg", resourceGroup)
+	s.Equal("testsa", storageAccount)
+
+	_, _, _, err = ParseStorageAccountPath("invalid")
+	s.NotNil(err)
+}
+
+func (s *constantsSuite) TestGetProtectedItemPath() {
+	path := GetProtectedItemPath("3f1d2fbd-117a-4742-8bde-6edbcdee6a04", "test-rg", "test-vault", "StorageContainer;Storage;test-rg;testsa", "AzureFileShare;2DAC3CBD")
+	s.Equal("/subscriptions/3f1d2fbd-117a-4742-8bde-6edbcdee6a04/resourceGroups/test-rg/providers/Microsoft.RecoveryServices/vaults/test-vault/backupFabrics/Azure/protectionContainers/StorageContainer;Storage;test-rg;testsa/protectedItems/AzureFileShare;2DAC3CBD", path)
+	subscription, resourceGroup, vault, container, protectedItem, err := ParseProtectedItemPath(path)
+	s.Nil(err)
+	s.Equal("3f1d2fbd-117a-4742-8bde-6edbcdee6a04", subscription)
+	s.Equal("test-rg", resourceGroup)
+	s.Equal("test-vault", vault)
+	s.Equal("StorageContainer;Storage;test-rg;testsa", container)
+	s.Equal("AzureFileShare;2DAC3CBD", protectedItem)
+
+	_, _, _, _, _, err = ParseProtectedItemPath("invalid")
+	s.NotNil(err)
+}
+
 func (s *constantsSuite) TestGetStorageAccountPath() {
 	samplePath := "/subscriptions/3f1d2fbd-117a-4742-8bde-6edbcdee6a04/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/testsa"
 	s.Equal(samplePath, GetStorageAccountPath("3f1d2fbd-117a-4742-8bde-6edbcdee6a04", "test-rg", "testsa"))
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+)
+
+var (
+	fileShareMock = newFileShareMockClient()
+)
+
+func newFileShareMockClient() *fileShareMockClient {
+	return &fileShareMockClient{
+		fileShares: map[string]*armstorage.FileShareItem{},
+	}
+}
+
+// fileShareMockClient keeps file shares by resource group, storage account and share name,
+// so lookups do not depend on the secret namespace part of the volume handle
+type fileShareMockClient struct {
+	m          sync.Mutex
+	fileShares map[string]*armstorage.FileShareItem
+}
+
+func fileShareMockKey(resourceGroupName, storageAccountName, fileShareName string) string {
+	return fmt.Sprintf("%s/%s/%s", resourceGroupName, storageAccountName, fileShareName)
+}
+
+func (m *fileShareMockClient) addFileShare(resourceGroupName, storageAccountName, fileShareName string) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.fileShares[fileShareMockKey(resourceGroupName, storageAccountName, fileShareName)] = &armstorage.FileShareItem{
+		Name: new(fileShareName),
+		ID:   new(GetStorageAccountPath("", resourceGroupName, storageAccountName) + "/fileServices/default/shares/" + fileShareName),
+	}
+}
+
+func (m *fileShareMockClient) CreateFileShare(_ context.Context, id string) error {
+	resourceGroupName, storageAccountName, fileShareName, _, _, err := ParsePvVolumeHandle(id)
+	if err != nil {
+		return err
+	}
+	m.addFileShare(resourceGroupName, storageAccountName, fileShareName)
+	return nil
+}
+
+func (m *fileShareMockClient) GetFileShare(_ context.Context, id string) (*armstorage.FileShareItem, error) {
+	resourceGroupName, storageAccountName, fileShareName, _, _, err := ParsePvVolumeHandle(id)
+	if err != nil {
+		return nil, err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.fileShares[fileShareMockKey(resourceGroupName, storageAccountName, fileShareName)], nil
+}
+
+func (m *fileShareMockClient) DeleteFileShare(_ context.Context, id string) error {
+	resourceGroupName, storageAccountName, fileShareName, _, _, err := ParsePvVolumeHandle(id)
+	if err != nil {
+		return err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	delete(m.fileShares, fileShareMockKey(resourceGroupName, storageAccountName, fileShareName))
+	return nil
+}
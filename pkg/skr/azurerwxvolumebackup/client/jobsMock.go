@@ -15,6 +15,7 @@ var (
 
 type restoreJob struct {
 	jobId             string
+	fileShareName     string
 	restoreFolderPath string
 	status            string
 	finalStatus       string
@@ -37,13 +38,15 @@ type jobsMockClient struct {
 	backupJobs  []*backupJob
 }
 
-func (m *jobsMockClient) FindRestoreJobId(_ context.Context, _ string, _ string, _ string, _ string, restoreFolderPath string) (*string, bool, error) {
+func (m *jobsMockClient) FindRestoreJobId(_ context.Context, _ string, _ string, fileShareName string, _ string, restoreFolderPath string) (*string, bool, error) {
 	retry := false
-	if restoreFolderPath == "" {
-		return nil, false, errors.New("restoreFolderPath is empty")
+	if restoreFolderPath == "" && fileShareName == "" {
+		return nil, false, errors.New("restoreFolderPath and fileShareName are empty")
 	}
 	for _, job := range m.restoreJobs {
-		if job.restoreFolderPath == restoreFolderPath {
+		// restores into the root of a new file share are identified by the file share name
+		if (restoreFolderPath != "" && job.restoreFolderPath == restoreFolderPath) ||
+			(restoreFolderPath == "" && job.restoreFolderPath == "" && job.fileShareName == fileShareName) {
 			if job.status != string(armrecoveryservicesbackup.JobStatusInProgress) {
 				return &job.jobId, false, nil
 			}
@@ -122,9 +125,10 @@ func (m *jobsMockClient) GetStorageJob(_ context.Context, _ string,
 	return nil, err
 }
 
-func (m *jobsMockClient) AddRestoreJob(jobId, restoreFolderPath string, status, finalStatus armrecoveryservicesbackup.JobStatus) {
+func (m *jobsMockClient) AddRestoreJob(jobId, fileShareName, restoreFolderPath string, status, finalStatus armrecoveryservicesbackup.JobStatus) {
 	m.restoreJobs = append(m.restoreJobs, &restoreJob{
 		jobId:             jobId,
+		fileShareName:     fileShareName,
 		restoreFolderPath: restoreFolderPath,
 		status:            string(status),
 		finalStatus:       string(finalStatus),
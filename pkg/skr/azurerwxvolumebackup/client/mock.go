@@ -14,6 +14,7 @@ func NewMockClient() azureclient.ClientProvider[Client] {
 		restoreMock = &restoreMockClient{
 			restoreClient: *newRestoreMockClient(),
 		}
+		fileShareMock = newFileShareMockClient()
 
 		// TODO: is this okay initialization?
 		vaultMock := &vaultMockClient{vaultClient: *newVaultMockClient()}
@@ -23,18 +24,20 @@ func NewMockClient() azureclient.ClientProvider[Client] {
 		protectionPoliciesMock := &protectionPoliciesMockClient{protectionPoliciesClient: *newProtectionPoliciesMockClient()}
 		backupProtectableItemsMock := &backupProtectableItemsMockClient{backupProtectableItemsClient: *newBackupProtectableItemsMockClient()}
 		protectedItemsMock := &protectedItemsMockClient{protectedItemsClient: *newProtectedItemsMockClient()}
+		recoveryPointMock := &recoveryPointMockClient{recoveryPointClient: *newRecoveryPointMockClient()}
 
 		return client{
 			vaultMock,
 			backupMock,
 			protectionPoliciesMock,
-			nil,
+			recoveryPointMock,
 			jobsMock,
 			restoreMock,
 			backupProtectableItemsMock,
 			protectedItemsMock,
 			backupProtectedItemsMock,
 			newVaultConfigMockClient(),
+			fileShareMock,
 		}, nil
 	}
 }
@@ -0,0 +1,56 @@
+package client
+
+// pairedRegions maps an Azure region to its paired region, where geo-redundant
+// Recovery Services vaults replicate the backup data to.
+// See https://learn.microsoft.com/en-us/azure/reliability/cross-region-replication-azure
+var pairedRegions = map[string]string{
+	"australiacentral":   "australiacentral2",
+	"australiacentral2":  "australiacentral",
+	"australiaeast":      "australiasoutheast",
+	"australiasoutheast": "australiaeast",
+	"brazilsouth":        "southcentralus",
+	"canadacentral":      "canadaeast",
+	"canadaeast":         "canadacentral",
+	"centralindia":       "southindia",
+	"centralus":          "eastus2",
+	"eastasia":           "southeastasia",
+	"eastus":             "westus",
+	"eastus2":            "centralus",
+	"francecentral":      "francesouth",
+	"francesouth":        "francecentral",
+	"germanynorth":       "germanywestcentral",
+	"germanywestcentral": "germanynorth",
+	"japaneast":          "japanwest",
+	"japanwest":          "japaneast",
+	"koreacentral":       "koreasouth",
+	"koreasouth":         "koreacentral",
+	"northcentralus":     "southcentralus",
+	"northeurope":        "westeurope",
+	"norwayeast":         "norwaywest",
+	"norwaywest":         "norwayeast",
+	"southafricanorth":   "southafricawest",
+	"southafricawest":    "southafricanorth",
+	"southcentralus":     "northcentralus",
+	"southeastasia":      "eastasia",
+	"southindia":         "centralindia",
+	"swedencentral":      "swedensouth",
+	"swedensouth":        "swedencentral",
+	"switzerlandnorth":   "switzerlandwest",
+	"switzerlandwest":    "switzerlandnorth",
+	"uaecentral":         "uaenorth",
+	"uaenorth":           "uaecentral",
+	"uksouth":            "ukwest",
+	"ukwest":             "uksouth",
+	"westcentralus":      "westus2",
+	"westeurope":         "northeurope",
+	"westindia":          "southindia",
+	"westus":             "eastus",
+	"westus2":            "westcentralus",
+	"westus3":            "eastus",
+}
+
+// GetPairedRegion returns the paired region of the given Azure region,
+// or an empty string if the region has no pair.
+func GetPairedRegion(location string) string {
+	return pairedRegions[location]
+}
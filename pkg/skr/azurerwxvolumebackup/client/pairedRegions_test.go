@@ -0,0 +1,15 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPairedRegion(t *testing.T) {
+	assert.Equal(t, "northeurope", GetPairedRegion("westeurope"))
+	assert.Equal(t, "westeurope", GetPairedRegion("northeurope"))
+	assert.Equal(t, "eastus", GetPairedRegion("westus3"))
+	assert.Equal(t, "", GetPairedRegion("qatarcentral"))
+	assert.Equal(t, "", GetPairedRegion(""))
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+)
+
+func newRecoveryPointMockClient() *recoveryPointClient {
+	return &recoveryPointClient{}
+}
+
+type recoveryPointMockClient struct {
+	recoveryPointClient
+}
+
+func (m *recoveryPointMockClient) GetRecoveryPoint(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string, recoveryPointId string) (*armrecoveryservicesbackup.RecoveryPointResource, error) {
+
+	// unhappy path
+	if ctx.Value("GetRecoveryPoint") == "fail" {
+		return nil, errors.New("failed to get recovery point")
+	}
+
+	// happy path
+	return &armrecoveryservicesbackup.RecoveryPointResource{
+		ID: new(GetRecoveryPointPath("subscription", resourceGroupName, vaultName, "storageAccount", protectedItemName, recoveryPointId)),
+		Properties: &armrecoveryservicesbackup.AzureFileShareRecoveryPoint{
+			ObjectType:               new("AzureFileShareRecoveryPoint"),
+			RecoveryPointTime:        new(time.Now()),
+			RecoveryPointTierDetails: mockRecoveryPointTiers(ctx.Value("GetRecoveryPoint") == "vaultTier"),
+		},
+	}, nil
+}
+
+// mockRecoveryPointTiers returns the tiers of a recovery point that is in the snapshot tier,
+// and also in the vault tier if inVaultTier is true.
+func mockRecoveryPointTiers(inVaultTier bool) []*armrecoveryservicesbackup.RecoveryPointTierInformation {
+	tiers := []*armrecoveryservicesbackup.RecoveryPointTierInformation{
+		{
+			Type:   to.Ptr(armrecoveryservicesbackup.RecoveryPointTierTypeInstantRP),
+			Status: to.Ptr(armrecoveryservicesbackup.RecoveryPointTierStatusValid),
+		},
+	}
+	if inVaultTier {
+		tiers = append(tiers, &armrecoveryservicesbackup.RecoveryPointTierInformation{
+			Type:   to.Ptr(armrecoveryservicesbackup.RecoveryPointTierTypeHardenedRP),
+			Status: to.Ptr(armrecoveryservicesbackup.RecoveryPointTierStatusValid),
+		})
+	}
+	return tiers
+}
+
+func (m *recoveryPointMockClient) ListRecoveryPoints(ctx context.Context, vaultName string, resourceGroupName string, fabricName string, containerName string, protectedItemName string) ([]*armrecoveryservicesbackup.RecoveryPointResource, error) {
+
+	// unhappy path
+	if ctx.Value("ListRecoveryPoints") == "fail" {
+		return nil, errors.New("failed to list recovery points")
+	}
+
+	// happy path
+	return []*armrecoveryservicesbackup.RecoveryPointResource{
+		{
+			ID: new(GetRecoveryPointPath("subscription", resourceGroupName, vaultName, "storageAccount", protectedItemName, "1234567890")),
+			Properties: &armrecoveryservicesbackup.AzureFileShareRecoveryPoint{
+				ObjectType:               new("AzureFileShareRecoveryPoint"),
+				RecoveryPointTime:        new(time.Now()),
+				RecoveryPointTierDetails: mockRecoveryPointTiers(ctx.Value("ListRecoveryPoints") == "vaultTier"),
+			},
+		},
+	}, nil
+}
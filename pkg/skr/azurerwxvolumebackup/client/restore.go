@@ -50,24 +50,28 @@ func (c restoreClient) TriggerRestore(ctx context.Context,
 	request RestoreRequest,
 ) (*string, error) {
 	logger := composed.LoggerFromCtx(ctx).WithName("restoreClient - TriggerRestore")
-	parameters := armrecoveryservicesbackup.RestoreRequestResource{
-		Properties: new(armrecoveryservicesbackup.AzureFileShareRestoreRequest{
-			ObjectType:   new("AzureFileShareRestoreRequest"),
-			CopyOptions:  to.Ptr(armrecoveryservicesbackup.CopyOptionsOverwrite),
-			RecoveryType: to.Ptr(armrecoveryservicesbackup.RecoveryTypeAlternateLocation),
-			RestoreFileSpecs: []*armrecoveryservicesbackup.RestoreFileSpecs{
-				{
-					TargetFolderPath: new(request.TargetFolderName),
-				},
-			},
-			RestoreRequestType: to.Ptr(armrecoveryservicesbackup.RestoreRequestTypeFullShareRestore),
-			SourceResourceID:   new(request.SourceStorageAccountPath), // Source file share arm id
-			TargetDetails: new(armrecoveryservicesbackup.TargetAFSRestoreInfo{
-				Name:             new(request.TargetFileShareName),      // Target File share name
-				TargetResourceID: new(request.TargetStorageAccountPath), // Target file share arm id
-			}),
+	restoreRequest := &armrecoveryservicesbackup.AzureFileShareRestoreRequest{
+		ObjectType:         new("AzureFileShareRestoreRequest"),
+		CopyOptions:        to.Ptr(armrecoveryservicesbackup.CopyOptionsOverwrite),
+		RecoveryType:       to.Ptr(armrecoveryservicesbackup.RecoveryTypeAlternateLocation),
+		RestoreRequestType: to.Ptr(armrecoveryservicesbackup.RestoreRequestTypeFullShareRestore),
+		SourceResourceID:   new(request.SourceStorageAccountPath), // Source file share arm id
+		TargetDetails: new(armrecoveryservicesbackup.TargetAFSRestoreInfo{
+			Name:             new(request.TargetFileShareName),      // Target File share name, created if it does not exist
+			TargetResourceID: new(request.TargetStorageAccountPath), // Target file share arm id
 		}),
 	}
+	// Without a target folder the backup is restored into the root of the target file share
+	if request.TargetFolderName != "" {
+		restoreRequest.RestoreFileSpecs = []*armrecoveryservicesbackup.RestoreFileSpecs{
+			{
+				TargetFolderPath: new(request.TargetFolderName),
+			},
+		}
+	}
+	parameters := armrecoveryservicesbackup.RestoreRequestResource{
+		Properties: restoreRequest,
+	}
 
 	poller, err := c.BeginTrigger(
 		ctx,
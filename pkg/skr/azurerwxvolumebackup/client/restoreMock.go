@@ -28,6 +28,11 @@ func (c restoreMockClient) TriggerRestore(_ context.Context,
 	if request.ResourceGroupName != "" {
 		nextJobStatus = armrecoveryservicesbackup.JobStatus(request.ResourceGroupName)
 	}
-	jobsMock.AddRestoreJob(request.VaultName, request.TargetFolderName, armrecoveryservicesbackup.JobStatusInProgress, nextJobStatus)
+	jobsMock.AddRestoreJob(request.VaultName, request.TargetFileShareName, request.TargetFolderName, armrecoveryservicesbackup.JobStatusInProgress, nextJobStatus)
+
+	// Azure creates the target file share if it does not exist yet
+	if _, resourceGroupName, storageAccountName, err := ParseStorageAccountPath(request.TargetStorageAccountPath); err == nil {
+		fileShareMock.addFileShare(resourceGroupName, storageAccountName, request.TargetFileShareName)
+	}
 	return &request.VaultName, nil
 }
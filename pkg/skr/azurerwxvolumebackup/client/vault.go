@@ -32,7 +32,9 @@ func NewVaultClient(vc *armrecoveryservices.VaultsClient) VaultClient {
 	return vaultClient{vc}
 }
 
-// Returns operationId used to check the status
+// Returns operationId used to check the status.
+// The vault is created geo-redundant with cross region restore enabled, so its recovery points are available
+// in the paired region. The storage redundancy can not be changed once the vault protects any items.
 func (c vaultClient) CreateVault(ctx context.Context, resourceGroupName string, vaultName string, location string) (*string, error) {
 	logger := composed.LoggerFromCtx(ctx).WithName("vaultClient - CreateVault")
 
@@ -44,6 +46,10 @@ func (c vaultClient) CreateVault(ctx context.Context, resourceGroupName string,
 			Location: new(location),
 			Properties: new(armrecoveryservices.VaultProperties{
 				PublicNetworkAccess: to.Ptr(armrecoveryservices.PublicNetworkAccessEnabled),
+				RedundancySettings: new(armrecoveryservices.VaultPropertiesRedundancySettings{
+					StandardTierStorageRedundancy: to.Ptr(armrecoveryservices.StandardTierStorageRedundancyGeoRedundant),
+					CrossRegionRestore:            to.Ptr(armrecoveryservices.CrossRegionRestoreEnabled),
+				}),
 			}),
 			SKU: new(armrecoveryservices.SKU{
 				Name: to.Ptr(armrecoveryservices.SKUNameStandard),
@@ -60,6 +60,9 @@ func createBackup(ctx context.Context, st composed.State) (error, context.Contex
 
 	}
 
+	// Copies stay pending until the recovery point of the protected item is replicated to the paired region, see updateBackupCopies
+	backup.Status.ProtectedItemPath = azurerwxvolumebackupclient.GetProtectedItemPath(state.subscriptionId, resourceGroupName, vaultName, containerName, protectedItemName)
+
 	backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupDone // TODO: redo with Creating
 	return composed.UpdateStatus(backup).SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).Run(ctx, state)
 }
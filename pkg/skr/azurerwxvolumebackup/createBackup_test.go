@@ -143,6 +143,23 @@ func TestCreateBackup(t *testing.T) {
 
 			})
 
+			t.Run("copies stay pending once the backup is done", func(t *testing.T) {
+
+				backup.Status.Id = "asdf"
+				backup.Status.Copies = []cloudresourcesv1beta1.BackupCopyStatus{
+					{Location: "northeurope", State: cloudresourcesv1beta1.StateCreating},
+				}
+
+				err, _ := createBackup(ctx, state)
+
+				assert.Equal(t, composed.StopWithRequeue, err)
+				assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+				assert.Empty(t, backup.Status.Copies[0].Id)
+				_, _, _, _, _, err = azurerwxvolumebackupclient.ParseProtectedItemPath(backup.Status.ProtectedItemPath)
+				assert.Nil(t, err)
+
+			})
+
 		})
 
 	})
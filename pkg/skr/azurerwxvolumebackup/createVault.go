@@ -8,9 +8,9 @@ import (
 	"slices"
 )
 
-func vaultExists(vaults []*armrecoveryservices.Vault, location string) bool {
+func findVault(vaults []*armrecoveryservices.Vault, location string) *armrecoveryservices.Vault {
 
-	return slices.ContainsFunc(vaults, func(vault *armrecoveryservices.Vault) bool {
+	idx := slices.IndexFunc(vaults, func(vault *armrecoveryservices.Vault) bool {
 
 		if vault.Location == nil || vault.Tags == nil {
 			return false
@@ -21,6 +21,11 @@ func vaultExists(vaults []*armrecoveryservices.Vault, location string) bool {
 		return *vault.Location == location && tagExists
 
 	})
+	if idx < 0 {
+		return nil
+	}
+
+	return vaults[idx]
 
 }
 
@@ -44,7 +49,8 @@ func createVault(ctx context.Context, st composed.State) (error, context.Context
 	state.vaultName = vaultName
 
 	// If exists, exit early and go next action
-	if vaultExists(vaults, location) {
+	if vault := findVault(vaults, location); vault != nil {
+		state.vault = vault
 		return nil, ctx
 	}
 
@@ -0,0 +1,78 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func vaultHasCrossRegionRestore(vault *armrecoveryservices.Vault) bool {
+	if vault == nil || vault.Properties == nil || vault.Properties.RedundancySettings == nil {
+		return false
+	}
+	return ptr.Deref(vault.Properties.RedundancySettings.CrossRegionRestore, "") == armrecoveryservices.CrossRegionRestoreEnabled
+}
+
+// initBackupCopies adds the pending copy of the backup when it has to be copied to another region.
+// The geo-redundant vault replicates its recovery points to the paired region, so that is the only supported copy location.
+func initBackupCopies(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsAzureRwxVolumeBackup()
+
+	if len(backup.Spec.CopyTo) == 0 {
+		return nil, ctx
+	}
+
+	pairedRegion := azurerwxvolumebackupclient.GetPairedRegion(backup.Spec.Location)
+	for _, location := range backup.Spec.CopyTo {
+		if location == pairedRegion {
+			continue
+		}
+		logger.Info("Backup copy location is not the paired region", "location", location, "pairedRegion", pairedRegion)
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupFailed
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonInvalidCopyLocation,
+				Message: fmt.Sprintf("Backups in %s can only be copied to the paired region %q", backup.Spec.Location, pairedRegion),
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	if cloudresourcesv1beta1.FindBackupCopy(backup.Status.Copies, pairedRegion) != nil {
+		return nil, ctx
+	}
+
+	// Vaults are created with cross region restore enabled, but vaults created before that can not be
+	// switched to it once they protect items.
+	if state.vault != nil && !vaultHasCrossRegionRestore(state.vault) {
+		logger.Info("Recovery Services vault does not have cross region restore enabled", "vault", state.vaultName)
+		backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupFailed
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonInvalidCopyLocation,
+				Message: fmt.Sprintf("Backups in %s can not be copied to %q since the vault does not have cross region restore enabled", backup.Spec.Location, pairedRegion),
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	backup.Status.Copies = cloudresourcesv1beta1.SetBackupCopy(backup.Status.Copies, cloudresourcesv1beta1.BackupCopyStatus{
+		Location: pairedRegion,
+		State:    cloudresourcesv1beta1.StateCreating,
+	})
+	return composed.PatchStatus(backup).
+		SuccessErrorNil().
+		Run(ctx, state)
+}
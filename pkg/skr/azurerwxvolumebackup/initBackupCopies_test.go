@@ -0,0 +1,94 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitBackupCopies(t *testing.T) {
+
+	t.Run("initBackupCopies", func(t *testing.T) {
+
+		ctx := context.Background()
+
+		t.Run("no copies requested", func(t *testing.T) {
+
+			backup := setupDefaultBackup()
+			state := setupDefaultState(ctx, backup)
+
+			err, res := initBackupCopies(ctx, state)
+
+			assert.Nil(t, err)
+			assert.Equal(t, ctx, res)
+			assert.Empty(t, backup.Status.Copies)
+
+		})
+
+		t.Run("copy location is not the paired region", func(t *testing.T) {
+
+			backup := setupDefaultBackup()
+			backup.Spec.Location = "westeurope"
+			backup.Spec.CopyTo = []string{"eastus"}
+			state := setupDefaultState(ctx, backup)
+
+			_, _ = initBackupCopies(ctx, state)
+
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupFailed, backup.Status.State)
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonInvalidCopyLocation, backup.Status.Conditions[0].Reason)
+			assert.Empty(t, backup.Status.Copies)
+
+		})
+
+		t.Run("existing vault without cross region restore", func(t *testing.T) {
+
+			backup := setupDefaultBackup()
+			backup.Spec.Location = "westeurope"
+			backup.Spec.CopyTo = []string{"northeurope"}
+			state := setupDefaultState(ctx, backup)
+			state.vault = &armrecoveryservices.Vault{
+				Properties: &armrecoveryservices.VaultProperties{
+					RedundancySettings: &armrecoveryservices.VaultPropertiesRedundancySettings{
+						StandardTierStorageRedundancy: to.Ptr(armrecoveryservices.StandardTierStorageRedundancyLocallyRedundant),
+						CrossRegionRestore:            to.Ptr(armrecoveryservices.CrossRegionRestoreDisabled),
+					},
+				},
+			}
+
+			_, _ = initBackupCopies(ctx, state)
+
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxBackupFailed, backup.Status.State)
+			assert.Empty(t, backup.Status.Copies)
+
+		})
+
+		t.Run("copy to the paired region", func(t *testing.T) {
+
+			backup := setupDefaultBackup()
+			backup.Spec.Location = "westeurope"
+			backup.Spec.CopyTo = []string{"northeurope"}
+			state := setupDefaultState(ctx, backup)
+
+			_, _ = initBackupCopies(ctx, state)
+
+			assert.Len(t, backup.Status.Copies, 1)
+			assert.Equal(t, "northeurope", backup.Status.Copies[0].Location)
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+
+			// once the copy is tracked, it is not reset
+			backup.Status.Copies[0].Id = "some-id"
+			err, res := initBackupCopies(ctx, state)
+
+			assert.Nil(t, err)
+			assert.Equal(t, ctx, res)
+			assert.Equal(t, "some-id", backup.Status.Copies[0].Id)
+
+		})
+
+	})
+
+}
@@ -53,11 +53,17 @@ func (r *reconciler) newAction() composed.Action {
 				loadPersistentVolume,
 				createClient,
 				createVault,
+				initBackupCopies,
 				getProtectedResourceName,
 				createBackupPolicy,
 				protectFileshare,
 				createBackup,
-			), nil,
+			),
+			composed.If(
+				BackupCopiesPendingPredicate,
+				createClient,
+				updateBackupCopies,
+			),
 		),
 		actions.PatchRemoveCommonFinalizer(),
 		composed.StopAndForgetAction,
@@ -84,3 +90,11 @@ func CompletedOrDeletedPredicate(_ context.Context, state composed.State) bool {
 	return isDeleted || currentState == cloudresourcesv1beta1.AzureRwxBackupDone || currentState == cloudresourcesv1beta1.AzureRwxBackupFailed
 
 }
+
+// BackupCopiesPendingPredicate is true for a done backup whose copies are not yet ready.
+func BackupCopiesPendingPredicate(_ context.Context, state composed.State) bool {
+	backup := state.Obj().(*cloudresourcesv1beta1.AzureRwxVolumeBackup)
+	return !composed.IsMarkedForDeletion(backup) &&
+		backup.Status.State == cloudresourcesv1beta1.AzureRwxBackupDone &&
+		!cloudresourcesv1beta1.AllBackupCopiesReady(backup.Spec.CopyTo, backup.Status.Copies)
+}
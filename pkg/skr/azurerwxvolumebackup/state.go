@@ -3,6 +3,7 @@ package azurerwxvolumebackup
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
@@ -22,6 +23,7 @@ type State struct {
 	fileShareName         string
 	pvc                   *corev1.PersistentVolumeClaim
 	vaultName             string
+	vault                 *armrecoveryservices.Vault // nil if the vault was created in this reconciliation
 	scope                 *cloudcontrolv1beta1.Scope
 	subscriptionId        string
 	protectedResourceName string // TODO: fetch via action
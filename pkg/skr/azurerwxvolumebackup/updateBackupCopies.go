@@ -0,0 +1,120 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v4"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/utils/ptr"
+)
+
+// findBackupRecoveryPoint returns the oldest file share recovery point taken at or after the given time.
+func findBackupRecoveryPoint(recoveryPoints []*armrecoveryservicesbackup.RecoveryPointResource, since time.Time) (*armrecoveryservicesbackup.RecoveryPointResource, *armrecoveryservicesbackup.AzureFileShareRecoveryPoint) {
+	var resultResource *armrecoveryservicesbackup.RecoveryPointResource
+	var result *armrecoveryservicesbackup.AzureFileShareRecoveryPoint
+	for _, rp := range recoveryPoints {
+		props, ok := rp.Properties.(*armrecoveryservicesbackup.AzureFileShareRecoveryPoint)
+		if !ok || props.RecoveryPointTime == nil || props.RecoveryPointTime.Before(since) {
+			continue
+		}
+		if result == nil || props.RecoveryPointTime.Before(*result.RecoveryPointTime) {
+			resultResource, result = rp, props
+		}
+	}
+	return resultResource, result
+}
+
+// isInVaultTier returns true if the recovery point has been transferred to the vault tier.
+func isInVaultTier(rp *armrecoveryservicesbackup.AzureFileShareRecoveryPoint) bool {
+	for _, tier := range rp.RecoveryPointTierDetails {
+		if tier == nil {
+			continue
+		}
+		if ptr.Deref(tier.Type, "") == armrecoveryservicesbackup.RecoveryPointTierTypeHardenedRP &&
+			ptr.Deref(tier.Status, "") == armrecoveryservicesbackup.RecoveryPointTierStatusValid {
+			return true
+		}
+	}
+	return false
+}
+
+// updateBackupCopies marks the copies of the backup Ready once its recovery point exists in the paired region.
+// The geo-redundant vault replicates only the vault tier of a recovery point, so the copy is available in the
+// paired region once the recovery point has been transferred to the vault tier. The recovery point is first
+// found among the recovery points of the protected item and its id is kept in the copies, later runs only
+// check the state of that recovery point.
+func updateBackupCopies(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsAzureRwxVolumeBackup()
+
+	if len(backup.Status.Copies) == 0 || cloudresourcesv1beta1.AllBackupCopiesReady(backup.Spec.CopyTo, backup.Status.Copies) {
+		return nil, ctx
+	}
+
+	_, resourceGroupName, vaultName, containerName, protectedItemName, err := azurerwxvolumebackupclient.ParseProtectedItemPath(backup.Status.ProtectedItemPath)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error parsing protected item path of the backup", composed.StopAndForget, ctx)
+	}
+
+	var rpResource *armrecoveryservicesbackup.RecoveryPointResource
+	if recoveryPointId := backup.Status.Copies[0].Id; recoveryPointId == "" {
+		recoveryPoints, err := state.client.ListRecoveryPoints(ctx, vaultName, resourceGroupName, azurerwxvolumebackupclient.AzureFabricName, containerName, protectedItemName)
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error listing Azure recovery points of the backup", composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+		}
+		rpResource, _ = findBackupRecoveryPoint(recoveryPoints, backup.CreationTimestamp.Time)
+		if rpResource == nil {
+			logger.Info("Recovery point of the backup is not yet created")
+			return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+		}
+	} else {
+		_, _, _, _, _, recoveryPointName, err := azurerwxvolumebackupclient.ParseRecoveryPointId(recoveryPointId)
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error parsing recovery point id of the backup copy", composed.StopAndForget, ctx)
+		}
+		rpResource, err = state.client.GetRecoveryPoint(ctx, vaultName, resourceGroupName, azurerwxvolumebackupclient.AzureFabricName, containerName, protectedItemName, recoveryPointName)
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error getting Azure recovery point of the backup", composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx)
+		}
+	}
+
+	rp, _ := rpResource.Properties.(*armrecoveryservicesbackup.AzureFileShareRecoveryPoint)
+	replicated := rp != nil && isInVaultTier(rp)
+
+	changed := false
+	for i := range backup.Status.Copies {
+		c := &backup.Status.Copies[i]
+		if id := ptr.Deref(rpResource.ID, ""); c.Id != id {
+			c.Id = id
+			changed = true
+		}
+		if replicated && c.State != cloudresourcesv1beta1.StateReady {
+			c.State = cloudresourcesv1beta1.StateReady
+			c.Message = ""
+			changed = true
+		}
+	}
+
+	if !replicated {
+		logger.Info("Recovery point of the backup is not yet replicated to the paired region")
+	}
+	if !changed {
+		return composed.StopWithRequeueDelay(util.Timing.T60000ms()), ctx
+	}
+
+	patch := composed.PatchStatus(backup).
+		ErrorLogMessage("Error patching AzureRwxVolumeBackup status with copies")
+	if !replicated {
+		return patch.
+			SuccessError(composed.StopWithRequeueDelay(util.Timing.T60000ms())).
+			Run(ctx, state)
+	}
+	return patch.
+		SuccessErrorNil().
+		Run(ctx, state)
+}
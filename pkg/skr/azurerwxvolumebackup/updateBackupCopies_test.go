@@ -0,0 +1,123 @@
+package azurerwxvolumebackup
+
+import (
+	"context"
+	"testing"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateBackupCopies(t *testing.T) {
+
+	t.Run("updateBackupCopies", func(t *testing.T) {
+
+		ctx := context.Background()
+		protectedItemPath := azurerwxvolumebackupclient.GetProtectedItemPath("test-subscription-id", "test-rg", "cm-vault-westeurope",
+			azurerwxvolumebackupclient.GetContainerName("test-rg", "testsa"), "AzureFileShare;2DAC3CBD")
+
+		setupBackupWithPendingCopy := func() *cloudresourcesv1beta1.AzureRwxVolumeBackup {
+			backup := setupDefaultBackup()
+			backup.Spec.Location = "westeurope"
+			backup.Spec.CopyTo = []string{"northeurope"}
+			backup.Status.State = cloudresourcesv1beta1.AzureRwxBackupDone
+			backup.Status.ProtectedItemPath = protectedItemPath
+			backup.Status.Copies = []cloudresourcesv1beta1.BackupCopyStatus{
+				{Location: "northeurope", State: cloudresourcesv1beta1.StateCreating},
+			}
+			return backup
+		}
+
+		t.Run("listing recovery points fails", func(t *testing.T) {
+
+			backup := setupBackupWithPendingCopy()
+			state := setupDefaultState(ctx, backup)
+			newCtx := addValuesToContext(ctx, map[string]string{"ListRecoveryPoints": "fail"})
+
+			err, _ := updateBackupCopies(newCtx, state)
+
+			assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T60000ms()), err)
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+			assert.Empty(t, backup.Status.Copies[0].Id)
+
+		})
+
+		t.Run("recovery point is not yet in the vault tier", func(t *testing.T) {
+
+			backup := setupBackupWithPendingCopy()
+			state := setupDefaultState(ctx, backup)
+
+			_, _ = updateBackupCopies(ctx, state)
+
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+			_, _, _, _, _, _, err := azurerwxvolumebackupclient.ParseRecoveryPointId(backup.Status.Copies[0].Id)
+			assert.Nil(t, err)
+			assert.Equal(t, protectedItemPath, backup.Status.ProtectedItemPath)
+
+		})
+
+		t.Run("recovery point is replicated to the paired region", func(t *testing.T) {
+
+			backup := setupBackupWithPendingCopy()
+			state := setupDefaultState(ctx, backup)
+			newCtx := addValuesToContext(ctx, map[string]string{"ListRecoveryPoints": "vaultTier"})
+
+			_, _ = updateBackupCopies(newCtx, state)
+
+			assert.Equal(t, cloudresourcesv1beta1.StateReady, backup.Status.Copies[0].State)
+			_, _, _, _, _, _, err := azurerwxvolumebackupclient.ParseRecoveryPointId(backup.Status.Copies[0].Id)
+			assert.Nil(t, err)
+
+		})
+
+		t.Run("known recovery point becomes replicated to the paired region", func(t *testing.T) {
+
+			backup := setupBackupWithPendingCopy()
+			state := setupDefaultState(ctx, backup)
+
+			// the first run finds the recovery point of the backup in the snapshot tier
+			_, _ = updateBackupCopies(ctx, state)
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+			recoveryPointId := backup.Status.Copies[0].Id
+
+			// the next run gets the same recovery point, still in the snapshot tier
+			err, _ := updateBackupCopies(ctx, state)
+			assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T60000ms()), err)
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+
+			// getting the recovery point fails
+			err, _ = updateBackupCopies(addValuesToContext(ctx, map[string]string{"GetRecoveryPoint": "fail"}), state)
+			assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T60000ms()), err)
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+
+			// the recovery point is transferred to the vault tier
+			_, _ = updateBackupCopies(addValuesToContext(ctx, map[string]string{"GetRecoveryPoint": "vaultTier"}), state)
+			assert.Equal(t, cloudresourcesv1beta1.StateReady, backup.Status.Copies[0].State)
+			assert.Equal(t, recoveryPointId, backup.Status.Copies[0].Id)
+			assert.Equal(t, protectedItemPath, backup.Status.ProtectedItemPath)
+
+			// all copies are ready, nothing left to do
+			err, _ = updateBackupCopies(ctx, state)
+			assert.Nil(t, err)
+
+		})
+
+		t.Run("backup has no protected item path", func(t *testing.T) {
+
+			backup := setupBackupWithPendingCopy()
+			backup.Status.ProtectedItemPath = ""
+			state := setupDefaultState(ctx, backup)
+
+			err, _ := updateBackupCopies(ctx, state)
+
+			assert.Equal(t, composed.StopAndForget, err)
+			assert.Equal(t, cloudresourcesv1beta1.StateCreating, backup.Status.Copies[0].State)
+
+		})
+
+	})
+
+}
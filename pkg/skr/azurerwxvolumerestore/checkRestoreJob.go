@@ -16,6 +16,10 @@ func checkRestoreJob(ctx context.Context, st composed.State) (error, context.Con
 	state := st.(*State)
 	restore := state.ObjAsAzureRwxVolumeRestore()
 	logger := composed.LoggerFromCtx(ctx)
+	if restore.Status.Progress == cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCreatingVolume {
+		// restore job already completed, the new volume is being created
+		return nil, ctx
+	}
 	if restore.Status.OpIdentifier == "" {
 		return composed.LogErrorAndReturn(nil, "Should not reach checkRestoreJob action if opIdentifier is missing.", composed.StopWithRequeueDelay(util.Timing.T1000ms()), ctx)
 	}
@@ -94,8 +98,15 @@ func checkRestoreJob(ctx context.Context, st composed.State) (error, context.Con
 		logger.Info("Restore job is in 'cancelling' state. Wait to reach final status.")
 		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
 	case string(armrecoveryservicesbackup.JobStatusCompleted):
-		restore.Status.State = cloudresourcesv1beta1.JobStateDone
 		logger.Info("Restore job completed")
+		if restore.Spec.Destination.NewVolume != nil {
+			restore.Status.Progress = cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCreatingVolume
+			return composed.PatchStatus(restore).
+				SuccessErrorNil().
+				Run(ctx, state)
+		}
+		restore.Status.State = cloudresourcesv1beta1.JobStateDone
+		restore.Status.Progress = cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCompleted
 		return composed.PatchStatus(restore).
 			SetExclusiveConditions(metav1.Condition{
 				Type:    cloudresourcesv1beta1.ConditionTypeReady,
@@ -77,8 +77,8 @@ func TestCheckRestoreJob(t *testing.T) {
 				},
 				Spec: cloudresourcesv1beta1.AzureRwxVolumeRestoreSpec{
 
-					Destination: cloudresourcesv1beta1.PvcSource{
-						Pvc: cloudresourcesv1beta1.PvcRef{
+					Destination: cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+						Pvc: &cloudresourcesv1beta1.PvcRef{
 							Name:      "test-azure-restore-pvc",
 							Namespace: "test-ns",
 						},
@@ -149,6 +149,46 @@ func TestCheckRestoreJob(t *testing.T) {
 			assert.Equal(t, cloudresourcesv1beta1.JobStateDone, azureRwxVolumeRestore.Status.State, "should be completed")
 		})
 
+		t.Run("Should: continue with volume creation when restoring into a new volume", func(t *testing.T) {
+			backupRecoveryPointId := "/subscriptions/3f1d2fbd-117a-4742-8bde-6edbcdee6a04/resourceGroups/rg-test/providers/Microsoft.RecoveryServices/vaults/v-test/backupFabrics/Azure/protectionContainers/StorageContainer;Storage;test;testsa/protectedItems/AzureFileShare;2DAC3CBDBBD863B2292F25490DC0794F35AAA4C27890D5DCA82B0A33E9596217/recoveryPoints/5639661428710522320"
+			backupStorageAccountPath := "test-storage-account-path"
+			setupTest(true, backupRecoveryPointId, backupStorageAccountPath)
+			ctx := t.Context()
+			restore := state.ObjAsAzureRwxVolumeRestore()
+			restore.Spec.Destination = cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+				NewVolume: &cloudresourcesv1beta1.AzureRwxVolumeRestoreNewVolume{
+					Pvc: cloudresourcesv1beta1.AzureRwxVolumeRestorePvcSpec{Name: "test-restored-pvc"},
+				},
+			}
+			assert.Nil(t, k8sClient.Update(ctx, restore), "should update destination")
+			restore.Status.RestoredDir = ""
+			restore.Status.FileShareName = "cm-restore-test"
+
+			jobId := "test-job-id"
+			request := azurerwxvolumebackupclient.RestoreRequest{
+				VaultName:           jobId,
+				ResourceGroupName:   string(armrecoveryservicesbackup.JobStatusCompleted),
+				TargetFileShareName: restore.Status.FileShareName,
+			}
+			_, _ = state.storageClient.TriggerRestore(ctx, request)
+			restore.Status.OpIdentifier = jobId
+			err, res := checkRestoreJob(ctx, state)
+			assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T10000ms()), err, "should stop and requeue with 10 seconds delay")
+			assert.Equal(t, ctx, res, "should return same context")
+			err, res = checkRestoreJob(ctx, state)
+			assert.Nil(t, err, "should continue")
+			assert.Equal(t, ctx, res, "should return same context")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: "test-azure-restore", Namespace: "test-ns-2"}, azureRwxVolumeRestore)
+			assert.Nil(t, err, "should get azureRwxVolumeRestore")
+			assert.Equal(t, cloudresourcesv1beta1.JobStateInProgress, azureRwxVolumeRestore.Status.State, "should still be in progress")
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCreatingVolume, azureRwxVolumeRestore.Status.Progress, "should be creating volume")
+
+			// the job is not checked again once the volume is being created
+			err, res = checkRestoreJob(ctx, state)
+			assert.Nil(t, err, "should continue")
+			assert.Equal(t, ctx, res, "should return same context")
+		})
+
 		t.Run("Should: check failed azure status ", func(t *testing.T) {
 			backupRecoveryPointId := "/subscriptions/3f1d2fbd-117a-4742-8bde-6edbcdee6a04/resourceGroups/rg-test/providers/Microsoft.RecoveryServices/vaults/v-test/backupFabrics/Azure/protectionContainers/StorageContainer;Storage;test;testsa/protectedItems/AzureFileShare;2DAC3CBDBBD863B2292F25490DC0794F35AAA4C27890D5DCA82B0A33E9596217/recoveryPoints/5639661428710522320"
 			backupStorageAccountPath := "test-storage-account-path"
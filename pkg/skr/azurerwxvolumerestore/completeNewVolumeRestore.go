@@ -0,0 +1,31 @@
+package azurerwxvolumerestore
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func completeNewVolumeRestore(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	restore := state.ObjAsAzureRwxVolumeRestore()
+
+	restore.Status.State = cloudresourcesv1beta1.JobStateDone
+	restore.Status.Progress = cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCompleted
+	restore.Status.CreatedPvc = &corev1.ObjectReference{
+		Namespace: restore.Namespace,
+		Name:      restore.Spec.Destination.NewVolume.Pvc.Name,
+	}
+	composed.LoggerFromCtx(ctx).Info("Restore into new volume completed", "PVC", restore.Status.CreatedPvc.Name)
+	return composed.PatchStatus(restore).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonReady,
+			Message: "Restore operation finished successfully.",
+		}).
+		Run(ctx, state)
+}
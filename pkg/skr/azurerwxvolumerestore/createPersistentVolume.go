@@ -0,0 +1,70 @@
+package azurerwxvolumerestore
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// createPersistentVolume creates a statically provisioned Azure File CSI PV for the restored file share,
+// pre-bound to the PVC of the restore. With the Delete reclaim policy the file share is cleaned up
+// the same way as for dynamically provisioned volumes once the PVC is deleted.
+func createPersistentVolume(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	restore := state.ObjAsAzureRwxVolumeRestore()
+	logger := composed.LoggerFromCtx(ctx)
+
+	pv := &corev1.PersistentVolume{}
+	err := state.Cluster().K8sClient().Get(ctx, types.NamespacedName{Name: state.fileShareName}, pv)
+	if ctrlclient.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading PersistentVolume", composed.StopWithRequeue, ctx)
+	}
+	if err == nil {
+		return nil, ctx
+	}
+
+	pv = &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   state.fileShareName,
+			Labels: getNewVolumeLabels(restore),
+			Annotations: map[string]string{
+				annotationProvisionedBy: client.AzureFileShareProvisioner,
+			},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: fileShareCapacity(state.fileShare),
+			},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			StorageClassName:              "",
+			ClaimRef: &corev1.ObjectReference{
+				Namespace: restore.Namespace,
+				Name:      restore.Spec.Destination.NewVolume.Pvc.Name,
+			},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{
+					Driver:       client.AzureFileShareProvisioner,
+					VolumeHandle: state.newVolumeHandle(),
+					VolumeAttributes: map[string]string{
+						"resourceGroup":  state.resourceGroupName,
+						"storageAccount": state.storageAccountName,
+						"shareName":      state.fileShareName,
+					},
+				},
+			},
+		},
+	}
+	err = state.Cluster().K8sClient().Create(ctx, pv)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating PersistentVolume for restored file share", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("PersistentVolume for restored file share created", "PV", pv.Name)
+	return nil, ctx
+}
@@ -0,0 +1,73 @@
+package azurerwxvolumerestore
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// createPersistentVolumeClaim creates the PVC of a new volume restore before its PV,
+// so that a name clash with a PVC not created by this restore is detected before the PV is pre-bound to it.
+func createPersistentVolumeClaim(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	restore := state.ObjAsAzureRwxVolumeRestore()
+	logger := composed.LoggerFromCtx(ctx)
+	pvcName := types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.Destination.NewVolume.Pvc.Name}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := state.Cluster().K8sClient().Get(ctx, pvcName, pvc)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading PersistentVolumeClaim", composed.StopWithRequeue, ctx)
+	}
+	if err == nil {
+		if pvc.Spec.VolumeName == state.fileShareName {
+			return nil, ctx
+		}
+		restore.Status.State = cloudresourcesv1beta1.JobStateFailed
+		logger.Error(nil, "PersistentVolumeClaim for the new volume already exists", "PVC", pvcName)
+		return composed.PatchStatus(restore).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonPvcAlreadyExists,
+				Message: fmt.Sprintf("PersistentVolumeClaim %s already exists", pvcName.Name),
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	capacity := fileShareCapacity(state.fileShare)
+	pvc = &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   pvcName.Namespace,
+			Name:        pvcName.Name,
+			Labels:      getNewVolumeLabels(restore),
+			Annotations: getNewVolumeClaimAnnotations(restore),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			VolumeName:  state.fileShareName, // connection to PV
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: capacity,
+				},
+			},
+			StorageClassName: new(""),
+			VolumeMode:       ptr.To(corev1.PersistentVolumeFilesystem),
+		},
+	}
+	err = state.Cluster().K8sClient().Create(ctx, pvc)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating PersistentVolumeClaim for restored file share", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("PersistentVolumeClaim for restored file share created", "PVC", pvcName)
+	return nil, ctx
+}
@@ -17,7 +17,7 @@ func findAzureRestoreJob(ctx context.Context, st composed.State) (error, context
 	if restore.Status.OpIdentifier != "" {
 		return nil, ctx
 	}
-	if restore.Status.RestoredDir == "" {
+	if restore.Status.RestoredDir == "" && restore.Status.FileShareName == "" {
 		// Restore was not tried yet
 		return nil, ctx
 	}
@@ -77,8 +77,8 @@ func TestFindAzureRestoreJob(t *testing.T) {
 				},
 				Spec: cloudresourcesv1beta1.AzureRwxVolumeRestoreSpec{
 
-					Destination: cloudresourcesv1beta1.PvcSource{
-						Pvc: cloudresourcesv1beta1.PvcRef{
+					Destination: cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+						Pvc: &cloudresourcesv1beta1.PvcRef{
 							Name:      "test-azure-restore-pvc",
 							Namespace: "test-ns",
 						},
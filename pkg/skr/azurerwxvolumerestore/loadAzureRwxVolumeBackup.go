@@ -21,8 +21,13 @@ func loadAzureRwxVolumeBackup(ctx context.Context, st composed.State) (error, co
 	logger := composed.LoggerFromCtx(ctx)
 
 	restore := state.ObjAsAzureRwxVolumeRestore()
-	logger = logger.WithValues("AzureRwxVolumeRestoreSource", restore.Spec.Source.Backup.ToNamespacedName(state.Obj().GetNamespace()),
-		"destination", restore.Spec.Destination.Pvc.ToNamespacedName(state.Obj().GetNamespace()))
+	logger = logger.WithValues("AzureRwxVolumeRestoreSource", restore.Spec.Source.Backup.ToNamespacedName(state.Obj().GetNamespace()))
+	if restore.Spec.Destination.Pvc != nil {
+		logger = logger.WithValues("destination", restore.Spec.Destination.Pvc.ToNamespacedName(state.Obj().GetNamespace()))
+	}
+	if restore.Spec.Destination.NewVolume != nil {
+		logger = logger.WithValues("newVolumePvc", restore.Spec.Destination.NewVolume.Pvc.Name)
+	}
 	composed.LoggerIntoCtx(ctx, logger)
 	logger.Info("Loading AzureRwxVolumeBackup")
 	//Load the rwxVolumeBackup object
@@ -0,0 +1,27 @@
+package azurerwxvolumerestore
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+// loadRestoredFileShare loads the file share created by the restore job.
+// The share might not be visible right after the job completes, so it is retried until found.
+func loadRestoredFileShare(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	fileShare, err := state.storageClient.GetFileShare(ctx, state.newVolumeHandle())
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading restored Azure file share", composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx)
+	}
+	if fileShare == nil {
+		logger.Info("Restored Azure file share not found, retrying later", "fileShare", state.fileShareName)
+		return composed.StopWithRequeueDelay(util.Timing.T10000ms()), ctx
+	}
+
+	state.fileShare = fileShare
+	return nil, ctx
+}
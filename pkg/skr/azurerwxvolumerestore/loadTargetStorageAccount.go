@@ -0,0 +1,40 @@
+package azurerwxvolumerestore
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// loadTargetStorageAccount resolves the storage account of a new volume restore.
+// The new file share is created in the storage account of the backed up file share.
+func loadTargetStorageAccount(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	restore := state.ObjAsAzureRwxVolumeRestore()
+	logger := composed.LoggerFromCtx(ctx)
+
+	_, resourceGroupName, storageAccountName, err := client.ParseStorageAccountPath(state.azureRwxVolumeBackup.Status.StorageAccountPath)
+	if err != nil {
+		restore.Status.State = cloudresourcesv1beta1.JobStateFailed
+		errorMessage := fmt.Sprintf("Source AzureRwxVolumeBackup has an invalid storageAccountPath: '%v'", state.azureRwxVolumeBackup.Status.StorageAccountPath)
+		logger.Error(err, errorMessage)
+		return composed.PatchStatus(restore).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonInvalidStorageAccountPath,
+				Message: errorMessage,
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.resourceGroupName = resourceGroupName
+	state.storageAccountName = storageAccountName
+	state.fileShareName = restore.Status.FileShareName
+	return nil, ctx
+}
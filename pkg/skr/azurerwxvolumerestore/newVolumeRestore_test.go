@@ -0,0 +1,209 @@
+package azurerwxvolumerestore
+
+import (
+	"context"
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	azurerwxvolumebackupclient "github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	spy "github.com/kyma-project/cloud-manager/pkg/testinfra/clientspy"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewVolumeRestore(t *testing.T) {
+	t.Run("newVolumeRestore", func(t *testing.T) {
+
+		var azureRwxVolumeRestore *cloudresourcesv1beta1.AzureRwxVolumeRestore
+		var state *State
+		var k8sClient client.WithWatch
+
+		scope := &cloudcontrolv1beta1.Scope{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-scope",
+				Namespace: "test-ns",
+			},
+			Spec: cloudcontrolv1beta1.ScopeSpec{
+				Scope: cloudcontrolv1beta1.ScopeInfo{
+					Azure: &cloudcontrolv1beta1.AzureScope{
+						SubscriptionId: "test-subscription-id",
+					},
+				},
+			},
+		}
+
+		kcpClient := fake.NewClientBuilder().
+			WithScheme(commonscheme.KcpScheme).
+			WithObjects(scope).
+			Build()
+		kcpCluster := composed.NewStateCluster(kcpClient, kcpClient, nil, commonscheme.KcpScheme)
+
+		createEmptyState := func(k8sClient client.WithWatch, azureRwxVolumeRestore *cloudresourcesv1beta1.AzureRwxVolumeRestore) *State {
+			cluster := composed.NewStateCluster(k8sClient, k8sClient, nil, k8sClient.Scheme())
+			scopeState, _ := commonscope.NewStateFactory(kcpCluster, scopeProvider).NewState(context.Background(), types.NamespacedName{}, composed.NewStateFactory(cluster).NewState(types.NamespacedName{}, azureRwxVolumeRestore))
+			return &State{
+				State: scopeState,
+			}
+		}
+
+		storageAccountPath := azurerwxvolumebackupclient.GetStorageAccountPath("test-subscription-id", "test-rg", "testsa")
+
+		setupTest := func(objs ...client.Object) {
+			azureRwxVolumeBackup := &cloudresourcesv1beta1.AzureRwxVolumeBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-azure-restore-backup",
+					Namespace: "test-ns",
+				},
+				Status: cloudresourcesv1beta1.AzureRwxVolumeBackupStatus{
+					StorageAccountPath: storageAccountPath,
+				},
+			}
+			azureRwxVolumeRestore = &cloudresourcesv1beta1.AzureRwxVolumeRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-azure-restore",
+					Namespace: "test-ns",
+				},
+				Spec: cloudresourcesv1beta1.AzureRwxVolumeRestoreSpec{
+					Destination: cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+						NewVolume: &cloudresourcesv1beta1.AzureRwxVolumeRestoreNewVolume{
+							Pvc: cloudresourcesv1beta1.AzureRwxVolumeRestorePvcSpec{
+								Name:        "test-restored-pvc",
+								Labels:      map[string]string{"app": "test"},
+								Annotations: map[string]string{"note": "restored"},
+							},
+						},
+					},
+					Source: cloudresourcesv1beta1.AzureRwxVolumeRestoreSource{
+						Backup: cloudresourcesv1beta1.AzureRwxVolumeBackupRef{
+							Name:      "test-azure-restore-backup",
+							Namespace: "test-ns",
+						},
+					},
+				},
+				Status: cloudresourcesv1beta1.AzureRwxVolumeRestoreStatus{
+					State:         cloudresourcesv1beta1.JobStateInProgress,
+					Progress:      cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCreatingVolume,
+					FileShareName: "cm-restore-test",
+					OpIdentifier:  "test-job-id",
+				},
+			}
+
+			fakeClient := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).
+				WithObjects(azureRwxVolumeRestore).
+				WithObjects(objs...).
+				WithStatusSubresource(azureRwxVolumeRestore).
+				Build()
+			k8sClient = spy.NewClientSpy(fakeClient)
+			state = createEmptyState(k8sClient, azureRwxVolumeRestore)
+			state.azureRwxVolumeBackup = azureRwxVolumeBackup
+			state.storageClient, _ = azurerwxvolumebackupclient.NewMockClient()(nil, "", "", "", "")
+			state.SetScope(scope)
+		}
+
+		t.Run("Should: create PVC and PV for the restored file share", func(t *testing.T) {
+			setupTest()
+			ctx := t.Context()
+			// the restore job creates the target file share
+			_, _ = state.storageClient.TriggerRestore(ctx, azurerwxvolumebackupclient.RestoreRequest{
+				VaultName:                "test-job-id",
+				TargetStorageAccountPath: storageAccountPath,
+				TargetFileShareName:      "cm-restore-test",
+			})
+
+			err, res := loadTargetStorageAccount(ctx, state)
+			assert.Nil(t, err, "should continue")
+			assert.Equal(t, ctx, res, "should return same context")
+			assert.Equal(t, "test-rg", state.resourceGroupName)
+			assert.Equal(t, "testsa", state.storageAccountName)
+			assert.Equal(t, "cm-restore-test", state.fileShareName)
+
+			err, _ = loadRestoredFileShare(ctx, state)
+			assert.Nil(t, err, "should continue")
+			assert.NotNil(t, state.fileShare, "should load restored file share")
+
+			err, _ = createPersistentVolumeClaim(ctx, state)
+			assert.Nil(t, err, "should continue")
+			err, _ = createPersistentVolume(ctx, state)
+			assert.Nil(t, err, "should continue")
+			err, _ = completeNewVolumeRestore(ctx, state)
+			assert.Equal(t, composed.StopAndForget, err, "should stop and forget")
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: "test-restored-pvc"}, pvc)
+			assert.Nil(t, err, "should get PVC")
+			assert.Equal(t, "cm-restore-test", pvc.Spec.VolumeName)
+			assert.Equal(t, "test", pvc.Labels["app"])
+			assert.Equal(t, "restored", pvc.Annotations["note"])
+			assert.True(t, azurerwxvolumebackupclient.IsPvcProvisionerAzureCsiDriver(pvc.Annotations), "should be marked as provisioned by Azure CSI driver")
+
+			pv := &corev1.PersistentVolume{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: "cm-restore-test"}, pv)
+			assert.Nil(t, err, "should get PV")
+			assert.Equal(t, "test-rg#testsa#cm-restore-test###test-ns", pv.Spec.CSI.VolumeHandle)
+			assert.Equal(t, "test-restored-pvc", pv.Spec.ClaimRef.Name)
+			assert.Equal(t, corev1.PersistentVolumeReclaimDelete, pv.Spec.PersistentVolumeReclaimPolicy)
+			assert.Equal(t, pvc.Spec.Resources.Requests[corev1.ResourceStorage], pv.Spec.Capacity[corev1.ResourceStorage])
+
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: "test-azure-restore", Namespace: "test-ns"}, azureRwxVolumeRestore)
+			assert.Nil(t, err, "should get azureRwxVolumeRestore")
+			assert.Equal(t, cloudresourcesv1beta1.JobStateDone, azureRwxVolumeRestore.Status.State)
+			assert.Equal(t, cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressCompleted, azureRwxVolumeRestore.Status.Progress)
+			assert.NotNil(t, azureRwxVolumeRestore.Status.CreatedPvc, "should have created PVC set")
+			assert.Equal(t, "test-restored-pvc", azureRwxVolumeRestore.Status.CreatedPvc.Name)
+		})
+
+		t.Run("Should: wait until the restored file share exists", func(t *testing.T) {
+			setupTest()
+			ctx := t.Context()
+
+			err, _ := loadTargetStorageAccount(ctx, state)
+			assert.Nil(t, err, "should continue")
+			err, res := loadRestoredFileShare(ctx, state)
+			assert.Equal(t, composed.StopWithRequeueDelay(util.Timing.T10000ms()), err, "should stop and requeue with 10 seconds delay")
+			assert.Equal(t, ctx, res, "should return same context")
+		})
+
+		t.Run("Should: fail if the backup has an invalid storage account path", func(t *testing.T) {
+			setupTest()
+			ctx := t.Context()
+			state.azureRwxVolumeBackup.Status.StorageAccountPath = "invalid"
+
+			err, _ := loadTargetStorageAccount(ctx, state)
+			assert.Equal(t, composed.StopAndForget, err, "should stop and forget")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: "test-azure-restore", Namespace: "test-ns"}, azureRwxVolumeRestore)
+			assert.Nil(t, err, "should get azureRwxVolumeRestore")
+			assert.Equal(t, cloudresourcesv1beta1.JobStateFailed, azureRwxVolumeRestore.Status.State)
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonInvalidStorageAccountPath, azureRwxVolumeRestore.Status.Conditions[0].Reason)
+		})
+
+		t.Run("Should: fail if a PVC with the same name already exists", func(t *testing.T) {
+			setupTest(&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-restored-pvc",
+					Namespace: "test-ns",
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					VolumeName: "some-other-pv",
+				},
+			})
+			ctx := t.Context()
+			state.fileShareName = "cm-restore-test"
+
+			err, _ := createPersistentVolumeClaim(ctx, state)
+			assert.Equal(t, composed.StopAndForget, err, "should stop and forget")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: "test-azure-restore", Namespace: "test-ns"}, azureRwxVolumeRestore)
+			assert.Nil(t, err, "should get azureRwxVolumeRestore")
+			assert.Equal(t, cloudresourcesv1beta1.JobStateFailed, azureRwxVolumeRestore.Status.State)
+			assert.Equal(t, cloudresourcesv1beta1.ConditionReasonPvcAlreadyExists, azureRwxVolumeRestore.Status.Conditions[0].Reason)
+		})
+	})
+}
@@ -2,6 +2,7 @@ package azurerwxvolumerestore
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -9,15 +10,21 @@ import (
 )
 
 // This action adds a start time to the status of the restore object prior to trigger the restore.
+// Depending on the destination it also picks the directory or the name of the new file share the backup is restored into.
 // This can be used as an indication that restore is going to be trigger shortly and also as filter to enhance the search for a restore job
 func prepareRestore(ctx context.Context, st composed.State) (error, context.Context) {
 	state := st.(*State)
 	restore := state.ObjAsAzureRwxVolumeRestore()
-	if restore.Status.RestoredDir != "" {
+	if restore.Status.RestoredDir != "" || restore.Status.FileShareName != "" {
 		return nil, nil
 	}
 	restore.Status.StartTime = &metav1.Time{Time: time.Now()}
-	restore.Status.RestoredDir = uuid.NewString()
+	if restore.Spec.Destination.NewVolume != nil {
+		restore.Status.FileShareName = fmt.Sprintf("cm-restore-%s", uuid.NewString())
+		state.fileShareName = restore.Status.FileShareName
+	} else {
+		restore.Status.RestoredDir = uuid.NewString()
+	}
 	return composed.PatchStatus(restore).
 		SuccessErrorNil().
 		Run(ctx, state)
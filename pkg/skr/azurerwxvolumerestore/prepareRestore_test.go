@@ -2,6 +2,7 @@ package azurerwxvolumerestore
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -60,8 +61,8 @@ func TestSetStartTime(t *testing.T) {
 				},
 				Spec: cloudresourcesv1beta1.AzureRwxVolumeRestoreSpec{
 
-					Destination: cloudresourcesv1beta1.PvcSource{
-						Pvc: cloudresourcesv1beta1.PvcRef{
+					Destination: cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+						Pvc: &cloudresourcesv1beta1.PvcRef{
 							Name:      "test-azure-restore-pvc",
 							Namespace: "test-ns",
 						},
@@ -106,6 +107,29 @@ func TestSetStartTime(t *testing.T) {
 			assert.NotEmpty(t, azureRwxVolumeRestore.Status.RestoredDir, "should have restored dir set")
 		})
 
+		t.Run("Should: pick new file share name when restoring into a new volume", func(t *testing.T) {
+			setupTest(true)
+			ctx := t.Context()
+			restore := state.ObjAsAzureRwxVolumeRestore()
+			restore.Spec.Destination = cloudresourcesv1beta1.AzureRwxVolumeRestoreDestination{
+				NewVolume: &cloudresourcesv1beta1.AzureRwxVolumeRestoreNewVolume{
+					Pvc: cloudresourcesv1beta1.AzureRwxVolumeRestorePvcSpec{Name: "test-restored-pvc"},
+				},
+			}
+			assert.Nil(t, k8sClient.Update(ctx, restore), "should update destination")
+
+			err, res := prepareRestore(ctx, state)
+
+			assert.Equal(t, ctx, res, "should return same context")
+			assert.Nil(t, err, "should return nil err")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: "test-azure-restore", Namespace: "test-ns-2"}, azureRwxVolumeRestore)
+			assert.Nil(t, err, "should get azureRwxVolumeRestore")
+			assert.NotNil(t, azureRwxVolumeRestore.Status.StartTime, "should have start time set")
+			assert.Empty(t, azureRwxVolumeRestore.Status.RestoredDir, "should restore into the root of the new file share")
+			assert.True(t, strings.HasPrefix(azureRwxVolumeRestore.Status.FileShareName, "cm-restore-"), "should have file share name set")
+			assert.Equal(t, azureRwxVolumeRestore.Status.FileShareName, state.fileShareName, "should set file share name in state")
+		})
+
 		t.Run("Should: retry if setting start time fails", func(t *testing.T) {
 			setupTest(false)
 			ctx := t.Context()
@@ -48,13 +48,28 @@ func (r *reconciler) newAction() composed.Action {
 			composed.ComposeActions("AzureRwxVolumeNotCompletedOrDeleted",
 				actions.PatchAddCommonFinalizer(),
 				loadAzureRwxVolumeBackup,
-				loadPersistentVolumeClaim,
-				loadPersistentVolume,
+				composed.IfElse(
+					NewVolumeRestorePredicate,
+					loadTargetStorageAccount,
+					composed.ComposeActions("AzureRwxVolumeRestoreExistingPvc",
+						loadPersistentVolumeClaim,
+						loadPersistentVolume,
+					),
+				),
 				createAzureStorageClient,
 				findAzureRestoreJob,
 				prepareRestore,
 				startAzureRestore,
 				checkRestoreJob,
+				composed.If(
+					NewVolumeRestorePredicate,
+					composed.ComposeActions("AzureRwxVolumeRestoreNewVolume",
+						loadRestoredFileShare,
+						createPersistentVolumeClaim,
+						createPersistentVolume,
+						completeNewVolumeRestore,
+					),
+				),
 			),
 			nil),
 		actions.PatchRemoveCommonFinalizer(),
@@ -83,3 +98,8 @@ func CompletedOrDeletedRestorePredicate(_ context.Context, state composed.State)
 	currentState := state.Obj().(*cloudresourcesv1beta1.AzureRwxVolumeRestore).Status.State
 	return isDeleted || currentState == cloudresourcesv1beta1.JobStateDone || currentState == cloudresourcesv1beta1.JobStateFailed
 }
+
+// NewVolumeRestorePredicate is true when the backup is restored into a new file share instead of an existing PVC
+func NewVolumeRestorePredicate(_ context.Context, state composed.State) bool {
+	return state.Obj().(*cloudresourcesv1beta1.AzureRwxVolumeRestore).Spec.Destination.NewVolume != nil
+}
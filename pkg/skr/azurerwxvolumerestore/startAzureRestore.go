@@ -82,6 +82,7 @@ func startAzureRestore(ctx context.Context, st composed.State) (error, context.C
 	//Update the status with opIdentifier and InProgress state.
 	restore.Status.OpIdentifier = ptr.Deref(jobId, "")
 	restore.Status.State = cloudresourcesv1beta1.JobStateInProgress
+	restore.Status.Progress = cloudresourcesv1beta1.AzureRwxVolumeRestoreProgressRestoring
 	return composed.PatchStatus(restore).
 		SetExclusiveConditions().
 		SuccessError(composed.StopWithRequeueDelay(util.Timing.T1000ms())).
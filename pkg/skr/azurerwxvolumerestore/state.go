@@ -3,6 +3,7 @@ package azurerwxvolumerestore
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	azureclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/azure/client"
@@ -19,6 +20,7 @@ type State struct {
 	resourceGroupName     string
 	storageAccountName    string
 	fileShareName         string
+	fileShare             *armstorage.FileShareItem
 	pvc                   *corev1.PersistentVolumeClaim
 	storageClientProvider azureclient.ClientProvider[client.Client]
 }
@@ -59,3 +61,8 @@ func newStateFactory(
 func (s *State) ObjAsAzureRwxVolumeRestore() *cloudresourcesv1beta1.AzureRwxVolumeRestore {
 	return s.Obj().(*cloudresourcesv1beta1.AzureRwxVolumeRestore)
 }
+
+// newVolumeHandle returns the CSI volume handle of the file share the backup is restored into
+func (s *State) newVolumeHandle() string {
+	return client.GetPvVolumeHandle(s.resourceGroupName, s.storageAccountName, s.fileShareName, s.Obj().GetNamespace())
+}
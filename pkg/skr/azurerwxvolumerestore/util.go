@@ -0,0 +1,43 @@
+package azurerwxvolumerestore
+
+import (
+	"maps"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azurerwxvolumebackup/client"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Quota of a file share in GiB when Azure does not report one, matches the Azure default for standard file shares
+const defaultFileShareQuotaGiB = 5120
+
+const annotationProvisionedBy = "pv.kubernetes.io/provisioned-by"
+
+func fileShareCapacity(fileShare *armstorage.FileShareItem) resource.Quantity {
+	quotaGiB := int64(defaultFileShareQuotaGiB)
+	if fileShare != nil && fileShare.Properties != nil && fileShare.Properties.ShareQuota != nil {
+		quotaGiB = int64(*fileShare.Properties.ShareQuota)
+	}
+	return *resource.NewQuantity(quotaGiB*1024*1024*1024, resource.BinarySI)
+}
+
+func getNewVolumeLabels(restore *cloudresourcesv1beta1.AzureRwxVolumeRestore) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+	for labelName, labelValue := range restore.Spec.Destination.NewVolume.Pvc.Labels {
+		labelsBuilder.WithCustomLabel(labelName, labelValue)
+	}
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	return labelsBuilder.Build()
+}
+
+// getNewVolumeClaimAnnotations marks the PVC as provisioned by the Azure File CSI driver,
+// so the restored volume can be backed up and restored into like a dynamically provisioned one
+func getNewVolumeClaimAnnotations(restore *cloudresourcesv1beta1.AzureRwxVolumeRestore) map[string]string {
+	result := map[string]string{}
+	maps.Copy(result, restore.Spec.Destination.NewVolume.Pvc.Annotations)
+	result[client.StorageProvisionerKey] = client.AzureFileShareProvisioner
+	return result
+}
@@ -12,6 +12,9 @@ const (
 	AwsNfsBackupSchedule ScheduleType = iota
 	GcpNfsBackupSchedule
 	AzureRwxBackupSchedule
+	AwsRedisBackupSchedule
+	GcpRedisBackupSchedule
+	AzureRedisBackupSchedule
 )
 
 type backupImpl interface {
@@ -31,6 +34,12 @@ func getBackupImpl(scheduleType ScheduleType) backupImpl {
 		return &backupImplGcpNfs{}
 	case AzureRwxBackupSchedule:
 		return &backupImplAzureRwx{}
+	case AwsRedisBackupSchedule:
+		return &backupImplAwsRedis{}
+	case GcpRedisBackupSchedule:
+		return &backupImplGcpRedis{}
+	case AzureRedisBackupSchedule:
+		return &backupImplAzureRedis{}
 	default:
 		return nil
 	}
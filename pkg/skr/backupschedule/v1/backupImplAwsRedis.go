@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type backupImplAwsRedis struct {
+}
+
+func (impl *backupImplAwsRedis) emptyScheduleObject() composed.ObjWithConditionsAndState {
+	return &cloudresourcesv1beta1.AwsRedisBackupSchedule{}
+}
+
+func (impl *backupImplAwsRedis) emptySourceObject() client.Object {
+	return &cloudresourcesv1beta1.AwsRedisInstance{}
+}
+
+func (impl *backupImplAwsRedis) emptyBackupList() client.ObjectList {
+	return &cloudresourcesv1beta1.AwsRedisInstanceBackupList{}
+}
+
+func (impl *backupImplAwsRedis) toObjectSlice(list client.ObjectList) []client.Object {
+	var objects []client.Object
+
+	if x, ok := list.(*cloudresourcesv1beta1.AwsRedisInstanceBackupList); ok {
+		for _, item := range x.Items {
+			objects = append(objects, &item)
+		}
+	}
+	return objects
+}
+
+func (impl *backupImplAwsRedis) getBackupObject(state *State, objectMeta *metav1.ObjectMeta) (client.Object, error) {
+	schedule := state.ObjAsBackupSchedule()
+	_, ok := schedule.(*cloudresourcesv1beta1.AwsRedisBackupSchedule)
+	if !ok {
+		return nil, fmt.Errorf("provider %s not supported", state.Scope.Spec.Provider)
+	}
+
+	return &cloudresourcesv1beta1.AwsRedisInstanceBackup{
+		ObjectMeta: *objectMeta,
+		Spec: cloudresourcesv1beta1.AwsRedisInstanceBackupSpec{
+			Source: cloudresourcesv1beta1.RedisInstanceBackupSource{
+				RedisInstance: cloudresourcesv1beta1.RedisInstanceRef{
+					Name:      schedule.GetSourceRef().Name,
+					Namespace: schedule.GetSourceRef().Namespace,
+				},
+			},
+		},
+	}, nil
+}
+
+func (impl *backupImplAwsRedis) sourceToObjWithConditionAndState(obj client.Object) (composed.ObjWithConditionsAndState, error) {
+	x, ok := obj.(*cloudresourcesv1beta1.AwsRedisInstance)
+	if !ok {
+		return nil, errors.New("source Object should be of type AwsRedisInstance")
+	}
+	return x, nil
+}
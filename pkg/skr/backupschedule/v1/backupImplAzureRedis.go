@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type backupImplAzureRedis struct {
+}
+
+func (impl *backupImplAzureRedis) emptyScheduleObject() composed.ObjWithConditionsAndState {
+	return &cloudresourcesv1beta1.AzureRedisBackupSchedule{}
+}
+
+func (impl *backupImplAzureRedis) emptySourceObject() client.Object {
+	return &cloudresourcesv1beta1.AzureRedisInstance{}
+}
+
+func (impl *backupImplAzureRedis) emptyBackupList() client.ObjectList {
+	return &cloudresourcesv1beta1.AzureRedisInstanceBackupList{}
+}
+
+func (impl *backupImplAzureRedis) toObjectSlice(list client.ObjectList) []client.Object {
+	var objects []client.Object
+
+	if x, ok := list.(*cloudresourcesv1beta1.AzureRedisInstanceBackupList); ok {
+		for _, item := range x.Items {
+			objects = append(objects, &item)
+		}
+	}
+	return objects
+}
+
+func (impl *backupImplAzureRedis) getBackupObject(state *State, objectMeta *metav1.ObjectMeta) (client.Object, error) {
+	schedule := state.ObjAsBackupSchedule()
+	_, ok := schedule.(*cloudresourcesv1beta1.AzureRedisBackupSchedule)
+	if !ok {
+		return nil, fmt.Errorf("provider %s not supported", state.Scope.Spec.Provider)
+	}
+
+	return &cloudresourcesv1beta1.AzureRedisInstanceBackup{
+		ObjectMeta: *objectMeta,
+		Spec: cloudresourcesv1beta1.AzureRedisInstanceBackupSpec{
+			Source: cloudresourcesv1beta1.RedisInstanceBackupSource{
+				RedisInstance: cloudresourcesv1beta1.RedisInstanceRef{
+					Name:      schedule.GetSourceRef().Name,
+					Namespace: schedule.GetSourceRef().Namespace,
+				},
+			},
+		},
+	}, nil
+}
+
+func (impl *backupImplAzureRedis) sourceToObjWithConditionAndState(obj client.Object) (composed.ObjWithConditionsAndState, error) {
+	x, ok := obj.(*cloudresourcesv1beta1.AzureRedisInstance)
+	if !ok {
+		return nil, errors.New("source Object should be of type AzureRedisInstance")
+	}
+	return x, nil
+}
@@ -56,6 +56,7 @@ func (impl *backupImplAzureRwx) getBackupObject(state *State, objectMeta *metav1
 					Namespace: schedule.GetSourceRef().Namespace,
 				},
 			},
+			CopyTo: x.Spec.CopyTo,
 		},
 	}, nil
 }
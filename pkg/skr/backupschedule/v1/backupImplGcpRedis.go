@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type backupImplGcpRedis struct {
+}
+
+func (impl *backupImplGcpRedis) emptyScheduleObject() composed.ObjWithConditionsAndState {
+	return &cloudresourcesv1beta1.GcpRedisBackupSchedule{}
+}
+
+func (impl *backupImplGcpRedis) emptySourceObject() client.Object {
+	return &cloudresourcesv1beta1.GcpRedisInstance{}
+}
+
+func (impl *backupImplGcpRedis) emptyBackupList() client.ObjectList {
+	return &cloudresourcesv1beta1.GcpRedisInstanceBackupList{}
+}
+
+func (impl *backupImplGcpRedis) toObjectSlice(list client.ObjectList) []client.Object {
+	var objects []client.Object
+
+	if x, ok := list.(*cloudresourcesv1beta1.GcpRedisInstanceBackupList); ok {
+		for _, item := range x.Items {
+			objects = append(objects, &item)
+		}
+	}
+	return objects
+}
+
+func (impl *backupImplGcpRedis) getBackupObject(state *State, objectMeta *metav1.ObjectMeta) (client.Object, error) {
+	schedule := state.ObjAsBackupSchedule()
+	x, ok := schedule.(*cloudresourcesv1beta1.GcpRedisBackupSchedule)
+	if !ok {
+		return nil, fmt.Errorf("provider %s not supported", state.Scope.Spec.Provider)
+	}
+
+	return &cloudresourcesv1beta1.GcpRedisInstanceBackup{
+		ObjectMeta: *objectMeta,
+		Spec: cloudresourcesv1beta1.GcpRedisInstanceBackupSpec{
+			Source: cloudresourcesv1beta1.RedisInstanceBackupSource{
+				RedisInstance: cloudresourcesv1beta1.RedisInstanceRef{
+					Name:      schedule.GetSourceRef().Name,
+					Namespace: schedule.GetSourceRef().Namespace,
+				},
+			},
+			Bucket: x.Spec.Bucket,
+		},
+	}, nil
+}
+
+func (impl *backupImplGcpRedis) sourceToObjWithConditionAndState(obj client.Object) (composed.ObjWithConditionsAndState, error) {
+	x, ok := obj.(*cloudresourcesv1beta1.GcpRedisInstance)
+	if !ok {
+		return nil, errors.New("source Object should be of type GcpRedisInstance")
+	}
+	return x, nil
+}
@@ -3,9 +3,9 @@ package v1
 import (
 	"context"
 
-	"github.com/gorhill/cronexpr"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -22,13 +22,7 @@ func validateSchedule(ctx context.Context, st composed.State) (error, context.Co
 	ctx = composed.LoggerIntoCtx(ctx, logger)
 	logger.Info("Validating BackupSchedule - Cron Expression")
 
-	sch := schedule.GetSchedule()
-
-	//If schedule is empty, continue
-	if sch == "" {
-		return nil, nil
-	}
-	expr, err := cronexpr.Parse(sch)
+	expr, err := backupschedule.CheckCronExpression(schedule.GetSchedule())
 
 	if err != nil {
 		logger.Error(err, "Invalid cron expression")
@@ -45,6 +39,11 @@ func validateSchedule(ctx context.Context, st composed.State) (error, context.Co
 			Run(ctx, state)
 	}
 
+	//If schedule is empty, continue
+	if expr == nil {
+		return nil, nil
+	}
+
 	logger.Info("Validated Cron Expression")
 
 	state.cronExpression = expr
@@ -3,7 +3,6 @@ package backupschedule
 import (
 	"context"
 
-	"github.com/gorhill/cronexpr"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,14 +15,7 @@ func ValidateSchedule(ctx context.Context, st composed.State) (error, context.Co
 
 	logger.Info("Validating BackupSchedule - Cron Expression")
 
-	sch := schedule.GetSchedule()
-
-	//If schedule is empty, continue (one-time schedule)
-	if sch == "" {
-		return nil, nil
-	}
-
-	expr, err := cronexpr.Parse(sch)
+	expr, err := CheckCronExpression(schedule.GetSchedule())
 	if err != nil {
 		logger.Error(err, "Invalid cron expression")
 
@@ -39,6 +31,11 @@ func ValidateSchedule(ctx context.Context, st composed.State) (error, context.Co
 			Run(ctx, state)
 	}
 
+	//If schedule is empty, continue (one-time schedule)
+	if expr == nil {
+		return nil, nil
+	}
+
 	logger.Info("Validated Cron Expression")
 
 	state.SetCronExpression(expr)
@@ -56,7 +56,7 @@ func ValidateTimes(ctx context.Context, st composed.State) (error, context.Conte
 		refTime = start.Time
 	}
 
-	if end != nil && !end.IsZero() && refTime.After(end.Time) {
+	if err := CheckEndTime(refTime, end); err != nil {
 		logger.Info("Invalid end time")
 
 		schedule.SetState(cloudresourcesv1beta1.JobStateError)
@@ -65,7 +65,7 @@ func ValidateTimes(ctx context.Context, st composed.State) (error, context.Conte
 				Type:    cloudresourcesv1beta1.ConditionTypeError,
 				Status:  metav1.ConditionTrue,
 				Reason:  cloudresourcesv1beta1.ReasonInvalidEndTime,
-				Message: "End time cannot be before start/creation time.",
+				Message: err.Error(),
 			}).
 			SuccessError(composed.StopAndForget).
 			Run(ctx, state)
@@ -0,0 +1,29 @@
+package backupschedule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gorhill/cronexpr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var ErrEndTimeBeforeStart = errors.New("End time cannot be before start/creation time.")
+
+// CheckCronExpression parses the schedule cron expression. Empty schedule is valid and
+// denotes a one-time schedule, in which case nil expression is returned.
+func CheckCronExpression(schedule string) (*cronexpr.Expression, error) {
+	if schedule == "" {
+		return nil, nil
+	}
+	return cronexpr.Parse(schedule)
+}
+
+// CheckEndTime returns ErrEndTimeBeforeStart if the end time is set and is before the
+// given refTime, that is the start time if set, or the creation time otherwise.
+func CheckEndTime(refTime time.Time, end *metav1.Time) error {
+	if end != nil && !end.IsZero() && refTime.After(end.Time) {
+		return ErrEndTimeBeforeStart
+	}
+	return nil
+}
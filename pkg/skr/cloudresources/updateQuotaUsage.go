@@ -0,0 +1,37 @@
+package cloudresources
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/quota"
+)
+
+// updateQuotaUsage reports the current usage of SKR quotas in the status. The status quotas
+// are the parameter of the quota admission policy installed in the SKR.
+func updateQuotaUsage(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if composed.MarkedForDeletionPredicate(ctx, state) {
+		return nil, nil
+	}
+
+	usage, err := quota.SkrUsage(ctx, state.Cluster().K8sClient(), state.Cluster().Scheme(), state.KymaRef.Name)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error calculating SKR quota usage", composed.StopWithRequeue, ctx)
+	}
+
+	if reflect.DeepEqual(usage, state.ObjAsCloudResources().Status.Quotas) {
+		return nil, nil
+	}
+
+	logger.Info("Updating SKR quota usage in CloudResources status")
+	state.ObjAsCloudResources().Status.Quotas = usage
+
+	return composed.UpdateStatus(state.ObjAsCloudResources()).
+		ErrorLogMessage("Error updating CloudResources status with quota usage").
+		SuccessErrorNil().
+		Run(ctx, state)
+}
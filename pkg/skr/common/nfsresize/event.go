@@ -0,0 +1,15 @@
+package nfsresize
+
+const (
+	eventReasonResizing        = "Resizing"
+	eventReasonResizeRejected  = "ResizeRejected"
+	eventReasonCapacityUpdated = "CapacityUpdated"
+)
+
+func recordEvent(state State, eventType, reason, note string, args ...interface{}) {
+	recorder := state.Cluster().EventRecorder()
+	if recorder == nil {
+		return
+	}
+	recorder.Eventf(state.Obj(), nil, eventType, reason, "Resize", note, args...)
+}
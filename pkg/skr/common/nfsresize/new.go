@@ -0,0 +1,50 @@
+package nfsresize
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+// New returns a composed.Action that implements the resize flow common to all SKR nfs volumes:
+// * if the requested capacity differs from the backing share capacity, validate the change
+// and request the backing share resize, or set the Error condition if it is not allowed
+// * once the backing share provides the requested capacity, update the PersistentVolume capacity
+// * update the bound PersistentVolumeClaim status capacity to the PersistentVolume capacity
+// The provided state MUST implement State interface and object in the state MUST implement
+// composed.ObjWithConditionsAndState. Nothing is done if object is marked for deletion.
+func New() composed.Action {
+	return func(ctx context.Context, st composed.State) (error, context.Context) {
+		state, ok := st.(State)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("state %T provided to nfsresize flow does not implement nfsresize.State", st),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		_, ok = state.Obj().(composed.ObjWithConditionsAndState)
+		if !ok {
+			return composed.LogErrorAndReturn(
+				fmt.Errorf("object %T provided to nfsresize flow does not implement composed.ObjWithConditionsAndState", state.Obj()),
+				"Logical error",
+				composed.StopAndForget,
+				ctx,
+			)
+		}
+
+		if composed.MarkedForDeletionPredicate(ctx, state) {
+			return nil, ctx
+		}
+
+		return composed.ComposeActions(
+			"nfsresize",
+			shareResize,
+			pvCapacityUpdate,
+			pvcCapacityUpdate,
+		)(ctx, state)
+	}
+}
@@ -0,0 +1,160 @@
+package nfsresize
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testState struct {
+	composed.State
+
+	pv          *corev1.PersistentVolume
+	pvc         *corev1.PersistentVolumeClaim
+	backing     *resource.Quantity
+	provisioned *resource.Quantity
+	validateErr error
+	resizedTo   *resource.Quantity
+}
+
+var _ State = &testState{}
+
+func (s *testState) GetPV() *corev1.PersistentVolume       { return s.pv }
+func (s *testState) GetPVC() *corev1.PersistentVolumeClaim { return s.pvc }
+func (s *testState) RequestedCapacity() resource.Quantity {
+	return resource.MustParse("200G")
+}
+func (s *testState) BackingCapacity() *resource.Quantity     { return s.backing }
+func (s *testState) ProvisionedCapacity() *resource.Quantity { return s.provisioned }
+func (s *testState) ValidateResize(_, _ resource.Quantity) error {
+	return s.validateErr
+}
+func (s *testState) ResizeBacking(_ context.Context, to resource.Quantity) error {
+	s.resizedTo = &to
+	s.backing = &to
+	return nil
+}
+
+func newTestState() (*testState, client.Client) {
+	vol := &cloudresourcesv1beta1.SapNfsVolume{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vol"},
+		Spec:       cloudresourcesv1beta1.SapNfsVolumeSpec{CapacityGb: 200},
+		Status: cloudresourcesv1beta1.SapNfsVolumeStatus{
+			State: cloudresourcesv1beta1.StateReady,
+			Conditions: []metav1.Condition{{
+				Type:   cloudresourcesv1beta1.ConditionTypeReady,
+				Status: metav1.ConditionTrue,
+				Reason: cloudresourcesv1beta1.ConditionTypeReady,
+			}},
+		},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv"},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("100G")},
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "vol",
+			Labels:    map[string]string{cloudresourcesv1beta1.LabelStorageCapacity: "100G"},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("100G")},
+		},
+	}
+	clnt := fake.NewClientBuilder().
+		WithScheme(commonscheme.SkrScheme).
+		WithObjects(vol, pv, pvc).
+		WithStatusSubresource(vol, pvc).
+		Build()
+	cluster := composed.NewStateCluster(clnt, clnt, nil, commonscheme.SkrScheme)
+	st := composed.NewStateFactory(cluster).NewState(client.ObjectKeyFromObject(vol), vol)
+
+	return &testState{
+		State:   st,
+		pv:      pv,
+		pvc:     pvc,
+		backing: new(resource.MustParse("100G")),
+	}, clnt
+}
+
+func TestResize(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejected resize sets error condition", func(t *testing.T) {
+		state, clnt := newTestState()
+		state.validateErr = errors.New("can not be reduced")
+
+		err, _ := New()(ctx, state)
+		assert.Equal(t, composed.StopAndForget, err)
+		assert.Nil(t, state.resizedTo)
+
+		vol := &cloudresourcesv1beta1.SapNfsVolume{}
+		require.NoError(t, clnt.Get(ctx, state.Name(), vol))
+		assert.Equal(t, cloudresourcesv1beta1.StateError, vol.Status.State)
+		cond := meta.FindStatusCondition(vol.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+		require.NotNil(t, cond)
+		assert.Equal(t, cloudresourcesv1beta1.ConditionReasonUnsupportedCapacityChange, cond.Reason)
+		assert.Equal(t, "can not be reduced", cond.Message)
+	})
+
+	t.Run("resize is requested and pv is updated once provisioned", func(t *testing.T) {
+		state, clnt := newTestState()
+
+		err, _ := New()(ctx, state)
+		assert.Error(t, err, "expected requeue after resize request")
+		require.NotNil(t, state.resizedTo)
+		assert.Equal(t, "200G", state.resizedTo.String())
+
+		vol := &cloudresourcesv1beta1.SapNfsVolume{}
+		require.NoError(t, clnt.Get(ctx, state.Name(), vol))
+		assert.Equal(t, cloudresourcesv1beta1.StateUpdating, vol.Status.State)
+		assert.Nil(t, meta.FindStatusCondition(vol.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady))
+
+		// backing share not yet provisioned with new capacity
+		state.provisioned = new(resource.MustParse("100G"))
+		err, _ = New()(ctx, state)
+		assert.NoError(t, err)
+		pv := &corev1.PersistentVolume{}
+		require.NoError(t, clnt.Get(ctx, client.ObjectKey{Name: "pv"}, pv))
+		assert.Equal(t, "100G", new(pv.Spec.Capacity[corev1.ResourceStorage]).String())
+
+		state.provisioned = new(resource.MustParse("200G"))
+		err, _ = New()(ctx, state)
+		assert.NoError(t, err)
+		require.NoError(t, clnt.Get(ctx, client.ObjectKey{Name: "pv"}, pv))
+		assert.Equal(t, "200G", new(pv.Spec.Capacity[corev1.ResourceStorage]).String())
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		require.NoError(t, clnt.Get(ctx, client.ObjectKey{Namespace: "default", Name: "vol"}, pvc))
+		assert.Equal(t, "200G", new(pvc.Status.Capacity[corev1.ResourceStorage]).String())
+		assert.Equal(t, "200G", pvc.Labels[cloudresourcesv1beta1.LabelStorageCapacity])
+	})
+
+	t.Run("elastic volume w/out backing capacity updates pv directly", func(t *testing.T) {
+		state, clnt := newTestState()
+		state.backing = nil
+
+		err, _ := New()(ctx, state)
+		assert.NoError(t, err)
+		assert.Nil(t, state.resizedTo)
+
+		pv := &corev1.PersistentVolume{}
+		require.NoError(t, clnt.Get(ctx, client.ObjectKey{Name: "pv"}, pv))
+		assert.Equal(t, "200G", new(pv.Spec.Capacity[corev1.ResourceStorage]).String())
+	})
+}
@@ -0,0 +1,57 @@
+package nfsresize
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func pvCapacityUpdate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	pv := state.GetPV()
+	if pv == nil {
+		return nil, ctx
+	}
+
+	requested := state.RequestedCapacity()
+
+	// wait until the backing share is resized
+	if backing := state.BackingCapacity(); backing != nil && !backing.Equal(requested) {
+		return nil, ctx
+	}
+	if provisioned := state.ProvisionedCapacity(); provisioned != nil && !provisioned.Equal(requested) {
+		return nil, ctx
+	}
+
+	current := pv.Spec.Capacity[corev1.ResourceStorage]
+	if current.Equal(requested) {
+		return nil, ctx
+	}
+
+	p := map[string]any{
+		"spec": map[string]any{
+			"capacity": map[string]any{
+				string(corev1.ResourceStorage): requested.String(),
+			},
+		},
+	}
+	err := composed.MergePatchObj(ctx, pv, p, state.Cluster().K8sClient())
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error patching PersistentVolume capacity", composed.StopWithRequeue, ctx)
+	}
+
+	logger.
+		WithValues(
+			"pvName", pv.Name,
+			"fromCapacity", current.String(),
+			"toCapacity", requested.String(),
+		).
+		Info("Updated PersistentVolume capacity")
+
+	recordEvent(state, corev1.EventTypeNormal, eventReasonCapacityUpdated, "PersistentVolume %s capacity updated from %s to %s", pv.Name, current.String(), requested.String())
+
+	return nil, ctx
+}
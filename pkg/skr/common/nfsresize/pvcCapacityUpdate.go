@@ -0,0 +1,65 @@
+package nfsresize
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pvcCapacityUpdate sets the bound PersistentVolumeClaim status capacity to the PersistentVolume capacity,
+// and its storage capacity label to the requested capacity. The PVC spec is not changed since static PVCs w/out storage class can not be
+// expanded, and the status capacity is set by the PV controller only at binding time.
+func pvcCapacityUpdate(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	pv := state.GetPV()
+	pvc := state.GetPVC()
+	if pv == nil || pvc == nil {
+		return nil, ctx
+	}
+
+	pvCapacity, ok := pv.Spec.Capacity[corev1.ResourceStorage]
+	if !ok {
+		return nil, ctx
+	}
+
+	requested := state.RequestedCapacity()
+	label, hasLabel := pvc.Labels[cloudresourcesv1beta1.LabelStorageCapacity]
+	if hasLabel && label != requested.String() {
+		original := pvc.DeepCopy()
+		pvc.Labels[cloudresourcesv1beta1.LabelStorageCapacity] = requested.String()
+		err := state.Cluster().K8sClient().Patch(ctx, pvc, client.MergeFrom(original))
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error patching PersistentVolumeClaim storage capacity label", composed.StopWithRequeue, ctx)
+		}
+	}
+
+	// not yet bound, PV controller will set the capacity
+	current, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+	if !ok || current.Equal(pvCapacity) {
+		return nil, ctx
+	}
+
+	original := pvc.DeepCopy()
+	pvc.Status.Capacity[corev1.ResourceStorage] = pvCapacity
+	err := state.Cluster().K8sClient().Status().Patch(ctx, pvc, client.MergeFrom(original))
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error patching PersistentVolumeClaim status capacity", composed.StopWithRequeue, ctx)
+	}
+
+	logger.
+		WithValues(
+			"pvcName", pvc.Name,
+			"fromCapacity", current.String(),
+			"toCapacity", pvCapacity.String(),
+		).
+		Info("Updated PersistentVolumeClaim status capacity")
+
+	recordEvent(state, corev1.EventTypeNormal, eventReasonCapacityUpdated, "PersistentVolumeClaim %s capacity updated from %s to %s", pvc.Name, current.String(), pvCapacity.String())
+
+	return nil, ctx
+}
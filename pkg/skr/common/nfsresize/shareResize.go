@@ -0,0 +1,82 @@
+package nfsresize
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func shareResize(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(State)
+	logger := composed.LoggerFromCtx(ctx)
+	obj := state.Obj().(composed.ObjWithConditionsAndState)
+
+	backing := state.BackingCapacity()
+	if backing == nil {
+		return nil, ctx
+	}
+	requested := state.RequestedCapacity()
+
+	condErr := meta.FindStatusCondition(*obj.Conditions(), cloudresourcesv1beta1.ConditionTypeError)
+	hasUnsupportedCapacityChange := condErr != nil && condErr.Reason == cloudresourcesv1beta1.ConditionReasonUnsupportedCapacityChange
+
+	if backing.Equal(requested) {
+		if !hasUnsupportedCapacityChange {
+			return nil, ctx
+		}
+		// capacity is reverted after rejected change, the provider flow will set Ready again
+		obj.SetState(cloudresourcesv1beta1.StateProcessing)
+		return composed.PatchStatus(obj).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			ErrorLogMessage("Error removing unsupported capacity change condition from SKR nfs volume").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
+	logger = logger.WithValues(
+		"fromCapacity", backing.String(),
+		"toCapacity", requested.String(),
+	)
+	ctx = composed.LoggerIntoCtx(ctx, logger)
+
+	if err := state.ValidateResize(*backing, requested); err != nil {
+		if hasUnsupportedCapacityChange && condErr.Message == err.Error() {
+			return composed.StopAndForget, nil
+		}
+		logger.Info("Rejecting SKR nfs volume resize: " + err.Error())
+		recordEvent(state, corev1.EventTypeWarning, eventReasonResizeRejected, "Resize from %s to %s rejected: %s", backing.String(), requested.String(), err.Error())
+		obj.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(obj).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonUnsupportedCapacityChange,
+				Message: err.Error(),
+			}).
+			ErrorLogMessage("Error patching SKR nfs volume status with unsupported capacity change").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	logger.Info("Resizing SKR nfs volume backing share")
+
+	if err := state.ResizeBacking(ctx, requested); err != nil {
+		return composed.LogErrorAndReturn(err, "Error resizing SKR nfs volume backing share", composed.StopWithRequeue, ctx)
+	}
+
+	recordEvent(state, corev1.EventTypeNormal, eventReasonResizing, "Resizing from %s to %s", backing.String(), requested.String())
+
+	obj.SetState(cloudresourcesv1beta1.StateUpdating)
+	return composed.PatchStatus(obj).
+		RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady, cloudresourcesv1beta1.ConditionTypeError).
+		ErrorLogMessage("Error patching SKR nfs volume status with Updating state for resize").
+		// the backing share is already requested to resize, the next loop will pick up its state
+		FailedError(composed.StopWithRequeueDelay(util.Timing.T1000ms())).
+		SuccessError(composed.StopWithRequeueDelay(util.Timing.T1000ms())).
+		Run(ctx, state)
+}
@@ -0,0 +1,34 @@
+package nfsresize
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+type State interface {
+	composed.State
+
+	GetPV() *corev1.PersistentVolume
+	GetPVC() *corev1.PersistentVolumeClaim
+
+	// RequestedCapacity returns the capacity requested in the SKR volume spec, in the same
+	// form as it is set to the PersistentVolume
+	RequestedCapacity() resource.Quantity
+
+	// BackingCapacity returns the capacity the backing share is requested with, or nil if the
+	// share is not yet created or has no provisioned capacity, like elastic EFS
+	BackingCapacity() *resource.Quantity
+
+	// ProvisionedCapacity returns the capacity the backing share reports, or nil if it is not
+	// known. The PersistentVolume capacity is updated only once it matches the requested capacity.
+	ProvisionedCapacity() *resource.Quantity
+
+	// ValidateResize returns an error if the backing share can not be resized from one capacity to another
+	ValidateResize(from, to resource.Quantity) error
+
+	// ResizeBacking requests the resize of the backing share, usually by updating the KCP NfsInstance
+	ResizeBacking(ctx context.Context, to resource.Quantity) error
+}
@@ -63,6 +63,7 @@ func createBackup(ctx context.Context, st composed.State) (error, context.Contex
 				},
 			},
 			AccessibleFrom: gcpSchedule.Spec.AccessibleFrom,
+			CopyTo:         gcpSchedule.Spec.CopyTo,
 		},
 	}
 
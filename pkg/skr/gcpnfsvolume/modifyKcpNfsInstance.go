@@ -22,14 +22,12 @@ func modifyKcpNfsInstance(ctx context.Context, st composed.State) (error, contex
 		return nil, ctx
 	}
 
+	// capacity changes of existing KCP NfsInstance are handled by the nfsresize flow
 	if state.KcpNfsInstance == nil {
 		return createKcpNfsInstance(ctx, state, logger.WithValues("operation", "createKcpNfsInstance"))
-	} else if state.IsChanged() {
-		return updateKcpNfsInstance(ctx, state, logger.WithValues("operation", "updateKcpNfsInstance"))
 	}
 
 	return nil, ctx
-
 }
 
 func createKcpNfsInstance(ctx context.Context, state *State, logger logr.Logger) (error, context.Context) {
@@ -96,29 +94,6 @@ func createKcpNfsInstance(ctx context.Context, state *State, logger logr.Logger)
 	return err, ctx
 }
 
-func updateKcpNfsInstance(ctx context.Context, state *State, logger logr.Logger) (error, context.Context) {
-	modified := state.KcpNfsInstance.DeepCopy()
-	// As of now, only CapacityGb is mutable
-	modified.Spec.Instance.Gcp.CapacityGb = state.ObjAsGcpNfsVolume().Spec.CapacityGb
-	err := state.KcpCluster.K8sClient().Update(ctx, modified)
-
-	if err != nil {
-		logger.Error(err, "Error updating KCP NfsInstance")
-		return composed.StopWithRequeue, nil
-	}
-	logger.
-		WithValues("kcpNfsInstanceName", state.KcpNfsInstance.Name).
-		Info("KCP NFS instance got updated")
-
-	// Update the object with the ID of the KCP NfsInstance
-	state.ObjAsGcpNfsVolume().Status.Id = state.KcpNfsInstance.Name
-	state.ObjAsGcpNfsVolume().Status.State = cloudresourcesv1beta1.GcpNfsVolumeProcessing
-	return composed.UpdateStatus(state.ObjAsGcpNfsVolume()).
-		RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
-		SuccessError(composed.StopWithRequeue).
-		Run(ctx, state)
-}
-
 func getLocation(state *State, logger logr.Logger) (string, error) {
 	switch state.ObjAsGcpNfsVolume().Spec.Tier {
 	case cloudresourcesv1beta1.REGIONAL:
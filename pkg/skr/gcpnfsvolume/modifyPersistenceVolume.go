@@ -21,7 +21,6 @@ func modifyPersistenceVolume(ctx context.Context, st composed.State) (error, con
 
 	//Get GcpNfsVolume object
 	nfsVolume := state.ObjAsGcpNfsVolume()
-	capacity := gcpNfsVolumeCapacityToResourceQuantity(nfsVolume)
 
 	//If GcpNfsVolume is not Ready state, continue.
 	if !meta.IsStatusConditionTrue(nfsVolume.Status.Conditions, v1beta1.ConditionTypeReady) {
@@ -33,14 +32,8 @@ func modifyPersistenceVolume(ctx context.Context, st composed.State) (error, con
 		return nil, ctx
 	}
 
-	//Modify PV if any changes are done to GcpNfsVolume.
+	//Modify PV if any changes are done to GcpNfsVolume, capacity is updated by the nfsresize flow.
 	changed := false
-	if !capacity.Equal(state.PV.Spec.Capacity["storage"]) {
-		changed = true
-		state.PV.Spec.Capacity["storage"] = *capacity
-		logger.Info("Detected modified PV capacity")
-	}
-
 	expectedLabels := getVolumeLabels(nfsVolume)
 	if !areLabelsEqual(state.PV.Labels, expectedLabels) {
 		changed = true
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/util"
 
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsresize"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
@@ -74,7 +75,9 @@ func (r *Reconciler) newAction() composed.Action {
 		loadPersistenceVolume,
 		sanitizeReleasedVolume,
 		loadPersistentVolumeClaim,
+		validateRegionCapabilities,
 		modifyKcpNfsInstance,
+		nfsresize.New(),
 		removePersistenceVolumeClaimFinalizer,
 		removePersistenceVolumeFinalizer,
 		deletePersistentVolumeClaim,
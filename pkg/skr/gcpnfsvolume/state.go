@@ -13,10 +13,12 @@ import (
 	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
 	gcpnfsbackupclientv1 "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v1"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsresize"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"google.golang.org/api/file/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
 )
 
@@ -37,6 +39,8 @@ type State struct {
 	fileBackupClient gcpnfsbackupclientv1.FileBackupClient
 }
 
+var _ nfsresize.State = &State{}
+
 type StateFactory interface {
 	NewState(ctx context.Context, baseState composed.State) (*State, error)
 }
@@ -89,8 +93,47 @@ func (s *State) ObjAsGcpNfsVolume() *cloudresourcesv1beta1.GcpNfsVolume {
 	return s.Obj().(*cloudresourcesv1beta1.GcpNfsVolume)
 }
 
-func (s *State) IsChanged() bool {
-	return s.KcpNfsInstance != nil && s.KcpNfsInstance.Spec.Instance.Gcp.CapacityGb != s.ObjAsGcpNfsVolume().Spec.CapacityGb
+func (s *State) GetPV() *corev1.PersistentVolume {
+	return s.PV
+}
+
+func (s *State) GetPVC() *corev1.PersistentVolumeClaim {
+	return s.PVC
+}
+
+func (s *State) RequestedCapacity() resource.Quantity {
+	return *gcpNfsVolumeCapacityToResourceQuantity(s.ObjAsGcpNfsVolume())
+}
+
+func (s *State) BackingCapacity() *resource.Quantity {
+	if s.KcpNfsInstance == nil || s.KcpNfsInstance.Spec.Instance.Gcp == nil {
+		return nil
+	}
+	return capacityGbToResourceQuantity(s.KcpNfsInstance.Spec.Instance.Gcp.CapacityGb)
+}
+
+func (s *State) ProvisionedCapacity() *resource.Quantity {
+	if s.KcpNfsInstance == nil || s.KcpNfsInstance.Status.CapacityGb == 0 {
+		return nil
+	}
+	return capacityGbToResourceQuantity(s.KcpNfsInstance.Status.CapacityGb)
+}
+
+// ValidateResize allows any resize, shrinking of BASIC tiers that Filestore does not support is
+// rejected by the GcpNfsVolume CRD validation rules
+func (s *State) ValidateResize(_, _ resource.Quantity) error {
+	return nil
+}
+
+func (s *State) ResizeBacking(ctx context.Context, _ resource.Quantity) error {
+	modified := s.KcpNfsInstance.DeepCopy()
+	// As of now, only CapacityGb is mutable
+	modified.Spec.Instance.Gcp.CapacityGb = s.ObjAsGcpNfsVolume().Spec.CapacityGb
+	if err := s.KcpCluster.K8sClient().Update(ctx, modified); err != nil {
+		return err
+	}
+	s.KcpNfsInstance = modified
+	return nil
 }
 
 func (s *State) GetSkrIpRange() *cloudresourcesv1beta1.IpRange {
@@ -86,7 +86,11 @@ func getVolumeClaimAnnotations(gcpVol *cloudresourcesv1beta1.GcpNfsVolume) map[s
 }
 
 func gcpNfsVolumeCapacityToResourceQuantity(gcpVol *cloudresourcesv1beta1.GcpNfsVolume) *resource.Quantity {
-	return resource.NewQuantity(int64(gcpVol.Spec.CapacityGb)*1024*1024*1024, resource.BinarySI)
+	return capacityGbToResourceQuantity(gcpVol.Spec.CapacityGb)
+}
+
+func capacityGbToResourceQuantity(capacityGb int) *resource.Quantity {
+	return resource.NewQuantity(int64(capacityGb)*1024*1024*1024, resource.BinarySI)
 }
 
 func areLabelsEqual(first, second map[string]string) bool {
@@ -0,0 +1,47 @@
+package gcpnfsvolume
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/capability"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func validateRegionCapabilities(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, ctx
+	}
+
+	// only validated before the KCP NfsInstance is created, existing volumes are not affected
+	if state.KcpNfsInstance != nil {
+		return nil, ctx
+	}
+
+	volume := state.ObjAsGcpNfsVolume()
+	region := capability.ForScope(state.Scope)
+
+	err := errors.Join(
+		region.CheckService(cloudcontrolv1beta1.CapabilityServiceNfs),
+		region.CheckNfsTier(string(volume.Spec.Tier)),
+	)
+	if err == nil {
+		return nil, ctx
+	}
+
+	volume.Status.State = cloudresourcesv1beta1.GcpNfsVolumeError
+	return composed.PatchStatus(volume).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonUnavailableInRegion,
+			Message: err.Error(),
+		}).
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
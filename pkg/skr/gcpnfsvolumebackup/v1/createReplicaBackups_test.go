@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/api/file/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type createReplicaBackupsSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *createReplicaBackupsSuite) SetupTest() {
+	s.ctx = log.IntoContext(context.Background(), logr.Discard())
+}
+
+func (s *createReplicaBackupsSuite) TestWhenBackupIsNotReady() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "CREATING"}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Nil(err)
+}
+
+func (s *createReplicaBackupsSuite) TestWhenReplicaIsCreated() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/projects/test-project/locations/us-east1/backups") &&
+			r.URL.Query().Get("backupId") == "cm-cffd6896-0127-48a1-8a64-e07f6ad5c912" {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"create-copy-operation-id"}`))
+			return
+		}
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "READY"}
+	state.copyBackups = map[string]*file.Backup{}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Equal(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Len(fromK8s.Status.Copies, 1)
+	s.Equal("us-east1", fromK8s.Status.Copies[0].Location)
+	s.Equal(v1beta1.StateCreating, fromK8s.Status.Copies[0].State)
+	s.Equal("projects/test-project/locations/us-east1/backups/cm-cffd6896-0127-48a1-8a64-e07f6ad5c912", fromK8s.Status.Copies[0].Id)
+}
+
+func (s *createReplicaBackupsSuite) TestWhenCreateReplicaReturnsError() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "READY"}
+	state.copyBackups = map[string]*file.Backup{}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Nil(err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Len(fromK8s.Status.Copies, 1)
+	s.Equal(v1beta1.StateError, fromK8s.Status.Copies[0].State)
+	s.NotEmpty(fromK8s.Status.Copies[0].Message)
+	errCondition := meta.FindStatusCondition(fromK8s.Status.Conditions, v1beta1.ConditionTypeError)
+	s.NotNil(errCondition)
+	s.Equal(v1beta1.ConditionReasonBackupCopyFailed, errCondition.Reason)
+	s.NotNil(meta.FindStatusCondition(fromK8s.Status.Conditions, v1beta1.ConditionTypeReady))
+}
+
+func (s *createReplicaBackupsSuite) TestWhenFailedReplicaIsNotRetried() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	obj.Status.Copies = []v1beta1.BackupCopyStatus{
+		{Location: "us-east1", State: v1beta1.StateError, Message: "Internal error"},
+	}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "READY"}
+	state.copyBackups = map[string]*file.Backup{}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Nil(err)
+	s.True(v1beta1.AllBackupCopiesDone(state.copyLocations(), obj.Status.Copies))
+}
+
+func (s *createReplicaBackupsSuite) TestWhenCopyToContainsBackupLocation() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.Location = ""
+	obj.Spec.CopyTo = []string{"us-west1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "READY"}
+	state.copyBackups = map[string]*file.Backup{}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Nil(err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Len(fromK8s.Status.Copies, 1)
+	s.Equal(v1beta1.StateError, fromK8s.Status.Copies[0].State)
+	s.Equal("CopyTo must not contain the location of the backup", fromK8s.Status.Copies[0].Message)
+}
+
+func (s *createReplicaBackupsSuite) TestWhenSourceVolumeIsDeleted() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+	s.Nil(factory.skrCluster.K8sClient().Delete(s.ctx, gcpNfsVolume.DeepCopy()))
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "READY"}
+	state.copyBackups = map[string]*file.Backup{}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Nil(err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Len(fromK8s.Status.Copies, 1)
+	s.Equal(v1beta1.StateError, fromK8s.Status.Copies[0].State)
+	s.Equal("Source GcpNfsVolume test-gcp-nfs-volume no longer exists", fromK8s.Status.Copies[0].Message)
+	errCondition := meta.FindStatusCondition(fromK8s.Status.Conditions, v1beta1.ConditionTypeError)
+	s.NotNil(errCondition)
+	s.Contains(errCondition.Message, "us-east1: Source GcpNfsVolume test-gcp-nfs-volume no longer exists")
+}
+
+func (s *createReplicaBackupsSuite) TestWhenReplicaIsReady() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.fileBackup = &file.Backup{State: "READY"}
+	state.copyBackups = map[string]*file.Backup{
+		"us-east1": {Name: "projects/test-project/locations/us-east1/backups/cm-cffd6896-0127-48a1-8a64-e07f6ad5c912", State: "READY"},
+	}
+
+	err, _ = createReplicaBackups(s.ctx, state)
+	s.Nil(err)
+
+	err, _ = updateBackupCopies(s.ctx, state)
+	s.Nil(err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Len(fromK8s.Status.Copies, 1)
+	s.Equal(v1beta1.StateReady, fromK8s.Status.Copies[0].State)
+	s.True(v1beta1.AllBackupCopiesReady(state.copyLocations(), fromK8s.Status.Copies))
+}
+
+func TestCreateReplicaBackups(t *testing.T) {
+	suite.Run(t, new(createReplicaBackupsSuite))
+}
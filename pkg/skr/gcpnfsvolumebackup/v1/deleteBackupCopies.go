@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+)
+
+// deleteBackupCopies deletes the copies of the backup and waits until they are gone,
+// before the backup itself gets deleted.
+func deleteBackupCopies(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsGcpNfsVolumeBackup()
+
+	if !composed.MarkedForDeletionPredicate(ctx, st) {
+		return nil, nil
+	}
+
+	if len(state.copyBackups) == 0 {
+		if len(backup.Status.Copies) == 0 {
+			return nil, nil
+		}
+		backup.Status.Copies = nil
+		return composed.PatchStatus(backup).
+			SuccessErrorNil().
+			Run(ctx, state)
+	}
+
+	project := state.Scope.Spec.Scope.Gcp.Project
+	name := fmt.Sprintf("cm-%.60s", backup.Status.Id)
+	for location, copyBackup := range state.copyBackups {
+		if copyBackup.State == "DELETING" {
+			continue
+		}
+
+		logger.Info("Deleting GCP File Backup copy", "location", location)
+		_, err := state.fileBackupClient.DeleteFileBackup(ctx, project, location, name)
+		if err != nil && !gcpmeta.IsNotFound(err) {
+			return composed.LogErrorAndReturn(err, "Error deleting GCP File Backup copy", composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), ctx)
+		}
+
+		backup.Status.Copies = cloudresourcesv1beta1.SetBackupCopy(backup.Status.Copies, cloudresourcesv1beta1.BackupCopyStatus{
+			Location: location,
+			State:    cloudresourcesv1beta1.StateDeleting,
+			Id:       copyBackup.Name,
+		})
+	}
+
+	return composed.PatchStatus(backup).
+		SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime)).
+		SuccessLogMsg("Waiting for GCP File Backup copies to be deleted").
+		Run(ctx, state)
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/api/file/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type deleteBackupCopiesSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func (s *deleteBackupCopiesSuite) SetupTest() {
+	s.ctx = log.IntoContext(context.Background(), logr.Discard())
+}
+
+func (s *deleteBackupCopiesSuite) TestWhenBackupIsNotDeleting() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := gcpNfsVolumeBackup.DeepCopy()
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.copyBackups = map[string]*file.Backup{
+		"us-east1": {State: "READY"},
+	}
+
+	err, _ = deleteBackupCopies(s.ctx, state)
+	s.Nil(err)
+}
+
+func (s *deleteBackupCopiesSuite) TestWhenCopyIsDeleted() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/projects/test-project/locations/us-east1/backups/cm-cffd6896-0127-48a1-8a64-e07f6ad5c912") {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"delete-copy-operation-id"}`))
+			return
+		}
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := deletingGpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.Scope = scope.DeepCopy()
+	state.copyBackups = map[string]*file.Backup{
+		"us-east1": {Name: "projects/test-project/locations/us-east1/backups/cm-cffd6896-0127-48a1-8a64-e07f6ad5c912", State: "READY"},
+	}
+
+	err, _ = deleteBackupCopies(s.ctx, state)
+	s.Equal(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Len(fromK8s.Status.Copies, 1)
+	s.Equal(v1beta1.StateDeleting, fromK8s.Status.Copies[0].State)
+}
+
+func (s *deleteBackupCopiesSuite) TestWhenCopiesAreGone() {
+	fakeHttpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(s.T(), "unexpected request: "+r.URL.String())
+	}))
+	obj := deletingGpNfsVolumeBackup.DeepCopy()
+	obj.Spec.CopyTo = []string{"us-east1"}
+	obj.Status.Copies = []v1beta1.BackupCopyStatus{{Location: "us-east1", State: v1beta1.StateDeleting}}
+	factory, err := newTestStateFactoryWithObj(fakeHttpServer, obj)
+	s.Nil(err)
+
+	state, err := factory.newStateWith(obj)
+	s.Nil(err)
+	state.copyBackups = map[string]*file.Backup{}
+
+	err, _ = deleteBackupCopies(s.ctx, state)
+	s.Nil(err)
+
+	fromK8s := &v1beta1.GcpNfsVolumeBackup{}
+	err = factory.skrCluster.K8sClient().Get(s.ctx, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}, fromK8s)
+	s.Nil(err)
+	s.Empty(fromK8s.Status.Copies)
+}
+
+func TestDeleteBackupCopies(t *testing.T) {
+	suite.Run(t, new(deleteBackupCopiesSuite))
+}
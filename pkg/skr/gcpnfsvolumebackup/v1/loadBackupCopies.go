@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"google.golang.org/api/file/v1"
+)
+
+// loadBackupCopies loads the copies of the backup in the regions listed in spec.copyTo.
+// Copies use the same backup id as the backup itself, in a different location.
+func loadBackupCopies(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsGcpNfsVolumeBackup()
+
+	state.copyBackups = map[string]*file.Backup{}
+	if backup.Status.Id == "" || len(backup.Spec.CopyTo) == 0 {
+		return nil, nil
+	}
+
+	project := state.Scope.Spec.Scope.Gcp.Project
+	name := fmt.Sprintf("cm-%.60s", backup.Status.Id)
+
+	for _, location := range state.copyLocations() {
+		copyBackup, err := state.fileBackupClient.GetFileBackup(ctx, project, location, name)
+		if gcpmeta.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error getting GCP backup copy", composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), ctx)
+		}
+		state.copyBackups[location] = copyBackup
+	}
+
+	return nil, nil
+}
@@ -58,15 +58,19 @@ func (r *Reconciler) newAction() composed.Action {
 		markFailed,
 		addFinalizer,
 		loadNfsBackup,
+		loadBackupCopies,
 		loadGcpNfsVolume,
 		addLabelsToNfsBackup,
 		mirrorLabelsToStatus,
 		createNfsBackup,
+		deleteBackupCopies,
 		deleteNfsBackup,
 		checkBackupOperation,
 		removeFinalizer,
 		updateCapacity,
 		updateStatus,
+		createReplicaBackups,
+		updateBackupCopies,
 		StopAndRequeueForCapacityAction(),
 	)
 }
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"github.com/kyma-project/cloud-manager/api"
+	"github.com/kyma-project/cloud-manager/pkg/common/pricing"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -26,6 +27,7 @@ func removeFinalizer(ctx context.Context, st composed.State) (error, context.Con
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error saving SKR GcpNfsVolumeBackup after finalizer remove", composed.StopWithRequeue, ctx)
 	}
+	pricing.ForgetBackup(pricing.KindGcpNfsVolumeBackup, state.Scope, state.Obj())
 
 	// bye, bye SKR GcpNfsVolume
 	return composed.StopAndForget, nil
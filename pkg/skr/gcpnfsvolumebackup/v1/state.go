@@ -18,6 +18,7 @@ import (
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	"google.golang.org/api/file/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 )
 
@@ -32,6 +33,9 @@ type State struct {
 
 	fileBackup *file.Backup
 
+	// copyBackups holds the existing copies of the backup, keyed by their location
+	copyBackups map[string]*file.Backup
+
 	fileBackupClient gcpnfsbackupclientv1.FileBackupClient
 }
 
@@ -127,7 +131,41 @@ func (s *State) ShouldShortCircuit() bool {
 	return backupState == cloudresourcesv1beta1.GcpNfsBackupReady &&
 		backup.Status.AccessibleFrom == s.specCommaSeparatedAccessibleFrom() &&
 		!s.isTimeForCapacityUpdate() &&
-		s.HasAllStatusLabels()
+		s.HasAllStatusLabels() &&
+		cloudresourcesv1beta1.AllBackupCopiesDone(s.copyLocations(), backup.Status.Copies)
+}
+
+// backupCopiesErrorCondition returns the Error condition describing the regional replica backups
+// that failed, or nil if none of them failed.
+func (s *State) backupCopiesErrorCondition() *metav1.Condition {
+	backup := s.ObjAsGcpNfsVolumeBackup()
+	var messages []string
+	for _, c := range backup.Status.Copies {
+		if c.State == cloudresourcesv1beta1.StateError {
+			messages = append(messages, fmt.Sprintf("%s: %s", c.Location, c.Message))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return &metav1.Condition{
+		Type:    cloudresourcesv1beta1.ConditionTypeError,
+		Status:  metav1.ConditionTrue,
+		Reason:  cloudresourcesv1beta1.ConditionReasonBackupCopyFailed,
+		Message: "Regional replica backup failed in " + strings.Join(messages, "; "),
+	}
+}
+
+// copyLocations returns the regions from spec.copyTo that differ from the backup location.
+func (s *State) copyLocations() []string {
+	backup := s.ObjAsGcpNfsVolumeBackup()
+	var result []string
+	for _, location := range backup.Spec.CopyTo {
+		if location != backup.Status.Location {
+			result = append(result, location)
+		}
+	}
+	return result
 }
 
 func (s *State) HasProperLabels() bool {
@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	v2client "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// createReplicaBackups creates a regional replica backup of the source volume in each region listed in spec.copyTo
+// that has none yet. Filestore can not copy a backup to another region, so a replica is a new backup of the
+// source instance taken in the target region once the backup itself is ready, and it does not hold the exact
+// same data as the backup. Replicas that fail are reported in Error state and are not retried.
+func createReplicaBackups(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsGcpNfsVolumeBackup()
+
+	if state.fileBackup == nil || state.fileBackup.State != filestorepb.Backup_READY {
+		return nil, nil
+	}
+
+	var pending []string
+	for _, location := range backup.Spec.CopyTo {
+		if _, exists := state.copyBackups[location]; exists {
+			continue
+		}
+		if c := cloudresourcesv1beta1.FindBackupCopy(backup.Status.Copies, location); c != nil && c.State == cloudresourcesv1beta1.StateError {
+			continue
+		}
+		pending = append(pending, location)
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	sourceExists := true
+	nfsVolume := &cloudresourcesv1beta1.GcpNfsVolume{}
+	err := state.SkrCluster.K8sClient().Get(ctx, backup.Spec.Source.Volume.ToNamespacedName(backup.Namespace), nfsVolume)
+	if apierrors.IsNotFound(err) || (err == nil && !nfsVolume.DeletionTimestamp.IsZero()) {
+		sourceExists = false
+	} else if err != nil {
+		return composed.LogErrorAndReturn(err, "Error getting source GcpNfsVolume of GcpNfsVolumeBackup", composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), ctx)
+	}
+
+	project := state.Scope.Spec.Scope.Gcp.Project
+	name := fmt.Sprintf("cm-%.60s", backup.Status.Id)
+
+	creating := false
+	for _, location := range pending {
+		copyStatus := cloudresourcesv1beta1.BackupCopyStatus{
+			Location: location,
+			State:    cloudresourcesv1beta1.StateError,
+		}
+
+		if location == backup.Status.Location {
+			copyStatus.Message = "CopyTo must not contain the location of the backup"
+			backup.Status.Copies = cloudresourcesv1beta1.SetBackupCopy(backup.Status.Copies, copyStatus)
+			continue
+		}
+		if !sourceExists {
+			copyStatus.Message = fmt.Sprintf("Source GcpNfsVolume %s no longer exists", backup.Spec.Source.Volume.Name)
+			backup.Status.Copies = cloudresourcesv1beta1.SetBackupCopy(backup.Status.Copies, copyStatus)
+			continue
+		}
+
+		logger.Info("Creating GCP File Backup regional replica", "location", location)
+		_, err := state.fileBackupClient.CreateFilestoreBackup(ctx, &filestorepb.CreateBackupRequest{
+			Parent:   v2client.GetFilestoreParentPath(project, location),
+			BackupId: name,
+			Backup: &filestorepb.Backup{
+				SourceFileShare: state.fileBackup.SourceFileShare,
+				SourceInstance:  state.fileBackup.SourceInstance,
+				Labels:          maps.Clone(state.fileBackup.Labels),
+			},
+		})
+		if err != nil {
+			logger.Error(err, "Error creating GCP File Backup regional replica", "location", location)
+			copyStatus.Message = err.Error()
+		} else {
+			copyStatus.State = cloudresourcesv1beta1.StateCreating
+			copyStatus.Id = v2client.GetFileBackupPath(project, location, name)
+			creating = true
+		}
+		backup.Status.Copies = cloudresourcesv1beta1.SetBackupCopy(backup.Status.Copies, copyStatus)
+	}
+
+	patch := composed.PatchStatus(backup).
+		ErrorLogMessage("Error patching GcpNfsVolumeBackup status with regional replica backups").
+		SuccessLogMsg("GcpNfsVolumeBackup regional replica backups status updated")
+	if cond := state.backupCopiesErrorCondition(); cond != nil {
+		patch = patch.SetCondition(*cond)
+	}
+	if !creating {
+		// failed replicas are final, there is nothing to wait for
+		return patch.SuccessErrorNil().Run(ctx, state)
+	}
+
+	// Give some time for the replicas to get created.
+	return patch.
+		SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime)).
+		Run(ctx, state)
+}
@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	v2client "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/nfsbackup/client/v2"
+)
+
+// loadBackupCopies loads the copies of the backup in the regions listed in spec.copyTo.
+// Copies use the same backup id as the backup itself, in a different location.
+func loadBackupCopies(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsGcpNfsVolumeBackup()
+
+	state.copyBackups = map[string]*filestorepb.Backup{}
+	if backup.Status.Id == "" || len(backup.Spec.CopyTo) == 0 {
+		return nil, nil
+	}
+
+	project := state.Scope.Spec.Scope.Gcp.Project
+	name := fmt.Sprintf("cm-%.60s", backup.Status.Id)
+
+	for _, location := range state.copyLocations() {
+		copyBackup, err := state.fileBackupClient.GetFilestoreBackup(ctx, &filestorepb.GetBackupRequest{
+			Name: v2client.GetFileBackupPath(project, location, name),
+		})
+		if gcpmeta.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return composed.LogErrorAndReturn(err, "Error getting GCP backup copy", composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), ctx)
+		}
+		state.copyBackups[location] = copyBackup
+	}
+
+	return nil, nil
+}
@@ -79,6 +79,7 @@ func composeActions() composed.Action {
 		actions.AddCommonFinalizer(),
 
 		loadNfsBackup,
+		loadBackupCopies,
 		loadGcpNfsVolume,
 
 		checkBackupOperation,
@@ -90,9 +91,12 @@ func composeActions() composed.Action {
 				waitBackupReady,
 				addLabelsToNfsBackup,
 				updateStatus,
+				createReplicaBackups,
+				updateBackupCopies,
 			),
 			composed.ComposeActions(
 				"gcpNfsVolumeBackupV2-delete",
+				deleteBackupCopies,
 				deleteNfsBackup,
 				waitBackupDeleted,
 				actions.RemoveCommonFinalizer(),
@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"context"
+
+	"cloud.google.com/go/filestore/apiv1/filestorepb"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+)
+
+// updateBackupCopies reflects the state of the loaded backup copies in the status
+// and requeues while any of them is still being created.
+func updateBackupCopies(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsGcpNfsVolumeBackup()
+
+	changed := false
+	creating := false
+	for location, copyBackup := range state.copyBackups {
+		copyStatus := cloudresourcesv1beta1.BackupCopyStatus{
+			Location: location,
+			Id:       copyBackup.Name,
+		}
+		switch copyBackup.State {
+		case filestorepb.Backup_READY:
+			copyStatus.State = cloudresourcesv1beta1.StateReady
+		case filestorepb.Backup_CREATING, filestorepb.Backup_FINALIZING:
+			copyStatus.State = cloudresourcesv1beta1.StateCreating
+			creating = true
+		default:
+			copyStatus.State = cloudresourcesv1beta1.StateError
+			copyStatus.Message = "Backup copy is in " + copyBackup.State.String() + " state"
+		}
+
+		existing := cloudresourcesv1beta1.FindBackupCopy(backup.Status.Copies, location)
+		if existing == nil || *existing != copyStatus {
+			backup.Status.Copies = cloudresourcesv1beta1.SetBackupCopy(backup.Status.Copies, copyStatus)
+			changed = true
+		}
+	}
+
+	if !changed {
+		if creating {
+			return composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime), nil
+		}
+		return nil, nil
+	}
+
+	patch := composed.PatchStatus(backup).
+		SuccessLogMsg("GcpNfsVolumeBackup copies status updated").
+		SuccessErrorNil()
+	if creating {
+		patch = patch.SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime))
+	}
+	return patch.Run(ctx, state)
+}
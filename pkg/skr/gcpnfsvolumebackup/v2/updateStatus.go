@@ -46,13 +46,18 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 	}
 
 	if needsUpdate {
+		conditions := []metav1.Condition{{
+			Type:    cloudresourcesv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionTypeReady,
+			Message: "Backup is ready for use.",
+		}}
+		// Keep reporting the failed regional replica backups, they are not retried.
+		if cond := state.backupCopiesErrorCondition(); cond != nil {
+			conditions = append(conditions, *cond)
+		}
 		return composed.PatchStatus(backup).
-			SetExclusiveConditions(metav1.Condition{
-				Type:    cloudresourcesv1beta1.ConditionTypeReady,
-				Status:  metav1.ConditionTrue,
-				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
-				Message: "Backup is ready for use.",
-			}).
+			SetExclusiveConditions(conditions...).
 			SuccessLogMsg("GcpNfsVolumeBackup status updated").
 			SuccessErrorNil().
 			Run(ctx, state)
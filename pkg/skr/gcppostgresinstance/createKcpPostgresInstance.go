@@ -0,0 +1,94 @@
+package gcppostgresinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpPostgresInstance != nil {
+		return nil, ctx
+	}
+
+	gcpPostgresInstance := state.ObjAsGcpPostgresInstance()
+
+	tier, err := postgresTierToTierConvertor(gcpPostgresInstance.Spec.PostgresTier)
+
+	if err != nil {
+		errMsg := "failed to map postgresTier to tier"
+		logger.Error(errors.New(errMsg), errMsg, "postgresTier", gcpPostgresInstance.Spec.PostgresTier)
+		gcpPostgresInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(gcpPostgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating GcpPostgresInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR GcpPostgresInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpPostgresInstance = &cloudcontrolv1beta1.PostgresInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gcpPostgresInstance.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      gcpPostgresInstance.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: gcpPostgresInstance.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.PostgresInstanceSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: gcpPostgresInstance.Namespace,
+				Name:      gcpPostgresInstance.Name,
+			},
+			IpRange: cloudcontrolv1beta1.IpRangeRef{
+				Name: state.SkrIpRange.Status.Id,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Instance: cloudcontrolv1beta1.PostgresInstanceInfo{
+				Gcp: &cloudcontrolv1beta1.PostgresInstanceGcp{
+					Tier:              tier,
+					DatabaseVersion:   postgresVersionToDatabaseVersion(gcpPostgresInstance.Spec.PostgresVersion),
+					DataDiskSizeGb:    gcpPostgresInstance.Spec.StorageGb,
+					AvailabilityType:  postgresTierToAvailabilityType(gcpPostgresInstance.Spec.PostgresTier),
+					DatabaseName:      gcpPostgresInstance.Spec.DatabaseName,
+					MaintenancePolicy: toGcpMaintenancePolicy(gcpPostgresInstance.Spec.MaintenancePolicy),
+				},
+			},
+		},
+	}
+
+	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP PostgresInstance")
+
+	gcpPostgresInstance.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(gcpPostgresInstance).
+		ErrorLogMessage("Error setting Creating state on GcpPostgresInstance").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
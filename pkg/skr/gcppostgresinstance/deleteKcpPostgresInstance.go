@@ -0,0 +1,56 @@
+package gcppostgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpPostgresInstance == nil {
+		return nil, ctx
+	}
+
+	if composed.IsMarkedForDeletion(state.KcpPostgresInstance) {
+		return nil, ctx
+	}
+
+	postgresInstance := state.ObjAsGcpPostgresInstance()
+
+	err, _ := composed.UpdateStatus(postgresInstance).
+		SetCondition(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeDeleting,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonDeletingInstance,
+			Message: fmt.Sprintf("Deleting PostgresInstance %s", state.Name()),
+		}).
+		ErrorLogMessage("Error setting ConditionReasonDeletingInstance condition on GcpPostgresInstance").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+	if err != nil {
+		return err, ctx
+	}
+
+	logger.Info("Deleting KCP PostgresInstance for GcpPostgresInstance")
+
+	err = state.KcpCluster.K8sClient().Delete(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error deleting KCP PostgresInstance for GcpPostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	postgresInstance.Status.State = cloudresourcesv1beta1.StateDeleting
+	err = state.UpdateObjStatus(ctx)
+
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Failed status update on AWS PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,59 @@
+package gcppostgresinstance
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func modifyKcpPostgresInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	gcpPostgresInstance := state.ObjAsGcpPostgresInstance()
+
+	if state.KcpPostgresInstance == nil {
+		logger.Error(fmt.Errorf("kcpPostgresInstance not found"), "KcpPostgresInstance not found")
+		return composed.StopWithRequeue, nil
+	}
+
+	if !state.ShouldModifyKcp() {
+		return nil, ctx
+	}
+
+	tier, err := postgresTierToTierConvertor(gcpPostgresInstance.Spec.PostgresTier)
+
+	if err != nil {
+		errMsg := "failed to map postgresTier to tier"
+		logger.Error(err, errMsg, "postgresTier", gcpPostgresInstance.Spec.PostgresTier)
+		gcpPostgresInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(gcpPostgresInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating GcpPostgresInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR GcpPostgresInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpPostgresInstance.Spec.Instance.Gcp.Tier = tier
+	state.KcpPostgresInstance.Spec.Instance.Gcp.AvailabilityType = postgresTierToAvailabilityType(gcpPostgresInstance.Spec.PostgresTier)
+	state.KcpPostgresInstance.Spec.Instance.Gcp.DatabaseVersion = postgresVersionToDatabaseVersion(gcpPostgresInstance.Spec.PostgresVersion)
+	state.KcpPostgresInstance.Spec.Instance.Gcp.DataDiskSizeGb = gcpPostgresInstance.Spec.StorageGb
+	state.KcpPostgresInstance.Spec.Instance.Gcp.MaintenancePolicy = toGcpMaintenancePolicy(gcpPostgresInstance.Spec.MaintenancePolicy)
+
+	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpPostgresInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP PostgresInstance", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,140 @@
+package gcppostgresinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	composed.State
+	KymaRef    klog.ObjectRef
+	KcpCluster composed.StateCluster
+
+	SkrIpRange          *cloudresourcesv1beta1.IpRange
+	KcpPostgresInstance *cloudcontrolv1beta1.PostgresInstance
+
+	AuthSecret *corev1.Secret
+}
+
+func newStateFactory(
+	baseStateFactory composed.StateFactory,
+	scopeProvider scopeprovider.ScopeProvider,
+	kcpCluster composed.StateCluster,
+) *stateFactory {
+	return &stateFactory{
+		baseStateFactory: baseStateFactory,
+		scopeProvider:    scopeProvider,
+		kcpCluster:       kcpCluster,
+	}
+}
+
+type stateFactory struct {
+	baseStateFactory composed.StateFactory
+	scopeProvider    scopeprovider.ScopeProvider
+	kcpCluster       composed.StateCluster
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	kymaRef, err := f.scopeProvider.GetScope(ctx, req.NamespacedName)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:      f.baseStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.GcpPostgresInstance{}),
+		KymaRef:    kymaRef,
+		KcpCluster: f.kcpCluster,
+	}, nil
+}
+
+func (s *State) ObjAsGcpPostgresInstance() *cloudresourcesv1beta1.GcpPostgresInstance {
+	return s.Obj().(*cloudresourcesv1beta1.GcpPostgresInstance)
+}
+
+func (s *State) GetSkrIpRange() *cloudresourcesv1beta1.IpRange {
+	return s.SkrIpRange
+}
+
+func (s *State) SetSkrIpRange(skrIpRange *cloudresourcesv1beta1.IpRange) {
+	s.SkrIpRange = skrIpRange
+}
+
+func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
+	return s.ObjAsGcpPostgresInstance()
+}
+
+func (s *State) GetAuthSecretData() map[string][]byte {
+	authSecretBaseData := getAuthSecretBaseData(s.KcpPostgresInstance)
+	postgresInstance := s.ObjAsGcpPostgresInstance()
+	if postgresInstance.Spec.AuthSecret == nil {
+		return authSecretBaseData
+	}
+
+	parsedAuthSecretExtraData := parseAuthSecretExtraData(postgresInstance.Spec.AuthSecret.ExtraData, authSecretBaseData)
+
+	return util.MergeMaps(authSecretBaseData, parsedAuthSecretExtraData, false)
+}
+
+func (s *State) ShouldModifyKcp() bool {
+	gcpPostgresInstance := s.ObjAsGcpPostgresInstance()
+
+	tier, err := postgresTierToTierConvertor(gcpPostgresInstance.Spec.PostgresTier)
+	if err != nil {
+		return true
+	}
+
+	kcpGcp := s.KcpPostgresInstance.Spec.Instance.Gcp
+
+	isTierDifferent := kcpGcp.Tier != tier
+	isAvailabilityTypeDifferent := kcpGcp.AvailabilityType != postgresTierToAvailabilityType(gcpPostgresInstance.Spec.PostgresTier)
+	isDatabaseVersionDifferent := kcpGcp.DatabaseVersion != postgresVersionToDatabaseVersion(gcpPostgresInstance.Spec.PostgresVersion)
+	isDataDiskSizeGbDifferent := kcpGcp.DataDiskSizeGb != gcpPostgresInstance.Spec.StorageGb
+	isMaintenancePolicyDifferent := areMaintenancePoliciesDifferent(gcpPostgresInstance.Spec.MaintenancePolicy, kcpGcp.MaintenancePolicy)
+
+	return isTierDifferent ||
+		isAvailabilityTypeDifferent ||
+		isDatabaseVersionDifferent ||
+		isDataDiskSizeGbDifferent ||
+		isMaintenancePolicyDifferent
+}
+
+func areMaintenancePoliciesDifferent(skrPolicy *cloudresourcesv1beta1.MaintenancePolicy, kcpPolicy *cloudcontrolv1beta1.MaintenancePolicyGcp) bool {
+	if skrPolicy == nil && kcpPolicy == nil {
+		return false
+	}
+
+	if (skrPolicy == nil && kcpPolicy != nil) || (skrPolicy != nil && kcpPolicy == nil) {
+		return true
+	}
+
+	if skrPolicy.DayOfWeek == nil && kcpPolicy.DayOfWeek == nil {
+		return false
+	}
+
+	if (skrPolicy.DayOfWeek == nil && kcpPolicy.DayOfWeek != nil) || (skrPolicy.DayOfWeek != nil && kcpPolicy.DayOfWeek == nil) {
+		return true
+	}
+
+	if skrPolicy.DayOfWeek.Day != kcpPolicy.DayOfWeek.Day {
+		return true
+	}
+
+	if skrPolicy.DayOfWeek.StartTime.Hours != kcpPolicy.DayOfWeek.StartTime.Hours {
+		return true
+	}
+
+	if skrPolicy.DayOfWeek.StartTime.Minutes != kcpPolicy.DayOfWeek.StartTime.Minutes {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,137 @@
+package gcppostgresinstance
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(gcpPostgres *cloudresourcesv1beta1.GcpPostgresInstance) string {
+	if gcpPostgres.Spec.AuthSecret != nil && len(gcpPostgres.Spec.AuthSecret.Name) > 0 {
+		return gcpPostgres.Spec.AuthSecret.Name
+	}
+
+	return gcpPostgres.Name
+}
+
+func getAuthSecretLabels(gcpPostgres *cloudresourcesv1beta1.GcpPostgresInstance) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if gcpPostgres.Spec.AuthSecret != nil {
+		for labelName, labelValue := range gcpPostgres.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelPostgresInstanceStatusId, gcpPostgres.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelPostgresInstanceNamespace, gcpPostgres.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+
+	return labelsBuilder.Build()
+}
+
+func getAuthSecretAnnotations(gcpPostgres *cloudresourcesv1beta1.GcpPostgresInstance) map[string]string {
+	if gcpPostgres.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, gcpPostgres.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpPostgres *cloudcontrolv1beta1.PostgresInstance) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpPostgres.Status.PrimaryEndpoint) > 0 {
+		result["primaryEndpoint"] = []byte(kcpPostgres.Status.PrimaryEndpoint)
+
+		splitEndpoint := strings.Split(kcpPostgres.Status.PrimaryEndpoint, ":")
+		if len(splitEndpoint) >= 2 {
+			result["host"] = []byte(splitEndpoint[0])
+			result["port"] = []byte(splitEndpoint[1])
+		}
+	}
+
+	if kcpPostgres.Spec.Instance.Gcp != nil && len(kcpPostgres.Spec.Instance.Gcp.DatabaseName) > 0 {
+		result["database"] = []byte(kcpPostgres.Spec.Instance.Gcp.DatabaseName)
+	}
+
+	if len(kcpPostgres.Status.Username) > 0 {
+		result["username"] = []byte(kcpPostgres.Status.Username)
+	}
+
+	if len(kcpPostgres.Status.Password) > 0 {
+		result["password"] = []byte(kcpPostgres.Status.Password)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+func toGcpMaintenancePolicy(maintenancePolicy *cloudresourcesv1beta1.MaintenancePolicy) *cloudcontrolv1beta1.MaintenancePolicyGcp {
+	if maintenancePolicy == nil {
+		return nil
+	}
+
+	if maintenancePolicy.DayOfWeek == nil {
+		return nil
+	}
+
+	return &cloudcontrolv1beta1.MaintenancePolicyGcp{
+		DayOfWeek: &cloudcontrolv1beta1.DayOfWeekPolicyGcp{
+			Day: maintenancePolicy.DayOfWeek.Day,
+			StartTime: cloudcontrolv1beta1.TimeOfDayGcp{
+				Hours:   maintenancePolicy.DayOfWeek.StartTime.Hours,
+				Minutes: maintenancePolicy.DayOfWeek.StartTime.Minutes,
+			},
+		},
+	}
+}
+
+var gcpPostgresTierToTierMap = map[cloudresourcesv1beta1.GcpPostgresTier]string{
+	cloudresourcesv1beta1.GcpPostgresTierS1: "db-custom-2-4096",
+	cloudresourcesv1beta1.GcpPostgresTierS2: "db-custom-2-8192",
+	cloudresourcesv1beta1.GcpPostgresTierS3: "db-custom-4-16384",
+	cloudresourcesv1beta1.GcpPostgresTierS4: "db-custom-8-32768",
+
+	cloudresourcesv1beta1.GcpPostgresTierP1: "db-custom-2-4096",
+	cloudresourcesv1beta1.GcpPostgresTierP2: "db-custom-2-8192",
+	cloudresourcesv1beta1.GcpPostgresTierP3: "db-custom-4-16384",
+	cloudresourcesv1beta1.GcpPostgresTierP4: "db-custom-8-32768",
+}
+
+func postgresTierToTierConvertor(gcpPostgresTier cloudresourcesv1beta1.GcpPostgresTier) (string, error) {
+	tier, exists := gcpPostgresTierToTierMap[gcpPostgresTier]
+
+	if !exists {
+		return "", errors.New("unknown postgres tier")
+	}
+
+	return tier, nil
+}
+
+func postgresTierToAvailabilityType(gcpPostgresTier cloudresourcesv1beta1.GcpPostgresTier) string {
+	if strings.HasPrefix(string(gcpPostgresTier), "P") {
+		return "REGIONAL"
+	}
+	return "ZONAL"
+}
+
+func postgresVersionToDatabaseVersion(postgresVersion string) string {
+	return fmt.Sprintf("POSTGRES_%s", postgresVersion)
+}
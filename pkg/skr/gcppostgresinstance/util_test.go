@@ -0,0 +1,83 @@
+package gcppostgresinstance
+
+import (
+	"fmt"
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+type converterTestCase struct {
+	InputPostgresTier        cloudresourcesv1beta1.GcpPostgresTier
+	ExpectedTier             string
+	ExpectedAvailabilityType string
+}
+
+func TestUtil(t *testing.T) {
+
+	t.Run("postgresTierToTierConvertor", func(t *testing.T) {
+
+		testCases := []converterTestCase{
+			{cloudresourcesv1beta1.GcpPostgresTierS1, "db-custom-2-4096", "ZONAL"},
+			{cloudresourcesv1beta1.GcpPostgresTierS2, "db-custom-2-8192", "ZONAL"},
+			{cloudresourcesv1beta1.GcpPostgresTierS3, "db-custom-4-16384", "ZONAL"},
+			{cloudresourcesv1beta1.GcpPostgresTierS4, "db-custom-8-32768", "ZONAL"},
+
+			{cloudresourcesv1beta1.GcpPostgresTierP1, "db-custom-2-4096", "REGIONAL"},
+			{cloudresourcesv1beta1.GcpPostgresTierP2, "db-custom-2-8192", "REGIONAL"},
+			{cloudresourcesv1beta1.GcpPostgresTierP3, "db-custom-4-16384", "REGIONAL"},
+			{cloudresourcesv1beta1.GcpPostgresTierP4, "db-custom-8-32768", "REGIONAL"},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(fmt.Sprintf("should return expected result for input (%s)", testCase.InputPostgresTier), func(t *testing.T) {
+				tier, err := postgresTierToTierConvertor(testCase.InputPostgresTier)
+
+				assert.Equal(t, testCase.ExpectedTier, tier, "resulting tier does not match expected tier")
+				assert.Nil(t, err, "expected nil error, got an error")
+				assert.Equal(t, testCase.ExpectedAvailabilityType, postgresTierToAvailabilityType(testCase.InputPostgresTier), "resulting availabilityType does not match expected availabilityType")
+			})
+		}
+
+		t.Run("should return error for unknown input", func(t *testing.T) {
+			tier, err := postgresTierToTierConvertor("unknown")
+
+			assert.NotNil(t, err, "expected defined error, got nil")
+			assert.Equal(t, "", tier, "expected tier to have zero value")
+		})
+	})
+
+	t.Run("postgresVersionToDatabaseVersion", func(t *testing.T) {
+		assert.Equal(t, "POSTGRES_15", postgresVersionToDatabaseVersion("15"))
+		assert.Equal(t, "POSTGRES_16", postgresVersionToDatabaseVersion("16"))
+		assert.Equal(t, "POSTGRES_17", postgresVersionToDatabaseVersion("17"))
+	})
+
+	t.Run("getAuthSecretBaseData", func(t *testing.T) {
+		kcpPostgres := &cloudcontrolv1beta1.PostgresInstance{
+			Spec: cloudcontrolv1beta1.PostgresInstanceSpec{
+				Instance: cloudcontrolv1beta1.PostgresInstanceInfo{
+					Gcp: &cloudcontrolv1beta1.PostgresInstanceGcp{
+						DatabaseName: "app",
+					},
+				},
+			},
+			Status: cloudcontrolv1beta1.PostgresInstanceStatus{
+				PrimaryEndpoint: "10.250.4.3:5432",
+				Username:        "postgres",
+				Password:        "secret",
+			},
+		}
+
+		data := getAuthSecretBaseData(kcpPostgres)
+
+		assert.Equal(t, "10.250.4.3:5432", string(data["primaryEndpoint"]))
+		assert.Equal(t, "10.250.4.3", string(data["host"]))
+		assert.Equal(t, "5432", string(data["port"]))
+		assert.Equal(t, "app", string(data["database"]))
+		assert.Equal(t, "postgres", string(data["username"]))
+		assert.Equal(t, "secret", string(data["password"]))
+	})
+}
@@ -73,6 +73,8 @@ func createKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 					RedisConfigs:      gcpRedisInstance.Spec.RedisConfigs,
 					MaintenancePolicy: toGcpMaintenancePolicy(gcpRedisInstance.Spec.MaintenancePolicy),
 					ReplicaCount:      redisTierToReplicaCount(gcpRedisInstance.Spec.RedisTier),
+					ImportUri:         state.SourceBackupUri,
+					DeferTierChange:   gcpRedisInstance.Spec.DeferTierChange,
 				},
 			},
 		},
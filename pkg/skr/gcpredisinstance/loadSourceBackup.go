@@ -0,0 +1,78 @@
+package gcpredisinstance
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func loadSourceBackup(ctx context.Context, st composed.State) (error, context.Context) {
+	// loadSourceBackup loads the GcpRedisInstanceBackup object from the redisInstance.Spec.SourceBackup value
+	// before the KCP RedisInstance is created, and stores the RDB object uri in the state.
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	redisInstance := state.ObjAsGcpRedisInstance()
+
+	if state.KcpRedisInstance != nil {
+		return nil, ctx
+	}
+	if redisInstance.Spec.SourceBackup == nil {
+		state.SourceBackupUri = redisInstance.Spec.SourceBackupUrl
+		return nil, ctx
+	}
+
+	backupKey := redisInstance.Spec.SourceBackup.ToNamespacedName(redisInstance.Namespace)
+	logger.WithValues("SourceBackup", backupKey).Info("Loading GcpRedisInstanceBackup")
+
+	backup := &cloudresourcesv1beta1.GcpRedisInstanceBackup{}
+	err := state.Cluster().K8sClient().Get(ctx, backupKey, backup)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading SKR GcpRedisInstanceBackup", composed.StopWithRequeue, ctx)
+	}
+	if err != nil {
+		redisInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.PatchStatus(redisInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonMissingRedisInstanceBackup,
+				Message: "Error loading GcpRedisInstanceBackup",
+			}).
+			SuccessError(composed.StopWithRequeueDelay(3*util.Timing.T1000ms())).
+			SuccessLogMsg("Error getting GcpRedisInstanceBackup").
+			Run(ctx, state)
+	}
+
+	if !meta.IsStatusConditionTrue(backup.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady) || len(backup.Status.Id) == 0 {
+		logger.WithValues("GcpRedisInstanceBackup", backup.Name).Info("GcpRedisInstanceBackup is not ready")
+		redisInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.PatchStatus(redisInstance).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonRedisInstanceBackupNotReady,
+				Message: "GcpRedisInstanceBackup is not ready",
+			}).
+			SuccessError(composed.StopWithRequeueDelay(3*util.Timing.T1000ms())).
+			SuccessLogMsg("Error loading GcpRedisInstanceBackup").
+			Run(ctx, state)
+	}
+
+	state.SourceBackupUri = backup.Status.Id
+
+	// clear the error left over while waiting for the backup
+	if meta.FindStatusCondition(redisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError) != nil {
+		return composed.PatchStatus(redisInstance).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			SuccessErrorNil().
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
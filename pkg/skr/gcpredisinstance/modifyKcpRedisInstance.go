@@ -27,7 +27,7 @@ func modifyKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 		return nil, ctx
 	}
 
-	_, memorySizeGb, err := redisTierToTierAndMemorySizeConverter(gcpRedisInstance.Spec.RedisTier)
+	tier, memorySizeGb, err := redisTierToTierAndMemorySizeConverter(gcpRedisInstance.Spec.RedisTier)
 
 	if err != nil {
 		errMsg := "failed to map redisTier to tier and memorySizeGb"
@@ -47,11 +47,13 @@ func modifyKcpRedisInstance(ctx context.Context, st composed.State) (error, cont
 			Run(ctx, state)
 	}
 
+	state.KcpRedisInstance.Spec.Instance.Gcp.Tier = tier
 	state.KcpRedisInstance.Spec.Instance.Gcp.MemorySizeGb = memorySizeGb
 	state.KcpRedisInstance.Spec.Instance.Gcp.RedisConfigs = gcpRedisInstance.Spec.RedisConfigs
 	state.KcpRedisInstance.Spec.Instance.Gcp.MaintenancePolicy = toGcpMaintenancePolicy(gcpRedisInstance.Spec.MaintenancePolicy)
 	state.KcpRedisInstance.Spec.Instance.Gcp.AuthEnabled = gcpRedisInstance.Spec.AuthEnabled
 	state.KcpRedisInstance.Spec.Instance.Gcp.RedisVersion = gcpRedisInstance.Spec.RedisVersion
+	state.KcpRedisInstance.Spec.Instance.Gcp.DeferTierChange = gcpRedisInstance.Spec.DeferTierChange
 
 	err = state.KcpCluster.K8sClient().Update(ctx, state.KcpRedisInstance)
 	if err != nil {
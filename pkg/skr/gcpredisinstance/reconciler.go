@@ -66,6 +66,8 @@ func (r *reconciler) newAction() composed.Action {
 			composed.ComposeActions(
 				"gcpRedisInstance-create",
 				actions.AddCommonFinalizer(),
+				loadSourceBackup,
+				validateRegionCapabilities,
 				createKcpRedisInstance,
 				modifyKcpRedisInstance,
 				waitKcpStatusUpdate,
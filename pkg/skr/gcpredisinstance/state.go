@@ -25,6 +25,8 @@ type State struct {
 	KcpRedisInstance *cloudcontrolv1beta1.RedisInstance
 
 	AuthSecret *corev1.Secret
+
+	SourceBackupUri string
 }
 
 func newStateFactory(
@@ -76,20 +78,24 @@ func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
 func (s *State) ShouldModifyKcp() bool {
 	gcpRedisInstance := s.ObjAsGcpRedisInstance()
 
-	_, memorySizeGb, err := redisTierToTierAndMemorySizeConverter(gcpRedisInstance.Spec.RedisTier)
+	tier, memorySizeGb, err := redisTierToTierAndMemorySizeConverter(gcpRedisInstance.Spec.RedisTier)
 	if err != nil {
 		return true
 	}
 
+	areTiersDifferent := s.KcpRedisInstance.Spec.Instance.Gcp.Tier != tier
 	areMemorySizesGbDifferent := s.KcpRedisInstance.Spec.Instance.Gcp.MemorySizeGb != memorySizeGb
 	areAuthEnablesDifferent := s.KcpRedisInstance.Spec.Instance.Gcp.AuthEnabled != gcpRedisInstance.Spec.AuthEnabled
 	areRedisVersionsDifferent := s.KcpRedisInstance.Spec.Instance.Gcp.RedisVersion != gcpRedisInstance.Spec.RedisVersion
+	isDeferTierChangeDifferent := s.KcpRedisInstance.Spec.Instance.Gcp.DeferTierChange != gcpRedisInstance.Spec.DeferTierChange
 
 	return !maps.Equal(s.KcpRedisInstance.Spec.Instance.Gcp.RedisConfigs, gcpRedisInstance.Spec.RedisConfigs) ||
+		areTiersDifferent ||
 		areMemorySizesGbDifferent ||
 		areMaintenancePoliciesDifferent(gcpRedisInstance.Spec.MaintenancePolicy, s.KcpRedisInstance.Spec.Instance.Gcp.MaintenancePolicy) ||
 		areAuthEnablesDifferent ||
-		areRedisVersionsDifferent
+		areRedisVersionsDifferent ||
+		isDeferTierChangeDifferent
 }
 
 func (s *State) GetAuthSecretData() map[string][]byte {
@@ -16,17 +16,28 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 
 	gcpRedisInstance := state.ObjAsGcpRedisInstance()
 
+	pendingRedisTier := cloudresourcesv1beta1.GcpRedisTier("")
+	if state.KcpRedisInstance.Status.PendingMemorySizeGb > 0 {
+		pendingRedisTier = gcpRedisInstance.Spec.RedisTier
+	}
+	if gcpRedisInstance.Status.PendingRedisTier != pendingRedisTier {
+		gcpRedisInstance.Status.PendingRedisTier = pendingRedisTier
+		return composed.UpdateStatus(gcpRedisInstance).
+			ErrorLogMessage("Error: updating GcpRedisInstance status with pending redisTier").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
 	kcpCondErr := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeError)
 	kcpCondReady := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
 
 	kcpCondUpdating := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeUpdating)
-	kcpHasUpdatingCondition := kcpCondUpdating != nil
 
 	skrCondErr := meta.FindStatusCondition(gcpRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
 	skrCondReady := meta.FindStatusCondition(gcpRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady)
-	skrHasUpdatingCondition := meta.FindStatusCondition(gcpRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeUpdating) != nil
+	skrCondUpdating := meta.FindStatusCondition(gcpRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeUpdating)
 
-	if kcpHasUpdatingCondition && skrCondErr == nil && !skrHasUpdatingCondition {
+	if kcpCondUpdating != nil && skrCondErr == nil && (skrCondUpdating == nil || skrCondUpdating.Message != kcpCondUpdating.Message) {
 		gcpRedisInstance.Status.State = cloudresourcesv1beta1.StateUpdating
 		return composed.UpdateStatus(gcpRedisInstance).
 			SetCondition(metav1.Condition{
@@ -41,16 +52,41 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 			Run(ctx, state)
 	}
 
+	kcpCondRestoring := meta.FindStatusCondition(state.KcpRedisInstance.Status.Conditions, cloudcontrolv1beta1.ConditionTypeRestoring)
+	skrHasRestoringCondition := meta.FindStatusCondition(gcpRedisInstance.Status.Conditions, cloudresourcesv1beta1.ConditionTypeRestoring) != nil
+
+	if kcpCondRestoring != nil && skrCondErr == nil && !skrHasRestoringCondition {
+		gcpRedisInstance.Status.State = cloudresourcesv1beta1.StateRestoring
+		return composed.UpdateStatus(gcpRedisInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeRestoring,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeRestoring,
+				Message: kcpCondRestoring.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating GcpRedisInstance status with restoring conditions").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
 	if kcpCondErr != nil && skrCondErr == nil {
+		errReason := cloudresourcesv1beta1.ConditionReasonError
+		switch kcpCondErr.Reason {
+		case cloudcontrolv1beta1.ReasonRestoreFailed:
+			errReason = cloudresourcesv1beta1.ConditionReasonRedisRestoreFailed
+		case cloudcontrolv1beta1.ReasonUnsupportedTierChange:
+			errReason = cloudresourcesv1beta1.ConditionReasonUnsupportedTierChange
+		}
 		gcpRedisInstance.Status.State = cloudresourcesv1beta1.StateError
 		return composed.UpdateStatus(gcpRedisInstance).
 			SetCondition(metav1.Condition{
 				Type:    cloudresourcesv1beta1.ConditionTypeError,
 				Status:  metav1.ConditionTrue,
-				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Reason:  errReason,
 				Message: kcpCondErr.Message,
 			}).
-			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady, cloudresourcesv1beta1.ConditionTypeUpdating).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady, cloudresourcesv1beta1.ConditionTypeUpdating, cloudresourcesv1beta1.ConditionTypeRestoring).
 			ErrorLogMessage("Error: updating GcpRedisInstance status with not ready condition due to KCP error").
 			SuccessLogMsg("Updated and forgot SKR GcpRedisInstance status with Error condition").
 			SuccessError(composed.StopAndForget).
@@ -67,7 +103,7 @@ func updateStatus(ctx context.Context, st composed.State) (error, context.Contex
 				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
 				Message: kcpCondReady.Message,
 			}).
-			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError, cloudresourcesv1beta1.ConditionTypeUpdating).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError, cloudresourcesv1beta1.ConditionTypeUpdating, cloudresourcesv1beta1.ConditionTypeRestoring).
 			ErrorLogMessage("Error updating SKR GcpRedisInstance status with ready condition").
 			SuccessError(composed.StopWithRequeue).
 			Run(ctx, state)
@@ -0,0 +1,88 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func checkExportOperation(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+	backup := state.ObjAsGcpRedisInstanceBackup()
+
+	opName := backup.Status.OpIdentifier
+	if opName == "" {
+		return nil, ctx
+	}
+
+	op, err := state.gcpClient.GetRedisInstanceOperation(ctx, &longrunningpb.GetOperationRequest{
+		Name: opName,
+	})
+	if err != nil {
+		// If the operation is not found, reset the OpIdentifier so the outcome is decided by the exported object
+		if gcpmeta.IsNotFound(err) {
+			backup.Status.OpIdentifier = ""
+		}
+		logger.Error(err, "Error getting GCP Redis export operation")
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonGcpError,
+				Message: err.Error(),
+			}).
+			SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime)).
+			Run(ctx, state)
+	}
+
+	if !op.Done {
+		return composed.StopWithRequeueDelay(config.GcpConfig.GcpOperationWaitTime), nil
+	}
+
+	backup.Status.OpIdentifier = ""
+
+	if composed.IsMarkedForDeletion(backup) {
+		// proceed with deletion once the export is finished
+		return composed.PatchStatus(backup).
+			OnUpdateSuccess(func(ctx context.Context) (error, context.Context) {
+				return nil, ctx
+			}).
+			Run(ctx, state)
+	}
+
+	if op.GetError() != nil {
+		opErr := status.FromProto(op.GetError())
+		logger.Error(opErr.Err(), "GCP Redis export operation failed", "code", opErr.Code(), "message", opErr.Message())
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonGcpError,
+				Message: fmt.Sprintf("Export operation failed: %s", opErr.Message()),
+			}).
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	backup.SetState(cloudresourcesv1beta1.StateReady)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonReady,
+			Message: "Backup is ready for use.",
+		}).
+		SuccessLogMsg("GcpRedisInstanceBackup status got updated with Ready condition").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
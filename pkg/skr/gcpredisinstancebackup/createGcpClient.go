@@ -0,0 +1,15 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func createGcpClient(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	state.gcpClient = state.gcpClientProvider(state.Scope().Spec.Scope.Gcp.Project)
+
+	return nil, ctx
+}
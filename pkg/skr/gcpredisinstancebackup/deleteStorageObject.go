@@ -0,0 +1,46 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	gcpmeta "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func deleteStorageObject(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsGcpRedisInstanceBackup()
+
+	if !composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+
+	bucketName, objectName, ok := parseObjectUri(backup.Status.Id)
+	if !ok {
+		// nothing was exported
+		return nil, ctx
+	}
+
+	composed.LoggerFromCtx(ctx).Info("Deleting GCP Redis backup object", "uri", backup.Status.Id)
+
+	err := state.gcpClient.DeleteStorageObject(ctx, bucketName, objectName)
+	if err != nil && !gcpmeta.IsNotFound(err) {
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonGcpError,
+				Message: err.Error(),
+			}).
+			SuccessLogMsg("Error deleting GCP Redis backup object").
+			SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime)).
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
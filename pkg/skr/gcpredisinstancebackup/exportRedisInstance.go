@@ -0,0 +1,61 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+
+	"cloud.google.com/go/redis/apiv1/redispb"
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/config"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func exportRedisInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsGcpRedisInstanceBackup()
+
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, ctx
+	}
+
+	// export was already started and its outcome recorded
+	if len(backup.Status.Id) > 0 {
+		return composed.StopAndForget, nil
+	}
+
+	uri := state.GetObjectUri()
+	composed.LoggerFromCtx(ctx).Info("Exporting GCP Redis instance", "uri", uri)
+
+	op, err := state.gcpClient.ExportRedisInstance(ctx, &redispb.ExportInstanceRequest{
+		Name: state.kcpRedisInstance.Status.Id,
+		OutputConfig: &redispb.OutputConfig{
+			Destination: &redispb.OutputConfig_GcsDestination{
+				GcsDestination: &redispb.GcsDestination{
+					Uri: uri,
+				},
+			},
+		},
+	})
+	if err != nil {
+		backup.SetState(cloudresourcesv1beta1.StateError)
+		return composed.PatchStatus(backup).
+			SetExclusiveConditions(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudcontrolv1beta1.ReasonGcpError,
+				Message: err.Error(),
+			}).
+			SuccessLogMsg("Error exporting GCP Redis instance").
+			SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpRetryWaitTime)).
+			Run(ctx, state)
+	}
+
+	backup.Status.Id = uri
+	backup.Status.OpIdentifier = op.Name()
+	backup.SetState(cloudresourcesv1beta1.StateCreating)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions().
+		SuccessError(composed.StopWithRequeueDelay(config.GcpConfig.GcpOperationWaitTime)).
+		Run(ctx, state)
+}
@@ -0,0 +1,46 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func loadSkrGcpRedisInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	backup := state.ObjAsGcpRedisInstanceBackup()
+
+	//If the object is being deleted continue...
+	if composed.IsMarkedForDeletion(backup) {
+		return nil, nil
+	}
+	name := backup.Spec.Source.RedisInstance.ToNamespacedName(backup.GetNamespace())
+
+	skrRedisInstance := &cloudresourcesv1beta1.GcpRedisInstance{}
+	err := state.Cluster().K8sClient().Get(ctx, name, skrRedisInstance)
+	if client.IgnoreNotFound(err) != nil {
+		return composed.LogErrorAndReturn(err, "Error loading SKR GcpRedisInstance", composed.StopWithRequeue, ctx)
+	}
+
+	if err == nil {
+		state.skrRedisInstance = skrRedisInstance
+		return nil, nil
+	}
+
+	backup.SetState(cloudresourcesv1beta1.StateError)
+	return composed.PatchStatus(backup).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonMissingRedisInstance,
+			Message: fmt.Sprintf("GcpRedisInstance %s does not exist", name),
+		}).
+		ErrorLogMessage("Failed updating GcpRedisInstanceBackup status with error state due to missing GcpRedisInstance").
+		SuccessLogMsg("Forgetting GcpRedisInstanceBackup with missing GcpRedisInstance").
+		FailedError(composed.StopAndForget).
+		Run(ctx, state)
+}
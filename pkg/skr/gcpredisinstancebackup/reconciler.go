@@ -0,0 +1,89 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/common/actions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"github.com/kyma-project/cloud-manager/pkg/feature"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcpredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisbackup/client"
+	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func NewReconcilerFactory(
+	gcpClientProvider gcpclient.GcpClientProvider[gcpredisbackupclient.Client],
+	env abstractions.Environment,
+) skrruntime.ReconcilerFactory {
+	return &reconcilerFactory{
+		gcpClientProvider: gcpClientProvider,
+		env:               env,
+	}
+}
+
+type reconcilerFactory struct {
+	gcpClientProvider gcpclient.GcpClientProvider[gcpredisbackupclient.Client]
+	env               abstractions.Environment
+}
+
+func (f *reconcilerFactory) New(args skrruntime.ReconcilerArguments) reconcile.Reconciler {
+	return &reconciler{
+		factory: newStateFactory(
+			composed.NewStateFactory(composed.NewStateClusterFromCluster(args.SkrCluster)),
+			commonscope.NewStateFactory(
+				composed.NewStateClusterFromCluster(args.KcpCluster),
+				args.ScopeProvider,
+			),
+			f.gcpClientProvider,
+			f.env,
+		),
+	}
+}
+
+type reconciler struct {
+	factory *stateFactory
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	if Ignore.ShouldIgnoreKey(request) {
+		return ctrl.Result{}, nil
+	}
+
+	state, err := r.factory.NewState(ctx, request)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error creating GcpRedisInstanceBackup state: %w", err)
+	}
+	action := r.newAction()
+
+	return composed.Handling().
+		WithMetrics("gcpredisinstancebackup", util.RequestObjToString(request)).
+		WithNoLog().
+		Handle(action(ctx, state))
+}
+
+func (r *reconciler) newAction() composed.Action {
+	return composed.ComposeActions(
+		"GcpRedisInstanceBackupMain",
+		feature.LoadFeatureContextFromObj(&cloudresourcesv1beta1.GcpRedisInstanceBackup{}),
+		commonscope.New(),
+		shortCircuitCompleted,
+		markFailed,
+		actions.AddCommonFinalizer(),
+		loadSkrGcpRedisInstance,
+		stopIfRedisInstanceNotReady,
+		loadKcpRedisInstance,
+		createGcpClient,
+		checkExportOperation,
+		exportRedisInstance,
+		deleteStorageObject,
+		actions.RemoveCommonFinalizer(),
+		composed.StopAndForgetAction,
+	)
+}
@@ -0,0 +1,85 @@
+package gcpredisinstancebackup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common/abstractions"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	gcpclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/client"
+	gcpredisbackupclient "github.com/kyma-project/cloud-manager/pkg/kcp/provider/gcp/redisbackup/client"
+	commonscope "github.com/kyma-project/cloud-manager/pkg/skr/common/scope"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type State struct {
+	commonscope.State
+	gcpClientProvider gcpclient.GcpClientProvider[gcpredisbackupclient.Client]
+	env               abstractions.Environment
+
+	gcpClient        gcpredisbackupclient.Client
+	skrRedisInstance *cloudresourcesv1beta1.GcpRedisInstance
+	kcpRedisInstance *cloudcontrolv1beta1.RedisInstance
+}
+
+func newStateFactory(
+	composedStateFactory composed.StateFactory,
+	commonScopeStateFactory commonscope.StateFactory,
+	gcpClientProvider gcpclient.GcpClientProvider[gcpredisbackupclient.Client],
+	env abstractions.Environment,
+) *stateFactory {
+	return &stateFactory{
+		composedStateFactory:    composedStateFactory,
+		commonScopeStateFactory: commonScopeStateFactory,
+		gcpClientProvider:       gcpClientProvider,
+		env:                     env,
+	}
+}
+
+type stateFactory struct {
+	composedStateFactory    composed.StateFactory
+	commonScopeStateFactory commonscope.StateFactory
+	gcpClientProvider       gcpclient.GcpClientProvider[gcpredisbackupclient.Client]
+	env                     abstractions.Environment
+}
+
+func (f *stateFactory) NewState(ctx context.Context, req ctrl.Request) (*State, error) {
+	scopeState, err := f.commonScopeStateFactory.NewState(
+		ctx,
+		req.NamespacedName,
+		f.composedStateFactory.NewState(req.NamespacedName, &cloudresourcesv1beta1.GcpRedisInstanceBackup{}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		State:             scopeState,
+		gcpClientProvider: f.gcpClientProvider,
+		env:               f.env,
+	}, nil
+}
+
+func (s *State) ObjAsGcpRedisInstanceBackup() *cloudresourcesv1beta1.GcpRedisInstanceBackup {
+	return s.Obj().(*cloudresourcesv1beta1.GcpRedisInstanceBackup)
+}
+
+// GetObjectName returns the name of the RDB object the instance is exported to
+func (s *State) GetObjectName() string {
+	return fmt.Sprintf("cm-%s.rdb", s.Obj().GetUID())
+}
+
+func (s *State) GetObjectUri() string {
+	return fmt.Sprintf("gs://%s/%s", s.ObjAsGcpRedisInstanceBackup().Spec.Bucket, s.GetObjectName())
+}
+
+// parseObjectUri splits the gs://bucket/object uri from the status id into bucket and object names
+func parseObjectUri(uri string) (string, string, bool) {
+	if !strings.HasPrefix(uri, "gs://") {
+		return "", "", false
+	}
+	bucketName, objectName, ok := strings.Cut(strings.TrimPrefix(uri, "gs://"), "/")
+	return bucketName, objectName, ok && len(bucketName) > 0 && len(objectName) > 0
+}
@@ -0,0 +1,67 @@
+package gcpstoragebucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpBucket != nil {
+		return nil, ctx
+	}
+
+	gcpStorageBucket := state.ObjAsGcpStorageBucket()
+
+	state.KcpBucket = &cloudcontrolv1beta1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gcpStorageBucket.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      gcpStorageBucket.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: gcpStorageBucket.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.BucketSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: gcpStorageBucket.Namespace,
+				Name:      gcpStorageBucket.Name,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			Bucket: cloudcontrolv1beta1.BucketInfo{
+				Gcp: &cloudcontrolv1beta1.BucketGcp{
+					Versioning:    gcpStorageBucket.Spec.Versioning,
+					Lifecycle:     toKcpLifecycle(gcpStorageBucket.Spec.Lifecycle),
+					RetentionDays: gcpStorageBucket.Spec.RetentionDays,
+				},
+			},
+		},
+	}
+
+	err := state.KcpCluster.K8sClient().Create(ctx, state.KcpBucket)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP Bucket", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP Bucket")
+
+	gcpStorageBucket.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(gcpStorageBucket).
+		ErrorLogMessage("Error setting Creating state on GcpStorageBucket").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
@@ -0,0 +1,35 @@
+package gcpstoragebucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+)
+
+func modifyKcpBucket(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	gcpStorageBucket := state.ObjAsGcpStorageBucket()
+
+	if state.KcpBucket == nil {
+		logger.Error(fmt.Errorf("kcpBucket not found"), "KcpBucket not found")
+		return composed.StopWithRequeue, nil
+	}
+
+	if !state.ShouldModifyKcp() {
+		return nil, ctx
+	}
+
+	state.KcpBucket.Spec.Bucket.Gcp.Versioning = gcpStorageBucket.Spec.Versioning
+	state.KcpBucket.Spec.Bucket.Gcp.Lifecycle = toKcpLifecycle(gcpStorageBucket.Spec.Lifecycle)
+	state.KcpBucket.Spec.Bucket.Gcp.RetentionDays = gcpStorageBucket.Spec.RetentionDays
+
+	err := state.KcpCluster.K8sClient().Update(ctx, state.KcpBucket)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error updating KCP Bucket", composed.StopWithRequeue, ctx)
+	}
+
+	return nil, ctx
+}
@@ -0,0 +1,58 @@
+package gcpstoragebucket
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func updateStatus(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	gcpStorageBucket := state.ObjAsGcpStorageBucket()
+
+	kcpCondErr := meta.FindStatusCondition(state.KcpBucket.Status.Conditions, cloudcontrolv1beta1.ConditionTypeError)
+	kcpCondReady := meta.FindStatusCondition(state.KcpBucket.Status.Conditions, cloudcontrolv1beta1.ConditionTypeReady)
+
+	skrCondErr := meta.FindStatusCondition(gcpStorageBucket.Status.Conditions, cloudresourcesv1beta1.ConditionTypeError)
+	skrCondReady := meta.FindStatusCondition(gcpStorageBucket.Status.Conditions, cloudresourcesv1beta1.ConditionTypeReady)
+
+	if kcpCondErr != nil && skrCondErr == nil {
+		gcpStorageBucket.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(gcpStorageBucket).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: kcpCondErr.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating GcpStorageBucket status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR GcpStorageBucket status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	if kcpCondReady != nil && skrCondReady == nil {
+		logger.Info("Updating SKR GcpStorageBucket status with Ready condition")
+		gcpStorageBucket.Status.State = cloudresourcesv1beta1.StateReady
+		return composed.UpdateStatus(gcpStorageBucket).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeReady,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionTypeReady,
+				Message: kcpCondReady.Message,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeError).
+			ErrorLogMessage("Error updating SKR GcpStorageBucket status with ready condition").
+			SuccessError(composed.StopWithRequeue).
+			Run(ctx, state)
+	}
+
+	return nil, ctx
+}
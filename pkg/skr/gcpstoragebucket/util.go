@@ -0,0 +1,90 @@
+package gcpstoragebucket
+
+import (
+	"maps"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(gcpStorageBucket *cloudresourcesv1beta1.GcpStorageBucket) string {
+	if gcpStorageBucket.Spec.AuthSecret != nil && len(gcpStorageBucket.Spec.AuthSecret.Name) > 0 {
+		return gcpStorageBucket.Spec.AuthSecret.Name
+	}
+
+	return gcpStorageBucket.Name
+}
+
+func getAuthSecretLabels(gcpStorageBucket *cloudresourcesv1beta1.GcpStorageBucket) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if gcpStorageBucket.Spec.AuthSecret != nil {
+		for labelName, labelValue := range gcpStorageBucket.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelBucketStatusId, gcpStorageBucket.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelBucketNamespace, gcpStorageBucket.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+
+	return labelsBuilder.Build()
+}
+
+func getAuthSecretAnnotations(gcpStorageBucket *cloudresourcesv1beta1.GcpStorageBucket) map[string]string {
+	if gcpStorageBucket.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, gcpStorageBucket.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpBucket *cloudcontrolv1beta1.Bucket) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpBucket.Status.Id) > 0 {
+		result["bucketName"] = []byte(kcpBucket.Status.Id)
+	}
+
+	if len(kcpBucket.Status.Location) > 0 {
+		result["location"] = []byte(kcpBucket.Status.Location)
+	}
+
+	if len(kcpBucket.Status.Endpoint) > 0 {
+		result["endpoint"] = []byte(kcpBucket.Status.Endpoint)
+	}
+
+	if len(kcpBucket.Status.AccessKeyId) > 0 {
+		result["accessKeyId"] = []byte(kcpBucket.Status.AccessKeyId)
+	}
+
+	if len(kcpBucket.Status.SecretAccessKey) > 0 {
+		result["secretAccessKey"] = []byte(kcpBucket.Status.SecretAccessKey)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+func toKcpLifecycle(rules []cloudresourcesv1beta1.BucketLifecycleRule) []cloudcontrolv1beta1.BucketLifecycleRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	result := make([]cloudcontrolv1beta1.BucketLifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, cloudcontrolv1beta1.BucketLifecycleRule(rule))
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package gcpstoragebucket
+
+import (
+	"testing"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUtil(t *testing.T) {
+
+	t.Run("getAuthSecretBaseData", func(t *testing.T) {
+		kcpBucket := &cloudcontrolv1beta1.Bucket{
+			Status: cloudcontrolv1beta1.BucketStatus{
+				Id:              "cm-abc",
+				Location:        "europe-west1",
+				Endpoint:        "https://storage.googleapis.com",
+				AccessKeyId:     "GOOG1EABC",
+				SecretAccessKey: "secret",
+			},
+		}
+
+		data := getAuthSecretBaseData(kcpBucket)
+
+		assert.Equal(t, "cm-abc", string(data["bucketName"]))
+		assert.Equal(t, "europe-west1", string(data["location"]))
+		assert.Equal(t, "https://storage.googleapis.com", string(data["endpoint"]))
+		assert.Equal(t, "GOOG1EABC", string(data["accessKeyId"]))
+		assert.Equal(t, "secret", string(data["secretAccessKey"]))
+	})
+
+	t.Run("toKcpLifecycle", func(t *testing.T) {
+		assert.Nil(t, toKcpLifecycle(nil))
+
+		rules := toKcpLifecycle([]cloudresourcesv1beta1.BucketLifecycleRule{
+			{Prefix: "logs/", ExpirationDays: 30},
+			{NoncurrentVersionExpirationDays: 7},
+		})
+
+		assert.Equal(t, []cloudcontrolv1beta1.BucketLifecycleRule{
+			{Prefix: "logs/", ExpirationDays: 30},
+			{NoncurrentVersionExpirationDays: 7},
+		}, rules)
+	})
+}
@@ -70,9 +70,14 @@ func (r *reconciler) newAction() composed.Action {
 		preventDeleteOnAwsNfsVolumeUsage,
 		preventDeleteOnGcpNfsVolumeUsage,
 		preventDeleteOnAzureRedisInstanceUsage,
+		preventDeleteOnSapRedisInstanceUsage,
 		preventDeleteOnAwsRedisInstanceUsage,
 		preventDeleteOnGcpRedisInstanceUsage,
 		preventDeleteOnAwsRedisClusterUsage,
+		preventDeleteOnAwsPostgresInstanceUsage,
+		preventDeleteOnGcpPostgresInstanceUsage,
+		preventDeleteOnAzurePostgresInstanceUsage,
+		preventDeleteOnAzureBlobContainerUsage,
 		deleteKcpIpRange,
 		removeFinalizer,
 		updateStatus,
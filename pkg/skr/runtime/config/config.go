@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/base64"
 	"time"
 
 	"github.com/kyma-project/cloud-manager/pkg/config"
@@ -8,10 +9,20 @@ import (
 
 type ConfigStruct struct {
 	SkrLockingLeaseDuration time.Duration
+	ResourceMetricsInterval time.Duration
+	WebhookCaBundle         []byte
 
 	ProvidersDir         string `yaml:"providersDir,omitempty" json:"providersDir,omitempty"`
 	Concurrency          int    `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
 	LockingLeaseDuration string `yaml:"lockingLeaseDuration,omitempty" json:"lockingLeaseDuration,omitempty"`
+	// MetricsInterval is the minimal interval between two collections of the per object metrics of one SKR, zero disables them
+	MetricsInterval string `yaml:"metricsInterval,omitempty" json:"metricsInterval,omitempty"`
+	// WebhookUrl is the external base url of the SKR admission webhooks served by cloud-manager, empty disables them
+	WebhookUrl string `yaml:"webhookUrl,omitempty" json:"webhookUrl,omitempty"`
+	// WebhookCa is the base64 encoded PEM CA bundle the SKR uses to verify the webhook server certificate
+	WebhookCa string `yaml:"webhookCa,omitempty" json:"webhookCa,omitempty"`
+	// WebhookSecret is the key of the per SKR tokens that authenticate SKRs calling the webhooks, empty disables them
+	WebhookSecret string `yaml:"webhookSecret,omitempty" json:"webhookSecret,omitempty"`
 }
 
 func (c *ConfigStruct) AfterConfigLoaded() {
@@ -22,11 +33,20 @@ func (c *ConfigStruct) AfterConfigLoaded() {
 		c.Concurrency = 100
 	}
 	c.SkrLockingLeaseDuration = GetDuration(c.LockingLeaseDuration, 10*time.Minute)
-
+	c.ResourceMetricsInterval = GetDuration(c.MetricsInterval, time.Minute)
+	c.WebhookCaBundle = nil
+	if ca, err := base64.StdEncoding.DecodeString(c.WebhookCa); err == nil && len(ca) > 0 {
+		c.WebhookCaBundle = ca
+	}
 }
 
 var SkrRuntimeConfig = &ConfigStruct{}
 
+// WebhooksEnabled returns true if both the webhook url and secret are configured
+func (c *ConfigStruct) WebhooksEnabled() bool {
+	return len(c.WebhookUrl) > 0 && len(c.WebhookSecret) > 0
+}
+
 func InitConfig(cfg config.Config) {
 	cfg.Path(
 		"skrRuntime",
@@ -44,6 +64,23 @@ func InitConfig(cfg config.Config) {
 			"lockingLeaseDuration",
 			config.DefaultScalar("600s"),
 		),
+		config.Path(
+			"metricsInterval",
+			config.DefaultScalar("60s"),
+			config.SourceEnv("SKR_RUNTIME_METRICS_INTERVAL"),
+		),
+		config.Path(
+			"webhookUrl",
+			config.SourceEnv("SKR_RUNTIME_WEBHOOK_URL"),
+		),
+		config.Path(
+			"webhookCa",
+			config.SourceEnv("SKR_RUNTIME_WEBHOOK_CA"),
+		),
+		config.Path(
+			"webhookSecret",
+			config.SourceEnv("SKR_RUNTIME_WEBHOOK_SECRET"),
+		),
 		config.SourceFile("skrRuntime.yaml"),
 		config.Bind(SkrRuntimeConfig),
 	)
@@ -19,6 +19,10 @@ func TestConfigFromEnv(t *testing.T) {
 	cfg.Read()
 
 	assert.Equal(t, "/env/path", SkrRuntimeConfig.ProvidersDir)
+	assert.Equal(t, time.Minute, SkrRuntimeConfig.ResourceMetricsInterval)
+	assert.Empty(t, SkrRuntimeConfig.WebhookUrl)
+	assert.Empty(t, SkrRuntimeConfig.WebhookCaBundle)
+	assert.False(t, SkrRuntimeConfig.WebhooksEnabled())
 }
 
 func TestConfigFromFile(t *testing.T) {
@@ -30,6 +34,10 @@ func TestConfigFromFile(t *testing.T) {
 	err = os.WriteFile(filepath.Join(dir, "skrRuntime.yaml"), []byte(`
 providersDir: /some/path/from/file
 lockingLeaseDuration: 10s
+metricsInterval: 5m
+webhookUrl: https://webhook.example.com
+webhookCa: Y2EtYnVuZGxl
+webhookSecret: secret
 `), 0644)
 	assert.NoError(t, err, "error creating key file")
 
@@ -43,4 +51,9 @@ lockingLeaseDuration: 10s
 
 	assert.Equal(t, "/some/path/from/file", SkrRuntimeConfig.ProvidersDir)
 	assert.Equal(t, 10*time.Second, SkrRuntimeConfig.SkrLockingLeaseDuration)
+	assert.Equal(t, 5*time.Minute, SkrRuntimeConfig.ResourceMetricsInterval)
+	assert.Equal(t, "https://webhook.example.com", SkrRuntimeConfig.WebhookUrl)
+	assert.Equal(t, []byte("ca-bundle"), SkrRuntimeConfig.WebhookCaBundle)
+	assert.Equal(t, "secret", SkrRuntimeConfig.WebhookSecret)
+	assert.True(t, SkrRuntimeConfig.WebhooksEnabled())
 }
@@ -68,7 +68,9 @@ var _ Installer = &installer{}
 type installer struct {
 	skrStatus        *SkrStatus
 	skrProvidersPath string
-	logger           logr.Logger
+	// objects are generated in runtime and applied after the manifest files, ie admission webhook configurations
+	objects []client.Object
+	logger  logr.Logger
 }
 
 func (i *installer) Handle(ctx context.Context, provider string, skrCluster Cluster) error {
@@ -104,6 +106,17 @@ func (i *installer) Handle(ctx context.Context, provider string, skrCluster Clus
 		docCount += cnt
 	}
 
+	for _, obj := range i.objects {
+		unstructuredData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("error converting %T to unstructured: %w", obj, err)
+		}
+		if err := i.applyObj(ctx, skrCluster, &unstructured.Unstructured{Object: unstructuredData}, "", provider); err != nil {
+			return fmt.Errorf("error installing SKR provider dependencies: %w", err)
+		}
+		docCount++
+	}
+
 	if docCount > 0 {
 		time.Sleep(2 * time.Second)
 	}
@@ -147,77 +160,84 @@ func (i *installer) applyFile(ctx context.Context, skrCluster Cluster, fn string
 			desired = &unstructured.Unstructured{Object: unstructuredData}
 		}
 
-		objCtx := feature.ContextBuilderFromCtx(ctx).
-			KindsFromObject(desired, skrCluster.GetScheme()).
-			FeatureFromObject(desired, skrCluster.GetScheme()).
-			Build(ctx)
-
-		handle := i.skrStatus.Handle(objCtx, "InstallerManifest")
-		handle.WithObj(desired)
-		handle.WithFilename(filepath.Base(fn))
-
-		logger := feature.DecorateLogger(objCtx, i.logger).
-			WithValues(
-				"objName", desired.GetName(),
-				"objNamespace", desired.GetNamespace(),
-				"manifestFile", filepath.Base(fn),
-			)
-
-		if !common.ObjSupportsProvider(desired, skrCluster.GetScheme(), provider) {
-			handle.NotSupportedByProvider()
-			//logger.Info("Object Kind does not support this provider")
-			continue
+		if err := i.applyObj(ctx, skrCluster, desired, filepath.Base(fn), provider); err != nil {
+			return docCount - 1, err
 		}
+	}
 
-		existing := desired.DeepCopy()
-		err = skrCluster.GetAPIReader().Get(ctx, client.ObjectKeyFromObject(existing), existing)
-		if client.IgnoreNotFound(err) != nil {
-			return docCount - 1, fmt.Errorf("error getting obj %s of kind %s/%s to check if it exist: %w", existing.GetName(), existing.GetAPIVersion(), existing.GetKind(), err)
-		}
+	return docCount, nil
+}
 
-		if err == nil {
-			// It already exists
-			// Even if desired belongs to disabled API, since it's already applied we must update it
-			// so feature flag will be checked in create branch only
+func (i *installer) applyObj(ctx context.Context, skrCluster Cluster, desired *unstructured.Unstructured, filename string, provider string) error {
+	objCtx := feature.ContextBuilderFromCtx(ctx).
+		KindsFromObject(desired, skrCluster.GetScheme()).
+		FeatureFromObject(desired, skrCluster.GetScheme()).
+		Build(ctx)
 
-			desiredVersion := i.getVersion(desired)
-			existingVersion := i.getVersion(existing)
-			if desiredVersion == existingVersion {
-				handle.AlreadyExistsWithSameVersion(desiredVersion)
-				continue
-			}
+	handle := i.skrStatus.Handle(objCtx, "InstallerManifest")
+	handle.WithObj(desired)
+	handle.WithFilename(filename)
 
-			err = i.copyForUpdate(desired, existing)
-			if err != nil {
-				handle.SpecCopyError(err)
-				// leave this log since it indicates a developer logical error that happens rarely and only if copy spec code is invalid
-				logger.Error(err, fmt.Sprintf("Error copying spec for %s/%s/%s before update", desired.GetAPIVersion(), desired.GetKind(), desired.GetName()))
-				continue
-			}
-			handle.Updating(existingVersion, desiredVersion)
-			//logger.Info(fmt.Sprintf("Updating %s/%s/%s from version %s to %s", desired.GetAPIVersion(), desired.GetKind(), desired.GetName(), existingVersion, desiredVersion))
-			err = skrCluster.GetClient().Update(ctx, existing)
-		} else {
-			err = nil // clear the not found error, so we only return Create error if any, and not this not found
-			if feature.ApiDisabled.Value(objCtx) {
-				handle.ApiDisabled()
-				//logger.Info(fmt.Sprintf("Skipping installation of disabled API of %s/%s/%s", desired.GetAPIVersion(), desired.GetKind(), desired.GetName()))
-			} else {
-				handle.Creating()
-				//logger.Info(fmt.Sprintf("Creating %s/%s/%s", desired.GetAPIVersion(), desired.GetKind(), desired.GetName()))
-				err = skrCluster.GetClient().Create(ctx, desired)
-			}
+	logger := feature.DecorateLogger(objCtx, i.logger).
+		WithValues(
+			"objName", desired.GetName(),
+			"objNamespace", desired.GetNamespace(),
+			"manifestFile", filename,
+		)
+
+	if !common.ObjSupportsProvider(desired, skrCluster.GetScheme(), provider) {
+		handle.NotSupportedByProvider()
+		//logger.Info("Object Kind does not support this provider")
+		return nil
+	}
+
+	existing := desired.DeepCopy()
+	err := skrCluster.GetAPIReader().Get(ctx, client.ObjectKeyFromObject(existing), existing)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error getting obj %s of kind %s/%s to check if it exist: %w", existing.GetName(), existing.GetAPIVersion(), existing.GetKind(), err)
+	}
+
+	if err == nil {
+		// It already exists
+		// Even if desired belongs to disabled API, since it's already applied we must update it
+		// so feature flag will be checked in create branch only
+
+		desiredVersion := i.getVersion(desired)
+		existingVersion := i.getVersion(existing)
+		if desiredVersion == existingVersion {
+			handle.AlreadyExistsWithSameVersion(desiredVersion)
+			return nil
 		}
 
+		err = i.copyForUpdate(desired, existing)
 		if err != nil {
-			handle.Error(err)
-			return docCount - 1, fmt.Errorf("error applying %s/%s/%s: %w", desired.GetAPIVersion(), desired.GetKind(), desired.GetName(), err)
+			handle.SpecCopyError(err)
+			// leave this log since it indicates a developer logical error that happens rarely and only if copy spec code is invalid
+			logger.Error(err, fmt.Sprintf("Error copying spec for %s/%s/%s before update", desired.GetAPIVersion(), desired.GetKind(), desired.GetName()))
+			return nil
 		}
+		handle.Updating(existingVersion, desiredVersion)
+		//logger.Info(fmt.Sprintf("Updating %s/%s/%s from version %s to %s", desired.GetAPIVersion(), desired.GetKind(), desired.GetName(), existingVersion, desiredVersion))
+		err = skrCluster.GetClient().Update(ctx, existing)
+	} else {
+		err = nil // clear the not found error, so we only return Create error if any, and not this not found
+		if feature.ApiDisabled.Value(objCtx) {
+			handle.ApiDisabled()
+			//logger.Info(fmt.Sprintf("Skipping installation of disabled API of %s/%s/%s", desired.GetAPIVersion(), desired.GetKind(), desired.GetName()))
+		} else {
+			handle.Creating()
+			//logger.Info(fmt.Sprintf("Creating %s/%s/%s", desired.GetAPIVersion(), desired.GetKind(), desired.GetName()))
+			err = skrCluster.GetClient().Create(ctx, desired)
+		}
+	}
 
-		handle.Success()
+	if err != nil {
+		handle.Error(err)
+		return fmt.Errorf("error applying %s/%s/%s: %w", desired.GetAPIVersion(), desired.GetKind(), desired.GetName(), err)
 	}
 
-	return docCount, nil
+	handle.Success()
+	return nil
 }
 
 func (i *installer) getVersion(u *unstructured.Unstructured) string {
@@ -244,6 +264,9 @@ func (i *installer) copyForUpdate(from, to *unstructured.Unstructured) (err erro
 	if err = i.copyField(from, to, "metadata", "annotations"); err != nil {
 		return fmt.Errorf("error copying labels field: %w", err)
 	}
+	if err = i.copySliceField(from, to, "webhooks"); err != nil {
+		return fmt.Errorf("error copying webhooks field: %w", err)
+	}
 	return nil
 }
 
@@ -261,3 +284,18 @@ func (i *installer) copyField(from, to *unstructured.Unstructured, fields ...str
 	}
 	return nil
 }
+
+func (i *installer) copySliceField(from, to *unstructured.Unstructured, fields ...string) error {
+	fromSlice, exists, err := unstructured.NestedSlice(from.Object, fields...)
+	if !exists {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting fields from source: %w", err)
+	}
+	err = unstructured.SetNestedSlice(to.Object, fromSlice, fields...)
+	if err != nil {
+		return fmt.Errorf("error setting fields to destination: %w", err)
+	}
+	return nil
+}
@@ -2,13 +2,18 @@ package looper
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	skrwebhook "github.com/kyma-project/cloud-manager/pkg/skr/webhook"
 	"github.com/stretchr/testify/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -43,11 +48,17 @@ func TestInstaller(t *testing.T) {
 		run(context.Background(), t, cloudcontrolv1beta1.ProviderAws, []SkrStatusTestCase{
 			{"awsnfsbackupschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"awsnfsvolumebackup.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"awsnfsvolumebackupdiscovery.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"awsnfsvolumerestore.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"awsnfsvolume.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"awspostgresinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"awss3bucket.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"awsrediscluster.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"awsredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"awsredisbackupschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"awsredisinstancebackup.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"awsvpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 
 			{"awsnfsbackupschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
@@ -57,7 +68,10 @@ func TestInstaller(t *testing.T) {
 			{"awsrediscluster.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"awsredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"awsvpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"validatingadmissionpolicy.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
+			{"validatingadmissionpolicybinding.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
 		})
 	})
 
@@ -67,10 +81,15 @@ func TestInstaller(t *testing.T) {
 			{"azurerwxvolumebackup.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"azurerwxvolumerestore.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			//{"azurerwxvolume.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"azurepostgresinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"azureblobcontainer.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"azurerediscluster.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"azureredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"azureredisbackupschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"azureredisinstancebackup.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"azurevpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"azurevpcdnslink.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 
 			{"azurerwxbackupschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
@@ -81,7 +100,10 @@ func TestInstaller(t *testing.T) {
 			{"azureredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"azurevpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"azurevpcdnslink.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"validatingadmissionpolicy.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
+			{"validatingadmissionpolicybinding.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
 		})
 	})
 
@@ -92,9 +114,14 @@ func TestInstaller(t *testing.T) {
 			{"gcpnfsvolumebackupdiscovery.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"gcpnfsvolumerestore.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"gcpnfsvolume.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"gcppostgresinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"gcpstoragebucket.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"gcprediscluster.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"gcpredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"gcpredisbackupschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"gcpredisinstancebackup.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"gcpvpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"gcpsubnet.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 
@@ -107,19 +134,70 @@ func TestInstaller(t *testing.T) {
 			{"gcpredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"gcpsubnet.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"gcpvpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"validatingadmissionpolicy.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
+			{"validatingadmissionpolicybinding.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
 		})
 	})
 
 	t.Run("openstack", func(t *testing.T) {
 		run(context.Background(), t, cloudcontrolv1beta1.ProviderOpenStack, []SkrStatusTestCase{
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"sapnfsvolume.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"sapnfsvolumesnapshot.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"sapnfsvolumesnapshotrestore.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
 			{"sapnfsvolumesnapshotschedule.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"sapredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"sapvpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormCrd, []string{"Creating"}},
+			{"cloudnetworkinfo.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"iprange.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
 			{"sapnfsvolume.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"sapredisinstance.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"sapvpcpeering.cloud-resources.kyma-project.io", true, "InstallerManifest", KindFormBusola, []string{"Creating"}},
+			{"validatingadmissionpolicy.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
+			{"validatingadmissionpolicybinding.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
 		})
 	})
+	t.Run("webhook configurations", func(t *testing.T) {
+		ctx := context.Background()
+		skrStatus, instlr, scheme, clstr := prepare(ctx)
+		secret := []byte("secret")
+		pathPrefix := "/skr/kyma/" + skrwebhook.Token(secret, "kyma")
+
+		objects, err := skrwebhook.Configurations(scheme, string(cloudcontrolv1beta1.ProviderGCP), "kyma", "https://webhook.example.com", []byte("ca"), secret)
+		assert.NoError(t, err)
+		instlr.objects = objects
+		assert.NoError(t, instlr.Handle(ctx, string(cloudcontrolv1beta1.ProviderGCP), clstr))
+
+		checker := NewSkrStatusChecker(skrStatus).InstallerManifest()
+		checker.CheckAll(t, []SkrStatusTestCase{
+			{"validatingwebhookconfiguration.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
+			{"mutatingwebhookconfiguration.admissionregistration.k8s.io", true, "InstallerManifest", KindFormObj, []string{"Creating"}},
+		})
+
+		validating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		assert.NoError(t, clstr.GetClient().Get(ctx, types.NamespacedName{Name: skrwebhook.ValidatingConfigurationName}, validating))
+		assert.NotEmpty(t, validating.Webhooks)
+		assert.True(t, strings.HasPrefix(ptr.Deref(validating.Webhooks[0].ClientConfig.URL, ""), "https://webhook.example.com"+pathPrefix+"/validate-"))
+
+		// changed url is updated in existing configurations
+		skrStatus, instlr, _, _ = prepare(ctx)
+		objects, err = skrwebhook.Configurations(scheme, string(cloudcontrolv1beta1.ProviderGCP), "kyma", "https://other.example.com", []byte("ca"), secret)
+		assert.NoError(t, err)
+		instlr.objects = objects
+		assert.NoError(t, instlr.Handle(ctx, string(cloudcontrolv1beta1.ProviderGCP), clstr))
+
+		validating = &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		assert.NoError(t, clstr.GetClient().Get(ctx, types.NamespacedName{Name: skrwebhook.ValidatingConfigurationName}, validating))
+		for _, wh := range validating.Webhooks {
+			assert.True(t, strings.HasPrefix(ptr.Deref(wh.ClientConfig.URL, ""), "https://other.example.com"+pathPrefix+"/validate-"))
+		}
+		mutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		assert.NoError(t, clstr.GetClient().Get(ctx, types.NamespacedName{Name: skrwebhook.MutatingConfigurationName}, mutating))
+		for _, wh := range mutating.Webhooks {
+			assert.True(t, strings.HasPrefix(ptr.Deref(wh.ClientConfig.URL, ""), "https://other.example.com"+pathPrefix+"/mutate-"))
+		}
+	})
 }
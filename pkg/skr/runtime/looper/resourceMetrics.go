@@ -0,0 +1,130 @@
+package looper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResourceMetrics exports the per object metrics of the SKR objects, so stuck resources
+// can be alerted on from the KCP without accessing the SKR.
+type ResourceMetrics interface {
+	// Collect lists all objects of the given kinds in the SKR and replaces the metrics of the
+	// SKR with their current state. It does nothing if the SKR was collected less than the
+	// configured interval ago.
+	Collect(ctx context.Context, kymaName string, reader client.Reader, kinds []schema.GroupVersionKind) error
+}
+
+func NewResourceMetrics(interval time.Duration) ResourceMetrics {
+	return &resourceMetrics{
+		interval:      interval,
+		lastCollected: map[string]time.Time{},
+		now:           time.Now,
+	}
+}
+
+var _ ResourceMetrics = &resourceMetrics{}
+
+type resourceMetrics struct {
+	interval time.Duration
+
+	m             sync.Mutex
+	lastCollected map[string]time.Time
+
+	now func() time.Time
+}
+
+func (r *resourceMetrics) shouldCollect(kymaName string) bool {
+	if r.interval <= 0 {
+		return false
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	last, ok := r.lastCollected[kymaName]
+	if ok && r.now().Sub(last) < r.interval {
+		return false
+	}
+	r.lastCollected[kymaName] = r.now()
+	return true
+}
+
+func (r *resourceMetrics) Collect(ctx context.Context, kymaName string, reader client.Reader, kinds []schema.GroupVersionKind) error {
+	if !r.shouldCollect(kymaName) {
+		return nil
+	}
+
+	lists := make([]*unstructured.UnstructuredList, 0, len(kinds))
+	for _, gvk := range kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := reader.List(ctx, list); err != nil {
+			return fmt.Errorf("error listing %s: %w", gvk.Kind, err)
+		}
+		lists = append(lists, list)
+	}
+
+	// all lists loaded, so objects deleted since the last collection can be dropped
+	metrics.DeleteSkrResourceMetrics(kymaName)
+
+	now := r.now()
+	for i, list := range lists {
+		for _, item := range list.Items {
+			r.collectObject(kymaName, kinds[i].Kind, &item, now)
+		}
+	}
+
+	return nil
+}
+
+func (r *resourceMetrics) collectObject(kymaName, kind string, obj *unstructured.Unstructured, now time.Time) {
+	namespace := obj.GetNamespace()
+	name := obj.GetName()
+
+	state, _, _ := unstructured.NestedString(obj.Object, "status", "state")
+	if state != "" {
+		metrics.SkrResourceState.WithLabelValues(kymaName, kind, namespace, name, state).Set(1)
+	}
+
+	ready := 0.0
+	var lastTransition time.Time
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == cloudresourcesv1beta1.ConditionTypeReady && condition["status"] == string(metav1.ConditionTrue) {
+			ready = 1
+		}
+		if txt, ok := condition["lastTransitionTime"].(string); ok {
+			if t, err := time.Parse(time.RFC3339, txt); err == nil && t.After(lastTransition) {
+				lastTransition = t
+			}
+		}
+	}
+	metrics.SkrResourceReady.WithLabelValues(kymaName, kind, namespace, name).Set(ready)
+
+	// objects without conditions are in their state since they were created
+	if lastTransition.IsZero() {
+		lastTransition = obj.GetCreationTimestamp().Time
+	}
+	if !lastTransition.IsZero() {
+		metrics.SkrResourceStateDurationSeconds.WithLabelValues(kymaName, kind, namespace, name).Set(now.Sub(lastTransition).Seconds())
+	}
+
+	capacity, found, _ := unstructured.NestedString(obj.Object, "status", "capacity")
+	if found {
+		if q, err := resource.ParseQuantity(capacity); err == nil {
+			metrics.SkrResourceCapacityBytes.WithLabelValues(kymaName, kind, namespace, name).Set(q.AsApproximateFloat64())
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package looper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/kyma-project/cloud-manager/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResourceMetrics(t *testing.T) {
+
+	const kymaName = "5a8b6a8e-4f0a-4a5f-9b8c-6f0e2b1c7d3e"
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	gcpNfsVolumeKind := cloudresourcesv1beta1.GroupVersion.WithKind("GcpNfsVolume")
+	gcpRedisInstanceKind := cloudresourcesv1beta1.GroupVersion.WithKind("GcpRedisInstance")
+	kinds := []schema.GroupVersionKind{gcpNfsVolumeKind, gcpRedisInstanceKind}
+
+	volume := &cloudresourcesv1beta1.GcpNfsVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume", Namespace: "default"},
+		Status: cloudresourcesv1beta1.GcpNfsVolumeStatus{
+			State:    cloudresourcesv1beta1.GcpNfsVolumeReady,
+			Capacity: resource.MustParse("1Ti"),
+			Conditions: []metav1.Condition{
+				{
+					Type:               cloudresourcesv1beta1.ConditionTypeReady,
+					Status:             metav1.ConditionTrue,
+					Reason:             cloudresourcesv1beta1.ConditionReasonReady,
+					LastTransitionTime: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+		},
+	}
+	redis := &cloudresourcesv1beta1.GcpRedisInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "apps"},
+		Status: cloudresourcesv1beta1.GcpRedisInstanceStatus{
+			State: cloudresourcesv1beta1.StateError,
+			Conditions: []metav1.Condition{
+				{
+					Type:               cloudresourcesv1beta1.ConditionTypeError,
+					Status:             metav1.ConditionTrue,
+					Reason:             cloudresourcesv1beta1.ConditionReasonError,
+					LastTransitionTime: metav1.NewTime(now.Add(-10 * time.Minute)),
+				},
+			},
+		},
+	}
+
+	newResourceMetrics := func(interval time.Duration) *resourceMetrics {
+		rm := NewResourceMetrics(interval).(*resourceMetrics)
+		rm.now = func() time.Time { return now }
+		return rm
+	}
+
+	t.Cleanup(func() {
+		metrics.DeleteSkrResourceMetrics(kymaName)
+	})
+
+	t.Run("collects state, readiness, duration and capacity of objects", func(t *testing.T) {
+		reader := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).WithObjects(volume.DeepCopy(), redis.DeepCopy()).Build()
+		rm := newResourceMetrics(time.Minute)
+
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.SkrResourceState.WithLabelValues(kymaName, "GcpNfsVolume", "default", "volume", "Ready")))
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.SkrResourceReady.WithLabelValues(kymaName, "GcpNfsVolume", "default", "volume")))
+		assert.Equal(t, 3600.0, testutil.ToFloat64(metrics.SkrResourceStateDurationSeconds.WithLabelValues(kymaName, "GcpNfsVolume", "default", "volume")))
+		assert.Equal(t, float64(1<<40), testutil.ToFloat64(metrics.SkrResourceCapacityBytes.WithLabelValues(kymaName, "GcpNfsVolume", "default", "volume")))
+
+		assert.Equal(t, 1.0, testutil.ToFloat64(metrics.SkrResourceState.WithLabelValues(kymaName, "GcpRedisInstance", "apps", "redis", "Error")))
+		assert.Equal(t, 0.0, testutil.ToFloat64(metrics.SkrResourceReady.WithLabelValues(kymaName, "GcpRedisInstance", "apps", "redis")))
+		assert.Equal(t, 600.0, testutil.ToFloat64(metrics.SkrResourceStateDurationSeconds.WithLabelValues(kymaName, "GcpRedisInstance", "apps", "redis")))
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.SkrResourceCapacityBytes), "only NFS volumes have capacity")
+	})
+
+	t.Run("removes metrics of deleted objects", func(t *testing.T) {
+		reader := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).WithObjects(volume.DeepCopy(), redis.DeepCopy()).Build()
+		rm := newResourceMetrics(time.Minute)
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+
+		assert.NoError(t, reader.Delete(context.Background(), &cloudresourcesv1beta1.GcpRedisInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: redis.Name, Namespace: redis.Namespace},
+		}))
+		rm.now = func() time.Time { return now.Add(2 * time.Minute) }
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.SkrResourceReady))
+		assert.Equal(t, 3720.0, testutil.ToFloat64(metrics.SkrResourceStateDurationSeconds.WithLabelValues(kymaName, "GcpNfsVolume", "default", "volume")))
+	})
+
+	t.Run("does not collect more often than the interval", func(t *testing.T) {
+		reader := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).WithObjects(volume.DeepCopy()).Build()
+		rm := newResourceMetrics(time.Minute)
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+
+		assert.NoError(t, reader.Create(context.Background(), redis.DeepCopy()))
+		rm.now = func() time.Time { return now.Add(30 * time.Second) }
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.SkrResourceReady))
+	})
+
+	t.Run("does not collect when disabled", func(t *testing.T) {
+		metrics.DeleteSkrResourceMetrics(kymaName)
+		reader := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).WithObjects(volume.DeepCopy()).Build()
+		rm := newResourceMetrics(0)
+
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.SkrResourceReady))
+	})
+
+	t.Run("removes metrics when SKR is removed", func(t *testing.T) {
+		reader := fake.NewClientBuilder().WithScheme(commonscheme.SkrScheme).WithObjects(volume.DeepCopy()).Build()
+		rm := newResourceMetrics(time.Minute)
+		assert.NoError(t, rm.Collect(context.Background(), kymaName, reader, kinds))
+		assert.Equal(t, 1, testutil.CollectAndCount(metrics.SkrResourceReady))
+
+		collection := NewActiveSkrCollection()
+		kyma := &cloudresourcesv1beta1.CloudResources{ObjectMeta: metav1.ObjectMeta{Name: kymaName}}
+		collection.(*activeSkrCollection).add(context.Background(), kyma)
+		collection.(*activeSkrCollection).remove(context.Background(), client.Object(kyma))
+
+		assert.Equal(t, 0, testutil.CollectAndCount(metrics.SkrResourceReady))
+	})
+}
@@ -16,11 +16,14 @@ import (
 	skrmanager "github.com/kyma-project/cloud-manager/pkg/skr/runtime/manager"
 	reconcile2 "github.com/kyma-project/cloud-manager/pkg/skr/runtime/reconcile"
 	"github.com/kyma-project/cloud-manager/pkg/skr/runtime/registry"
+	skrwebhook "github.com/kyma-project/cloud-manager/pkg/skr/webhook"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 )
 
@@ -28,6 +31,7 @@ type RunOptions struct {
 	timeout           time.Duration
 	checkSkrReadiness bool
 	provider          *cloudcontrolv1beta1.ProviderType
+	resourceMetrics   ResourceMetrics
 }
 
 type RunOption = func(options *RunOptions)
@@ -51,6 +55,12 @@ func WithTimeout(timeout time.Duration) RunOption {
 	}
 }
 
+func WithResourceMetrics(resourceMetrics ResourceMetrics) RunOption {
+	return func(options *RunOptions) {
+		options.resourceMetrics = resourceMetrics
+	}
+}
+
 type SkrRunner interface {
 	// ScopeProvider returns used ScopeProvider when started. Before that it's nil
 	ScopeProvider() scopeprovider.ScopeProviderRegistry
@@ -129,9 +139,24 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 
 		if options.provider != nil {
 			//logger.Info(fmt.Sprintf("This SKR cluster is started with provider option %s", ptr.Deref(options.provider, "")))
+			var webhookConfigurations []client.Object
+			webhookConfigurations, err = skrwebhook.Configurations(
+				skrManager.GetScheme(),
+				string(ptr.Deref(options.provider, "")),
+				skrManager.KymaRef().Name,
+				skrruntimeconfig.SkrRuntimeConfig.WebhookUrl,
+				skrruntimeconfig.SkrRuntimeConfig.WebhookCaBundle,
+				[]byte(skrruntimeconfig.SkrRuntimeConfig.WebhookSecret),
+			)
+			if err != nil {
+				err = fmt.Errorf("error creating SKR webhook configurations: %w", err)
+				logger.Error(err, "Error installing dependencies")
+				return
+			}
 			instlr := &installer{
 				skrStatus:        skrStatus,
 				skrProvidersPath: skrruntimeconfig.SkrRuntimeConfig.ProvidersDir,
+				objects:          webhookConfigurations,
 				logger:           logger,
 			}
 			err = instlr.Handle(ctx, string(ptr.Deref(options.provider, "")), ToCluster(skrManager))
@@ -197,6 +222,7 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 			}
 		}
 
+		var activeKinds []schema.GroupVersionKind
 		for _, b := range r.registry.Builders() {
 			ctx := feature.ContextBuilderFromCtx(ctx).
 				Provider(util.CastInterfaceToString(options.provider)).
@@ -216,6 +242,9 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 					err = fmt.Errorf("setup with manager error for %T: %w", b.GetForObj(), err)
 					return
 				}
+				if gvk, gvkErr := apiutil.GVKForObject(b.GetForObj(), skrManager.GetScheme()); gvkErr == nil {
+					activeKinds = append(activeKinds, gvk)
+				}
 				//logger.
 				//	WithValues(
 				//		"registryBuilderObject", fmt.Sprintf("%T", b.GetForObj()),
@@ -241,6 +270,13 @@ func (r *skrRunner) Run(ctx context.Context, skrManager skrmanager.SkrManager, o
 		// executed it will not save it again
 		r.saveSkrStatus(ctx, skrStatus, logger)
 
+		if options.resourceMetrics != nil {
+			metricsErr := options.resourceMetrics.Collect(ctx, r.kymaName, skrManager.GetAPIReader(), activeKinds)
+			if util.IgnoreContextCanceledAndDeadlineExceeded(metricsErr) != nil {
+				logger.Error(metricsErr, "Error collecting SKR resource metrics")
+			}
+		}
+
 		if options.timeout == 0 {
 			options.timeout = time.Minute
 		}
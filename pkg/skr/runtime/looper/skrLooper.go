@@ -134,6 +134,8 @@ func (l *activeSkrCollection) remove(ctx context.Context, obj client.Object) {
 
 	l.queue.Remove(kymaName)
 
+	metrics.DeleteSkrResourceMetrics(kymaName)
+
 	metrics.
 		SkrRuntimeModuleActiveCount.WithLabelValues(kymaName, globalAccountId, subaccountId, shootName, region, brokerPlanName).
 		Add(-1)
@@ -165,6 +167,7 @@ func New(activeSkrCollection ActiveSkrCollectionAdmin, kcpCluster cluster.Cluste
 		skrStatusSaver:           NewSkrStatusSaver(NewSkrStatusRepo(kcpCluster.GetClient()), "kcp-system"),
 		registry:                 reg,
 		concurrency:              skrruntimeconfig.SkrRuntimeConfig.Concurrency,
+		resourceMetrics:          NewResourceMetrics(skrruntimeconfig.SkrRuntimeConfig.ResourceMetricsInterval),
 	}
 }
 
@@ -178,6 +181,8 @@ type skrLooper struct {
 	concurrency    int
 	skrStatusSaver SkrStatusSaver
 
+	resourceMetrics ResourceMetrics
+
 	// wg the WorkGroup for workers
 	wg      sync.WaitGroup
 	started bool
@@ -276,7 +281,7 @@ func (l *skrLooper) handleOneSkr(skrWorkerId int, kymaName string) {
 		to = 15 * time.Minute
 	}
 
-	err = runner.Run(ctx, skrManager, WithTimeout(to), WithProvider(scope.Spec.Provider))
+	err = runner.Run(ctx, skrManager, WithTimeout(to), WithProvider(scope.Spec.Provider), WithResourceMetrics(l.resourceMetrics))
 	if util.IgnoreContextCanceledAndDeadlineExceeded(err) != nil {
 		if !apierrors.IsTimeout(err) {
 			logger.Error(err, "Error running SKR Runner")
@@ -2,7 +2,6 @@ package sapnfsvolume
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/kyma-project/cloud-manager/api"
@@ -10,7 +9,6 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,10 +26,7 @@ func pvCreate(ctx context.Context, st composed.State) (error, context.Context) {
 		return nil, ctx
 	}
 
-	storageSize, err := resource.ParseQuantity(fmt.Sprintf("%dG", state.ObjAsSapNfsVolume().Spec.CapacityGb))
-	if err != nil {
-		return composed.LogErrorAndReturn(err, "Error parsing SapNfsVolume capacity as resource quantity", composed.StopAndForget, ctx)
-	}
+	storageSize := state.RequestedCapacity()
 
 	path := state.KcpNfsInstance.Status.Path
 	if !strings.HasPrefix(path, "/") {
@@ -67,7 +62,7 @@ func pvCreate(ctx context.Context, st composed.State) (error, context.Context) {
 		},
 	}
 
-	err = state.Cluster().K8sClient().Create(ctx, pv)
+	err := state.Cluster().K8sClient().Create(ctx, pv)
 	if err != nil {
 		return composed.LogErrorAndReturn(err, "Error creating PV for SapNfsVolume", composed.StopWithRequeue, ctx)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/feature"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsresize"
 	skrruntime "github.com/kyma-project/cloud-manager/pkg/skr/runtime"
 	"github.com/kyma-project/cloud-manager/pkg/util"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -72,7 +73,7 @@ func (r *reconciler) newAction() composed.Action {
 		kcpNfsInstanceCreate,
 		waitKcpNfsInstanceStatus,
 
-		updateSize,
+		nfsresize.New(),
 
 		pvCreate,
 		pvcCreate,
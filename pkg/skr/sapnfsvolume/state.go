@@ -2,13 +2,16 @@ package sapnfsvolume
 
 import (
 	"context"
+	"fmt"
 
 	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
 	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
 	"github.com/kyma-project/cloud-manager/pkg/composed"
 	"github.com/kyma-project/cloud-manager/pkg/skr/common/defaultiprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/common/nfsresize"
 	scopeprovider "github.com/kyma-project/cloud-manager/pkg/skr/common/scope/provider"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -25,6 +28,8 @@ type State struct {
 	PVC                *corev1.PersistentVolumeClaim
 }
 
+var _ nfsresize.State = &State{}
+
 func newStateFactory(
 	baseStateFactory composed.StateFactory,
 	scopeProvider scopeprovider.ScopeProvider,
@@ -77,3 +82,52 @@ func (s *State) GetDataSourceSnapshotId() string {
 func (s *State) ObjAsObjWithIpRangeRef() defaultiprange.ObjWithIpRangeRef {
 	return s.ObjAsSapNfsVolume()
 }
+
+func (s *State) GetPV() *corev1.PersistentVolume {
+	return s.PV
+}
+
+func (s *State) GetPVC() *corev1.PersistentVolumeClaim {
+	return s.PVC
+}
+
+func (s *State) RequestedCapacity() resource.Quantity {
+	return sizeGbToQuantity(s.ObjAsSapNfsVolume().Spec.CapacityGb)
+}
+
+func (s *State) BackingCapacity() *resource.Quantity {
+	if s.KcpNfsInstance == nil || s.KcpNfsInstance.Spec.Instance.OpenStack == nil {
+		return nil
+	}
+	return new(sizeGbToQuantity(s.KcpNfsInstance.Spec.Instance.OpenStack.SizeGb))
+}
+
+func (s *State) ProvisionedCapacity() *resource.Quantity {
+	if s.KcpNfsInstance == nil || s.KcpNfsInstance.Status.CapacityGb == 0 {
+		return nil
+	}
+	return new(sizeGbToQuantity(s.KcpNfsInstance.Status.CapacityGb))
+}
+
+// ValidateResize allows both extending and shrinking, Manila rejects the shrink below the used
+// share size and that is reported by the KCP NfsInstance Error condition
+func (s *State) ValidateResize(_, _ resource.Quantity) error {
+	return nil
+}
+
+func (s *State) ResizeBacking(ctx context.Context, _ resource.Quantity) error {
+	p := map[string]any{
+		"spec": map[string]any{
+			"instance": map[string]any{
+				"openStack": map[string]any{
+					"sizeGb": s.ObjAsSapNfsVolume().Spec.CapacityGb,
+				},
+			},
+		},
+	}
+	return composed.MergePatchObj(ctx, s.KcpNfsInstance, p, s.KcpCluster.K8sClient())
+}
+
+func sizeGbToQuantity(sizeGb int) resource.Quantity {
+	return resource.MustParse(fmt.Sprintf("%dG", sizeGb))
+}
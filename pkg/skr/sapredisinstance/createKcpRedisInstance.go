@@ -0,0 +1,92 @@
+package sapredisinstance
+
+import (
+	"context"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createKcpRedisInstance(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+	logger := composed.LoggerFromCtx(ctx)
+
+	if state.KcpRedisInstance != nil {
+		return nil, ctx
+	}
+
+	sapRedisInstance := state.ObjAsSapRedisInstance()
+
+	flavorName, volumeSizeGb, err := redisTierToFlavorConverter(sapRedisInstance.Spec.RedisTier)
+
+	if err != nil {
+		errMsg := "Failed to map redisTier to flavor"
+		logger.Error(err, errMsg, "redisTier", sapRedisInstance.Spec.RedisTier)
+		sapRedisInstance.Status.State = cloudresourcesv1beta1.StateError
+		return composed.UpdateStatus(sapRedisInstance).
+			SetCondition(metav1.Condition{
+				Type:    cloudresourcesv1beta1.ConditionTypeError,
+				Status:  metav1.ConditionTrue,
+				Reason:  cloudresourcesv1beta1.ConditionReasonError,
+				Message: errMsg,
+			}).
+			RemoveConditions(cloudresourcesv1beta1.ConditionTypeReady).
+			ErrorLogMessage("Error: updating SapRedisInstance status with not ready condition due to KCP error").
+			SuccessLogMsg("Updated and forgot SKR sapRedisInstance status with Error condition").
+			SuccessError(composed.StopAndForget).
+			Run(ctx, state)
+	}
+
+	state.KcpRedisInstance = &cloudcontrolv1beta1.RedisInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sapRedisInstance.Status.Id,
+			Namespace: state.KymaRef.Namespace,
+			Labels: map[string]string{
+				common.LabelKymaModule: common.FieldOwner,
+			},
+			Annotations: map[string]string{
+				cloudcontrolv1beta1.LabelKymaName:        state.KymaRef.Name,
+				cloudcontrolv1beta1.LabelRemoteName:      sapRedisInstance.Name,
+				cloudcontrolv1beta1.LabelRemoteNamespace: sapRedisInstance.Namespace,
+			},
+		},
+		Spec: cloudcontrolv1beta1.RedisInstanceSpec{
+			RemoteRef: cloudcontrolv1beta1.RemoteRef{
+				Namespace: sapRedisInstance.Namespace,
+				Name:      sapRedisInstance.Name,
+			},
+			Scope: cloudcontrolv1beta1.ScopeRef{
+				Name: state.KymaRef.Name,
+			},
+			IpRange: cloudcontrolv1beta1.IpRangeRef{
+				Name: state.SkrIpRange.Status.Id,
+			},
+			Instance: cloudcontrolv1beta1.RedisInstanceInfo{
+				OpenStack: &cloudcontrolv1beta1.RedisInstanceOpenStack{
+					FlavorName:    flavorName,
+					VolumeSizeGb:  volumeSizeGb,
+					Engine:        string(getEngine(sapRedisInstance)),
+					EngineVersion: getEngineVersion(sapRedisInstance),
+					AuthEnabled:   sapRedisInstance.Spec.AuthEnabled,
+				},
+			},
+		},
+	}
+
+	err = state.KcpCluster.K8sClient().Create(ctx, state.KcpRedisInstance)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error creating KCP RedisInstance", composed.StopWithRequeue, ctx)
+	}
+
+	logger.Info("Created KCP RedisInstance")
+
+	sapRedisInstance.Status.State = cloudresourcesv1beta1.StateCreating
+	return composed.UpdateStatus(sapRedisInstance).
+		ErrorLogMessage("Error setting Creating state on SapRedisInstance").
+		SuccessErrorNil().
+		FailedError(composed.StopWithRequeue).
+		Run(ctx, state)
+}
@@ -0,0 +1,136 @@
+package sapredisinstance
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/util"
+)
+
+func getAuthSecretName(sapRedis *cloudresourcesv1beta1.SapRedisInstance) string {
+	if sapRedis.Spec.AuthSecret != nil && len(sapRedis.Spec.AuthSecret.Name) > 0 {
+		return sapRedis.Spec.AuthSecret.Name
+	}
+
+	return sapRedis.Name
+}
+
+func getAuthSecretLabels(sapRedis *cloudresourcesv1beta1.SapRedisInstance) map[string]string {
+	labelsBuilder := util.NewLabelBuilder()
+
+	if sapRedis.Spec.AuthSecret != nil {
+		for labelName, labelValue := range sapRedis.Spec.AuthSecret.Labels {
+			labelsBuilder.WithCustomLabel(labelName, labelValue)
+		}
+	}
+
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelRedisInstanceStatusId, sapRedis.Status.Id)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelRedisInstanceNamespace, sapRedis.Namespace)
+	labelsBuilder.WithCustomLabel(cloudresourcesv1beta1.LabelCloudManaged, "true")
+	labelsBuilder.WithCloudManagerDefaults()
+	pvLabels := labelsBuilder.Build()
+
+	return pvLabels
+}
+
+func getAuthSecretAnnotations(sapRedis *cloudresourcesv1beta1.SapRedisInstance) map[string]string {
+	if sapRedis.Spec.AuthSecret == nil {
+		return nil
+	}
+	result := map[string]string{}
+	maps.Copy(result, sapRedis.Spec.AuthSecret.Annotations)
+	return result
+}
+
+func getAuthSecretBaseData(kcpRedis *cloudcontrolv1beta1.RedisInstance) map[string][]byte {
+	result := map[string][]byte{}
+
+	if len(kcpRedis.Status.PrimaryEndpoint) > 0 {
+		result["primaryEndpoint"] = []byte(kcpRedis.Status.PrimaryEndpoint)
+
+		splitEndpoint := strings.Split(kcpRedis.Status.PrimaryEndpoint, ":")
+		if len(splitEndpoint) >= 2 {
+			host := splitEndpoint[0]
+			port := splitEndpoint[1]
+			result["host"] = []byte(host)
+			result["port"] = []byte(port)
+		}
+	}
+
+	if len(kcpRedis.Status.ReadEndpoint) > 0 {
+		result["readEndpoint"] = []byte(kcpRedis.Status.ReadEndpoint)
+
+		splitReadEndpoint := strings.Split(kcpRedis.Status.ReadEndpoint, ":")
+		if len(splitReadEndpoint) >= 2 {
+			readHost := splitReadEndpoint[0]
+			readPort := splitReadEndpoint[1]
+			result["readHost"] = []byte(readHost)
+			result["readPort"] = []byte(readPort)
+		}
+	}
+
+	if len(kcpRedis.Status.AuthString) > 0 {
+		result["authString"] = []byte(kcpRedis.Status.AuthString)
+	}
+
+	return result
+}
+
+func parseAuthSecretExtraData(extraDataTemplates map[string]string, authSecretBaseData map[string][]byte) map[string][]byte {
+	baseDataStringMap := map[string]string{}
+	for k, v := range authSecretBaseData {
+		baseDataStringMap[k] = string(v)
+	}
+
+	return util.ParseTemplatesMapToBytesMap(extraDataTemplates, baseDataStringMap)
+}
+
+type sapRedisTierValue struct {
+	FlavorName   string
+	VolumeSizeGb int
+}
+
+var sapRedisTierToFlavorValueMap = map[cloudresourcesv1beta1.SapRedisTier]sapRedisTierValue{
+	cloudresourcesv1beta1.SapRedisTierS1: {"redis_c1_m2", 4},
+	cloudresourcesv1beta1.SapRedisTierS2: {"redis_c2_m4", 8},
+	cloudresourcesv1beta1.SapRedisTierS3: {"redis_c4_m8", 16},
+	cloudresourcesv1beta1.SapRedisTierS4: {"redis_c8_m16", 32},
+}
+
+var sapRedisEngineDefaultVersionMap = map[cloudresourcesv1beta1.SapRedisEngine]string{
+	cloudresourcesv1beta1.SapRedisEngineValkey: "8.0",
+	cloudresourcesv1beta1.SapRedisEngineRedis:  "7.2",
+}
+
+func redisTierToFlavorConverter(redisTier cloudresourcesv1beta1.SapRedisTier) (string, int, error) {
+	sapRedisTierValue, exists := sapRedisTierToFlavorValueMap[redisTier]
+
+	if !exists {
+		return "", 0, errors.New("unknown sap redis tier")
+	}
+
+	return sapRedisTierValue.FlavorName, sapRedisTierValue.VolumeSizeGb, nil
+}
+
+func getEngine(sapRedis *cloudresourcesv1beta1.SapRedisInstance) cloudresourcesv1beta1.SapRedisEngine {
+	if len(sapRedis.Spec.Engine) > 0 {
+		return sapRedis.Spec.Engine
+	}
+	return cloudresourcesv1beta1.SapRedisEngineValkey
+}
+
+func getEngineVersion(sapRedis *cloudresourcesv1beta1.SapRedisInstance) string {
+	if len(sapRedis.Spec.EngineVersion) > 0 {
+		return sapRedis.Spec.EngineVersion
+	}
+	return sapRedisEngineDefaultVersionMap[getEngine(sapRedis)]
+}
+
+// ValidateRedisTier returns an error if the redis tier can not be provisioned
+func ValidateRedisTier(redisTier cloudresourcesv1beta1.SapRedisTier) error {
+	_, _, err := redisTierToFlavorConverter(redisTier)
+	return err
+}
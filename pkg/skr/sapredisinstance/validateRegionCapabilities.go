@@ -0,0 +1,51 @@
+package sapredisinstance
+
+import (
+	"context"
+	"errors"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/capability"
+	"github.com/kyma-project/cloud-manager/pkg/composed"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func validateRegionCapabilities(ctx context.Context, st composed.State) (error, context.Context) {
+	state := st.(*State)
+
+	if state.KcpRedisInstance != nil {
+		return nil, ctx
+	}
+
+	region, err := capability.LoadForKyma(ctx, state.KcpCluster.K8sClient(), state.KymaRef)
+	if err != nil {
+		return composed.LogErrorAndReturn(err, "Error loading region capabilities", composed.StopWithRequeue, ctx)
+	}
+	if region == nil {
+		return nil, ctx
+	}
+
+	sapRedisInstance := state.ObjAsSapRedisInstance()
+
+	err = errors.Join(
+		region.CheckService(cloudcontrolv1beta1.CapabilityServiceRedis),
+		region.CheckRedisTier(string(sapRedisInstance.Spec.RedisTier)),
+	)
+	if err == nil {
+		return nil, ctx
+	}
+
+	sapRedisInstance.Status.State = cloudresourcesv1beta1.StateError
+	return composed.UpdateStatus(sapRedisInstance).
+		SetExclusiveConditions(metav1.Condition{
+			Type:    cloudresourcesv1beta1.ConditionTypeError,
+			Status:  metav1.ConditionTrue,
+			Reason:  cloudresourcesv1beta1.ConditionReasonUnavailableInRegion,
+			Message: err.Error(),
+		}).
+		ErrorLogMessage("Error updating SapRedisInstance status with region capabilities error").
+		SuccessLogMsg("Updated and forgot SKR SapRedisInstance status with region capabilities error").
+		SuccessError(composed.StopAndForget).
+		Run(ctx, state)
+}
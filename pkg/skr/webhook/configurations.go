@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cloud-manager/pkg/common"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	ValidatingConfigurationName = "cloud-manager-skr-validation"
+	MutatingConfigurationName   = "cloud-manager-skr-defaulting"
+
+	versionAnnotation = "cloud-resources.kyma-project.io/version"
+)
+
+// Configurations returns the validating and mutating webhook configurations to be installed in the SKR
+// of the given provider and kyma, calling the webhooks served by the KCP cloud-manager on the given baseUrl
+// with the kyma token in the path. The version annotation is a hash of the webhooks, so the installer
+// updates them when url or CA change. If baseUrl or secret is empty, webhooks are disabled and no
+// configurations are returned.
+//
+// The failure policy is Ignore, so the SKR does not depend on KCP availability. Objects admitted while
+// the webhooks are not reachable are still validated by the reconcilers.
+func Configurations(scheme *runtime.Scheme, provider string, kymaName string, baseUrl string, caBundle []byte, secret []byte) ([]client.Object, error) {
+	if len(baseUrl) == 0 || len(secret) == 0 {
+		return nil, nil
+	}
+	baseUrl = strings.TrimSuffix(baseUrl, "/") + pathPrefix(secret, kymaName)
+
+	var validating []admissionregistrationv1.ValidatingWebhook
+	var mutating []admissionregistrationv1.MutatingWebhook
+
+	for _, def := range definitions {
+		if !common.ObjSupportsProvider(def.obj, scheme, provider) {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(def.obj, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("error getting GVK for %T: %w", def.obj, err)
+		}
+		kind := strings.ToLower(gvk.Kind)
+		rule := admissionregistrationv1.Rule{
+			APIGroups:   []string{gvk.Group},
+			APIVersions: []string{gvk.Version},
+			Resources:   []string{def.resource},
+			Scope:       ptr.To(admissionregistrationv1.NamespacedScope),
+		}
+
+		if def.validator != nil {
+			validating = append(validating, admissionregistrationv1.ValidatingWebhook{
+				Name: fmt.Sprintf("%s.validate.%s", kind, gvk.Group),
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					URL:      ptr.To(baseUrl + validatePath(kind)),
+					CABundle: caBundle,
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+					Rule:       rule,
+				}},
+				FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+				SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+				AdmissionReviewVersions: []string{"v1"},
+				TimeoutSeconds:          ptr.To(int32(5)),
+			})
+		}
+
+		if def.defaulter != nil {
+			mutating = append(mutating, admissionregistrationv1.MutatingWebhook{
+				Name: fmt.Sprintf("%s.mutate.%s", kind, gvk.Group),
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					URL:      ptr.To(baseUrl + mutatePath(kind)),
+					CABundle: caBundle,
+				},
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+					Rule:       rule,
+				}},
+				FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+				SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+				AdmissionReviewVersions: []string{"v1"},
+				TimeoutSeconds:          ptr.To(int32(5)),
+			})
+		}
+	}
+
+	var result []client.Object
+	if len(validating) > 0 {
+		version, err := hashVersion(validating)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &admissionregistrationv1.ValidatingWebhookConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+				Kind:       "ValidatingWebhookConfiguration",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        ValidatingConfigurationName,
+				Annotations: map[string]string{versionAnnotation: version},
+			},
+			Webhooks: validating,
+		})
+	}
+	if len(mutating) > 0 {
+		version, err := hashVersion(mutating)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &admissionregistrationv1.MutatingWebhookConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: admissionregistrationv1.SchemeGroupVersion.String(),
+				Kind:       "MutatingWebhookConfiguration",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        MutatingConfigurationName,
+				Annotations: map[string]string{versionAnnotation: version},
+			},
+			Webhooks: mutating,
+		})
+	}
+
+	return result, nil
+}
+
+func hashVersion(webhooks any) (string, error) {
+	b, err := json.Marshal(webhooks)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling webhooks: %w", err)
+	}
+	return fmt.Sprintf("sha256-%x", sha256.Sum256(b))[:19], nil
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"context"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// createDefaulter sets defaults only on create, since some of the defaulted fields are immutable.
+// The namespace is taken from the request, since object metadata might not have it set on create.
+type createDefaulter[T client.Object] struct {
+	setDefaults func(obj T, namespace string)
+}
+
+var _ admission.Defaulter[*cloudresourcesv1beta1.GcpNfsVolumeBackup] = &createDefaulter[*cloudresourcesv1beta1.GcpNfsVolumeBackup]{}
+
+func defaulterFor[T client.Object](setDefaults func(obj T, namespace string)) func(scheme *runtime.Scheme) *admission.Webhook {
+	return func(scheme *runtime.Scheme) *admission.Webhook {
+		return admission.WithDefaulter[T](scheme, &createDefaulter[T]{setDefaults: setDefaults})
+	}
+}
+
+func (d *createDefaulter[T]) Default(ctx context.Context, obj T) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if req.Operation != admissionv1.Create {
+		return nil
+	}
+	d.setDefaults(obj, req.Namespace)
+	return nil
+}
+
+// Backups =========================================
+
+func defaultGcpNfsVolumeBackup(obj *cloudresourcesv1beta1.GcpNfsVolumeBackup, namespace string) {
+	if len(obj.Spec.Source.Volume.Namespace) == 0 {
+		obj.Spec.Source.Volume.Namespace = namespace
+	}
+}
+
+func defaultAwsNfsVolumeBackup(obj *cloudresourcesv1beta1.AwsNfsVolumeBackup, namespace string) {
+	if len(obj.Spec.Source.Volume.Namespace) == 0 {
+		obj.Spec.Source.Volume.Namespace = namespace
+	}
+}
+
+// Schedules =======================================
+
+type scheduleWithSource interface {
+	client.Object
+	GetSourceRef() corev1.ObjectReference
+	SetSourceRef(ref corev1.ObjectReference)
+}
+
+func defaultSchedule[T scheduleWithSource](obj T, namespace string) {
+	ref := obj.GetSourceRef()
+	if len(ref.Namespace) == 0 {
+		ref.Namespace = namespace
+		obj.SetSourceRef(ref)
+	}
+}
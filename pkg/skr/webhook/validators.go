@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"github.com/kyma-project/cloud-manager/pkg/skr/azureredisinstance"
+	"github.com/kyma-project/cloud-manager/pkg/skr/backupschedule"
+	"github.com/kyma-project/cloud-manager/pkg/skr/iprange"
+	"github.com/kyma-project/cloud-manager/pkg/skr/sapredisinstance"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// specValidator runs the validation on create, and on update only if the spec has changed, so
+// objects that were created before the webhook was installed can still be updated and deleted
+type specValidator[T client.Object] struct {
+	validate func(ctx context.Context, obj T) error
+}
+
+var _ admission.Validator[*cloudresourcesv1beta1.IpRange] = &specValidator[*cloudresourcesv1beta1.IpRange]{}
+
+func validatorFor[T client.Object](validate func(ctx context.Context, obj T) error) func(scheme *runtime.Scheme) *admission.Webhook {
+	return func(scheme *runtime.Scheme) *admission.Webhook {
+		return admission.WithValidator[T](scheme, &specValidator[T]{validate: validate})
+	}
+}
+
+func (v *specValidator[T]) ValidateCreate(ctx context.Context, obj T) (admission.Warnings, error) {
+	return nil, v.validate(ctx, obj)
+}
+
+func (v *specValidator[T]) ValidateUpdate(ctx context.Context, oldObj, newObj T) (admission.Warnings, error) {
+	if newObj.GetDeletionTimestamp() != nil || !specChanged(oldObj, newObj) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, newObj)
+}
+
+func (v *specValidator[T]) ValidateDelete(_ context.Context, _ T) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func specChanged(oldObj, newObj runtime.Object) bool {
+	oldU, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldObj)
+	if err != nil {
+		return true
+	}
+	newU, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newObj)
+	if err != nil {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(oldU["spec"], newU["spec"])
+}
+
+// IpRange =========================================
+
+func validateIpRange(_ context.Context, obj *cloudresourcesv1beta1.IpRange) error {
+	// cidr is optional, if empty it's allocated by the reconciler
+	if len(obj.Spec.Cidr) == 0 {
+		return nil
+	}
+	return iprange.ValidateCidr(obj.Spec.Cidr)
+}
+
+// Redis ===========================================
+
+func validateAzureRedisInstance(_ context.Context, obj *cloudresourcesv1beta1.AzureRedisInstance) error {
+	return azureredisinstance.ValidateRedisTier(obj.Spec.RedisTier)
+}
+
+func validateSapRedisInstance(_ context.Context, obj *cloudresourcesv1beta1.SapRedisInstance) error {
+	return sapredisinstance.ValidateRedisTier(obj.Spec.RedisTier)
+}
+
+// Schedules =======================================
+
+type schedule interface {
+	client.Object
+	GetSchedule() string
+	GetStartTime() *metav1.Time
+	GetEndTime() *metav1.Time
+}
+
+func validateSchedule[T schedule](_ context.Context, obj T) error {
+	if _, err := backupschedule.CheckCronExpression(obj.GetSchedule()); err != nil {
+		return err
+	}
+
+	refTime := time.Now().UTC()
+	if created := obj.GetCreationTimestamp(); !created.IsZero() {
+		refTime = created.Time
+	}
+	if start := obj.GetStartTime(); start != nil && !start.IsZero() {
+		refTime = start.Time
+	}
+	return backupschedule.CheckEndTime(refTime, obj.GetEndTime())
+}
@@ -0,0 +1,168 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// definition of admission webhooks for one SKR kind. Webhooks are served by the KCP cloud-manager
+// and the SKR installer creates webhook configurations pointing to them.
+// Only kinds with validation that can not be expressed with the CEL rules in the CRD, like cron and
+// CIDR parsing, or with defaulting that depends on the request, have webhooks. Other checks, like
+// Filestore capacity ranges of the GcpNfsVolume tiers, are done by the CRD validation rules.
+type definition struct {
+	obj client.Object
+	// resource is the plural resource name used in the webhook rules
+	resource string
+	// validator returns validating webhook for the kind, or nil if kind has no validation
+	validator func(scheme *runtime.Scheme) *admission.Webhook
+	// defaulter returns mutating webhook for the kind, or nil if kind has no defaulting
+	defaulter func(scheme *runtime.Scheme) *admission.Webhook
+}
+
+var definitions = []definition{
+	{
+		obj:       &cloudresourcesv1beta1.IpRange{},
+		resource:  "ipranges",
+		validator: validatorFor(validateIpRange),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.AzureRedisInstance{},
+		resource:  "azureredisinstances",
+		validator: validatorFor(validateAzureRedisInstance),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.SapRedisInstance{},
+		resource:  "sapredisinstances",
+		validator: validatorFor(validateSapRedisInstance),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.GcpNfsVolumeBackup{},
+		resource:  "gcpnfsvolumebackups",
+		defaulter: defaulterFor(defaultGcpNfsVolumeBackup),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.AwsNfsVolumeBackup{},
+		resource:  "awsnfsvolumebackups",
+		defaulter: defaulterFor(defaultAwsNfsVolumeBackup),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.GcpNfsBackupSchedule{},
+		resource:  "gcpnfsbackupschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.GcpNfsBackupSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.GcpNfsBackupSchedule]),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.AwsNfsBackupSchedule{},
+		resource:  "awsnfsbackupschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.AwsNfsBackupSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.AwsNfsBackupSchedule]),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.AzureRwxBackupSchedule{},
+		resource:  "azurerwxbackupschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.AzureRwxBackupSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.AzureRwxBackupSchedule]),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.GcpRedisBackupSchedule{},
+		resource:  "gcpredisbackupschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.GcpRedisBackupSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.GcpRedisBackupSchedule]),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.AwsRedisBackupSchedule{},
+		resource:  "awsredisbackupschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.AwsRedisBackupSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.AwsRedisBackupSchedule]),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.AzureRedisBackupSchedule{},
+		resource:  "azureredisbackupschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.AzureRedisBackupSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.AzureRedisBackupSchedule]),
+	},
+	{
+		obj:       &cloudresourcesv1beta1.SapNfsVolumeSnapshotSchedule{},
+		resource:  "sapnfsvolumesnapshotschedules",
+		validator: validatorFor(validateSchedule[*cloudresourcesv1beta1.SapNfsVolumeSnapshotSchedule]),
+		defaulter: defaulterFor(defaultSchedule[*cloudresourcesv1beta1.SapNfsVolumeSnapshotSchedule]),
+	},
+}
+
+func kindName(obj client.Object, scheme *runtime.Scheme) (string, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return "", fmt.Errorf("error getting GVK for %T: %w", obj, err)
+	}
+	return strings.ToLower(gvk.Kind), nil
+}
+
+// serverPathPrefix is the path pattern the webhooks are registered with, the kyma name and its token
+// are path values verified by authenticated
+const serverPathPrefix = "/skr/{kyma}/{token}"
+
+// pathPrefix returns the path prefix of the webhooks called by the SKR of the given kyma
+func pathPrefix(secret []byte, kymaName string) string {
+	return fmt.Sprintf("/skr/%s/%s", kymaName, Token(secret, kymaName))
+}
+
+func validatePath(kind string) string {
+	return "/validate-" + kind
+}
+
+func mutatePath(kind string) string {
+	return "/mutate-" + kind
+}
+
+// Token returns the token the SKR of the given kyma uses to authenticate to the webhooks. It is the
+// HMAC of the kyma name with the secret, so it can be verified without storing tokens of each SKR.
+func Token(secret []byte, kymaName string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(kymaName))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// authenticated serves only requests with the path token valid for the kyma in the path
+func authenticated(secret []byte, hook http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kymaName := r.PathValue("kyma")
+		if len(kymaName) == 0 || !hmac.Equal([]byte(r.PathValue("token")), []byte(Token(secret, kymaName))) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		hook.ServeHTTP(w, r)
+	})
+}
+
+// SetupWithServer registers the SKR admission webhooks of all kinds in the given webhook server.
+// Only callers with the token of their kyma, created with the given secret, are served.
+func SetupWithServer(server webhook.Server, scheme *runtime.Scheme, secret []byte) error {
+	if len(secret) == 0 {
+		return fmt.Errorf("webhook secret is required")
+	}
+	for _, def := range definitions {
+		kind, err := kindName(def.obj, scheme)
+		if err != nil {
+			return err
+		}
+		if def.validator != nil {
+			server.Register(serverPathPrefix+validatePath(kind), authenticated(secret, def.validator(scheme)))
+		}
+		if def.defaulter != nil {
+			server.Register(serverPathPrefix+mutatePath(kind), authenticated(secret, def.defaulter(scheme)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	commonscheme "github.com/kyma-project/cloud-manager/pkg/common/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newRequest(t *testing.T, op admissionv1.Operation, namespace string, obj, oldObj client.Object) admission.Request {
+	raw := func(o client.Object) runtime.RawExtension {
+		if o == nil {
+			return runtime.RawExtension{}
+		}
+		b, err := json.Marshal(o)
+		require.NoError(t, err)
+		return runtime.RawExtension{Raw: b}
+	}
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			UID:       "uid",
+			Operation: op,
+			Namespace: namespace,
+			Object:    raw(obj),
+			OldObject: raw(oldObj),
+		},
+	}
+}
+
+func findDefinition(t *testing.T, obj client.Object) definition {
+	objKind, err := kindName(obj, commonscheme.SkrScheme)
+	require.NoError(t, err)
+	for _, def := range definitions {
+		kind, err := kindName(def.obj, commonscheme.SkrScheme)
+		require.NoError(t, err)
+		if kind == objKind {
+			return def
+		}
+	}
+	require.Failf(t, "definition not found", "%T", obj)
+	return definition{}
+}
+
+func TestValidators(t *testing.T) {
+
+	validate := func(t *testing.T, op admissionv1.Operation, obj, oldObj client.Object) admission.Response {
+		def := findDefinition(t, obj)
+		require.NotNil(t, def.validator)
+		return def.validator(commonscheme.SkrScheme).Handle(context.Background(), newRequest(t, op, "default", obj, oldObj))
+	}
+
+	t.Run("IpRange", func(t *testing.T) {
+		t.Run("empty cidr is allowed", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.IpRange{}, nil)
+			assert.True(t, resp.Allowed)
+		})
+
+		t.Run("valid cidr is allowed", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.IpRange{
+				Spec: cloudresourcesv1beta1.IpRangeSpec{Cidr: "10.250.0.0/22"},
+			}, nil)
+			assert.True(t, resp.Allowed)
+		})
+
+		t.Run("too large cidr is denied", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.IpRange{
+				Spec: cloudresourcesv1beta1.IpRangeSpec{Cidr: "10.0.0.0/8"},
+			}, nil)
+			assert.False(t, resp.Allowed)
+			assert.Contains(t, resp.Result.Message, "block size must not be less than 16")
+		})
+
+		t.Run("invalid cidr syntax is denied", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.IpRange{
+				Spec: cloudresourcesv1beta1.IpRangeSpec{Cidr: "10.250.0.0"},
+			}, nil)
+			assert.False(t, resp.Allowed)
+			assert.Contains(t, resp.Result.Message, "has invalid syntax")
+		})
+
+		t.Run("update with unchanged invalid spec is allowed", func(t *testing.T) {
+			obj := &cloudresourcesv1beta1.IpRange{
+				Spec: cloudresourcesv1beta1.IpRangeSpec{Cidr: "10.0.0.0/8"},
+			}
+			newObj := obj.DeepCopy()
+			newObj.Labels = map[string]string{"foo": "bar"}
+			resp := validate(t, admissionv1.Update, newObj, obj)
+			assert.True(t, resp.Allowed)
+		})
+
+		t.Run("update with changed invalid spec is denied", func(t *testing.T) {
+			obj := &cloudresourcesv1beta1.IpRange{
+				Spec: cloudresourcesv1beta1.IpRangeSpec{Cidr: "10.250.0.0/22"},
+			}
+			newObj := obj.DeepCopy()
+			newObj.Spec.Cidr = "10.0.0.0/8"
+			resp := validate(t, admissionv1.Update, newObj, obj)
+			assert.False(t, resp.Allowed)
+		})
+	})
+
+	t.Run("SapRedisInstance", func(t *testing.T) {
+		t.Run("supported tier is allowed", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.SapRedisInstance{
+				Spec: cloudresourcesv1beta1.SapRedisInstanceSpec{RedisTier: cloudresourcesv1beta1.SapRedisTierS2},
+			}, nil)
+			assert.True(t, resp.Allowed)
+		})
+
+		t.Run("unsupported tier is denied", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.SapRedisInstance{
+				Spec: cloudresourcesv1beta1.SapRedisInstanceSpec{RedisTier: "S5"},
+			}, nil)
+			assert.False(t, resp.Allowed)
+		})
+	})
+
+	t.Run("GcpNfsBackupSchedule", func(t *testing.T) {
+		t.Run("valid schedule is allowed", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsBackupSchedule{
+				Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{
+					Schedule: "0 * * * *",
+					EndTime:  ptr.To(metav1.NewTime(time.Now().Add(time.Hour))),
+				},
+			}, nil)
+			assert.True(t, resp.Allowed)
+		})
+
+		t.Run("invalid cron expression is denied", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsBackupSchedule{
+				Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{Schedule: "every hour"},
+			}, nil)
+			assert.False(t, resp.Allowed)
+		})
+
+		t.Run("end time before start time is denied", func(t *testing.T) {
+			start := time.Now().Add(24 * time.Hour)
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsBackupSchedule{
+				Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{
+					Schedule:  "0 * * * *",
+					StartTime: ptr.To(metav1.NewTime(start)),
+					EndTime:   ptr.To(metav1.NewTime(start.Add(-time.Hour))),
+				},
+			}, nil)
+			assert.False(t, resp.Allowed)
+			assert.Contains(t, resp.Result.Message, "End time cannot be before start/creation time")
+		})
+
+		t.Run("end time in the past is denied", func(t *testing.T) {
+			resp := validate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsBackupSchedule{
+				Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{
+					Schedule: "0 * * * *",
+					EndTime:  ptr.To(metav1.NewTime(time.Now().Add(-time.Hour))),
+				},
+			}, nil)
+			assert.False(t, resp.Allowed)
+		})
+	})
+}
+
+func TestDefaulters(t *testing.T) {
+
+	mutate := func(t *testing.T, op admissionv1.Operation, obj, oldObj client.Object) admission.Response {
+		def := findDefinition(t, obj)
+		require.NotNil(t, def.defaulter)
+		return def.defaulter(commonscheme.SkrScheme).Handle(context.Background(), newRequest(t, op, "dev", obj, oldObj))
+	}
+
+	t.Run("GcpNfsVolumeBackup source namespace is defaulted on create", func(t *testing.T) {
+		resp := mutate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsVolumeBackup{
+			Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+				Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{
+					Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{Name: "vol"},
+				},
+			},
+		}, nil)
+		assert.True(t, resp.Allowed)
+		require.Len(t, resp.Patches, 1)
+		assert.Equal(t, "/spec/source/volume/namespace", resp.Patches[0].Path)
+		assert.Equal(t, "dev", resp.Patches[0].Value)
+	})
+
+	t.Run("GcpNfsVolumeBackup source namespace is not changed if set", func(t *testing.T) {
+		resp := mutate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsVolumeBackup{
+			Spec: cloudresourcesv1beta1.GcpNfsVolumeBackupSpec{
+				Source: cloudresourcesv1beta1.GcpNfsVolumeBackupSource{
+					Volume: cloudresourcesv1beta1.GcpNfsVolumeRef{Name: "vol", Namespace: "other"},
+				},
+			},
+		}, nil)
+		assert.True(t, resp.Allowed)
+		assert.Empty(t, resp.Patches)
+	})
+
+	t.Run("GcpNfsBackupSchedule source namespace is defaulted on create", func(t *testing.T) {
+		resp := mutate(t, admissionv1.Create, &cloudresourcesv1beta1.GcpNfsBackupSchedule{
+			Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{
+				NfsVolumeRef: corev1.ObjectReference{Name: "vol"},
+			},
+		}, nil)
+		assert.True(t, resp.Allowed)
+		require.Len(t, resp.Patches, 1)
+		assert.Equal(t, "/spec/nfsVolumeRef/namespace", resp.Patches[0].Path)
+		assert.Equal(t, "dev", resp.Patches[0].Value)
+	})
+
+	t.Run("GcpNfsBackupSchedule is not defaulted on update", func(t *testing.T) {
+		obj := &cloudresourcesv1beta1.GcpNfsBackupSchedule{
+			Spec: cloudresourcesv1beta1.GcpNfsBackupScheduleSpec{
+				NfsVolumeRef: corev1.ObjectReference{Name: "vol"},
+			},
+		}
+		resp := mutate(t, admissionv1.Update, obj, obj)
+		assert.True(t, resp.Allowed)
+		assert.Empty(t, resp.Patches)
+	})
+}
+
+func TestConfigurations(t *testing.T) {
+
+	secret := []byte("secret")
+
+	t.Run("empty url disables webhooks", func(t *testing.T) {
+		objects, err := Configurations(commonscheme.SkrScheme, string(cloudcontrolv1beta1.ProviderGCP), "kyma", "", nil, secret)
+		assert.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+
+	t.Run("empty secret disables webhooks", func(t *testing.T) {
+		objects, err := Configurations(commonscheme.SkrScheme, string(cloudcontrolv1beta1.ProviderGCP), "kyma", "https://webhook.example.com", nil, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+
+	t.Run("webhooks of provider kinds only", func(t *testing.T) {
+		objects, err := Configurations(commonscheme.SkrScheme, string(cloudcontrolv1beta1.ProviderGCP), "kyma", "https://webhook.example.com/", []byte("ca"), secret)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		validating, ok := objects[0].(*admissionregistrationv1.ValidatingWebhookConfiguration)
+		require.True(t, ok)
+		assert.Equal(t, ValidatingConfigurationName, validating.Name)
+
+		var resources []string
+		for _, wh := range validating.Webhooks {
+			resources = append(resources, wh.Rules[0].Resources...)
+			assert.Equal(t, []byte("ca"), wh.ClientConfig.CABundle)
+			assert.Equal(t, admissionregistrationv1.Ignore, ptr.Deref(wh.FailurePolicy, ""))
+		}
+		assert.Contains(t, resources, "ipranges")
+		assert.NotContains(t, resources, "gcpredisinstances")
+		assert.Contains(t, resources, "gcpnfsbackupschedules")
+		assert.NotContains(t, resources, "awsredisinstances")
+		assert.NotContains(t, resources, "azurerwxbackupschedules")
+
+		for _, wh := range validating.Webhooks {
+			if wh.Rules[0].Resources[0] == "ipranges" {
+				assert.Equal(t, "https://webhook.example.com/skr/kyma/"+Token(secret, "kyma")+"/validate-iprange", ptr.Deref(wh.ClientConfig.URL, ""))
+			}
+		}
+
+		mutating, ok := objects[1].(*admissionregistrationv1.MutatingWebhookConfiguration)
+		require.True(t, ok)
+		assert.Equal(t, MutatingConfigurationName, mutating.Name)
+		for _, wh := range mutating.Webhooks {
+			assert.Equal(t, []admissionregistrationv1.OperationType{admissionregistrationv1.Create}, wh.Rules[0].Operations)
+		}
+	})
+
+	t.Run("version changes with url", func(t *testing.T) {
+		first, err := Configurations(commonscheme.SkrScheme, string(cloudcontrolv1beta1.ProviderAws), "kyma", "https://one.example.com", nil, secret)
+		require.NoError(t, err)
+		second, err := Configurations(commonscheme.SkrScheme, string(cloudcontrolv1beta1.ProviderAws), "kyma", "https://two.example.com", nil, secret)
+		require.NoError(t, err)
+		same, err := Configurations(commonscheme.SkrScheme, string(cloudcontrolv1beta1.ProviderAws), "kyma", "https://one.example.com", nil, secret)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first[0].GetAnnotations()[versionAnnotation], second[0].GetAnnotations()[versionAnnotation])
+		assert.Equal(t, first[0].GetAnnotations()[versionAnnotation], same[0].GetAnnotations()[versionAnnotation])
+	})
+}
+
+func TestAuthentication(t *testing.T) {
+	secret := []byte("secret")
+	mux := http.NewServeMux()
+	mux.Handle(serverPathPrefix+validatePath("iprange"), authenticated(secret, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	call := func(path string) int {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+		return rec.Code
+	}
+
+	t.Run("token of the kyma is served", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, call(pathPrefix(secret, "kyma")+validatePath("iprange")))
+	})
+
+	t.Run("invalid token is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, call("/skr/kyma/invalid"+validatePath("iprange")))
+	})
+
+	t.Run("token of other kyma is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, call("/skr/kyma/"+Token(secret, "other")+validatePath("iprange")))
+	})
+
+	t.Run("token created with other secret is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, call(pathPrefix([]byte("other"), "kyma")+validatePath("iprange")))
+	})
+
+	t.Run("setup requires secret", func(t *testing.T) {
+		assert.Error(t, SetupWithServer(webhook.NewServer(webhook.Options{}), commonscheme.SkrScheme, nil))
+	})
+}
@@ -127,6 +127,32 @@ func WithAwsNfsVolumeCapacity(capacity string) ObjAction {
 	}
 }
 
+func WithAwsNfsVolumeSourceBackup(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AwsNfsVolume); ok {
+				x.Spec.SourceBackup = &cloudresourcesv1beta1.BackupRef{
+					Name: name,
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsNfsVolumeSourceBackup", obj))
+		},
+	}
+}
+
+func WithAwsNfsVolumeSourceBackupUrl(url string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AwsNfsVolume); ok {
+				x.Spec.SourceBackupUrl = url
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsNfsVolumeSourceBackupUrl", obj))
+		},
+	}
+}
+
 func CreateAwsNfsVolume(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AwsNfsVolume, opts ...ObjAction) error {
 	if obj == nil {
 		obj = &cloudresourcesv1beta1.AwsNfsVolume{}
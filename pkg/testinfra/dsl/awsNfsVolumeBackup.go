@@ -126,3 +126,13 @@ func AssertAwsNfsVolumeBackupHasLocation(location string) ObjAssertion {
 			x.Namespace, x.Name, location, x.Status.Locations)
 	}
 }
+
+func WithAwsNfsVolumeBackupStatusId(id string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.AwsNfsVolumeBackup); ok {
+				x.Status.Id = id
+			}
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package dsl
+
+import (
+	"fmt"
+	"slices"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HavingAwsNfsVolumeBackupDiscoveryAvailableBackupUris asserts that exactly the given recovery point ARNs were discovered
+func HavingAwsNfsVolumeBackupDiscoveryAvailableBackupUris(uris ...string) ObjAssertion {
+	return func(obj client.Object) error {
+		x, ok := obj.(*cloudresourcesv1beta1.AwsNfsVolumeBackupDiscovery)
+		if !ok {
+			return fmt.Errorf("the object %T is not AwsNfsVolumeBackupDiscovery", obj)
+		}
+
+		if x.Status.AvailableBackupsCount == nil || *x.Status.AvailableBackupsCount != len(uris) {
+			return fmt.Errorf("expected AvailableBackupsCount to be %d, but got %v", len(uris), x.Status.AvailableBackupsCount)
+		}
+
+		actual := slices.Sorted(slices.Values(x.Status.AvailableBackupUris))
+		expected := slices.Sorted(slices.Values(uris))
+		if !slices.Equal(actual, expected) {
+			return fmt.Errorf("expected AvailableBackupUris to be %v, but got %v", expected, actual)
+		}
+
+		return nil
+	}
+}
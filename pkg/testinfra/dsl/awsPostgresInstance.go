@@ -0,0 +1,119 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateAwsPostgresInstance(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AwsPostgresInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AwsPostgresInstance{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR AwsPostgresInstance must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithAwsPostgresInstanceDefaultSpecs() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsPostgresInstance, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				awsPostgresInstance.Spec.PostgresTier = cloudresourcesv1beta1.AwsPostgresTierS1
+				awsPostgresInstance.Spec.EngineVersion = "16"
+				awsPostgresInstance.Spec.StorageGb = 20
+				awsPostgresInstance.Spec.DatabaseName = "postgres"
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsPostgresInstanceDefaultSpecs", obj))
+		},
+	}
+}
+
+func WithAwsPostgresInstancePostgresTier(postgresTier cloudresourcesv1beta1.AwsPostgresTier) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsPostgresInstance, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				awsPostgresInstance.Spec.PostgresTier = postgresTier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsPostgresInstancePostgresTier", obj))
+		},
+	}
+}
+
+func WithAwsPostgresInstanceStorageGb(storageGb int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsPostgresInstance, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				awsPostgresInstance.Spec.StorageGb = storageGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsPostgresInstanceStorageGb", obj))
+		},
+	}
+}
+
+func WithAwsPostgresInstanceAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsPostgresInstance, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				if awsPostgresInstance.Spec.AuthSecret == nil {
+					awsPostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				awsPostgresInstance.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsPostgresInstanceAuthSecretName", obj))
+		},
+	}
+}
+
+func WithAwsPostgresInstanceAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsPostgresInstance, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				if awsPostgresInstance.Spec.AuthSecret == nil {
+					awsPostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				if awsPostgresInstance.Spec.AuthSecret.Labels == nil {
+					awsPostgresInstance.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(awsPostgresInstance.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsPostgresInstanceAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithAwsPostgresInstanceAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsPostgresInstance, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				if awsPostgresInstance.Spec.AuthSecret == nil {
+					awsPostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				if awsPostgresInstance.Spec.AuthSecret.ExtraData == nil {
+					awsPostgresInstance.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(awsPostgresInstance.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsPostgresInstanceAuthSecretExtraData", obj))
+		},
+	}
+}
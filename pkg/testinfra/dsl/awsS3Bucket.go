@@ -0,0 +1,116 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateAwsS3Bucket(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AwsS3Bucket, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AwsS3Bucket{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR AwsS3Bucket must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithAwsS3BucketVersioning(versioning bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsS3Bucket, ok := obj.(*cloudresourcesv1beta1.AwsS3Bucket); ok {
+				awsS3Bucket.Spec.Versioning = versioning
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsS3BucketVersioning", obj))
+		},
+	}
+}
+
+func WithAwsS3BucketLifecycle(rules ...cloudresourcesv1beta1.BucketLifecycleRule) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsS3Bucket, ok := obj.(*cloudresourcesv1beta1.AwsS3Bucket); ok {
+				awsS3Bucket.Spec.Lifecycle = rules
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsS3BucketLifecycle", obj))
+		},
+	}
+}
+
+func WithAwsS3BucketRetentionDays(days int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsS3Bucket, ok := obj.(*cloudresourcesv1beta1.AwsS3Bucket); ok {
+				awsS3Bucket.Spec.RetentionDays = days
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsS3BucketRetentionDays", obj))
+		},
+	}
+}
+
+func WithAwsS3BucketAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsS3Bucket, ok := obj.(*cloudresourcesv1beta1.AwsS3Bucket); ok {
+				if awsS3Bucket.Spec.AuthSecret == nil {
+					awsS3Bucket.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				awsS3Bucket.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsS3BucketAuthSecretName", obj))
+		},
+	}
+}
+
+func WithAwsS3BucketAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsS3Bucket, ok := obj.(*cloudresourcesv1beta1.AwsS3Bucket); ok {
+				if awsS3Bucket.Spec.AuthSecret == nil {
+					awsS3Bucket.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				if awsS3Bucket.Spec.AuthSecret.Labels == nil {
+					awsS3Bucket.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(awsS3Bucket.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsS3BucketAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithAwsS3BucketAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsS3Bucket, ok := obj.(*cloudresourcesv1beta1.AwsS3Bucket); ok {
+				if awsS3Bucket.Spec.AuthSecret == nil {
+					awsS3Bucket.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				if awsS3Bucket.Spec.AuthSecret.ExtraData == nil {
+					awsS3Bucket.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(awsS3Bucket.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAwsS3BucketAuthSecretExtraData", obj))
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateAzureBlobContainer(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AzureBlobContainer, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AzureBlobContainer{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR AzureBlobContainer must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithAzureBlobContainerVersioning(versioning bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azureBlobContainer, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				azureBlobContainer.Spec.Versioning = versioning
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureBlobContainerVersioning", obj))
+		},
+	}
+}
+
+func WithAzureBlobContainerLifecycle(rules ...cloudresourcesv1beta1.BucketLifecycleRule) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azureBlobContainer, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				azureBlobContainer.Spec.Lifecycle = rules
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureBlobContainerLifecycle", obj))
+		},
+	}
+}
+
+func WithAzureBlobContainerRetentionDays(days int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azureBlobContainer, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				azureBlobContainer.Spec.RetentionDays = days
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureBlobContainerRetentionDays", obj))
+		},
+	}
+}
+
+func WithAzureBlobContainerAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azureBlobContainer, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				if azureBlobContainer.Spec.AuthSecret == nil {
+					azureBlobContainer.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				azureBlobContainer.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureBlobContainerAuthSecretName", obj))
+		},
+	}
+}
+
+func WithAzureBlobContainerAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azureBlobContainer, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				if azureBlobContainer.Spec.AuthSecret == nil {
+					azureBlobContainer.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				if azureBlobContainer.Spec.AuthSecret.Labels == nil {
+					azureBlobContainer.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(azureBlobContainer.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureBlobContainerAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithAzureBlobContainerAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azureBlobContainer, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				if azureBlobContainer.Spec.AuthSecret == nil {
+					azureBlobContainer.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				if azureBlobContainer.Spec.AuthSecret.ExtraData == nil {
+					azureBlobContainer.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(azureBlobContainer.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzureBlobContainerAuthSecretExtraData", obj))
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateAzurePostgresInstance(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AzurePostgresInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AzurePostgresInstance{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR AzurePostgresInstance must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithAzurePostgresInstanceDefaultSpecs() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azurePostgresInstance, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				azurePostgresInstance.Spec.PostgresTier = cloudresourcesv1beta1.AzurePostgresTierS1
+				azurePostgresInstance.Spec.PostgresVersion = "16"
+				azurePostgresInstance.Spec.StorageGb = 32
+				azurePostgresInstance.Spec.DatabaseName = "postgres"
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzurePostgresInstanceDefaultSpecs", obj))
+		},
+	}
+}
+
+func WithAzurePostgresInstancePostgresTier(postgresTier cloudresourcesv1beta1.AzurePostgresTier) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azurePostgresInstance, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				azurePostgresInstance.Spec.PostgresTier = postgresTier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzurePostgresInstancePostgresTier", obj))
+		},
+	}
+}
+
+func WithAzurePostgresInstanceStorageGb(storageGb int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azurePostgresInstance, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				azurePostgresInstance.Spec.StorageGb = storageGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzurePostgresInstanceStorageGb", obj))
+		},
+	}
+}
+
+func WithAzurePostgresInstanceAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azurePostgresInstance, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				if azurePostgresInstance.Spec.AuthSecret == nil {
+					azurePostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				azurePostgresInstance.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzurePostgresInstanceAuthSecretName", obj))
+		},
+	}
+}
+
+func WithAzurePostgresInstanceAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azurePostgresInstance, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				if azurePostgresInstance.Spec.AuthSecret == nil {
+					azurePostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				if azurePostgresInstance.Spec.AuthSecret.Labels == nil {
+					azurePostgresInstance.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(azurePostgresInstance.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzurePostgresInstanceAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithAzurePostgresInstanceAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if azurePostgresInstance, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				if azurePostgresInstance.Spec.AuthSecret == nil {
+					azurePostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				if azurePostgresInstance.Spec.AuthSecret.ExtraData == nil {
+					azurePostgresInstance.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(azurePostgresInstance.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithAzurePostgresInstanceAuthSecretExtraData", obj))
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func WithBucketStatusId(id string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if bucket, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				bucket.Status.Id = id
+			}
+		},
+	}
+}
+
+func WithBucketEndpoint(endpoint, location string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if bucket, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				bucket.Status.Endpoint = endpoint
+				bucket.Status.Location = location
+			}
+		},
+	}
+}
+
+func WithBucketCredentials(accessKeyId, secretAccessKey string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if bucket, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				bucket.Status.AccessKeyId = accessKeyId
+				bucket.Status.SecretAccessKey = secretAccessKey
+			}
+		},
+	}
+}
+
+func CreateBucket(ctx context.Context, clnt client.Client, obj *cloudcontrolv1beta1.Bucket, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudcontrolv1beta1.Bucket{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultKcpNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the KCP Bucket must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithBucketAws() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				x.Spec.Bucket.Aws = &cloudcontrolv1beta1.BucketAws{}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithBucketAws", obj))
+		},
+	}
+}
+
+func WithBucketGcp() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				x.Spec.Bucket.Gcp = &cloudcontrolv1beta1.BucketGcp{}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithBucketGcp", obj))
+		},
+	}
+}
+
+func WithBucketAzure() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				x.Spec.Bucket.Azure = &cloudcontrolv1beta1.BucketAzure{}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithBucketAzure", obj))
+		},
+	}
+}
+
+// WithKcpBucketVersioning sets versioning on the provider specific part of the KCP Bucket,
+// so it must be called after WithBucketAws, WithBucketGcp or WithBucketAzure
+func WithKcpBucketVersioning(versioning bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				switch {
+				case x.Spec.Bucket.Aws != nil:
+					x.Spec.Bucket.Aws.Versioning = versioning
+				case x.Spec.Bucket.Gcp != nil:
+					x.Spec.Bucket.Gcp.Versioning = versioning
+				case x.Spec.Bucket.Azure != nil:
+					x.Spec.Bucket.Azure.Versioning = versioning
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpBucketVersioning", obj))
+		},
+	}
+}
+
+// WithKcpBucketLifecycle sets lifecycle rules on the provider specific part of the KCP Bucket,
+// so it must be called after WithBucketAws, WithBucketGcp or WithBucketAzure
+func WithKcpBucketLifecycle(rules ...cloudcontrolv1beta1.BucketLifecycleRule) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				switch {
+				case x.Spec.Bucket.Aws != nil:
+					x.Spec.Bucket.Aws.Lifecycle = rules
+				case x.Spec.Bucket.Gcp != nil:
+					x.Spec.Bucket.Gcp.Lifecycle = rules
+				case x.Spec.Bucket.Azure != nil:
+					x.Spec.Bucket.Azure.Lifecycle = rules
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpBucketLifecycle", obj))
+		},
+	}
+}
+
+// WithKcpBucketRetentionDays sets retention on the provider specific part of the KCP Bucket,
+// so it must be called after WithBucketAws, WithBucketGcp or WithBucketAzure
+func WithKcpBucketRetentionDays(days int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				switch {
+				case x.Spec.Bucket.Aws != nil:
+					x.Spec.Bucket.Aws.RetentionDays = days
+				case x.Spec.Bucket.Gcp != nil:
+					x.Spec.Bucket.Gcp.RetentionDays = days
+				case x.Spec.Bucket.Azure != nil:
+					x.Spec.Bucket.Azure.RetentionDays = days
+				}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpBucketRetentionDays", obj))
+		},
+	}
+}
@@ -42,6 +42,10 @@ func WithRemoteRef(name string) ObjAction {
 				x.Spec.RemoteRef = remoteRef
 			case *cloudcontrolv1beta1.GcpSubnet:
 				x.Spec.RemoteRef = remoteRef
+			case *cloudcontrolv1beta1.PostgresInstance:
+				x.Spec.RemoteRef = remoteRef
+			case *cloudcontrolv1beta1.Bucket:
+				x.Spec.RemoteRef = remoteRef
 			default:
 				panic(fmt.Sprintf("unhandled type in WithRemoteRef: %T", obj))
 			}
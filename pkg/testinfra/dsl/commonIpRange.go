@@ -48,12 +48,42 @@ func WithIpRange(ipRangeName string) ObjAction {
 				}
 				return
 			}
+			if x, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
 			if x, ok := obj.(*cloudresourcesv1beta1.AzureRedisCluster); ok {
 				if x.Spec.IpRange.Name == "" {
 					x.Spec.IpRange.Name = ipRangeName
 				}
 				return
 			}
+			if x, ok := obj.(*cloudresourcesv1beta1.AwsPostgresInstance); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
+			if x, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
+			if x, ok := obj.(*cloudresourcesv1beta1.AzurePostgresInstance); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
+			if x, ok := obj.(*cloudresourcesv1beta1.AzureBlobContainer); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
 
 			// KCP
 			if x, ok := obj.(*cloudcontrolv1beta1.NfsInstance); ok {
@@ -74,6 +104,18 @@ func WithIpRange(ipRangeName string) ObjAction {
 				}
 				return
 			}
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
+			if x, ok := obj.(*cloudcontrolv1beta1.Bucket); ok {
+				if x.Spec.IpRange.Name == "" {
+					x.Spec.IpRange.Name = ipRangeName
+				}
+				return
+			}
 
 			panic(fmt.Errorf("unhandled type %T in WithIpRange", obj))
 		},
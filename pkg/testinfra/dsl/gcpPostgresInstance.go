@@ -0,0 +1,119 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateGcpPostgresInstance(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.GcpPostgresInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.GcpPostgresInstance{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR GcpPostgresInstance must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithGcpPostgresInstanceDefaultSpecs() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpPostgresInstance, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				gcpPostgresInstance.Spec.PostgresTier = cloudresourcesv1beta1.GcpPostgresTierS1
+				gcpPostgresInstance.Spec.PostgresVersion = "16"
+				gcpPostgresInstance.Spec.StorageGb = 20
+				gcpPostgresInstance.Spec.DatabaseName = "postgres"
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpPostgresInstanceDefaultSpecs", obj))
+		},
+	}
+}
+
+func WithGcpPostgresInstancePostgresTier(postgresTier cloudresourcesv1beta1.GcpPostgresTier) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpPostgresInstance, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				gcpPostgresInstance.Spec.PostgresTier = postgresTier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpPostgresInstancePostgresTier", obj))
+		},
+	}
+}
+
+func WithGcpPostgresInstanceStorageGb(storageGb int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpPostgresInstance, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				gcpPostgresInstance.Spec.StorageGb = storageGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpPostgresInstanceStorageGb", obj))
+		},
+	}
+}
+
+func WithGcpPostgresInstanceAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpPostgresInstance, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				if gcpPostgresInstance.Spec.AuthSecret == nil {
+					gcpPostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				gcpPostgresInstance.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpPostgresInstanceAuthSecretName", obj))
+		},
+	}
+}
+
+func WithGcpPostgresInstanceAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpPostgresInstance, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				if gcpPostgresInstance.Spec.AuthSecret == nil {
+					gcpPostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				if gcpPostgresInstance.Spec.AuthSecret.Labels == nil {
+					gcpPostgresInstance.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(gcpPostgresInstance.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpPostgresInstanceAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithGcpPostgresInstanceAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpPostgresInstance, ok := obj.(*cloudresourcesv1beta1.GcpPostgresInstance); ok {
+				if gcpPostgresInstance.Spec.AuthSecret == nil {
+					gcpPostgresInstance.Spec.AuthSecret = &cloudresourcesv1beta1.PostgresAuthSecretSpec{}
+				}
+				if gcpPostgresInstance.Spec.AuthSecret.ExtraData == nil {
+					gcpPostgresInstance.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(gcpPostgresInstance.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpPostgresInstanceAuthSecretExtraData", obj))
+		},
+	}
+}
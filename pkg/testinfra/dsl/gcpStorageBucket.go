@@ -0,0 +1,116 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateGcpStorageBucket(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.GcpStorageBucket, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.GcpStorageBucket{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR GcpStorageBucket must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithGcpStorageBucketVersioning(versioning bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpStorageBucket, ok := obj.(*cloudresourcesv1beta1.GcpStorageBucket); ok {
+				gcpStorageBucket.Spec.Versioning = versioning
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpStorageBucketVersioning", obj))
+		},
+	}
+}
+
+func WithGcpStorageBucketLifecycle(rules ...cloudresourcesv1beta1.BucketLifecycleRule) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpStorageBucket, ok := obj.(*cloudresourcesv1beta1.GcpStorageBucket); ok {
+				gcpStorageBucket.Spec.Lifecycle = rules
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpStorageBucketLifecycle", obj))
+		},
+	}
+}
+
+func WithGcpStorageBucketRetentionDays(days int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpStorageBucket, ok := obj.(*cloudresourcesv1beta1.GcpStorageBucket); ok {
+				gcpStorageBucket.Spec.RetentionDays = days
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpStorageBucketRetentionDays", obj))
+		},
+	}
+}
+
+func WithGcpStorageBucketAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpStorageBucket, ok := obj.(*cloudresourcesv1beta1.GcpStorageBucket); ok {
+				if gcpStorageBucket.Spec.AuthSecret == nil {
+					gcpStorageBucket.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				gcpStorageBucket.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpStorageBucketAuthSecretName", obj))
+		},
+	}
+}
+
+func WithGcpStorageBucketAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpStorageBucket, ok := obj.(*cloudresourcesv1beta1.GcpStorageBucket); ok {
+				if gcpStorageBucket.Spec.AuthSecret == nil {
+					gcpStorageBucket.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				if gcpStorageBucket.Spec.AuthSecret.Labels == nil {
+					gcpStorageBucket.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(gcpStorageBucket.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpStorageBucketAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithGcpStorageBucketAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpStorageBucket, ok := obj.(*cloudresourcesv1beta1.GcpStorageBucket); ok {
+				if gcpStorageBucket.Spec.AuthSecret == nil {
+					gcpStorageBucket.Spec.AuthSecret = &cloudresourcesv1beta1.BucketAuthSecretSpec{}
+				}
+				if gcpStorageBucket.Spec.AuthSecret.ExtraData == nil {
+					gcpStorageBucket.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(gcpStorageBucket.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpStorageBucketAuthSecretExtraData", obj))
+		},
+	}
+}
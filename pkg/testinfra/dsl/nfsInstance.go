@@ -48,6 +48,16 @@ func WithNfsInstanceStatusCapacity(capacity resource.Quantity) ObjStatusAction {
 	}
 }
 
+func WithNfsInstanceStatusCapacityGb(capacityGb int) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.NfsInstance); ok {
+				x.Status.CapacityGb = capacityGb
+			}
+		},
+	}
+}
+
 func WithNfsInstanceStatusPath(path string) ObjStatusAction {
 	return &objStatusAction{
 		f: func(obj client.Object) {
@@ -97,6 +107,26 @@ func WithNfsInstanceAws() ObjAction {
 	}
 }
 
+func WithNfsInstanceAwsSourceRecoveryPointId(recoveryPointId string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.NfsInstance); ok {
+				x.Spec.Instance.Aws.SourceRecoveryPointId = recoveryPointId
+			}
+		},
+	}
+}
+
+func WithNfsInstanceAwsSourceBackupVaultName(backupVaultName string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.NfsInstance); ok {
+				x.Spec.Instance.Aws.SourceBackupVaultName = backupVaultName
+			}
+		},
+	}
+}
+
 func WithNfsInstanceSap(sizeGb int) ObjAction {
 	return &objAction{
 		f: func(obj client.Object) {
@@ -0,0 +1,32 @@
+package dsl
+
+import (
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func WithNukeDryRun() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Nuke); ok {
+				x.Spec.DryRun = true
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithNukeDryRun", obj))
+		},
+	}
+}
+
+func WithNukeApproved() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.Nuke); ok {
+				x.Spec.Approved = true
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithNukeApproved", obj))
+		},
+	}
+}
@@ -0,0 +1,269 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudcontrolv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-control/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func WithPostgresInstancePrimaryEndpoint(primaryEndpoint string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if postgresInstance, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				postgresInstance.Status.PrimaryEndpoint = primaryEndpoint
+			}
+		},
+	}
+}
+
+func WithPostgresInstanceCredentials(username, password string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if postgresInstance, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				postgresInstance.Status.Username = username
+				postgresInstance.Status.Password = password
+			}
+		},
+	}
+}
+
+func CreatePostgresInstance(ctx context.Context, clnt client.Client, obj *cloudcontrolv1beta1.PostgresInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudcontrolv1beta1.PostgresInstance{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultKcpNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the KCP PostgresInstance must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func UpdatePostgresInstance(ctx context.Context, clnt client.Client, obj *cloudcontrolv1beta1.PostgresInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudcontrolv1beta1.PostgresInstance{}
+	}
+	NewObjActions(opts...).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the KCP PostgresInstance must have name set")
+	}
+
+	err := clnt.Update(ctx, obj)
+	return err
+}
+
+func WithPostgresInstanceAws() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Aws = &cloudcontrolv1beta1.PostgresInstanceAws{}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithPostgresInstanceAws", obj))
+		},
+	}
+}
+
+func WithKcpAwsPostgresInstanceClass(instanceClass string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Aws.InstanceClass = instanceClass
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAwsPostgresInstanceClass", obj))
+		},
+	}
+}
+
+func WithKcpAwsPostgresEngineVersion(engineVersion string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Aws.EngineVersion = engineVersion
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAwsPostgresEngineVersion", obj))
+		},
+	}
+}
+
+func WithKcpAwsPostgresStorageGb(storageGb int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Aws.StorageGb = storageGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAwsPostgresStorageGb", obj))
+		},
+	}
+}
+
+func WithKcpAwsPostgresMultiAz(multiAz bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Aws.MultiAz = multiAz
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAwsPostgresMultiAz", obj))
+		},
+	}
+}
+
+func WithKcpAwsPostgresPreferredMaintenanceWindow(preferredMaintenanceWindow *string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Aws.PreferredMaintenanceWindow = preferredMaintenanceWindow
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAwsPostgresPreferredMaintenanceWindow", obj))
+		},
+	}
+}
+
+func WithPostgresInstanceGcp() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Gcp = &cloudcontrolv1beta1.PostgresInstanceGcp{}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithPostgresInstanceGcp", obj))
+		},
+	}
+}
+
+func WithKcpGcpPostgresTier(tier string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Gcp.Tier = tier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpGcpPostgresTier", obj))
+		},
+	}
+}
+
+func WithKcpGcpPostgresDatabaseVersion(databaseVersion string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Gcp.DatabaseVersion = databaseVersion
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpGcpPostgresDatabaseVersion", obj))
+		},
+	}
+}
+
+func WithKcpGcpPostgresDataDiskSizeGb(dataDiskSizeGb int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Gcp.DataDiskSizeGb = dataDiskSizeGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpGcpPostgresDataDiskSizeGb", obj))
+		},
+	}
+}
+
+func WithKcpGcpPostgresAvailabilityType(availabilityType string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Gcp.AvailabilityType = availabilityType
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpGcpPostgresAvailabilityType", obj))
+		},
+	}
+}
+
+func WithPostgresInstanceAzure() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Azure = &cloudcontrolv1beta1.PostgresInstanceAzure{}
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithPostgresInstanceAzure", obj))
+		},
+	}
+}
+
+func WithKcpAzurePostgresSku(skuName, skuTier string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Azure.SkuName = skuName
+				x.Spec.Instance.Azure.SkuTier = skuTier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAzurePostgresSku", obj))
+		},
+	}
+}
+
+func WithKcpAzurePostgresVersion(version string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Azure.Version = version
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAzurePostgresVersion", obj))
+		},
+	}
+}
+
+func WithKcpAzurePostgresStorageGb(storageGb int32) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Azure.StorageGb = storageGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAzurePostgresStorageGb", obj))
+		},
+	}
+}
+
+func WithKcpAzurePostgresHighAvailability(highAvailability bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Azure.HighAvailability = highAvailability
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAzurePostgresHighAvailability", obj))
+		},
+	}
+}
+
+func WithKcpAzurePostgresDatabaseName(databaseName string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.PostgresInstance); ok {
+				x.Spec.Instance.Azure.DatabaseName = databaseName
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAzurePostgresDatabaseName", obj))
+		},
+	}
+}
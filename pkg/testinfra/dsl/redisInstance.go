@@ -19,6 +19,16 @@ func WithRedisInstancePrimaryEndpoint(primaryEndpoint string) ObjStatusAction {
 	}
 }
 
+func WithRedisInstanceStatusId(id string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			if redisInstance, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				redisInstance.Status.Id = id
+			}
+		},
+	}
+}
+
 func WithRedisInstanceReadEndpoint(readEndpoint string) ObjStatusAction {
 	return &objStatusAction{
 		f: func(obj client.Object) {
@@ -218,6 +228,18 @@ func WithKcpGcpRedisInstanceMaintenancePolicy(maintenancePolicy *cloudcontrolv1b
 	}
 }
 
+func WithKcpGcpRedisInstanceImportUri(importUri string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if gcpRedisInstance, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				gcpRedisInstance.Spec.Instance.Gcp.ImportUri = importUri
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpGcpRedisInstanceImportUri", obj))
+		},
+	}
+}
+
 func WithRedisInstanceAws() ObjAction {
 	return &objAction{
 		f: func(obj client.Object) {
@@ -302,6 +324,18 @@ func WithKcpAwsAuthEnabled(authEnabled bool) ObjAction {
 	}
 }
 
+func WithKcpAwsDeferTierChange(deferTierChange bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if awsRedisInstance, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				awsRedisInstance.Spec.Instance.Aws.DeferTierChange = deferTierChange
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpAwsDeferTierChange", obj))
+		},
+	}
+}
+
 func WithKcpAwsReadReplicas(readReplicas int32) ObjAction {
 	return &objAction{
 		f: func(obj client.Object) {
@@ -437,3 +471,51 @@ func WithClusterProperties(shardCount int, replicaCount int) ObjAction {
 		},
 	}
 }
+
+func WithRedisInstanceOpenStack() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				x.Spec.Instance.OpenStack = &cloudcontrolv1beta1.RedisInstanceOpenStack{}
+			}
+		},
+	}
+}
+
+func WithKcpOpenStackFlavor(flavorName string, volumeSizeGb int) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				sapRedisInstance.Spec.Instance.OpenStack.FlavorName = flavorName
+				sapRedisInstance.Spec.Instance.OpenStack.VolumeSizeGb = volumeSizeGb
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpOpenStackFlavor", obj))
+		},
+	}
+}
+
+func WithKcpOpenStackEngine(engine, engineVersion string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				sapRedisInstance.Spec.Instance.OpenStack.Engine = engine
+				sapRedisInstance.Spec.Instance.OpenStack.EngineVersion = engineVersion
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpOpenStackEngine", obj))
+		},
+	}
+}
+
+func WithKcpOpenStackAuthEnabled(authEnabled bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudcontrolv1beta1.RedisInstance); ok {
+				sapRedisInstance.Spec.Instance.OpenStack.AuthEnabled = authEnabled
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithKcpOpenStackAuthEnabled", obj))
+		},
+	}
+}
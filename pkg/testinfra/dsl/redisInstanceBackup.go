@@ -0,0 +1,174 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func createRedisInstanceBackup(ctx context.Context, clnt client.Client, obj client.Object, source *cloudresourcesv1beta1.RedisInstanceBackupSource, opts ...ObjAction) error {
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.GetName() == "" {
+		return fmt.Errorf("the SKR %T must have name set", obj)
+	}
+	if source.RedisInstance.Name == "" {
+		return fmt.Errorf("the SKR %T must have spec.source.redisInstance.name set", obj)
+	}
+	if source.RedisInstance.Namespace == "" {
+		source.RedisInstance.Namespace = DefaultSkrNamespace
+	}
+
+	err := clnt.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if err == nil {
+		// already exists
+		return nil
+	}
+	if client.IgnoreNotFound(err) != nil {
+		// some error
+		return err
+	}
+	err = clnt.Create(ctx, obj)
+	return err
+}
+
+func CreateAwsRedisInstanceBackup(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AwsRedisInstanceBackup, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AwsRedisInstanceBackup{}
+	}
+	return createRedisInstanceBackup(ctx, clnt, obj, &obj.Spec.Source, opts...)
+}
+
+func CreateGcpRedisInstanceBackup(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.GcpRedisInstanceBackup, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.GcpRedisInstanceBackup{}
+	}
+	NewObjActions(opts...).ApplyOnObject(obj)
+	if obj.Spec.Bucket == "" {
+		return errors.New("the SKR GcpRedisInstanceBackup must have spec.bucket set")
+	}
+	return createRedisInstanceBackup(ctx, clnt, obj, &obj.Spec.Source)
+}
+
+func CreateAzureRedisInstanceBackup(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.AzureRedisInstanceBackup, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.AzureRedisInstanceBackup{}
+	}
+	return createRedisInstanceBackup(ctx, clnt, obj, &obj.Spec.Source, opts...)
+}
+
+func WithRedisInstanceBackupSource(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			var source *cloudresourcesv1beta1.RedisInstanceBackupSource
+			switch x := obj.(type) {
+			case *cloudresourcesv1beta1.AwsRedisInstanceBackup:
+				source = &x.Spec.Source
+			case *cloudresourcesv1beta1.GcpRedisInstanceBackup:
+				source = &x.Spec.Source
+			case *cloudresourcesv1beta1.AzureRedisInstanceBackup:
+				source = &x.Spec.Source
+			default:
+				panic(fmt.Errorf("unhandled type %T in WithRedisInstanceBackupSource", obj))
+			}
+			source.RedisInstance.Name = name
+			if source.RedisInstance.Namespace == "" {
+				source.RedisInstance.Namespace = DefaultSkrNamespace
+			}
+		},
+	}
+}
+
+func WithGcpRedisInstanceBackupBucket(bucket string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if x, ok := obj.(*cloudresourcesv1beta1.GcpRedisInstanceBackup); ok {
+				x.Spec.Bucket = bucket
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithGcpRedisInstanceBackupBucket", obj))
+		},
+	}
+}
+
+func WithSkrRedisInstanceStatusId(id string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			switch x := obj.(type) {
+			case *cloudresourcesv1beta1.AwsRedisInstance:
+				x.Status.Id = id
+			case *cloudresourcesv1beta1.GcpRedisInstance:
+				x.Status.Id = id
+			case *cloudresourcesv1beta1.AzureRedisInstance:
+				x.Status.Id = id
+			default:
+				panic(fmt.Errorf("unhandled type %T in WithSkrRedisInstanceStatusId", obj))
+			}
+		},
+	}
+}
+
+func WithRedisInstanceBackupStatusId(id string) ObjStatusAction {
+	return &objStatusAction{
+		f: func(obj client.Object) {
+			switch x := obj.(type) {
+			case *cloudresourcesv1beta1.AwsRedisInstanceBackup:
+				x.Status.Id = id
+			case *cloudresourcesv1beta1.GcpRedisInstanceBackup:
+				x.Status.Id = id
+			case *cloudresourcesv1beta1.AzureRedisInstanceBackup:
+				x.Status.Id = id
+			default:
+				panic(fmt.Errorf("unhandled type %T in WithRedisInstanceBackupStatusId", obj))
+			}
+		},
+	}
+}
+
+func WithRedisInstanceSourceBackup(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			ref := &cloudresourcesv1beta1.RedisInstanceBackupRef{Name: name}
+			switch x := obj.(type) {
+			case *cloudresourcesv1beta1.AwsRedisInstance:
+				x.Spec.SourceBackup = ref
+			case *cloudresourcesv1beta1.GcpRedisInstance:
+				x.Spec.SourceBackup = ref
+			case *cloudresourcesv1beta1.AzureRedisInstance:
+				x.Spec.SourceBackup = ref
+			default:
+				panic(fmt.Errorf("unhandled type %T in WithRedisInstanceSourceBackup", obj))
+			}
+		},
+	}
+}
+
+func AssertRedisInstanceBackupHasState(state string) ObjAssertion {
+	return func(obj client.Object) error {
+		var actual string
+		switch x := obj.(type) {
+		case *cloudresourcesv1beta1.AwsRedisInstanceBackup:
+			actual = x.Status.State
+		case *cloudresourcesv1beta1.GcpRedisInstanceBackup:
+			actual = x.Status.State
+		case *cloudresourcesv1beta1.AzureRedisInstanceBackup:
+			actual = x.Status.State
+		default:
+			return fmt.Errorf("the object %T is not a redis instance backup", obj)
+		}
+		if actual == "" {
+			return fmt.Errorf("the %T state not set", obj)
+		}
+		if actual != state {
+			return fmt.Errorf("the %T state is %s, expected %s", obj, actual, state)
+		}
+		return nil
+	}
+}
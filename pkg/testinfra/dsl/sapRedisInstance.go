@@ -0,0 +1,153 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateSapRedisInstance(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.SapRedisInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.SapRedisInstance{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR SapRedisInstance must have name set")
+	}
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithSapRedisInstanceRedisTier(redisTier cloudresourcesv1beta1.SapRedisTier) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				sapRedisInstance.Spec.RedisTier = redisTier
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceRedisTier", obj))
+		},
+	}
+}
+
+func WithSapRedisInstanceAuthEnabled(authEnabled bool) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				sapRedisInstance.Spec.AuthEnabled = authEnabled
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceAuthEnabled", obj))
+		},
+	}
+}
+
+func WithSapRedisInstanceAuthSecretName(name string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				if sapRedisInstance.Spec.AuthSecret == nil {
+					sapRedisInstance.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+				}
+				sapRedisInstance.Spec.AuthSecret.Name = name
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceAuthSecretName", obj))
+		},
+	}
+}
+
+func WithSapRedisInstanceAuthSecretLabels(labels map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				if sapRedisInstance.Spec.AuthSecret == nil {
+					sapRedisInstance.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+				}
+				if sapRedisInstance.Spec.AuthSecret.Labels == nil {
+					sapRedisInstance.Spec.AuthSecret.Labels = map[string]string{}
+				}
+				maps.Copy(sapRedisInstance.Spec.AuthSecret.Labels, labels)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceAuthSecretLabels", obj))
+		},
+	}
+}
+
+func WithSapRedisInstanceAuthSecretAnnotations(annotations map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				if sapRedisInstance.Spec.AuthSecret == nil {
+					sapRedisInstance.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+				}
+				if sapRedisInstance.Spec.AuthSecret.Annotations == nil {
+					sapRedisInstance.Spec.AuthSecret.Annotations = map[string]string{}
+				}
+				maps.Copy(sapRedisInstance.Spec.AuthSecret.Annotations, annotations)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceAuthSecretAnnotations", obj))
+		},
+	}
+}
+
+func WithSapRedisInstanceAuthSecretExtraData(extraData map[string]string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				if sapRedisInstance.Spec.AuthSecret == nil {
+					sapRedisInstance.Spec.AuthSecret = &cloudresourcesv1beta1.RedisAuthSecretSpec{}
+				}
+				if sapRedisInstance.Spec.AuthSecret.ExtraData == nil {
+					sapRedisInstance.Spec.AuthSecret.ExtraData = map[string]string{}
+				}
+				maps.Copy(sapRedisInstance.Spec.AuthSecret.ExtraData, extraData)
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceAuthSecretExtraData", obj))
+		},
+	}
+}
+
+func UpdateSapRedisInstance(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.SapRedisInstance, opts ...ObjAction) error {
+	if obj == nil {
+		obj = &cloudresourcesv1beta1.SapRedisInstance{}
+	}
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	if obj.Name == "" {
+		return errors.New("the SKR SapRedisInstance must have name set")
+	}
+
+	err := clnt.Update(ctx, obj)
+	return err
+}
+
+func WithSapRedisInstanceDefaultSpecs() ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			if sapRedisInstance, ok := obj.(*cloudresourcesv1beta1.SapRedisInstance); ok {
+				sapRedisInstance.Spec.RedisTier = cloudresourcesv1beta1.SapRedisTierS1
+				sapRedisInstance.Spec.Engine = cloudresourcesv1beta1.SapRedisEngineValkey
+				return
+			}
+			panic(fmt.Errorf("unhandled type %T in WithSapRedisInstanceDefaultSpecs", obj))
+		},
+	}
+}
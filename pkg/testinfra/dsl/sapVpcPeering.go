@@ -0,0 +1,53 @@
+package dsl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudresourcesv1beta1 "github.com/kyma-project/cloud-manager/api/cloud-resources/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CreateSapVpcPeering(ctx context.Context, clnt client.Client, obj *cloudresourcesv1beta1.SapVpcPeering, opts ...ObjAction) error {
+	NewObjActions(opts...).
+		Append(
+			WithNamespace(DefaultSkrNamespace),
+		).
+		ApplyOnObject(obj)
+
+	err := clnt.Create(ctx, obj)
+	return err
+}
+
+func WithSapVpcPeeringRemote(remoteProject, remoteNetworkId string) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			x := obj.(*cloudresourcesv1beta1.SapVpcPeering)
+			x.Spec.RemoteProject = remoteProject
+			x.Spec.RemoteNetworkId = remoteNetworkId
+		},
+	}
+}
+
+func WithSapVpcPeeringMode(mode cloudresourcesv1beta1.SapVpcPeeringMode) ObjAction {
+	return &objAction{
+		f: func(obj client.Object) {
+			x := obj.(*cloudresourcesv1beta1.SapVpcPeering)
+			x.Spec.Mode = mode
+		},
+	}
+}
+
+func AssertSapVpcPeeringHasId() ObjAssertion {
+	return func(obj client.Object) error {
+		x, ok := obj.(*cloudresourcesv1beta1.SapVpcPeering)
+		if !ok {
+			return fmt.Errorf("the object %T is not SapVpcPeering", obj)
+		}
+		if x.Status.Id == "" {
+			return errors.New("the SapVpcPeering ID not set")
+		}
+		return nil
+	}
+}
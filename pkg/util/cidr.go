@@ -56,3 +56,23 @@ func CidrParseIPnPrefix(cidr string) (string, int, error) {
 	prefix, _ := ipnet.Mask.Size()
 	return ip.String(), prefix, nil
 }
+
+// CidrValidateIpv4 returns an error if the given cidr has invalid syntax, is not IPv4, or its
+// block size is not between minOnes and maxOnes
+func CidrValidateIpv4(cidr string, minOnes, maxOnes int) error {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("CIDR %s has invalid syntax", cidr)
+	}
+	ones, bits := ipnet.Mask.Size()
+	if bits != 32 {
+		return fmt.Errorf("CIDR %s is not IPv4", cidr)
+	}
+	if ones > maxOnes {
+		return fmt.Errorf("CIDR %s block size must not be greater than %d", cidr, maxOnes)
+	}
+	if ones < minOnes {
+		return fmt.Errorf("CIDR %s block size must not be less than %d", cidr, minOnes)
+	}
+	return nil
+}
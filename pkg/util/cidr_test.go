@@ -32,4 +32,29 @@ func TestCidr(t *testing.T) {
 		}
 	})
 
+	t.Run("CidrValidateIpv4", func(t *testing.T) {
+		testData := []struct {
+			cidr string
+			err  string
+		}{
+			{cidr: "10.250.0.0/22", err: ""},
+			{cidr: "10.250.0.0/16", err: ""},
+			{cidr: "10.250.0.0/30", err: ""},
+			{cidr: "10.250.0.0", err: "CIDR 10.250.0.0 has invalid syntax"},
+			{cidr: "2001:db8::/64", err: "CIDR 2001:db8::/64 is not IPv4"},
+			{cidr: "10.250.0.0/31", err: "CIDR 10.250.0.0/31 block size must not be greater than 30"},
+			{cidr: "10.0.0.0/15", err: "CIDR 10.0.0.0/15 block size must not be less than 16"},
+		}
+
+		for _, data := range testData {
+			t.Run(data.cidr, func(t *testing.T) {
+				err := CidrValidateIpv4(data.cidr, 16, 30)
+				if data.err == "" {
+					assert.NoError(t, err)
+				} else {
+					assert.EqualError(t, err, data.err)
+				}
+			})
+		}
+	})
 }